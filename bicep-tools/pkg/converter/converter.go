@@ -14,6 +14,9 @@ import (
 	"github.com/radius-project/radius/bicep-tools/pkg/manifest"
 )
 
+// immutableDescription is appended to the description of properties with the x-radius-immutable annotation.
+const immutableDescription = "This property cannot be changed after the resource is created."
+
 // ConversionResult represents the output of converting a manifest to Bicep types
 type ConversionResult struct {
 	TypesContent         string
@@ -472,16 +475,26 @@ func addObjectProperty(
 		}
 	}
 
-	// Check if this property is read-only
-	if property.ReadOnly != nil && *property.ReadOnly {
+	// Check if this property is read-only. Fields computed by Radius are read-only as well.
+	if (property.ReadOnly != nil && *property.ReadOnly) || (property.IsReadOnly != nil && *property.IsReadOnly) {
 		flags |= types.TypePropertyFlagsReadOnly
 	}
 
+	// Check if this property is write-only
+	if property.IsWriteOnly != nil && *property.IsWriteOnly {
+		flags |= types.TypePropertyFlagsWriteOnly
+	}
+
 	description := ""
 	if property.Description != nil {
 		description = *property.Description
 	}
 
+	// Bicep has no flag for properties that cannot be changed, so they are described instead.
+	if property.IsImmutable != nil && *property.IsImmutable {
+		description = strings.TrimSpace(description + " " + immutableDescription)
+	}
+
 	return types.ObjectTypeProperty{
 		Type:        propertyTypeRef,
 		Flags:       flags,
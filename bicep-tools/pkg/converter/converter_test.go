@@ -1634,3 +1634,60 @@ func TestAddSchemaType_ArrayOfSensitiveObjects(t *testing.T) {
 		t.Error("Expected array item object to be marked as sensitive")
 	}
 }
+
+func TestAddObjectProperty_FieldAnnotations(t *testing.T) {
+	parent := &manifest.Schema{
+		Type:       "object",
+		Properties: map[string]manifest.Schema{},
+	}
+
+	enabled := true
+	description := "cool description"
+	tests := []struct {
+		name                string
+		property            *manifest.Schema
+		expectedFlags       types.TypePropertyFlags
+		expectedDescription string
+	}{
+		{
+			name:                "readonly",
+			property:            &manifest.Schema{Type: "string", Description: &description, IsReadOnly: &enabled},
+			expectedFlags:       types.TypePropertyFlagsReadOnly,
+			expectedDescription: "cool description",
+		},
+		{
+			name:                "writeonly",
+			property:            &manifest.Schema{Type: "string", Description: &description, IsWriteOnly: &enabled},
+			expectedFlags:       types.TypePropertyFlagsWriteOnly,
+			expectedDescription: "cool description",
+		},
+		{
+			name:                "immutable",
+			property:            &manifest.Schema{Type: "string", Description: &description, IsImmutable: &enabled},
+			expectedFlags:       types.TypePropertyFlagsNone,
+			expectedDescription: "cool description This property cannot be changed after the resource is created.",
+		},
+		{
+			name:                "immutable without description",
+			property:            &manifest.Schema{Type: "string", IsImmutable: &enabled},
+			expectedFlags:       types.TypePropertyFlagsNone,
+			expectedDescription: "This property cannot be changed after the resource is created.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := addObjectProperty(parent, "a", tt.property, factory.NewTypeFactory(), false)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			if result.Flags != tt.expectedFlags {
+				t.Errorf("Expected flags %v, got %v", tt.expectedFlags, result.Flags)
+			}
+			if result.Description != tt.expectedDescription {
+				t.Errorf("Expected description '%s', got '%s'", tt.expectedDescription, result.Description)
+			}
+		})
+	}
+}
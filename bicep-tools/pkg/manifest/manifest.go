@@ -39,6 +39,9 @@ type Schema struct {
 	Items                *Schema           `yaml:"items,omitempty" json:"items,omitempty"`
 	Enum                 []string          `yaml:"enum,omitempty" json:"enum,omitempty"`
 	IsSensitive          *bool             `yaml:"x-radius-sensitive,omitempty" json:"x-radius-sensitive,omitempty"`
+	IsImmutable          *bool             `yaml:"x-radius-immutable,omitempty" json:"x-radius-immutable,omitempty"`
+	IsReadOnly           *bool             `yaml:"x-radius-readonly,omitempty" json:"x-radius-readonly,omitempty"`
+	IsWriteOnly          *bool             `yaml:"x-radius-writeonly,omitempty" json:"x-radius-writeonly,omitempty"`
 }
 
 // ParseManifest parses a YAML manifest string into a ResourceProvider struct
@@ -128,6 +131,13 @@ func (s *Schema) Validate(context string) error {
 		}
 	}
 
+	// Validate x-radius-readonly is not combined with the annotations for fields set by the user
+	if s.IsReadOnly != nil && *s.IsReadOnly {
+		if (s.IsImmutable != nil && *s.IsImmutable) || (s.IsWriteOnly != nil && *s.IsWriteOnly) {
+			return fmt.Errorf("x-radius-readonly annotation cannot be combined with x-radius-immutable or x-radius-writeonly in %s", context)
+		}
+	}
+
 	// Validate nested properties if this is an object type
 	if s.Type == "object" && s.Properties != nil {
 		for propName, propSchema := range s.Properties {
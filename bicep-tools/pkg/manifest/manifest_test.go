@@ -562,3 +562,63 @@ types:
 		t.Error("Expected credentials object to be marked as sensitive")
 	}
 }
+
+func TestSchema_Validate_FieldAnnotations(t *testing.T) {
+	enabled := true
+
+	// Test that x-radius-readonly combined with x-radius-writeonly fails validation
+	schema := Schema{
+		Type:        "string",
+		IsReadOnly:  &enabled,
+		IsWriteOnly: &enabled,
+	}
+	if err := schema.Validate("test"); err == nil {
+		t.Error("Expected validation error for x-radius-readonly combined with x-radius-writeonly")
+	} else if !strings.Contains(err.Error(), "cannot be combined") {
+		t.Errorf("Expected error message about combined annotations, got: %v", err)
+	}
+
+	// Test that x-radius-immutable combined with x-radius-writeonly passes validation
+	schema = Schema{
+		Type:        "string",
+		IsImmutable: &enabled,
+		IsWriteOnly: &enabled,
+	}
+	if err := schema.Validate("test"); err != nil {
+		t.Errorf("Expected no validation error for x-radius-immutable with x-radius-writeonly, got: %v", err)
+	}
+
+	// Test that the annotations are parsed from the manifest
+	provider, err := ParseManifest(`
+namespace: MyCompany.Resources
+types:
+  testResources:
+    apiVersions:
+      '2025-01-01-preview':
+        schema:
+          type: object
+          properties:
+            name:
+              type: string
+              x-radius-immutable: true
+            host:
+              type: string
+              x-radius-readonly: true
+            password:
+              type: string
+              x-radius-writeonly: true
+`)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	properties := provider.Types["testResources"].APIVersions["2025-01-01-preview"].Schema.Properties
+	if properties["name"].IsImmutable == nil || !*properties["name"].IsImmutable {
+		t.Error("Expected name to be immutable")
+	}
+	if properties["host"].IsReadOnly == nil || !*properties["host"].IsReadOnly {
+		t.Error("Expected host to be read-only")
+	}
+	if properties["password"].IsWriteOnly == nil || !*properties["password"].IsWriteOnly {
+		t.Error("Expected password to be write-only")
+	}
+}
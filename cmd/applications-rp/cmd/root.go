@@ -87,6 +87,11 @@ var rootCmd = &cobra.Command{
 			server.NewAsyncWorker(options, builders),
 		)
 
+		// Recipe drift detection is opt-in because it periodically re-plans every recipe deployment.
+		if options.Config.RecipeDrift.Enabled {
+			services = append(services, server.NewRecipeDriftService(options))
+		}
+
 		host := &hosting.Host{
 			Services: services,
 		}
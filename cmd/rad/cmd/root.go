@@ -65,16 +65,21 @@ import (
 	"github.com/radius-project/radius/pkg/cli/cmd/radinit"
 	radinit_preview "github.com/radius-project/radius/pkg/cli/cmd/radinit/preview"
 	recipe_list "github.com/radius-project/radius/pkg/cli/cmd/recipe/list"
+	recipe_prefetch "github.com/radius-project/radius/pkg/cli/cmd/recipe/prefetch"
 	recipe_register "github.com/radius-project/radius/pkg/cli/cmd/recipe/register"
 	recipe_show "github.com/radius-project/radius/pkg/cli/cmd/recipe/show"
 	recipe_unregister "github.com/radius-project/radius/pkg/cli/cmd/recipe/unregister"
+	recipe_validate "github.com/radius-project/radius/pkg/cli/cmd/recipe/validate"
 	recipe_pack_delete "github.com/radius-project/radius/pkg/cli/cmd/recipepack/delete"
 	recipe_pack_list "github.com/radius-project/radius/pkg/cli/cmd/recipepack/list"
 	recipe_pack_show "github.com/radius-project/radius/pkg/cli/cmd/recipepack/show"
 	resource_create "github.com/radius-project/radius/pkg/cli/cmd/resource/create"
 	resource_delete "github.com/radius-project/radius/pkg/cli/cmd/resource/delete"
+	resource_invoke "github.com/radius-project/radius/pkg/cli/cmd/resource/invoke"
 	resource_list "github.com/radius-project/radius/pkg/cli/cmd/resource/list"
+	resource_logs "github.com/radius-project/radius/pkg/cli/cmd/resource/logs"
 	resource_show "github.com/radius-project/radius/pkg/cli/cmd/resource/show"
+	resource_update "github.com/radius-project/radius/pkg/cli/cmd/resource/update"
 	resourceprovider_create "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/create"
 	resourceprovider_delete "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/delete"
 	resourceprovider_list "github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/list"
@@ -304,12 +309,21 @@ func initSubCommands() {
 	resourceListCmd, _ := resource_list.NewCommand(framework)
 	resourceCmd.AddCommand(resourceListCmd)
 
+	resourceLogsCmd, _ := resource_logs.NewCommand(framework)
+	resourceCmd.AddCommand(resourceLogsCmd)
+
 	resourceCreateCmd, _ := resource_create.NewCommand(framework)
 	resourceCmd.AddCommand(resourceCreateCmd)
 
 	resourceDeleteCmd, _ := resource_delete.NewCommand(framework)
 	resourceCmd.AddCommand(resourceDeleteCmd)
 
+	resourceUpdateCmd, _ := resource_update.NewCommand(framework)
+	resourceCmd.AddCommand(resourceUpdateCmd)
+
+	resourceInvokeCmd, _ := resource_invoke.NewCommand(framework)
+	resourceCmd.AddCommand(resourceInvokeCmd)
+
 	resourceProviderShowCmd, _ := resourceprovider_show.NewCommand(framework)
 	resourceProviderCmd.AddCommand(resourceProviderShowCmd)
 
@@ -346,6 +360,12 @@ func initSubCommands() {
 	unregisterRecipeCmd, _ := recipe_unregister.NewCommand(framework)
 	recipeCmd.AddCommand(unregisterRecipeCmd)
 
+	validateRecipeCmd, _ := recipe_validate.NewCommand(framework)
+	recipeCmd.AddCommand(validateRecipeCmd)
+
+	prefetchRecipeCmd, _ := recipe_prefetch.NewCommand(framework)
+	recipeCmd.AddCommand(prefetchRecipeCmd)
+
 	listRecipePackCmd, _ := recipe_pack_list.NewCommand(framework)
 	recipePackCmd.AddCommand(listRecipePackCmd)
 
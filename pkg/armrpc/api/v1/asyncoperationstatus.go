@@ -39,4 +39,7 @@ type AsyncOperationStatus struct {
 
 	// Error represents the error occurred during provisioning.
 	Error *ErrorDetails `json:"error,omitempty"`
+
+	// StatusMessage describes the progress of an operation that has not completed, such as the reason it is waiting.
+	StatusMessage string `json:"statusMessage,omitempty"`
 }
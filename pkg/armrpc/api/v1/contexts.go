@@ -31,4 +31,10 @@ var (
 
 	// HostingConfigContextKey is the context key for hosting configuration.
 	HostingConfigContextKey = &contextKey{"hostingConfig"}
+
+	// operationStatusReporterKey is the context key for the reporter of the async operation status message.
+	operationStatusReporterKey = &contextKey{"operationStatusReporter"}
+
+	// operationLoggerKey is the context key for the logger of the async operation.
+	operationLoggerKey = &contextKey{"operationLogger"}
 )
@@ -61,4 +61,7 @@ const (
 
 	// Used for failed invalid spec api validation.
 	CodeHTTPRequestPayloadAPISpecValidationFailed = "HttpRequestPayloadAPISpecValidationFailed"
+
+	// Used when a downstream service is unavailable.
+	CodeServiceUnavailable = "ServiceUnavailable"
 )
@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// OperationLogEntry is a line of the log recorded while processing an async operation.
+type OperationLogEntry struct {
+	// Sequence is the position of the entry in the log of the operation, starting at 1.
+	Sequence int64 `json:"sequence"`
+
+	// Timestamp is the time when the entry was recorded.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Message is the content of the entry.
+	Message string `json:"message"`
+}
+
+// OperationLogs represents the log of an async operation returned by the operationStatuses logs endpoint.
+type OperationLogs struct {
+	// ID represents the async operation id.
+	ID string `json:"id,omitempty"`
+
+	// Name represents the async operation name and is usually set to the async operation id.
+	Name string `json:"name,omitempty"`
+
+	// ResourceID is the id of the resource the operation was performed on.
+	ResourceID string `json:"resourceId,omitempty"`
+
+	// Status represents the provisioning state of the operation.
+	Status ProvisioningState `json:"status,omitempty"`
+
+	// StartTime represents the async operation start time.
+	StartTime time.Time `json:"startTime"`
+
+	// Entries are the entries of the log, ordered by sequence.
+	Entries []OperationLogEntry `json:"entries"`
+
+	// Truncated is true when the oldest entries were dropped because the log exceeded its size limit.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// OperationLogger records the log of the async operation being processed, such as the output of the tools run by a
+// recipe. Values registered with AddSecrets are redacted from the log.
+type OperationLogger interface {
+	io.Writer
+
+	// AddSecrets registers values that must not appear in the log.
+	AddSecrets(values ...string)
+}
+
+// WithOperationLogger adds the OperationLogger of the async operation being processed to the context and returns the
+// new context.
+func WithOperationLogger(ctx context.Context, logger OperationLogger) context.Context {
+	return context.WithValue(ctx, operationLoggerKey, logger)
+}
+
+// OperationLoggerFromContext returns the OperationLogger of the async operation being processed. It returns a logger
+// that discards its input if the context was not created for an async operation.
+func OperationLoggerFromContext(ctx context.Context) OperationLogger {
+	logger, ok := ctx.Value(operationLoggerKey).(OperationLogger)
+	if !ok || logger == nil {
+		return discardOperationLogger{}
+	}
+
+	return logger
+}
+
+type discardOperationLogger struct{}
+
+func (discardOperationLogger) Write(p []byte) (int, error) {
+	return len(p), nil
+}
+
+func (discardOperationLogger) AddSecrets(values ...string) {}
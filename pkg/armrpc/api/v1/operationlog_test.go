@@ -0,0 +1,56 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testOperationLogger struct {
+	bytes.Buffer
+	secrets []string
+}
+
+func (l *testOperationLogger) AddSecrets(values ...string) {
+	l.secrets = append(l.secrets, values...)
+}
+
+func TestOperationLoggerFromContext(t *testing.T) {
+	t.Run("no logger", func(t *testing.T) {
+		logger := OperationLoggerFromContext(t.Context())
+		_, err := fmt.Fprintln(logger, "discarded")
+		require.NoError(t, err)
+		logger.AddSecrets("secret")
+	})
+
+	t.Run("logger", func(t *testing.T) {
+		expected := &testOperationLogger{}
+		ctx := WithOperationLogger(t.Context(), expected)
+
+		logger := OperationLoggerFromContext(ctx)
+		_, err := fmt.Fprintln(logger, "recorded")
+		require.NoError(t, err)
+		logger.AddSecrets("secret")
+
+		require.Equal(t, "recorded\n", expected.String())
+		require.Equal(t, []string{"secret"}, expected.secrets)
+	})
+}
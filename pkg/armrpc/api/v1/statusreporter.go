@@ -0,0 +1,41 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+)
+
+// OperationStatusReporter records a message describing the progress of the async operation being processed.
+type OperationStatusReporter func(ctx context.Context, message string) error
+
+// WithOperationStatusReporter adds the OperationStatusReporter of the async operation being processed to the context
+// and returns the new context.
+func WithOperationStatusReporter(ctx context.Context, reporter OperationStatusReporter) context.Context {
+	return context.WithValue(ctx, operationStatusReporterKey, reporter)
+}
+
+// ReportOperationStatus records a message describing the progress of the async operation being processed, such as the
+// reason the operation is waiting. It is a no-op if the context was not created for an async operation.
+func ReportOperationStatus(ctx context.Context, message string) error {
+	reporter, ok := ctx.Value(operationStatusReporterKey).(OperationStatusReporter)
+	if !ok || reporter == nil {
+		return nil
+	}
+
+	return reporter(ctx, message)
+}
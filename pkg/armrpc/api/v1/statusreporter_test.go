@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportOperationStatus(t *testing.T) {
+	t.Run("no reporter", func(t *testing.T) {
+		require.NoError(t, ReportOperationStatus(t.Context(), "waiting"))
+	})
+
+	t.Run("reporter", func(t *testing.T) {
+		reported := []string{}
+		ctx := WithOperationStatusReporter(t.Context(), func(ctx context.Context, message string) error {
+			reported = append(reported, message)
+			return nil
+		})
+
+		require.NoError(t, ReportOperationStatus(ctx, "waiting"))
+		require.Equal(t, []string{"waiting"}, reported)
+	})
+
+	t.Run("reporter error", func(t *testing.T) {
+		ctx := WithOperationStatusReporter(t.Context(), func(ctx context.Context, message string) error {
+			return errors.New("failed")
+		})
+
+		require.EqualError(t, ReportOperationStatus(ctx, "waiting"), "failed")
+	})
+}
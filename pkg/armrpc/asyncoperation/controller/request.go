@@ -56,6 +56,14 @@ type Request struct {
 
 	// OperationTimeout represents the timeout duration of async operation.
 	OperationTimeout *time.Duration `json:"asyncOperationTimeout"`
+
+	// Input represents the input of a custom action operation.
+	Input map[string]any `json:"input,omitempty"`
+
+	// ResourceProvisioningState represents the provisioning state the resource is restored to when the operation
+	// completes. It is set by operations that do not change the resource, such as custom actions, so that their
+	// outcome is only reported by the operation status.
+	ResourceProvisioningState v1.ProvisioningState `json:"resourceProvisioningState,omitempty"`
 }
 
 // Timeout gets the operation timeout and returns the default timeout unless it specifies.
@@ -28,6 +28,9 @@ type Result struct {
 	// Error represents the error when status is Cancelled or Failed.
 	Error *v1.ErrorDetails
 
+	// Output represents the output of a custom action operation. It is returned as the operation result.
+	Output map[string]any
+
 	// state represents the provisioning status.
 	state *v1.ProvisioningState
 }
@@ -44,6 +44,44 @@ func (m *MockStatusManager) EXPECT() *MockStatusManagerMockRecorder {
 	return m.recorder
 }
 
+// AppendLogs mocks base method.
+func (m *MockStatusManager) AppendLogs(ctx context.Context, id resources.ID, operationID uuid.UUID, entries []v1.OperationLogEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendLogs", ctx, id, operationID, entries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendLogs indicates an expected call of AppendLogs.
+func (mr *MockStatusManagerMockRecorder) AppendLogs(ctx, id, operationID, entries any) *MockStatusManagerAppendLogsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendLogs", reflect.TypeOf((*MockStatusManager)(nil).AppendLogs), ctx, id, operationID, entries)
+	return &MockStatusManagerAppendLogsCall{Call: call}
+}
+
+// MockStatusManagerAppendLogsCall wrap *gomock.Call
+type MockStatusManagerAppendLogsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusManagerAppendLogsCall) Return(arg0 error) *MockStatusManagerAppendLogsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusManagerAppendLogsCall) Do(f func(context.Context, resources.ID, uuid.UUID, []v1.OperationLogEntry) error) *MockStatusManagerAppendLogsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusManagerAppendLogsCall) DoAndReturn(f func(context.Context, resources.ID, uuid.UUID, []v1.OperationLogEntry) error) *MockStatusManagerAppendLogsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Delete mocks base method.
 func (m *MockStatusManager) Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -196,3 +234,79 @@ func (c *MockStatusManagerUpdateCall) DoAndReturn(f func(context.Context, resour
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// UpdateOutput mocks base method.
+func (m *MockStatusManager) UpdateOutput(ctx context.Context, id resources.ID, operationID uuid.UUID, output map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOutput", ctx, id, operationID, output)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOutput indicates an expected call of UpdateOutput.
+func (mr *MockStatusManagerMockRecorder) UpdateOutput(ctx, id, operationID, output any) *MockStatusManagerUpdateOutputCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOutput", reflect.TypeOf((*MockStatusManager)(nil).UpdateOutput), ctx, id, operationID, output)
+	return &MockStatusManagerUpdateOutputCall{Call: call}
+}
+
+// MockStatusManagerUpdateOutputCall wrap *gomock.Call
+type MockStatusManagerUpdateOutputCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusManagerUpdateOutputCall) Return(arg0 error) *MockStatusManagerUpdateOutputCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusManagerUpdateOutputCall) Do(f func(context.Context, resources.ID, uuid.UUID, map[string]any) error) *MockStatusManagerUpdateOutputCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusManagerUpdateOutputCall) DoAndReturn(f func(context.Context, resources.ID, uuid.UUID, map[string]any) error) *MockStatusManagerUpdateOutputCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateStatusMessage mocks base method.
+func (m *MockStatusManager) UpdateStatusMessage(ctx context.Context, id resources.ID, operationID uuid.UUID, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatusMessage", ctx, id, operationID, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatusMessage indicates an expected call of UpdateStatusMessage.
+func (mr *MockStatusManagerMockRecorder) UpdateStatusMessage(ctx, id, operationID, message any) *MockStatusManagerUpdateStatusMessageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatusMessage", reflect.TypeOf((*MockStatusManager)(nil).UpdateStatusMessage), ctx, id, operationID, message)
+	return &MockStatusManagerUpdateStatusMessageCall{Call: call}
+}
+
+// MockStatusManagerUpdateStatusMessageCall wrap *gomock.Call
+type MockStatusManagerUpdateStatusMessageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockStatusManagerUpdateStatusMessageCall) Return(arg0 error) *MockStatusManagerUpdateStatusMessageCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockStatusManagerUpdateStatusMessageCall) Do(f func(context.Context, resources.ID, uuid.UUID, string) error) *MockStatusManagerUpdateStatusMessageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockStatusManagerUpdateStatusMessageCall) DoAndReturn(f func(context.Context, resources.ID, uuid.UUID, string) error) *MockStatusManagerUpdateStatusMessageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
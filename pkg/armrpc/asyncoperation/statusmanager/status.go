@@ -43,4 +43,13 @@ type Status struct {
 
 	// LastUpdatedTime represents the async operation last updated time.
 	LastUpdatedTime time.Time `json:"lastUpdatedTime"`
+
+	// Logs are the entries of the log recorded while processing the operation, bounded to MaxLogSize bytes.
+	Logs []v1.OperationLogEntry `json:"logs,omitempty"`
+
+	// LogsTruncated is true when the oldest entries of the log were dropped because it exceeded MaxLogSize.
+	LogsTruncated bool `json:"logsTruncated,omitempty"`
+
+	// Output is the output of a custom action operation, returned as the operation result once the operation completes.
+	Output map[string]any `json:"output,omitempty"`
 }
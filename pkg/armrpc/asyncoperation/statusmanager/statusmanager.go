@@ -41,12 +41,29 @@ type statusManager struct {
 	location       string
 }
 
+const (
+	// MaxLogSize is the maximum size in bytes of the log stored with an async operation status. The oldest entries
+	// are dropped when the log exceeds it.
+	MaxLogSize = 256 * 1024
+
+	// MaxLogEntrySize is the maximum size in bytes of the message of a single log entry.
+	MaxLogEntrySize = 8 * 1024
+
+	// appendLogsMaxAttempts is the number of attempts to append log entries when the status is updated concurrently.
+	appendLogsMaxAttempts = 5
+)
+
 // QueueOperationOptions is the options type provided when queueing an async operation.
 type QueueOperationOptions struct {
 	// OperationTimeout specifies the timeout duration for the async operation.
 	OperationTimeout time.Duration
 	// RetryAfter specifies the value of the Retry-After header that will be used for async operations.
 	RetryAfter time.Duration
+	// Input specifies the input of a custom action operation. It is sent to the async operation controller with the request.
+	Input map[string]any
+	// ResourceProvisioningState specifies the provisioning state the resource is restored to when the operation
+	// completes, for operations that do not change the resource.
+	ResourceProvisioningState v1.ProvisioningState
 }
 
 //go:generate go tool mockgen -typed -destination=./mock_statusmanager.go -package=statusmanager -self_package github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager StatusManager
@@ -59,6 +76,12 @@ type StatusManager interface {
 	QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMRequestContext, options QueueOperationOptions) error
 	// Update updates an async operation status.
 	Update(ctx context.Context, id resources.ID, operationID uuid.UUID, state v1.ProvisioningState, endTime *time.Time, opError *v1.ErrorDetails) error
+	// UpdateOutput updates the output of an async operation.
+	UpdateOutput(ctx context.Context, id resources.ID, operationID uuid.UUID, output map[string]any) error
+	// UpdateStatusMessage updates the message describing the progress of an async operation that has not completed.
+	UpdateStatusMessage(ctx context.Context, id resources.ID, operationID uuid.UUID, message string) error
+	// AppendLogs appends entries to the log of an async operation.
+	AppendLogs(ctx context.Context, id resources.ID, operationID uuid.UUID, entries []v1.OperationLogEntry) error
 	// Delete deletes an async operation status.
 	Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error
 }
@@ -115,7 +138,7 @@ func (aom *statusManager) QueueAsyncOperation(ctx context.Context, sCtx *v1.ARMR
 		return err
 	}
 
-	if err = aom.queueRequestMessage(ctx, sCtx, aos, options.OperationTimeout); err != nil {
+	if err = aom.queueRequestMessage(ctx, sCtx, aos, options); err != nil {
 		delErr := aom.databaseClient.Delete(ctx, opID)
 		if delErr != nil {
 			return delErr
@@ -166,6 +189,59 @@ func (aom *statusManager) Update(ctx context.Context, id resources.ID, operation
 		s.Error = opError
 	}
 
+	// The status message only describes the progress of an operation that has not completed.
+	if state.IsTerminal() {
+		s.StatusMessage = ""
+	}
+
+	s.LastUpdatedTime = time.Now().UTC()
+
+	obj.Data = s
+
+	return aom.databaseClient.Save(ctx, obj, database.WithETag(obj.ETag))
+}
+
+// UpdateOutput retrieves an existing operation status resource from the store, updates its output and saves it back
+// to the store.
+func (aom *statusManager) UpdateOutput(ctx context.Context, id resources.ID, operationID uuid.UUID, output map[string]any) error {
+	opID := aom.operationStatusResourceID(id, operationID)
+	obj, err := aom.databaseClient.Get(ctx, opID)
+	if err != nil {
+		return err
+	}
+
+	s := &Status{}
+	if err := obj.As(s); err != nil {
+		return err
+	}
+
+	s.Output = output
+	s.LastUpdatedTime = time.Now().UTC()
+
+	obj.Data = s
+
+	return aom.databaseClient.Save(ctx, obj, database.WithETag(obj.ETag))
+}
+
+// UpdateStatusMessage retrieves an existing operation status resource from the store, updates its status message and
+// saves it back to the store. The status message of an operation that has already completed is not updated.
+func (aom *statusManager) UpdateStatusMessage(ctx context.Context, id resources.ID, operationID uuid.UUID, message string) error {
+	opID := aom.operationStatusResourceID(id, operationID)
+	obj, err := aom.databaseClient.Get(ctx, opID)
+	if err != nil {
+		return err
+	}
+
+	s := &Status{}
+	if err := obj.As(s); err != nil {
+		return err
+	}
+
+	if s.Status.IsTerminal() || s.StatusMessage == message {
+		return nil
+	}
+
+	s.StatusMessage = message
 	s.LastUpdatedTime = time.Now().UTC()
 
 	obj.Data = s
@@ -173,6 +249,72 @@ func (aom *statusManager) Update(ctx context.Context, id resources.ID, operation
 	return aom.databaseClient.Save(ctx, obj, database.WithETag(obj.ETag))
 }
 
+// AppendLogs retrieves an existing operation status resource from the store, appends the entries to its log and saves
+// it back to the store. Entries are numbered in sequence and the oldest entries are dropped when the log exceeds
+// MaxLogSize. The update is retried when the status is updated concurrently.
+func (aom *statusManager) AppendLogs(ctx context.Context, id resources.ID, operationID uuid.UUID, entries []v1.OperationLogEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	opID := aom.operationStatusResourceID(id, operationID)
+	for attempt := 1; ; attempt++ {
+		obj, err := aom.databaseClient.Get(ctx, opID)
+		if err != nil {
+			return err
+		}
+
+		s := &Status{}
+		if err := obj.As(s); err != nil {
+			return err
+		}
+
+		s.appendLogs(entries)
+		obj.Data = s
+
+		err = aom.databaseClient.Save(ctx, obj, database.WithETag(obj.ETag))
+		if errors.Is(err, &database.ErrConcurrency{}) && attempt < appendLogsMaxAttempts {
+			continue
+		}
+
+		return err
+	}
+}
+
+// appendLogs appends entries to the log of the status, numbering them after the last entry, and drops the oldest
+// entries while the log exceeds MaxLogSize.
+func (s *Status) appendLogs(entries []v1.OperationLogEntry) {
+	next := int64(1)
+	if len(s.Logs) > 0 {
+		next = s.Logs[len(s.Logs)-1].Sequence + 1
+	}
+
+	for _, entry := range entries {
+		if len(entry.Message) > MaxLogEntrySize {
+			entry.Message = entry.Message[:MaxLogEntrySize] + "... (truncated)"
+		}
+		entry.Sequence = next
+		next++
+		s.Logs = append(s.Logs, entry)
+	}
+
+	size := 0
+	for _, entry := range s.Logs {
+		size += len(entry.Message)
+	}
+
+	dropped := 0
+	for size > MaxLogSize && dropped < len(s.Logs)-1 {
+		size -= len(s.Logs[dropped].Message)
+		dropped++
+	}
+
+	if dropped > 0 {
+		s.Logs = append([]v1.OperationLogEntry{}, s.Logs[dropped:]...)
+		s.LogsTruncated = true
+	}
+}
+
 // Delete deletes the operation status resource associated with the given ID and
 // operationID, and returns an error if unsuccessful.
 func (aom *statusManager) Delete(ctx context.Context, id resources.ID, operationID uuid.UUID) error {
@@ -180,18 +322,20 @@ func (aom *statusManager) Delete(ctx context.Context, id resources.ID, operation
 }
 
 // queueRequestMessage function is to put the async operation message to the queue to be worked on.
-func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMRequestContext, aos *Status, operationTimeout time.Duration) error {
+func (aom *statusManager) queueRequestMessage(ctx context.Context, sCtx *v1.ARMRequestContext, aos *Status, options QueueOperationOptions) error {
 	msg := &ctrl.Request{
-		APIVersion:       sCtx.APIVersion,
-		OperationID:      sCtx.OperationID,
-		OperationType:    sCtx.OperationType.String(),
-		ResourceID:       aos.LinkedResourceID,
-		CorrelationID:    sCtx.CorrelationID,
-		TraceparentID:    trace.ExtractTraceparent(ctx),
-		AcceptLanguage:   sCtx.AcceptLanguage,
-		HomeTenantID:     sCtx.HomeTenantID,
-		ClientObjectID:   sCtx.ClientObjectID,
-		OperationTimeout: &operationTimeout,
+		APIVersion:                sCtx.APIVersion,
+		OperationID:               sCtx.OperationID,
+		OperationType:             sCtx.OperationType.String(),
+		ResourceID:                aos.LinkedResourceID,
+		CorrelationID:             sCtx.CorrelationID,
+		TraceparentID:             trace.ExtractTraceparent(ctx),
+		AcceptLanguage:            sCtx.AcceptLanguage,
+		HomeTenantID:              sCtx.HomeTenantID,
+		ClientObjectID:            sCtx.ClientObjectID,
+		OperationTimeout:          &options.OperationTimeout,
+		Input:                     options.Input,
+		ResourceProvisioningState: options.ResourceProvisioningState,
 	}
 
 	return aom.queue.Enqueue(ctx, queue.NewMessage(msg))
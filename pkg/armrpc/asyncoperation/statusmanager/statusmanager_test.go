@@ -17,8 +17,10 @@ limitations under the License.
 package statusmanager
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -324,3 +326,191 @@ func TestUpdateAsyncOperationStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateAsyncOperationStatusMessage(t *testing.T) {
+	rid, err := resources.ParseResource(azureEnvResourceID)
+	require.NoError(t, err)
+
+	t.Run("update_in_progress", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		status := &Status{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				ID:     opID.String(),
+				Name:   opID.String(),
+				Status: v1.ProvisioningStateUpdating,
+			},
+		}
+
+		aomTest.databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&database.Object{Metadata: database.Metadata{ID: opID.String(), ETag: "etag"}, Data: status}, nil)
+
+		aomTest.databaseClient.
+			EXPECT().
+			Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, obj *database.Object, _ ...database.SaveOptions) error {
+				saved := obj.Data.(*Status)
+				require.Equal(t, "waiting for the resource", saved.StatusMessage)
+				require.False(t, saved.LastUpdatedTime.IsZero())
+				return nil
+			})
+
+		err := aomTest.manager.UpdateStatusMessage(t.Context(), rid, opID, "waiting for the resource")
+		require.NoError(t, err)
+	})
+
+	t.Run("skip_terminal", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		status := &Status{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				ID:     opID.String(),
+				Name:   opID.String(),
+				Status: v1.ProvisioningStateSucceeded,
+			},
+		}
+
+		aomTest.databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&database.Object{Metadata: database.Metadata{ID: opID.String(), ETag: "etag"}, Data: status}, nil)
+
+		err := aomTest.manager.UpdateStatusMessage(t.Context(), rid, opID, "waiting for the resource")
+		require.NoError(t, err)
+	})
+
+	t.Run("get_error", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		aomTest.databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, errors.New(getErr))
+
+		err := aomTest.manager.UpdateStatusMessage(t.Context(), rid, opID, "waiting for the resource")
+		require.EqualError(t, err, getErr)
+	})
+}
+
+func TestUpdateAsyncOperationOutput(t *testing.T) {
+	rid, err := resources.ParseResource(azureEnvResourceID)
+	require.NoError(t, err)
+
+	aomTest, mctrl := setup(t)
+	defer mctrl.Finish()
+
+	status := &Status{
+		AsyncOperationStatus: v1.AsyncOperationStatus{
+			ID:     opID.String(),
+			Name:   opID.String(),
+			Status: v1.ProvisioningStateUpdating,
+		},
+	}
+
+	aomTest.databaseClient.
+		EXPECT().
+		Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&database.Object{Metadata: database.Metadata{ID: opID.String(), ETag: "etag"}, Data: status}, nil)
+
+	aomTest.databaseClient.
+		EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, obj *database.Object, _ ...database.SaveOptions) error {
+			saved := obj.Data.(*Status)
+			require.Equal(t, map[string]any{"snapshotId": "snap-1"}, saved.Output)
+			require.Equal(t, v1.ProvisioningStateUpdating, saved.Status)
+			return nil
+		})
+
+	err = aomTest.manager.UpdateOutput(t.Context(), rid, opID, map[string]any{"snapshotId": "snap-1"})
+	require.NoError(t, err)
+}
+
+func TestAppendAsyncOperationLogs(t *testing.T) {
+	rid, err := resources.ParseResource(azureEnvResourceID)
+	require.NoError(t, err)
+
+	t.Run("append", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		status := &Status{
+			AsyncOperationStatus: v1.AsyncOperationStatus{ID: opID.String(), Status: v1.ProvisioningStateUpdating},
+			Logs:                 []v1.OperationLogEntry{{Sequence: 1, Message: "first"}},
+		}
+
+		aomTest.databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(&database.Object{Metadata: database.Metadata{ID: opID.String(), ETag: "etag"}, Data: status}, nil)
+
+		aomTest.databaseClient.
+			EXPECT().
+			Save(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, obj *database.Object, _ ...database.SaveOptions) error {
+				saved := obj.Data.(*Status)
+				require.Len(t, saved.Logs, 3)
+				require.Equal(t, int64(2), saved.Logs[1].Sequence)
+				require.Equal(t, "second", saved.Logs[1].Message)
+				require.Equal(t, int64(3), saved.Logs[2].Sequence)
+				require.False(t, saved.LogsTruncated)
+				return nil
+			})
+
+		err := aomTest.manager.AppendLogs(t.Context(), rid, opID, []v1.OperationLogEntry{{Message: "second"}, {Message: "third"}})
+		require.NoError(t, err)
+	})
+
+	t.Run("retry_on_conflict", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		aomTest.databaseClient.
+			EXPECT().
+			Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+				return &database.Object{Metadata: database.Metadata{ID: id, ETag: "etag"}, Data: &Status{}}, nil
+			}).
+			Times(2)
+
+		gomock.InOrder(
+			aomTest.databaseClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(&database.ErrConcurrency{}),
+			aomTest.databaseClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil),
+		)
+
+		err := aomTest.manager.AppendLogs(t.Context(), rid, opID, []v1.OperationLogEntry{{Message: "first"}})
+		require.NoError(t, err)
+	})
+
+	t.Run("no_entries", func(t *testing.T) {
+		aomTest, mctrl := setup(t)
+		defer mctrl.Finish()
+
+		err := aomTest.manager.AppendLogs(t.Context(), rid, opID, nil)
+		require.NoError(t, err)
+	})
+}
+
+func TestStatusAppendLogs_Bounded(t *testing.T) {
+	s := &Status{}
+	line := strings.Repeat("a", MaxLogEntrySize)
+
+	entries := []v1.OperationLogEntry{}
+	for range MaxLogSize/MaxLogEntrySize + 2 {
+		entries = append(entries, v1.OperationLogEntry{Message: line})
+	}
+	s.appendLogs(entries)
+
+	require.True(t, s.LogsTruncated)
+	require.Len(t, s.Logs, MaxLogSize/MaxLogEntrySize)
+	require.Equal(t, int64(len(entries)), s.Logs[len(s.Logs)-1].Sequence)
+
+	s.appendLogs([]v1.OperationLogEntry{{Message: line + "b"}})
+	require.Equal(t, line+"... (truncated)", s.Logs[len(s.Logs)-1].Message)
+	require.Equal(t, int64(len(entries)+1), s.Logs[len(s.Logs)-1].Sequence)
+}
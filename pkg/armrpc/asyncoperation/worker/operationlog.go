@@ -0,0 +1,231 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// operationLogFlushInterval is the interval at which the log of an async operation is saved with its status.
+	operationLogFlushInterval = 2 * time.Second
+
+	// minSecretLength is the minimum length of a value registered as a secret. Shorter values are not redacted to
+	// avoid making the log unreadable.
+	minSecretLength = 4
+
+	// redacted replaces the secrets in the log.
+	redacted = "***"
+)
+
+// redactPatterns match common forms of credentials in the output of tools, such as authorization headers and
+// passwords in connection strings. The "secret" group of a match is redacted.
+var redactPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)(?:proxy-)?authorization["']?\s*[:=]\s*["']?(?:bearer|basic|token)\s+(?P<secret>[^\s"']+)`),
+	regexp.MustCompile(`(?i)(?:password|passwd|pwd|client_secret|secret_key|access_key|api_key|apikey)["']?\s*[:=]\s*["']?(?P<secret>[^\s"',;&]+)`),
+	regexp.MustCompile(`://[^/\s:@]+:(?P<secret>[^@\s/]+)@`),
+}
+
+// operationLogger records the log of an async operation and periodically appends it to the operation status. It
+// implements v1.OperationLogger.
+type operationLogger struct {
+	flush func(ctx context.Context, entries []v1.OperationLogEntry) error
+
+	mu          sync.Mutex
+	partial     []byte
+	pending     []v1.OperationLogEntry
+	pendingSize int
+	secrets     []string
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+var _ v1.OperationLogger = (*operationLogger)(nil)
+
+// newOperationLogger creates an operationLogger that saves the log using flush.
+func newOperationLogger(flush func(ctx context.Context, entries []v1.OperationLogEntry) error) *operationLogger {
+	return &operationLogger{
+		flush: flush,
+		stop:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+}
+
+// Write records the lines of p in the log. An incomplete line is recorded when it is completed by a later write or
+// when the logger is closed.
+func (l *operationLogger) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.partial = append(l.partial, p...)
+	for {
+		i := bytes.IndexByte(l.partial, '\n')
+		if i < 0 {
+			break
+		}
+
+		l.addLine(string(l.partial[:i]))
+		l.partial = l.partial[i+1:]
+	}
+
+	if len(l.partial) >= manager.MaxLogEntrySize {
+		l.addLine(string(l.partial))
+		l.partial = nil
+	}
+
+	return len(p), nil
+}
+
+// AddSecrets registers values that are redacted from the log.
+func (l *operationLogger) AddSecrets(values ...string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, value := range values {
+		if len(value) >= minSecretLength {
+			l.secrets = append(l.secrets, value)
+		}
+	}
+
+	// Longer secrets are replaced first in case a secret contains another one.
+	sort.Slice(l.secrets, func(i, j int) bool { return len(l.secrets[i]) > len(l.secrets[j]) })
+}
+
+// Run saves the log periodically until the context is canceled or the logger is closed.
+func (l *operationLogger) Run(ctx context.Context) {
+	defer close(l.done)
+
+	ticker := time.NewTicker(operationLogFlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			l.flushPending(ctx)
+		case <-l.stop:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Close stops saving the log periodically and saves the remaining entries. Entries written after the logger is
+// closed are not saved.
+func (l *operationLogger) Close(ctx context.Context) {
+	l.closeOnce.Do(func() {
+		close(l.stop)
+		<-l.done
+
+		l.mu.Lock()
+		if len(l.partial) > 0 {
+			l.addLine(string(l.partial))
+			l.partial = nil
+		}
+		l.mu.Unlock()
+
+		l.flushPending(ctx)
+	})
+}
+
+// addLine adds a line to the pending entries, dropping the oldest entries when they exceed the size of the log.
+// The caller must hold the lock.
+func (l *operationLogger) addLine(line string) {
+	line = strings.TrimRight(line, "\r")
+	if strings.TrimSpace(line) == "" {
+		return
+	}
+
+	l.pending = append(l.pending, v1.OperationLogEntry{Timestamp: time.Now().UTC(), Message: line})
+	l.pendingSize += len(line)
+	l.trimPending()
+}
+
+// trimPending drops the oldest pending entries while they exceed the size of the log. The caller must hold the lock.
+func (l *operationLogger) trimPending() {
+	dropped := 0
+	for l.pendingSize > manager.MaxLogSize && dropped < len(l.pending)-1 {
+		l.pendingSize -= len(l.pending[dropped].Message)
+		dropped++
+	}
+	l.pending = l.pending[dropped:]
+}
+
+// flushPending redacts and saves the pending entries. The entries are kept to be saved again later when saving
+// them fails.
+func (l *operationLogger) flushPending(ctx context.Context) {
+	l.mu.Lock()
+	entries := l.pending
+	size := l.pendingSize
+	l.pending = nil
+	l.pendingSize = 0
+	secrets := l.secrets
+	l.mu.Unlock()
+
+	if len(entries) == 0 {
+		return
+	}
+
+	// Redact when saving rather than when writing so that secrets registered after a line was written are redacted.
+	redactedEntries := make([]v1.OperationLogEntry, len(entries))
+	for i, entry := range entries {
+		entry.Message = redact(entry.Message, secrets)
+		redactedEntries[i] = entry
+	}
+
+	if err := l.flush(ctx, redactedEntries); err != nil {
+		ucplog.FromContextOrDiscard(ctx).Error(err, "failed to save the operation log")
+
+		l.mu.Lock()
+		l.pending = append(entries, l.pending...)
+		l.pendingSize += size
+		l.trimPending()
+		l.mu.Unlock()
+	}
+}
+
+// redact replaces the secrets and the credentials matched by redactPatterns in the message.
+func redact(message string, secrets []string) string {
+	for _, secret := range secrets {
+		message = strings.ReplaceAll(message, secret, redacted)
+	}
+
+	for _, pattern := range redactPatterns {
+		group := pattern.SubexpIndex("secret")
+		matches := pattern.FindAllStringSubmatchIndex(message, -1)
+
+		// Replace from the end so that the indexes of the previous matches are still valid.
+		for i := len(matches) - 1; i >= 0; i-- {
+			start, end := matches[i][2*group], matches[i][2*group+1]
+			message = message[:start] + redacted + message[end:]
+		}
+	}
+
+	return message
+}
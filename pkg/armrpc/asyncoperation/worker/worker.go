@@ -244,6 +244,13 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 		return
 	}
 	asyncReqCtx, opCancel := context.WithCancel(ctx)
+	asyncReqCtx = v1.WithOperationStatusReporter(asyncReqCtx, w.operationStatusReporter(asyncReq))
+
+	// The operation log is saved with the operation status before the operation is completed, so that it does not
+	// conflict with the update of the status.
+	opLogger := newOperationLogger(w.operationLogFlusher(asyncReq))
+	asyncReqCtx = v1.WithOperationLogger(asyncReqCtx, opLogger)
+	go opLogger.Run(asyncReqCtx)
 	// Ensure that asyncReqCtx context is cancelled when runOperation returns.
 	// That is, cancelling asyncReqCtx signals to ctrl.Run() to cancel the execution,
 	// resulting in completing the go-routine calling ctrl.Run() when runOperation returns.
@@ -276,6 +283,7 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 						Code:    v1.CodeInternal,
 						Message: fmt.Sprintf("unexpected error while processing async operation: %v", err),
 					})
+					opLogger.Close(ctx)
 					w.completeOperation(ctx, message, failed, asyncCtrl.DatabaseClient())
 				}
 			}
@@ -303,6 +311,7 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 		// 2. When parent context is canceled or done, we need to requeue the operation to reprocess the request.
 		// Such cases should not call w.completeOperation.
 		if !errors.Is(asyncReqCtx.Err(), context.Canceled) {
+			opLogger.Close(ctx)
 			w.completeOperation(ctx, message, result, asyncCtrl.DatabaseClient())
 		}
 		trace.SetAsyncResultStatus(result, span)
@@ -326,6 +335,7 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 			logger.Info("Cancelling async operation.")
 
 			opCancel()
+			opLogger.Close(ctx)
 			errMessage := fmt.Sprintf("Operation (%s) has timed out because it was processing longer than %d s.", asyncReq.OperationType, int(asyncReq.Timeout().Seconds()))
 			result := ctrl.NewCanceledResult(errMessage)
 			result.Error.Target = asyncReq.ResourceID
@@ -347,6 +357,31 @@ func (w *AsyncRequestProcessWorker) runOperation(ctx context.Context, message *q
 	}
 }
 
+// operationStatusReporter returns the reporter used by controllers to record the status message of the operation,
+// such as the reason the operation is waiting.
+func (w *AsyncRequestProcessWorker) operationStatusReporter(req *ctrl.Request) v1.OperationStatusReporter {
+	return func(ctx context.Context, message string) error {
+		rID, err := resources.ParseResource(req.ResourceID)
+		if err != nil {
+			return err
+		}
+
+		return w.sm.UpdateStatusMessage(ctx, rID, req.OperationID, message)
+	}
+}
+
+// operationLogFlusher returns the function used to append the entries of the operation log to the operation status.
+func (w *AsyncRequestProcessWorker) operationLogFlusher(req *ctrl.Request) func(ctx context.Context, entries []v1.OperationLogEntry) error {
+	return func(ctx context.Context, entries []v1.OperationLogEntry) error {
+		rID, err := resources.ParseResource(req.ResourceID)
+		if err != nil {
+			return err
+		}
+
+		return w.sm.AppendLogs(ctx, rID, req.OperationID, entries)
+	}
+}
+
 func extractError(err error) v1.ErrorDetails {
 	if clientErr, ok := err.(*v1.ErrClientRP); ok {
 		return v1.ErrorDetails{Code: clientErr.Code, Message: clientErr.Message}
@@ -363,6 +398,15 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 		return
 	}
 
+	// The output is saved before the operation status becomes terminal, so that it is available as soon as the
+	// operation result can be read.
+	if result.Output != nil {
+		if err := w.updateOperationOutput(ctx, req, result.Output); err != nil {
+			logger.Error(err, "failed to update operation output")
+			return
+		}
+	}
+
 	err := w.updateResourceAndOperationStatus(ctx, sc, req, result.ProvisioningState(), result.Error)
 	if err != nil {
 		logger.Error(err, "failed to update resource and/or operation status")
@@ -379,6 +423,15 @@ func (w *AsyncRequestProcessWorker) completeOperation(ctx context.Context, messa
 	metrics.DefaultAsyncOperationMetrics.RecordAsyncOperation(ctx, req, &result)
 }
 
+func (w *AsyncRequestProcessWorker) updateOperationOutput(ctx context.Context, req *ctrl.Request, output map[string]any) error {
+	rID, err := resources.ParseResource(req.ResourceID)
+	if err != nil {
+		return err
+	}
+
+	return w.sm.UpdateOutput(ctx, rID, req.OperationID, output)
+}
+
 func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context.Context, sc database.Client, req *ctrl.Request, state v1.ProvisioningState, opErr *v1.ErrorDetails) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -388,7 +441,13 @@ func (w *AsyncRequestProcessWorker) updateResourceAndOperationStatus(ctx context
 		return err
 	}
 
-	err = updateResourceState(ctx, sc, rID.String(), state)
+	// Operations that do not change the resource restore its provisioning state once they complete.
+	resourceState := state
+	if state.IsTerminal() && req.ResourceProvisioningState != "" {
+		resourceState = req.ResourceProvisioningState
+	}
+
+	err = updateResourceState(ctx, sc, rID.String(), resourceState)
 	if errors.Is(err, &database.ErrNotFound{}) {
 		logger.Info("failed to update the provisioningState in resource because it no longer exists.")
 	} else if err != nil {
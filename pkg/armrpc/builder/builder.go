@@ -40,8 +40,8 @@ type Builder struct {
 	registrations []*OperationRegistration
 }
 
-// defaultHandlerOptions returns HandlerOption for the default operations such as getting operationStatuses and
-// operationResults.
+// defaultHandlerOptions returns HandlerOption for the default operations such as getting operationStatuses, their logs
+// and operationResults.
 func defaultHandlerOptions(
 	rootRouter chi.Router,
 	rootScopePath string,
@@ -73,6 +73,14 @@ func defaultHandlerOptions(
 		ControllerFactory: defaultoperation.NewGetOperationStatus,
 	})
 
+	handlers = append(handlers, server.HandlerOptions{
+		ParentRouter:      rootRouter,
+		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationstatuses/{operationId}/logs", rootScopePath, namespace),
+		ResourceType:      statusType + "/logs",
+		Method:            v1.OperationGet,
+		ControllerFactory: defaultoperation.NewGetOperationLogs,
+	})
+
 	handlers = append(handlers, server.HandlerOptions{
 		ParentRouter:      rootRouter,
 		Path:              fmt.Sprintf("%s/providers/%s/locations/{location}/operationresults/{operationId}", rootScopePath, namespace),
@@ -42,6 +42,10 @@ type Operation[T any] struct {
 	// DeleteFilters is a slice of filters that execute prior to deleting a resource.
 	DeleteFilters []controller.DeleteFilter[T]
 
+	// PatchFilters is a slice of filters that execute on a copy of the existing resource before a merge patch is
+	// applied to it. This is ignored by operations other than PATCH.
+	PatchFilters []controller.PatchFilter[T]
+
 	// APIController is the controller function for the API controller frontend.
 	APIController server.ControllerFactoryFunc
 
@@ -289,17 +293,18 @@ func (r *ResourceOption[P, T]) patchOutput(opts BuildOptions) *OperationRegistra
 			RequestConverter:         r.RequestConverter,
 			ResponseConverter:        r.ResponseConverter,
 			UpdateFilters:            r.Patch.UpdateFilters,
+			PatchFilters:             r.Patch.PatchFilters,
 			AsyncOperationTimeout:    getOrDefaultAsyncOperationTimeout(r.Patch.AsyncOperationTimeout),
 			AsyncOperationRetryAfter: getOrDefaultRetryAfter(r.Patch.AsyncOperationRetryAfter),
 		}
 
 		if r.Patch.AsyncJobController == nil {
 			h.APIController = func(opt controller.Options) (controller.Controller, error) {
-				return defaultoperation.NewDefaultSyncPut[P, T](opt, ro)
+				return defaultoperation.NewDefaultSyncPatch[P, T](opt, ro)
 			}
 		} else {
 			h.APIController = func(opt controller.Options) (controller.Controller, error) {
-				return defaultoperation.NewDefaultAsyncPut[P, T](opt, ro)
+				return defaultoperation.NewDefaultAsyncPatch[P, T](opt, ro)
 			}
 		}
 	}
@@ -106,6 +106,10 @@ type ResourceOptions[T any] struct {
 	// UpdateFilters is a slice of filters that execute prior to updating a resource.
 	UpdateFilters []UpdateFilter[T]
 
+	// PatchFilters is a slice of filters that execute on a copy of the existing resource before a merge patch is
+	// applied to it.
+	PatchFilters []PatchFilter[T]
+
 	// AsyncOperationTimeout is the default timeout duration of async put operation.
 	AsyncOperationTimeout time.Duration
 
@@ -249,3 +253,11 @@ type DeleteFilter[T any] func(ctx context.Context, oldResource *T, options *Opti
 // UpdateFilters should return a rest.Response to handle the request without allowing updates to occur. Any
 // errors returned will be treated as "unhandled" and logged before sending back an HTTP 500.
 type UpdateFilter[T any] func(ctx context.Context, newResource *T, oldResource *T, options *Options) (rest.Response, error)
+
+// PatchFilter is a function that is executed as part of the controller lifecycle. PatchFilters run on a copy of the
+// existing resource before a merge patch is applied to it and can be used to:
+//
+// - Remove values, such as write-only secrets, that must not be carried over from the existing resource.
+//
+// Any errors returned will be treated as "unhandled" and logged before sending back an HTTP 500.
+type PatchFilter[T any] func(ctx context.Context, base *T, options *Options) error
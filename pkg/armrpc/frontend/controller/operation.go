@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -89,6 +90,73 @@ func (c *Operation[P, T]) GetResourceFromRequest(ctx context.Context, req *http.
 	return dm, nil
 }
 
+// GetResourceFromPatchRequest applies the JSON merge patch (RFC 7386) in the HTTP request body to the versioned
+// representation of the existing resource and deserializes the result to datamodel. The patch filters run on a copy
+// of the existing resource before the patch is applied, so oldResource is not modified.
+func (c *Operation[P, T]) GetResourceFromPatchRequest(ctx context.Context, req *http.Request, oldResource *T) (*T, error) {
+	patch, err := ReadJSONBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Copy the existing resource through its stored representation so that the filters can modify it freely.
+	b, err := json.Marshal(oldResource)
+	if err != nil {
+		return nil, err
+	}
+	base := new(T)
+	if err := json.Unmarshal(b, base); err != nil {
+		return nil, err
+	}
+
+	for _, filter := range c.PatchFilters() {
+		if err := filter(ctx, base, c.Options()); err != nil {
+			return nil, err
+		}
+	}
+
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	versioned, err := c.resourceOptions.ResponseConverter(base, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := json.Marshal(versioned)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := MergePatch(doc, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.resourceOptions.RequestConverter(content, serviceCtx.APIVersion)
+}
+
+// GetResourceForUpdate gets the existing resource and builds the new resource from the HTTP request. PUT requests
+// replace the existing resource with the request body, and PATCH requests apply the request body to the existing
+// resource as a JSON merge patch. The new resource is nil when a PATCH request targets a resource that does not exist,
+// which PrepareResource reports as not found.
+func (c *Operation[P, T]) GetResourceForUpdate(ctx context.Context, req *http.Request) (newResource *T, oldResource *T, etag string, err error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	oldResource, etag, err = c.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	if req.Method != http.MethodPatch {
+		newResource, err = c.GetResourceFromRequest(ctx, req)
+	} else if oldResource != nil {
+		newResource, err = c.GetResourceFromPatchRequest(ctx, req, oldResource)
+	}
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	return newResource, oldResource, etag, nil
+}
+
 // GetResource is the helper to get the resource via database client.
 func (c *Operation[P, T]) GetResource(ctx context.Context, id resources.ID) (out *T, etag string, err error) {
 	etag = ""
@@ -244,6 +312,11 @@ func (b *Operation[P, T]) UpdateFilters() []UpdateFilter[T] {
 	return b.resourceOptions.UpdateFilters
 }
 
+// PatchFilters returns the set of filters to execute on the existing resource before a merge patch is applied.
+func (b *Operation[P, T]) PatchFilters() []PatchFilter[T] {
+	return b.resourceOptions.PatchFilters
+}
+
 // AsyncOperationTimeout returns the timeput for the operation.
 func (b *Operation[P, T]) AsyncOperationTimeout() time.Duration {
 	if b.resourceOptions.AsyncOperationTimeout == 0 {
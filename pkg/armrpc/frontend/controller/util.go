@@ -18,6 +18,7 @@ package controller
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -49,7 +50,7 @@ var (
 )
 
 // ReadJSONBody extracts the content from request - it reads the body of the request if the content type
-// is "application/json". It returns the body as a byte array or an error if the content type is not supported
+// is "application/json" or "application/merge-patch+json". It returns the body as a byte array or an error if the content type is not supported
 // or an error occurs while reading the body.
 func ReadJSONBody(r *http.Request) ([]byte, error) {
 	defer r.Body.Close()
@@ -59,7 +60,7 @@ func ReadJSONBody(r *http.Request) ([]byte, error) {
 		contentType = contentType[0:i]
 	}
 
-	if contentType != "application/json" {
+	if contentType != "application/json" && contentType != "application/merge-patch+json" {
 		return nil, ErrUnsupportedContentType
 	}
 	data, err := io.ReadAll(r.Body)
@@ -69,6 +70,45 @@ func ReadJSONBody(r *http.Request) ([]byte, error) {
 	return data, nil
 }
 
+// MergePatch applies the JSON merge patch (RFC 7386) in patch to the JSON document in doc and returns the
+// resulting document. Objects in the patch are merged recursively, null values remove the member from the target,
+// and all other values, including arrays, replace the target value.
+func MergePatch(doc []byte, patch []byte) ([]byte, error) {
+	var target any
+	if err := json.Unmarshal(doc, &target); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal the target document: %w", err)
+	}
+
+	var p any
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, fmt.Errorf("%w: the request body is not a valid JSON merge patch: %s", v1.ErrInvalidModelConversion, err.Error())
+	}
+
+	return json.Marshal(mergePatchValue(target, p))
+}
+
+func mergePatchValue(target any, patch any) any {
+	p, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	t, ok := target.(map[string]any)
+	if !ok {
+		t = map[string]any{}
+	}
+
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+		} else {
+			t[k] = mergePatchValue(t[k], v)
+		}
+	}
+
+	return t
+}
+
 // ValidateETag checks the If-Match and If-None-Match headers of the ARMRequestContext against the provided etag,
 // and returns an error if the etag does not match either header.
 func ValidateETag(armRequestContext v1.ARMRequestContext, etag string) error {
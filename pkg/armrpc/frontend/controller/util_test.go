@@ -43,6 +43,7 @@ func TestReadJSONBody(t *testing.T) {
 		{"application/json; charset=utf8", content, nil},
 		{"application/json;    charset=utf8", content, nil},
 		{"Application/Json;    charset=utf8    ", content, nil},
+		{"application/merge-patch+json", content, nil},
 		{"plain/text", content, ErrUnsupportedContentType},
 	}
 
@@ -64,6 +65,56 @@ func TestReadJSONBody(t *testing.T) {
 	}
 }
 
+func TestMergePatch(t *testing.T) {
+	doc := `{"name":"app","tags":{"env":"dev","team":"a"},"properties":{"ports":[80,443],"image":"nginx"}}`
+
+	tests := []struct {
+		name  string
+		patch string
+		want  string
+		err   error
+	}{
+		{
+			name:  "merge nested object",
+			patch: `{"properties":{"image":"nginx:1.27"}}`,
+			want:  `{"name":"app","tags":{"env":"dev","team":"a"},"properties":{"ports":[80,443],"image":"nginx:1.27"}}`,
+		},
+		{
+			name:  "null removes member",
+			patch: `{"tags":{"team":null}}`,
+			want:  `{"name":"app","tags":{"env":"dev"},"properties":{"ports":[80,443],"image":"nginx"}}`,
+		},
+		{
+			name:  "arrays are replaced",
+			patch: `{"properties":{"ports":[8080]}}`,
+			want:  `{"name":"app","tags":{"env":"dev","team":"a"},"properties":{"ports":[8080],"image":"nginx"}}`,
+		},
+		{
+			name:  "object replaces scalar",
+			patch: `{"name":{"value":"app"}}`,
+			want:  `{"name":{"value":"app"},"tags":{"env":"dev","team":"a"},"properties":{"ports":[80,443],"image":"nginx"}}`,
+		},
+		{
+			name:  "invalid patch",
+			patch: `{"properties":`,
+			err:   v1.ErrInvalidModelConversion,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			merged, err := MergePatch([]byte(doc), []byte(tc.patch))
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.JSONEq(t, tc.want, string(merged))
+		})
+	}
+}
+
 var tag string = uuid.New().String()
 
 func TestValidateEtag_IfMatch(t *testing.T) {
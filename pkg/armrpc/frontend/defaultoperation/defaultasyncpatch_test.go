@@ -0,0 +1,214 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDefaultAsyncPatch(t *testing.T) {
+	patchCases := []struct {
+		desc      string
+		curState  v1.ProvisioningState
+		notFound  bool
+		patch     map[string]any
+		rCode     int
+		propertyA string
+		propertyB string
+	}{
+		{
+			desc:      "async-patch-existing-resource-success",
+			curState:  v1.ProvisioningStateSucceeded,
+			patch:     map[string]any{"properties": map[string]any{"propertyA": "patchedValue"}},
+			rCode:     http.StatusAccepted,
+			propertyA: "patchedValue",
+			propertyB: "propertyBValue",
+		},
+		{
+			desc:      "async-patch-existing-resource-remove-property",
+			curState:  v1.ProvisioningStateSucceeded,
+			patch:     map[string]any{"properties": map[string]any{"propertyB": nil}},
+			rCode:     http.StatusAccepted,
+			propertyA: "propertyAValue",
+		},
+		{
+			desc:     "async-patch-existing-resource-mismatched-appid",
+			curState: v1.ProvisioningStateSucceeded,
+			patch:    map[string]any{"properties": map[string]any{"application": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/testGroup/providers/Applications.Core/applications/app1"}},
+			rCode:    http.StatusBadRequest,
+		},
+		{
+			desc:     "async-patch-existing-resource-in-progress",
+			curState: v1.ProvisioningStateUpdating,
+			patch:    map[string]any{"properties": map[string]any{"propertyA": "patchedValue"}},
+			rCode:    http.StatusConflict,
+		},
+		{
+			desc:     "async-patch-non-existing-resource",
+			notFound: true,
+			patch:    map[string]any{"properties": map[string]any{"propertyA": "patchedValue"}},
+			rCode:    http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range patchCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			teardownTest, mds, msm := setupTest(t)
+			defer teardownTest(t)
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(t.Context(), http.MethodPatch, resourceTestHeaderFile, tt.patch)
+			require.NoError(t, err)
+
+			ctx := rpctest.NewARMRequestContext(req)
+
+			if tt.notFound {
+				mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+					Return(nil, &database.ErrNotFound{}).
+					Times(1)
+			} else {
+				dataModel := &TestResourceDataModel{}
+				_ = json.Unmarshal(testutil.ReadFixture("resource-datamodel.json"), dataModel)
+				dataModel.InternalMetadata.AsyncProvisioningState = tt.curState
+
+				mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+					Return(&database.Object{Data: dataModel}, nil).
+					Times(1)
+			}
+
+			var saved *TestResourceDataModel
+			if tt.rCode == http.StatusAccepted {
+				mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
+						saved = obj.Data.(*TestResourceDataModel)
+						return nil
+					}).
+					Times(1)
+				msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil).
+					Times(1)
+			}
+
+			opts := ctrl.Options{
+				DatabaseClient: mds,
+				StatusManager:  msm,
+			}
+
+			resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+				RequestConverter:  testResourceDataModelFromVersioned,
+				ResponseConverter: testResourceDataModelToVersioned,
+				UpdateFilters: []ctrl.UpdateFilter[TestResourceDataModel]{
+					testValidateRequest,
+				},
+			}
+
+			ctl, err := NewDefaultAsyncPatch(opts, resourceOpts)
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.rCode, w.Result().StatusCode)
+
+			if tt.rCode == http.StatusAccepted {
+				require.NotNil(t, saved)
+				require.Equal(t, tt.propertyA, saved.Properties.PropertyA)
+				require.Equal(t, tt.propertyB, saved.Properties.PropertyB)
+				require.Equal(t, "West US", saved.Location)
+				require.Equal(t, v1.ProvisioningStateAccepted, saved.InternalMetadata.AsyncProvisioningState)
+			}
+		})
+	}
+}
+
+func TestDefaultAsyncPatch_PatchFilters(t *testing.T) {
+	teardownTest, mds, msm := setupTest(t)
+	defer teardownTest(t)
+
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(t.Context(), http.MethodPatch, resourceTestHeaderFile, map[string]any{"properties": map[string]any{"propertyA": "patchedValue"}})
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(req)
+
+	dataModel := &TestResourceDataModel{}
+	_ = json.Unmarshal(testutil.ReadFixture("resource-datamodel.json"), dataModel)
+
+	mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+		Return(&database.Object{Data: dataModel}, nil).
+		Times(1)
+
+	var saved *TestResourceDataModel
+	mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
+			saved = obj.Data.(*TestResourceDataModel)
+			return nil
+		}).
+		Times(1)
+	msm.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	var old *TestResourceDataModel
+	resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+		RequestConverter:  testResourceDataModelFromVersioned,
+		ResponseConverter: testResourceDataModelToVersioned,
+		PatchFilters: []ctrl.PatchFilter[TestResourceDataModel]{
+			// propertyB is treated as write-only, so it is not carried over from the existing resource.
+			func(ctx context.Context, base *TestResourceDataModel, options *ctrl.Options) error {
+				base.Properties.PropertyB = ""
+				return nil
+			},
+		},
+		UpdateFilters: []ctrl.UpdateFilter[TestResourceDataModel]{
+			func(ctx context.Context, newResource *TestResourceDataModel, oldResource *TestResourceDataModel, options *ctrl.Options) (rest.Response, error) {
+				old = oldResource
+				return nil, nil
+			},
+		},
+	}
+
+	ctl, err := NewDefaultAsyncPatch(ctrl.Options{DatabaseClient: mds, StatusManager: msm}, resourceOpts)
+	require.NoError(t, err)
+
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusAccepted, w.Result().StatusCode)
+
+	require.Equal(t, "patchedValue", saved.Properties.PropertyA)
+	require.Empty(t, saved.Properties.PropertyB)
+
+	// The patch filters run on a copy, so the existing resource passed to the update filters is unchanged.
+	require.Equal(t, "propertyBValue", old.Properties.PropertyB)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDefaultSyncPatch(t *testing.T) {
+	patchCases := []struct {
+		desc     string
+		notFound bool
+		rCode    int
+	}{
+		{
+			desc:  "sync-patch-existing-resource-success",
+			rCode: http.StatusOK,
+		},
+		{
+			desc:     "sync-patch-non-existing-resource",
+			notFound: true,
+			rCode:    http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range patchCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			teardownTest, mds, msm := setupTest(t)
+			defer teardownTest(t)
+
+			w := httptest.NewRecorder()
+			req, err := rpctest.NewHTTPRequestFromJSON(t.Context(), http.MethodPatch, resourceTestHeaderFile, map[string]any{"properties": map[string]any{"propertyA": "patchedValue"}})
+			require.NoError(t, err)
+
+			ctx := rpctest.NewARMRequestContext(req)
+
+			if tt.notFound {
+				mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+					Return(nil, &database.ErrNotFound{}).
+					Times(1)
+			} else {
+				dataModel := &TestResourceDataModel{}
+				_ = json.Unmarshal(testutil.ReadFixture("resource-datamodel.json"), dataModel)
+
+				mds.EXPECT().Get(gomock.Any(), gomock.Any()).
+					Return(&database.Object{Data: dataModel}, nil).
+					Times(1)
+			}
+
+			var saved *TestResourceDataModel
+			if tt.rCode == http.StatusOK {
+				mds.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
+						saved = obj.Data.(*TestResourceDataModel)
+						return nil
+					}).
+					Times(1)
+			}
+
+			resourceOpts := ctrl.ResourceOptions[TestResourceDataModel]{
+				RequestConverter:  testResourceDataModelFromVersioned,
+				ResponseConverter: testResourceDataModelToVersioned,
+				UpdateFilters: []ctrl.UpdateFilter[TestResourceDataModel]{
+					testValidateRequest,
+				},
+			}
+
+			ctl, err := NewDefaultSyncPatch(ctrl.Options{DatabaseClient: mds, StatusManager: msm}, resourceOpts)
+			require.NoError(t, err)
+
+			resp, err := ctl.Run(ctx, w, req)
+			require.NoError(t, err)
+
+			_ = resp.Apply(ctx, w, req)
+			require.Equal(t, tt.rCode, w.Result().StatusCode)
+
+			if tt.rCode == http.StatusOK {
+				require.Equal(t, "patchedValue", saved.Properties.PropertyA)
+				require.Equal(t, "propertyBValue", saved.Properties.PropertyB)
+				require.Equal(t, v1.ProvisioningStateSucceeded, saved.InternalMetadata.AsyncProvisioningState)
+			}
+		})
+	}
+}
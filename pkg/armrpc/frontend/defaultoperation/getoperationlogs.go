@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// LatestOperationName is the operation name used to get the log of the latest operation performed on the
+	// resource specified by the ResourceIDQueryParam query parameter.
+	LatestOperationName = "latest"
+
+	// ResourceIDQueryParam is the query parameter specifying the resource of the latest operation.
+	ResourceIDQueryParam = "resourceId"
+
+	// SinceQueryParam is the query parameter specifying the sequence number of the last log entry already received.
+	// Only the entries after it are returned.
+	SinceQueryParam = "since"
+)
+
+var _ ctrl.Controller = (*GetOperationLogs)(nil)
+
+// GetOperationLogs is the controller implementation to get the log of an async operation.
+type GetOperationLogs struct {
+	ctrl.BaseController
+}
+
+// NewGetOperationLogs creates a new GetOperationLogs.
+func NewGetOperationLogs(opts ctrl.Options) (ctrl.Controller, error) {
+	return &GetOperationLogs{ctrl.NewBaseController(opts)}, nil
+}
+
+// Run returns the log recorded while processing an asynchronous operation, or a NotFound error if the operation is not
+// found. Callers following the log of a running operation can pass the sequence number of the last entry they received
+// in the "since" query parameter to only get the new entries.
+func (e *GetOperationLogs) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	// The request id is the operation status id followed by the "logs" segment.
+	id := serviceCtx.ResourceID.Truncate()
+
+	since := int64(0)
+	if value := req.URL.Query().Get(SinceQueryParam); value != "" {
+		var err error
+		since, err = strconv.ParseInt(value, 10, 64)
+		if err != nil || since < 0 {
+			return rest.NewBadRequestResponse(fmt.Sprintf("The %q query parameter must be a non-negative integer.", SinceQueryParam)), nil
+		}
+	}
+
+	os := &manager.Status{}
+	if strings.EqualFold(id.Name(), LatestOperationName) {
+		resourceID, err := resources.ParseResource(req.URL.Query().Get(ResourceIDQueryParam))
+		if err != nil {
+			return rest.NewBadRequestResponse(fmt.Sprintf("The %q query parameter must be a valid resource id when getting the log of the latest operation.", ResourceIDQueryParam)), nil
+		}
+
+		latest, err := e.latestOperation(ctx, id, resourceID)
+		if err != nil {
+			return nil, err
+		}
+		if latest == nil {
+			return rest.NewNotFoundMessageResponse(fmt.Sprintf("No operation was found for the resource %q.", resourceID.String())), nil
+		}
+		os = latest
+	} else {
+		_, err := e.GetResource(ctx, id.String(), os)
+		if errors.Is(err, &database.ErrNotFound{ID: id.String()}) {
+			return rest.NewNotFoundResponse(id), nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	entries := []v1.OperationLogEntry{}
+	for _, entry := range os.Logs {
+		if entry.Sequence > since {
+			entries = append(entries, entry)
+		}
+	}
+
+	return rest.NewOKResponse(&v1.OperationLogs{
+		ID:         os.ID,
+		Name:       os.Name,
+		ResourceID: os.LinkedResourceID,
+		Status:     os.Status,
+		StartTime:  os.StartTime,
+		Entries:    entries,
+		Truncated:  os.LogsTruncated,
+	}), nil
+}
+
+// latestOperation returns the most recently started operation performed on the resource, or nil if there is none.
+func (e *GetOperationLogs) latestOperation(ctx context.Context, id resources.ID, resourceID resources.ID) (*manager.Status, error) {
+	result, err := e.DatabaseClient().Query(ctx, database.Query{
+		// Operation statuses are stored at the plane scope of the resource.
+		RootScope:    resourceID.PlaneScope(),
+		ResourceType: id.Type(),
+		Filters: []database.QueryFilter{
+			{Field: "resourceID", Value: resourceID.String()},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *manager.Status
+	for _, item := range result.Items {
+		os := &manager.Status{}
+		if err := item.As(os); err != nil {
+			return nil, err
+		}
+
+		if latest == nil || os.StartTime.After(latest.StartTime) {
+			latest = os
+		}
+	}
+
+	return latest, nil
+}
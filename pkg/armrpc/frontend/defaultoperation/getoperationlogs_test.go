@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaultoperation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	manager "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	operationLogsResourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/test-container"
+	operationLogsStatusBase = "/planes/radius/local/providers/applications.core/locations/global/operationstatuses/"
+)
+
+func TestGetOperationLogsRun(t *testing.T) {
+	databaseClient := inmemory.NewClient()
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	saveStatus := func(name string, startTime time.Time, logs ...string) {
+		s := &manager.Status{
+			AsyncOperationStatus: v1.AsyncOperationStatus{
+				ID:        operationLogsStatusBase + name,
+				Name:      name,
+				Status:    v1.ProvisioningStateUpdating,
+				StartTime: startTime,
+			},
+			LinkedResourceID: operationLogsResourceID,
+		}
+		for i, message := range logs {
+			s.Logs = append(s.Logs, v1.OperationLogEntry{Sequence: int64(i + 1), Message: message})
+		}
+
+		err := databaseClient.Save(t.Context(), &database.Object{Metadata: database.Metadata{ID: s.ID}, Data: s})
+		require.NoError(t, err)
+	}
+
+	saveStatus("00000000-0000-0000-0000-000000000001", start, "old operation")
+	saveStatus("00000000-0000-0000-0000-000000000002", start.Add(time.Minute), "first", "second", "third")
+
+	run := func(t *testing.T, url string) (*httptest.ResponseRecorder, *v1.OperationLogs) {
+		req := httptest.NewRequest(http.MethodGet, url, nil)
+		ctx := rpctest.NewARMRequestContext(req)
+
+		ctl, err := NewGetOperationLogs(ctrl.Options{DatabaseClient: databaseClient})
+		require.NoError(t, err)
+
+		resp, err := ctl.Run(ctx, nil, req)
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		require.NoError(t, resp.Apply(ctx, w, req))
+
+		logs := &v1.OperationLogs{}
+		_ = json.Unmarshal(w.Body.Bytes(), logs)
+		return w, logs
+	}
+
+	t.Run("get logs", func(t *testing.T) {
+		w, logs := run(t, operationLogsStatusBase+"00000000-0000-0000-0000-000000000002/logs?api-version=2023-10-01-preview")
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, operationLogsResourceID, logs.ResourceID)
+		require.Equal(t, v1.ProvisioningStateUpdating, logs.Status)
+		require.Len(t, logs.Entries, 3)
+	})
+
+	t.Run("get logs since", func(t *testing.T) {
+		w, logs := run(t, operationLogsStatusBase+"00000000-0000-0000-0000-000000000002/logs?since=2")
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Len(t, logs.Entries, 1)
+		require.Equal(t, "third", logs.Entries[0].Message)
+	})
+
+	t.Run("get logs of latest operation", func(t *testing.T) {
+		w, logs := run(t, operationLogsStatusBase+"latest/logs?resourceId="+operationLogsResourceID)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "00000000-0000-0000-0000-000000000002", logs.Name)
+		require.Len(t, logs.Entries, 3)
+	})
+
+	t.Run("latest operation not found", func(t *testing.T) {
+		w, _ := run(t, operationLogsStatusBase+"latest/logs?resourceId=/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/other")
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("latest operation requires resource id", func(t *testing.T) {
+		w, _ := run(t, operationLogsStatusBase+"latest/logs")
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("invalid since", func(t *testing.T) {
+		w, _ := run(t, operationLogsStatusBase+"00000000-0000-0000-0000-000000000002/logs?since=abc")
+		require.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("operation not found", func(t *testing.T) {
+		w, _ := run(t, operationLogsStatusBase+"00000000-0000-0000-0000-000000000003/logs")
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
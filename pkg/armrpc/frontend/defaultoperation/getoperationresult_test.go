@@ -155,3 +155,44 @@ func TestGetOperationResultRun(t *testing.T) {
 		})
 	}
 }
+
+func TestGetOperationResultRun_Output(t *testing.T) {
+	rawDataModel := testutil.ReadFixture("operationstatus_datamodel.json")
+	osDataModel := &manager.Status{}
+	err := json.Unmarshal(rawDataModel, osDataModel)
+	require.NoError(t, err)
+
+	osDataModel.Status = v1.ProvisioningStateSucceeded
+	osDataModel.Output = map[string]any{"snapshotId": "snap-1"}
+
+	mctrl := gomock.NewController(t)
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.
+		EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id string, _ ...database.GetOptions) (*database.Object, error) {
+			return &database.Object{
+				Metadata: database.Metadata{ID: id},
+				Data:     osDataModel,
+			}, nil
+		})
+
+	w := httptest.NewRecorder()
+	req, err := rpctest.NewHTTPRequestFromJSON(t.Context(), http.MethodGet, operationStatusTestHeaderFile, nil)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+
+	ctl, err := NewGetOperationResult(ctrl.Options{
+		DatabaseClient: databaseClient,
+	})
+	require.NoError(t, err)
+
+	resp, err := ctl.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	body := map[string]any{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	require.Equal(t, map[string]any{"snapshotId": "snap-1"}, body)
+}
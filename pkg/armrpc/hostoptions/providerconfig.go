@@ -46,6 +46,9 @@ type ProviderConfig struct {
 	Logging          ucplog.LoggingOptions                `yaml:"logging"`
 	Bicep            BicepOptions                         `yaml:"bicep,omitempty"`
 	Terraform        TerraformOptions                     `yaml:"terraform,omitempty"`
+	RecipeDrift      RecipeDriftOptions                   `yaml:"recipeDrift,omitempty"`
+	RecipeCache      RecipeCacheOptions                   `yaml:"recipeCache,omitempty"`
+	Controller       ControllerOptions                    `yaml:"controller,omitempty"`
 
 	// FeatureFlags includes the list of feature flags.
 	FeatureFlags []string `yaml:"featureFlags"`
@@ -102,3 +105,55 @@ type TerraformOptions struct {
 	// LogLevel is the log level for Terraform execution (ERROR, DEBUG, etc.).
 	LogLevel string `yaml:"logLevel,omitempty"`
 }
+
+// RecipeDriftOptions includes options for periodic drift detection of recipe-deployed resources.
+type RecipeDriftOptions struct {
+	// Enabled turns on the drift detector.
+	Enabled bool `yaml:"enabled,omitempty"`
+
+	// Interval is the interval between drift detection passes, for example "30m".
+	Interval string `yaml:"interval,omitempty"`
+}
+
+// StorageMigrationOptions includes options for the periodic migration of stored resources to the storage version
+// of their resource type.
+type StorageMigrationOptions struct {
+	// Enabled turns on the migrator.
+	Enabled bool `yaml:"enabled,omitempty"`
+
+	// Interval is the interval between migration passes, for example "24h".
+	Interval string `yaml:"interval,omitempty"`
+}
+
+// RecipeCacheOptions includes options for the cache of recipe templates, modules and providers.
+type RecipeCacheOptions struct {
+	// Path is the path to the directory where the cache is stored, typically a mounted persistent volume.
+	// The cache is disabled when it is empty.
+	Path string `yaml:"path,omitempty"`
+
+	// MaxSizeMB is the maximum size of the cache in megabytes. Zero means no limit.
+	MaxSizeMB int64 `yaml:"maxSizeMB,omitempty"`
+}
+
+// ControllerOptions includes options for the Kubernetes controller when it deploys to one or more remote UCP endpoints.
+type ControllerOptions struct {
+	// ClusterName identifies the cluster that the controller runs in. When set, it is added as a tag to the Radius
+	// resources deployed by the controller.
+	ClusterName string `yaml:"clusterName,omitempty"`
+
+	// Routes is the list of per-namespace routes to UCP endpoints. Namespaces that match no route use the
+	// connection configured by the ucp property.
+	Routes []ControllerRouteOptions `yaml:"routes,omitempty"`
+}
+
+// ControllerRouteOptions describes the UCP endpoint that the Radius resources of a set of namespaces are deployed to.
+type ControllerRouteOptions struct {
+	// Namespaces is the list of namespace patterns that the route applies to, for example "team-*".
+	Namespaces []string `yaml:"namespaces"`
+
+	// UCP is the connection to the UCP endpoint of the route.
+	UCP config.UCPOptions `yaml:"ucp"`
+
+	// ResourceGroup is the name of the Radius resource group that the resources of the namespaces are deployed to.
+	ResourceGroup string `yaml:"resourceGroup,omitempty"`
+}
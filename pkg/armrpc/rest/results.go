@@ -652,6 +652,38 @@ func (r *InternalServerErrorResponse) Apply(ctx context.Context, w http.Response
 	return nil
 }
 
+// ServiceUnavailableResponse represents an HTTP 503 with an ARM error payload.
+type ServiceUnavailableResponse struct {
+	Body v1.ErrorResponse
+}
+
+// NewServiceUnavailableARMResponse creates a new ServiceUnavailableResponse with the given error payload.
+func NewServiceUnavailableARMResponse(body v1.ErrorResponse) Response {
+	return &ServiceUnavailableResponse{
+		Body: body,
+	}
+}
+
+// Apply renders 503 ServiceUnavailable HTTP response into http.ResponseWriter by setting Content-Type and serializing response.
+func (r *ServiceUnavailableResponse) Apply(ctx context.Context, w http.ResponseWriter, req *http.Request) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info(fmt.Sprintf("responding with status code: %d", http.StatusServiceUnavailable), logging.LogHTTPStatusCode, http.StatusServiceUnavailable)
+
+	bytes, err := json.MarshalIndent(r.Body, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling %T: %w", r.Body, err)
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusServiceUnavailable)
+	_, err = w.Write(bytes)
+	if err != nil {
+		return fmt.Errorf("error writing marshaled %T bytes to output: %s", r.Body, err)
+	}
+
+	return nil
+}
+
 // PreconditionFailedResponse represents an HTTP 412 with an ARM error payload.
 type PreconditionFailedResponse struct {
 	Body v1.ErrorResponse
@@ -22,6 +22,7 @@ import (
 	"maps"
 	"os"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	radiuscore "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
@@ -85,6 +86,9 @@ const (
 type ResourceProgress struct {
 	Resource ucpresources.ID
 	Status   ResourceStatus
+	// Log is a line of the recipe execution log of the resource. Updates with a log line do not change the status of
+	// the resource.
+	Log string
 }
 
 type DeploymentOutput struct {
@@ -108,22 +112,64 @@ type DeploymentClient interface {
 type DiagnosticsClient interface {
 	Expose(ctx context.Context, options ExposeOptions) (failed chan error, stop chan struct{}, signals chan os.Signal, err error)
 	Logs(ctx context.Context, options LogsOptions) ([]LogStream, error)
-	GetPublicEndpoint(ctx context.Context, options EndpointOptions) (*string, error)
+	GetEndpoints(ctx context.Context, options EndpointOptions) ([]Endpoint, error)
 }
 
 type ApplicationStatus struct {
-	Name          string
-	ResourceCount int
-	Gateways      []GatewayStatus
+	Name             string
+	ResourceCount    int
+	Endpoints        []EndpointStatus
+	DriftedResources []DriftedResourceStatus
 }
 
-type GatewayStatus struct {
-	Name     string
+// EndpointStatus describes an endpoint of a resource in an application.
+type EndpointStatus struct {
+	// Name is the name of the resource.
+	Name string
+
+	// Type is the resource type.
+	Type string
+
+	// Property identifies the endpoint within the resource, e.g. "url" or "urls[0]".
+	Property string
+
+	// Visibility is either "public" or "internal".
+	Visibility string
+
+	// Endpoint is the URL of the endpoint.
 	Endpoint string
+
+	// Reachable is the result of checking whether the endpoint can be reached, e.g. "Yes" or "No (HTTP 503)".
+	// It is empty when the endpoint was not checked.
+	Reachable string
+}
+
+// DriftedResourceStatus describes a resource whose recipe-deployed infrastructure has drifted.
+type DriftedResourceStatus struct {
+	Name    string
+	Type    string
+	State   string
+	Message string
 }
 
 type EndpointOptions struct {
 	ResourceID ucpresources.ID
+
+	// Properties are the properties of the resource, if they are already known. When not set, the resource is
+	// fetched if its resource type has endpoint fields.
+	Properties map[string]any
+}
+
+// Endpoint is a URL where a resource can be reached.
+type Endpoint struct {
+	// Name identifies the endpoint within the resource, e.g. "url" or "urls[0]".
+	Name string
+
+	// Visibility is either "public" for endpoints that can be reached from outside the environment, or "internal".
+	Visibility string
+
+	// URL is the URL of the endpoint.
+	URL string
 }
 
 type ExposeOptions struct {
@@ -178,6 +224,13 @@ type ApplicationsManagementClient interface {
 	// CreateOrUpdateResource creates or updates a resource using its type name (or id).
 	CreateOrUpdateResource(ctx context.Context, resourceType string, resourceNameOrID string, resource *generated.GenericResource) (generated.GenericResource, error)
 
+	// UpdateResource applies a JSON merge patch to a resource using its type name (or id) and returns the updated resource.
+	UpdateResource(ctx context.Context, resourceType string, resourceNameOrID string, patch map[string]any) (generated.GenericResource, error)
+
+	// InvokeResourceAction invokes a custom action of a resource using its type name (or id), waits for the action to
+	// complete and returns its output.
+	InvokeResourceAction(ctx context.Context, resourceType string, resourceNameOrID string, action string, input map[string]any) (map[string]any, error)
+
 	// DeleteResource deletes a resource by its type and name (or id).
 	// When force is true, the delete will proceed even if the resource is in a non-terminal provisioning state.
 	DeleteResource(ctx context.Context, resourceType string, resourceNameOrID string, force bool) (bool, error)
@@ -185,6 +238,9 @@ type ApplicationsManagementClient interface {
 	// ListApplications lists all applications in the configured scope.
 	ListApplications(ctx context.Context) ([]corerp.ApplicationResource, error)
 
+	// ListApplicationsInEnvironment lists the applications that are part of the specified environment and in the configured scope.
+	ListApplicationsInEnvironment(ctx context.Context, environmentNameOrID string) ([]corerp.ApplicationResource, error)
+
 	// GetApplication retrieves an application by its name (or id).
 	GetApplication(ctx context.Context, applicationNameOrID string) (corerp.ApplicationResource, error)
 
@@ -197,7 +253,11 @@ type ApplicationsManagementClient interface {
 	// CreateApplicationIfNotFound creates an application if it does not exist.
 	CreateApplicationIfNotFound(ctx context.Context, applicationNameOrID string, resource *corerp.ApplicationResource) error
 
-	// DeleteApplication deletes an application and all of its resources by its name (or id).
+	// PlanApplicationDelete computes the order in which DeleteApplication deletes the resources of an application.
+	PlanApplicationDelete(ctx context.Context, applicationNameOrID string) (DeletePlan, error)
+
+	// DeleteApplication deletes an application and all of its resources by its name (or id). Resources are deleted
+	// before the resources they connect to, and the delete stops at the first resource that can't be deleted.
 	// When force is true, resources in non-terminal provisioning states will be force-deleted.
 	DeleteApplication(ctx context.Context, applicationNameOrID string, force bool) (bool, error)
 
@@ -232,6 +292,8 @@ type ApplicationsManagementClient interface {
 	CreateOrUpdateEnvironment(ctx context.Context, environmentNameOrID string, resource *corerp.EnvironmentResource) error
 
 	// DeleteEnvironment deletes an environment and all of its resources by its name (in the configured scope) or resource ID.
+	// The applications of the environment are deleted one at a time with DeleteApplication, which orders their resources.
+	// Resources of the environment that don't belong to an application are not ordered.
 	DeleteEnvironment(ctx context.Context, environmentNameOrID string) (bool, error)
 
 	// ListResourceGroups lists all resource groups in the configured scope.
@@ -279,8 +341,12 @@ type ApplicationsManagementClient interface {
 	// CreateOrUpdateResourceType creates or updates a resource type in the configured plane.
 	CreateOrUpdateResourceType(ctx context.Context, planeName string, providerNamespace string, resourceTypeName string, resource *ucp_v20231001preview.ResourceTypeResource) (ucp_v20231001preview.ResourceTypeResource, error)
 
-	// DeleteResourceType deletes a resource type in the configured plane.
-	DeleteResourceType(ctx context.Context, planeName string, providerNamespace string, resourceTypeName string) (bool, error)
+	// DeleteResourceType deletes a resource type in the configured plane. When force is true, the resource type
+	// is deleted even if resources of that type exist.
+	DeleteResourceType(ctx context.Context, planeName string, providerNamespace string, resourceTypeName string, force bool) (bool, error)
+
+	// GetResourceTypeUsage gets the number of stored resources of a resource type in the configured plane, by API version.
+	GetResourceTypeUsage(ctx context.Context, planeName string, providerNamespace string, resourceTypeName string) (ucp_v20231001preview.ResourceTypeUsage, error)
 
 	// ListAllResourceTypesNames lists the names of all resource types in the configured plane.
 	ListAllResourceTypesNames(ctx context.Context, planeName string) ([]string, error)
@@ -290,6 +356,10 @@ type ApplicationsManagementClient interface {
 
 	// CreateOrUpdateLocation creates or updates a resource provider location in the configured scope.
 	CreateOrUpdateLocation(ctx context.Context, planeName string, providerNamespace string, locationName string, resource *ucp_v20231001preview.LocationResource) (ucp_v20231001preview.LocationResource, error)
+
+	// GetLatestOperationLogs retrieves the recipe execution logs of the latest operation on a resource, starting after
+	// the log entry with the given sequence number.
+	GetLatestOperationLogs(ctx context.Context, resourceID string, since int64) (v1.OperationLogs, error)
 }
 
 // ShallowCopy creates a shallow copy of the DeploymentParameters object by iterating through the original object and
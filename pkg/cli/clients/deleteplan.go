@@ -0,0 +1,293 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	corerpv20231001 "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
+)
+
+// maxConcurrentDeletes is the maximum number of resources deleted in parallel within a wave of a cascade delete.
+const maxConcurrentDeletes = 5
+
+// DeletePlan is the order in which the resources of an application are deleted by a cascade delete. Resources are
+// deleted in waves: a resource is deleted in an earlier wave than the resources it connects to, so that for example a
+// container is deleted before the database it uses. The resources within a wave are deleted in parallel.
+type DeletePlan struct {
+	// Waves are the resources to delete, in order.
+	Waves [][]DeletePlanResource
+
+	// OrderError is the error that prevented the resources from being ordered, eg: the application graph could not be
+	// retrieved. The resources of an unordered plan are deleted together in a single wave.
+	OrderError error
+}
+
+// DeletePlanResource is a resource deleted as part of a DeletePlan.
+type DeletePlanResource struct {
+	// ID is the resource ID.
+	ID string
+
+	// Type is the resource type.
+	Type string
+
+	// Name is the resource name.
+	Name string
+
+	// OutputResources are the IDs of the output resources, such as Kubernetes objects or cloud resources, that are
+	// destroyed when the resource is deleted.
+	OutputResources []string
+}
+
+// Resources returns the resources of the plan in the order they are deleted.
+func (p DeletePlan) Resources() []DeletePlanResource {
+	result := []DeletePlanResource{}
+	for _, wave := range p.Waves {
+		result = append(result, wave...)
+	}
+
+	return result
+}
+
+// NewDeletePlan orders resources for deletion. dependencies maps the ID of a resource to the IDs of the resources it
+// connects to, which are deleted after it. IDs are compared case-insensitively, and dependencies on resources that are
+// not being deleted are ignored. Resources that form a cycle can't be ordered, so they are deleted together in the last
+// wave.
+func NewDeletePlan(resourceList []DeletePlanResource, dependencies map[string][]string) DeletePlan {
+	byID := map[string]DeletePlanResource{}
+	for _, resource := range resourceList {
+		byID[strings.ToLower(resource.ID)] = resource
+	}
+
+	// dependents counts the resources that must be deleted before a resource, and dependsOn is the set of resources
+	// that can be deleted once a resource is gone.
+	dependents := map[string]int{}
+	dependsOn := map[string]map[string]bool{}
+	for source, targets := range dependencies {
+		source = strings.ToLower(source)
+		if _, ok := byID[source]; !ok {
+			continue
+		}
+
+		for _, target := range targets {
+			target = strings.ToLower(target)
+			if _, ok := byID[target]; !ok || target == source || dependsOn[source][target] {
+				continue
+			}
+
+			if dependsOn[source] == nil {
+				dependsOn[source] = map[string]bool{}
+			}
+			dependsOn[source][target] = true
+			dependents[target]++
+		}
+	}
+
+	plan := DeletePlan{}
+	remaining := byID
+	for len(remaining) > 0 {
+		wave := []DeletePlanResource{}
+		for id, resource := range remaining {
+			if dependents[id] == 0 {
+				wave = append(wave, resource)
+			}
+		}
+
+		if len(wave) == 0 {
+			// The remaining resources form one or more cycles.
+			for _, resource := range remaining {
+				wave = append(wave, resource)
+			}
+		}
+
+		for _, resource := range wave {
+			id := strings.ToLower(resource.ID)
+			delete(remaining, id)
+			for target := range dependsOn[id] {
+				dependents[target]--
+			}
+		}
+
+		sortDeletePlanResources(wave)
+		plan.Waves = append(plan.Waves, wave)
+	}
+
+	return plan
+}
+
+// NewApplicationDeletePlan orders the resources of an application for deletion using the connections and output
+// resources of the application graph. The graph of every API version has the same shape as the
+// Applications.Core/applications graph. When graph is nil the resources are deleted together.
+func NewApplicationDeletePlan(resourceList []generated.GenericResource, graph []*corerpv20231001.ApplicationGraphResource) DeletePlan {
+	planResources, dependencies := deletePlanInputFromGraph(resourceList, graph)
+	return NewDeletePlan(planResources, dependencies)
+}
+
+// deletePlanInputFromGraph returns the resources to delete and the resources they connect to, using the connections
+// and output resources of the application graph.
+func deletePlanInputFromGraph(resourceList []generated.GenericResource, graph []*corerpv20231001.ApplicationGraphResource) ([]DeletePlanResource, map[string][]string) {
+	graphResources := map[string]*corerpv20231001.ApplicationGraphResource{}
+	dependencies := map[string][]string{}
+	for _, resource := range graph {
+		if resource == nil || resource.ID == nil {
+			continue
+		}
+
+		id := strings.ToLower(*resource.ID)
+		graphResources[id] = resource
+
+		for _, connection := range resource.Connections {
+			if connection == nil || connection.ID == nil || connection.Direction == nil {
+				continue
+			}
+
+			// Both ends of a connection list it, as outbound from the resource that defines it and as inbound to the
+			// resource it connects to.
+			if *connection.Direction == corerpv20231001.DirectionOutbound {
+				dependencies[id] = append(dependencies[id], *connection.ID)
+			} else {
+				source := strings.ToLower(*connection.ID)
+				dependencies[source] = append(dependencies[source], *resource.ID)
+			}
+		}
+	}
+
+	planResources := []DeletePlanResource{}
+	for _, resource := range resourceList {
+		if resource.ID == nil || resource.Type == nil {
+			continue
+		}
+
+		planResource := DeletePlanResource{ID: *resource.ID, Type: *resource.Type, Name: to.String(resource.Name)}
+		if graphResource, ok := graphResources[strings.ToLower(*resource.ID)]; ok {
+			for _, outputResource := range graphResource.OutputResources {
+				if outputResource != nil && outputResource.ID != nil {
+					planResource.OutputResources = append(planResource.OutputResources, *outputResource.ID)
+				}
+			}
+		}
+
+		planResources = append(planResources, planResource)
+	}
+
+	return planResources, dependencies
+}
+
+func sortDeletePlanResources(resourceList []DeletePlanResource) {
+	sort.Slice(resourceList, func(i, j int) bool {
+		if resourceList[i].Type != resourceList[j].Type {
+			return resourceList[i].Type < resourceList[j].Type
+		}
+		if resourceList[i].Name != resourceList[j].Name {
+			return resourceList[i].Name < resourceList[j].Name
+		}
+		return resourceList[i].ID < resourceList[j].ID
+	})
+}
+
+// CascadeDeleteError is returned when a cascade delete stops because a resource could not be deleted. The application
+// is not deleted, so running the delete again resumes with the remaining resources.
+type CascadeDeleteError struct {
+	// Resource is the ID of the resource that could not be deleted.
+	Resource string
+
+	// Err is the error returned when deleting the resource.
+	Err error
+
+	// Deleted are the IDs of the resources that were deleted before the delete stopped.
+	Deleted []string
+
+	// Remaining are the IDs of the resources that were not deleted, including the one that failed.
+	Remaining []string
+}
+
+// Error returns the error message, including a summary of the resources that were and weren't deleted.
+func (e *CascadeDeleteError) Error() string {
+	return fmt.Sprintf("failed to delete resource %q: %v. %d resource(s) were deleted and %d remain; run the delete again to resume",
+		e.Resource, e.Err, len(e.Deleted), len(e.Remaining))
+}
+
+// Unwrap returns the error returned when deleting the resource.
+func (e *CascadeDeleteError) Unwrap() error {
+	return e.Err
+}
+
+// ExecuteDeletePlan deletes the resources of a plan wave by wave using deleteResource, which should treat resources
+// that are already gone as deleted. At most maxConcurrentDeletes resources are deleted in parallel. When a resource
+// can't be deleted the resources of the current wave that already started are allowed to finish, no further deletes
+// are started, and a *CascadeDeleteError is returned.
+func ExecuteDeletePlan(ctx context.Context, plan DeletePlan, deleteResource func(ctx context.Context, resource DeletePlanResource) error) error {
+	deleted := []string{}
+	for _, wave := range plan.Waves {
+		mutex := sync.Mutex{}
+		var failed *CascadeDeleteError
+
+		g := errgroup.Group{}
+		g.SetLimit(maxConcurrentDeletes)
+		for _, resource := range wave {
+			g.Go(func() error {
+				mutex.Lock()
+				stopped := failed != nil
+				mutex.Unlock()
+				if stopped {
+					return nil
+				}
+
+				err := deleteResource(ctx, resource)
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				if err != nil {
+					if failed == nil {
+						failed = &CascadeDeleteError{Resource: resource.ID, Err: err}
+					}
+					return nil
+				}
+
+				deleted = append(deleted, resource.ID)
+				return nil
+			})
+		}
+		_ = g.Wait()
+
+		if failed != nil {
+			isDeleted := map[string]bool{}
+			for _, id := range deleted {
+				isDeleted[id] = true
+			}
+
+			failed.Deleted = deleted
+			for _, resource := range plan.Resources() {
+				if !isDeleted[resource.ID] {
+					failed.Remaining = append(failed.Remaining, resource.ID)
+				}
+			}
+
+			return failed
+		}
+	}
+
+	return nil
+}
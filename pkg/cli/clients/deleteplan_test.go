@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewDeletePlan(t *testing.T) {
+	frontend := DeletePlanResource{ID: "/planes/radius/local/resourceGroups/test/providers/Applications.Core/containers/frontend", Type: "Applications.Core/containers", Name: "frontend"}
+	backend := DeletePlanResource{ID: "/planes/radius/local/resourceGroups/test/providers/Applications.Core/containers/backend", Type: "Applications.Core/containers", Name: "backend"}
+	database := DeletePlanResource{ID: "/planes/radius/local/resourceGroups/test/providers/Applications.Datastores/redisCaches/db", Type: "Applications.Datastores/redisCaches", Name: "db"}
+	queue := DeletePlanResource{ID: "/planes/radius/local/resourceGroups/test/providers/Applications.Messaging/rabbitMQQueues/queue", Type: "Applications.Messaging/rabbitMQQueues", Name: "queue"}
+
+	tests := []struct {
+		name         string
+		resources    []DeletePlanResource
+		dependencies map[string][]string
+		expected     DeletePlan
+	}{
+		{
+			name:      "no resources",
+			resources: []DeletePlanResource{},
+			expected:  DeletePlan{},
+		},
+		{
+			name:      "no connections",
+			resources: []DeletePlanResource{database, frontend, backend},
+			expected:  DeletePlan{Waves: [][]DeletePlanResource{{backend, frontend, database}}},
+		},
+		{
+			name:      "resources are deleted before the resources they connect to",
+			resources: []DeletePlanResource{database, queue, backend, frontend},
+			dependencies: map[string][]string{
+				frontend.ID: {backend.ID},
+				backend.ID:  {database.ID, queue.ID},
+			},
+			expected: DeletePlan{Waves: [][]DeletePlanResource{{frontend}, {backend}, {database, queue}}},
+		},
+		{
+			name:      "IDs are case-insensitive and duplicate connections are ignored",
+			resources: []DeletePlanResource{database, backend},
+			dependencies: map[string][]string{
+				strings.ToUpper(backend.ID): {database.ID, strings.ToLower(database.ID)},
+			},
+			expected: DeletePlan{Waves: [][]DeletePlanResource{{backend}, {database}}},
+		},
+		{
+			name:      "connections to resources that are not deleted are ignored",
+			resources: []DeletePlanResource{backend},
+			dependencies: map[string][]string{
+				backend.ID:  {database.ID},
+				frontend.ID: {backend.ID},
+			},
+			expected: DeletePlan{Waves: [][]DeletePlanResource{{backend}}},
+		},
+		{
+			name:      "cycles are deleted together",
+			resources: []DeletePlanResource{frontend, backend, database},
+			dependencies: map[string][]string{
+				frontend.ID: {backend.ID},
+				backend.ID:  {database.ID},
+				database.ID: {backend.ID},
+			},
+			expected: DeletePlan{Waves: [][]DeletePlanResource{{frontend}, {backend, database}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := NewDeletePlan(tt.resources, tt.dependencies)
+			require.Equal(t, tt.expected, plan)
+		})
+	}
+}
+
+func Test_ExecuteDeletePlan(t *testing.T) {
+	plan := DeletePlan{
+		Waves: [][]DeletePlanResource{
+			{{ID: "frontend"}, {ID: "worker"}},
+			{{ID: "backend"}},
+			{{ID: "database"}},
+		},
+	}
+
+	t.Run("deletes waves in order", func(t *testing.T) {
+		mutex := sync.Mutex{}
+		deleted := []string{}
+		err := ExecuteDeletePlan(t.Context(), plan, func(ctx context.Context, resource DeletePlanResource) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			deleted = append(deleted, resource.ID)
+			return nil
+		})
+		require.NoError(t, err)
+
+		require.ElementsMatch(t, []string{"frontend", "worker"}, deleted[:2])
+		require.Equal(t, []string{"backend", "database"}, deleted[2:])
+	})
+
+	t.Run("stops on the first failure", func(t *testing.T) {
+		deleteErr := errors.New("backend is still draining")
+		mutex := sync.Mutex{}
+		attempted := []string{}
+		err := ExecuteDeletePlan(t.Context(), plan, func(ctx context.Context, resource DeletePlanResource) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			attempted = append(attempted, resource.ID)
+			if resource.ID == "backend" {
+				return deleteErr
+			}
+			return nil
+		})
+		require.NotContains(t, attempted, "database", "resources after the failed wave must not be deleted")
+
+		var cascadeErr *CascadeDeleteError
+		require.ErrorAs(t, err, &cascadeErr)
+		require.ErrorIs(t, err, deleteErr)
+		require.Equal(t, "backend", cascadeErr.Resource)
+		require.ElementsMatch(t, []string{"frontend", "worker"}, cascadeErr.Deleted)
+		require.Equal(t, []string{"backend", "database"}, cascadeErr.Remaining)
+		require.Equal(t, `failed to delete resource "backend": backend is still draining. 2 resource(s) were deleted and 2 remain; run the delete again to resume`, err.Error())
+	})
+
+	t.Run("limits parallelism", func(t *testing.T) {
+		wave := []DeletePlanResource{}
+		for range maxConcurrentDeletes * 3 {
+			wave = append(wave, DeletePlanResource{ID: "resource"})
+		}
+
+		mutex := sync.Mutex{}
+		running, maxRunning := 0, 0
+		err := ExecuteDeletePlan(t.Context(), DeletePlan{Waves: [][]DeletePlanResource{wave}}, func(ctx context.Context, resource DeletePlanResource) error {
+			mutex.Lock()
+			running++
+			maxRunning = max(maxRunning, running)
+			mutex.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			mutex.Lock()
+			running--
+			mutex.Unlock()
+			return nil
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, maxRunning, maxConcurrentDeletes)
+	})
+}
@@ -20,14 +20,18 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	"golang.org/x/exp/maps"
 	"golang.org/x/sync/errgroup"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/azure/clientv2"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
@@ -56,6 +60,10 @@ type UCPApplicationsManagementClient struct {
 
 var _ ApplicationsManagementClient = (*UCPApplicationsManagementClient)(nil)
 
+// defaultOperationLogsAPIVersion is the API version used to retrieve operation logs when the resource type does not
+// declare its API versions.
+const defaultOperationLogsAPIVersion = "2023-10-01-preview"
+
 // ListResourcesOfType lists all resources of a given type in the configured scope.
 func (amc *UCPApplicationsManagementClient) ListResourcesOfType(ctx context.Context, resourceType string) ([]generated.GenericResource, error) {
 	apiVersions, err := amc.getApiVersionsForResourceType(ctx, resourceType)
@@ -178,6 +186,119 @@ func (amc *UCPApplicationsManagementClient) CreateOrUpdateResource(ctx context.C
 	return response.GenericResource, nil
 }
 
+// UpdateResource applies a JSON merge patch to a resource using its type name (or id) and returns the updated resource.
+// Properties that are not in the patch keep their current values, and properties set to nil are removed.
+func (amc *UCPApplicationsManagementClient) UpdateResource(ctx context.Context, resourceType string, resourceNameOrID string, patch map[string]any) (generated.GenericResource, error) {
+	apiVersions, err := amc.getApiVersionsForResourceType(ctx, resourceType)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	// Radius.Core resources require a specific API version. See getGenericClient.
+	if strings.HasPrefix(resourceType, "Radius.Core") {
+		apiVersions = []string{"2025-08-01-preview"}
+	}
+	if len(apiVersions) == 0 {
+		return generated.GenericResource{}, fmt.Errorf("resource type %q has no API versions", resourceType)
+	}
+
+	scope, name, err := amc.extractScopeAndName(resourceNameOrID)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	// The generated generic resource client does not support PATCH, so the request is sent through the ARM pipeline.
+	client, err := arm.NewClient("github.com/radius-project/radius/pkg/cli/clients", "v0.0.1", &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	urlPath := fmt.Sprintf("%s/providers/%s/%s", scope, resourceType, url.PathEscape(name))
+	req, err := runtime.NewRequest(ctx, http.MethodPatch, runtime.JoinPaths(client.Endpoint(), urlPath))
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	query := req.Raw().URL.Query()
+	query.Set("api-version", apiVersions[0])
+	req.Raw().URL.RawQuery = query.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if err := runtime.MarshalAsJSON(req, patch); err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	resp, err := client.Pipeline().Do(req)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusCreated, http.StatusAccepted) {
+		return generated.GenericResource{}, runtime.NewResponseError(resp)
+	}
+
+	poller, err := runtime.NewPoller[generated.GenericResource](resp, client.Pipeline(), nil)
+	if err != nil {
+		return generated.GenericResource{}, err
+	}
+
+	return poller.PollUntilDone(ctx, nil)
+}
+
+// InvokeResourceAction invokes a custom action of a resource using its type name (or id), waits for the action to
+// complete and returns its output. The output is nil if the action has none.
+func (amc *UCPApplicationsManagementClient) InvokeResourceAction(ctx context.Context, resourceType string, resourceNameOrID string, action string, input map[string]any) (map[string]any, error) {
+	apiVersions, err := amc.getApiVersionsForResourceType(ctx, resourceType)
+	if err != nil {
+		return nil, err
+	}
+	if len(apiVersions) == 0 {
+		return nil, fmt.Errorf("resource type %q has no API versions", resourceType)
+	}
+
+	scope, name, err := amc.extractScopeAndName(resourceNameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The generated generic resource client only supports the listSecrets action, so the request is sent through
+	// the ARM pipeline.
+	client, err := arm.NewClient("github.com/radius-project/radius/pkg/cli/clients", "v0.0.1", &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	urlPath := fmt.Sprintf("%s/providers/%s/%s/%s", scope, resourceType, url.PathEscape(name), url.PathEscape(action))
+	req, err := runtime.NewRequest(ctx, http.MethodPost, runtime.JoinPaths(client.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+
+	query := req.Raw().URL.Query()
+	query.Set("api-version", apiVersions[0])
+	req.Raw().URL.RawQuery = query.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	if input == nil {
+		input = map[string]any{}
+	}
+	if err := runtime.MarshalAsJSON(req, input); err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK, http.StatusAccepted) {
+		return nil, runtime.NewResponseError(resp)
+	}
+
+	poller, err := runtime.NewPoller[map[string]any](resp, client.Pipeline(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return poller.PollUntilDone(ctx, nil)
+}
+
 // DeleteResource deletes a resource by its type and name (or id).
 func (amc *UCPApplicationsManagementClient) DeleteResource(ctx context.Context, resourceType string, resourceNameOrID string, force bool) (bool, error) {
 	apiVersions, err := amc.getApiVersionsForResourceType(ctx, resourceType)
@@ -358,33 +479,55 @@ func (amc *UCPApplicationsManagementClient) CreateApplicationIfNotFound(ctx cont
 	return nil
 }
 
-// DeleteApplication deletes an application and all of its resources by its name (or id).
+// PlanApplicationDelete computes the order in which the resources of an application are deleted by DeleteApplication.
+// The order is computed from the connections of the application graph. If the graph can't be retrieved, the resources
+// can't be ordered: they are deleted together in a single wave, and the error is returned in the OrderError of the plan.
+func (amc *UCPApplicationsManagementClient) PlanApplicationDelete(ctx context.Context, applicationNameOrID string) (DeletePlan, error) {
+	// This *also* handles the case where the resource group doesn't exist.
+	resourceList, err := amc.ListResourcesInApplication(ctx, applicationNameOrID)
+	if err != nil && !clientv2.Is404Error(err) {
+		return DeletePlan{}, err
+	}
+
+	if len(resourceList) == 0 {
+		return DeletePlan{}, nil
+	}
+
+	graph, err := amc.GetApplicationGraph(ctx, applicationNameOrID)
+	if err != nil {
+		plan := NewApplicationDeletePlan(resourceList, nil)
+		plan.OrderError = fmt.Errorf("failed to get the graph of application %q: %w", applicationNameOrID, err)
+		return plan, nil
+	}
+
+	return NewApplicationDeletePlan(resourceList, graph.Resources), nil
+}
+
+// DeleteApplication deletes an application and all of its resources by its name (or id). The resources are deleted
+// in the order computed by PlanApplicationDelete. If the resources can't be ordered, the OrderError of the plan is returned
+// and nothing is deleted, unless force is set, in which case the resources are deleted together. If a resource can't be
+// deleted, the delete stops with a *CascadeDeleteError and the application is kept, so that the delete can be resumed.
 func (amc *UCPApplicationsManagementClient) DeleteApplication(ctx context.Context, applicationNameOrID string, force bool) (bool, error) {
 	scope, name, err := amc.extractScopeAndName(applicationNameOrID)
 	if err != nil {
 		return false, err
 	}
 
-	// This *also* handles the case where the resource group doesn't exist.
-	resources, err := amc.ListResourcesInApplication(ctx, applicationNameOrID)
-	if err != nil && !clientv2.Is404Error(err) {
+	plan, err := amc.PlanApplicationDelete(ctx, applicationNameOrID)
+	if err != nil {
 		return false, err
+	} else if plan.OrderError != nil && !force {
+		return false, plan.OrderError
 	}
 
-	// Delete resources in parallel
-	g, groupCtx := errgroup.WithContext(ctx)
-	for _, resource := range resources {
-		g.Go(func() error {
-			_, err := amc.DeleteResource(groupCtx, *resource.Type, *resource.ID, force)
-			if err != nil && !clientv2.Is404Error(err) {
-				return err
-			}
-			return nil
-		})
-	}
-
-	// Wait for dependent resources to be deleted.
-	err = g.Wait()
+	// Delete the resources before the application, in dependency order.
+	err = ExecuteDeletePlan(ctx, plan, func(ctx context.Context, resource DeletePlanResource) error {
+		_, err := amc.DeleteResource(ctx, resource.Type, resource.ID, force)
+		if err != nil && !clientv2.Is404Error(err) {
+			return err
+		}
+		return nil
+	})
 	if err != nil {
 		return false, err
 	}
@@ -672,6 +815,9 @@ func (amc *UCPApplicationsManagementClient) CreateOrUpdateEnvironment(ctx contex
 }
 
 // DeleteEnvironment deletes an environment and all of its resources by its name (in the configured scope) or resource ID.
+// The applications of the environment are deleted one at a time with DeleteApplication, which deletes their resources in
+// dependency order. Resources of the environment that don't belong to an application are left to the delete of the
+// environment, so they are not ordered.
 func (amc *UCPApplicationsManagementClient) DeleteEnvironment(ctx context.Context, environmentNameOrID string) (bool, error) {
 	scope, name, err := amc.extractScopeAndName(environmentNameOrID)
 	if err != nil {
@@ -1179,8 +1325,8 @@ func (amc *UCPApplicationsManagementClient) CreateOrUpdateResourceType(ctx conte
 }
 
 // DeleteResourceType deletes a resource type in the configured plane.
-func (amc *UCPApplicationsManagementClient) DeleteResourceType(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string) (bool, error) {
-	client, err := amc.createResourceTypeClient()
+func (amc *UCPApplicationsManagementClient) DeleteResourceType(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, force bool) (bool, error) {
+	client, err := amc.createResourceTypeClient(force)
 	if err != nil {
 		return false, err
 	}
@@ -1201,6 +1347,21 @@ func (amc *UCPApplicationsManagementClient) DeleteResourceType(ctx context.Conte
 	return response.StatusCode != 204, nil
 }
 
+// GetResourceTypeUsage gets the number of stored resources of a resource type, by API version.
+func (amc *UCPApplicationsManagementClient) GetResourceTypeUsage(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string) (ucpv20231001.ResourceTypeUsage, error) {
+	client, err := amc.createResourceTypeClient()
+	if err != nil {
+		return ucpv20231001.ResourceTypeUsage{}, err
+	}
+
+	response, err := client.GetUsage(ctx, planeName, resourceProviderName, resourceTypeName, &ucpv20231001.ResourceTypesClientGetUsageOptions{})
+	if err != nil {
+		return ucpv20231001.ResourceTypeUsage{}, err
+	}
+
+	return response.ResourceTypeUsage, nil
+}
+
 // CreateOrUpdateAPIVersion creates or updates an API version in the configured scope.
 func (amc *UCPApplicationsManagementClient) CreateOrUpdateAPIVersion(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, apiVersionName string, resource *ucpv20231001.APIVersionResource) (ucpv20231001.APIVersionResource, error) {
 	client, err := amc.createAPIVersionClient()
@@ -1241,6 +1402,57 @@ func (amc *UCPApplicationsManagementClient) CreateOrUpdateLocation(ctx context.C
 	return response.LocationResource, nil
 }
 
+// GetLatestOperationLogs retrieves the recipe execution logs of the latest operation on a resource, starting after the
+// log entry with the given sequence number.
+func (amc *UCPApplicationsManagementClient) GetLatestOperationLogs(ctx context.Context, resourceID string, since int64) (v1.OperationLogs, error) {
+	id, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return v1.OperationLogs{}, err
+	}
+
+	// The operation logs are served by every API version of the resource provider. Built-in providers such as
+	// Applications.Core are not always registered in UCP, so the default version is used when the lookup fails.
+	apiVersion := defaultOperationLogsAPIVersion
+	if apiVersions, err := amc.getApiVersionsForResourceType(ctx, id.Type()); err == nil && len(apiVersions) > 0 {
+		slices.Sort(apiVersions)
+		apiVersion = apiVersions[len(apiVersions)-1]
+	}
+
+	client, err := arm.NewClient("github.com/radius-project/radius/pkg/cli/clients", "v0.0.1", &aztoken.AnonymousCredential{}, amc.ClientOptions)
+	if err != nil {
+		return v1.OperationLogs{}, err
+	}
+
+	namespace := strings.Split(id.Type(), resources.SegmentSeparator)[0]
+	urlPath := fmt.Sprintf("%s/providers/%s/locations/global/operationStatuses/latest/logs", id.PlaneScope(), namespace)
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.Endpoint(), urlPath))
+	if err != nil {
+		return v1.OperationLogs{}, err
+	}
+
+	query := req.Raw().URL.Query()
+	query.Set("api-version", apiVersion)
+	query.Set("resourceId", id.String())
+	query.Set("since", strconv.FormatInt(since, 10))
+	req.Raw().URL.RawQuery = query.Encode()
+	req.Raw().Header["Accept"] = []string{"application/json"}
+
+	resp, err := client.Pipeline().Do(req)
+	if err != nil {
+		return v1.OperationLogs{}, err
+	}
+	if !runtime.HasStatusCode(resp, http.StatusOK) {
+		return v1.OperationLogs{}, runtime.NewResponseError(resp)
+	}
+
+	logs := v1.OperationLogs{}
+	if err := runtime.UnmarshalAsJSON(resp, &logs); err != nil {
+		return v1.OperationLogs{}, err
+	}
+
+	return logs, nil
+}
+
 // createApplicationClient creates an application resource client for the specified scope.
 // When no applicationResourceClientFactory is configured and force is true, a per-call policy is added
 // that appends force=true to the request URL query string.
@@ -1333,12 +1545,18 @@ func (amc *UCPApplicationsManagementClient) createResourceProviderClient() (reso
 	return amc.resourceProviderClientFactory()
 }
 
-func (amc *UCPApplicationsManagementClient) createResourceTypeClient() (resourceTypeClient, error) {
-	if amc.resourceTypeClientFactory == nil {
-		return ucpv20231001.NewResourceTypesClient(&aztoken.AnonymousCredential{}, amc.ClientOptions)
+func (amc *UCPApplicationsManagementClient) createResourceTypeClient(force ...bool) (resourceTypeClient, error) {
+	if amc.resourceTypeClientFactory != nil {
+		return amc.resourceTypeClientFactory()
+	}
+
+	clientOptions := amc.ClientOptions
+	if len(force) > 0 && force[0] {
+		opts := withForceDeletePolicy(*amc.ClientOptions)
+		clientOptions = &opts
 	}
 
-	return amc.resourceTypeClientFactory()
+	return ucpv20231001.NewResourceTypesClient(&aztoken.AnonymousCredential{}, clientOptions)
 }
 
 func (amc *UCPApplicationsManagementClient) createAPIVersionClient() (apiVersionClient, error) {
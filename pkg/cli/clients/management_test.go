@@ -964,6 +964,64 @@ func Test_DeleteApplication_ForceQueryParameter(t *testing.T) {
 	}
 }
 
+func Test_GetLatestOperationLogs(t *testing.T) {
+	var capturedURL string
+	transport := &mockTransport{
+		do: func(req *http.Request) (*http.Response, error) {
+			capturedURL = req.URL.String()
+			header := http.Header{}
+			header.Set("Content-Type", "application/json")
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     header,
+				Body:       io.NopCloser(strings.NewReader(`{"name":"op1","status":"Updating","entries":[{"sequence":3,"message":"Applying..."}]}`)),
+				Request:    req,
+			}, nil
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	rpClient := NewMockresourceProviderClient(ctrl)
+	rpClient.EXPECT().
+		GetProviderSummary(gomock.Any(), "local", "Applications.Test", gomock.Any()).
+		Return(ucp.ResourceProvidersClientGetProviderSummaryResponse{
+			ResourceProviderSummary: ucp.ResourceProviderSummary{
+				Name: new("Applications.Test"),
+				ResourceTypes: map[string]*ucp.ResourceProviderSummaryResourceType{
+					"testResource": {
+						APIVersions: map[string]*ucp.ResourceTypeSummaryResultAPIVersion{
+							version: {},
+						},
+					},
+				},
+			},
+		}, nil)
+
+	client := &UCPApplicationsManagementClient{
+		RootScope: testScope,
+		ClientOptions: &arm.ClientOptions{
+			ClientOptions: policy.ClientOptions{
+				Transport: transport,
+			},
+		},
+		resourceProviderClientFactory: func() (resourceProviderClient, error) {
+			return rpClient, nil
+		},
+	}
+
+	resourceID := testScope + "/providers/Applications.Test/testResource/myresource"
+	logs, err := client.GetLatestOperationLogs(t.Context(), resourceID, 2)
+	require.NoError(t, err)
+	require.Equal(t, "op1", logs.Name)
+	require.Equal(t, v1.ProvisioningStateUpdating, logs.Status)
+	require.Equal(t, []v1.OperationLogEntry{{Sequence: 3, Message: "Applying..."}}, logs.Entries)
+
+	require.Contains(t, capturedURL, "/planes/radius/local/providers/Applications.Test/locations/global/operationStatuses/latest/logs?")
+	require.Contains(t, capturedURL, "api-version="+version)
+	require.Contains(t, capturedURL, "since=2")
+	require.Contains(t, capturedURL, "resourceId=%2Fplanes%2Fradius%2Flocal%2FresourceGroups%2Fmy-default-rg%2Fproviders%2FApplications.Test%2FtestResource%2Fmyresource")
+}
+
 type mockTransport struct {
 	do func(req *http.Request) (*http.Response, error)
 }
@@ -1215,6 +1273,10 @@ func Test_Application(t *testing.T) {
 			BeginDelete(gomock.Any(), "test1", gomock.Any()).
 			Return(poller(&generated.GenericResourcesClientDeleteResponse{}), nil)
 
+		mock.EXPECT().
+			GetGraph(gomock.Any(), testResourceName, gomock.Any(), gomock.Any()).
+			Return(corerp.ApplicationsClientGetGraphResponse{}, nil)
+
 		mock.EXPECT().
 			Delete(gomock.Any(), testResourceName, gomock.Any()).
 			DoAndReturn(func(ctx context.Context, s string, acdo *corerp.ApplicationsClientDeleteOptions) (corerp.ApplicationsClientDeleteResponse, error) {
@@ -1347,6 +1409,190 @@ func Test_Application(t *testing.T) {
 		// Verify the error is propagated correctly
 		require.Contains(t, err.Error(), "failed to list resource provider summaries")
 	})
+
+	// The container connects to the database, so it is deleted first.
+	containerID := testScope + "/providers/Applications.Test1/resourceType1/container"
+	databaseID := testScope + "/providers/Applications.Test1/resourceType1/database"
+	dependencyGraph := corerp.ApplicationsClientGetGraphResponse{
+		ApplicationGraphResponse: corerp.ApplicationGraphResponse{
+			Resources: []*corerp.ApplicationGraphResource{
+				{
+					ID: new(containerID),
+					Connections: []*corerp.ApplicationGraphConnection{
+						{ID: new(databaseID), Direction: to.Ptr(corerp.DirectionOutbound)},
+					},
+				},
+				{
+					ID: new(databaseID),
+					Connections: []*corerp.ApplicationGraphConnection{
+						{ID: new(containerID), Direction: to.Ptr(corerp.DirectionInbound)},
+					},
+					OutputResources: []*corerp.ApplicationGraphOutputResource{
+						{ID: new("/planes/kubernetes/local/namespaces/default/providers/apps/StatefulSet/database")},
+					},
+				},
+			},
+		},
+	}
+
+	createDependencyClient := func(t *testing.T, graphErr error) (*UCPApplicationsManagementClient, *MockapplicationResourceClient, *MockgenericResourceClient) {
+		ctrl := gomock.NewController(t)
+		mock := NewMockapplicationResourceClient(ctrl)
+		mockResourceProviderClient := NewMockresourceProviderClient(ctrl)
+		genericResourceMock := NewMockgenericResourceClient(ctrl)
+		client := createClient(mock)
+		client.genericResourceClientFactory = func(scope string, resourceType string) (genericResourceClient, error) {
+			return genericResourceMock, nil
+		}
+		client.resourceProviderClientFactory = func() (resourceProviderClient, error) {
+			return mockResourceProviderClient, nil
+		}
+
+		resourceListPages := []generated.GenericResourcesClientListByRootScopeResponse{
+			{
+				GenericResourcesList: generated.GenericResourcesList{
+					Value: []*generated.GenericResource{
+						{
+							ID:         new(databaseID),
+							Name:       new("database"),
+							Type:       new("Applications.Test1/resourceType1"),
+							Properties: map[string]any{"application": testScope + "/providers/Applications.Core/applications/test-application"},
+						},
+						{
+							ID:         new(containerID),
+							Name:       new("container"),
+							Type:       new("Applications.Test1/resourceType1"),
+							Properties: map[string]any{"application": testScope + "/providers/Applications.Core/applications/test-application"},
+						},
+					},
+					NextLink: new("0"),
+				},
+			},
+		}
+
+		mockResourceProviderClient.EXPECT().
+			GetProviderSummary(gomock.Any(), "local", gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, plane string, providerName string, opts *ucp.ResourceProvidersClientGetProviderSummaryOptions) (ucp.ResourceProvidersClientGetProviderSummaryResponse, error) {
+				return ucp.ResourceProvidersClientGetProviderSummaryResponse{ResourceProviderSummary: *findProviderSummary(providerName)}, nil
+			}).AnyTimes()
+		mockResourceProviderClient.EXPECT().NewListProviderSummariesPager("local", gomock.Any()).Return(pager(resourceProviderSummaryPages)).AnyTimes()
+		genericResourceMock.EXPECT().
+			NewListByRootScopePager(gomock.Any()).
+			Return(pager(resourceListPages)).AnyTimes()
+
+		mock.EXPECT().
+			GetGraph(gomock.Any(), testResourceName, gomock.Any(), gomock.Any()).
+			Return(dependencyGraph, graphErr)
+
+		return client, mock, genericResourceMock
+	}
+
+	t.Run("PlanApplicationDelete", func(t *testing.T) {
+		client, _, _ := createDependencyClient(t, nil)
+
+		plan, err := client.PlanApplicationDelete(t.Context(), testResourceID)
+		require.NoError(t, err)
+		require.Equal(t, DeletePlan{
+			Waves: [][]DeletePlanResource{
+				{{ID: containerID, Type: "Applications.Test1/resourceType1", Name: "container"}},
+				{{
+					ID:              databaseID,
+					Type:            "Applications.Test1/resourceType1",
+					Name:            "database",
+					OutputResources: []string{"/planes/kubernetes/local/namespaces/default/providers/apps/StatefulSet/database"},
+				}},
+			},
+		}, plan)
+	})
+
+	t.Run("PlanApplicationDelete_GraphError", func(t *testing.T) {
+		client, _, _ := createDependencyClient(t, &azcore.ResponseError{StatusCode: http.StatusInternalServerError, ErrorCode: "InternalServerError"})
+
+		// Without the graph the resources can't be ordered, so they are deleted together.
+		plan, err := client.PlanApplicationDelete(t.Context(), testResourceID)
+		require.NoError(t, err)
+		require.ErrorContains(t, plan.OrderError, "failed to get the graph of application")
+		require.Equal(t, [][]DeletePlanResource{
+			{
+				{ID: containerID, Type: "Applications.Test1/resourceType1", Name: "container"},
+				{ID: databaseID, Type: "Applications.Test1/resourceType1", Name: "database"},
+			},
+		}, plan.Waves)
+	})
+
+	t.Run("DeleteApplication_InDependencyOrder", func(t *testing.T) {
+		client, mock, genericResourceMock := createDependencyClient(t, nil)
+
+		gomock.InOrder(
+			genericResourceMock.EXPECT().
+				BeginDelete(gomock.Any(), "container", gomock.Any()).
+				Return(poller(&generated.GenericResourcesClientDeleteResponse{}), nil),
+			genericResourceMock.EXPECT().
+				BeginDelete(gomock.Any(), "database", gomock.Any()).
+				Return(poller(&generated.GenericResourcesClientDeleteResponse{}), nil),
+		)
+
+		mock.EXPECT().
+			Delete(gomock.Any(), testResourceName, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, s string, acdo *corerp.ApplicationsClientDeleteOptions) (corerp.ApplicationsClientDeleteResponse, error) {
+				setCapture(ctx, &http.Response{StatusCode: 200})
+				return corerp.ApplicationsClientDeleteResponse{}, nil
+			})
+
+		deleted, err := client.DeleteApplication(t.Context(), testResourceID, false)
+		require.NoError(t, err)
+		require.True(t, deleted)
+	})
+
+	t.Run("DeleteApplication_GraphError", func(t *testing.T) {
+		client, _, _ := createDependencyClient(t, &azcore.ResponseError{StatusCode: http.StatusInternalServerError, ErrorCode: "InternalServerError"})
+
+		// Nothing is deleted when the resources can't be ordered.
+		deleted, err := client.DeleteApplication(t.Context(), testResourceID, false)
+		require.False(t, deleted)
+		require.ErrorContains(t, err, "failed to get the graph of application")
+	})
+
+	t.Run("DeleteApplication_GraphError_Force", func(t *testing.T) {
+		client, mock, genericResourceMock := createDependencyClient(t, &azcore.ResponseError{StatusCode: http.StatusInternalServerError, ErrorCode: "InternalServerError"})
+
+		// With force the resources are deleted together.
+		genericResourceMock.EXPECT().
+			BeginDelete(gomock.Any(), "container", gomock.Any()).
+			Return(poller(&generated.GenericResourcesClientDeleteResponse{}), nil)
+		genericResourceMock.EXPECT().
+			BeginDelete(gomock.Any(), "database", gomock.Any()).
+			Return(poller(&generated.GenericResourcesClientDeleteResponse{}), nil)
+
+		mock.EXPECT().
+			Delete(gomock.Any(), testResourceName, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, s string, acdo *corerp.ApplicationsClientDeleteOptions) (corerp.ApplicationsClientDeleteResponse, error) {
+				setCapture(ctx, &http.Response{StatusCode: 200})
+				return corerp.ApplicationsClientDeleteResponse{}, nil
+			})
+
+		deleted, err := client.DeleteApplication(t.Context(), testResourceID, true)
+		require.NoError(t, err)
+		require.True(t, deleted)
+	})
+
+	t.Run("DeleteApplication_StopsOnFailure", func(t *testing.T) {
+		client, _, genericResourceMock := createDependencyClient(t, nil)
+
+		// Neither the database nor the application are deleted when the container can't be deleted.
+		genericResourceMock.EXPECT().
+			BeginDelete(gomock.Any(), "container", gomock.Any()).
+			Return(nil, &azcore.ResponseError{StatusCode: http.StatusInternalServerError, ErrorCode: "InternalServerError"})
+
+		deleted, err := client.DeleteApplication(t.Context(), testResourceID, false)
+		require.False(t, deleted)
+
+		var cascadeErr *CascadeDeleteError
+		require.ErrorAs(t, err, &cascadeErr)
+		require.Equal(t, containerID, cascadeErr.Resource)
+		require.Empty(t, cascadeErr.Deleted)
+		require.Equal(t, []string{containerID, databaseID}, cascadeErr.Remaining)
+	})
 }
 
 func Test_Environment(t *testing.T) {
@@ -1596,6 +1842,10 @@ func Test_Environment(t *testing.T) {
 			NewListByScopePager(gomock.Any()).
 			Return(pager(applicationListPages))
 
+		applicationResourceMock.EXPECT().
+			GetGraph(gomock.Any(), "test-application", gomock.Any(), gomock.Any()).
+			Return(corerp.ApplicationsClientGetGraphResponse{}, nil)
+
 		applicationResourceMock.EXPECT().
 			Delete(gomock.Any(), "test-application", gomock.Any()).
 			DoAndReturn(func(ctx context.Context, s string, acdo *corerp.ApplicationsClientDeleteOptions) (corerp.ApplicationsClientDeleteResponse, error) {
@@ -2453,10 +2703,29 @@ func Test_ResourceType(t *testing.T) {
 				return poller(&ucp.ResourceTypesClientDeleteResponse{}), nil
 			})
 
-		deleted, err := client.DeleteResourceType(t.Context(), "local", testResourceProviderName, testResourceTypeName)
+		deleted, err := client.DeleteResourceType(t.Context(), "local", testResourceProviderName, testResourceTypeName, false)
 		require.NoError(t, err)
 		require.True(t, deleted)
 	})
+
+	t.Run("GetResourceTypeUsage", func(t *testing.T) {
+		mock := NewMockresourceTypeClient(gomock.NewController(t))
+		client := createClient(mock)
+
+		expectedUsage := ucp.ResourceTypeUsage{
+			ResourceType: to.Ptr(testResourceProviderName + "/" + testResourceTypeName),
+			Count:        to.Ptr(int32(0)),
+			APIVersions:  map[string]*ucp.APIVersionUsage{},
+		}
+
+		mock.EXPECT().
+			GetUsage(gomock.Any(), "local", testResourceProviderName, testResourceTypeName, gomock.Any()).
+			Return(ucp.ResourceTypesClientGetUsageResponse{ResourceTypeUsage: expectedUsage}, nil)
+
+		usage, err := client.GetResourceTypeUsage(t.Context(), "local", testResourceProviderName, testResourceTypeName)
+		require.NoError(t, err)
+		require.Equal(t, expectedUsage, usage)
+	})
 }
 
 func Test_APIVersion(t *testing.T) {
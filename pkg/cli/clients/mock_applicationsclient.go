@@ -13,6 +13,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	generated "github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	v20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	v20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
@@ -626,18 +627,18 @@ func (c *MockApplicationsManagementClientDeleteResourceProviderCall) DoAndReturn
 }
 
 // DeleteResourceType mocks base method.
-func (m *MockApplicationsManagementClient) DeleteResourceType(ctx context.Context, planeName, providerNamespace, resourceTypeName string) (bool, error) {
+func (m *MockApplicationsManagementClient) DeleteResourceType(ctx context.Context, planeName, providerNamespace, resourceTypeName string, force bool) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteResourceType", ctx, planeName, providerNamespace, resourceTypeName)
+	ret := m.ctrl.Call(m, "DeleteResourceType", ctx, planeName, providerNamespace, resourceTypeName, force)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // DeleteResourceType indicates an expected call of DeleteResourceType.
-func (mr *MockApplicationsManagementClientMockRecorder) DeleteResourceType(ctx, planeName, providerNamespace, resourceTypeName any) *MockApplicationsManagementClientDeleteResourceTypeCall {
+func (mr *MockApplicationsManagementClientMockRecorder) DeleteResourceType(ctx, planeName, providerNamespace, resourceTypeName, force any) *MockApplicationsManagementClientDeleteResourceTypeCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceType", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeleteResourceType), ctx, planeName, providerNamespace, resourceTypeName)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceType", reflect.TypeOf((*MockApplicationsManagementClient)(nil).DeleteResourceType), ctx, planeName, providerNamespace, resourceTypeName, force)
 	return &MockApplicationsManagementClientDeleteResourceTypeCall{Call: call}
 }
 
@@ -653,13 +654,13 @@ func (c *MockApplicationsManagementClientDeleteResourceTypeCall) Return(arg0 boo
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockApplicationsManagementClientDeleteResourceTypeCall) Do(f func(context.Context, string, string, string) (bool, error)) *MockApplicationsManagementClientDeleteResourceTypeCall {
+func (c *MockApplicationsManagementClientDeleteResourceTypeCall) Do(f func(context.Context, string, string, string, bool) (bool, error)) *MockApplicationsManagementClientDeleteResourceTypeCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockApplicationsManagementClientDeleteResourceTypeCall) DoAndReturn(f func(context.Context, string, string, string) (bool, error)) *MockApplicationsManagementClientDeleteResourceTypeCall {
+func (c *MockApplicationsManagementClientDeleteResourceTypeCall) DoAndReturn(f func(context.Context, string, string, string, bool) (bool, error)) *MockApplicationsManagementClientDeleteResourceTypeCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -781,6 +782,45 @@ func (c *MockApplicationsManagementClientGetEnvironmentCall) DoAndReturn(f func(
 	return c
 }
 
+// GetLatestOperationLogs mocks base method.
+func (m *MockApplicationsManagementClient) GetLatestOperationLogs(ctx context.Context, resourceID string, since int64) (v1.OperationLogs, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestOperationLogs", ctx, resourceID, since)
+	ret0, _ := ret[0].(v1.OperationLogs)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestOperationLogs indicates an expected call of GetLatestOperationLogs.
+func (mr *MockApplicationsManagementClientMockRecorder) GetLatestOperationLogs(ctx, resourceID, since any) *MockApplicationsManagementClientGetLatestOperationLogsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestOperationLogs", reflect.TypeOf((*MockApplicationsManagementClient)(nil).GetLatestOperationLogs), ctx, resourceID, since)
+	return &MockApplicationsManagementClientGetLatestOperationLogsCall{Call: call}
+}
+
+// MockApplicationsManagementClientGetLatestOperationLogsCall wrap *gomock.Call
+type MockApplicationsManagementClientGetLatestOperationLogsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientGetLatestOperationLogsCall) Return(arg0 v1.OperationLogs, arg1 error) *MockApplicationsManagementClientGetLatestOperationLogsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientGetLatestOperationLogsCall) Do(f func(context.Context, string, int64) (v1.OperationLogs, error)) *MockApplicationsManagementClientGetLatestOperationLogsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientGetLatestOperationLogsCall) DoAndReturn(f func(context.Context, string, int64) (v1.OperationLogs, error)) *MockApplicationsManagementClientGetLatestOperationLogsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetRecipeMetadata mocks base method.
 func (m *MockApplicationsManagementClient) GetRecipeMetadata(ctx context.Context, environmentNameOrID string, recipe v20231001preview.RecipeGetMetadata) (v20231001preview.RecipeGetMetadataResponse, error) {
 	m.ctrl.T.Helper()
@@ -1015,6 +1055,84 @@ func (c *MockApplicationsManagementClientGetResourceProviderSummaryCall) DoAndRe
 	return c
 }
 
+// GetResourceTypeUsage mocks base method.
+func (m *MockApplicationsManagementClient) GetResourceTypeUsage(ctx context.Context, planeName, providerNamespace, resourceTypeName string) (v20231001preview0.ResourceTypeUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResourceTypeUsage", ctx, planeName, providerNamespace, resourceTypeName)
+	ret0, _ := ret[0].(v20231001preview0.ResourceTypeUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResourceTypeUsage indicates an expected call of GetResourceTypeUsage.
+func (mr *MockApplicationsManagementClientMockRecorder) GetResourceTypeUsage(ctx, planeName, providerNamespace, resourceTypeName any) *MockApplicationsManagementClientGetResourceTypeUsageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResourceTypeUsage", reflect.TypeOf((*MockApplicationsManagementClient)(nil).GetResourceTypeUsage), ctx, planeName, providerNamespace, resourceTypeName)
+	return &MockApplicationsManagementClientGetResourceTypeUsageCall{Call: call}
+}
+
+// MockApplicationsManagementClientGetResourceTypeUsageCall wrap *gomock.Call
+type MockApplicationsManagementClientGetResourceTypeUsageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientGetResourceTypeUsageCall) Return(arg0 v20231001preview0.ResourceTypeUsage, arg1 error) *MockApplicationsManagementClientGetResourceTypeUsageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientGetResourceTypeUsageCall) Do(f func(context.Context, string, string, string) (v20231001preview0.ResourceTypeUsage, error)) *MockApplicationsManagementClientGetResourceTypeUsageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientGetResourceTypeUsageCall) DoAndReturn(f func(context.Context, string, string, string) (v20231001preview0.ResourceTypeUsage, error)) *MockApplicationsManagementClientGetResourceTypeUsageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// InvokeResourceAction mocks base method.
+func (m *MockApplicationsManagementClient) InvokeResourceAction(ctx context.Context, resourceType, resourceNameOrID, action string, input map[string]any) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvokeResourceAction", ctx, resourceType, resourceNameOrID, action, input)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InvokeResourceAction indicates an expected call of InvokeResourceAction.
+func (mr *MockApplicationsManagementClientMockRecorder) InvokeResourceAction(ctx, resourceType, resourceNameOrID, action, input any) *MockApplicationsManagementClientInvokeResourceActionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvokeResourceAction", reflect.TypeOf((*MockApplicationsManagementClient)(nil).InvokeResourceAction), ctx, resourceType, resourceNameOrID, action, input)
+	return &MockApplicationsManagementClientInvokeResourceActionCall{Call: call}
+}
+
+// MockApplicationsManagementClientInvokeResourceActionCall wrap *gomock.Call
+type MockApplicationsManagementClientInvokeResourceActionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientInvokeResourceActionCall) Return(arg0 map[string]any, arg1 error) *MockApplicationsManagementClientInvokeResourceActionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientInvokeResourceActionCall) Do(f func(context.Context, string, string, string, map[string]any) (map[string]any, error)) *MockApplicationsManagementClientInvokeResourceActionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientInvokeResourceActionCall) DoAndReturn(f func(context.Context, string, string, string, map[string]any) (map[string]any, error)) *MockApplicationsManagementClientInvokeResourceActionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListAllResourceTypesNames mocks base method.
 func (m *MockApplicationsManagementClient) ListAllResourceTypesNames(ctx context.Context, planeName string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -1093,6 +1211,45 @@ func (c *MockApplicationsManagementClientListApplicationsCall) DoAndReturn(f fun
 	return c
 }
 
+// ListApplicationsInEnvironment mocks base method.
+func (m *MockApplicationsManagementClient) ListApplicationsInEnvironment(ctx context.Context, environmentNameOrID string) ([]v20231001preview.ApplicationResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApplicationsInEnvironment", ctx, environmentNameOrID)
+	ret0, _ := ret[0].([]v20231001preview.ApplicationResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApplicationsInEnvironment indicates an expected call of ListApplicationsInEnvironment.
+func (mr *MockApplicationsManagementClientMockRecorder) ListApplicationsInEnvironment(ctx, environmentNameOrID any) *MockApplicationsManagementClientListApplicationsInEnvironmentCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplicationsInEnvironment", reflect.TypeOf((*MockApplicationsManagementClient)(nil).ListApplicationsInEnvironment), ctx, environmentNameOrID)
+	return &MockApplicationsManagementClientListApplicationsInEnvironmentCall{Call: call}
+}
+
+// MockApplicationsManagementClientListApplicationsInEnvironmentCall wrap *gomock.Call
+type MockApplicationsManagementClientListApplicationsInEnvironmentCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientListApplicationsInEnvironmentCall) Return(arg0 []v20231001preview.ApplicationResource, arg1 error) *MockApplicationsManagementClientListApplicationsInEnvironmentCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientListApplicationsInEnvironmentCall) Do(f func(context.Context, string) ([]v20231001preview.ApplicationResource, error)) *MockApplicationsManagementClientListApplicationsInEnvironmentCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientListApplicationsInEnvironmentCall) DoAndReturn(f func(context.Context, string) ([]v20231001preview.ApplicationResource, error)) *MockApplicationsManagementClientListApplicationsInEnvironmentCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // ListEnvironments mocks base method.
 func (m *MockApplicationsManagementClient) ListEnvironments(ctx context.Context) ([]v20231001preview.EnvironmentResource, error) {
 	m.ctrl.T.Helper()
@@ -1755,3 +1912,81 @@ func (c *MockApplicationsManagementClientListResourcesOfTypeInResourceGroupFilte
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// PlanApplicationDelete mocks base method.
+func (m *MockApplicationsManagementClient) PlanApplicationDelete(ctx context.Context, applicationNameOrID string) (DeletePlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanApplicationDelete", ctx, applicationNameOrID)
+	ret0, _ := ret[0].(DeletePlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlanApplicationDelete indicates an expected call of PlanApplicationDelete.
+func (mr *MockApplicationsManagementClientMockRecorder) PlanApplicationDelete(ctx, applicationNameOrID any) *MockApplicationsManagementClientPlanApplicationDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanApplicationDelete", reflect.TypeOf((*MockApplicationsManagementClient)(nil).PlanApplicationDelete), ctx, applicationNameOrID)
+	return &MockApplicationsManagementClientPlanApplicationDeleteCall{Call: call}
+}
+
+// MockApplicationsManagementClientPlanApplicationDeleteCall wrap *gomock.Call
+type MockApplicationsManagementClientPlanApplicationDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientPlanApplicationDeleteCall) Return(arg0 DeletePlan, arg1 error) *MockApplicationsManagementClientPlanApplicationDeleteCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientPlanApplicationDeleteCall) Do(f func(context.Context, string) (DeletePlan, error)) *MockApplicationsManagementClientPlanApplicationDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientPlanApplicationDeleteCall) DoAndReturn(f func(context.Context, string) (DeletePlan, error)) *MockApplicationsManagementClientPlanApplicationDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateResource mocks base method.
+func (m *MockApplicationsManagementClient) UpdateResource(ctx context.Context, resourceType, resourceNameOrID string, patch map[string]any) (generated.GenericResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateResource", ctx, resourceType, resourceNameOrID, patch)
+	ret0, _ := ret[0].(generated.GenericResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateResource indicates an expected call of UpdateResource.
+func (mr *MockApplicationsManagementClientMockRecorder) UpdateResource(ctx, resourceType, resourceNameOrID, patch any) *MockApplicationsManagementClientUpdateResourceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResource", reflect.TypeOf((*MockApplicationsManagementClient)(nil).UpdateResource), ctx, resourceType, resourceNameOrID, patch)
+	return &MockApplicationsManagementClientUpdateResourceCall{Call: call}
+}
+
+// MockApplicationsManagementClientUpdateResourceCall wrap *gomock.Call
+type MockApplicationsManagementClientUpdateResourceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockApplicationsManagementClientUpdateResourceCall) Return(arg0 generated.GenericResource, arg1 error) *MockApplicationsManagementClientUpdateResourceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockApplicationsManagementClientUpdateResourceCall) Do(f func(context.Context, string, string, map[string]any) (generated.GenericResource, error)) *MockApplicationsManagementClientUpdateResourceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockApplicationsManagementClientUpdateResourceCall) DoAndReturn(f func(context.Context, string, string, map[string]any) (generated.GenericResource, error)) *MockApplicationsManagementClientUpdateResourceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -82,41 +82,41 @@ func (c *MockDiagnosticsClientExposeCall) DoAndReturn(f func(context.Context, Ex
 	return c
 }
 
-// GetPublicEndpoint mocks base method.
-func (m *MockDiagnosticsClient) GetPublicEndpoint(ctx context.Context, options EndpointOptions) (*string, error) {
+// GetEndpoints mocks base method.
+func (m *MockDiagnosticsClient) GetEndpoints(ctx context.Context, options EndpointOptions) ([]Endpoint, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPublicEndpoint", ctx, options)
-	ret0, _ := ret[0].(*string)
+	ret := m.ctrl.Call(m, "GetEndpoints", ctx, options)
+	ret0, _ := ret[0].([]Endpoint)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPublicEndpoint indicates an expected call of GetPublicEndpoint.
-func (mr *MockDiagnosticsClientMockRecorder) GetPublicEndpoint(ctx, options any) *MockDiagnosticsClientGetPublicEndpointCall {
+// GetEndpoints indicates an expected call of GetEndpoints.
+func (mr *MockDiagnosticsClientMockRecorder) GetEndpoints(ctx, options any) *MockDiagnosticsClientGetEndpointsCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicEndpoint", reflect.TypeOf((*MockDiagnosticsClient)(nil).GetPublicEndpoint), ctx, options)
-	return &MockDiagnosticsClientGetPublicEndpointCall{Call: call}
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEndpoints", reflect.TypeOf((*MockDiagnosticsClient)(nil).GetEndpoints), ctx, options)
+	return &MockDiagnosticsClientGetEndpointsCall{Call: call}
 }
 
-// MockDiagnosticsClientGetPublicEndpointCall wrap *gomock.Call
-type MockDiagnosticsClientGetPublicEndpointCall struct {
+// MockDiagnosticsClientGetEndpointsCall wrap *gomock.Call
+type MockDiagnosticsClientGetEndpointsCall struct {
 	*gomock.Call
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockDiagnosticsClientGetPublicEndpointCall) Return(arg0 *string, arg1 error) *MockDiagnosticsClientGetPublicEndpointCall {
+func (c *MockDiagnosticsClientGetEndpointsCall) Return(arg0 []Endpoint, arg1 error) *MockDiagnosticsClientGetEndpointsCall {
 	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockDiagnosticsClientGetPublicEndpointCall) Do(f func(context.Context, EndpointOptions) (*string, error)) *MockDiagnosticsClientGetPublicEndpointCall {
+func (c *MockDiagnosticsClientGetEndpointsCall) Do(f func(context.Context, EndpointOptions) ([]Endpoint, error)) *MockDiagnosticsClientGetEndpointsCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockDiagnosticsClientGetPublicEndpointCall) DoAndReturn(f func(context.Context, EndpointOptions) (*string, error)) *MockDiagnosticsClientGetPublicEndpointCall {
+func (c *MockDiagnosticsClientGetEndpointsCall) DoAndReturn(f func(context.Context, EndpointOptions) ([]Endpoint, error)) *MockDiagnosticsClientGetEndpointsCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -1173,6 +1173,45 @@ func (c *MockresourceTypeClientBeginDeleteCall) DoAndReturn(f func(context.Conte
 	return c
 }
 
+// GetUsage mocks base method.
+func (m *MockresourceTypeClient) GetUsage(ctx context.Context, planeName, resourceProviderName, resourceTypeName string, options *v20231001preview0.ResourceTypesClientGetUsageOptions) (v20231001preview0.ResourceTypesClientGetUsageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsage", ctx, planeName, resourceProviderName, resourceTypeName, options)
+	ret0, _ := ret[0].(v20231001preview0.ResourceTypesClientGetUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockresourceTypeClientMockRecorder) GetUsage(ctx, planeName, resourceProviderName, resourceTypeName, options any) *MockresourceTypeClientGetUsageCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockresourceTypeClient)(nil).GetUsage), ctx, planeName, resourceProviderName, resourceTypeName, options)
+	return &MockresourceTypeClientGetUsageCall{Call: call}
+}
+
+// MockresourceTypeClientGetUsageCall wrap *gomock.Call
+type MockresourceTypeClientGetUsageCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockresourceTypeClientGetUsageCall) Return(arg0 v20231001preview0.ResourceTypesClientGetUsageResponse, arg1 error) *MockresourceTypeClientGetUsageCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockresourceTypeClientGetUsageCall) Do(f func(context.Context, string, string, string, *v20231001preview0.ResourceTypesClientGetUsageOptions) (v20231001preview0.ResourceTypesClientGetUsageResponse, error)) *MockresourceTypeClientGetUsageCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockresourceTypeClientGetUsageCall) DoAndReturn(f func(context.Context, string, string, string, *v20231001preview0.ResourceTypesClientGetUsageOptions) (v20231001preview0.ResourceTypesClientGetUsageResponse, error)) *MockresourceTypeClientGetUsageCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // MockapiVersionClient is a mock of apiVersionClient interface.
 type MockapiVersionClient struct {
 	ctrl     *gomock.Controller
@@ -18,6 +18,7 @@ package delete
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -52,7 +53,11 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	cmd := &cobra.Command{
 		Use:   "delete",
 		Short: "Delete Radius Application",
-		Long:  "Delete the specified Radius Application deployed in the default environment",
+		Long: `Delete the specified Radius Application deployed in the default environment.
+
+The resources of the application are deleted before the application. A resource is deleted before the resources it
+connects to, so that for example a container is deleted before the database it uses. If a resource can't be deleted,
+the delete stops and the application is kept; run the command again to resume deleting the remaining resources.`,
 		Example: `
 # Delete specified application and bypass confirmation prompt
 rad app delete --yes --application my-app
@@ -65,6 +70,9 @@ rad app delete my-app --group my-group
 
 # Force delete an application with resources stuck in a non-terminal state
 rad app delete my-app --force
+
+# Print the order in which the resources of an application would be deleted, without deleting anything
+rad app delete my-app --dry-run
 `,
 		Args: cobra.MaximumNArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -75,6 +83,7 @@ rad app delete my-app --force
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddConfirmationFlag(cmd)
 	commonflags.AddForceFlag(cmd)
+	cmd.Flags().Bool("dry-run", false, "Print the order in which the resources of the application would be deleted, without deleting anything")
 
 	return cmd, runner
 }
@@ -92,6 +101,7 @@ type Runner struct {
 	Scope           string
 	Confirm         bool
 	Force           bool
+	DryRun          bool
 	Workspace       *workspaces.Workspace
 }
 
@@ -155,6 +165,11 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	r.DryRun, err = cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -186,6 +201,16 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	if r.DryRun {
+		plan, err := client.PlanApplicationDelete(ctx, r.ApplicationName)
+		if err != nil {
+			return err
+		}
+
+		r.displayDeletePlan(plan)
+		return nil
+	}
+
 	if r.Force {
 		r.Output.LogInfo("WARNING: Force deleting an application. Resources in non-terminal states may leave orphaned external resources that require manual cleanup.")
 	}
@@ -208,7 +233,11 @@ func (r *Runner) Run(ctx context.Context) error {
 		ProgressText:        progressText,
 		Force:               r.Force,
 	})
-	if err != nil {
+	var cascadeErr *clients.CascadeDeleteError
+	if errors.As(err, &cascadeErr) {
+		return clierrors.Message("Failed to delete application '%s' because resource '%s' could not be deleted: %v\nDeleted resources: %s\nRemaining resources: %s\nRun the delete again to resume.",
+			r.ApplicationName, cascadeErr.Resource, cascadeErr.Err, formatResourceIDs(cascadeErr.Deleted), formatResourceIDs(cascadeErr.Remaining))
+	} else if err != nil {
 		if strings.Contains(err.Error(), "not found") {
 			r.Output.LogInfo("Applications.Core/applications/%s not found", r.ApplicationName)
 			return nil
@@ -224,3 +253,19 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// displayDeletePlan prints the order in which the resources of the application would be deleted, and the output
+// resources that would be destroyed with them.
+func (r *Runner) displayDeletePlan(plan clients.DeletePlan) {
+	delete.DisplayDeletePlan(r.Output, r.ApplicationName, plan)
+	r.Output.LogInfo("")
+	r.Output.LogInfo("Applications.Core/applications/%s would be deleted", r.ApplicationName)
+}
+
+// formatResourceIDs formats a list of resource IDs for an error message.
+func formatResourceIDs(ids []string) string {
+	if len(ids) == 0 {
+		return "none"
+	}
+	return strings.Join(ids, ", ")
+}
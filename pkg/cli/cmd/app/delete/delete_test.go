@@ -48,6 +48,15 @@ func Test_Validate(t *testing.T) {
 				Config:         radcli.LoadConfigWithWorkspace(t),
 			},
 		},
+		{
+			Name:          "Delete Command with dry run",
+			Input:         []string{"test-application", "--dry-run"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadConfigWithWorkspace(t),
+			},
+		},
 		{
 			Name:          "Delete Command with positional arg",
 			Input:         []string{"test-application"},
@@ -529,6 +538,73 @@ func Test_Delete(t *testing.T) {
 		require.Equal(t, expected, outputSink.Writes)
 	})
 
+	t.Run("Error: Cascade Delete Stops On A Resource That Was Not Found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		containerID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/container"
+		gatewayID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/gateway"
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		deleteMock := delete.NewMockInterface(ctrl)
+
+		// Since GetApplication is now always called, we need to mock it
+		appManagementClient.EXPECT().
+			GetApplication(gomock.Any(), "test-app").
+			Return(v20231001preview.ApplicationResource{
+				Properties: &v20231001preview.ApplicationProperties{
+					Environment: new("/planes/radius/local/resourceGroups/default/providers/Applications.Core/environments/default"),
+				},
+			}, nil).
+			Times(1)
+
+		progressText := fmt.Sprintf("Deleting application '%s' from environment '%s'...", "test-app", "default")
+		deleteMock.EXPECT().
+			DeleteApplicationWithProgress(
+				gomock.Any(),
+				appManagementClient,
+				clients.DeleteOptions{
+					ApplicationNameOrID: "test-app",
+					ProgressText:        progressText,
+				},
+			).
+			Return(false, &clients.CascadeDeleteError{
+				Resource:  containerID,
+				Err:       fmt.Errorf("resource not found in backend"),
+				Deleted:   []string{gatewayID},
+				Remaining: []string{containerID},
+			}).
+			Times(1)
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+			Name:        "kind-kind",
+			Scope:       "/planes/radius/local/resourceGroups/test-group",
+			Environment: "/planes/radius/local/resourceGroups/default/providers/Applications.Core/environments/default",
+		}
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Delete:            deleteMock,
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         workspace,
+			Output:            outputSink,
+			ApplicationName:   "test-app",
+			EnvironmentName:   "default",
+			Confirm:           true,
+		}
+
+		// The cascade error is reported even though its message contains "not found".
+		err := runner.Run(t.Context())
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Failed to delete application 'test-app' because resource '"+containerID+"' could not be deleted")
+		require.Contains(t, err.Error(), "Deleted resources: "+gatewayID)
+		require.Contains(t, err.Error(), "Remaining resources: "+containerID)
+		require.Empty(t, outputSink.Writes)
+	})
+
 	t.Run("Success: Delete Returns False (already gone)", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -654,4 +730,67 @@ func Test_Delete(t *testing.T) {
 		require.True(t, ok)
 		require.Equal(t, "Applications.Core/applications/%s deleted", lastOutput.Format)
 	})
+
+	t.Run("Success: Dry Run", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		deleteMock := delete.NewMockInterface(ctrl)
+
+		appManagementClient.EXPECT().
+			GetApplication(gomock.Any(), "test-app").
+			Return(v20231001preview.ApplicationResource{
+				Properties: &v20231001preview.ApplicationProperties{
+					Environment: new("/planes/radius/local/resourceGroups/default/providers/Applications.Core/environments/default"),
+				},
+			}, nil).
+			Times(1)
+
+		appManagementClient.EXPECT().
+			PlanApplicationDelete(gomock.Any(), "test-app").
+			Return(clients.DeletePlan{
+				Waves: [][]clients.DeletePlanResource{
+					{{
+						ID:              "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/frontend",
+						Type:            "Applications.Core/containers",
+						Name:            "frontend",
+						OutputResources: []string{"/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/frontend"},
+					}},
+					{{
+						ID:   "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/db",
+						Type: "Applications.Datastores/redisCaches",
+						Name: "db",
+					}},
+				},
+			}, nil).
+			Times(1)
+
+		// Nothing is deleted and there is no prompt.
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			Delete:            deleteMock,
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			Output:            outputSink,
+			ApplicationName:   "test-app",
+			DryRun:            true,
+		}
+
+		err := runner.Run(t.Context())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{Format: "The resources of application '%s' would be deleted in the following order:", Params: []any{"test-app"}},
+			output.LogOutput{Format: ""},
+			output.LogOutput{Format: "%d.", Params: []any{1}},
+			output.LogOutput{Format: "  %s (%s)", Params: []any{"frontend", "Applications.Core/containers"}},
+			output.LogOutput{Format: "    %s", Params: []any{"/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/frontend"}},
+			output.LogOutput{Format: ""},
+			output.LogOutput{Format: "%d.", Params: []any{2}},
+			output.LogOutput{Format: "  %s (%s)", Params: []any{"db", "Applications.Datastores/redisCaches"}},
+			output.LogOutput{Format: ""},
+			output.LogOutput{Format: "Applications.Core/applications/%s would be deleted", Params: []any{"test-app"}},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
 }
@@ -18,11 +18,11 @@ package preview
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
-	"golang.org/x/sync/errgroup"
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
@@ -31,10 +31,12 @@ import (
 	"github.com/radius-project/radius/pkg/cli/cmd"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/delete"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/prompt"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerpv20231001 "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	corerpv20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 )
@@ -53,8 +55,11 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	cmd := &cobra.Command{
 		Use:   "delete",
 		Short: "Delete Radius Application (preview)",
-		Long:  `Delete application and its associated resources using the Radius.Core preview API surface.`,
-		Args:  cobra.MaximumNArgs(1),
+		Long: `Delete application and its associated resources using the Radius.Core preview API surface.
+
+A resource is deleted before the resources it connects to. If a resource can't be deleted, the delete stops and the
+application is kept; run the command again to resume deleting the remaining resources.`,
+		Args: cobra.MaximumNArgs(1),
 		Example: `
 # Delete current application
 rad app delete --preview
@@ -64,6 +69,9 @@ rad app delete --yes --preview
 
 # Delete specified application
 rad app delete my-app --preview
+
+# Print the order in which the resources of an application would be deleted, without deleting anything
+rad app delete my-app --preview --dry-run
 `,
 		RunE: framework.RunCommand(runner),
 	}
@@ -73,6 +81,7 @@ rad app delete my-app --preview
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddConfirmationFlag(cmd)
 	commonflags.AddForceFlag(cmd)
+	cmd.Flags().Bool("dry-run", false, "Print the order in which the resources of the application would be deleted, without deleting anything")
 
 	return cmd, runner
 }
@@ -88,6 +97,7 @@ type Runner struct {
 
 	Confirm         bool
 	Force           bool
+	DryRun          bool
 	ApplicationName string
 }
 
@@ -134,6 +144,11 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	r.DryRun, err = cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -141,7 +156,8 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 //
 // This discovers resources owned by the application using the management client's
 // resource enumeration (ownership-based via properties.application), deletes them
-// in parallel, then deletes the application via the Radius.Core preview API.
+// in the order computed from the connections of the application graph, then deletes
+// the application via the Radius.Core preview API.
 func (r *Runner) Run(ctx context.Context) error {
 	if r.RadiusCoreClientFactory == nil {
 		factory, err := cmd.InitializeRadiusCoreClientFactory(ctx, r.Workspace)
@@ -162,6 +178,21 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	if r.DryRun {
+		managementClient, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+		if err != nil {
+			return err
+		}
+
+		plan, err := r.planDelete(ctx, appClient, managementClient)
+		if err != nil {
+			return err
+		}
+
+		r.displayDeletePlan(plan)
+		return nil
+	}
+
 	if !r.Confirm {
 		promptMsg := fmt.Sprintf("Are you sure you want to delete application '%s'?", r.ApplicationName)
 		confirmed, err := prompt.YesOrNoPrompt(promptMsg, prompt.ConfirmNo, r.InputPrompter)
@@ -179,45 +210,40 @@ func (r *Runner) Run(ctx context.Context) error {
 	}
 
 	// Use the management client to discover and delete owned resources.
-	// This uses ownership-based filtering (properties.application matches our app ID)
-	// rather than GetGraph which returns a connectivity graph that may include shared resources.
 	managementClient, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
 	if err != nil {
 		return err
 	}
 
-	// Build the fully qualified Radius.Core application ID for ownership matching
-	applicationID := r.Workspace.Scope + "/providers/" + datamodel.ApplicationResourceType_v20250801preview + "/" + r.ApplicationName
-
-	resourcesList, err := listResourcesOwnedByApplication(ctx, managementClient, applicationID)
-	if err != nil && !clients.Is404Error(err) {
+	plan, err := r.planDelete(ctx, appClient, managementClient)
+	if err != nil {
 		return err
 	}
 
-	// Delete associated resources in parallel
+	if plan.OrderError != nil {
+		r.Output.LogInfo("WARNING: The resources of application '%s' could not be ordered and will be deleted together: %v", r.ApplicationName, plan.OrderError)
+	}
+
+	// Delete associated resources in dependency order
+	resourcesList := plan.Resources()
 	if len(resourcesList) > 0 {
 		r.Output.LogInfo(msgDeletingResources, len(resourcesList), r.ApplicationName)
 
-		g, groupCtx := errgroup.WithContext(ctx)
+		// Log before deleting; output.Interface implementations (including the MockOutput
+		// used in tests) are not guaranteed to be thread-safe, and logging in plan order
+		// keeps output deterministic.
 		for _, resource := range resourcesList {
-			if resource.ID != nil && resource.Type != nil {
-				// Log before launching the goroutine; output.Interface implementations
-				// (including the MockOutput used in tests) are not guaranteed to be
-				// thread-safe, and ordering the log here keeps output deterministic.
-				r.Output.LogInfo("  Deleting %s...", *resource.ID)
-				resourceType := *resource.Type
-				resourceID := *resource.ID
-				g.Go(func() error {
-					_, err := managementClient.DeleteResource(groupCtx, resourceType, resourceID, r.Force)
-					if err != nil && !clients.Is404Error(err) {
-						return err
-					}
-					return nil
-				})
-			}
+			r.Output.LogInfo("  Deleting %s...", resource.ID)
 		}
 
-		if err := g.Wait(); err != nil {
+		err = clients.ExecuteDeletePlan(ctx, plan, func(ctx context.Context, resource clients.DeletePlanResource) error {
+			_, err := managementClient.DeleteResource(ctx, resource.Type, resource.ID, r.Force)
+			if err != nil && !clients.Is404Error(err) {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
 			return clierrors.Message("Failed to delete resources for application '%s': %v", r.ApplicationName, err)
 		}
 	}
@@ -238,6 +264,65 @@ func (r *Runner) Run(ctx context.Context) error {
 	return nil
 }
 
+// planDelete computes the order in which the resources owned by the application are deleted.
+//
+// The resources are discovered using the management client's resource enumeration
+// (ownership-based via properties.application) rather than GetGraph, which returns a
+// connectivity graph that may include shared resources. The connections of the graph are
+// only used to order the owned resources. If the graph can't be retrieved, the resources
+// are deleted together and the error is returned in the OrderError of the plan.
+func (r *Runner) planDelete(ctx context.Context, appClient *corerpv20250801.ApplicationsClient, managementClient clients.ApplicationsManagementClient) (clients.DeletePlan, error) {
+	// Build the fully qualified Radius.Core application ID for ownership matching
+	applicationID := r.Workspace.Scope + "/providers/" + datamodel.ApplicationResourceType_v20250801preview + "/" + r.ApplicationName
+
+	resourcesList, err := listResourcesOwnedByApplication(ctx, managementClient, applicationID)
+	if err != nil && !clients.Is404Error(err) {
+		return clients.DeletePlan{}, err
+	}
+
+	if len(resourcesList) == 0 {
+		return clients.DeletePlan{}, nil
+	}
+
+	graph, err := r.getDeletePlanGraph(ctx, appClient)
+	if err != nil {
+		plan := clients.NewApplicationDeletePlan(resourcesList, nil)
+		plan.OrderError = fmt.Errorf("failed to get the graph of application %q: %w", r.ApplicationName, err)
+		return plan, nil
+	}
+
+	return clients.NewApplicationDeletePlan(resourcesList, graph), nil
+}
+
+// getDeletePlanGraph returns the graph of the application in the shape used to order deletes. The Radius.Core graph
+// has the same JSON shape as the Applications.Core graph, so it is converted through JSON.
+func (r *Runner) getDeletePlanGraph(ctx context.Context, appClient *corerpv20250801.ApplicationsClient) ([]*corerpv20231001.ApplicationGraphResource, error) {
+	response, err := appClient.GetGraph(ctx, r.Workspace.Scope, r.ApplicationName, corerpv20250801.GetGraphRequest{}, &corerpv20250801.ApplicationsClientGetGraphOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(response.Resources)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := []*corerpv20231001.ApplicationGraphResource{}
+	if err := json.Unmarshal(b, &graph); err != nil {
+		return nil, err
+	}
+
+	return graph, nil
+}
+
+// displayDeletePlan prints the order in which the resources of the application would be
+// deleted, and the output resources that would be destroyed with them.
+func (r *Runner) displayDeletePlan(plan clients.DeletePlan) {
+	delete.DisplayDeletePlan(r.Output, r.ApplicationName, plan)
+	r.Output.LogInfo("")
+	r.Output.LogInfo("Radius.Core/applications/%s would be deleted", r.ApplicationName)
+}
+
 // listResourcesOwnedByApplication lists resources whose properties.application field
 // matches the given application ID. This is an ownership-based query that only returns
 // resources explicitly owned by the application, unlike GetGraph which returns a
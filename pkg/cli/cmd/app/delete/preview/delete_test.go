@@ -72,6 +72,14 @@ func Test_Validate(t *testing.T) {
 				Config: radcli.LoadEmptyConfig(t),
 			},
 		},
+		{
+			Name:          "Delete command with dry run",
+			Input:         []string{"test-app", "--dry-run"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				Config: configWithWorkspace,
+			},
+		},
 		{
 			Name:          "Delete command with incorrect args",
 			Input:         []string{"foo", "bar"},
@@ -134,6 +142,88 @@ func mockManagementClientWithResources(ctrl *gomock.Controller, appID string, fo
 	return mock
 }
 
+// mockManagementClientWithConnectedResources returns a mock that has a container and the
+// database it connects to. The caller sets up the expected deletes.
+func mockManagementClientWithConnectedResources(ctrl *gomock.Controller, appID string) *clients.MockApplicationsManagementClient {
+	mock := clients.NewMockApplicationsManagementClient(ctrl)
+	mock.EXPECT().
+		ListAllResourceTypesNames(gomock.Any(), "local").
+		Return([]string{testContainerType, testDatabaseType}, nil).
+		Times(1)
+	mock.EXPECT().
+		ListResourcesOfType(gomock.Any(), testContainerType).
+		Return([]generated.GenericResource{
+			{
+				ID:         new(testContainerID),
+				Name:       new("frontend"),
+				Type:       new(testContainerType),
+				Properties: map[string]any{"application": appID},
+			},
+		}, nil).
+		Times(1)
+	mock.EXPECT().
+		ListResourcesOfType(gomock.Any(), testDatabaseType).
+		Return([]generated.GenericResource{
+			{
+				ID:         new(testDatabaseID),
+				Name:       new("db"),
+				Type:       new(testDatabaseType),
+				Properties: map[string]any{"application": appID},
+			},
+		}, nil).
+		Times(1)
+	return mock
+}
+
+const (
+	testContainerType = "Radius.Compute/containers"
+	testContainerID   = "/planes/radius/local/resourceGroups/test-group/providers/Radius.Compute/containers/frontend"
+	testDatabaseType  = "Radius.Data/postgreSqlDatabases"
+	testDatabaseID    = "/planes/radius/local/resourceGroups/test-group/providers/Radius.Data/postgreSqlDatabases/db"
+	testDatabaseOutID = "/planes/kubernetes/local/namespaces/default/providers/apps/StatefulSet/db"
+)
+
+// withConnectedGraphServer returns an ApplicationsServer whose graph has the container
+// connecting to the database.
+func withConnectedGraphServer() fake.ApplicationsServer {
+	server := test_client_factory.WithApplicationsServerNoError()
+	server.GetGraph = func(
+		ctx context.Context,
+		rootScope string,
+		applicationName string,
+		body corerpv20250801.GetGraphRequest,
+		options *corerpv20250801.ApplicationsClientGetGraphOptions,
+	) (resp azfake.Responder[corerpv20250801.ApplicationsClientGetGraphResponse], errResp azfake.ErrorResponder) {
+		resp.SetResponse(http.StatusOK, corerpv20250801.ApplicationsClientGetGraphResponse{
+			ApplicationGraphResponse: corerpv20250801.ApplicationGraphResponse{
+				Resources: []*corerpv20250801.ApplicationGraphResource{
+					{
+						ID:   new(testContainerID),
+						Name: new("frontend"),
+						Type: new(testContainerType),
+						Connections: []*corerpv20250801.ApplicationGraphConnection{
+							{ID: new(testDatabaseID), Direction: new(corerpv20250801.DirectionOutbound)},
+						},
+					},
+					{
+						ID:   new(testDatabaseID),
+						Name: new("db"),
+						Type: new(testDatabaseType),
+						Connections: []*corerpv20250801.ApplicationGraphConnection{
+							{ID: new(testContainerID), Direction: new(corerpv20250801.DirectionInbound)},
+						},
+						OutputResources: []*corerpv20250801.ApplicationGraphOutputResource{
+							{ID: new(testDatabaseOutID), Name: new("db"), Type: new("apps/StatefulSet")},
+						},
+					},
+				},
+			},
+		}, nil)
+		return
+	}
+	return server
+}
+
 func Test_Run(t *testing.T) {
 	workspace := &workspaces.Workspace{
 		Name:  "test-workspace",
@@ -472,6 +562,142 @@ func Test_Run(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("Success: resources are deleted in dependency order", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		factory, err := test_client_factory.NewRadiusCoreTestClientFactory(workspace.Scope, nil, nil, withConnectedGraphServer)
+		require.NoError(t, err)
+
+		appID := workspace.Scope + "/providers/Radius.Core/applications/test-app"
+		mockMgmt := mockManagementClientWithConnectedResources(ctrl, appID)
+		gomock.InOrder(
+			mockMgmt.EXPECT().
+				DeleteResource(gomock.Any(), testContainerType, testContainerID, false).
+				Return(true, nil).
+				Times(1),
+			mockMgmt.EXPECT().
+				DeleteResource(gomock.Any(), testDatabaseType, testDatabaseID, false).
+				Return(true, nil).
+				Times(1),
+		)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			RadiusCoreClientFactory: factory,
+			ConnectionFactory:       &connections.MockFactory{ApplicationsManagementClient: mockMgmt},
+			Workspace:               workspace,
+			Output:                  outputSink,
+			ApplicationName:         "test-app",
+			Confirm:                 true,
+		}
+
+		err = runner.Run(t.Context())
+		require.NoError(t, err)
+
+		lastLog, ok := outputSink.Writes[len(outputSink.Writes)-1].(output.LogOutput)
+		require.True(t, ok)
+		require.Equal(t, msgApplicationDeletedPreview, lastLog.Format)
+	})
+
+	t.Run("Success: dry run prints the delete plan", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		factory, err := test_client_factory.NewRadiusCoreTestClientFactory(workspace.Scope, nil, nil, withConnectedGraphServer)
+		require.NoError(t, err)
+
+		appID := workspace.Scope + "/providers/Radius.Core/applications/test-app"
+		mockMgmt := mockManagementClientWithConnectedResources(ctrl, appID)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			RadiusCoreClientFactory: factory,
+			ConnectionFactory:       &connections.MockFactory{ApplicationsManagementClient: mockMgmt},
+			Workspace:               workspace,
+			Output:                  outputSink,
+			ApplicationName:         "test-app",
+			DryRun:                  true,
+		}
+
+		err = runner.Run(t.Context())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "The resources of application '%s' would be deleted in the following order:",
+				Params: []any{"test-app"},
+			},
+			output.LogOutput{Format: ""},
+			output.LogOutput{Format: "%d.", Params: []any{1}},
+			output.LogOutput{Format: "  %s (%s)", Params: []any{"frontend", testContainerType}},
+			output.LogOutput{Format: ""},
+			output.LogOutput{Format: "%d.", Params: []any{2}},
+			output.LogOutput{Format: "  %s (%s)", Params: []any{"db", testDatabaseType}},
+			output.LogOutput{Format: "    %s", Params: []any{testDatabaseOutID}},
+			output.LogOutput{Format: ""},
+			output.LogOutput{Format: "Radius.Core/applications/%s would be deleted", Params: []any{"test-app"}},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("Success: dry run warns when the graph can't be retrieved", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		factory, err := test_client_factory.NewRadiusCoreTestClientFactory(workspace.Scope, nil, nil, func() fake.ApplicationsServer {
+			server := test_client_factory.WithApplicationsServerNoError()
+			server.GetGraph = func(
+				ctx context.Context,
+				rootScope string,
+				applicationName string,
+				body corerpv20250801.GetGraphRequest,
+				options *corerpv20250801.ApplicationsClientGetGraphOptions,
+			) (resp azfake.Responder[corerpv20250801.ApplicationsClientGetGraphResponse], errResp azfake.ErrorResponder) {
+				errResp.SetResponseError(http.StatusInternalServerError, "InternalServerError")
+				return
+			}
+			return server
+		})
+		require.NoError(t, err)
+
+		appID := workspace.Scope + "/providers/Radius.Core/applications/test-app"
+		mockMgmt := mockManagementClientWithConnectedResources(ctrl, appID)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			RadiusCoreClientFactory: factory,
+			ConnectionFactory:       &connections.MockFactory{ApplicationsManagementClient: mockMgmt},
+			Workspace:               workspace,
+			Output:                  outputSink,
+			ApplicationName:         "test-app",
+			DryRun:                  true,
+		}
+
+		err = runner.Run(t.Context())
+		require.NoError(t, err)
+
+		// The plan is not presented as ordered: a warning is printed and the resources are in a single wave.
+		warning := outputSink.Writes[0].(output.LogOutput)
+		require.Equal(t, "WARNING: The resources of application '%s' could not be ordered and would be deleted together: %v", warning.Format)
+		require.ErrorContains(t, warning.Params[1].(error), "failed to get the graph of application")
+
+		expected := []any{
+			output.LogOutput{Format: ""},
+			output.LogOutput{
+				Format: "The resources of application '%s' would be deleted in the following order:",
+				Params: []any{"test-app"},
+			},
+			output.LogOutput{Format: ""},
+			output.LogOutput{Format: "%d.", Params: []any{1}},
+			output.LogOutput{Format: "  %s (%s)", Params: []any{"frontend", testContainerType}},
+			output.LogOutput{Format: "  %s (%s)", Params: []any{"db", testDatabaseType}},
+			output.LogOutput{Format: ""},
+			output.LogOutput{Format: "Radius.Core/applications/%s would be deleted", Params: []any{"test-app"}},
+		}
+		require.Equal(t, expected, outputSink.Writes[1:])
+	})
+
 	t.Run("Success: user accepts confirmation prompt", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
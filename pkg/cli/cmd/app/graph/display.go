@@ -83,6 +83,13 @@ func display(applicationResources []*v20231001preview.ApplicationGraphResource,
 			}
 		}
 
+		if len(resource.Endpoints) > 0 {
+			output.WriteString("Endpoints:\n")
+			for _, endpoint := range resource.Endpoints {
+				output.WriteString(fmt.Sprintf("  %s: %s (%s)\n", *endpoint.Name, *endpoint.URL, *endpoint.Visibility))
+			}
+		}
+
 		if len(resource.OutputResources) == 0 {
 			output.WriteString("Resources: (none)\n")
 		} else {
@@ -164,6 +164,35 @@ Resources: (none)
 		require.Equal(t, expected, actual)
 	})
 
+	t.Run("resource with endpoints", func(t *testing.T) {
+		graph := []*corerpv20231001preview.ApplicationGraphResource{
+			{
+				ID:                to.Ptr("/planes/radius/local/resourcegroups/default/providers/Radius.Compute/routes/frontend"),
+				Name:              to.Ptr("frontend"),
+				Type:              to.Ptr("Radius.Compute/routes"),
+				ProvisioningState: to.Ptr("Succeeded"),
+				OutputResources:   []*corerpv20231001preview.ApplicationGraphOutputResource{},
+				Endpoints: []*corerpv20231001preview.ApplicationGraphEndpoint{
+					{Name: to.Ptr("internalUrl"), Visibility: to.Ptr("internal"), URL: to.Ptr("http://frontend.default.svc:80")},
+					{Name: to.Ptr("url"), Visibility: to.Ptr("public"), URL: to.Ptr("https://frontend.example.com")},
+				},
+			},
+		}
+
+		expected := `Displaying application: test-app
+
+Name: frontend (Radius.Compute/routes)
+Connections: (none)
+Endpoints:
+  internalUrl: http://frontend.default.svc:80 (internal)
+  url: https://frontend.example.com (public)
+Resources: (none)
+
+`
+
+		actual := display(graph, "test-app")
+		require.Equal(t, expected, actual)
+	})
 }
 
 func Test_MakeResourceHyperlink(t *testing.T) {
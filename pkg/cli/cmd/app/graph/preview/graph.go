@@ -260,6 +260,13 @@ func display(applicationResources []*corerpv20250801.ApplicationGraphResource, a
 			}
 		}
 
+		if len(resource.Endpoints) > 0 {
+			out.WriteString("Endpoints:\n")
+			for _, endpoint := range resource.Endpoints {
+				out.WriteString(fmt.Sprintf("  %s: %s (%s)\n", *endpoint.Name, *endpoint.URL, *endpoint.Visibility))
+			}
+		}
+
 		if len(resource.OutputResources) == 0 {
 			out.WriteString("Resources: (none)\n")
 		} else {
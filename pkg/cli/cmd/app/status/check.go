@@ -0,0 +1,111 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/schema"
+)
+
+const (
+	// endpointCheckTimeout is the timeout for checking whether an endpoint can be reached.
+	endpointCheckTimeout = 5 * time.Second
+
+	// reachableYes is the value of EndpointStatus.Reachable for an endpoint that can be reached.
+	reachableYes = "Yes"
+)
+
+// CheckEndpoints checks whether the public endpoints can be reached and sets their Reachable field. Internal endpoints
+// are only reachable from within the environment, so they are not checked.
+//
+// An HTTP or HTTPS endpoint is reachable if it responds with a status code below 500. Other endpoints are reachable if
+// a TCP connection can be opened to their host and port.
+func CheckEndpoints(ctx context.Context, client *http.Client, endpoints []clients.EndpointStatus) {
+	if client == nil {
+		client = &http.Client{Timeout: endpointCheckTimeout}
+	}
+
+	wg := sync.WaitGroup{}
+	for i := range endpoints {
+		if endpoints[i].Visibility != schema.EndpointVisibilityPublic {
+			continue
+		}
+
+		wg.Go(func() {
+			endpoints[i].Reachable = checkEndpoint(ctx, client, endpoints[i].Endpoint)
+		})
+	}
+	wg.Wait()
+}
+
+func checkEndpoint(ctx context.Context, client *http.Client, endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		// Endpoints like "example.com:5432" don't have a scheme.
+		return checkTCP(ctx, endpoint)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return checkTCP(ctx, u.Host)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return fmt.Sprintf("No (%s)", err.Error())
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Sprintf("No (%s)", unwrapURLError(err).Error())
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 500 {
+		return fmt.Sprintf("No (HTTP %d)", resp.StatusCode)
+	}
+
+	return reachableYes
+}
+
+func checkTCP(ctx context.Context, address string) string {
+	dialer := net.Dialer{Timeout: endpointCheckTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return fmt.Sprintf("No (%s)", err.Error())
+	}
+	_ = conn.Close()
+
+	return reachableYes
+}
+
+// unwrapURLError removes the method and URL from the errors returned by the HTTP client, since the URL is already
+// part of the output.
+func unwrapURLError(err error) error {
+	if urlErr, ok := err.(*url.Error); ok {
+		return urlErr.Err
+	}
+
+	return err
+}
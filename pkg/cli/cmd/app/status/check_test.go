@@ -0,0 +1,58 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CheckEndpoints(t *testing.T) {
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer healthy.Close()
+
+	unhealthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unhealthy.Close()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	endpoints := []clients.EndpointStatus{
+		{Name: "healthy", Visibility: "public", Endpoint: healthy.URL},
+		{Name: "unhealthy", Visibility: "public", Endpoint: unhealthy.URL},
+		{Name: "tcp", Visibility: "public", Endpoint: listener.Addr().String()},
+		{Name: "internal", Visibility: "internal", Endpoint: healthy.URL},
+	}
+
+	CheckEndpoints(context.Background(), healthy.Client(), endpoints)
+
+	require.Equal(t, "Yes", endpoints[0].Reachable)
+	require.Equal(t, "No (HTTP 503)", endpoints[1].Reachable)
+	require.Equal(t, "Yes", endpoints[2].Reachable)
+	require.Empty(t, endpoints[3].Reachable)
+}
@@ -34,18 +34,64 @@ func StatusFormat() output.FormatterOptions {
 	}
 }
 
-// GatewayFormat returns a FormatterOptions object which contains a list of columns to be used for
-// formatting the output of a list of application gateways.
-func GatewayFormat() output.FormatterOptions {
+// EndpointTableFormat returns a FormatterOptions object which contains a list of columns to be used for
+// formatting the output of a list of application endpoints. When checked is true, the reachability of the
+// endpoints is included.
+func EndpointTableFormat(checked bool) output.FormatterOptions {
+	columns := endpointColumns()
+	if checked {
+		columns = append(columns, output.Column{
+			Heading:  "REACHABLE",
+			JSONPath: "{ .Reachable }",
+		})
+	}
+
+	return output.FormatterOptions{
+		Columns: columns,
+	}
+}
+
+func endpointColumns() []output.Column {
+	return []output.Column{
+		{
+			Heading:  "RESOURCE",
+			JSONPath: "{ .Name }",
+		},
+		{
+			Heading:  "TYPE",
+			JSONPath: "{ .Type }",
+		},
+		{
+			Heading:  "VISIBILITY",
+			JSONPath: "{ .Visibility }",
+		},
+		{
+			Heading:  "ENDPOINT",
+			JSONPath: "{ .Endpoint }",
+		},
+	}
+}
+
+// DriftFormat returns a FormatterOptions object which contains a list of columns to be used for
+// formatting the output of a list of resources whose recipe-deployed infrastructure has drifted.
+func DriftFormat() output.FormatterOptions {
 	return output.FormatterOptions{
 		Columns: []output.Column{
 			{
-				Heading:  "GATEWAY",
+				Heading:  "DRIFTED RESOURCE",
 				JSONPath: "{ .Name }",
 			},
 			{
-				Heading:  "ENDPOINT",
-				JSONPath: "{ .Endpoint }",
+				Heading:  "TYPE",
+				JSONPath: "{ .Type }",
+			},
+			{
+				Heading:  "DRIFT",
+				JSONPath: "{ .State }",
+			},
+			{
+				Heading:  "MESSAGE",
+				JSONPath: "{ .Message }",
 			},
 		},
 	}
@@ -39,16 +39,42 @@ func Test_GetApplicationStatusTableFormat(t *testing.T) {
 	require.Equal(t, expected, buffer.String())
 }
 
-func Test_GetApplicationGatewaysTableFormat(t *testing.T) {
-	obj := clients.GatewayStatus{
-		Name:     "test",
-		Endpoint: "test-endpoint",
+func Test_GetApplicationEndpointsTableFormat(t *testing.T) {
+	obj := clients.EndpointStatus{
+		Name:       "test",
+		Type:       "Radius.Compute/routes",
+		Visibility: "public",
+		Endpoint:   "test-endpoint",
+		Reachable:  "Yes",
 	}
 
 	buffer := &bytes.Buffer{}
-	err := output.Write(output.FormatTable, obj, buffer, GatewayFormat())
+	err := output.Write(output.FormatTable, obj, buffer, EndpointTableFormat(false))
 	require.NoError(t, err)
 
-	expected := "GATEWAY   ENDPOINT\ntest      test-endpoint\n"
+	expected := "RESOURCE  TYPE                   VISIBILITY  ENDPOINT\ntest      Radius.Compute/routes  public      test-endpoint\n"
+	require.Equal(t, expected, buffer.String())
+
+	buffer = &bytes.Buffer{}
+	err = output.Write(output.FormatTable, obj, buffer, EndpointTableFormat(true))
+	require.NoError(t, err)
+
+	expected = "RESOURCE  TYPE                   VISIBILITY  ENDPOINT       REACHABLE\ntest      Radius.Compute/routes  public      test-endpoint  Yes\n"
+	require.Equal(t, expected, buffer.String())
+}
+
+func Test_GetApplicationDriftTableFormat(t *testing.T) {
+	obj := clients.DriftedResourceStatus{
+		Name:    "test",
+		Type:    "test-type",
+		State:   "Drifted",
+		Message: "test-message",
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, DriftFormat())
+	require.NoError(t, err)
+
+	expected := "DRIFTED RESOURCE  TYPE       DRIFT     MESSAGE\ntest              test-type  Drifted   test-message\n"
 	require.Equal(t, expected, buffer.String())
 }
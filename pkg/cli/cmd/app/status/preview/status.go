@@ -43,7 +43,7 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	cmd := &cobra.Command{
 		Use:   "status",
 		Short: "Show Radius Application status (preview)",
-		Long:  `Show Radius.Core application status using the preview API surface, including resource count and public endpoints.`,
+		Long:  `Show Radius.Core application status using the preview API surface, including resource count and endpoints.`,
 		Args:  cobra.MaximumNArgs(1),
 		Example: `
 # Show status of specified application
@@ -59,6 +59,7 @@ rad app status my-app --group my-group --preview
 	commonflags.AddResourceGroupFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().Bool("check", false, "Check whether the public endpoints of the application can be reached")
 
 	return cmd, runner
 }
@@ -73,6 +74,7 @@ type Runner struct {
 
 	ApplicationName string
 	Format          string
+	Check           bool
 }
 
 // NewRunner creates a new instance of the preview status runner.
@@ -107,6 +109,11 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	r.Check, err = cmd.Flags().GetBool("check")
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -147,7 +154,7 @@ func (r *Runner) Run(ctx context.Context) error {
 		ResourceCount: len(resourceList),
 	}
 
-	// Gather public endpoints from gateway resources.
+	// Gather the endpoints of the resources.
 	diagnosticsClient, err := r.ConnectionFactory.CreateDiagnosticsClient(ctx, *r.Workspace)
 	if err != nil {
 		return err
@@ -159,29 +166,37 @@ func (r *Runner) Run(ctx context.Context) error {
 			return err
 		}
 
-		publicEndpoint, err := diagnosticsClient.GetPublicEndpoint(ctx, clients.EndpointOptions{
+		endpoints, err := diagnosticsClient.GetEndpoints(ctx, clients.EndpointOptions{
 			ResourceID: resourceID,
+			Properties: resource.Properties,
 		})
 		if err != nil {
 			return err
 		}
 
-		if publicEndpoint != nil {
-			applicationStatus.Gateways = append(applicationStatus.Gateways, clients.GatewayStatus{
-				Name:     *resource.Name,
-				Endpoint: *publicEndpoint,
+		for _, endpoint := range endpoints {
+			applicationStatus.Endpoints = append(applicationStatus.Endpoints, clients.EndpointStatus{
+				Name:       *resource.Name,
+				Type:       resourceID.Type(),
+				Property:   endpoint.Name,
+				Visibility: endpoint.Visibility,
+				Endpoint:   endpoint.URL,
 			})
 		}
 	}
 
+	if r.Check {
+		status.CheckEndpoints(ctx, nil, applicationStatus.Endpoints)
+	}
+
 	err = r.Output.WriteFormatted(r.Format, applicationStatus, status.StatusFormat())
 	if err != nil {
 		return err
 	}
 
-	if r.Format == output.FormatTable && len(applicationStatus.Gateways) > 0 {
+	if r.Format == output.FormatTable && len(applicationStatus.Endpoints) > 0 {
 		r.Output.LogInfo("")
-		err = r.Output.WriteFormatted(r.Format, applicationStatus.Gateways, status.GatewayFormat())
+		err = r.Output.WriteFormatted(r.Format, applicationStatus.Endpoints, status.EndpointTableFormat(r.Check))
 		if err != nil {
 			return err
 		}
@@ -142,7 +142,7 @@ func Test_Run(t *testing.T) {
 		require.Equal(t, 0, appStatus.ResourceCount)
 	})
 
-	t.Run("Success: application with resources and gateways", func(t *testing.T) {
+	t.Run("Success: application with resources and endpoints", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -156,6 +156,10 @@ func Test_Run(t *testing.T) {
 		gatewayID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/gw"
 		gatewayName := "gw"
 		gatewayType := "Applications.Core/gateways"
+		routeID := "/planes/radius/local/resourceGroups/test-group/providers/Radius.Compute/routes/frontend"
+		routeName := "frontend"
+		routeType := "Radius.Compute/routes"
+		routeProperties := map[string]any{"url": "https://frontend.example.com"}
 
 		mockMgmt := clients.NewMockApplicationsManagementClient(ctrl)
 		mockMgmt.EXPECT().
@@ -163,21 +167,29 @@ func Test_Run(t *testing.T) {
 			Return([]generated.GenericResource{
 				{ID: &containerID, Name: &containerName, Type: &containerType},
 				{ID: &gatewayID, Name: &gatewayName, Type: &gatewayType},
+				{ID: &routeID, Name: &routeName, Type: &routeType, Properties: routeProperties},
 			}, nil).
 			Times(1)
 
 		containerParsedID := mustParse(t, containerID)
 		gatewayParsedID := mustParse(t, gatewayID)
-		endpoint := "http://gw.example.com"
+		routeParsedID := mustParse(t, routeID)
 
 		mockDiag := clients.NewMockDiagnosticsClient(ctrl)
 		mockDiag.EXPECT().
-			GetPublicEndpoint(gomock.Any(), clients.EndpointOptions{ResourceID: containerParsedID}).
+			GetEndpoints(gomock.Any(), clients.EndpointOptions{ResourceID: containerParsedID}).
 			Return(nil, nil).
 			Times(1)
 		mockDiag.EXPECT().
-			GetPublicEndpoint(gomock.Any(), clients.EndpointOptions{ResourceID: gatewayParsedID}).
-			Return(&endpoint, nil).
+			GetEndpoints(gomock.Any(), clients.EndpointOptions{ResourceID: gatewayParsedID}).
+			Return([]clients.Endpoint{{Name: "url", Visibility: "public", URL: "http://gw.example.com"}}, nil).
+			Times(1)
+		mockDiag.EXPECT().
+			GetEndpoints(gomock.Any(), clients.EndpointOptions{ResourceID: routeParsedID, Properties: routeProperties}).
+			Return([]clients.Endpoint{
+				{Name: "url", Visibility: "public", URL: "https://frontend.example.com"},
+				{Name: "internalUrl", Visibility: "internal", URL: "http://frontend.default.svc:80"},
+			}, nil).
 			Times(1)
 
 		outputSink := &output.MockOutput{}
@@ -196,7 +208,7 @@ func Test_Run(t *testing.T) {
 		err = runner.Run(t.Context())
 		require.NoError(t, err)
 
-		// Should have: status table, blank line, gateway table
+		// Should have: status table, blank line, endpoint table
 		require.Len(t, outputSink.Writes, 3)
 
 		// Verify status
@@ -204,15 +216,17 @@ func Test_Run(t *testing.T) {
 		require.True(t, ok)
 		appStatus, ok := formatted.Obj.(clients.ApplicationStatus)
 		require.True(t, ok)
-		require.Equal(t, 2, appStatus.ResourceCount)
-		require.Len(t, appStatus.Gateways, 1)
-		require.Equal(t, "gw", appStatus.Gateways[0].Name)
-		require.Equal(t, "http://gw.example.com", appStatus.Gateways[0].Endpoint)
-
-		// Verify gateway table format
-		gwFormatted, ok := outputSink.Writes[2].(output.FormattedOutput)
+		require.Equal(t, 3, appStatus.ResourceCount)
+		require.Equal(t, []clients.EndpointStatus{
+			{Name: "gw", Type: "Applications.Core/gateways", Property: "url", Visibility: "public", Endpoint: "http://gw.example.com"},
+			{Name: "frontend", Type: "Radius.Compute/routes", Property: "url", Visibility: "public", Endpoint: "https://frontend.example.com"},
+			{Name: "frontend", Type: "Radius.Compute/routes", Property: "internalUrl", Visibility: "internal", Endpoint: "http://frontend.default.svc:80"},
+		}, appStatus.Endpoints)
+
+		// Verify endpoint table format
+		endpointsFormatted, ok := outputSink.Writes[2].(output.FormattedOutput)
 		require.True(t, ok)
-		require.Equal(t, status.GatewayFormat(), gwFormatted.Options)
+		require.Equal(t, status.EndpointTableFormat(false), endpointsFormatted.Options)
 	})
 
 	t.Run("Error: application not found (404)", func(t *testing.T) {
@@ -21,12 +21,14 @@ import (
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/spf13/cobra"
 )
@@ -38,14 +40,19 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	cmd := &cobra.Command{
 		Use:   "status",
 		Short: "Show Radius Application status",
-		Long:  `Show Radius Application status, such as public endpoints and resource count.`,
-		Args:  cobra.MaximumNArgs(1),
+		Long: `Show Radius Application status, such as endpoints, resource count, and resources whose recipe-deployed infrastructure has drifted.
+
+Endpoints are the URLs of gateways, and the values of the properties marked with the x-radius-endpoint annotation in the schema of a resource type. Use --check to check whether the public endpoints can be reached.`,
+		Args: cobra.MaximumNArgs(1),
 		Example: `
 # Show status of specified application
 rad app status my-app
 
 # Show status of specified application in a specified resource group
 rad app status my-app --group my-group
+
+# Show status of specified application and check whether its public endpoints can be reached
+rad app status my-app --check
 `,
 		RunE: framework.RunCommand(runner),
 	}
@@ -54,6 +61,7 @@ rad app status my-app --group my-group
 	commonflags.AddResourceGroupFlag(cmd)
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().Bool("check", false, "Check whether the public endpoints of the application can be reached")
 
 	return cmd, runner
 }
@@ -67,6 +75,7 @@ type Runner struct {
 
 	ApplicationName string
 	Format          string
+	Check           bool
 }
 
 // NewRunner creates an instance of the runner for the `rad app status` command.
@@ -109,13 +118,18 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 
 	r.Format = format
 
+	r.Check, err = cmd.Flags().GetBool("check")
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
 // Run runs the `rad app status` command.
 //
 
-// Run() retrieves the application status and its associated gateways from the given workspace and returns it in the specified format.
+// Run() retrieves the application status and the endpoints of its resources from the given workspace and returns it in the specified format.
 // It returns an error if the application is not found or if there is an error while retrieving the application status.
 func (r *Runner) Run(ctx context.Context) error {
 	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
@@ -151,19 +165,31 @@ func (r *Runner) Run(ctx context.Context) error {
 			return err
 		}
 
-		publicEndpoint, err := diagnosticsClient.GetPublicEndpoint(ctx, clients.EndpointOptions{
+		endpoints, err := diagnosticsClient.GetEndpoints(ctx, clients.EndpointOptions{
 			ResourceID: resourceID,
+			Properties: resource.Properties,
 		})
 		if err != nil {
 			return err
 		}
 
-		if publicEndpoint != nil {
-			applicationStatus.Gateways = append(applicationStatus.Gateways, clients.GatewayStatus{
-				Name:     *resource.Name,
-				Endpoint: *publicEndpoint,
+		for _, endpoint := range endpoints {
+			applicationStatus.Endpoints = append(applicationStatus.Endpoints, clients.EndpointStatus{
+				Name:       *resource.Name,
+				Type:       resourceID.Type(),
+				Property:   endpoint.Name,
+				Visibility: endpoint.Visibility,
+				Endpoint:   endpoint.URL,
 			})
 		}
+
+		if drifted, ok := driftedResourceStatus(resource); ok {
+			applicationStatus.DriftedResources = append(applicationStatus.DriftedResources, drifted)
+		}
+	}
+
+	if r.Check {
+		CheckEndpoints(ctx, nil, applicationStatus.Endpoints)
 	}
 
 	err = r.Output.WriteFormatted(r.Format, applicationStatus, StatusFormat())
@@ -171,11 +197,21 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
-	if r.Format == output.FormatTable && len(applicationStatus.Gateways) > 0 {
+	if r.Format == output.FormatTable && len(applicationStatus.Endpoints) > 0 {
+		// Print newline for readability
+		r.Output.LogInfo("")
+
+		err = r.Output.WriteFormatted(r.Format, applicationStatus.Endpoints, EndpointTableFormat(r.Check))
+		if err != nil {
+			return err
+		}
+	}
+
+	if r.Format == output.FormatTable && len(applicationStatus.DriftedResources) > 0 {
 		// Print newline for readability
 		r.Output.LogInfo("")
 
-		err = r.Output.WriteFormatted(r.Format, applicationStatus.Gateways, GatewayFormat())
+		err = r.Output.WriteFormatted(r.Format, applicationStatus.DriftedResources, DriftFormat())
 		if err != nil {
 			return err
 		}
@@ -183,3 +219,23 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// driftedResourceStatus returns the drift status of a resource whose recipe-deployed infrastructure
+// has drifted or is being remediated.
+func driftedResourceStatus(resource generated.GenericResource) (clients.DriftedResourceStatus, bool) {
+	status, _ := resource.Properties["status"].(map[string]any)
+	recipe, _ := status["recipe"].(map[string]any)
+	drift, _ := recipe["drift"].(map[string]any)
+	state, _ := drift["state"].(string)
+	if state != "Drifted" && state != "Remediating" {
+		return clients.DriftedResourceStatus{}, false
+	}
+
+	message, _ := drift["message"].(string)
+	return clients.DriftedResourceStatus{
+		Name:    to.String(resource.Name),
+		Type:    to.String(resource.Type),
+		State:   state,
+		Message: message,
+	}, true
+}
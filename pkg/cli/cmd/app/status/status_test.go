@@ -112,6 +112,21 @@ func Test_Run(t *testing.T) {
 				Name: new("test-gateway"),
 				ID:   new("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/test-gateway"),
 			},
+			{
+				Name: new("test-redis"),
+				Type: new("Applications.Datastores/redisCaches"),
+				ID:   new("/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/test-redis"),
+				Properties: map[string]any{
+					"status": map[string]any{
+						"recipe": map[string]any{
+							"drift": map[string]any{
+								"state":   "Drifted",
+								"message": "1 resource(s) deployed by the recipe no longer exist",
+							},
+						},
+					},
+				},
+			},
 		}
 
 		appManagementClient.EXPECT().
@@ -121,13 +136,18 @@ func Test_Run(t *testing.T) {
 
 		diagnosticsClient := clients.NewMockDiagnosticsClient(ctrl)
 		diagnosticsClient.EXPECT().
-			GetPublicEndpoint(gomock.Any(), clients.EndpointOptions{ResourceID: mustParse(t, "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test-container")}).
+			GetEndpoints(gomock.Any(), clients.EndpointOptions{ResourceID: mustParse(t, "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/test-container")}).
 			Return(nil, nil).
 			Times(1)
 
 		diagnosticsClient.EXPECT().
-			GetPublicEndpoint(gomock.Any(), clients.EndpointOptions{ResourceID: mustParse(t, "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/test-gateway")}).
-			Return(new("http://some-url.example.com"), nil).
+			GetEndpoints(gomock.Any(), clients.EndpointOptions{ResourceID: mustParse(t, "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/test-gateway")}).
+			Return([]clients.Endpoint{{Name: "url", Visibility: "public", URL: "http://some-url.example.com"}}, nil).
+			Times(1)
+
+		diagnosticsClient.EXPECT().
+			GetEndpoints(gomock.Any(), clients.EndpointOptions{ResourceID: mustParse(t, "/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/test-redis"), Properties: resourceList[2].Properties}).
+			Return(nil, nil).
 			Times(1)
 
 		workspace := &workspaces.Workspace{
@@ -155,11 +175,22 @@ func Test_Run(t *testing.T) {
 
 		applicationStatus := clients.ApplicationStatus{
 			Name:          "test-app",
-			ResourceCount: 2,
-			Gateways: []clients.GatewayStatus{
+			ResourceCount: 3,
+			Endpoints: []clients.EndpointStatus{
+				{
+					Name:       "test-gateway",
+					Type:       "Applications.Core/gateways",
+					Property:   "url",
+					Visibility: "public",
+					Endpoint:   "http://some-url.example.com",
+				},
+			},
+			DriftedResources: []clients.DriftedResourceStatus{
 				{
-					Name:     "test-gateway",
-					Endpoint: "http://some-url.example.com",
+					Name:    "test-redis",
+					Type:    "Applications.Datastores/redisCaches",
+					State:   "Drifted",
+					Message: "1 resource(s) deployed by the recipe no longer exist",
 				},
 			},
 		}
@@ -175,8 +206,16 @@ func Test_Run(t *testing.T) {
 			},
 			output.FormattedOutput{
 				Format:  "table",
-				Obj:     applicationStatus.Gateways,
-				Options: GatewayFormat(),
+				Obj:     applicationStatus.Endpoints,
+				Options: EndpointTableFormat(false),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     applicationStatus.DriftedResources,
+				Options: DriftFormat(),
 			},
 		}
 
@@ -0,0 +1,187 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package serviceaccountkey
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/credential/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	cli_credential "github.com/radius-project/radius/pkg/cli/credential"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// serviceAccountKeyType is the value of the "type" field in a GCP service account key file.
+	serviceAccountKeyType = "service_account"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad credential register gcp service-account-key` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:     "service-account-key",
+		Aliases: []string{"sa"},
+		Short:   "Register (Add or update) GCP cloud provider credential for a Radius installation using a service account key.",
+		Long: `Register (Add or update) GCP cloud provider credential for a Radius installation using a service account key.
+
+This command is intended for scripting or advanced use-cases. See 'rad init' for a user-friendly way
+to configure these settings.
+
+Radius will use the provided service account key for all interactions with GCP. The key file is the JSON
+key downloaded from the GCP console or created with 'gcloud iam service-accounts keys create'.
+` + common.LongDescriptionBlurb,
+		Example: `
+# Register (Add or update) cloud provider credential for GCP with a service account key
+rad credential register gcp service-account-key --key-file ./key.json
+`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+
+	cmd.Flags().String("key-file", "", "The path to the GCP service account key file (JSON).")
+	_ = cmd.MarkFlagRequired("key-file")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad credential register gcp service-account-key` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Format            string
+	Workspace         *workspaces.Workspace
+
+	ServiceAccountKey string
+	KubeContext       string
+}
+
+// NewRunner creates a new instance of the `rad credential register gcp service-account-key` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad credential register gcp service-account-key` command.
+//
+// Validate checks that the workspace and output format are valid and that the key file contains a GCP
+// service account key.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	keyFile, err := cmd.Flags().GetString("key-file")
+	if err != nil {
+		return err
+	}
+	if keyFile == "" {
+		return clierrors.Message("Key file %q cannot be empty.", keyFile)
+	}
+
+	key, err := readServiceAccountKey(keyFile)
+	if err != nil {
+		return err
+	}
+	r.ServiceAccountKey = key
+
+	kubeContext, ok := r.Workspace.KubernetesContext()
+	if !ok {
+		return clierrors.Message("A Kubernetes connection is required.")
+	}
+	r.KubeContext = kubeContext
+	return nil
+}
+
+// Run runs the `rad credential register gcp service-account-key` command.
+func (r *Runner) Run(ctx context.Context) error {
+	r.Output.LogInfo("Registering credential for %q cloud provider in Radius installation %q...", "gcp", r.Workspace.FmtConnection())
+	client, err := r.ConnectionFactory.CreateCredentialManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	credential := ucp.GcpCredentialResource{
+		Location: to.Ptr(v1.LocationGlobal),
+		Type:     to.Ptr(cli_credential.GCPCredential),
+		Properties: &ucp.GcpServiceAccountKeyCredentialProperties{
+			Storage: &ucp.CredentialStorageProperties{
+				Kind: to.Ptr(ucp.CredentialStorageKindInternal),
+			},
+			ServiceAccountKey: &r.ServiceAccountKey,
+		},
+	}
+
+	err = client.PutGCP(ctx, credential)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Successfully registered credential for %q cloud provider. Tokens may take up to 30 seconds to refresh.", "gcp")
+
+	return nil
+}
+
+// readServiceAccountKey reads the key file and checks that it is a GCP service account key.
+func readServiceAccountKey(keyFile string) (string, error) {
+	b, err := os.ReadFile(keyFile)
+	if err != nil {
+		return "", clierrors.Message("Unable to read key file %q: %v.", keyFile, err)
+	}
+
+	key := struct {
+		Type        string `json:"type"`
+		ClientEmail string `json:"client_email"`
+		PrivateKey  string `json:"private_key"`
+	}{}
+	if err := json.Unmarshal(b, &key); err != nil {
+		return "", clierrors.Message("Key file %q is not valid JSON.", keyFile)
+	}
+
+	if key.Type != serviceAccountKeyType || key.ClientEmail == "" || key.PrivateKey == "" {
+		return "", clierrors.Message("Key file %q is not a GCP service account key.", keyFile)
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadidentity
+
+import (
+	"context"
+	"net/mail"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/credential/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	cli_credential "github.com/radius-project/radius/pkg/cli/credential"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad credential register gcp workload-identity` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:     "workload-identity",
+		Aliases: []string{"wi"},
+		Short:   "Register (Add or update) GCP cloud provider credential for a Radius installation using workload identity federation.",
+		Long: `Register (Add or update) GCP cloud provider credential for a Radius installation using workload identity federation.
+
+This command is intended for scripting or advanced use-cases. See 'rad init' for a user-friendly way
+to configure these settings.
+
+Radius will exchange its Kubernetes service account token with the given workload identity pool provider
+and impersonate the given GCP service account for all interactions with GCP. The audience has the form
+'//iam.googleapis.com/projects/<project-number>/locations/global/workloadIdentityPools/<pool>/providers/<provider>'.
+` + common.LongDescriptionBlurb,
+		Example: `
+# Register (Add or update) cloud provider credential for GCP with workload identity federation
+rad credential register gcp workload-identity --audience <audience> --service-account-email <service-account-email>
+`,
+		Args: cobra.ExactArgs(0),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+
+	cmd.Flags().String("audience", "", "The audience of the GCP workload identity pool provider.")
+	_ = cmd.MarkFlagRequired("audience")
+
+	cmd.Flags().String("service-account-email", "", "The email of the GCP service account to impersonate.")
+	_ = cmd.MarkFlagRequired("service-account-email")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad credential register gcp workload-identity` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	Output            output.Interface
+	Format            string
+	Workspace         *workspaces.Workspace
+
+	Audience            string
+	ServiceAccountEmail string
+	KubeContext         string
+}
+
+// NewRunner creates a new instance of the `rad credential register gcp workload-identity` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad credential register gcp workload-identity` command.
+//
+// Validate checks that the workspace and output format are valid and that the audience and service
+// account email are provided.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	audience, err := cmd.Flags().GetString("audience")
+	if err != nil {
+		return err
+	}
+	serviceAccountEmail, err := cmd.Flags().GetString("service-account-email")
+	if err != nil {
+		return err
+	}
+	r.Audience = audience
+	r.ServiceAccountEmail = serviceAccountEmail
+
+	if r.Audience == "" {
+		return clierrors.Message("Audience %q cannot be empty.", r.Audience)
+	}
+	if _, err := mail.ParseAddress(r.ServiceAccountEmail); err != nil {
+		return clierrors.Message("Service account email %q is not a valid email address.", r.ServiceAccountEmail)
+	}
+
+	kubeContext, ok := r.Workspace.KubernetesContext()
+	if !ok {
+		return clierrors.Message("A Kubernetes connection is required.")
+	}
+	r.KubeContext = kubeContext
+	return nil
+}
+
+// Run runs the `rad credential register gcp workload-identity` command.
+func (r *Runner) Run(ctx context.Context) error {
+	r.Output.LogInfo("Registering credential for %q cloud provider in Radius installation %q...", "gcp", r.Workspace.FmtConnection())
+	client, err := r.ConnectionFactory.CreateCredentialManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	credential := ucp.GcpCredentialResource{
+		Location: to.Ptr(v1.LocationGlobal),
+		Type:     to.Ptr(cli_credential.GCPCredential),
+		Properties: &ucp.GcpWorkloadIdentityCredentialProperties{
+			Storage: &ucp.CredentialStorageProperties{
+				Kind: to.Ptr(ucp.CredentialStorageKindInternal),
+			},
+			Audience:            &r.Audience,
+			ServiceAccountEmail: &r.ServiceAccountEmail,
+		},
+	}
+
+	err = client.PutGCP(ctx, credential)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Successfully registered credential for %q cloud provider. Tokens may take up to 30 seconds to refresh.", "gcp")
+
+	return nil
+}
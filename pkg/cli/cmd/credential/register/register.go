@@ -20,6 +20,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/cmd/credential/common"
 	credential_register_aws "github.com/radius-project/radius/pkg/cli/cmd/credential/register/aws"
 	credential_register_azure "github.com/radius-project/radius/pkg/cli/cmd/credential/register/azure"
+	credential_register_gcp "github.com/radius-project/radius/pkg/cli/cmd/credential/register/gcp"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/spf13/cobra"
 )
@@ -27,7 +28,7 @@ import (
 // NewCommand creates an instance of the command for the `rad credential create` command.
 //
 
-// NewCommand() creates a new command for registering cloud provider credentials and adds subcommands for Azure and AWS.
+// NewCommand() creates a new command for registering cloud provider credentials and adds subcommands for Azure, AWS and GCP.
 func NewCommand(factory framework.Factory) *cobra.Command {
 	// This command is not runnable, and thus has no runner.
 	cmd := &cobra.Command{
@@ -43,6 +44,10 @@ rad credential register azure wi --client-id <client id> --tenant-id <tenant id>
 rad credential register aws access-key --access-key-id <access-key-id> --secret-access-key <secret-access-key>
 # Register (Add or update) cloud provider credential for AWS with IRSA (IAM Roles for service Accounts).
 rad credential register aws irsa --iam-role <roleARN>
+# Register (Add or update) cloud provider credential for GCP with a service account key.
+rad credential register gcp service-account-key --key-file <path-to-key-file>
+# Register (Add or update) cloud provider credential for GCP with workload identity federation.
+rad credential register gcp workload-identity --audience <audience> --service-account-email <service-account-email>
 `,
 	}
 
@@ -52,5 +57,8 @@ rad credential register aws irsa --iam-role <roleARN>
 	aws := credential_register_aws.NewCommand(factory)
 	cmd.AddCommand(aws)
 
+	gcp := credential_register_gcp.NewCommand(factory)
+	cmd.AddCommand(gcp)
+
 	return cmd
 }
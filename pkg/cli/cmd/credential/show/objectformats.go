@@ -119,3 +119,49 @@ func credentialFormatAWSIRSA() output.FormatterOptions {
 		},
 	}
 }
+
+func credentialFormatGCPServiceAccountKey() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "REGISTERED",
+				JSONPath: "{ .Enabled }",
+			},
+			{
+				Heading:  "KIND",
+				JSONPath: "{ .GCPCredentials.Kind }",
+			},
+		},
+	}
+}
+
+func credentialFormatGCPWorkloadIdentity() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "NAME",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "REGISTERED",
+				JSONPath: "{ .Enabled }",
+			},
+			{
+				Heading:  "KIND",
+				JSONPath: "{ .GCPCredentials.Kind }",
+			},
+			{
+				Heading:  "SERVICEACCOUNTEMAIL",
+				JSONPath: "{ .GCPCredentials.WorkloadIdentity.ServiceAccountEmail }",
+			},
+			{
+				Heading:  "AUDIENCE",
+				JSONPath: "{ .GCPCredentials.WorkloadIdentity.Audience }",
+			},
+		},
+	}
+}
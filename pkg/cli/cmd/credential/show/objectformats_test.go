@@ -124,3 +124,51 @@ func Test_credentialFormatAWSIRSA(t *testing.T) {
 	expected := "NAME      REGISTERED  KIND      ROLEARN\ntest      true        IRSA      test-role-arn\n"
 	require.Equal(t, expected, buffer.String())
 }
+
+func Test_credentialFormatGCPServiceAccountKey(t *testing.T) {
+	obj := credential.ProviderCredentialConfiguration{
+		CloudProviderStatus: credential.CloudProviderStatus{
+			Name:    "test",
+			Enabled: true,
+		},
+		GCPCredentials: &credential.GCPCredentialProperties{
+			Kind:              new("ServiceAccountKey"),
+			ServiceAccountKey: &credential.GCPServiceAccountKeyCredentialProperties{},
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	credentialFormatOutput := credentialFormatGCPServiceAccountKey()
+
+	err := output.Write(output.FormatTable, obj, buffer, credentialFormatOutput)
+	require.NoError(t, err)
+
+	expected := "NAME      REGISTERED  KIND\ntest      true        ServiceAccountKey\n"
+	require.Equal(t, expected, buffer.String())
+}
+
+func Test_credentialFormatGCPWorkloadIdentity(t *testing.T) {
+	obj := credential.ProviderCredentialConfiguration{
+		CloudProviderStatus: credential.CloudProviderStatus{
+			Name:    "test",
+			Enabled: true,
+		},
+		GCPCredentials: &credential.GCPCredentialProperties{
+			Kind: new("WorkloadIdentity"),
+			WorkloadIdentity: &credential.GCPWorkloadIdentityCredentialProperties{
+				Kind:                new("WorkloadIdentity"),
+				Audience:            new("test-audience"),
+				ServiceAccountEmail: new("sa@test.iam.gserviceaccount.com"),
+			},
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	credentialFormatOutput := credentialFormatGCPWorkloadIdentity()
+
+	err := output.Write(output.FormatTable, obj, buffer, credentialFormatOutput)
+	require.NoError(t, err)
+
+	expected := "NAME      REGISTERED  KIND              SERVICEACCOUNTEMAIL              AUDIENCE\ntest      true        WorkloadIdentity  sa@test.iam.gserviceaccount.com  test-audience\n"
+	require.Equal(t, expected, buffer.String())
+}
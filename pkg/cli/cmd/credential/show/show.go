@@ -50,6 +50,9 @@ rad credential show azure
 
 # Show cloud providers details for AWS
 rad credential show aws
+
+# Show cloud providers details for GCP
+rad credential show gcp
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -148,6 +151,15 @@ func (r *Runner) Run(ctx context.Context) error {
 		default:
 			return fmt.Errorf("unknown AWS credential kind, expected AccessKey or IRSA (got %s)", *providers.AWSCredentials.Kind)
 		}
+	case "gcp":
+		switch *providers.GCPCredentials.Kind {
+		case datamodel.GCPServiceAccountKeyCredentialKind:
+			output = credentialFormatGCPServiceAccountKey()
+		case datamodel.GCPWorkloadIdentityCredentialKind:
+			output = credentialFormatGCPWorkloadIdentity()
+		default:
+			return fmt.Errorf("unknown GCP credential kind, expected ServiceAccountKey or WorkloadIdentity (got %s)", *providers.GCPCredentials.Kind)
+		}
 	default:
 		return fmt.Errorf("unknown credential type: %s", r.Kind)
 	}
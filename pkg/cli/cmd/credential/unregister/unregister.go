@@ -47,6 +47,9 @@ rad credential unregister azure
 
 # Unregister AWS cloud provider credential
 rad credential unregister aws
+
+# Unregister GCP cloud provider credential
+rad credential unregister gcp
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
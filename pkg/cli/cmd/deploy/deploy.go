@@ -122,6 +122,9 @@ rad deploy myapp.bicep --parameters @myfile.json
 
 # specify parameters from multiple sources
 rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
+
+# stream the recipe execution logs of the resources while they are being deployed
+rad deploy myapp.bicep --recipe-logs
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
@@ -133,6 +136,7 @@ rad deploy myapp.bicep --parameters @myfile.json --parameters version=latest
 	commonflags.AddApplicationNameFlag(cmd)
 	commonflags.AddParameterFlag(cmd)
 	cmd.Flags().Bool("preview", false, "Deploy the application using the Radius.Core/applications resource type instead of Applications.Core/applications (can also be set via RADIUS_PREVIEW=true)")
+	cmd.Flags().Bool("recipe-logs", false, "Stream the recipe execution logs of the resources while they are being deployed")
 
 	return cmd, runner
 }
@@ -161,6 +165,8 @@ type Runner struct {
 	// Preview indicates that the application should be deployed using the
 	// Radius.Core/applications resource type instead of Applications.Core/applications.
 	Preview bool
+	// RecipeLogs indicates that the recipe execution logs of the resources should be streamed during the deployment.
+	RecipeLogs bool
 }
 
 // NewRunner creates a new instance of the `rad deploy` runner.
@@ -245,6 +251,14 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	// Commands that reuse this runner, such as `rad run`, do not define the --recipe-logs flag.
+	if cmd.Flags().Lookup("recipe-logs") != nil {
+		r.RecipeLogs, err = cmd.Flags().GetBool("recipe-logs")
+		if err != nil {
+			return err
+		}
+	}
+
 	// The --preview flag only affects the application resource, so it requires an application.
 	// Reject only when the flag was set explicitly on the command line; env-var activation
 	// (RADIUS_PREVIEW=true) is intentionally tolerated as a no-op so it can be set globally
@@ -364,6 +378,7 @@ func (r *Runner) Run(ctx context.Context) error {
 		ProgressText:      progressText,
 		CompletionText:    "Deployment Complete",
 		Providers:         r.Providers,
+		RecipeLogs:        r.RecipeLogs,
 	})
 	if err != nil {
 		return addDeploymentErrorContext(err, template)
@@ -98,6 +98,30 @@ func Test_Validate(t *testing.T) {
 
 			},
 		},
+		{
+			Name:          "rad deploy - valid with recipe logs",
+			Input:         []string{"app.bicep", "--recipe-logs"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ConfigureMocks: func(mocks radcli.ValidateMocks) {
+				mocks.Bicep.EXPECT().
+					PrepareTemplate("app.bicep").
+					Return(map[string]any{}, nil).
+					Times(1)
+				mocks.ApplicationManagementClient.EXPECT().
+					GetEnvironment(gomock.Any(), radcli.TestEnvironmentID).
+					Return(v20231001preview.EnvironmentResource{
+						ID: to.Ptr(radcli.TestEnvironmentID),
+					}, nil).
+					Times(1)
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.True(t, runner.(*Runner).RecipeLogs)
+			},
+		},
 		{
 			Name:          "rad deploy - valid without application name",
 			Input:         []string{"app.bicep", "-e", "/planes/radius/local/resourceGroups/test-resource-group/providers/Applications.Core/environments/prod"},
@@ -19,14 +19,19 @@ package delete
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/delete"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/prompt"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/spf13/cobra"
 )
 
@@ -46,8 +51,12 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 	cmd := &cobra.Command{
 		Use:   "delete",
 		Short: "Delete environment",
-		Long:  `Delete environment. Deletes the user's default environment by default.`,
-		Args:  cobra.MaximumNArgs(1),
+		Long: `Delete environment. Deletes the user's default environment by default.
+
+The applications of the environment are deleted first, one at a time. The resources of each application are deleted in
+dependency order, like 'rad app delete'. Resources of the environment that don't belong to an application are not
+ordered.`,
+		Args: cobra.MaximumNArgs(1),
 		Example: `
 # Delete current environment
 rad env delete
@@ -60,6 +69,9 @@ rad env delete my-env
 
 # Delete specified environment in a specified resource group
 rad env delete my-env --group my-env
+
+# Print the order in which the applications and resources of an environment would be deleted, without deleting anything
+rad env delete my-env --dry-run
 `,
 		RunE: framework.RunCommand(runner),
 	}
@@ -69,6 +81,7 @@ rad env delete my-env --group my-env
 	commonflags.AddEnvironmentNameFlag(cmd)
 	commonflags.AddConfirmationFlag(cmd)
 	commonflags.AddOutputFlag(cmd)
+	cmd.Flags().Bool("dry-run", false, "Print the order in which the applications and resources of the environment would be deleted, without deleting anything")
 
 	return cmd, runner
 }
@@ -82,6 +95,7 @@ type Runner struct {
 	InputPrompter     prompt.Interface
 
 	Confirm         bool
+	DryRun          bool
 	EnvironmentName string
 	Format          string
 }
@@ -122,6 +136,11 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	r.DryRun, err = cmd.Flags().GetBool("dry-run")
+	if err != nil {
+		return err
+	}
+
 	format, err := cli.RequireOutput(cmd)
 	if err != nil {
 		return err
@@ -148,6 +167,10 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	totalResourceCount := len(resourcesInEnvironment)
 
+	if r.DryRun {
+		return r.displayDeletePlan(ctx, client, resourcesInEnvironment)
+	}
+
 	// Prompt user to confirm deletion
 	if !r.Confirm {
 		var promptMsg string
@@ -181,3 +204,45 @@ func (r *Runner) Run(ctx context.Context) error {
 
 	return nil
 }
+
+// displayDeletePlan prints the order in which the applications of the environment and their resources would be
+// deleted, followed by the resources of the environment that don't belong to an application.
+func (r *Runner) displayDeletePlan(ctx context.Context, client clients.ApplicationsManagementClient, resourcesInEnvironment []generated.GenericResource) error {
+	applications, err := client.ListApplicationsInEnvironment(ctx, r.EnvironmentName)
+	if err != nil {
+		return err
+	}
+
+	for _, application := range applications {
+		plan, err := client.PlanApplicationDelete(ctx, *application.ID)
+		if err != nil {
+			return err
+		}
+
+		delete.DisplayDeletePlan(r.Output, *application.Name, plan)
+		r.Output.LogInfo("")
+		r.Output.LogInfo("Applications.Core/applications/%s would be deleted", *application.Name)
+		r.Output.LogInfo("")
+	}
+
+	unordered := []generated.GenericResource{}
+	for _, resource := range resourcesInEnvironment {
+		if strings.EqualFold(to.String(resource.Type), "Applications.Core/applications") {
+			continue
+		}
+		if application, _ := resource.Properties["application"].(string); application == "" {
+			unordered = append(unordered, resource)
+		}
+	}
+
+	if len(unordered) > 0 {
+		r.Output.LogInfo("The following resources of environment '%s' don't belong to an application and are not ordered:", r.EnvironmentName)
+		for _, resource := range unordered {
+			r.Output.LogInfo("  %s (%s)", to.String(resource.Name), to.String(resource.Type))
+		}
+		r.Output.LogInfo("")
+	}
+
+	r.Output.LogInfo("Applications.Core/environments/%s would be deleted", r.EnvironmentName)
+	return nil
+}
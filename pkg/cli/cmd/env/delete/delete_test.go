@@ -28,6 +28,8 @@ import (
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/prompt"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerp "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/test/radcli"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -91,6 +93,15 @@ func Test_Validate(t *testing.T) {
 				Config:         radcli.LoadEmptyConfig(t),
 			},
 		},
+		{
+			Name:          "Delete Command with dry run",
+			Input:         []string{"test-env", "--dry-run"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
 		{
 			Name:          "Delete Command with incorrect args",
 			Input:         []string{"foo", "bar"},
@@ -448,4 +459,59 @@ func Test_Show(t *testing.T) {
 		require.Equal(t, &prompt.ErrExitConsole{}, err)
 		require.Empty(t, outputSink.Writes)
 	})
+
+	t.Run("Success: Dry Run", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		appID := "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/applications/test-app"
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			ListResourcesInEnvironment(gomock.Any(), "test-env").
+			Return([]generated.GenericResource{
+				{Name: to.Ptr("test-app"), Type: to.Ptr("Applications.Core/applications")},
+				{Name: to.Ptr("frontend"), Type: to.Ptr("Applications.Core/containers"), Properties: map[string]any{"application": appID}},
+				{Name: to.Ptr("shared-db"), Type: to.Ptr("Applications.Datastores/redisCaches"), Properties: map[string]any{}},
+			}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			ListApplicationsInEnvironment(gomock.Any(), "test-env").
+			Return([]corerp.ApplicationResource{{ID: to.Ptr(appID), Name: to.Ptr("test-app")}}, nil).
+			Times(1)
+		appManagementClient.EXPECT().
+			PlanApplicationDelete(gomock.Any(), appID).
+			Return(clients.DeletePlan{
+				Waves: [][]clients.DeletePlanResource{{{ID: "frontend-id", Name: "frontend", Type: "Applications.Core/containers"}}},
+			}, nil).
+			Times(1)
+
+		// Nothing is deleted.
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:         &workspaces.Workspace{Scope: "/planes/radius/local/resourceGroups/test-group"},
+			Format:            "table",
+			Output:            outputSink,
+			EnvironmentName:   "test-env",
+			DryRun:            true,
+		}
+
+		err := runner.Run(t.Context())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{Format: "The resources of application '%s' would be deleted in the following order:", Params: []any{"test-app"}},
+			output.LogOutput{Format: ""},
+			output.LogOutput{Format: "%d.", Params: []any{1}},
+			output.LogOutput{Format: "  %s (%s)", Params: []any{"frontend", "Applications.Core/containers"}},
+			output.LogOutput{Format: ""},
+			output.LogOutput{Format: "Applications.Core/applications/%s would be deleted", Params: []any{"test-app"}},
+			output.LogOutput{Format: ""},
+			output.LogOutput{Format: "The following resources of environment '%s' don't belong to an application and are not ordered:", Params: []any{"test-env"}},
+			output.LogOutput{Format: "  %s (%s)", Params: []any{"shared-db", "Applications.Datastores/redisCaches"}},
+			output.LogOutput{Format: ""},
+			output.LogOutput{Format: "Applications.Core/environments/%s would be deleted", Params: []any{"test-env"}},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
 }
@@ -0,0 +1,360 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prefetch
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	credentials "oras.land/oras-go/v2/registry/remote/credentials"
+	"oras.land/oras-go/v2/registry/remote/retry"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/recipepack"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerpv20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/cache"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/terraform"
+	rputil "github.com/radius-project/radius/pkg/rp/util"
+)
+
+const (
+	// ResultCached is the result of a recipe whose artifacts were added to the cache.
+	ResultCached = "Cached"
+
+	// ResultFailed is the result of a recipe whose artifacts could not be added to the cache.
+	ResultFailed = "Failed"
+
+	// recipePackRecipeName is the name of the recipes of a recipe pack, which is used as the name of the Terraform
+	// module installed by the recipe engine.
+	recipePackRecipeName = "default"
+
+	recipePackFlag    = "recipe-pack"
+	cacheDirFlag      = "cache-dir"
+	platformFlag      = "platform"
+	terraformPathFlag = "terraform-path"
+)
+
+// BicepPrefetcher downloads a Bicep recipe template into the cache.
+type BicepPrefetcher func(ctx context.Context, c *cache.Cache, source string, plainHTTP bool) error
+
+// TerraformPrefetcher downloads a Terraform module and its providers into the cache.
+type TerraformPrefetcher func(ctx context.Context, c *cache.Cache, options terraform.PrefetchOptions) error
+
+// RecipePrefetchResult is the result of prefetching a single recipe of a recipe pack.
+type RecipePrefetchResult struct {
+	ResourceType string `json:"resourceType"`
+	Kind         string `json:"kind"`
+	Source       string `json:"source"`
+	Result       string `json:"result"`
+	Message      string `json:"message,omitempty"`
+}
+
+// NewCommand creates an instance of the `rad recipe prefetch` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "prefetch",
+		Short: "Download the recipes of a recipe pack into a recipe cache",
+		Long: `Download the recipes of a recipe pack into a recipe cache for offline use.
+
+The Bicep templates and Terraform modules of the recipe pack are downloaded into the cache directory, together with
+the Terraform providers required by the modules for each platform specified with --platform. Terraform recipes require
+the terraform CLI, and must be pinned to a version.
+
+Copy the content of the cache directory to the recipe cache volume of the Radius control plane (enabled with the
+'recipeCache.enabled' Helm value) for recipes to run without downloading their templates, modules and providers.`,
+		Example: `
+# Download the recipes of a recipe pack into the default cache directory (~/.rad/recipe-cache)
+rad recipe prefetch --recipe-pack my-recipe-pack
+
+# Download the recipes of a recipe pack, with providers for amd64 and arm64 clusters, into a specified directory
+rad recipe prefetch --recipe-pack my-recipe-pack --cache-dir ./recipe-cache --platform linux_amd64 --platform linux_arm64
+
+# Download the recipes of a recipe pack specified by resource ID
+rad recipe prefetch --recipe-pack /planes/radius/local/resourceGroups/my-group/providers/Radius.Core/recipePacks/my-recipe-pack`,
+		Args: cobra.NoArgs,
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	cmd.Flags().String(recipePackFlag, "", "The name or resource ID of the recipe pack")
+	_ = cmd.MarkFlagRequired(recipePackFlag)
+	cmd.Flags().String(cacheDirFlag, "", "The directory of the recipe cache. Defaults to ~/.rad/recipe-cache")
+	cmd.Flags().StringSlice(platformFlag, []string{"linux_amd64"}, "The platforms of the Radius control plane to download Terraform providers for")
+	cmd.Flags().String(terraformPathFlag, "", "The path to the terraform CLI. Defaults to the terraform CLI found in PATH")
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe prefetch` command.
+type Runner struct {
+	ConfigHolder            *framework.ConfigHolder
+	RadiusCoreClientFactory *corerpv20250801.ClientFactory
+	BicepPrefetcher         BicepPrefetcher
+	TerraformPrefetcher     TerraformPrefetcher
+	Output                  output.Interface
+	Workspace               *workspaces.Workspace
+	Format                  string
+	RecipePack              string
+	CacheDir                string
+	Platforms               []string
+	TerraformPath           string
+}
+
+// NewRunner creates a new instance of the `rad recipe prefetch` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:        factory.GetConfigHolder(),
+		BicepPrefetcher:     prefetchRegistryTemplate,
+		TerraformPrefetcher: terraform.Prefetch,
+		Output:              factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad recipe prefetch` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	r.RecipePack, err = cmd.Flags().GetString(recipePackFlag)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(r.RecipePack) == "" {
+		return clierrors.Message("Specify the name or resource ID of a recipe pack with --%s.", recipePackFlag)
+	}
+
+	r.CacheDir, err = cmd.Flags().GetString(cacheDirFlag)
+	if err != nil {
+		return err
+	}
+	if r.CacheDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return err
+		}
+		r.CacheDir = filepath.Join(home, ".rad", "recipe-cache")
+	}
+
+	r.Platforms, err = cmd.Flags().GetStringSlice(platformFlag)
+	if err != nil {
+		return err
+	}
+
+	r.TerraformPath, err = cmd.Flags().GetString(terraformPathFlag)
+	if err != nil {
+		return err
+	}
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad recipe prefetch` command.
+func (r *Runner) Run(ctx context.Context) error {
+	if r.RadiusCoreClientFactory == nil {
+		clientFactory, err := cmd.InitializeRadiusCoreClientFactory(ctx, r.Workspace)
+		if err != nil {
+			return err
+		}
+		r.RadiusCoreClientFactory = clientFactory
+	}
+
+	recipePackID, _, err := recipepack.ResolveID(r.RecipePack, r.Workspace.Scope)
+	if err != nil {
+		return err
+	}
+
+	response, err := r.RadiusCoreClientFactory.NewRecipePacksClient().Get(ctx, recipePackID.RootScope(), recipePackID.Name(), &corerpv20250801.RecipePacksClientGetOptions{})
+	if clients.Is404Error(err) {
+		return clierrors.Message("The recipe pack %q was not found or has been deleted.", r.RecipePack)
+	} else if err != nil {
+		return err
+	}
+
+	var definitions map[string]*corerpv20250801.RecipeDefinition
+	if response.Properties != nil {
+		definitions = response.Properties.Recipes
+	}
+
+	c, err := cache.New(cache.Options{Dir: r.CacheDir})
+	if err != nil {
+		return err
+	}
+
+	resourceTypes := make([]string, 0, len(definitions))
+	for resourceType := range definitions {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	results := []RecipePrefetchResult{}
+	failed := 0
+	for _, resourceType := range resourceTypes {
+		result := r.prefetchRecipe(ctx, c, resourceType, definitions[resourceType])
+		if result.Result == ResultFailed {
+			failed++
+		}
+		results = append(results, result)
+	}
+
+	err = r.Output.WriteFormatted(r.Format, results, prefetchResultFormat())
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return clierrors.Message("%d recipe(s) in recipe pack %q could not be downloaded.", failed, recipePackID.Name())
+	}
+
+	r.Output.LogInfo("Recipes downloaded to %s. Copy this directory to the recipe cache volume of the Radius control plane to use them offline.", r.CacheDir)
+	return nil
+}
+
+// prefetchRecipe downloads the artifacts of a single recipe into the cache. Errors are reported in the result.
+func (r *Runner) prefetchRecipe(ctx context.Context, c *cache.Cache, resourceType string, definition *corerpv20250801.RecipeDefinition) RecipePrefetchResult {
+	result := RecipePrefetchResult{
+		ResourceType: resourceType,
+		Result:       ResultCached,
+	}
+
+	if definition == nil || definition.Kind == nil || definition.Source == nil {
+		result.Result = ResultFailed
+		result.Message = "the recipe must set both kind and source"
+		return result
+	}
+	result.Kind = string(*definition.Kind)
+	result.Source = *definition.Source
+
+	var err error
+	switch *definition.Kind {
+	case corerpv20250801.RecipeKindBicep:
+		plainHTTP := definition.PlainHTTP != nil && *definition.PlainHTTP
+		err = r.BicepPrefetcher(ctx, c, *definition.Source, plainHTTP)
+	case corerpv20250801.RecipeKindTerraform:
+		err = r.prefetchTerraformModule(ctx, c, *definition.Source)
+	default:
+		err = clierrors.Message("recipes of kind %q cannot be cached", *definition.Kind)
+	}
+
+	if err != nil {
+		result.Result = ResultFailed
+		result.Message = strings.ReplaceAll(err.Error(), "\n", "; ")
+	}
+
+	return result
+}
+
+// prefetchTerraformModule downloads a Terraform module and its providers into the cache.
+func (r *Runner) prefetchTerraformModule(ctx context.Context, c *cache.Cache, location string) error {
+	if r.TerraformPath == "" {
+		path, err := exec.LookPath("terraform")
+		if err != nil {
+			return clierrors.Message("The terraform CLI was not found in PATH. Install it or specify its path with --%s.", terraformPathFlag)
+		}
+		r.TerraformPath = path
+	}
+
+	// Recipe packs encode the version of Terraform modules in their source, like the recipe engine expects them.
+	source, version := configloader.ParseTerraformModuleSource(location)
+	return r.TerraformPrefetcher(ctx, c, terraform.PrefetchOptions{
+		ExecPath:  r.TerraformPath,
+		Name:      recipePackRecipeName,
+		Source:    source,
+		Version:   version,
+		Platforms: r.Platforms,
+	})
+}
+
+// prefetchRegistryTemplate downloads a Bicep recipe template from an OCI registry into the cache using the local
+// Docker credentials.
+func prefetchRegistryTemplate(ctx context.Context, c *cache.Cache, source string, plainHTTP bool) error {
+	store, err := credentials.NewStoreFromDocker(credentials.StoreOptions{})
+	if err != nil {
+		return err
+	}
+
+	client := &auth.Client{
+		Client:     retry.DefaultClient,
+		Cache:      auth.DefaultCache,
+		Credential: store.Get,
+	}
+
+	// Templates are cached when they are read.
+	template := map[string]any{}
+	return rputil.ReadFromRegistryWithCache(ctx, recipes.EnvironmentDefinition{TemplatePath: source, PlainHTTP: plainHTTP}, &template, client, c)
+}
+
+func prefetchResultFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE TYPE",
+				JSONPath: "{ .ResourceType }",
+			},
+			{
+				Heading:  "KIND",
+				JSONPath: "{ .Kind }",
+			},
+			{
+				Heading:  "SOURCE",
+				JSONPath: "{ .Source }",
+			},
+			{
+				Heading:  "RESULT",
+				JSONPath: "{ .Result }",
+			},
+			{
+				Heading:  "MESSAGE",
+				JSONPath: "{ .Message }",
+			},
+		},
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prefetch
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/test_client_factory"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/recipes/cache"
+	"github.com/radius-project/radius/pkg/recipes/terraform"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "missing recipe pack",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "empty recipe pack",
+			Input:         []string{"--recipe-pack", " "},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "unexpected argument",
+			Input:         []string{"my-pack"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "valid",
+			Input:         []string{"--recipe-pack", "my-pack", "--cache-dir", "cache", "--platform", "linux_arm64"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "my-pack", r.RecipePack)
+				require.Equal(t, "cache", r.CacheDir)
+				require.Equal(t, []string{"linux_arm64"}, r.Platforms)
+			},
+		},
+		{
+			Name:          "valid with defaults",
+			Input:         []string{"--recipe-pack", "my-pack", "--group", "test-group"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadEmptyConfig(t),
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Contains(t, r.CacheDir, "recipe-cache")
+				require.Equal(t, []string{"linux_amd64"}, r.Platforms)
+			},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+
+	bicepPrefetcher := func(ctx context.Context, c *cache.Cache, source string, plainHTTP bool) error {
+		return c.Put(cache.Key(source, ""), []byte("{}"))
+	}
+
+	t.Run("terraform recipes", func(t *testing.T) {
+		factory, err := test_client_factory.NewRadiusCoreTestClientFactory(workspace.Scope, nil, test_client_factory.WithRecipePackServerNoError)
+		require.NoError(t, err)
+
+		prefetched := []terraform.PrefetchOptions{}
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			RadiusCoreClientFactory: factory,
+			BicepPrefetcher:         bicepPrefetcher,
+			TerraformPrefetcher: func(ctx context.Context, c *cache.Cache, options terraform.PrefetchOptions) error {
+				prefetched = append(prefetched, options)
+				return nil
+			},
+			Output:        outputSink,
+			Workspace:     workspace,
+			Format:        "table",
+			RecipePack:    "sample-pack",
+			CacheDir:      t.TempDir(),
+			Platforms:     []string{"linux_amd64"},
+			TerraformPath: "/usr/local/bin/terraform",
+		}
+
+		err = runner.Run(t.Context())
+		require.NoError(t, err)
+
+		require.Equal(t, []terraform.PrefetchOptions{
+			{
+				ExecPath:  "/usr/local/bin/terraform",
+				Name:      "default",
+				Source:    "https://example.com/recipe1?ref=v0.1",
+				Platforms: []string{"linux_amd64"},
+			},
+			{
+				ExecPath:  "/usr/local/bin/terraform",
+				Name:      "default",
+				Source:    "https://example.com/recipe2?ref=v0.1",
+				Platforms: []string{"linux_amd64"},
+			},
+		}, prefetched)
+
+		results := outputSink.Writes[0].(output.FormattedOutput).Obj.([]RecipePrefetchResult)
+		require.Len(t, results, 2)
+		require.Equal(t, ResultCached, results[0].Result)
+		require.Equal(t, ResultCached, results[1].Result)
+	})
+
+	t.Run("bicep recipes", func(t *testing.T) {
+		factory, err := test_client_factory.NewRadiusCoreTestClientFactory(workspace.Scope, nil, test_client_factory.WithRecipePackServerUniqueTypes)
+		require.NoError(t, err)
+
+		cacheDir := t.TempDir()
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			RadiusCoreClientFactory: factory,
+			BicepPrefetcher:         bicepPrefetcher,
+			Output:                  outputSink,
+			Workspace:               workspace,
+			Format:                  "table",
+			RecipePack:              "sample-pack",
+			CacheDir:                cacheDir,
+		}
+
+		err = runner.Run(t.Context())
+		require.NoError(t, err)
+
+		c, err := cache.New(cache.Options{Dir: cacheDir})
+		require.NoError(t, err)
+		entries, err := c.Entries()
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, "ghcr.io/test/sample-pack:latest", entries[0].Key)
+	})
+
+	t.Run("failed recipes", func(t *testing.T) {
+		factory, err := test_client_factory.NewRadiusCoreTestClientFactory(workspace.Scope, nil, test_client_factory.WithRecipePackServerUniqueTypes)
+		require.NoError(t, err)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			RadiusCoreClientFactory: factory,
+			BicepPrefetcher: func(ctx context.Context, c *cache.Cache, source string, plainHTTP bool) error {
+				return errors.New("registry unavailable")
+			},
+			Output:     outputSink,
+			Workspace:  workspace,
+			Format:     "table",
+			RecipePack: "sample-pack",
+			CacheDir:   t.TempDir(),
+		}
+
+		err = runner.Run(t.Context())
+		require.Equal(t, clierrors.Message("%d recipe(s) in recipe pack %q could not be downloaded.", 1, "sample-pack"), err)
+
+		results := outputSink.Writes[0].(output.FormattedOutput).Obj.([]RecipePrefetchResult)
+		require.Len(t, results, 1)
+		require.Equal(t, ResultFailed, results[0].Result)
+		require.Equal(t, "registry unavailable", results[0].Message)
+	})
+
+	t.Run("recipe pack not found", func(t *testing.T) {
+		factory, err := test_client_factory.NewRadiusCoreTestClientFactory(workspace.Scope, nil, test_client_factory.WithRecipePackServer404OnGet)
+		require.NoError(t, err)
+
+		runner := &Runner{
+			RadiusCoreClientFactory: factory,
+			Output:                  &output.MockOutput{},
+			Workspace:               workspace,
+			Format:                  "table",
+			RecipePack:              "sample-pack",
+			CacheDir:                t.TempDir(),
+		}
+
+		err = runner.Run(t.Context())
+		require.Equal(t, clierrors.Message("The recipe pack %q was not found or has been deleted.", "sample-pack"), err)
+	})
+}
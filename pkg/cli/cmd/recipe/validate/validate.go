@@ -0,0 +1,331 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"oras.land/oras-go/v2/registry/remote/auth"
+	credentials "oras.land/oras-go/v2/registry/remote/credentials"
+	"oras.land/oras-go/v2/registry/remote/retry"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/cmd/resourcetype/common"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerpv20250801 "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/contract"
+	rputil "github.com/radius-project/radius/pkg/rp/util"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+const (
+	// ResultValid is the result of a recipe that passed validation.
+	ResultValid = "Valid"
+
+	// ResultInvalid is the result of a recipe that failed validation.
+	ResultInvalid = "Invalid"
+)
+
+// TemplateReader reads a Bicep recipe template from an OCI registry.
+type TemplateReader func(ctx context.Context, source string, plainHTTP bool) (map[string]any, error)
+
+// RecipeValidationResult is the result of validating a single recipe of a recipe pack.
+type RecipeValidationResult struct {
+	ResourceType string `json:"resourceType"`
+	Kind         string `json:"kind"`
+	Source       string `json:"source"`
+	Result       string `json:"result"`
+	Message      string `json:"message,omitempty"`
+}
+
+// NewCommand creates an instance of the `rad recipe validate` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "validate [recipe pack]",
+		Short: "Validate the recipes of a recipe pack against their resource types",
+		Long: `Validate the recipes of a recipe pack against their resource types.
+
+Each recipe in the recipe pack is checked without deploying it:
+- The resource type of the recipe must be registered.
+- The output mappings of the recipe must match the properties and secrets declared by every API version of the resource type.
+- For Bicep recipes, the parameters set by the recipe pack must match the parameters declared by the recipe template.
+
+The same checks are applied by the recipe engine when a recipe is deployed, together with the validation of the recipe outputs.`,
+		Example: `
+# Validate a recipe pack in the current resource group
+rad recipe validate my-recipe-pack
+
+# Validate a recipe pack in a specified resource group
+rad recipe validate my-recipe-pack --group my-group
+
+# Validate a recipe pack and output the results in JSON format
+rad recipe validate my-recipe-pack --output json`,
+		Args: cobra.ExactArgs(1),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad recipe validate` command.
+type Runner struct {
+	ConfigHolder      *framework.ConfigHolder
+	ConnectionFactory connections.Factory
+	UCPClientFactory  *v20231001preview.ClientFactory
+	TemplateReader    TemplateReader
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+	Format            string
+	RecipePackName    string
+}
+
+// NewRunner creates a new instance of the `rad recipe validate` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConfigHolder:      factory.GetConfigHolder(),
+		ConnectionFactory: factory.GetConnectionFactory(),
+		TemplateReader:    readRegistryTemplate,
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad recipe validate` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	// Allow '--group' to override scope
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	recipePackName, err := cli.RequireRecipePackNameArgs(cmd, args)
+	if err != nil {
+		return err
+	}
+	r.RecipePackName = recipePackName
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	return nil
+}
+
+// Run runs the `rad recipe validate` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	recipePack, err := client.GetRecipePack(ctx, r.RecipePackName)
+	if clients.Is404Error(err) {
+		return clierrors.Message("The recipe pack %q was not found or has been deleted.", r.RecipePackName)
+	} else if err != nil {
+		return err
+	}
+
+	// Initialize the client factory if it hasn't been set externally.
+	// This allows for flexibility where a test UCPClientFactory can be set externally during testing.
+	if r.UCPClientFactory == nil {
+		clientFactory, err := cmd.InitializeClientFactory(ctx, r.Workspace)
+		if err != nil {
+			return err
+		}
+		r.UCPClientFactory = clientFactory
+	}
+
+	var definitions map[string]*corerpv20250801.RecipeDefinition
+	if recipePack.Properties != nil {
+		definitions = recipePack.Properties.Recipes
+	}
+
+	resourceTypes := make([]string, 0, len(definitions))
+	for resourceType := range definitions {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	results := []RecipeValidationResult{}
+	invalid := 0
+	for _, resourceType := range resourceTypes {
+		result := r.validateRecipe(ctx, resourceType, definitions[resourceType])
+		if result.Result == ResultInvalid {
+			invalid++
+		}
+		results = append(results, result)
+	}
+
+	err = r.Output.WriteFormatted(r.Format, results, validationResultFormat())
+	if err != nil {
+		return err
+	}
+
+	if invalid > 0 {
+		return clierrors.Message("%d recipe(s) in recipe pack %q failed validation.", invalid, r.RecipePackName)
+	}
+
+	return nil
+}
+
+// validateRecipe validates a single recipe of the recipe pack. Validation errors are reported in the result.
+func (r *Runner) validateRecipe(ctx context.Context, resourceType string, definition *corerpv20250801.RecipeDefinition) RecipeValidationResult {
+	result := RecipeValidationResult{
+		ResourceType: resourceType,
+		Result:       ResultValid,
+	}
+
+	if definition == nil || definition.Kind == nil || definition.Source == nil {
+		result.Result = ResultInvalid
+		result.Message = "the recipe must set both kind and source"
+		return result
+	}
+	result.Kind = string(*definition.Kind)
+	result.Source = *definition.Source
+
+	var errs []error
+	if err := r.validateOutputMappings(ctx, resourceType, definition); err != nil {
+		errs = append(errs, err)
+	}
+
+	if *definition.Kind == corerpv20250801.RecipeKindBicep {
+		plainHTTP := definition.PlainHTTP != nil && *definition.PlainHTTP
+		template, err := r.TemplateReader(ctx, *definition.Source, plainHTTP)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to read the recipe template: %w", err))
+		} else if err := contract.ValidateParameterValues(definition.Parameters, template); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		result.Result = ResultInvalid
+		result.Message = strings.ReplaceAll(errors.Join(errs...).Error(), "\n", "; ")
+	}
+
+	return result
+}
+
+// validateOutputMappings validates the output mappings of the recipe against every API version of the resource type.
+func (r *Runner) validateOutputMappings(ctx context.Context, resourceType string, definition *corerpv20250801.RecipeDefinition) error {
+	providerNamespace, resourceTypeName, ok := strings.Cut(resourceType, "/")
+	if !ok || providerNamespace == "" || resourceTypeName == "" {
+		return fmt.Errorf("%q is not a fully-qualified resource type", resourceType)
+	}
+
+	resourceTypeDetails, err := common.GetResourceTypeDetails(ctx, providerNamespace, resourceTypeName, r.UCPClientFactory)
+	if err != nil {
+		return err
+	}
+
+	outputs, secretOutputs := corerpv20250801.SplitRecipeOutputs(definition.Outputs)
+	if len(outputs) == 0 && len(secretOutputs) == 0 {
+		return nil
+	}
+
+	apiVersions := make([]string, 0, len(resourceTypeDetails.APIVersions))
+	for apiVersion := range resourceTypeDetails.APIVersions {
+		apiVersions = append(apiVersions, apiVersion)
+	}
+	sort.Strings(apiVersions)
+
+	var errs []error
+	for _, apiVersion := range apiVersions {
+		properties := resourceTypeDetails.APIVersions[apiVersion]
+		if properties == nil {
+			continue
+		}
+
+		if err := contract.ValidateOutputMappings(properties.Schema, outputs, secretOutputs); err != nil {
+			errs = append(errs, fmt.Errorf("API version %s: %w", apiVersion, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// readRegistryTemplate reads a Bicep recipe template from an OCI registry using the local Docker credentials.
+func readRegistryTemplate(ctx context.Context, source string, plainHTTP bool) (map[string]any, error) {
+	store, err := credentials.NewStoreFromDocker(credentials.StoreOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	client := &auth.Client{
+		Client:     retry.DefaultClient,
+		Cache:      auth.DefaultCache,
+		Credential: store.Get,
+	}
+
+	template := map[string]any{}
+	err = rputil.ReadFromRegistry(ctx, recipes.EnvironmentDefinition{TemplatePath: source, PlainHTTP: plainHTTP}, &template, client)
+	if err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func validationResultFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "RESOURCE TYPE",
+				JSONPath: "{ .ResourceType }",
+			},
+			{
+				Heading:  "KIND",
+				JSONPath: "{ .Kind }",
+			},
+			{
+				Heading:  "RESULT",
+				JSONPath: "{ .Result }",
+			},
+			{
+				Heading:  "MESSAGE",
+				JSONPath: "{ .Message }",
+			},
+		},
+	}
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/manifest"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/test/radcli"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "missing recipe pack name",
+			Input:         []string{},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "too many arguments",
+			Input:         []string{"my-pack", "other-pack"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "valid with workspace flag",
+			Input:         []string{"my-pack", "-w", radcli.TestWorkspaceName},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "valid with fallback workspace",
+			Input:         []string{"my-pack", "--group", "test-group"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         radcli.LoadEmptyConfig(t),
+			},
+		},
+	}
+
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	workspace := &workspaces.Workspace{
+		Connection: map[string]any{
+			"kind":    "kubernetes",
+			"context": "kind-kind",
+		},
+		Name:  "kind-kind",
+		Scope: "/planes/radius/local/resourceGroups/test-group",
+	}
+
+	template := map[string]any{
+		"parameters": map[string]any{
+			"context": map[string]any{"type": "object"},
+			"size":    map[string]any{"type": "string", "allowedValues": []any{"S", "M"}},
+		},
+	}
+
+	templateReader := func(ctx context.Context, source string, plainHTTP bool) (map[string]any, error) {
+		return template, nil
+	}
+
+	setup := func(t *testing.T, recipePack corerpv20250801preview.RecipePackResource) (*Runner, *output.MockOutput) {
+		ctrl := gomock.NewController(t)
+
+		appMgmtClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appMgmtClient.EXPECT().
+			GetRecipePack(gomock.Any(), "sample-pack").
+			Return(recipePack, nil).
+			Times(1)
+
+		factory, err := manifest.NewTestClientFactory(manifest.WithResourceProviderServerNoError)
+		require.NoError(t, err)
+
+		outputSink := &output.MockOutput{}
+		return &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appMgmtClient},
+			UCPClientFactory:  factory,
+			TemplateReader:    templateReader,
+			Workspace:         workspace,
+			Output:            outputSink,
+			RecipePackName:    "sample-pack",
+			Format:            "table",
+		}, outputSink
+	}
+
+	t.Run("valid recipe pack", func(t *testing.T) {
+		recipePack := corerpv20250801preview.RecipePackResource{
+			Name: new("sample-pack"),
+			Properties: &corerpv20250801preview.RecipePackProperties{
+				Recipes: map[string]*corerpv20250801preview.RecipeDefinition{
+					"Test.Resources/testResources": {
+						Kind:       to.Ptr(corerpv20250801preview.RecipeKindBicep),
+						Source:     new("ghcr.io/radius-project/recipes/test:latest"),
+						Parameters: map[string]any{"size": "M"},
+						Outputs:    map[string]any{"database": "databaseName"},
+					},
+				},
+			},
+		}
+
+		runner, outputSink := setup(t, recipePack)
+		err := runner.Run(t.Context())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []RecipeValidationResult{
+					{
+						ResourceType: "Test.Resources/testResources",
+						Kind:         "bicep",
+						Source:       "ghcr.io/radius-project/recipes/test:latest",
+						Result:       ResultValid,
+					},
+				},
+				Options: validationResultFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("invalid recipe pack", func(t *testing.T) {
+		recipePack := corerpv20250801preview.RecipePackResource{
+			Name: new("sample-pack"),
+			Properties: &corerpv20250801preview.RecipePackProperties{
+				Recipes: map[string]*corerpv20250801preview.RecipeDefinition{
+					"Test.Resources/testResources": {
+						Kind:       to.Ptr(corerpv20250801preview.RecipeKindBicep),
+						Source:     new("ghcr.io/radius-project/recipes/test:latest"),
+						Parameters: map[string]any{"size": "XL"},
+						Outputs: map[string]any{
+							"hostname": "hostName",
+							"secrets":  map[string]any{"password": "adminPassword"},
+						},
+					},
+				},
+			},
+		}
+
+		runner, outputSink := setup(t, recipePack)
+		err := runner.Run(t.Context())
+		require.Equal(t, clierrors.Message("%d recipe(s) in recipe pack %q failed validation.", 1, "sample-pack"), err)
+
+		require.Len(t, outputSink.Writes, 1)
+		results := outputSink.Writes[0].(output.FormattedOutput).Obj.([]RecipeValidationResult)
+		require.Len(t, results, 1)
+		require.Equal(t, ResultInvalid, results[0].Result)
+		require.Contains(t, results[0].Message, `output "hostname" is not a property declared by the resource type`)
+		require.Contains(t, results[0].Message, `secret output "password" cannot be mapped`)
+		require.Contains(t, results[0].Message, `parameter "size" must be one of [S M]`)
+	})
+
+	t.Run("recipe pack not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appMgmtClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appMgmtClient.EXPECT().
+			GetRecipePack(gomock.Any(), "sample-pack").
+			Return(corerpv20250801preview.RecipePackResource{}, radcli.Create404Error()).
+			Times(1)
+
+		runner := &Runner{
+			ConnectionFactory: &connections.MockFactory{ApplicationsManagementClient: appMgmtClient},
+			Workspace:         workspace,
+			Output:            &output.MockOutput{},
+			RecipePackName:    "sample-pack",
+			Format:            "table",
+		}
+
+		err := runner.Run(t.Context())
+		require.Equal(t, clierrors.Message("The recipe pack %q was not found or has been deleted.", "sample-pack"), err)
+	})
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package invoke
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+	"helm.sh/helm/v4/pkg/strvals"
+)
+
+// NewCommand creates an instance of the `rad resource invoke` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "invoke [resource type] [name] [action] --set [key=value]",
+		Short: "Invoke a custom action of a resource",
+		Long: `Invoke a custom action of a resource
+
+Custom actions are declared by the resource type, for example rotateCredentials or snapshot. The input of the
+action is set with the --set flag using dot-separated paths, and is validated against the input schema of the
+action. The command waits for the action to complete and prints its output as JSON.`,
+		Example: `
+# Invoke an action without input
+rad resource invoke My.Company/postgreSQLDatabases db snapshot
+
+# Invoke an action with input
+rad resource invoke My.Company/postgreSQLDatabases db rotateCredentials --set expiryDays=30`,
+		Args: cobra.ExactArgs(3),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	cmd.Flags().StringArrayVar(&runner.Set, "set", []string{}, "Set input values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad resource invoke` command.
+type Runner struct {
+	ConnectionFactory connections.Factory
+	ConfigHolder      *framework.ConfigHolder
+	Output            output.Interface
+	Workspace         *workspaces.Workspace
+
+	FullyQualifiedResourceTypeName string
+	ResourceName                   string
+	Action                         string
+	Set                            []string
+	Input                          map[string]any
+}
+
+// NewRunner creates an instance of the runner for the `rad resource invoke` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource invoke` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceProviderName, resourceTypeName, resourceName, err := cli.RequireFullyQualifiedResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	r.FullyQualifiedResourceTypeName = resourceProviderName + "/" + resourceTypeName
+	r.ResourceName = resourceName
+	r.Action = args[2]
+
+	r.Input = map[string]any{}
+	for _, value := range r.Set {
+		if err := strvals.ParseInto(value, r.Input); err != nil {
+			return clierrors.Message("Invalid --set value %q: %v", value, err)
+		}
+	}
+
+	return nil
+}
+
+// Run runs the `rad resource invoke` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Invoking action %s on resource %s/%s", r.Action, r.FullyQualifiedResourceTypeName, r.ResourceName)
+	result, err := client.InvokeResourceAction(ctx, r.FullyQualifiedResourceTypeName, r.ResourceName, r.Action, r.Input)
+	if err != nil {
+		return err
+	}
+
+	if len(result) == 0 {
+		r.Output.LogInfo("Action %s completed", r.Action)
+		return nil
+	}
+
+	return r.Output.WriteFormatted(output.FormatJson, result, output.FormatterOptions{})
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package invoke
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Invoke Command",
+			Input:         []string{"Applications.Test/exampleResources", "foo", "snapshot"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "snapshot", r.Action)
+				require.Equal(t, map[string]any{}, r.Input)
+			},
+		},
+		{
+			Name:          "Invoke Command with input",
+			Input:         []string{"Applications.Test/exampleResources", "foo", "rotateCredentials", "--set", "expiryDays=30,notify.email=true"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, map[string]any{
+					"expiryDays": int64(30),
+					"notify":     map[string]any{"email": true},
+				}, r.Input)
+			},
+		},
+		{
+			Name:          "Invoke Command with invalid value",
+			Input:         []string{"Applications.Test/exampleResources", "foo", "rotateCredentials", "--set", "expiryDays"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Invoke Command without action",
+			Input:         []string{"Applications.Test/exampleResources", "foo"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Invoke Command with invalid resource type",
+			Input:         []string{"invalidResourceType", "foo", "snapshot"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("with output", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		input := map[string]any{"expiryDays": int64(30)}
+		result := map[string]any{"rotatedAt": "2025-01-01T00:00:00Z"}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			InvokeResourceAction(gomock.Any(), "Applications.Test/exampleResources", "foo", "rotateCredentials", input).
+			Return(result, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:                         outputSink,
+			Workspace:                      &workspaces.Workspace{},
+			FullyQualifiedResourceTypeName: "Applications.Test/exampleResources",
+			ResourceName:                   "foo",
+			Action:                         "rotateCredentials",
+			Input:                          input,
+		}
+
+		err := runner.Run(t.Context())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Invoking action %s on resource %s/%s",
+				Params: []any{"rotateCredentials", "Applications.Test/exampleResources", "foo"},
+			},
+			output.FormattedOutput{
+				Format:  "json",
+				Obj:     result,
+				Options: output.FormatterOptions{},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("without output", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			InvokeResourceAction(gomock.Any(), "Applications.Test/exampleResources", "foo", "snapshot", map[string]any{}).
+			Return(nil, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:                         outputSink,
+			Workspace:                      &workspaces.Workspace{},
+			FullyQualifiedResourceTypeName: "Applications.Test/exampleResources",
+			ResourceName:                   "foo",
+			Action:                         "snapshot",
+			Input:                          map[string]any{},
+		}
+
+		err := runner.Run(t.Context())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "Invoking action %s on resource %s/%s",
+				Params: []any{"snapshot", "Applications.Test/exampleResources", "foo"},
+			},
+			output.LogOutput{
+				Format: "Action %s completed",
+				Params: []any{"snapshot"},
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+}
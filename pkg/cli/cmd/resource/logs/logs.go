@@ -0,0 +1,151 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"context"
+	"errors"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/recipelogs"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/spf13/cobra"
+)
+
+const (
+	recipeFlag = "recipe"
+	followFlag = "follow"
+)
+
+// NewCommand creates an instance of the command and runner for the `rad resource logs` command.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "logs [resourceType] [resourceName]",
+		Short: "Show the recipe execution logs of a Radius resource",
+		Long: `Show the recipe execution logs of a Radius resource.
+
+The logs contain the output of the tools run by the recipe of the resource, such as Terraform, during the latest
+operation on the resource. Secrets passed to the recipe are redacted. Use --follow to stream the logs until the
+operation completes.`,
+		Example: `
+# show the recipe logs of the latest operation on a resource
+rad resource logs Applications.Datastores/redisCaches cache --recipe
+
+# stream the recipe logs of a resource while it is being deployed
+rad resource logs Applications.Datastores/redisCaches cache --recipe --follow
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	cmd.Flags().Bool(recipeFlag, false, "Show the recipe execution logs of the resource")
+	cmd.Flags().BoolP(followFlag, "f", false, "Stream the logs until the operation on the resource completes")
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+
+	return cmd, runner
+}
+
+// Runner is the runner implementation for the `rad resource logs` command.
+type Runner struct {
+	ConfigHolder                   *framework.ConfigHolder
+	ConnectionFactory              connections.Factory
+	Output                         output.Interface
+	Workspace                      *workspaces.Workspace
+	FullyQualifiedResourceTypeName string
+	ResourceName                   string
+	Follow                         bool
+}
+
+// NewRunner creates a new instance of the `rad resource logs` runner.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource logs` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceProviderName, resourceTypeName, resourceName, err := cli.RequireFullyQualifiedResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	r.FullyQualifiedResourceTypeName = resourceProviderName + "/" + resourceTypeName
+	r.ResourceName = resourceName
+
+	// Recipe logs are the only kind of logs available for now. Requiring the flag leaves room for other kinds, such as
+	// the logs of the containers of a resource.
+	recipe, err := cmd.Flags().GetBool(recipeFlag)
+	if err != nil {
+		return err
+	}
+	if !recipe {
+		return clierrors.Message("The 'rad resource logs' command requires the --recipe flag.")
+	}
+
+	r.Follow, err = cmd.Flags().GetBool(followFlag)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run runs the `rad resource logs` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	resource, err := client.GetResource(ctx, r.FullyQualifiedResourceTypeName, r.ResourceName)
+	if err != nil {
+		return err
+	}
+
+	err = recipelogs.Follow(ctx, client, to.String(resource.ID), recipelogs.Options{
+		Follow: r.Follow,
+		Write:  func(line string) { r.Output.LogInfo("%s", line) },
+	})
+	if errors.Is(err, recipelogs.ErrNotFound) {
+		return clierrors.Message("No recipe logs were found for resource %q of type %q.", r.ResourceName, r.FullyQualifiedResourceTypeName)
+	}
+
+	return err
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logs
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Logs Command",
+			Input:         []string{"Applications.Datastores/redisCaches", "cache", "--recipe"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, "Applications.Datastores/redisCaches", r.FullyQualifiedResourceTypeName)
+				require.Equal(t, "cache", r.ResourceName)
+				require.False(t, r.Follow)
+			},
+		},
+		{
+			Name:          "Logs Command with follow",
+			Input:         []string{"Applications.Datastores/redisCaches", "cache", "--recipe", "-f"},
+			ExpectedValid: true,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				require.True(t, runner.(*Runner).Follow)
+			},
+		},
+		{
+			Name:          "Logs Command without recipe flag",
+			Input:         []string{"Applications.Datastores/redisCaches", "cache"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Logs Command with invalid resource type",
+			Input:         []string{"invalidResourceType", "cache", "--recipe"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+		{
+			Name:          "Logs Command with insufficient args",
+			Input:         []string{"Applications.Datastores/redisCaches", "--recipe"},
+			ExpectedValid: false,
+			ConfigHolder: framework.ConfigHolder{
+				ConfigFilePath: "",
+				Config:         configWithWorkspace,
+			},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	resource := radcli.CreateResource("Applications.Datastores/redisCaches", "cache")
+
+	t.Run("writes the recipe logs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), "Applications.Datastores/redisCaches", "cache").
+			Return(resource, nil).Times(1)
+		appManagementClient.EXPECT().
+			GetLatestOperationLogs(gomock.Any(), *resource.ID, int64(0)).
+			Return(v1.OperationLogs{
+				Name:   "op",
+				Status: v1.ProvisioningStateSucceeded,
+				Entries: []v1.OperationLogEntry{
+					{Sequence: 1, Message: "Initializing the backend..."},
+					{Sequence: 2, Message: "Apply complete!"},
+				},
+			}, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:                         outputSink,
+			Workspace:                      &workspaces.Workspace{},
+			FullyQualifiedResourceTypeName: "Applications.Datastores/redisCaches",
+			ResourceName:                   "cache",
+		}
+
+		err := runner.Run(t.Context())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{Format: "%s", Params: []any{"Initializing the backend..."}},
+			output.LogOutput{Format: "%s", Params: []any{"Apply complete!"}},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
+	t.Run("no recipe logs", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), "Applications.Datastores/redisCaches", "cache").
+			Return(resource, nil).Times(1)
+		appManagementClient.EXPECT().
+			GetLatestOperationLogs(gomock.Any(), *resource.ID, int64(0)).
+			Return(v1.OperationLogs{}, &azcore.ResponseError{StatusCode: http.StatusNotFound}).Times(1)
+
+		runner := &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:                         &output.MockOutput{},
+			Workspace:                      &workspaces.Workspace{},
+			FullyQualifiedResourceTypeName: "Applications.Datastores/redisCaches",
+			ResourceName:                   "cache",
+		}
+
+		err := runner.Run(t.Context())
+		require.Equal(t, clierrors.Message("No recipe logs were found for resource %q of type %q.", "cache", "Applications.Datastores/redisCaches"), err)
+	})
+}
@@ -18,14 +18,20 @@ package show
 
 import (
 	"context"
+	"encoding/json"
+	"slices"
+	"strings"
 
 	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
 	"github.com/radius-project/radius/pkg/cli/connections"
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/objectformats"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/spf13/cobra"
 )
 
@@ -125,7 +131,8 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 //
 
 // Run creates a connection to an applications management client, retrieves resource details, and writes the details in a
-// specified format to an output. It returns an error if any of these steps fail.
+// specified format to an output. Write-only fields are not shown, and the values computed by Radius are listed
+// separately in the table format. It returns an error if any of these steps fail.
 func (r *Runner) Run(ctx context.Context) error {
 	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
 	if err != nil {
@@ -137,5 +144,109 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
-	return r.Output.WriteFormatted(r.Format, resourceDetails, objectformats.GetGenericResourceTableFormat())
+	// The field annotations are best-effort: resources are still shown if the resource type schema is not available.
+	annotations := r.getFieldAnnotations(ctx, client)
+
+	// Write-only fields are removed by the server, this covers servers that do not support the annotation.
+	if resourceDetails.Properties != nil {
+		schema.RemoveFields(resourceDetails.Properties, annotations.WriteOnly)
+	}
+
+	err = r.Output.WriteFormatted(r.Format, resourceDetails, objectformats.GetGenericResourceDetailsTableFormat())
+	if err != nil {
+		return err
+	}
+
+	// The computed values are part of the properties for the other formats.
+	if r.Format != output.FormatTable {
+		return nil
+	}
+
+	computedValues := []ComputedValue{}
+	for _, path := range annotations.ReadOnly {
+		value, ok := schema.GetFieldValue(resourceDetails.Properties, path)
+		if !ok {
+			continue
+		}
+		computedValues = append(computedValues, ComputedValue{Name: path, Value: formatValue(value)})
+	}
+	if len(computedValues) == 0 {
+		return nil
+	}
+
+	r.Output.LogInfo("")
+	return r.Output.WriteFormatted(r.Format, computedValues, objectformats.GetComputedValuesTableFormat())
+}
+
+// ComputedValue is a property of a resource that is computed by Radius.
+type ComputedValue struct {
+	Name  string
+	Value string
+}
+
+// getFieldAnnotations returns the annotated fields of the resource type. The annotations of all API versions are
+// combined since the resource can be returned in any of them. It returns empty annotations if the resource type
+// schema cannot be fetched.
+func (r *Runner) getFieldAnnotations(ctx context.Context, client clients.ApplicationsManagementClient) *schema.FieldAnnotations {
+	annotations := &schema.FieldAnnotations{}
+
+	providerNamespace, resourceTypeName, ok := strings.Cut(r.FullyQualifiedResourceTypeName, "/")
+	if !ok {
+		return annotations
+	}
+
+	summary, err := client.GetResourceProviderSummary(ctx, "local", providerNamespace)
+	if err != nil {
+		return annotations
+	}
+
+	var resourceType *v20231001preview.ResourceProviderSummaryResourceType
+	for name, candidate := range summary.ResourceTypes {
+		if strings.EqualFold(name, resourceTypeName) {
+			resourceType = candidate
+			break
+		}
+	}
+	if resourceType == nil {
+		return annotations
+	}
+
+	for _, apiVersion := range resourceType.APIVersions {
+		if apiVersion == nil || apiVersion.Schema == nil {
+			continue
+		}
+
+		versionAnnotations := schema.ExtractFieldAnnotations(apiVersion.Schema)
+		annotations.ReadOnly = appendMissing(annotations.ReadOnly, versionAnnotations.ReadOnly)
+		annotations.WriteOnly = appendMissing(annotations.WriteOnly, versionAnnotations.WriteOnly)
+	}
+
+	slices.Sort(annotations.ReadOnly)
+	slices.Sort(annotations.WriteOnly)
+	return annotations
+}
+
+// appendMissing appends the values that are not already in the slice.
+func appendMissing(values []string, others []string) []string {
+	for _, other := range others {
+		if !slices.Contains(values, other) {
+			values = append(values, other)
+		}
+	}
+
+	return values
+}
+
+// formatValue formats a computed value for the table output. Values that are not strings are formatted as JSON.
+func formatValue(value any) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+
+	return string(b)
 }
@@ -17,6 +17,7 @@
 package show
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/radius-project/radius/pkg/cli/clients"
@@ -25,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/objectformats"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/test/radcli"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -102,6 +104,9 @@ func Test_Run(t *testing.T) {
 		appManagementClient.EXPECT().
 			GetResource(gomock.Any(), "applications.core/containers", "foo").
 			Return(resource, nil).Times(1)
+		appManagementClient.EXPECT().
+			GetResourceProviderSummary(gomock.Any(), "local", "applications.core").
+			Return(ucp.ResourceProviderSummary{}, errors.New("not found")).Times(1)
 
 		outputSink := &output.MockOutput{}
 
@@ -121,7 +126,82 @@ func Test_Run(t *testing.T) {
 			output.FormattedOutput{
 				Format:  "table",
 				Obj:     resource,
-				Options: objectformats.GetGenericResourceTableFormat(),
+				Options: objectformats.GetGenericResourceDetailsTableFormat(),
+			},
+		}
+		require.Equal(t, expected, outputSink.Writes)
+	})
+	t.Run("Validate rad resource show with field annotations", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		resource := radcli.CreateResource("MyCompany.Resources/databases", "orders")
+		resource.Properties = map[string]any{
+			"environment": "default",
+			"host":        "orders.example.com",
+			"port":        float64(5432),
+			"password":    "secret",
+		}
+
+		summary := ucp.ResourceProviderSummary{
+			Name: new("MyCompany.Resources"),
+			ResourceTypes: map[string]*ucp.ResourceProviderSummaryResourceType{
+				"databases": {
+					APIVersions: map[string]*ucp.ResourceTypeSummaryResultAPIVersion{
+						"2025-01-01-preview": {
+							Schema: map[string]any{
+								"type": "object",
+								"properties": map[string]any{
+									"environment": map[string]any{"type": "string"},
+									"host":        map[string]any{"type": "string", "x-radius-readonly": true},
+									"port":        map[string]any{"type": "integer", "x-radius-readonly": true},
+									"password":    map[string]any{"type": "string", "x-radius-writeonly": true},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResource(gomock.Any(), "MyCompany.Resources/databases", "orders").
+			Return(resource, nil).Times(1)
+		appManagementClient.EXPECT().
+			GetResourceProviderSummary(gomock.Any(), "local", "MyCompany.Resources").
+			Return(summary, nil).Times(1)
+
+		outputSink := &output.MockOutput{}
+
+		runner := &Runner{
+			ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Output:                         outputSink,
+			Workspace:                      &workspaces.Workspace{},
+			FullyQualifiedResourceTypeName: "MyCompany.Resources/databases",
+			ResourceName:                   "orders",
+			Format:                         "table",
+		}
+
+		err := runner.Run(t.Context())
+		require.NoError(t, err)
+
+		require.NotContains(t, resource.Properties, "password")
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     resource,
+				Options: objectformats.GetGenericResourceDetailsTableFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []ComputedValue{
+					{Name: "host", Value: "orders.example.com"},
+					{Name: "port", Value: "5432"},
+				},
+				Options: objectformats.GetComputedValuesTableFormat(),
 			},
 		}
 		require.Equal(t, expected, outputSink.Writes)
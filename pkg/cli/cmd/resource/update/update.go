@@ -0,0 +1,159 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package update
+
+import (
+	"context"
+
+	"github.com/radius-project/radius/pkg/cli"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	"github.com/radius-project/radius/pkg/cli/cmd/commonflags"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/spf13/cobra"
+	"helm.sh/helm/v4/pkg/strvals"
+)
+
+// NewCommand creates an instance of the `rad resource update` command and runner.
+func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
+	runner := NewRunner(factory)
+
+	cmd := &cobra.Command{
+		Use:   "update [resource type] [name] --set [key=value]",
+		Short: "Update properties of an existing resource",
+		Long: `Update properties of an existing resource
+
+Values are set with the --set flag using dot-separated paths from the root of the resource, for example
+properties.replicas=3 or tags.team=web. Only the given values are changed; all other properties keep their
+current values. Setting a value to null removes it.
+
+Sensitive properties are not returned by Radius and are not kept by an update, so they must be set again.`,
+		Example: `
+# Update a single property of a resource
+rad resource update Applications.Core/containers mycontainer --set properties.container.image=nginx:1.27
+
+# Update several properties at once
+rad resource update My.Company/postgreSQLDatabases db --set properties.size=L,tags.team=data
+
+# Remove a property from a resource
+rad resource update My.Company/postgreSQLDatabases db --set properties.backup=null`,
+		Args: cobra.ExactArgs(2),
+		RunE: framework.RunCommand(runner),
+	}
+
+	commonflags.AddOutputFlag(cmd)
+	commonflags.AddWorkspaceFlag(cmd)
+	commonflags.AddResourceGroupFlag(cmd)
+	cmd.Flags().StringArrayVar(&runner.Set, "set", []string{}, "Set values on the command line (can specify multiple or separate values with commas: key1=val1,key2=val2)")
+	_ = cmd.MarkFlagRequired("set")
+
+	return cmd, runner
+}
+
+// Runner is the Runner implementation for the `rad resource update` command.
+type Runner struct {
+	ConnectionFactory connections.Factory
+	ConfigHolder      *framework.ConfigHolder
+	Output            output.Interface
+	Format            string
+	Workspace         *workspaces.Workspace
+
+	FullyQualifiedResourceTypeName string
+	ResourceName                   string
+	Set                            []string
+	Patch                          map[string]any
+}
+
+// NewRunner creates an instance of the runner for the `rad resource update` command.
+func NewRunner(factory framework.Factory) *Runner {
+	return &Runner{
+		ConnectionFactory: factory.GetConnectionFactory(),
+		ConfigHolder:      factory.GetConfigHolder(),
+		Output:            factory.GetOutput(),
+	}
+}
+
+// Validate runs validation for the `rad resource update` command.
+func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
+	workspace, err := cli.RequireWorkspace(cmd, r.ConfigHolder.Config)
+	if err != nil {
+		return err
+	}
+	r.Workspace = workspace
+
+	scope, err := cli.RequireScope(cmd, *r.Workspace)
+	if err != nil {
+		return err
+	}
+	r.Workspace.Scope = scope
+
+	resourceProviderName, resourceTypeName, resourceName, err := cli.RequireFullyQualifiedResourceTypeAndName(args)
+	if err != nil {
+		return err
+	}
+	r.FullyQualifiedResourceTypeName = resourceProviderName + "/" + resourceTypeName
+	r.ResourceName = resourceName
+
+	format, err := cli.RequireOutput(cmd)
+	if err != nil {
+		return err
+	}
+	r.Format = format
+
+	r.Patch, err = parseSetValues(r.Set)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// parseSetValues builds a JSON merge patch from --set values. Values are typed the same way as Helm values, so
+// numbers and booleans are not sent as strings, and null removes the property from the resource.
+func parseSetValues(values []string) (map[string]any, error) {
+	patch := map[string]any{}
+	for _, value := range values {
+		if err := strvals.ParseInto(value, patch); err != nil {
+			return nil, clierrors.Message("Invalid --set value %q: %v", value, err)
+		}
+	}
+
+	if len(patch) == 0 {
+		return nil, clierrors.Message("At least one value must be specified with --set.")
+	}
+
+	return patch, nil
+}
+
+// Run runs the `rad resource update` command.
+func (r *Runner) Run(ctx context.Context) error {
+	client, err := r.ConnectionFactory.CreateApplicationsManagementClient(ctx, *r.Workspace)
+	if err != nil {
+		return err
+	}
+
+	r.Output.LogInfo("Updating resource %s/%s", r.FullyQualifiedResourceTypeName, r.ResourceName)
+	resource, err := client.UpdateResource(ctx, r.FullyQualifiedResourceTypeName, r.ResourceName, r.Patch)
+	if err != nil {
+		return err
+	}
+
+	return r.Output.WriteFormatted(r.Format, resource, objectformats.GetGenericResourceDetailsTableFormat())
+}
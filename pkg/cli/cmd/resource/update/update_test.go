@@ -0,0 +1,130 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package update
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/connections"
+	"github.com/radius-project/radius/pkg/cli/framework"
+	"github.com/radius-project/radius/pkg/cli/objectformats"
+	"github.com/radius-project/radius/pkg/cli/output"
+	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/test/radcli"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_CommandValidation(t *testing.T) {
+	radcli.SharedCommandValidation(t, NewCommand)
+}
+
+func Test_Validate(t *testing.T) {
+	configWithWorkspace := radcli.LoadConfigWithWorkspace(t)
+	testcases := []radcli.ValidateInput{
+		{
+			Name:          "Valid Update Command",
+			Input:         []string{"Applications.Test/exampleResources", "foo", "--set", "properties.replicas=3"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, map[string]any{"properties": map[string]any{"replicas": int64(3)}}, r.Patch)
+			},
+		},
+		{
+			Name:          "Update Command with multiple values",
+			Input:         []string{"Applications.Test/exampleResources", "foo", "--set", "properties.size=L,tags.team=data", "--set", "properties.backup=null"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+			ValidateCallback: func(t *testing.T, runner framework.Runner) {
+				r := runner.(*Runner)
+				require.Equal(t, map[string]any{
+					"properties": map[string]any{"size": "L", "backup": nil},
+					"tags":       map[string]any{"team": "data"},
+				}, r.Patch)
+			},
+		},
+		{
+			Name:          "Update Command without values",
+			Input:         []string{"Applications.Test/exampleResources", "foo"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Update Command with invalid value",
+			Input:         []string{"Applications.Test/exampleResources", "foo", "--set", "properties.replicas"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Update Command with invalid resource type",
+			Input:         []string{"invalidResourceType", "foo", "--set", "properties.replicas=3"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+		{
+			Name:          "Update Command with too many args",
+			Input:         []string{"Applications.Test/exampleResources", "a", "b", "--set", "properties.replicas=3"},
+			ExpectedValid: false,
+			ConfigHolder:  framework.ConfigHolder{Config: configWithWorkspace},
+		},
+	}
+	radcli.SharedValidateValidation(t, NewCommand, testcases)
+}
+
+func Test_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	patch := map[string]any{"properties": map[string]any{"replicas": int64(3)}}
+	resource := radcli.CreateResource("Applications.Test/exampleResources", "foo")
+
+	appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+	appManagementClient.EXPECT().
+		UpdateResource(gomock.Any(), "Applications.Test/exampleResources", "foo", patch).
+		Return(resource, nil).
+		Times(1)
+
+	outputSink := &output.MockOutput{}
+
+	runner := &Runner{
+		ConnectionFactory:              &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+		Output:                         outputSink,
+		Workspace:                      &workspaces.Workspace{},
+		FullyQualifiedResourceTypeName: "Applications.Test/exampleResources",
+		ResourceName:                   "foo",
+		Patch:                          patch,
+		Format:                         "table",
+	}
+
+	err := runner.Run(t.Context())
+	require.NoError(t, err)
+
+	expected := []any{
+		output.LogOutput{
+			Format: "Updating resource %s/%s",
+			Params: []any{"Applications.Test/exampleResources", "foo"},
+		},
+		output.FormattedOutput{
+			Format:  "table",
+			Obj:     resource,
+			Options: objectformats.GetGenericResourceDetailsTableFormat(),
+		},
+	}
+	require.Equal(t, expected, outputSink.Writes)
+}
@@ -37,3 +37,27 @@ func GetResourceProviderTableFormat() output.FormatterOptions {
 		},
 	}
 }
+
+// GetLocationHealthTableFormat returns the fields to output from the health of a resource provider's location addresses.
+func GetLocationHealthTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "LOCATION",
+				JSONPath: "{ .Location }",
+			},
+			{
+				Heading:  "ADDRESS",
+				JSONPath: "{ .Address }",
+			},
+			{
+				Heading:  "HEALTH",
+				JSONPath: "{ .Status }",
+			},
+			{
+				Heading:  "MESSAGE",
+				JSONPath: "{ .Message }",
+			},
+		},
+	}
+}
@@ -18,6 +18,7 @@ package show
 
 import (
 	"context"
+	"slices"
 
 	"github.com/radius-project/radius/pkg/cli"
 	"github.com/radius-project/radius/pkg/cli/clients"
@@ -28,6 +29,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/framework"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/spf13/cobra"
 )
 
@@ -111,5 +113,59 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	// Location health is part of the JSON output already, only show the table for other formats.
+	if r.Format != "json" {
+		health := locationHealthRows(resourceProviders)
+		if len(health) > 0 {
+			r.Output.LogInfo("")
+			err = r.Output.WriteFormatted(r.Format, health, common.GetLocationHealthTableFormat())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
+
+// locationHealthRow is a row of the location health table.
+type locationHealthRow struct {
+	Location string
+	Address  string
+	Status   string
+	Message  string
+}
+
+// locationHealthRows flattens the health of each location address of the resource provider, ordered by location name.
+func locationHealthRows(summary v20231001preview.ResourceProviderSummary) []locationHealthRow {
+	names := []string{}
+	for name, location := range summary.Locations {
+		if location != nil && location.Health != nil {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	rows := []locationHealthRow{}
+	for _, name := range names {
+		for _, address := range summary.Locations[name].Health.Addresses {
+			if address == nil {
+				continue
+			}
+
+			row := locationHealthRow{Location: name}
+			if address.Address != nil {
+				row.Address = *address.Address
+			}
+			if address.Status != nil {
+				row.Status = string(*address.Status)
+			}
+			if address.Message != nil {
+				row.Message = *address.Message
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	return rows
+}
@@ -19,6 +19,7 @@ package show
 import (
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/resourceprovider/common"
@@ -114,6 +115,73 @@ func Test_Run(t *testing.T) {
 		require.Equal(t, expected, outputSink.Writes)
 	})
 
+	t.Run("Success: Resource Provider With Location Health", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		resourceProvider := v20231001preview.ResourceProviderSummary{
+			Name: new("Applications.Test"),
+			Locations: map[string]*v20231001preview.ResourceProviderSummaryLocation{
+				"global": {
+					Health: &v20231001preview.LocationHealth{
+						Status: to.Ptr(v20231001preview.LocationHealthStatusDegraded),
+						Addresses: []*v20231001preview.LocationAddressHealth{
+							{
+								Address: new("http://primary:8080"),
+								Status:  to.Ptr(v20231001preview.LocationHealthStatusUnhealthy),
+								Message: new("connection refused"),
+							},
+							{
+								Address: new("http://standby:8080"),
+								Status:  to.Ptr(v20231001preview.LocationHealthStatusHealthy),
+							},
+						},
+					},
+				},
+				"east": {},
+			},
+		}
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			GetResourceProviderSummary(gomock.Any(), "local", "Applications.Test").
+			Return(resourceProvider, nil).
+			Times(1)
+
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory:         &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:                 &workspaces.Workspace{Name: "kind-kind", Scope: "/planes/radius/local/resourceGroups/test-group"},
+			Format:                    "table",
+			Output:                    outputSink,
+			ResourceProviderNamespace: "Applications.Test",
+		}
+
+		err := runner.Run(t.Context())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.FormattedOutput{
+				Format:  "table",
+				Obj:     resourceProvider,
+				Options: common.GetResourceProviderTableFormat(),
+			},
+			output.LogOutput{
+				Format: "",
+			},
+			output.FormattedOutput{
+				Format: "table",
+				Obj: []locationHealthRow{
+					{Location: "global", Address: "http://primary:8080", Status: "Unhealthy", Message: "connection refused"},
+					{Location: "global", Address: "http://standby:8080", Status: "Healthy"},
+				},
+				Options: common.GetLocationHealthTableFormat(),
+			},
+		}
+
+		require.Equal(t, expected, outputSink.Writes)
+	})
+
 	t.Run("Error: Resource Provider Not Found", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -36,12 +36,18 @@ type ResourceType struct {
 	ResourceProviderNamespace string
 	// APIVersions is the list of API versions supported by the resource type.
 	APIVersions map[string]*APIVersionProperties
+	// Lifecycle is the lifecycle of the resource type.
+	Lifecycle *v20231001preview.ResourceTypeLifecycle `json:",omitempty"`
+	// Usage is the number of stored resources of the resource type, by API version.
+	Usage *v20231001preview.ResourceTypeUsage `json:",omitempty"`
 }
 
 // APIVersionProperties is used to store the schema of the resource type for the api version.
 type APIVersionProperties struct {
 	// Schema is the schema of the resource type.
 	Schema map[string]any
+	// Lifecycle is the lifecycle of the api version.
+	Lifecycle *v20231001preview.ResourceTypeLifecycle `json:",omitempty"`
 }
 
 // ResourceTypeListOutputFormat is used to format the output of the resource type list and create commands.
@@ -63,11 +69,13 @@ func ResourceTypesForProvider(provider *v20231001preview.ResourceProviderSummary
 		if resourceType.Description != nil {
 			rt.Description = *resourceType.Description
 		}
+		rt.Lifecycle = resourceType.Lifecycle
 
 		rt.APIVersions = make(map[string]*APIVersionProperties)
 		for apiVersion, properties := range resourceType.APIVersions {
 			rt.APIVersions[apiVersion] = &APIVersionProperties{
-				Schema: properties.Schema,
+				Schema:    properties.Schema,
+				Lifecycle: properties.Lifecycle,
 			}
 		}
 
@@ -47,6 +47,8 @@ func NewCommand(factory framework.Factory) (*cobra.Command, framework.Runner) {
 
 Deleting a resource type will delete the specified resource type. For example, deleting 'Applications.Core/containers' will delete that type (but not deployed instances of the type).
 
+A resource type cannot be deleted while resources of that type exist. Use --force to delete the resource type anyway.
+
 The resource type name argument must be a fully qualified resource type name in the format 'ResourceType.Namespace/resourceTypeName' (e.g., 'Radius.Compute/containers').
 `,
 		Example: `
@@ -54,12 +56,16 @@ The resource type name argument must be a fully qualified resource type name in
 rad resource-type delete Radius.Compute/containers
 
 # Delete a resource type (bypass confirmation)
-rad resource-type delete Applications.Core/containers --yes`,
+rad resource-type delete Applications.Core/containers --yes
+
+# Delete a resource type even if resources of that type exist
+rad resource-type delete Applications.Core/containers --force`,
 		Args: cobra.ExactArgs(1),
 		RunE: framework.RunCommand(runner),
 	}
 
 	commonflags.AddConfirmationFlag(cmd)
+	cmd.Flags().Bool("force", false, "Delete the resource type even if resources of that type exist")
 	commonflags.AddOutputFlag(cmd)
 	commonflags.AddWorkspaceFlag(cmd)
 
@@ -76,6 +82,7 @@ type Runner struct {
 	Workspace         *workspaces.Workspace
 
 	Confirm                   bool
+	Force                     bool
 	ResourceTypeName          string
 	ResourceProviderNamespace string
 	ResourceTypeSuffix        string
@@ -110,6 +117,11 @@ func (r *Runner) Validate(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	r.Force, err = cmd.Flags().GetBool("force")
+	if err != nil {
+		return err
+	}
+
 	r.ResourceProviderNamespace, r.ResourceTypeSuffix, err = cli.RequireFullyQualifiedResourceType(args)
 	if err != nil {
 		return err
@@ -137,7 +149,7 @@ func (r *Runner) Run(ctx context.Context) error {
 		}
 	}
 
-	deleted, err := client.DeleteResourceType(ctx, "local", r.ResourceProviderNamespace, r.ResourceTypeSuffix)
+	deleted, err := client.DeleteResourceType(ctx, "local", r.ResourceProviderNamespace, r.ResourceTypeSuffix, r.Force)
 	if clients.Is404Error(err) {
 		return clierrors.Message("The resource type %q was not found or has been deleted.", r.ResourceTypeName)
 	} else if err != nil {
@@ -44,6 +44,12 @@ func Test_Validate(t *testing.T) {
 			ExpectedValid: true,
 			ConfigHolder:  framework.ConfigHolder{Config: config},
 		},
+		{
+			Name:          "Valid: force",
+			Input:         []string{"Applications.Test/testResources", "--force"},
+			ExpectedValid: true,
+			ConfigHolder:  framework.ConfigHolder{Config: config},
+		},
 		{
 			Name:          "Invalid: bad name",
 			Input:         []string{"Applications.Test"},
@@ -73,7 +79,7 @@ func Test_Run(t *testing.T) {
 
 		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
 		appManagementClient.EXPECT().
-			DeleteResourceType(gomock.Any(), "local", "Applications.Test", "testResources").
+			DeleteResourceType(gomock.Any(), "local", "Applications.Test", "testResources", false).
 			Return(true, nil).
 			Times(1)
 
@@ -116,7 +122,7 @@ func Test_Run(t *testing.T) {
 
 		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
 		appManagementClient.EXPECT().
-			DeleteResourceType(gomock.Any(), "local", "Applications.Test", "testResources").
+			DeleteResourceType(gomock.Any(), "local", "Applications.Test", "testResources", false).
 			Return(false, nil).
 			Times(1)
 
@@ -159,7 +165,7 @@ func Test_Run(t *testing.T) {
 
 		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
 		appManagementClient.EXPECT().
-			DeleteResourceType(gomock.Any(), "local", "Applications.Test", "testResources").
+			DeleteResourceType(gomock.Any(), "local", "Applications.Test", "testResources", false).
 			Return(true, nil).
 			Times(1)
 
@@ -201,4 +207,48 @@ func Test_Run(t *testing.T) {
 
 		require.Equal(t, expected, outputSink.Writes)
 	})
+
+	t.Run("Success: Resource Type Force Deleted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		appManagementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		appManagementClient.EXPECT().
+			DeleteResourceType(gomock.Any(), "local", "Applications.Test", "testResources", true).
+			Return(true, nil).
+			Times(1)
+
+		workspace := &workspaces.Workspace{
+			Connection: map[string]any{
+				"kind":    "kubernetes",
+				"context": "kind-kind",
+			},
+			Name:  "kind-kind",
+			Scope: "/planes/radius/local/resourceGroups/test-group",
+		}
+		outputSink := &output.MockOutput{}
+		runner := &Runner{
+			ConnectionFactory:         &connections.MockFactory{ApplicationsManagementClient: appManagementClient},
+			Workspace:                 workspace,
+			Format:                    "table",
+			Output:                    outputSink,
+			ResourceTypeName:          "Applications.Test/testResources",
+			ResourceProviderNamespace: "Applications.Test",
+			ResourceTypeSuffix:        "testResources",
+			Confirm:                   true,
+			Force:                     true,
+		}
+
+		err := runner.Run(t.Context())
+		require.NoError(t, err)
+
+		expected := []any{
+			output.LogOutput{
+				Format: "%s deleted",
+				Params: []any{"Applications.Test/testResources"},
+			},
+		}
+
+		require.Equal(t, expected, outputSink.Writes)
+	})
 }
@@ -21,8 +21,10 @@ import (
 	"fmt"
 	"slices"
 	"sort"
+	"time"
 
 	"github.com/radius-project/radius/pkg/cli/cmd/resourcetype/common"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 )
 
 // PropertiesOutputFormat holds a nested field path as Heading and its schema definition.
@@ -105,8 +107,24 @@ const (
 func (r *Runner) display(resourceTypeDetails *common.ResourceType) error {
 	r.Output.LogInfo("\nDESCRIPTION:")
 	r.Output.LogInfo("%s", resourceTypeDetails.Description)
+	if resourceTypeDetails.Lifecycle != nil {
+		r.Output.LogInfo("\nLIFECYCLE: %s", formatLifecycle(resourceTypeDetails.Lifecycle))
+	}
+	if resourceTypeDetails.Usage != nil && resourceTypeDetails.Usage.Count != nil {
+		r.Output.LogInfo("\nRESOURCES: %d\n", *resourceTypeDetails.Usage.Count)
+	}
 	for apiVersion, apiVersionProperties := range resourceTypeDetails.APIVersions {
 		r.Output.LogInfo("API VERSION: %s\n", apiVersion)
+		if apiVersionProperties.Lifecycle != nil {
+			r.Output.LogInfo("LIFECYCLE: %s\n", formatLifecycle(apiVersionProperties.Lifecycle))
+		}
+		if resourceTypeDetails.Usage != nil {
+			count := int32(0)
+			if usage, ok := resourceTypeDetails.Usage.APIVersions[apiVersion]; ok && usage.Count != nil {
+				count = *usage.Count
+			}
+			r.Output.LogInfo("RESOURCES: %d\n", count)
+		}
 		propertyTitleStatus := PropertyTitleNone
 		if apiVersionProperties.Schema != nil {
 			resourceTypeSchema := GetResourceTypeSchema(apiVersionProperties.Schema)
@@ -163,6 +181,23 @@ func (r *Runner) display(resourceTypeDetails *common.ResourceType) error {
 	return nil
 }
 
+// formatLifecycle formats the lifecycle of a resource type or API version for display.
+// Example: "Deprecated (sunset 2026-01-01). Use Test.Resources/otherType instead."
+func formatLifecycle(lifecycle *v20231001preview.ResourceTypeLifecycle) string {
+	text := ""
+	if lifecycle.State != nil {
+		text = string(*lifecycle.State)
+	}
+	if lifecycle.SunsetDate != nil {
+		text += fmt.Sprintf(" (sunset %s)", lifecycle.SunsetDate.UTC().Format(time.DateOnly))
+	}
+	if lifecycle.Message != nil {
+		text += ". " + *lifecycle.Message
+	}
+
+	return text
+}
+
 // GetResourceTypeSchema extracts the field schema from each fields in the resource type schema.
 // It returns a map where the keys are property names and the values are FieldSchema objects.
 func GetResourceTypeSchema(schema map[string]any) map[string]FieldSchema {
@@ -204,6 +239,10 @@ func GetResourceTypeSchema(schema map[string]any) map[string]FieldSchema {
 			if readOnly, ok := prop["readOnly"]; ok && readOnly == true {
 				isReadOnly = true
 			}
+			// Fields computed by Radius are read-only for the user.
+			if readOnly, ok := prop["x-radius-readonly"]; ok && readOnly == true {
+				isReadOnly = true
+			}
 
 			fieldSchema[propertyName] = FieldSchema{
 				Name:        propertyName,
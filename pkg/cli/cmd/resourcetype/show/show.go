@@ -114,6 +114,12 @@ func (r *Runner) Run(ctx context.Context) error {
 		return err
 	}
 
+	// Usage is best-effort. Older control planes don't support the usage query.
+	usage, err := r.UCPClientFactory.NewResourceTypesClient().GetUsage(ctx, "local", r.ResourceProviderNamespace, r.ResourceTypeSuffix, nil)
+	if err == nil {
+		resourceTypeDetails.Usage = &usage.ResourceTypeUsage
+	}
+
 	err = r.Output.WriteFormatted(r.Format, resourceTypeDetails, common.GetResourceTypeShowTableFormat())
 	if err != nil {
 		return err
@@ -18,6 +18,7 @@ package show
 
 import (
 	"testing"
+	"time"
 
 	"github.com/radius-project/radius/pkg/cli/clierrors"
 	"github.com/radius-project/radius/pkg/cli/cmd/resourcetype/common"
@@ -25,6 +26,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/manifest"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/workspaces"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/test/radcli"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -96,6 +98,19 @@ func Test_Run(t *testing.T) {
 					},
 				},
 			}},
+			Usage: &v20231001preview.ResourceTypeUsage{
+				ResourceType: new("MyCompany.Resources/testResources"),
+				Count:        new(int32(2)),
+				APIVersions: map[string]*v20231001preview.APIVersionUsage{
+					"2023-10-01-preview": {
+						Count: new(int32(2)),
+						Resources: []*string{
+							new("/planes/radius/local/resourceGroups/test-group/providers/MyCompany.Resources/testResources/one"),
+							new("/planes/radius/local/resourceGroups/test-group/providers/MyCompany.Resources/testResources/two"),
+						},
+					},
+				},
+			},
 		}
 
 		clientFactory, err := manifest.NewTestClientFactory(manifest.WithResourceProviderServerNoError)
@@ -136,10 +151,18 @@ func Test_Run(t *testing.T) {
 				Format: "%s",
 				Params: []any{"Resource type description"},
 			},
+			output.LogOutput{
+				Format: "\nRESOURCES: %d\n",
+				Params: []any{int32(2)},
+			},
 			output.LogOutput{
 				Format: "API VERSION: %s\n",
 				Params: []any{"2023-10-01-preview"},
 			},
+			output.LogOutput{
+				Format: "RESOURCES: %d\n",
+				Params: []any{int32(2)},
+			},
 			output.LogOutput{
 				Format: "TOP-LEVEL PROPERTIES:\n",
 			},
@@ -403,3 +426,14 @@ func Test_GetResourceTypeSchema_AdditionalProperties(t *testing.T) {
 		},
 	}, result)
 }
+
+func Test_formatLifecycle(t *testing.T) {
+	lifecycle := &v20231001preview.ResourceTypeLifecycle{
+		State:      new(v20231001preview.LifecycleStateDeprecated),
+		SunsetDate: new(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Message:    new("Use MyCompany.Resources/otherResources instead."),
+	}
+	require.Equal(t, "Deprecated (sunset 2026-01-01). Use MyCompany.Resources/otherResources instead.", formatLifecycle(lifecycle))
+
+	require.Equal(t, "GA", formatLifecycle(&v20231001preview.ResourceTypeLifecycle{State: new(v20231001preview.LifecycleStateGA)}))
+}
@@ -92,7 +92,7 @@ func (i *impl) CreateDeploymentClient(ctx context.Context, workspace workspaces.
 }
 
 // CreateDiagnosticsClient creates a DiagnosticsClient by connecting to a workspace, testing the connection, and creating
-// clients for applications, containers, environments, and gateways. If an error occurs, it is returned.
+// clients for applications, containers, environments, gateways and resource type schemas. If an error occurs, it is returned.
 func (i *impl) CreateDiagnosticsClient(ctx context.Context, workspace workspaces.Workspace) (clients.DiagnosticsClient, error) {
 	connection, err := workspace.Connect(ctx)
 	if err != nil {
@@ -136,6 +136,11 @@ func (i *impl) CreateDiagnosticsClient(ctx context.Context, workspace workspaces
 			return nil, err
 		}
 
+		managementClient, err := i.CreateApplicationsManagementClient(ctx, workspace)
+		if err != nil {
+			return nil, err
+		}
+
 		return &deployment.ARMDiagnosticsClient{
 			K8sTypedClient:    k8sClient,
 			RestConfig:        config,
@@ -144,6 +149,7 @@ func (i *impl) CreateDiagnosticsClient(ctx context.Context, workspace workspaces
 			ContainerClient:   *cntrClient,
 			EnvironmentClient: *envClient,
 			GatewayClient:     *gwClient,
+			ManagementClient:  managementClient,
 		}, nil
 	default:
 		return nil, fmt.Errorf("unsupported connection type: %+v", connection)
@@ -205,7 +211,7 @@ func (*impl) CreateApplicationsManagementClient(ctx context.Context, workspace w
 // Creates Credential management client to interact with server side credentials.
 //
 
-// CreateCredentialManagementClient establishes a connection to a workspace, tests the connection, creates Azure and AWS
+// CreateCredentialManagementClient establishes a connection to a workspace, tests the connection, creates Azure, AWS and GCP
 // credential clients, and returns a UCPCredentialManagementClient. An error is returned if any of the steps fail.
 func (*impl) CreateCredentialManagementClient(ctx context.Context, workspace workspaces.Workspace) (cli_credential.CredentialManagementClient, error) {
 	connection, err := workspace.Connect(ctx)
@@ -225,6 +231,11 @@ func (*impl) CreateCredentialManagementClient(ctx context.Context, workspace wor
 		return nil, err
 	}
 
+	gcpCredentialClient, err := v20231001preview.NewGcpCredentialsClient(&aztoken.AnonymousCredential{}, clientOptions)
+	if err != nil {
+		return nil, err
+	}
+
 	azureCMClient := &cli_credential.AzureCredentialManagementClient{
 		AzureCredentialClient: *azureCredentialClient,
 	}
@@ -233,9 +244,14 @@ func (*impl) CreateCredentialManagementClient(ctx context.Context, workspace wor
 		AWSCredentialClient: *awsCredentialClient,
 	}
 
+	gcpCMClient := &cli_credential.GCPCredentialManagementClient{
+		GCPCredentialClient: *gcpCredentialClient,
+	}
+
 	cpClient := &cli_credential.UCPCredentialManagementClient{
 		AzClient:  azureCMClient,
 		AWSClient: awsCMClient,
+		GCPClient: gcpCMClient,
 	}
 
 	return cpClient, nil
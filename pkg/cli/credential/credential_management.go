@@ -27,6 +27,7 @@ import (
 const (
 	AzurePlaneType    = "azure"
 	AWSPlaneType      = "aws"
+	GCPPlaneType      = "gcp"
 	AzureCredential   = "azure"
 	AzurePlaneName    = "azurecloud"
 	defaultSecretName = "default"
@@ -44,13 +45,15 @@ type CredentialManagementClient interface {
 	PutAWS(ctx context.Context, credential_config ucp.AwsCredentialResource) error
 	// PutAzure registers an Azure credential with the respective ucp provider plane.
 	PutAzure(ctx context.Context, credential_config ucp.AzureCredentialResource) error
+	// PutGCP registers a GCP credential with the respective ucp provider plane.
+	PutGCP(ctx context.Context, credential_config ucp.GcpCredentialResource) error
 	// Delete unregisters credential from the given ucp provider plane.
 	Delete(ctx context.Context, providerName string) (bool, error)
 }
 
 // CloudProviderStatus is the representation of a cloud provider configuration.
 type CloudProviderStatus struct {
-	// Name is the name/kind of the provider. For right now this only supports Azure and AWS.
+	// Name is the name/kind of the provider. For right now this only supports Azure, AWS and GCP.
 	Name string
 
 	// Enabled is the enabled/disabled status of the provider.
@@ -65,12 +68,16 @@ type ProviderCredentialConfiguration struct {
 
 	// AWSCredentials is used to set the credentials on Puts. It is NOT returned on Get/List.
 	AWSCredentials *AWSCredentialProperties
+
+	// GCPCredentials is used to set the credentials on Puts. It is NOT returned on Get/List.
+	GCPCredentials *GCPCredentialProperties
 }
 
 // UCPCredentialManagementClient implements operations to manage credentials on ucp.
 type UCPCredentialManagementClient struct {
 	AzClient  AzureCredentialManagementClientInterface
 	AWSClient AWSCredentialManagementClientInterface
+	GCPClient GCPCredentialManagementClientInterface
 }
 
 var _ CredentialManagementClient = (*UCPCredentialManagementClient)(nil)
@@ -94,6 +101,16 @@ func (cpm *UCPCredentialManagementClient) PutAzure(ctx context.Context, credenti
 	return err
 }
 
+// PutGCP registers credentials with the provided credential config
+//
+
+// PutGCP takes in a context and a GcpCredentialResource object and attempts to store it through the GCP Client,
+// returning an error if unsuccessful.
+func (cpm *UCPCredentialManagementClient) PutGCP(ctx context.Context, credential ucp.GcpCredentialResource) error {
+	err := cpm.GCPClient.Put(ctx, credential)
+	return err
+}
+
 // Get, gets the credential from the provided ucp provider plane
 // We've a single credential configured today for all providers which we name as "default"
 // example: If we ask for azure credential, then we will fetch the credential with the name "default" because that is the only
@@ -112,6 +129,9 @@ func (cpm *UCPCredentialManagementClient) Get(ctx context.Context, providerName
 	} else if strings.EqualFold(providerName, AWSCredential) {
 		// We send only the name when getting credentials from backend which we already have access to
 		cred, err = cpm.AWSClient.Get(ctx, defaultSecretName)
+	} else if strings.EqualFold(providerName, GCPCredential) {
+		// We send only the name when getting credentials from backend which we already have access to
+		cred, err = cpm.GCPClient.Get(ctx, defaultSecretName)
 	} else {
 		return ProviderCredentialConfiguration{}, &ErrUnsupportedCloudProvider{}
 	}
@@ -134,8 +154,8 @@ func (cpm *UCPCredentialManagementClient) Get(ctx context.Context, providerName
 // List, lists the credentials registered with all ucp provider planes
 //
 
-// List() lists the credentials from both Azure and AWS and returns a slice of CloudProviderStatus. It returns
-// an error if either of the list operations fail.
+// List() lists the credentials from Azure, AWS and GCP and returns a slice of CloudProviderStatus. It returns
+// an error if any of the list operations fail.
 func (cpm *UCPCredentialManagementClient) List(ctx context.Context) ([]CloudProviderStatus, error) {
 	// list azure credential
 	res, err := cpm.AzClient.List(ctx)
@@ -149,6 +169,13 @@ func (cpm *UCPCredentialManagementClient) List(ctx context.Context) ([]CloudProv
 		return nil, err
 	}
 	res = append(res, awsList...)
+
+	// list gcp credential
+	gcpList, err := cpm.GCPClient.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res = append(res, gcpList...)
 	return res, nil
 }
 
@@ -165,6 +192,8 @@ func (cpm *UCPCredentialManagementClient) Delete(ctx context.Context, providerNa
 		return cpm.AzClient.Delete(ctx, defaultSecretName)
 	} else if strings.EqualFold(providerName, AWSCredential) {
 		return cpm.AWSClient.Delete(ctx, defaultSecretName)
+	} else if strings.EqualFold(providerName, GCPCredential) {
+		return cpm.GCPClient.Delete(ctx, defaultSecretName)
 	}
 
 	return true, nil
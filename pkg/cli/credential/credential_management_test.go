@@ -33,6 +33,7 @@ import (
 const (
 	azureProviderName = "azure"
 	awsProviderName   = "aws"
+	gcpProviderName   = "gcp"
 	clientID          = "00000000-0000-0000-0000-000000000000"
 	tenantID          = "00000000-0000-0000-0000-000000000000"
 	credentialName    = "default"
@@ -279,6 +280,7 @@ func Test_Credential_List(t *testing.T) {
 
 	azMockCredentialClient := NewMockAzureCredentialManagementClientInterface(mockCtrl)
 	awsMockCredentialClient := NewMockAWSCredentialManagementClientInterface(mockCtrl)
+	gcpMockCredentialClient := NewMockGCPCredentialManagementClientInterface(mockCtrl)
 
 	azureList := []CloudProviderStatus{
 		{
@@ -300,14 +302,25 @@ func Test_Credential_List(t *testing.T) {
 		List(gomock.Any()).
 		Return(awsList, nil).
 		Times(1)
+	gcpList := []CloudProviderStatus{
+		{
+			Name:    GCPCredential,
+			Enabled: true,
+		},
+	}
+	gcpMockCredentialClient.EXPECT().
+		List(gomock.Any()).
+		Return(gcpList, nil).
+		Times(1)
 
 	cliCredentialClient := UCPCredentialManagementClient{
 		AzClient:  azMockCredentialClient,
 		AWSClient: awsMockCredentialClient,
+		GCPClient: gcpMockCredentialClient,
 	}
 	resp, err := cliCredentialClient.List(ctx)
 	require.NoError(t, err)
-	require.Equal(t, len(resp), 2)
+	require.Equal(t, len(resp), 3)
 }
 
 func Test_Credential_Azure_Show(t *testing.T) {
@@ -360,6 +373,49 @@ func Test_Credential_AWS_Show(t *testing.T) {
 	require.Equal(t, AWSProvider, expectedAWSProvider)
 }
 
+func Test_Credential_GCP_Show(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	t.Cleanup(cancel)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	gcpMockCredentialClient := NewMockGCPCredentialManagementClientInterface(mockCtrl)
+
+	expectedGCPProvider := ProviderCredentialConfiguration{
+		CloudProviderStatus: CloudProviderStatus{
+			Name:    gcpProviderName,
+			Enabled: true,
+		},
+	}
+
+	gcpMockCredentialClient.EXPECT().Get(gomock.Any(), credentialName).Return(expectedGCPProvider, nil).Times(1)
+	cliCredentialClient := UCPCredentialManagementClient{
+		GCPClient: gcpMockCredentialClient,
+	}
+	gcpProvider, err := cliCredentialClient.Get(ctx, gcpProviderName)
+	require.NoError(t, err)
+	require.Equal(t, expectedGCPProvider, gcpProvider)
+}
+
+func Test_Credential_GCP_Delete(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	t.Cleanup(cancel)
+
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	gcpMockCredentialClient := NewMockGCPCredentialManagementClientInterface(mockCtrl)
+	gcpMockCredentialClient.EXPECT().Delete(gomock.Any(), credentialName).Return(true, nil).Times(1)
+
+	cliCredentialClient := UCPCredentialManagementClient{
+		GCPClient: gcpMockCredentialClient,
+	}
+	isDeleted, err := cliCredentialClient.Delete(ctx, gcpProviderName)
+	require.NoError(t, err)
+	require.True(t, isDeleted)
+}
+
 func Test_Credential_Delete(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -0,0 +1,179 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credential
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/radius-project/radius/pkg/cli/clierrors"
+	ucp "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+//go:generate go tool mockgen -typed -destination=./mock_gcp_credential_management.go -package=credential -self_package github.com/radius-project/radius/pkg/cli/credential github.com/radius-project/radius/pkg/cli/credential GCPCredentialManagementClientInterface
+
+// GCPCredentialManagementClient is used to interface with cloud provider configuration and credentials.
+type GCPCredentialManagementClient struct {
+	GCPCredentialClient ucp.GcpCredentialsClient
+}
+
+const (
+	GCPCredential = "gcp"
+	GCPPlaneName  = "gcp"
+)
+
+type GCPCredentialProperties struct {
+	// Kind is the credential kind (ServiceAccountKey or WorkloadIdentity)
+	Kind *string
+
+	// ServiceAccountKey is the properties for a GCP service account key credential
+	ServiceAccountKey *GCPServiceAccountKeyCredentialProperties
+
+	// WorkloadIdentity is the properties for a GCP workload identity federation credential
+	WorkloadIdentity *GCPWorkloadIdentityCredentialProperties
+}
+
+type GCPServiceAccountKeyCredentialProperties struct {
+	// Kind is the credential kind (Must be ServiceAccountKey)
+	Kind *string
+}
+
+type GCPWorkloadIdentityCredentialProperties struct {
+	// Kind is the credential kind (Must be WorkloadIdentity)
+	Kind *string
+
+	// Audience is the workload identity pool provider audience
+	Audience *string
+
+	// ServiceAccountEmail is the GCP service account impersonated by the workload identity
+	ServiceAccountEmail *string
+}
+
+// GCPCredentialManagementClientInterface is used to interface with cloud provider configuration and credentials.
+type GCPCredentialManagementClientInterface interface {
+	// Get gets the credential registered with the given ucp provider plane.
+	Get(ctx context.Context, name string) (ProviderCredentialConfiguration, error)
+	// List lists the credentials registered with all ucp provider planes.
+	List(ctx context.Context) ([]CloudProviderStatus, error)
+	// Put registers a GCP credential with the respective ucp provider plane.
+	Put(ctx context.Context, credential_config ucp.GcpCredentialResource) error
+	// Delete unregisters credential from the given ucp provider plane.
+	Delete(ctx context.Context, name string) (bool, error)
+}
+
+// Put checks if the credential type is "GCPCredential" and if so, creates or updates the credential in the GCP plane,
+// otherwise it returns an error.
+func (cpm *GCPCredentialManagementClient) Put(ctx context.Context, credential ucp.GcpCredentialResource) error {
+	if strings.EqualFold(*credential.Type, GCPCredential) {
+		_, err := cpm.GCPCredentialClient.CreateOrUpdate(ctx, GCPPlaneName, defaultSecretName, credential, nil)
+		return err
+	}
+	return &ErrUnsupportedCloudProvider{}
+}
+
+// Get retrieves the credentials for the GCP cloud provider from the backend and returns a ProviderCredentialConfiguration
+// object containing the credentials or an error if the credentials could not be retrieved.
+func (cpm *GCPCredentialManagementClient) Get(ctx context.Context, credentialName string) (ProviderCredentialConfiguration, error) {
+	// We send only the name when getting credentials from backend which we already have access to
+	resp, err := cpm.GCPCredentialClient.Get(ctx, GCPPlaneName, credentialName, nil)
+	if err != nil {
+		return ProviderCredentialConfiguration{}, err
+	}
+
+	switch *resp.GcpCredentialResource.Properties.GetGcpCredentialProperties().Kind {
+	case ucp.GCPCredentialKindServiceAccountKey:
+		gcpServiceAccountKeyCredentials, ok := resp.GcpCredentialResource.Properties.(*ucp.GcpServiceAccountKeyCredentialProperties)
+		if !ok {
+			return ProviderCredentialConfiguration{}, clierrors.Message("Unable to find credentials for cloud provider %s.", GCPCredential)
+		}
+
+		providerCredentialConfiguration := ProviderCredentialConfiguration{
+			CloudProviderStatus: CloudProviderStatus{
+				Name:    GCPCredential,
+				Enabled: true,
+			},
+			GCPCredentials: &GCPCredentialProperties{
+				Kind:              (*string)(gcpServiceAccountKeyCredentials.Kind),
+				ServiceAccountKey: &GCPServiceAccountKeyCredentialProperties{},
+			},
+		}
+		return providerCredentialConfiguration, nil
+	case ucp.GCPCredentialKindWorkloadIdentity:
+		gcpWorkloadIdentityCredentials, ok := resp.GcpCredentialResource.Properties.(*ucp.GcpWorkloadIdentityCredentialProperties)
+		if !ok {
+			return ProviderCredentialConfiguration{}, clierrors.Message("Unable to find credentials for cloud provider %s.", GCPCredential)
+		}
+
+		providerCredentialConfiguration := ProviderCredentialConfiguration{
+			CloudProviderStatus: CloudProviderStatus{
+				Name:    GCPCredential,
+				Enabled: true,
+			},
+			GCPCredentials: &GCPCredentialProperties{
+				Kind: (*string)(gcpWorkloadIdentityCredentials.Kind),
+				WorkloadIdentity: &GCPWorkloadIdentityCredentialProperties{
+					Audience:            gcpWorkloadIdentityCredentials.Audience,
+					ServiceAccountEmail: gcpWorkloadIdentityCredentials.ServiceAccountEmail,
+				},
+			},
+		}
+		return providerCredentialConfiguration, nil
+
+	default:
+		return ProviderCredentialConfiguration{}, clierrors.Message("Unable to find credentials for cloud provider %s.", GCPCredential)
+	}
+}
+
+// List retrieves a list of GCP credentials and returns a slice of CloudProviderStatus objects containing the name and
+// enabled status of each credential. If an error occurs, an error is returned.
+func (cpm *GCPCredentialManagementClient) List(ctx context.Context) ([]CloudProviderStatus, error) {
+	// list GCP credential
+	var providerList []*ucp.GcpCredentialResource
+
+	pager := cpm.GCPCredentialClient.NewListPager(GCPPlaneName, nil)
+	for pager.More() {
+		nextPage, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		credList := nextPage.GcpCredentialResourceListResult.Value
+		providerList = append(providerList, credList...)
+	}
+
+	res := []CloudProviderStatus{}
+	if len(providerList) > 0 {
+		res = append(res, CloudProviderStatus{
+			Name:    GCPCredential,
+			Enabled: true,
+		})
+	}
+	return res, nil
+}
+
+// Delete checks if a credential for the provider plane is registered and if so, deletes it; if not, it returns true
+// without an error. If an error occurs, it returns false and the error.
+func (cpm *GCPCredentialManagementClient) Delete(ctx context.Context, name string) (bool, error) {
+	var respFromCtx *http.Response
+	ctxWithResp := policy.WithCaptureResponse(ctx, &respFromCtx)
+	_, err := cpm.GCPCredentialClient.Delete(ctxWithResp, GCPPlaneName, name, nil)
+	if err != nil {
+		return false, err
+	}
+	return respFromCtx.StatusCode != 204, nil
+}
@@ -233,3 +233,41 @@ func (c *MockCredentialManagementClientPutAzureCall) DoAndReturn(f func(context.
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// PutGCP mocks base method.
+func (m *MockCredentialManagementClient) PutGCP(ctx context.Context, credential_config v20231001preview.GcpCredentialResource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutGCP", ctx, credential_config)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutGCP indicates an expected call of PutGCP.
+func (mr *MockCredentialManagementClientMockRecorder) PutGCP(ctx, credential_config any) *MockCredentialManagementClientPutGCPCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutGCP", reflect.TypeOf((*MockCredentialManagementClient)(nil).PutGCP), ctx, credential_config)
+	return &MockCredentialManagementClientPutGCPCall{Call: call}
+}
+
+// MockCredentialManagementClientPutGCPCall wrap *gomock.Call
+type MockCredentialManagementClientPutGCPCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockCredentialManagementClientPutGCPCall) Return(arg0 error) *MockCredentialManagementClientPutGCPCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockCredentialManagementClientPutGCPCall) Do(f func(context.Context, v20231001preview.GcpCredentialResource) error) *MockCredentialManagementClientPutGCPCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockCredentialManagementClientPutGCPCall) DoAndReturn(f func(context.Context, v20231001preview.GcpCredentialResource) error) *MockCredentialManagementClientPutGCPCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,197 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/radius-project/radius/pkg/cli/credential (interfaces: GCPCredentialManagementClientInterface)
+//
+// Generated by this command:
+//
+//	mockgen -typed -destination=./mock_gcp_credential_management.go -package=credential -self_package github.com/radius-project/radius/pkg/cli/credential github.com/radius-project/radius/pkg/cli/credential GCPCredentialManagementClientInterface
+//
+
+// Package credential is a generated GoMock package.
+package credential
+
+import (
+	context "context"
+	reflect "reflect"
+
+	v20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGCPCredentialManagementClientInterface is a mock of GCPCredentialManagementClientInterface interface.
+type MockGCPCredentialManagementClientInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockGCPCredentialManagementClientInterfaceMockRecorder
+	isgomock struct{}
+}
+
+// MockGCPCredentialManagementClientInterfaceMockRecorder is the mock recorder for MockGCPCredentialManagementClientInterface.
+type MockGCPCredentialManagementClientInterfaceMockRecorder struct {
+	mock *MockGCPCredentialManagementClientInterface
+}
+
+// NewMockGCPCredentialManagementClientInterface creates a new mock instance.
+func NewMockGCPCredentialManagementClientInterface(ctrl *gomock.Controller) *MockGCPCredentialManagementClientInterface {
+	mock := &MockGCPCredentialManagementClientInterface{ctrl: ctrl}
+	mock.recorder = &MockGCPCredentialManagementClientInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGCPCredentialManagementClientInterface) EXPECT() *MockGCPCredentialManagementClientInterfaceMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockGCPCredentialManagementClientInterface) Delete(ctx context.Context, name string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockGCPCredentialManagementClientInterfaceMockRecorder) Delete(ctx, name any) *MockGCPCredentialManagementClientInterfaceDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockGCPCredentialManagementClientInterface)(nil).Delete), ctx, name)
+	return &MockGCPCredentialManagementClientInterfaceDeleteCall{Call: call}
+}
+
+// MockGCPCredentialManagementClientInterfaceDeleteCall wrap *gomock.Call
+type MockGCPCredentialManagementClientInterfaceDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockGCPCredentialManagementClientInterfaceDeleteCall) Return(arg0 bool, arg1 error) *MockGCPCredentialManagementClientInterfaceDeleteCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockGCPCredentialManagementClientInterfaceDeleteCall) Do(f func(context.Context, string) (bool, error)) *MockGCPCredentialManagementClientInterfaceDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockGCPCredentialManagementClientInterfaceDeleteCall) DoAndReturn(f func(context.Context, string) (bool, error)) *MockGCPCredentialManagementClientInterfaceDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Get mocks base method.
+func (m *MockGCPCredentialManagementClientInterface) Get(ctx context.Context, name string) (ProviderCredentialConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, name)
+	ret0, _ := ret[0].(ProviderCredentialConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockGCPCredentialManagementClientInterfaceMockRecorder) Get(ctx, name any) *MockGCPCredentialManagementClientInterfaceGetCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockGCPCredentialManagementClientInterface)(nil).Get), ctx, name)
+	return &MockGCPCredentialManagementClientInterfaceGetCall{Call: call}
+}
+
+// MockGCPCredentialManagementClientInterfaceGetCall wrap *gomock.Call
+type MockGCPCredentialManagementClientInterfaceGetCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockGCPCredentialManagementClientInterfaceGetCall) Return(arg0 ProviderCredentialConfiguration, arg1 error) *MockGCPCredentialManagementClientInterfaceGetCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockGCPCredentialManagementClientInterfaceGetCall) Do(f func(context.Context, string) (ProviderCredentialConfiguration, error)) *MockGCPCredentialManagementClientInterfaceGetCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockGCPCredentialManagementClientInterfaceGetCall) DoAndReturn(f func(context.Context, string) (ProviderCredentialConfiguration, error)) *MockGCPCredentialManagementClientInterfaceGetCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// List mocks base method.
+func (m *MockGCPCredentialManagementClientInterface) List(ctx context.Context) ([]CloudProviderStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]CloudProviderStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockGCPCredentialManagementClientInterfaceMockRecorder) List(ctx any) *MockGCPCredentialManagementClientInterfaceListCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockGCPCredentialManagementClientInterface)(nil).List), ctx)
+	return &MockGCPCredentialManagementClientInterfaceListCall{Call: call}
+}
+
+// MockGCPCredentialManagementClientInterfaceListCall wrap *gomock.Call
+type MockGCPCredentialManagementClientInterfaceListCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockGCPCredentialManagementClientInterfaceListCall) Return(arg0 []CloudProviderStatus, arg1 error) *MockGCPCredentialManagementClientInterfaceListCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockGCPCredentialManagementClientInterfaceListCall) Do(f func(context.Context) ([]CloudProviderStatus, error)) *MockGCPCredentialManagementClientInterfaceListCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockGCPCredentialManagementClientInterfaceListCall) DoAndReturn(f func(context.Context) ([]CloudProviderStatus, error)) *MockGCPCredentialManagementClientInterfaceListCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Put mocks base method.
+func (m *MockGCPCredentialManagementClientInterface) Put(ctx context.Context, credential_config v20231001preview.GcpCredentialResource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, credential_config)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockGCPCredentialManagementClientInterfaceMockRecorder) Put(ctx, credential_config any) *MockGCPCredentialManagementClientInterfacePutCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockGCPCredentialManagementClientInterface)(nil).Put), ctx, credential_config)
+	return &MockGCPCredentialManagementClientInterfacePutCall{Call: call}
+}
+
+// MockGCPCredentialManagementClientInterfacePutCall wrap *gomock.Call
+type MockGCPCredentialManagementClientInterfacePutCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockGCPCredentialManagementClientInterfacePutCall) Return(arg0 error) *MockGCPCredentialManagementClientInterfacePutCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockGCPCredentialManagementClientInterfacePutCall) Do(f func(context.Context, v20231001preview.GcpCredentialResource) error) *MockGCPCredentialManagementClientInterfacePutCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockGCPCredentialManagementClientInterfacePutCall) DoAndReturn(f func(context.Context, v20231001preview.GcpCredentialResource) error) *MockGCPCredentialManagementClientInterfacePutCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
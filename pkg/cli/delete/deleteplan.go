@@ -0,0 +1,48 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package delete
+
+import (
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/output"
+)
+
+// DisplayDeletePlan prints the order in which the resources of an application would be deleted, and the output
+// resources that would be destroyed with them. A warning is printed first if the resources could not be ordered.
+func DisplayDeletePlan(out output.Interface, applicationName string, plan clients.DeletePlan) {
+	if len(plan.Waves) == 0 {
+		out.LogInfo("Application '%s' has no resources to delete.", applicationName)
+		return
+	}
+
+	if plan.OrderError != nil {
+		out.LogInfo("WARNING: The resources of application '%s' could not be ordered and would be deleted together: %v", applicationName, plan.OrderError)
+		out.LogInfo("")
+	}
+
+	out.LogInfo("The resources of application '%s' would be deleted in the following order:", applicationName)
+	for i, wave := range plan.Waves {
+		out.LogInfo("")
+		out.LogInfo("%d.", i+1)
+		for _, resource := range wave {
+			out.LogInfo("  %s (%s)", resource.Name, resource.Type)
+			for _, outputResource := range resource.OutputResources {
+				out.LogInfo("    %s", outputResource)
+			}
+		}
+	}
+}
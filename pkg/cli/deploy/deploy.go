@@ -23,6 +23,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/output"
 	"github.com/radius-project/radius/pkg/cli/output/progress"
+	"github.com/radius-project/radius/pkg/cli/recipelogs"
 )
 
 // DeployWithProgress runs a deployment and displays progress to the user. This is intended to be used
@@ -37,12 +38,26 @@ func DeployWithProgress(ctx context.Context, options Options) (clients.Deploymen
 		return clients.DeploymentResult{}, err
 	}
 
+	var recipeLogsClient recipelogs.Client
+	if options.RecipeLogs {
+		recipeLogsClient, err = options.ConnectionFactory.CreateApplicationsManagementClient(ctx, options.Workspace)
+		if err != nil {
+			return clients.DeploymentResult{}, err
+		}
+	}
+
 	step := output.BeginStep("%s", options.ProgressText)
 	output.LogInfo("")
 
 	// Watch for progress while we're deploying.
 	progressChan := make(chan clients.ResourceProgress, 1)
-	listener := progress.NewListener(progressChan)
+	listenerChan := progressChan
+	if recipeLogsClient != nil {
+		listenerChan = make(chan clients.ResourceProgress, 1)
+		go forwardRecipeLogs(ctx, recipeLogsClient, progressChan, listenerChan, recipelogs.DefaultInterval)
+	}
+
+	listener := progress.NewListener(listenerChan)
 	wg := &sync.WaitGroup{}
 	wg.Go(func() {
 		listener.Run()
@@ -20,29 +20,38 @@ import (
 	"context"
 
 	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/schema"
 	ucpresources "github.com/radius-project/radius/pkg/ucp/resources"
 )
 
 type PublicEndpoint struct {
+	// Resource is the resource that the endpoint belongs to.
 	Resource ucpresources.ID
+
+	// Name identifies the endpoint within the resource, e.g. "url" or "urls[0]".
+	Name string
+
+	// Endpoint is the URL of the endpoint.
 	Endpoint string
 }
 
-// FindPublicEndpoints iterates through a list of resources and attempts to retrieve a public endpoint for each one,
-// returning a list of public endpoints and an error if one occurs.
+// FindPublicEndpoints iterates through a list of resources and retrieves the public endpoints of each one, returning a
+// list of public endpoints and an error if one occurs. A resource can have multiple public endpoints.
 func FindPublicEndpoints(ctx context.Context, diag clients.DiagnosticsClient, result clients.DeploymentResult) ([]PublicEndpoint, error) {
 	endpoints := []PublicEndpoint{}
 	for _, resource := range result.Resources {
-		endpoint, err := diag.GetPublicEndpoint(ctx, clients.EndpointOptions{ResourceID: resource})
+		resourceEndpoints, err := diag.GetEndpoints(ctx, clients.EndpointOptions{ResourceID: resource})
 		if err != nil {
 			return nil, err
 		}
 
-		if endpoint == nil {
-			continue
-		}
+		for _, endpoint := range resourceEndpoints {
+			if endpoint.Visibility != schema.EndpointVisibilityPublic {
+				continue
+			}
 
-		endpoints = append(endpoints, PublicEndpoint{Resource: resource, Endpoint: *endpoint})
+			endpoints = append(endpoints, PublicEndpoint{Resource: resource, Name: endpoint.Name, Endpoint: endpoint.URL})
+		}
 	}
 
 	return endpoints, nil
@@ -0,0 +1,57 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_FindPublicEndpoints(t *testing.T) {
+	gatewayID := resources.MustParse("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/gateway")
+	routeID := resources.MustParse("/planes/radius/local/resourceGroups/test-group/providers/Radius.Compute/routes/frontend")
+	containerID := resources.MustParse("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/containers/backend")
+
+	ctrl := gomock.NewController(t)
+	diag := clients.NewMockDiagnosticsClient(ctrl)
+	diag.EXPECT().
+		GetEndpoints(gomock.Any(), clients.EndpointOptions{ResourceID: gatewayID}).
+		Return([]clients.Endpoint{{Name: "url", Visibility: "public", URL: "http://gateway.example.com"}}, nil)
+	diag.EXPECT().
+		GetEndpoints(gomock.Any(), clients.EndpointOptions{ResourceID: routeID}).
+		Return([]clients.Endpoint{
+			{Name: "internalUrl", Visibility: "internal", URL: "http://frontend.default.svc:80"},
+			{Name: "urls[0]", Visibility: "public", URL: "https://frontend.example.com"},
+			{Name: "urls[1]", Visibility: "public", URL: "https://www.frontend.example.com"},
+		}, nil)
+	diag.EXPECT().
+		GetEndpoints(gomock.Any(), clients.EndpointOptions{ResourceID: containerID}).
+		Return(nil, nil)
+
+	result := clients.DeploymentResult{Resources: []resources.ID{gatewayID, routeID, containerID}}
+	endpoints, err := FindPublicEndpoints(t.Context(), diag, result)
+	require.NoError(t, err)
+	require.Equal(t, []PublicEndpoint{
+		{Resource: gatewayID, Name: "url", Endpoint: "http://gateway.example.com"},
+		{Resource: routeID, Name: "urls[0]", Endpoint: "https://frontend.example.com"},
+		{Resource: routeID, Name: "urls[1]", Endpoint: "https://www.frontend.example.com"},
+	}, endpoints)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/cli/recipelogs"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// recipeLogsClockSkew is subtracted from the start time of the deployment when matching operations, so that the
+// operations started by this deployment are not ignored when the clock of the server is behind.
+const recipeLogsClockSkew = time.Minute
+
+// forwardRecipeLogs forwards the updates received on in to out, and streams the recipe logs of every Radius resource
+// that starts deploying as additional updates. It closes out once in is closed and the log streams have stopped.
+func forwardRecipeLogs(ctx context.Context, client recipelogs.Client, in <-chan clients.ResourceProgress, out chan<- clients.ResourceProgress, interval time.Duration) {
+	ctx, cancel := context.WithCancel(ctx)
+	startedAfter := time.Now().Add(-recipeLogsClockSkew)
+	followed := map[string]bool{}
+	wg := &sync.WaitGroup{}
+
+	for update := range in {
+		out <- update
+
+		id := update.Resource
+		if update.Status != clients.StatusStarted || followed[id.String()] || !hasRecipeLogs(id) {
+			continue
+		}
+		followed[id.String()] = true
+
+		wg.Go(func() {
+			// Recipe logs are best effort, so errors such as a server that does not serve them are ignored.
+			_ = recipelogs.Follow(ctx, client, id.String(), recipelogs.Options{
+				Follow:       true,
+				StartedAfter: startedAfter,
+				Interval:     interval,
+				Write: func(line string) {
+					out <- clients.ResourceProgress{Resource: id, Log: line}
+				},
+			})
+		})
+	}
+
+	// The operations of the deployment have completed, so each log stream stops after its next request once it has
+	// written the last lines. Streams of resources that have no operation logs are stopped after that delay.
+	stop := time.AfterFunc(2*interval, cancel)
+	wg.Wait()
+	stop.Stop()
+	cancel()
+	close(out)
+}
+
+// hasRecipeLogs returns true if the resource is managed by a Radius resource provider, whose operations can record
+// recipe logs.
+func hasRecipeLogs(id resources.ID) bool {
+	return strings.HasPrefix(id.PlaneNamespace(), "radius/") && !strings.EqualFold(id.Type(), "Microsoft.Resources/deployments")
+}
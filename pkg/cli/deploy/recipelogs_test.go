@@ -0,0 +1,93 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/cli/clients"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeLogsClient struct {
+	mu    sync.Mutex
+	calls map[string]int
+}
+
+func (c *fakeLogsClient) GetLatestOperationLogs(ctx context.Context, resourceID string, since int64) (v1.OperationLogs, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.calls[resourceID]++
+
+	id, _ := resources.ParseResource(resourceID)
+	if id.Name() != "cache" {
+		return v1.OperationLogs{}, &azcore.ResponseError{StatusCode: http.StatusNotFound}
+	}
+
+	return v1.OperationLogs{
+		Name:      "op",
+		Status:    v1.ProvisioningStateSucceeded,
+		StartTime: time.Now(),
+		Entries:   []v1.OperationLogEntry{{Sequence: 1, Message: "Apply complete!"}},
+	}, nil
+}
+
+func Test_forwardRecipeLogs(t *testing.T) {
+	cache, err := resources.ParseResource("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/cache")
+	require.NoError(t, err)
+	container, err := resources.ParseResource("/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/containers/frontend")
+	require.NoError(t, err)
+	deployment, err := resources.ParseResource("/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/module")
+	require.NoError(t, err)
+
+	client := &fakeLogsClient{calls: map[string]int{}}
+	in := make(chan clients.ResourceProgress)
+	out := make(chan clients.ResourceProgress)
+	go forwardRecipeLogs(t.Context(), client, in, out, time.Millisecond)
+
+	received := []clients.ResourceProgress{}
+	done := make(chan struct{})
+	go func() {
+		for update := range out {
+			received = append(received, update)
+		}
+		close(done)
+	}()
+
+	in <- clients.ResourceProgress{Resource: deployment, Status: clients.StatusStarted}
+	in <- clients.ResourceProgress{Resource: cache, Status: clients.StatusStarted}
+	in <- clients.ResourceProgress{Resource: container, Status: clients.StatusStarted}
+	require.Eventually(t, func() bool {
+		client.mu.Lock()
+		defer client.mu.Unlock()
+		return client.calls[container.String()] > 0
+	}, 5*time.Second, time.Millisecond)
+	close(in)
+	<-done
+
+	require.Contains(t, received, clients.ResourceProgress{Resource: cache, Log: "Apply complete!"})
+	require.Len(t, received, 4)
+	require.Equal(t, 1, client.calls[cache.String()])
+	require.Zero(t, client.calls[deployment.String()])
+}
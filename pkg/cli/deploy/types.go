@@ -53,6 +53,9 @@ type Options struct {
 
 	// CompleteText is a message displayed on the console when deployment completes.
 	CompletionText string
+
+	// RecipeLogs streams the recipe execution logs of the resources while they are being deployed.
+	RecipeLogs bool
 }
 
 var _ Interface = (*Impl)(nil)
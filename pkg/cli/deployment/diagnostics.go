@@ -20,10 +20,13 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	k8slabels "github.com/radius-project/radius/pkg/kubernetes"
+	"github.com/radius-project/radius/pkg/schema"
+	ucpv20231001 "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	resourceskubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 
@@ -56,40 +59,118 @@ type ARMDiagnosticsClient struct {
 	// API-version resolution provided by ManagementClient.
 	Preview bool
 
-	// ManagementClient is used in preview mode to resolve a container resource using the
-	// API version registered for its resource type. It is only set when Preview is true.
+	// ManagementClient is used to read the schemas of resource types to find the endpoints of resources. In preview
+	// mode it is also used to resolve a container resource using the API version registered for its resource type.
 	ManagementClient clients.ApplicationsManagementClient
+
+	// endpointFields caches the endpoint fields of resource types, keyed by the lowercase resource type.
+	endpointFields      map[string][]schema.EndpointField
+	endpointFieldsMutex sync.Mutex
 }
 
+// gatewayResourceType is the resource type of gateways, whose endpoint is their URL.
+const gatewayResourceType = "Applications.Core/gateways"
+
 // previewContainerResourceType is the preview container resource type resolved via the
 // management client when Preview is enabled.
 const previewContainerResourceType = "Radius.Compute/containers"
 
 var _ clients.DiagnosticsClient = (*ARMDiagnosticsClient)(nil)
 
-// GetPublicEndpoint checks if the resource type is Applications.Core/gateways, then attempts to get the URL from the response
-// properties, and returns the URL as a string or an error if the URL is not found.
-func (dc *ARMDiagnosticsClient) GetPublicEndpoint(ctx context.Context, options clients.EndpointOptions) (*string, error) {
-	if !strings.EqualFold("Applications.Core/gateways", options.ResourceID.Type()) {
-		return nil, nil
+// GetEndpoints returns the endpoints of a resource. The endpoint of an Applications.Core/gateways resource is its URL.
+// For other resource types, the endpoints are the values of the fields marked with the x-radius-endpoint annotation in
+// the schema of the resource type. The resource is only fetched when the schema has endpoint fields and the properties
+// are not part of the options.
+func (dc *ARMDiagnosticsClient) GetEndpoints(ctx context.Context, options clients.EndpointOptions) ([]clients.Endpoint, error) {
+	if strings.EqualFold(gatewayResourceType, options.ResourceID.Type()) {
+		url, err := dc.getGatewayURL(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		return []clients.Endpoint{{Name: "url", Visibility: schema.EndpointVisibilityPublic, URL: url}}, nil
 	}
 
-	response, err := dc.GatewayClient.Get(ctx, options.ResourceID.Name(), nil)
+	fields, err := dc.getEndpointFields(ctx, options.ResourceID)
 	if err != nil {
 		return nil, err
 	}
+	if len(fields) == 0 {
+		return nil, nil
+	}
 
-	obj, ok := response.Properties["url"]
-	if !ok {
-		return nil, fmt.Errorf("could not find URL for gateway %q", options.ResourceID.Name())
+	properties := options.Properties
+	if properties == nil {
+		resource, err := dc.ManagementClient.GetResource(ctx, options.ResourceID.Type(), options.ResourceID.String())
+		if err != nil {
+			return nil, err
+		}
+		properties = resource.Properties
+	}
+
+	endpoints := []clients.Endpoint{}
+	for _, endpoint := range schema.GetEndpoints(properties, fields) {
+		endpoints = append(endpoints, clients.Endpoint{Name: endpoint.Name, Visibility: endpoint.Visibility, URL: endpoint.URL})
+	}
+
+	return endpoints, nil
+}
+
+func (dc *ARMDiagnosticsClient) getGatewayURL(ctx context.Context, options clients.EndpointOptions) (string, error) {
+	properties := options.Properties
+	if properties == nil {
+		response, err := dc.GatewayClient.Get(ctx, options.ResourceID.Name(), nil)
+		if err != nil {
+			return "", err
+		}
+		properties = response.Properties
 	}
 
-	url, ok := obj.(string)
+	url, ok := properties["url"].(string)
 	if !ok {
-		return nil, fmt.Errorf("could not find URL for gateway %q", options.ResourceID.Name())
+		return "", fmt.Errorf("could not find URL for gateway %q", options.ResourceID.Name())
+	}
+
+	return url, nil
+}
+
+// getEndpointFields returns the endpoint fields of the schema of the resource type of a resource. The fields are
+// cached by resource type. Resource types that are not registered have no endpoint fields.
+func (dc *ARMDiagnosticsClient) getEndpointFields(ctx context.Context, id resources.ID) ([]schema.EndpointField, error) {
+	if dc.ManagementClient == nil {
+		return nil, nil
+	}
+
+	resourceType := strings.ToLower(id.Type())
+
+	dc.endpointFieldsMutex.Lock()
+	defer dc.endpointFieldsMutex.Unlock()
+
+	if fields, ok := dc.endpointFields[resourceType]; ok {
+		return fields, nil
+	}
+
+	summary, err := dc.ManagementClient.GetResourceProviderSummary(ctx, "local", id.ProviderNamespace())
+	if clients.Is404Error(err) {
+		summary = ucpv20231001.ResourceProviderSummary{}
+	} else if err != nil {
+		return nil, err
+	}
+
+	var fields []schema.EndpointField
+	for name, summaryType := range summary.ResourceTypes {
+		if strings.EqualFold(id.ProviderNamespace()+"/"+name, resourceType) {
+			fields = schema.GetEndpointFieldsFromSummary(summaryType)
+			break
+		}
+	}
+
+	if dc.endpointFields == nil {
+		dc.endpointFields = map[string][]schema.EndpointField{}
 	}
+	dc.endpointFields[resourceType] = fields
 
-	return &url, nil
+	return fields, nil
 }
 
 // Expose function finds a running replica of the container, prints the replica name, sets up a signal notification,
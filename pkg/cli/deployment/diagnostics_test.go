@@ -18,10 +18,14 @@ package deployment
 
 import (
 	"errors"
+	"net/http"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	ucpv20231001 "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
@@ -160,3 +164,95 @@ func Test_defaultContainerName(t *testing.T) {
 		require.Equal(t, "frontend", defaultContainerName(dc, pod))
 	})
 }
+
+func Test_GetEndpoints(t *testing.T) {
+	routeID, err := resources.ParseResource("/planes/radius/local/resourceGroups/test-group/providers/Radius.Compute/routes/frontend")
+	require.NoError(t, err)
+
+	summary := ucpv20231001.ResourceProviderSummary{
+		ResourceTypes: map[string]*ucpv20231001.ResourceProviderSummaryResourceType{
+			"routes": {
+				APIVersions: map[string]*ucpv20231001.ResourceTypeSummaryResultAPIVersion{
+					"2025-08-01-preview": {
+						Schema: map[string]any{
+							"type": "object",
+							"properties": map[string]any{
+								"url":         map[string]any{"type": "string", "readOnly": true, "x-radius-endpoint": "public"},
+								"internalUrl": map[string]any{"type": "string", "readOnly": true, "x-radius-endpoint": "internal"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	properties := map[string]any{
+		"url":         "https://frontend.example.com",
+		"internalUrl": "http://frontend.default.svc:80",
+	}
+	expected := []clients.Endpoint{
+		{Name: "internalUrl", Visibility: "internal", URL: "http://frontend.default.svc:80"},
+		{Name: "url", Visibility: "public", URL: "https://frontend.example.com"},
+	}
+
+	t.Run("uses the properties of the options", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		managementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		managementClient.EXPECT().
+			GetResourceProviderSummary(gomock.Any(), "local", "Radius.Compute").
+			Return(summary, nil).
+			Times(1)
+
+		dc := &ARMDiagnosticsClient{ManagementClient: managementClient}
+		endpoints, err := dc.GetEndpoints(t.Context(), clients.EndpointOptions{ResourceID: routeID, Properties: properties})
+		require.NoError(t, err)
+		require.Equal(t, expected, endpoints)
+
+		// The endpoint fields are cached by resource type.
+		endpoints, err = dc.GetEndpoints(t.Context(), clients.EndpointOptions{ResourceID: routeID, Properties: properties})
+		require.NoError(t, err)
+		require.Equal(t, expected, endpoints)
+	})
+
+	t.Run("fetches the resource", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		managementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		managementClient.EXPECT().
+			GetResourceProviderSummary(gomock.Any(), "local", "Radius.Compute").
+			Return(summary, nil)
+		managementClient.EXPECT().
+			GetResource(gomock.Any(), "Radius.Compute/routes", routeID.String()).
+			Return(generated.GenericResource{Properties: properties}, nil)
+
+		dc := &ARMDiagnosticsClient{ManagementClient: managementClient}
+		endpoints, err := dc.GetEndpoints(t.Context(), clients.EndpointOptions{ResourceID: routeID})
+		require.NoError(t, err)
+		require.Equal(t, expected, endpoints)
+	})
+
+	t.Run("unregistered resource type has no endpoints", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		managementClient := clients.NewMockApplicationsManagementClient(ctrl)
+		managementClient.EXPECT().
+			GetResourceProviderSummary(gomock.Any(), "local", "Radius.Compute").
+			Return(ucpv20231001.ResourceProviderSummary{}, &azcore.ResponseError{StatusCode: http.StatusNotFound})
+
+		dc := &ARMDiagnosticsClient{ManagementClient: managementClient}
+		endpoints, err := dc.GetEndpoints(t.Context(), clients.EndpointOptions{ResourceID: routeID})
+		require.NoError(t, err)
+		require.Empty(t, endpoints)
+	})
+
+	t.Run("gateway", func(t *testing.T) {
+		gatewayID, err := resources.ParseResource("/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/gateways/gateway")
+		require.NoError(t, err)
+
+		dc := &ARMDiagnosticsClient{}
+		endpoints, err := dc.GetEndpoints(t.Context(), clients.EndpointOptions{
+			ResourceID: gatewayID,
+			Properties: map[string]any{"url": "http://gateway.example.com"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []clients.Endpoint{{Name: "url", Visibility: "public", URL: "http://gateway.example.com"}}, endpoints)
+	})
+}
@@ -166,6 +166,31 @@ func WithResourceTypeServerNoError() ucpfake.ResourceTypesServer {
 			resp.SetResponse(http.StatusOK, response, nil)
 			return
 		},
+		GetUsage: func(
+			ctx context.Context,
+			planeName string,
+			resourceProviderName string,
+			resourceTypeName string,
+			options *v20231001preview.ResourceTypesClientGetUsageOptions,
+		) (resp azfake.Responder[v20231001preview.ResourceTypesClientGetUsageResponse], errResp azfake.ErrorResponder) {
+			response := v20231001preview.ResourceTypesClientGetUsageResponse{
+				ResourceTypeUsage: v20231001preview.ResourceTypeUsage{
+					ResourceType: new(resourceProviderName + "/" + resourceTypeName),
+					Count:        new(int32(2)),
+					APIVersions: map[string]*v20231001preview.APIVersionUsage{
+						"2023-10-01-preview": {
+							Count: new(int32(2)),
+							Resources: []*string{
+								new("/planes/radius/local/resourceGroups/test-group/providers/" + resourceProviderName + "/" + resourceTypeName + "/one"),
+								new("/planes/radius/local/resourceGroups/test-group/providers/" + resourceProviderName + "/" + resourceTypeName + "/two"),
+							},
+						},
+					},
+				},
+			}
+			resp.SetResponse(http.StatusOK, response, nil)
+			return
+		},
 	}
 	return resourceTypesServer
 }
@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/radius-project/radius/pkg/schema"
@@ -117,8 +119,14 @@ func validateManifestSchemas(ctx context.Context, provider *ResourceProvider) er
 
 	// Iterate through resource types in the provider
 	for resourceTypeName, resourceType := range provider.Types {
+		storageVersions := []string{}
+
 		// Check each API version
 		for apiVersion, versionInfo := range resourceType.APIVersions {
+			if schemaData, ok := versionInfo.Schema.(map[string]any); ok && schema.IsStorageVersion(schemaData) {
+				storageVersions = append(storageVersions, apiVersion)
+			}
+
 			if versionInfo.Schema != nil {
 				schemaPath := fmt.Sprintf("%s/%s@%s", provider.Namespace, resourceTypeName, apiVersion)
 
@@ -140,6 +148,14 @@ func validateManifestSchemas(ctx context.Context, provider *ResourceProvider) er
 				}
 			}
 		}
+
+		// Resources are converted through the storage version, so there can only be one.
+		if len(storageVersions) > 1 {
+			sort.Strings(storageVersions)
+			errors.Add(schema.NewConstraintError(
+				fmt.Sprintf("%s/%s", provider.Namespace, resourceTypeName),
+				fmt.Sprintf("only one API version can be the storage version, found %s", strings.Join(storageVersions, ", "))))
+		}
 	}
 
 	if errors.HasErrors() {
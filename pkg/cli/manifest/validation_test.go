@@ -376,6 +376,56 @@ func TestValidateManifestSchemas(t *testing.T) {
 		require.Contains(t, err.Error(), "allOf is not supported")
 	})
 
+	t.Run("provider with more than one storage version", func(t *testing.T) {
+		provider := &ResourceProvider{
+			Namespace: "Test.Provider",
+			Types: map[string]*ResourceType{
+				"widgets": {
+					APIVersions: map[string]*ResourceTypeAPIVersion{
+						"2023-10-01": {
+							Schema: map[string]any{
+								"type":                     "object",
+								"x-radius-storage-version": true,
+							},
+						},
+						"2024-01-01": {
+							Schema: map[string]any{
+								"type":                     "object",
+								"x-radius-storage-version": true,
+							},
+						},
+					},
+				},
+			},
+		}
+		err := validateManifestSchemas(ctx, provider)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "only one API version can be the storage version, found 2023-10-01, 2024-01-01")
+	})
+
+	t.Run("provider with invalid conversion", func(t *testing.T) {
+		provider := &ResourceProvider{
+			Namespace: "Test.Provider",
+			Types: map[string]*ResourceType{
+				"widgets": {
+					APIVersions: map[string]*ResourceTypeAPIVersion{
+						"2023-10-01": {
+							Schema: map[string]any{
+								"type": "object",
+								"x-radius-conversion": map[string]any{
+									"renamed": map[string]any{"size": ""},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		err := validateManifestSchemas(ctx, provider)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "x-radius-conversion.renamed.size must be a non-empty field path")
+	})
+
 	t.Run("provider with invalid JSON schema", func(t *testing.T) {
 		provider := &ResourceProvider{
 			Namespace: "Test.Provider",
@@ -128,6 +128,35 @@ func GetGenericResourceTableFormat() output.FormatterOptions {
 	}
 }
 
+// GetGenericResourceDetailsTableFormat returns the fields to output when showing a single generic resource object.
+// It extends GetGenericResourceTableFormat with the drift state of the infrastructure deployed by the resource's recipe.
+func GetGenericResourceDetailsTableFormat() output.FormatterOptions {
+	options := GetGenericResourceTableFormat()
+	options.Columns = append(options.Columns, output.Column{
+		Heading:  "DRIFT",
+		JSONPath: "{ .Properties.status.recipe.drift.state }",
+	})
+
+	return options
+}
+
+// GetComputedValuesTableFormat returns the fields to output for the values of a resource that are computed by Radius,
+// i.e. the properties with the x-radius-readonly annotation.
+func GetComputedValuesTableFormat() output.FormatterOptions {
+	return output.FormatterOptions{
+		Columns: []output.Column{
+			{
+				Heading:  "COMPUTED VALUE",
+				JSONPath: "{ .Name }",
+			},
+			{
+				Heading:  "VALUE",
+				JSONPath: "{ .Value }",
+			},
+		},
+	}
+}
+
 func GetRecipesForEnvironmentTableFormat() output.FormatterOptions {
 	return output.FormatterOptions{
 		Columns: []output.Column{
@@ -62,3 +62,28 @@ func Test_GetGenericResourceTableFormat(t *testing.T) {
 	expected := "RESOURCE  TYPE       GROUP       STATE\ntest      test-type  test-group  Updating\n"
 	require.Equal(t, expected, buffer.String())
 }
+
+func Test_GetGenericResourceDetailsTableFormat(t *testing.T) {
+	obj := generated.GenericResource{
+		Name: new("test"),
+		Type: new("test-type"),
+		ID:   new("/planes/radius/local/resourceGroups/test-group/providers/Applications.Datastores/redisCaches/test"),
+		Properties: map[string]any{
+			"provisioningState": "Succeeded",
+			"status": map[string]any{
+				"recipe": map[string]any{
+					"drift": map[string]any{
+						"state": "Drifted",
+					},
+				},
+			},
+		},
+	}
+
+	buffer := &bytes.Buffer{}
+	err := output.Write(output.FormatTable, obj, buffer, GetGenericResourceDetailsTableFormat())
+	require.NoError(t, err)
+
+	expected := "RESOURCE  TYPE       GROUP       STATE      DRIFT\ntest      test-type  test-group  Succeeded  Drifted\n"
+	require.Equal(t, expected, buffer.String())
+}
@@ -55,10 +55,12 @@ type noOpListener struct {
 	progressChan <-chan clients.ResourceProgress
 }
 
-// Run drains updates from the channel without taking any action.
+// Run drains updates from the channel, printing only the recipe log lines.
 func (l *noOpListener) Run() {
-	for range l.progressChan {
-		// Do nothing except drain the updates.
+	for update := range l.progressChan {
+		if update.Log != "" {
+			output.LogInfo("%s", formatLog(update))
+		}
 	}
 }
 
@@ -163,6 +165,10 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 	case progressMsg:
+		if msg.Log != "" {
+			// Log lines are printed above the progress display, in the order they are received.
+			return m, tea.Sequence(tea.Println(formatLog(clients.ResourceProgress(msg))), waitForUpdate(m.updates))
+		}
 		m.apply(clients.ResourceProgress(msg))
 		return m, waitForUpdate(m.updates)
 	case doneMsg:
@@ -199,6 +205,11 @@ func (m *model) apply(update clients.ResourceProgress) {
 	}
 }
 
+// formatLog returns the display string of a recipe log line, prefixed by the name of its resource.
+func formatLog(update clients.ResourceProgress) string {
+	return fmt.Sprintf("[%s] %s", update.Resource.Name(), update.Log)
+}
+
 // View renders every tracked resource on its own line, replacing the spinner
 // with a final-state token once the resource reaches a terminal status.
 func (m *model) View() tea.View {
@@ -92,3 +92,20 @@ func Test_model_failedStateWithoutStart(t *testing.T) {
 	close(updates)
 	tm.WaitFinished(t, teatest.WithFinalTimeout(waitTimeout))
 }
+
+func Test_model_printsRecipeLogs(t *testing.T) {
+	updates := make(chan clients.ResourceProgress, 1)
+	tm := teatest.NewTestModel(t, newModel(updates), teatest.WithInitialTermSize(150, 40))
+
+	id, err := resources.ParseResource("/planes/radius/local/resourceGroups/radius-test-rg/providers/Applications.Datastores/redisCaches/test-cache")
+	require.NoError(t, err)
+
+	updates <- clients.ResourceProgress{Resource: id, Status: clients.StatusStarted}
+	updates <- clients.ResourceProgress{Resource: id, Log: "Apply complete!"}
+	teatest.WaitFor(t, tm.Output(), func(bts []byte) bool {
+		return strings.Contains(ansi.Strip(string(bts)), "[test-cache] Apply complete!")
+	}, teatest.WithDuration(waitTimeout))
+
+	close(updates)
+	tm.WaitFinished(t, teatest.WithFinalTimeout(waitTimeout))
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipelogs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/azure/clientv2"
+)
+
+const (
+	// DefaultInterval is the default interval between two requests for the logs of an operation.
+	DefaultInterval = 2 * time.Second
+
+	// TruncatedMessage is written before the entries of a log when its oldest entries were dropped.
+	TruncatedMessage = "(earlier recipe log lines were truncated)"
+)
+
+// ErrNotFound is returned by Follow when the resource has no operation logs and Options.Follow is false.
+var ErrNotFound = errors.New("no recipe logs were found for the resource")
+
+// Client retrieves the recipe execution logs of an operation.
+type Client interface {
+	// GetLatestOperationLogs retrieves the recipe execution logs of the latest operation on a resource, starting after
+	// the log entry with the given sequence number.
+	GetLatestOperationLogs(ctx context.Context, resourceID string, since int64) (v1.OperationLogs, error)
+}
+
+// Options configures Follow.
+type Options struct {
+	// Follow keeps polling the logs until the operation completes. When false, the logs recorded so far are written
+	// once.
+	Follow bool
+
+	// StartedAfter ignores the operations started before this time, for example the previous deployment of the
+	// resource. The zero value accepts any operation.
+	StartedAfter time.Time
+
+	// Interval is the interval between two requests. DefaultInterval is used when it is zero.
+	Interval time.Duration
+
+	// Write is called for each line of the log.
+	Write func(line string)
+}
+
+// Follow writes the recipe execution logs of the latest operation on a resource. When options.Follow is true, it waits
+// for the operation to start and writes new log lines until the operation completes or the context is canceled.
+func Follow(ctx context.Context, client Client, resourceID string, options Options) error {
+	interval := options.Interval
+	if interval == 0 {
+		interval = DefaultInterval
+	}
+
+	operation := ""
+	since := int64(0)
+	for {
+		logs, err := client.GetLatestOperationLogs(ctx, resourceID, since)
+		if ctx.Err() != nil {
+			return nil
+		} else if err != nil && !clientv2.Is404Error(err) {
+			return err
+		}
+
+		found := err == nil && !logs.StartTime.Before(options.StartedAfter)
+
+		if found {
+			if logs.Name != operation {
+				// A new operation started, so its log is read again from the beginning.
+				operation = logs.Name
+				if since != 0 {
+					since = 0
+					continue
+				}
+				if logs.Truncated {
+					options.Write(TruncatedMessage)
+				}
+			}
+
+			for _, entry := range logs.Entries {
+				if entry.Sequence <= since {
+					continue
+				}
+				options.Write(entry.Message)
+				since = entry.Sequence
+			}
+		}
+
+		if !options.Follow {
+			if !found {
+				return ErrNotFound
+			}
+			return nil
+		}
+
+		if found && logs.Status.IsTerminal() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recipelogs
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/stretchr/testify/require"
+)
+
+const resourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis"
+
+type response struct {
+	logs v1.OperationLogs
+	err  error
+}
+
+type fakeClient struct {
+	responses []response
+	since     []int64
+}
+
+func (c *fakeClient) GetLatestOperationLogs(ctx context.Context, id string, since int64) (v1.OperationLogs, error) {
+	c.since = append(c.since, since)
+	r := c.responses[0]
+	if len(c.responses) > 1 {
+		c.responses = c.responses[1:]
+	}
+
+	logs := v1.OperationLogs{}
+	for _, entry := range r.logs.Entries {
+		if entry.Sequence > since {
+			logs.Entries = append(logs.Entries, entry)
+		}
+	}
+	logs.Name, logs.Status, logs.StartTime, logs.Truncated = r.logs.Name, r.logs.Status, r.logs.StartTime, r.logs.Truncated
+	return logs, r.err
+}
+
+func entries(messages ...string) []v1.OperationLogEntry {
+	result := []v1.OperationLogEntry{}
+	for i, message := range messages {
+		result = append(result, v1.OperationLogEntry{Sequence: int64(i + 1), Message: message})
+	}
+	return result
+}
+
+func Test_Follow(t *testing.T) {
+	now := time.Now()
+	notFound := &azcore.ResponseError{StatusCode: http.StatusNotFound}
+
+	t.Run("once", func(t *testing.T) {
+		client := &fakeClient{responses: []response{
+			{logs: v1.OperationLogs{Name: "op", Status: v1.ProvisioningStateUpdating, Entries: entries("a", "b"), Truncated: true}},
+		}}
+
+		lines := []string{}
+		err := Follow(t.Context(), client, resourceID, Options{Write: func(line string) { lines = append(lines, line) }})
+		require.NoError(t, err)
+		require.Equal(t, []string{TruncatedMessage, "a", "b"}, lines)
+	})
+
+	t.Run("once not found", func(t *testing.T) {
+		client := &fakeClient{responses: []response{{err: notFound}}}
+
+		err := Follow(t.Context(), client, resourceID, Options{Write: func(line string) {}})
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("follow until completed", func(t *testing.T) {
+		client := &fakeClient{responses: []response{
+			{err: notFound},
+			{logs: v1.OperationLogs{Name: "previous", Status: v1.ProvisioningStateSucceeded, StartTime: now.Add(-time.Hour), Entries: entries("old")}},
+			{logs: v1.OperationLogs{Name: "op", Status: v1.ProvisioningStateUpdating, StartTime: now, Entries: entries("a")}},
+			{logs: v1.OperationLogs{Name: "op", Status: v1.ProvisioningStateSucceeded, StartTime: now, Entries: entries("a", "b", "c")}},
+		}}
+
+		lines := []string{}
+		err := Follow(t.Context(), client, resourceID, Options{
+			Follow:       true,
+			StartedAfter: now.Add(-time.Minute),
+			Interval:     time.Millisecond,
+			Write:        func(line string) { lines = append(lines, line) },
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "b", "c"}, lines)
+		require.Equal(t, []int64{0, 0, 0, 1}, client.since)
+	})
+
+	t.Run("follow new operation", func(t *testing.T) {
+		client := &fakeClient{responses: []response{
+			{logs: v1.OperationLogs{Name: "op1", Status: v1.ProvisioningStateUpdating, StartTime: now, Entries: entries("a", "b")}},
+			{logs: v1.OperationLogs{Name: "op2", Status: v1.ProvisioningStateFailed, StartTime: now, Entries: entries("c", "d", "e")}},
+		}}
+
+		lines := []string{}
+		err := Follow(t.Context(), client, resourceID, Options{
+			Follow:   true,
+			Interval: time.Millisecond,
+			Write:    func(line string) { lines = append(lines, line) },
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "b", "c", "d", "e"}, lines)
+		require.Equal(t, []int64{0, 2, 0}, client.since)
+	})
+
+	t.Run("follow canceled", func(t *testing.T) {
+		client := &fakeClient{responses: []response{{err: notFound}}}
+
+		ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+		defer cancel()
+
+		err := Follow(ctx, client, resourceID, Options{Follow: true, Interval: time.Millisecond, Write: func(line string) {}})
+		require.NoError(t, err)
+	})
+}
@@ -308,6 +308,10 @@ func (c *APIServerClient) Save(ctx context.Context, obj *database.Object, option
 		} else if index == nil {
 			resource.Entries = append(resource.Entries, *converted)
 		} else {
+			if config.CreateOnly {
+				return false, &database.ErrConcurrency{}
+			}
+
 			if config.ETag != "" && config.ETag != resource.Entries[*index].ETag {
 				return false, &database.ErrConcurrency{}
 			}
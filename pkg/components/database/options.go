@@ -72,6 +72,9 @@ type DatabaseOptions struct {
 
 	// ETag represents the entity tag for optimistic consistency control.
 	ETag ETag
+
+	// CreateOnly represents whether Save() should fail when the object already exists.
+	CreateOnly bool
 }
 
 // Query Options
@@ -150,6 +153,16 @@ func WithETag(etag ETag) MutatingOptions {
 	}
 }
 
+// WithCreateOnly makes Save() fail with ErrConcurrency when the object already exists.
+func WithCreateOnly() SaveOptions {
+	return &saveOptions{
+		fn: func(cfg DatabaseOptions) DatabaseOptions {
+			cfg.CreateOnly = true
+			return cfg
+		},
+	}
+}
+
 // NewQueryConfig applies a set of QueryOptions to a StoreConfig and returns the modified StoreConfig for Query().
 func NewQueryConfig(opts ...QueryOptions) DatabaseOptions {
 	cfg := DatabaseOptions{}
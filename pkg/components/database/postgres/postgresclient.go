@@ -381,6 +381,20 @@ CASE
 END AS result;`
 
 		args = []any{databaseutil.NormalizePart(converted.String()), obj.Data, config.ETag, obj.ETag}
+	} else if config.CreateOnly {
+		// This is the query that only performs inserts. An existing row is reported as ErrConcurrency.
+		sql = `
+WITH inserted AS (
+	INSERT INTO resources (id, original_id, resource_type, root_scope, routing_scope, etag, resource_data)
+	VALUES ($1, $2, $3, $4, $5, $6, $7)
+	ON CONFLICT (id) DO NOTHING
+	RETURNING id
+)
+SELECT
+CASE
+	WHEN EXISTS (SELECT 1 FROM inserted) THEN 'Success'
+	ELSE 'ErrConcurrency'
+END AS result;`
 	}
 
 	result := ""
@@ -22,6 +22,9 @@ var (
 
 	// DefaultRecipeEngineMetrics holds recipe engine metrics definitions.
 	DefaultRecipeEngineMetrics = newRecipeEngineMetrics()
+
+	// DefaultProxyMetrics holds UCP proxy metrics definitions.
+	DefaultProxyMetrics = newProxyMetrics()
 )
 
 // InitMetrics initializes metrics for Radius.
@@ -34,5 +37,9 @@ func InitMetrics() error {
 		return err
 	}
 
+	if err := DefaultProxyMetrics.Init(); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,121 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	// proxyRateLimitWaitDuration is the metric name for the time a proxied request waited for a rate limit.
+	proxyRateLimitWaitDuration = "ucp.proxy.ratelimit.wait.duration"
+
+	// proxyRetryCount is the metric name for the number of proxied requests that were retried.
+	proxyRetryCount = "ucp.proxy.retry.count"
+
+	// proxyCircuitRejectedCount is the metric name for the number of proxied requests rejected by an open circuit.
+	proxyCircuitRejectedCount = "ucp.proxy.circuit.rejected.count"
+
+	// proxyCircuitStateChangeCount is the metric name for the number of circuit breaker state changes.
+	proxyCircuitStateChangeCount = "ucp.proxy.circuit.state.change.count"
+)
+
+type proxyMetrics struct {
+	counters       map[string]metric.Int64Counter
+	valueRecorders map[string]metric.Float64Histogram
+}
+
+func newProxyMetrics() *proxyMetrics {
+	return &proxyMetrics{
+		counters:       make(map[string]metric.Int64Counter),
+		valueRecorders: make(map[string]metric.Float64Histogram),
+	}
+}
+
+// Init initializes the counters and value recorders for proxyMetrics and returns an error if any of the
+// initialization fails.
+func (p *proxyMetrics) Init() error {
+	meter := otel.GetMeterProvider().Meter("ucp-proxy-metrics")
+
+	var err error
+	p.valueRecorders[proxyRateLimitWaitDuration], err = meter.Float64Histogram(proxyRateLimitWaitDuration)
+	if err != nil {
+		return err
+	}
+
+	p.counters[proxyRetryCount], err = meter.Int64Counter(proxyRetryCount)
+	if err != nil {
+		return err
+	}
+
+	p.counters[proxyCircuitRejectedCount], err = meter.Int64Counter(proxyCircuitRejectedCount)
+	if err != nil {
+		return err
+	}
+
+	p.counters[proxyCircuitStateChangeCount], err = meter.Int64Counter(proxyCircuitStateChangeCount)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RecordRateLimitWait records the time in milliseconds that a proxied request waited for the given rate limit.
+func (p *proxyMetrics) RecordRateLimitWait(ctx context.Context, limit string, plane string, resourceProvider string, wait time.Duration) {
+	if p.valueRecorders[proxyRateLimitWaitDuration] != nil {
+		elapsedTime := float64(wait) / float64(time.Millisecond)
+		p.valueRecorders[proxyRateLimitWaitDuration].Record(ctx, elapsedTime, metric.WithAttributes(
+			append(newProxyAttributes(plane, resourceProvider), rateLimitAttrKey.String(normalizeAttrValue(limit)))...))
+	}
+}
+
+// RecordRetry records a retry of a proxied request after the given HTTP status code.
+func (p *proxyMetrics) RecordRetry(ctx context.Context, plane string, resourceProvider string, statusCode int) {
+	if p.counters[proxyRetryCount] != nil {
+		p.counters[proxyRetryCount].Add(ctx, 1, metric.WithAttributes(
+			append(newProxyAttributes(plane, resourceProvider), statusCodeAttrKey.String(strconv.Itoa(statusCode)))...))
+	}
+}
+
+// RecordCircuitRejected records a proxied request that was rejected because the circuit for the downstream is open.
+func (p *proxyMetrics) RecordCircuitRejected(ctx context.Context, plane string, resourceProvider string) {
+	if p.counters[proxyCircuitRejectedCount] != nil {
+		p.counters[proxyCircuitRejectedCount].Add(ctx, 1, metric.WithAttributes(newProxyAttributes(plane, resourceProvider)...))
+	}
+}
+
+// RecordCircuitStateChange records a change of the state of the circuit for a downstream, eg: open.
+func (p *proxyMetrics) RecordCircuitStateChange(ctx context.Context, plane string, resourceProvider string, state string) {
+	if p.counters[proxyCircuitStateChangeCount] != nil {
+		p.counters[proxyCircuitStateChangeCount].Add(ctx, 1, metric.WithAttributes(
+			append(newProxyAttributes(plane, resourceProvider), circuitStateAttrKey.String(normalizeAttrValue(state)))...))
+	}
+}
+
+func newProxyAttributes(plane string, resourceProvider string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		planeAttrKey.String(normalizeAttrValue(plane)),
+		resourceProviderAttrKey.String(normalizeAttrValue(resourceProvider)),
+	}
+}
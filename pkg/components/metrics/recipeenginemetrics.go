@@ -46,6 +46,12 @@ const (
 	// terraformInstallVerificationDuration is the metric name for verifying the completion of a Terraform installation duration.
 	terraformInstallVerificationDuration = "recipe.tf.install.verification.duration"
 
+	// recipeDriftDetectedCount is the metric name for the number of recipe drift detections that found drifted resources.
+	recipeDriftDetectedCount = "recipe.drift.detected.count"
+
+	// recipeDriftRemediationCount is the metric name for the number of recipe redeployments queued to remediate drift.
+	recipeDriftRemediationCount = "recipe.drift.remediation.count"
+
 	// RecipeEngineOperationExecute represents the Execute operation of the Recipe Engine.
 	RecipeEngineOperationExecute = "execute"
 
@@ -57,6 +63,9 @@ const (
 
 	// RecipeEngineOperationGC represents the Garbage Collection operation of the Recipe Engine.
 	RecipeEngineOperationGC = "garbage.collection.recipe"
+
+	// RecipeEngineOperationDetectDrift represents the Detect Drift operation of the Recipe Engine.
+	RecipeEngineOperationDetectDrift = "detect.drift"
 )
 
 type recipeEngineMetrics struct {
@@ -96,6 +105,16 @@ func (m *recipeEngineMetrics) Init() error {
 		return err
 	}
 
+	m.counters[recipeDriftDetectedCount], err = meter.Int64Counter(recipeDriftDetectedCount)
+	if err != nil {
+		return err
+	}
+
+	m.counters[recipeDriftRemediationCount], err = meter.Int64Counter(recipeDriftRemediationCount)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -148,6 +167,20 @@ func (m *recipeEngineMetrics) RecordRecipeGarbageCollectionDuration(ctx context.
 	}
 }
 
+// RecordRecipeDriftDetected increments the number of recipe drift detections that found drifted resources.
+func (m *recipeEngineMetrics) RecordRecipeDriftDetected(ctx context.Context, attrs []attribute.KeyValue) {
+	if m.counters[recipeDriftDetectedCount] != nil {
+		m.counters[recipeDriftDetectedCount].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+}
+
+// RecordRecipeDriftRemediation increments the number of recipe redeployments queued to remediate drift.
+func (m *recipeEngineMetrics) RecordRecipeDriftRemediation(ctx context.Context, attrs []attribute.KeyValue) {
+	if m.counters[recipeDriftRemediationCount] != nil {
+		m.counters[recipeDriftRemediationCount].Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+}
+
 // NewRecipeAttributes generates common attributes for recipe operations.
 func NewRecipeAttributes(operationType, recipeName string, definition *recipes.EnvironmentDefinition, state string) []attribute.KeyValue {
 	attrs := make([]attribute.KeyValue, 0)
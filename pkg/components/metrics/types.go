@@ -42,6 +42,21 @@ const (
 	// recipeTemplatePathAttrKey is the attribute name for the recipe template path.
 	recipeTemplatePathAttrKey = attribute.Key("recipe_template_path")
 
+	// planeAttrKey is the attribute name for the UCP plane type.
+	planeAttrKey = attribute.Key("plane")
+
+	// resourceProviderAttrKey is the attribute name for the resource provider namespace.
+	resourceProviderAttrKey = attribute.Key("resource_provider")
+
+	// rateLimitAttrKey is the attribute name for the kind of rate limit, eg: plane.
+	rateLimitAttrKey = attribute.Key("rate_limit")
+
+	// statusCodeAttrKey is the attribute name for the HTTP status code.
+	statusCodeAttrKey = attribute.Key("status_code")
+
+	// circuitStateAttrKey is the attribute name for the state of a circuit breaker.
+	circuitStateAttrKey = attribute.Key("circuit_state")
+
 	// TerraformVersionAttrKey is the attribute key for the Terraform version.
 	TerraformVersionAttrKey = attribute.Key("terraform_version")
 
@@ -32,10 +32,39 @@ type DeploymentTemplateSpec struct {
 	// ProviderConfig specifies the scopes for resources.
 	ProviderConfig string `json:"providerConfig,omitempty"`
 
-	// Repository is the git repository that the Bicep manifests are stored in.
+	// Repository is the Flux source that the Bicep manifests are stored in. A GitRepository is referenced by name,
+	// and other kinds of sources are referenced as "<kind>/<name>", for example "OCIRepository/my-bundle".
 	Repository string `json:"repository,omitempty"`
+
+	// ReconcileInterval is the interval at which the resources deployed by the template are checked for drift from
+	// their deployed state. When unset, the template is only deployed when its spec changes.
+	// +optional
+	ReconcileInterval *metav1.Duration `json:"reconcileInterval,omitempty"`
+
+	// SelfHeal redeploys the template when drift is detected. When false, drift is only reported in the status.
+	// Requires ReconcileInterval to be set.
+	// +optional
+	SelfHeal bool `json:"selfHeal,omitempty"`
+
+	// PrunePolicy is the policy applied to the resources that are no longer deployed by the template.
+	// Delete, the default, deletes the resources. Orphan leaves the resources in place and stops tracking them.
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +optional
+	PrunePolicy DeploymentTemplatePrunePolicy `json:"prunePolicy,omitempty"`
 }
 
+// DeploymentTemplatePrunePolicy is the policy applied to the resources that are no longer deployed by a
+// Deployment Template.
+type DeploymentTemplatePrunePolicy string
+
+const (
+	// DeploymentTemplatePrunePolicyDelete deletes the resources that are no longer deployed by the template.
+	DeploymentTemplatePrunePolicyDelete DeploymentTemplatePrunePolicy = "Delete"
+
+	// DeploymentTemplatePrunePolicyOrphan leaves the resources that are no longer deployed by the template in place.
+	DeploymentTemplatePrunePolicyOrphan DeploymentTemplatePrunePolicy = "Orphan"
+)
+
 // DeploymentTemplateStatus defines the observed state of a DeploymentTemplate resource.
 type DeploymentTemplateStatus struct {
 	// ObservedGeneration is the most recent generation observed for this DeploymentTemplate.
@@ -53,8 +82,32 @@ type DeploymentTemplateStatus struct {
 
 	// Phrase indicates the current status of the Deployment Template.
 	Phrase DeploymentTemplatePhrase `json:"phrase,omitempty"`
+
+	// OutputResourceHashes are the hashes of the properties of the Radius resources in OutputResources, by resource
+	// ID, as they were deployed. They are compared with the live resources to detect drift.
+	OutputResourceHashes map[string]string `json:"outputResourceHashes,omitempty"`
+
+	// LastSyncTime is the time the deployed resources were last deployed or checked for drift.
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// Conditions are the conditions of the Deployment Template: Synced reports the result of the last sync, and
+	// Drifted reports the drift detected in the deployed resources.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+const (
+	// DeploymentTemplateConditionSynced is the type of the condition that reports the result of the last sync of a
+	// Deployment Template.
+	DeploymentTemplateConditionSynced = "Synced"
+
+	// DeploymentTemplateConditionDrifted is the type of the condition that reports whether the resources deployed by a
+	// Deployment Template have drifted from their deployed state.
+	DeploymentTemplateConditionDrifted = "Drifted"
+)
+
 // DeploymentTemplatePhrase is a string representation of the current status of a Deployment Template.
 type DeploymentTemplatePhrase string
 
@@ -30,10 +30,40 @@ type RecipeSpec struct {
 	// +kubebuilder:validation:Required
 	Type string `json:"type,omitempty"`
 
-	// SecretName is the name of a Kubernetes secret to create once the resource is created.
+	// RecipeName is the name of the recipe registered in the environment for the resource type. If unset
+	// the recipe named 'default' will be used.
+	// +kubebuilder:validation:Optional
+	RecipeName string `json:"recipeName,omitempty"`
+
+	// Parameters are the parameters passed to the recipe. They override the parameters set for the recipe
+	// in the environment.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Parameters *runtime.RawExtension `json:"parameters,omitempty"`
+
+	// SecretName is the name of a Kubernetes secret to create once the resource is created. The secret
+	// contains the secrets of the resource, and also its values unless ConfigMapName is set.
 	// +kubebuilder:validation:Optional
 	SecretName string `json:"secretName,omitempty"`
 
+	// ConfigMapName is the name of a Kubernetes config map to create once the resource is created. The
+	// config map contains the values of the resource, which are then omitted from the secret.
+	// +kubebuilder:validation:Optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+
+	// OutputKeyPrefix is a prefix added to the key of each output written to the secret and the config map.
+	// +kubebuilder:validation:Optional
+	OutputKeyPrefix string `json:"outputKeyPrefix,omitempty"`
+
+	// OutputKeyTemplate is a Go template used to compute the key of each output written to the secret and the
+	// config map, before OutputKeyPrefix is added. The template is executed with the fields Name (the name of the
+	// output), Recipe (the name of the Recipe) and Type (the resource type), and can use the functions 'upper',
+	// 'lower' and 'replace'. eg: '{{ .Recipe | upper }}_{{ .Name | upper }}'. If unset the name of the output is
+	// used as the key.
+	// +kubebuilder:validation:Optional
+	OutputKeyTemplate string `json:"outputKeyTemplate,omitempty"`
+
 	// Environment is the name of the Radius environment to use. If unset the value 'default' will be
 	// used as the environment name.
 	Environment string `json:"environment,omitempty"`
@@ -87,6 +117,11 @@ type RecipeStatus struct {
 	// +kubebuilder:validation:Optional
 	Resource string `json:"resource,omitempty"`
 
+	// StatusHash is a hash of the properties the resource was last created or updated with. A change of the
+	// spec that changes the properties of the resource updates it in place.
+	// +kubebuilder:validation:Optional
+	StatusHash string `json:"statusHash,omitempty"`
+
 	// Operation tracks the status of an in-progress provisioning operation.
 	// +kubebuilder:validation:Optional
 	Operation *ResourceOperation `json:"operation,omitempty"`
@@ -98,6 +133,30 @@ type RecipeStatus struct {
 	// Secret specifies a reference to the secret being managed by this Recipe.
 	// +kubebuilder:validation:Optional
 	Secret corev1.ObjectReference `json:"secret,omitempty"`
+
+	// ConfigMap specifies a reference to the config map being managed by this Recipe.
+	// +kubebuilder:validation:Optional
+	ConfigMap corev1.ObjectReference `json:"configMap,omitempty"`
+
+	// Outputs describes the outputs of the resource.
+	// +kubebuilder:validation:Optional
+	Outputs *RecipeOutputs `json:"outputs,omitempty"`
+}
+
+// RecipeOutputs describes the outputs of the resource created by a Recipe.
+type RecipeOutputs struct {
+	// Values lists the keys of the values of the resource, in the config map if one is managed by the Recipe
+	// and otherwise in the secret.
+	// +kubebuilder:validation:Optional
+	Values []string `json:"values,omitempty"`
+
+	// Secrets lists the keys of the secrets of the resource in the secret.
+	// +kubebuilder:validation:Optional
+	Secrets []string `json:"secrets,omitempty"`
+
+	// Resources lists the IDs of the resources deployed by the recipe of the resource.
+	// +kubebuilder:validation:Optional
+	Resources []string `json:"resources,omitempty"`
 }
 
 // ResourceOperation describes the status of an in-progress provisioning operation.
@@ -123,8 +182,12 @@ const (
 //+kubebuilder:object:root=true
 //+kubebuilder:resource:categories={"all","radius"}
 //+kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type",description="Type of resource the recipe should create"
+//+kubebuilder:printcolumn:name="Recipe",type="string",JSONPath=".spec.recipeName",description="Name of the recipe to use",priority=1
 //+kubebuilder:printcolumn:name="Secret",type="string",JSONPath=".spec.secretName",description="Name of the secret to create"
+//+kubebuilder:printcolumn:name="ConfigMap",type="string",JSONPath=".spec.configMapName",description="Name of the config map to create"
 //+kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phrase",description="Status of the resource"
+//+kubebuilder:printcolumn:name="Resource",type="string",JSONPath=".status.resource",description="ID of the resource",priority=1
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 //+kubebuilder:subresource:status
 
 // Recipe is the Schema for the recipes API
@@ -48,6 +48,19 @@ const (
 	// the namespace of the Deployment will be used as the application name.
 	AnnotationRadiusApplication = "radapp.io/application"
 
+	// AnnotationHelmInventory is the name of the annotation that records the objects applied from the Helm chart of a
+	// DeploymentTemplate created from a Flux source.
+	AnnotationHelmInventory = "radapp.io/helm-inventory"
+
+	// TagRadiusCluster is the name of the tag that identifies the cluster whose controller deployed a Radius resource.
+	TagRadiusCluster = "radapp.io/cluster"
+
+	// defaultRecipeName is the name of the recipe used when a Recipe does not set a recipe name.
+	defaultRecipeName = "default"
+
+	// defaultEnvironmentName is the name of the environment used when a Deployment does not set AnnotationRadiusEnvironment.
+	defaultEnvironmentName = "default"
+
 	// DeploymentFinalizer is the name of the finalizer added to Deployments.
 	DeploymentFinalizer = "radapp.io/deployment-finalizer"
 
@@ -64,6 +77,13 @@ const (
 	// child of a DeploymentTemplate whose deployment scope covers Spec.Id.
 	EventDeploymentResourceDeleteSkipped = "DeploymentResourceDeleteSkipped"
 
+	// EventBuildFailed is emitted on a Flux source when a Bicep file of its radius-gitops-config.yaml fails to build.
+	EventBuildFailed = "BuildFailed"
+
+	// ConditionRadiusReady is the type of the condition set on a Flux source that reports the health of the
+	// DeploymentTemplates created from it.
+	ConditionRadiusReady = "RadiusReady"
+
 	// deploymentTemplateKind is the Kind of the DeploymentTemplate CRD, used when checking the
 	// controller owner reference on a DeploymentResource.
 	deploymentTemplateKind = "DeploymentTemplate"
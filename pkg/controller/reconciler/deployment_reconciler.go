@@ -63,6 +63,13 @@ type DeploymentReconciler struct {
 	// Radius is the Radius client.
 	Radius RadiusClient
 
+	// Router selects the UCP endpoint of a namespace. Namespaces that match no route use the default clients.
+	Router *UCPRouter
+
+	// ClusterName identifies the cluster that the controller runs in. When set, it is added as a tag to the
+	// deployed Radius resources.
+	ClusterName string
+
 	// DelayInterval is the amount of time to wait between operations.
 	DelayInterval time.Duration
 }
@@ -157,7 +164,7 @@ func (r *DeploymentReconciler) reconcileOperation(ctx context.Context, deploymen
 	//
 	// The only difference between these two codepaths is how they handle success.
 	if annotations.Status.Operation.OperationKind == radappiov1alpha3.OperationKindPut {
-		poller, err := r.Radius.Containers(annotations.Status.Scope).ContinueCreateOperation(ctx, annotations.Status.Operation.ResumeToken)
+		poller, err := r.radius(deployment.Namespace).Containers(annotations.Status.Scope).ContinueCreateOperation(ctx, annotations.Status.Operation.ResumeToken)
 		if err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to continue PUT operation: %w", err)
 		}
@@ -196,7 +203,7 @@ func (r *DeploymentReconciler) reconcileOperation(ctx context.Context, deploymen
 		annotations.Status.Container = annotations.Status.Scope + "/providers/Applications.Core/containers/" + deployment.Name
 		return ctrl.Result{}, nil
 	} else if annotations.Status.Operation.OperationKind == radappiov1alpha3.OperationKindDelete {
-		poller, err := r.Radius.Containers(annotations.Status.Scope).ContinueDeleteOperation(ctx, annotations.Status.Operation.ResumeToken)
+		poller, err := r.radius(deployment.Namespace).Containers(annotations.Status.Scope).ContinueDeleteOperation(ctx, annotations.Status.Operation.ResumeToken)
 		if err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to continue DELETE operation: %w", err)
 		}
@@ -262,7 +269,7 @@ func (r *DeploymentReconciler) reconcileUpdate(ctx context.Context, deployment *
 		}
 	}
 
-	environmentName := "default"
+	environmentName := defaultEnvironmentName
 	if annotations.Configuration.Environment != "" {
 		environmentName = annotations.Configuration.Environment
 	}
@@ -272,7 +279,7 @@ func (r *DeploymentReconciler) reconcileUpdate(ctx context.Context, deployment *
 		applicationName = annotations.Configuration.Application
 	}
 
-	resourceGroupID, environmentID, applicationID, err := resolveDependencies(ctx, r.Radius, "/planes/radius/local", environmentName, applicationName)
+	resourceGroupID, environmentID, applicationID, err := resolveDependencies(ctx, r.radius(deployment.Namespace), "/planes/radius/local", environmentName, applicationName, r.Router.resourceGroup(deployment.Namespace), clusterTags(r.ClusterName))
 	if err != nil {
 		r.EventRecorder.Event(deployment, corev1.EventTypeWarning, "DependencyError", err.Error())
 		logger.Error(err, "Unable to resolve dependencies.")
@@ -467,7 +474,7 @@ func (r *DeploymentReconciler) startPutOrDeleteOperationIfNeeded(ctx context.Con
 		}
 	}
 
-	poller, err := createOrUpdateContainer(ctx, r.Radius, resourceID, &properties)
+	poller, err := createOrUpdateContainer(ctx, r.radius(deployment.Namespace), resourceID, &properties, clusterTags(r.ClusterName))
 	if err != nil {
 		return nil, nil, false, err
 	} else if poller != nil {
@@ -493,7 +500,7 @@ func (r *DeploymentReconciler) startDeleteOperationIfNeeded(ctx context.Context,
 	}
 
 	expectedDeploymentResourceID := makeKubernetesDeploymentResourceID(deployment.Namespace, deployment.Name)
-	container, err := fetchContainerResource(ctx, r.Radius, annotations.Status.Container)
+	container, err := fetchContainerResource(ctx, r.radius(deployment.Namespace), annotations.Status.Container)
 	if clients.Is404Error(err) {
 		logger.Info("Container was already deleted before cleanup began.", "container", annotations.Status.Container)
 		annotations.Status.Container = ""
@@ -516,7 +523,7 @@ func (r *DeploymentReconciler) startDeleteOperationIfNeeded(ctx context.Context,
 	}
 
 	logger.Info("Starting DELETE operation.")
-	poller, err := deleteContainer(ctx, r.Radius, annotations.Status.Container)
+	poller, err := deleteContainer(ctx, r.radius(deployment.Namespace), annotations.Status.Container)
 	if err != nil {
 		return nil, err
 	} else if poller != nil {
@@ -581,12 +588,12 @@ func (r *DeploymentReconciler) updateDeployment(ctx context.Context, deployment
 			return err
 		}
 
-		response, err := r.Radius.Resources(id.RootScope(), id.Type()).Get(ctx, id.Name())
+		response, err := r.radius(deployment.Namespace).Resources(id.RootScope(), id.Type()).Get(ctx, id.Name())
 		if err != nil {
 			return fmt.Errorf("failed to fetch resource %s: %w", id, err)
 		}
 
-		secrets, err := r.Radius.Resources(id.RootScope(), id.Type()).ListSecrets(ctx, id.Name())
+		secrets, err := r.radius(deployment.Namespace).Resources(id.RootScope(), id.Type()).ListSecrets(ctx, id.Name())
 		if clients.Is404Error(err) {
 			// This is fine. The resource doesn't have any secrets.
 			secrets.Value = map[string]*string{}
@@ -686,6 +693,11 @@ func (r *DeploymentReconciler) findDeploymentsForRecipe(ctx context.Context, obj
 	return requests
 }
 
+// radius returns the Radius client for the UCP endpoint of a namespace.
+func (r *DeploymentReconciler) radius(namespace string) RadiusClient {
+	return r.Router.radius(namespace, r.Radius)
+}
+
 func (r *DeploymentReconciler) requeueDelay() time.Duration {
 	delay := r.DelayInterval
 	if delay == 0 {
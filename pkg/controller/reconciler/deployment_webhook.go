@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupWebhookWithManager sets up the mutating and validating webhooks for the Deployment type with the provided manager.
+func (r *DeploymentWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &appsv1.Deployment{}).
+		WithDefaulter(r).
+		WithValidator(r).
+		Complete()
+}
+
+// DeploymentWebhook implements the mutating and validating webhook functions for Deployments with Radius enabled.
+// Deployments without the radapp.io/enabled annotation are admitted unchanged.
+type DeploymentWebhook struct {
+	// Client is the Kubernetes client used to resolve the Recipes referenced by connections.
+	Client client.Reader
+}
+
+// Default sets the environment and application annotations of a Deployment with Radius enabled to the values
+// that the DeploymentReconciler would otherwise use implicitly.
+func (r *DeploymentWebhook) Default(ctx context.Context, deployment *appsv1.Deployment) error {
+	if !isRadiusEnabled(deployment) || deployment.DeletionTimestamp != nil {
+		return nil
+	}
+
+	namespace := deployment.Namespace
+	if namespace == "" {
+		// The namespace of an object being created can be omitted and is then taken from the request.
+		if request, err := admission.RequestFromContext(ctx); err == nil {
+			namespace = request.Namespace
+		}
+	}
+
+	if deployment.Annotations[AnnotationRadiusEnvironment] == "" {
+		deployment.Annotations[AnnotationRadiusEnvironment] = defaultEnvironmentName
+	}
+	if deployment.Annotations[AnnotationRadiusApplication] == "" && namespace != "" {
+		deployment.Annotations[AnnotationRadiusApplication] = namespace
+	}
+
+	return nil
+}
+
+// ValidateCreate validates the creation of a Deployment object.
+func (r *DeploymentWebhook) ValidateCreate(ctx context.Context, deployment *appsv1.Deployment) (admission.Warnings, error) {
+	if !isRadiusEnabled(deployment) {
+		return nil, nil
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info("Validating Create Deployment", "name", deployment.Name)
+	return r.validateConnections(ctx, nil, deployment)
+}
+
+// ValidateUpdate validates the update of a Deployment object.
+func (r *DeploymentWebhook) ValidateUpdate(ctx context.Context, oldDeployment, newDeployment *appsv1.Deployment) (admission.Warnings, error) {
+	// The finalizer of a Deployment that is being deleted must be removable even if its connections are invalid.
+	if !isRadiusEnabled(newDeployment) || newDeployment.DeletionTimestamp != nil {
+		return nil, nil
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	logger.Info("Validating Update Deployment", "name", newDeployment.Name)
+	return r.validateConnections(ctx, oldDeployment, newDeployment)
+}
+
+// ValidateDelete validates the deletion of a Deployment object.
+func (r *DeploymentWebhook) ValidateDelete(ctx context.Context, deployment *appsv1.Deployment) (admission.Warnings, error) {
+	// currently there is no validation when deleting Deployment
+	return nil, nil
+}
+
+// validateConnections validates that each connection annotation of a Deployment has a name that is unique once
+// uppercased for environment variables, and references a Recipe in the namespace of the Deployment. On update, only
+// the Recipes of connections that were added or changed are resolved, so that the Deployment can still be scaled or
+// rolled out if a Recipe it was already connected to was deleted.
+func (r *DeploymentWebhook) validateConnections(ctx context.Context, oldDeployment, deployment *appsv1.Deployment) (admission.Warnings, error) {
+	var errList field.ErrorList
+	annotationsPath := field.NewPath("metadata", "annotations")
+	connectionNames := map[string]string{}
+
+	for _, key := range sortedKeys(deployment.Annotations) {
+		name, ok := strings.CutPrefix(key, AnnotationRadiusConnectionPrefix)
+		if !ok {
+			continue
+		}
+
+		source := deployment.Annotations[key]
+		keyPath := annotationsPath.Key(key)
+		if name == "" {
+			errList = append(errList, field.Invalid(keyPath, source, "the connection name must not be empty"))
+			continue
+		}
+		if other, ok := connectionNames[strings.ToUpper(name)]; ok {
+			errList = append(errList, field.Duplicate(keyPath, fmt.Sprintf("the connection name %q conflicts with %q, because connection environment variable names are uppercase", name, other)))
+			continue
+		}
+		connectionNames[strings.ToUpper(name)] = name
+
+		if oldDeployment != nil && isRadiusEnabled(oldDeployment) && oldDeployment.Annotations[key] == source {
+			continue
+		}
+
+		if source == "" {
+			errList = append(errList, field.Required(keyPath, "must be the name of a Recipe"))
+			continue
+		}
+		if errs := validation.IsDNS1123Subdomain(source); len(errs) > 0 {
+			errList = append(errList, field.Invalid(keyPath, source, fmt.Sprintf("must be the name of a Recipe: %s", strings.Join(errs, ", "))))
+			continue
+		}
+
+		namespace := deployment.Namespace
+		if namespace == "" {
+			if request, err := admission.RequestFromContext(ctx); err == nil {
+				namespace = request.Namespace
+			}
+		}
+
+		recipe := radappiov1alpha3.Recipe{}
+		err := r.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: source}, &recipe)
+		if apierrors.IsNotFound(err) {
+			errList = append(errList, field.Invalid(keyPath, source, fmt.Sprintf("Recipe %q was not found in namespace %q", source, namespace)))
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to fetch recipe %s: %w", source, err)
+		}
+	}
+
+	if len(errList) > 0 {
+		return nil, apierrors.NewInvalid(
+			schema.GroupKind{Group: appsv1.GroupName, Kind: "Deployment"},
+			deployment.Name,
+			errList)
+	}
+
+	return nil, nil
+}
+
+// isRadiusEnabled returns true if a Deployment has Radius enabled.
+func isRadiusEnabled(deployment *appsv1.Deployment) bool {
+	return strings.EqualFold(deployment.Annotations[AnnotationRadiusEnabled], "true")
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	controllerfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newDeploymentWebhookTest(t *testing.T) *DeploymentWebhook {
+	s := runtime.NewScheme()
+	require.NoError(t, radappiov1alpha3.AddToScheme(s))
+
+	recipe := &radappiov1alpha3.Recipe{ObjectMeta: metav1.ObjectMeta{Name: "redis", Namespace: "app"}}
+	return &DeploymentWebhook{Client: controllerfake.NewClientBuilder().WithScheme(s).WithObjects(recipe).Build()}
+}
+
+func Test_DeploymentWebhook_Default(t *testing.T) {
+	webhook := newDeploymentWebhookTest(t)
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "app", Annotations: map[string]string{AnnotationRadiusEnabled: "true"}},
+	}
+	require.NoError(t, webhook.Default(t.Context(), deployment))
+	require.Equal(t, map[string]string{
+		AnnotationRadiusEnabled:     "true",
+		AnnotationRadiusEnvironment: "default",
+		AnnotationRadiusApplication: "app",
+	}, deployment.Annotations)
+
+	deployment.Annotations[AnnotationRadiusEnvironment] = "prod"
+	deployment.Annotations[AnnotationRadiusApplication] = "shop"
+	require.NoError(t, webhook.Default(t.Context(), deployment))
+	require.Equal(t, "prod", deployment.Annotations[AnnotationRadiusEnvironment])
+	require.Equal(t, "shop", deployment.Annotations[AnnotationRadiusApplication])
+
+	disabled := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "app"}}
+	require.NoError(t, webhook.Default(t.Context(), disabled))
+	require.Nil(t, disabled.Annotations)
+}
+
+func Test_DeploymentWebhook_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		errors      []string
+	}{
+		{
+			name:        "radius disabled",
+			annotations: map[string]string{AnnotationRadiusConnectionPrefix + "cache": "missing"},
+		},
+		{
+			name:        "valid connection",
+			annotations: map[string]string{AnnotationRadiusEnabled: "true", AnnotationRadiusConnectionPrefix + "cache": "redis"},
+		},
+		{
+			name:        "recipe not found",
+			annotations: map[string]string{AnnotationRadiusEnabled: "true", AnnotationRadiusConnectionPrefix + "cache": "missing"},
+			errors:      []string{`metadata.annotations[radapp.io/connection-cache]: Invalid value: "missing": Recipe "missing" was not found in namespace "app"`},
+		},
+		{
+			name: "invalid connection names and recipes",
+			annotations: map[string]string{
+				AnnotationRadiusEnabled:                    "true",
+				AnnotationRadiusConnectionPrefix:           "redis",
+				AnnotationRadiusConnectionPrefix + "Queue": "redis",
+				AnnotationRadiusConnectionPrefix + "cache": "",
+				AnnotationRadiusConnectionPrefix + "queue": "redis",
+				AnnotationRadiusConnectionPrefix + "db":    "Redis",
+			},
+			errors: []string{
+				`metadata.annotations[radapp.io/connection-]: Invalid value: "redis": the connection name must not be empty`,
+				`metadata.annotations[radapp.io/connection-queue]: Duplicate value: "the connection name \"queue\" conflicts with \"Queue\", because connection environment variable names are uppercase"`,
+				"metadata.annotations[radapp.io/connection-cache]: Required value: must be the name of a Recipe",
+				`metadata.annotations[radapp.io/connection-db]: Invalid value: "Redis": must be the name of a Recipe`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhook := newDeploymentWebhookTest(t)
+			deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "app", Annotations: tt.annotations}}
+
+			_, err := webhook.ValidateCreate(t.Context(), deployment)
+			if len(tt.errors) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.True(t, apierrors.IsInvalid(err))
+			for _, message := range tt.errors {
+				require.ErrorContains(t, err, message)
+			}
+		})
+	}
+}
+
+func Test_DeploymentWebhook_ValidateUpdate(t *testing.T) {
+	webhook := newDeploymentWebhookTest(t)
+
+	oldDeployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test",
+			Namespace:   "app",
+			Annotations: map[string]string{AnnotationRadiusEnabled: "true", AnnotationRadiusConnectionPrefix + "cache": "deleted"},
+		},
+	}
+
+	// A connection to a Recipe that was deleted since it was added does not block unrelated updates.
+	newDeployment := oldDeployment.DeepCopy()
+	newDeployment.Spec.Replicas = new(int32(3))
+	_, err := webhook.ValidateUpdate(t.Context(), oldDeployment, newDeployment)
+	require.NoError(t, err)
+
+	newDeployment.Annotations[AnnotationRadiusConnectionPrefix+"queue"] = "missing"
+	_, err = webhook.ValidateUpdate(t.Context(), oldDeployment, newDeployment)
+	require.ErrorContains(t, err, `Recipe "missing" was not found in namespace "app"`)
+	require.NotContains(t, err.Error(), "deleted")
+}
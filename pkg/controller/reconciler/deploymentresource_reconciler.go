@@ -56,6 +56,9 @@ type DeploymentResourceReconciler struct {
 	// ResourceDeploymentsClient is the client for managing deployments.
 	ResourceDeploymentsClient sdkclients.ResourceDeploymentsClient
 
+	// Router selects the UCP endpoint of a namespace. Namespaces that match no route use the default clients.
+	Router *UCPRouter
+
 	// DelayInterval is the amount of time to wait between operations.
 	DelayInterval time.Duration
 
@@ -133,7 +136,7 @@ func (r *DeploymentResourceReconciler) reconcileOperation(ctx context.Context, d
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	if deploymentResource.Status.Operation.OperationKind == radappiov1alpha3.OperationKindDelete {
-		poller, err := r.ResourceDeploymentsClient.ContinueDeleteOperation(ctx, deploymentResource.Status.Operation.ResumeToken)
+		poller, err := r.resourceDeployments(deploymentResource.Namespace).ContinueDeleteOperation(ctx, deploymentResource.Status.Operation.ResumeToken)
 		if err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to continue DELETE operation: %w", err)
 		}
@@ -320,7 +323,7 @@ func (r *DeploymentResourceReconciler) startDeleteOperation(ctx context.Context,
 	radiusAPIVersion := "2023-10-01-preview"
 
 	logger.Info("Starting DELETE operation.")
-	poller, err := r.ResourceDeploymentsClient.Delete(ctx, resourceId, radiusAPIVersion)
+	poller, err := r.resourceDeployments(deploymentResource.Namespace).Delete(ctx, resourceId, radiusAPIVersion)
 	if err != nil {
 		return nil, err
 	} else if poller != nil {
@@ -399,6 +402,11 @@ func resourceWithinScope(resourceID string, scope string) (bool, error) {
 	return strings.EqualFold(strings.TrimRight(id.RootScope(), "/"), strings.TrimRight(scope, "/")), nil
 }
 
+// resourceDeployments returns the deployments client for the UCP endpoint of a namespace.
+func (r *DeploymentResourceReconciler) resourceDeployments(namespace string) sdkclients.ResourceDeploymentsClient {
+	return r.Router.resourceDeployments(namespace, r.ResourceDeploymentsClient)
+}
+
 func (r *DeploymentResourceReconciler) requeueDelay() time.Duration {
 	delay := r.DelayInterval
 	if delay == 0 {
@@ -0,0 +1,204 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"strings"
+	"time"
+
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/radius-project/radius/pkg/cli/clients"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/radius-project/radius/pkg/hashutil"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// ReasonSyncSucceeded is the reason of the Synced condition when the deployed resources match the template.
+	ReasonSyncSucceeded = "Succeeded"
+
+	// ReasonSyncFailed is the reason of the Synced condition when the deployment of the template failed.
+	ReasonSyncFailed = "Failed"
+
+	// ReasonOutOfSync is the reason of the Synced condition when drift was detected and self-heal is disabled.
+	ReasonOutOfSync = "OutOfSync"
+
+	// ReasonDriftDetected is the reason of the Drifted condition when the deployed resources drifted.
+	ReasonDriftDetected = "DriftDetected"
+
+	// ReasonNoDrift is the reason of the Drifted condition when the deployed resources did not drift.
+	ReasonNoDrift = "NoDrift"
+
+	// EventDriftDetected is emitted on a DeploymentTemplate when its deployed resources drifted.
+	EventDriftDetected = "DriftDetected"
+
+	// EventDriftCheckFailed is emitted on a DeploymentTemplate when its deployed resources could not be checked for
+	// drift.
+	EventDriftCheckFailed = "DriftCheckFailed"
+)
+
+// driftIgnoredProperties are the properties of a resource that are updated by its resource provider, and so are not
+// compared when detecting drift.
+var driftIgnoredProperties = []string{"provisioningState", "status"}
+
+// deploymentSpec returns the part of the spec of a DeploymentTemplate that is deployed. The sync options are
+// excluded so that changing them does not redeploy the template.
+func deploymentSpec(deploymentTemplate *radappiov1alpha3.DeploymentTemplate) radappiov1alpha3.DeploymentTemplateSpec {
+	spec := deploymentTemplate.Spec
+	spec.ReconcileInterval = nil
+	spec.SelfHeal = false
+	spec.PrunePolicy = ""
+	return spec
+}
+
+// reconcileInterval returns the interval at which a DeploymentTemplate is checked for drift, or 0 when it is not.
+func reconcileInterval(deploymentTemplate *radappiov1alpha3.DeploymentTemplate) time.Duration {
+	if deploymentTemplate.Spec.ReconcileInterval == nil {
+		return 0
+	}
+	return deploymentTemplate.Spec.ReconcileInterval.Duration
+}
+
+// parseDriftTrackedResource parses the ID of an output resource, and returns false if drift is not detected for the
+// resource because it is not managed by a Radius resource provider.
+func parseDriftTrackedResource(resourceID string) (resources.ID, bool) {
+	id, err := resources.ParseResource(resourceID)
+	if err != nil || !id.IsUCPQualified() || !strings.HasPrefix(id.PlaneNamespace(), "radius/") {
+		return resources.ID{}, false
+	}
+	return id, true
+}
+
+// hashResourceProperties computes a hash of the properties of a resource, excluding driftIgnoredProperties.
+func hashResourceProperties(properties map[string]any) (string, error) {
+	compared := maps.Clone(properties)
+	for _, property := range driftIgnoredProperties {
+		delete(compared, property)
+	}
+
+	// Maps are marshalled with sorted keys, so the hash does not depend on the order of the properties.
+	b, err := json.Marshal(compared)
+	if err != nil {
+		return "", err
+	}
+
+	return hashutil.Hex(b), nil
+}
+
+// liveResourceHash returns the hash of the properties of a live resource deployed from a namespace, or "" if the
+// resource does not exist.
+func (r *DeploymentTemplateReconciler) liveResourceHash(ctx context.Context, namespace string, id resources.ID) (string, error) {
+	response, err := r.radius(namespace).Resources(id.RootScope(), id.Type()).Get(ctx, id.Name())
+	if clients.Is404Error(err) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	return hashResourceProperties(response.Properties)
+}
+
+// outputResourceHashes returns the hashes of the properties of the live output resources managed by a Radius
+// resource provider, by resource ID.
+func (r *DeploymentTemplateReconciler) outputResourceHashes(ctx context.Context, namespace string, outputResources []string) (map[string]string, error) {
+	hashes := map[string]string{}
+	for _, resourceID := range outputResources {
+		id, ok := parseDriftTrackedResource(resourceID)
+		if !ok {
+			continue
+		}
+
+		hash, err := r.liveResourceHash(ctx, namespace, id)
+		if err != nil {
+			return nil, err
+		} else if hash != "" {
+			hashes[resourceID] = hash
+		}
+	}
+
+	return hashes, nil
+}
+
+// detectDrift compares the live output resources of a DeploymentTemplate with their hashes recorded after the last
+// deployment. It returns a description of each drifted resource, and the hashes to record: the hash of a resource
+// deployed before drift detection was enabled is recorded from the live resource.
+func (r *DeploymentTemplateReconciler) detectDrift(ctx context.Context, deploymentTemplate *radappiov1alpha3.DeploymentTemplate) ([]string, map[string]string, error) {
+	drifted := []string{}
+	hashes := map[string]string{}
+	for _, resourceID := range deploymentTemplate.Status.OutputResources {
+		id, ok := parseDriftTrackedResource(resourceID)
+		if !ok {
+			continue
+		}
+
+		live, err := r.liveResourceHash(ctx, deploymentTemplate.Namespace, id)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		recorded, ok := deploymentTemplate.Status.OutputResourceHashes[resourceID]
+		switch {
+		case live == "":
+			drifted = append(drifted, resourceID+" was deleted")
+			if ok {
+				hashes[resourceID] = recorded
+			}
+		case ok && live != recorded:
+			drifted = append(drifted, resourceID+" was modified")
+			hashes[resourceID] = recorded
+		default:
+			hashes[resourceID] = live
+		}
+	}
+
+	return drifted, hashes, nil
+}
+
+// orphanDeploymentResource deletes a DeploymentResource without deleting the resource it tracks, by removing the
+// finalizer that deletes the resource first.
+func (r *DeploymentTemplateReconciler) orphanDeploymentResource(ctx context.Context, key client.ObjectKey) error {
+	deploymentResource := &radappiov1alpha3.DeploymentResource{}
+	if err := r.Client.Get(ctx, key, deploymentResource); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if controllerutil.RemoveFinalizer(deploymentResource, DeploymentResourceFinalizer) {
+		if err := r.Client.Update(ctx, deploymentResource); err != nil {
+			return err
+		}
+	}
+
+	return client.IgnoreNotFound(r.Client.Delete(ctx, deploymentResource))
+}
+
+// setDeploymentTemplateCondition sets a condition on the status of a DeploymentTemplate. The status is not updated.
+func setDeploymentTemplateCondition(deploymentTemplate *radappiov1alpha3.DeploymentTemplate, conditionType string, status metav1.ConditionStatus, reason string, message string) {
+	apimeta.SetStatusCondition(&deploymentTemplate.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: deploymentTemplate.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
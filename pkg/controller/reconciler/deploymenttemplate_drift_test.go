@@ -0,0 +1,189 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func Test_hashResourceProperties(t *testing.T) {
+	hash, err := hashResourceProperties(map[string]any{"image": "nginx", "port": 80, "provisioningState": "Succeeded"})
+	require.NoError(t, err)
+
+	same, err := hashResourceProperties(map[string]any{"port": 80, "image": "nginx", "status": map[string]any{"outputResources": []any{}}})
+	require.NoError(t, err)
+	require.Equal(t, hash, same)
+
+	modified, err := hashResourceProperties(map[string]any{"image": "redis", "port": 80})
+	require.NoError(t, err)
+	require.NotEqual(t, hash, modified)
+}
+
+func Test_parseDriftTrackedResource(t *testing.T) {
+	testcases := []struct {
+		name       string
+		resourceID string
+		expected   bool
+	}{
+		{name: "radius", resourceID: "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/frontend", expected: true},
+		{name: "kubernetes", resourceID: "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/frontend", expected: false},
+		{name: "azure", resourceID: "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/account", expected: false},
+		{name: "invalid", resourceID: "invalid", expected: false},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, ok := parseDriftTrackedResource(tc.resourceID)
+			require.Equal(t, tc.expected, ok)
+		})
+	}
+}
+
+func Test_DeploymentTemplateReconciler_detectDrift(t *testing.T) {
+	const (
+		scope        = "/planes/radius/local/resourceGroups/rg"
+		unchangedID  = scope + "/providers/Applications.Core/containers/unchanged"
+		modifiedID   = scope + "/providers/Applications.Core/containers/modified"
+		deletedID    = scope + "/providers/Applications.Core/containers/deleted"
+		untrackedID  = scope + "/providers/Applications.Core/containers/untracked"
+		kubernetesID = "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/frontend"
+	)
+
+	deployed := map[string]any{"image": "nginx"}
+	deployedHash, err := hashResourceProperties(deployed)
+	require.NoError(t, err)
+
+	radius := NewMockRadiusClient()
+	radius.resources[unchangedID] = generated.GenericResource{Properties: map[string]any{"image": "nginx", "provisioningState": "Succeeded"}}
+	radius.resources[modifiedID] = generated.GenericResource{Properties: map[string]any{"image": "redis"}}
+	radius.resources[untrackedID] = generated.GenericResource{Properties: deployed}
+
+	deploymentTemplate := &radappiov1alpha3.DeploymentTemplate{
+		Status: radappiov1alpha3.DeploymentTemplateStatus{
+			OutputResources: []string{unchangedID, modifiedID, deletedID, untrackedID, kubernetesID},
+			OutputResourceHashes: map[string]string{
+				unchangedID: deployedHash,
+				modifiedID:  deployedHash,
+				deletedID:   deployedHash,
+			},
+		},
+	}
+
+	r := &DeploymentTemplateReconciler{Radius: radius}
+	drifted, hashes, err := r.detectDrift(t.Context(), deploymentTemplate)
+	require.NoError(t, err)
+	require.Equal(t, []string{modifiedID + " was modified", deletedID + " was deleted"}, drifted)
+
+	// The hash of the resource deployed before drift detection was enabled is recorded from the live resource.
+	require.Equal(t, map[string]string{
+		unchangedID: deployedHash,
+		modifiedID:  deployedHash,
+		deletedID:   deployedHash,
+		untrackedID: deployedHash,
+	}, hashes)
+}
+
+func Test_DeploymentTemplateReconciler_IsUpToDate_IgnoresSyncOptions(t *testing.T) {
+	deploymentTemplate := &radappiov1alpha3.DeploymentTemplate{
+		Spec: radappiov1alpha3.DeploymentTemplateSpec{
+			Template:       "{}",
+			Parameters:     map[string]string{},
+			ProviderConfig: "{}",
+		},
+	}
+
+	hash, err := computeHash(deploymentTemplate)
+	require.NoError(t, err)
+	deploymentTemplate.Status.StatusHash = hash
+
+	deploymentTemplate.Spec.ReconcileInterval = &metav1.Duration{Duration: time.Minute}
+	deploymentTemplate.Spec.SelfHeal = true
+	deploymentTemplate.Spec.PrunePolicy = radappiov1alpha3.DeploymentTemplatePrunePolicyOrphan
+	require.True(t, isUpToDate(deploymentTemplate))
+
+	deploymentTemplate.Spec.Template = `{"resources":{}}`
+	require.False(t, isUpToDate(deploymentTemplate))
+}
+
+// unavailableRadiusClient is a RadiusClient whose resources can't be retrieved.
+type unavailableRadiusClient struct {
+	*mockRadiusClient
+}
+
+func (rc *unavailableRadiusClient) Resources(scope string, resourceType string) ResourceClient {
+	return &unavailableResourceClient{ResourceClient: rc.mockRadiusClient.Resources(scope, resourceType)}
+}
+
+type unavailableResourceClient struct {
+	ResourceClient
+}
+
+func (rc *unavailableResourceClient) Get(ctx context.Context, resourceName string) (generated.GenericResourcesClientGetResponse, error) {
+	return generated.GenericResourcesClientGetResponse{}, errors.New("service unavailable")
+}
+
+func Test_DeploymentTemplateReconciler_checkDrift(t *testing.T) {
+	const resourceID = "/planes/radius/local/resourceGroups/rg/providers/Applications.Core/containers/frontend"
+
+	newDeploymentTemplate := func(lastSync time.Time) *radappiov1alpha3.DeploymentTemplate {
+		return &radappiov1alpha3.DeploymentTemplate{
+			Spec: radappiov1alpha3.DeploymentTemplateSpec{
+				ReconcileInterval: &metav1.Duration{Duration: time.Minute},
+			},
+			Status: radappiov1alpha3.DeploymentTemplateStatus{
+				Phrase:          radappiov1alpha3.DeploymentTemplatePhraseReady,
+				LastSyncTime:    &metav1.Time{Time: lastSync},
+				OutputResources: []string{resourceID},
+			},
+		}
+	}
+
+	t.Run("interval not elapsed", func(t *testing.T) {
+		r := &DeploymentTemplateReconciler{Radius: &unavailableRadiusClient{NewMockRadiusClient()}, EventRecorder: record.NewFakeRecorder(10)}
+
+		requeueAfter, healing, err := r.checkDrift(t.Context(), newDeploymentTemplate(time.Now()))
+		require.NoError(t, err)
+		require.False(t, healing)
+		require.Greater(t, requeueAfter, time.Duration(0))
+		require.LessOrEqual(t, requeueAfter, time.Minute)
+	})
+
+	t.Run("transient error does not change the deployed status", func(t *testing.T) {
+		recorder := record.NewFakeRecorder(10)
+		r := &DeploymentTemplateReconciler{Radius: &unavailableRadiusClient{NewMockRadiusClient()}, EventRecorder: recorder}
+
+		deploymentTemplate := newDeploymentTemplate(time.Now().Add(-time.Hour))
+		expected := deploymentTemplate.Status.DeepCopy()
+
+		_, healing, err := r.checkDrift(t.Context(), deploymentTemplate)
+		require.ErrorContains(t, err, "service unavailable")
+		require.False(t, healing)
+		require.Equal(t, *expected, deploymentTemplate.Status)
+
+		event := <-recorder.Events
+		require.Contains(t, event, EventDriftCheckFailed)
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -58,6 +59,13 @@ type DeploymentTemplateReconciler struct {
 	// ResourceDeploymentsClient is the client for managing deployments.
 	ResourceDeploymentsClient sdkclients.ResourceDeploymentsClient
 
+	// Router selects the UCP endpoint of a namespace. Namespaces that match no route use the default clients.
+	Router *UCPRouter
+
+	// ClusterName identifies the cluster that the controller runs in. When set, it is added as a tag to the
+	// deployed Radius resources.
+	ClusterName string
+
 	// DelayInterval is the amount of time to wait between operations.
 	DelayInterval time.Duration
 }
@@ -128,7 +136,7 @@ func (r *DeploymentTemplateReconciler) reconcileOperation(ctx context.Context, d
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	if deploymentTemplate.Status.Operation.OperationKind == radappiov1alpha3.OperationKindPut {
-		poller, err := r.ResourceDeploymentsClient.ContinueCreateOperation(ctx, deploymentTemplate.Status.Operation.ResumeToken)
+		poller, err := r.resourceDeployments(deploymentTemplate.Namespace).ContinueCreateOperation(ctx, deploymentTemplate.Status.Operation.ResumeToken)
 		if err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to continue PUT operation: %w", err)
 		}
@@ -161,7 +169,7 @@ func (r *DeploymentTemplateReconciler) reconcileOperation(ctx context.Context, d
 			r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeWarning, "ResourceError", err.Error())
 			logger.Error(err, "Update failed.")
 
-			if err := r.updateFailedStatus(ctx, deploymentTemplate); err != nil {
+			if err := r.updateFailedStatus(ctx, deploymentTemplate, err.Error()); err != nil {
 				return ctrl.Result{}, err
 			}
 
@@ -247,14 +255,23 @@ func (r *DeploymentTemplateReconciler) reconcileOperation(ctx context.Context, d
 
 		for _, resource := range deploymentTemplate.Status.OutputResources {
 			if _, ok := newOutputResources[resource]; !ok {
-				// Resource is present in deploymentTemplate.Status.OutputResources but not in outputResources, delete it
-
-				logger.Info("Deleting resource.", "resourceId", resource)
+				// Resource is present in deploymentTemplate.Status.OutputResources but not in outputResources, prune it
+				// according to the prune policy.
 				resourceName, err := generateDeploymentResourceName(resource)
 				if err != nil {
 					return ctrl.Result{}, err
 				}
 
+				if deploymentTemplate.Spec.PrunePolicy == radappiov1alpha3.DeploymentTemplatePrunePolicyOrphan {
+					logger.Info("Orphaning resource.", "resourceId", resource)
+					err = r.orphanDeploymentResource(ctx, client.ObjectKey{Namespace: deploymentTemplate.Namespace, Name: resourceName})
+					if err != nil {
+						return ctrl.Result{}, err
+					}
+					continue
+				}
+
+				logger.Info("Deleting resource.", "resourceId", resource)
 				err = r.Client.Delete(ctx, &radappiov1alpha3.DeploymentResource{
 					ObjectMeta: metav1.ObjectMeta{
 						Name:      resourceName,
@@ -281,10 +298,24 @@ func (r *DeploymentTemplateReconciler) reconcileOperation(ctx context.Context, d
 			return ctrl.Result{}, err
 		}
 
+		// Record the deployed state of the resources to detect drift later on.
+		var outputResourceHashes map[string]string
+		if reconcileInterval(deploymentTemplate) > 0 {
+			outputResourceHashes, err = r.outputResourceHashes(ctx, deploymentTemplate.Namespace, outputResources)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionDrifted, metav1.ConditionFalse, ReasonNoDrift, "The deployed resources match the template.")
+		}
+
 		// If we get here, the operation was a success. Update the status and continue.
+		now := metav1.Now()
 		deploymentTemplate.Status.Operation = nil
 		deploymentTemplate.Status.OutputResources = outputResources
+		deploymentTemplate.Status.OutputResourceHashes = outputResourceHashes
 		deploymentTemplate.Status.StatusHash = hash
+		deploymentTemplate.Status.LastSyncTime = &now
+		setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionSynced, metav1.ConditionTrue, ReasonSyncSucceeded, "The template was deployed.")
 		if residualsPresent {
 			deploymentTemplate.Status.Phrase = radappiov1alpha3.DeploymentTemplatePhraseReadyPendingCleanup
 		} else {
@@ -344,7 +375,7 @@ func (r *DeploymentTemplateReconciler) reconcileUpdate(ctx context.Context, depl
 		logger.Error(err, "Unable to create or update resource.")
 		r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeWarning, "ResourceError", err.Error())
 
-		if statusErr := r.updateFailedStatus(ctx, deploymentTemplate); statusErr != nil {
+		if statusErr := r.updateFailedStatus(ctx, deploymentTemplate, err.Error()); statusErr != nil {
 			return ctrl.Result{}, statusErr
 		}
 
@@ -371,6 +402,14 @@ func (r *DeploymentTemplateReconciler) reconcileUpdate(ctx context.Context, depl
 	// If we get here then it means we can process the result of the operation.
 	logger.Info("Resource is in desired state.")
 
+	// Check the deployed resources for drift once the reconcile interval has elapsed since the last sync.
+	requeueAfter, healing, err := r.checkDrift(ctx, deploymentTemplate)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if healing {
+		return ctrl.Result{Requeue: true, RequeueAfter: r.requeueDelay()}, nil
+	}
+
 	// If we're still in ReadyPendingCleanup from a prior reconcile, re-evaluate
 	// residuals (woken by the Owns watch) and only transition to Ready once
 	// they've drained.
@@ -391,7 +430,89 @@ func (r *DeploymentTemplateReconciler) reconcileUpdate(ctx context.Context, depl
 	}
 
 	r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeNormal, "Reconciled", "Successfully reconciled resource.")
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// checkDrift checks the deployed resources of a DeploymentTemplate for drift once its reconcile interval has elapsed
+// since the last sync. It returns the delay until the next check, and true when the template is being redeployed to
+// heal drift.
+//
+// The template is already deployed, so failing to check for drift doesn't change its status. A warning event is
+// emitted and the error is returned, so the check is retried with backoff.
+func (r *DeploymentTemplateReconciler) checkDrift(ctx context.Context, deploymentTemplate *radappiov1alpha3.DeploymentTemplate) (time.Duration, bool, error) {
+	interval := reconcileInterval(deploymentTemplate)
+	if interval <= 0 {
+		return 0, false, nil
+	}
+
+	if deploymentTemplate.Status.LastSyncTime != nil {
+		if elapsed := time.Since(deploymentTemplate.Status.LastSyncTime.Time); elapsed < interval {
+			return interval - elapsed, false, nil
+		}
+	}
+
+	healing, err := r.reconcileDrift(ctx, deploymentTemplate)
+	if err != nil {
+		ucplog.FromContextOrDiscard(ctx).Error(err, "Unable to reconcile drift.")
+		r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeWarning, EventDriftCheckFailed, err.Error())
+		return 0, false, err
+	}
+
+	return interval, healing, nil
+}
+
+// reconcileDrift checks the deployed resources of a DeploymentTemplate for drift and records the result in its
+// status. When drift is detected and self-heal is enabled, it starts a PUT operation to redeploy the template and
+// returns true.
+func (r *DeploymentTemplateReconciler) reconcileDrift(ctx context.Context, deploymentTemplate *radappiov1alpha3.DeploymentTemplate) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	drifted, hashes, err := r.detectDrift(ctx, deploymentTemplate)
+	if err != nil {
+		return false, fmt.Errorf("failed to detect drift: %w", err)
+	}
+
+	now := metav1.Now()
+	deploymentTemplate.Status.LastSyncTime = &now
+	deploymentTemplate.Status.OutputResourceHashes = hashes
+
+	if len(drifted) == 0 {
+		setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionDrifted, metav1.ConditionFalse, ReasonNoDrift, "The deployed resources match the template.")
+		setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionSynced, metav1.ConditionTrue, ReasonSyncSucceeded, "The deployed resources match the template.")
+		return false, nil
+	}
+
+	message := "Drift detected: " + strings.Join(drifted, ", ") + "."
+	logger.Info("Drift detected.", "resources", drifted)
+	r.EventRecorder.Event(deploymentTemplate, corev1.EventTypeWarning, EventDriftDetected, message)
+	setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionDrifted, metav1.ConditionTrue, ReasonDriftDetected, message)
+
+	if !deploymentTemplate.Spec.SelfHeal {
+		setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionSynced, metav1.ConditionFalse, ReasonOutOfSync, "The deployed resources drifted from the template and selfHeal is disabled.")
+		return false, nil
+	}
+
+	logger.Info("Self-healing drift, starting PUT operation.")
+	poller, err := r.startPutOperation(ctx, deploymentTemplate)
+	if err != nil {
+		return false, err
+	} else if poller == nil {
+		// The update was synchronous, so the resources are deployed again.
+		return false, nil
+	}
+
+	token, err := poller.ResumeToken()
+	if err != nil {
+		return false, fmt.Errorf("failed to get operation token: %w", err)
+	}
+
+	deploymentTemplate.Status.Operation = &radappiov1alpha3.ResourceOperation{ResumeToken: token, OperationKind: radappiov1alpha3.OperationKindPut}
+	deploymentTemplate.Status.Phrase = radappiov1alpha3.DeploymentTemplatePhraseUpdating
+	if err := r.Client.Status().Update(ctx, deploymentTemplate); err != nil {
+		return false, err
+	}
+
+	return true, nil
 }
 
 func (r *DeploymentTemplateReconciler) reconcileDelete(ctx context.Context, deploymentTemplate *radappiov1alpha3.DeploymentTemplate) (ctrl.Result, error) {
@@ -468,8 +589,6 @@ func (r *DeploymentTemplateReconciler) reconcileDelete(ctx context.Context, depl
 func (r *DeploymentTemplateReconciler) startPutOperationIfNeeded(ctx context.Context, deploymentTemplate *radappiov1alpha3.DeploymentTemplate) (sdkclients.Poller[sdkclients.ClientCreateOrUpdateResponse], error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
-	specParameters := convertToARMJSONParameters(deploymentTemplate.Spec.Parameters)
-
 	// If the resource is already created and is up-to-date, then we don't need to do anything.
 	if isUpToDate(deploymentTemplate) {
 		logger.Info("Resource is up-to-date.")
@@ -477,6 +596,15 @@ func (r *DeploymentTemplateReconciler) startPutOperationIfNeeded(ctx context.Con
 	}
 
 	logger.Info("Desired state has changed, starting PUT operation.")
+	return r.startPutOperation(ctx, deploymentTemplate)
+}
+
+// startPutOperation starts a PUT operation that deploys the template of a DeploymentTemplate. It returns a nil poller
+// when the update was synchronous.
+func (r *DeploymentTemplateReconciler) startPutOperation(ctx context.Context, deploymentTemplate *radappiov1alpha3.DeploymentTemplate) (sdkclients.Poller[sdkclients.ClientCreateOrUpdateResponse], error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	specParameters := convertToARMJSONParameters(deploymentTemplate.Spec.Parameters)
 
 	var template any
 	err := json.Unmarshal([]byte(deploymentTemplate.Spec.Template), &template)
@@ -499,7 +627,7 @@ func (r *DeploymentTemplateReconciler) startPutOperationIfNeeded(ctx context.Con
 	// Create the Radius resource group corresponding the providerConfig.Deployments.Value.Scope
 	// if it does not exist. This is necessary because the resource group is required for the
 	// deployment operation.
-	err = createResourceGroupIfNotExists(ctx, r.Radius, providerConfig.Deployments.Value.Scope)
+	err = createResourceGroupIfNotExists(ctx, r.radius(deploymentTemplate.Namespace), providerConfig.Deployments.Value.Scope, clusterTags(r.ClusterName))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create resource group: %w", err)
 	}
@@ -508,7 +636,7 @@ func (r *DeploymentTemplateReconciler) startPutOperationIfNeeded(ctx context.Con
 	resourceID := providerConfig.Deployments.Value.Scope + "/providers/" + "Microsoft.Resources/deployments" + "/" + deploymentName
 
 	logger.Info("Starting PUT operation.")
-	poller, err := r.ResourceDeploymentsClient.CreateOrUpdate(ctx,
+	poller, err := r.resourceDeployments(deploymentTemplate.Namespace).CreateOrUpdate(ctx,
 		sdkclients.Deployment{
 			Properties: &sdkclients.DeploymentProperties{
 				Template:       template,
@@ -530,6 +658,16 @@ func (r *DeploymentTemplateReconciler) startPutOperationIfNeeded(ctx context.Con
 	return nil, nil
 }
 
+// radius returns the Radius client for the UCP endpoint of a namespace.
+func (r *DeploymentTemplateReconciler) radius(namespace string) RadiusClient {
+	return r.Router.radius(namespace, r.Radius)
+}
+
+// resourceDeployments returns the deployments client for the UCP endpoint of a namespace.
+func (r *DeploymentTemplateReconciler) resourceDeployments(namespace string) sdkclients.ResourceDeploymentsClient {
+	return r.Router.resourceDeployments(namespace, r.ResourceDeploymentsClient)
+}
+
 func (r *DeploymentTemplateReconciler) requeueDelay() time.Duration {
 	delay := r.DelayInterval
 	if delay == 0 {
@@ -598,9 +736,9 @@ func (r *DeploymentTemplateReconciler) hasResidualDeploymentResources(ctx contex
 }
 
 // computeHash computes a hash of the DeploymentTemplate's spec (desired state)
-// to save in the status (observed state).
+// to save in the status (observed state). The sync options of the spec are not hashed.
 func computeHash(deploymentTemplate *radappiov1alpha3.DeploymentTemplate) (string, error) {
-	b, err := json.Marshal(deploymentTemplate.Spec)
+	b, err := json.Marshal(deploymentSpec(deploymentTemplate))
 	if err != nil {
 		return "", err
 	}
@@ -615,7 +753,7 @@ func computeHash(deploymentTemplate *radappiov1alpha3.DeploymentTemplate) (strin
 // upgrading Radius (which changes the hash algorithm) does not flag an otherwise
 // unchanged DeploymentTemplate as out-of-date and trigger an unnecessary deployment.
 func isUpToDate(deploymentTemplate *radappiov1alpha3.DeploymentTemplate) bool {
-	b, err := json.Marshal(deploymentTemplate.Spec)
+	b, err := json.Marshal(deploymentSpec(deploymentTemplate))
 	if err != nil {
 		return false
 	}
@@ -626,9 +764,10 @@ func isUpToDate(deploymentTemplate *radappiov1alpha3.DeploymentTemplate) bool {
 
 // updateFailedStatus updates the deployment template status to failed state and clears the operation.
 // This helper reduces duplication when handling operation failures.
-func (r *DeploymentTemplateReconciler) updateFailedStatus(ctx context.Context, deploymentTemplate *radappiov1alpha3.DeploymentTemplate) error {
+func (r *DeploymentTemplateReconciler) updateFailedStatus(ctx context.Context, deploymentTemplate *radappiov1alpha3.DeploymentTemplate, message string) error {
 	deploymentTemplate.Status.Operation = nil
 	deploymentTemplate.Status.Phrase = radappiov1alpha3.DeploymentTemplatePhraseFailed
+	setDeploymentTemplateCondition(deploymentTemplate, radappiov1alpha3.DeploymentTemplateConditionSynced, metav1.ConditionFalse, ReasonSyncFailed, message)
 	return r.Client.Status().Update(ctx, deploymentTemplate)
 }
 
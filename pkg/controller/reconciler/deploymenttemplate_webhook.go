@@ -0,0 +1,166 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupWebhookWithManager sets up the validating webhook for the DeploymentTemplate type with the provided manager.
+func (r *DeploymentTemplateWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr, &radappiov1alpha3.DeploymentTemplate{}).
+		WithValidator(r).
+		Complete()
+}
+
+// DeploymentTemplateWebhook implements the validating webhook functions for the DeploymentTemplate type. It
+// statically validates the template, provider config and parameters, which would otherwise only fail when the
+// template is deployed.
+type DeploymentTemplateWebhook struct{}
+
+// ValidateCreate validates the creation of a DeploymentTemplate object.
+func (r *DeploymentTemplateWebhook) ValidateCreate(ctx context.Context, deploymentTemplate *radappiov1alpha3.DeploymentTemplate) (admission.Warnings, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	logger.Info("Validating Create DeploymentTemplate", "name", deploymentTemplate.Name)
+	return r.validateDeploymentTemplate(deploymentTemplate)
+}
+
+// ValidateUpdate validates the update of a DeploymentTemplate object.
+func (r *DeploymentTemplateWebhook) ValidateUpdate(ctx context.Context, oldDeploymentTemplate, newDeploymentTemplate *radappiov1alpha3.DeploymentTemplate) (admission.Warnings, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// The finalizer of a DeploymentTemplate that is being deleted must be removable whatever its spec.
+	if newDeploymentTemplate.DeletionTimestamp != nil {
+		return nil, nil
+	}
+
+	logger.Info("Validating Update DeploymentTemplate", "name", newDeploymentTemplate.Name)
+	return r.validateDeploymentTemplate(newDeploymentTemplate)
+}
+
+// ValidateDelete validates the deletion of a DeploymentTemplate object.
+func (r *DeploymentTemplateWebhook) ValidateDelete(ctx context.Context, deploymentTemplate *radappiov1alpha3.DeploymentTemplate) (admission.Warnings, error) {
+	// currently there is no validation when deleting DeploymentTemplate
+	return nil, nil
+}
+
+// validateDeploymentTemplate validates the spec of a DeploymentTemplate object.
+func (r *DeploymentTemplateWebhook) validateDeploymentTemplate(deploymentTemplate *radappiov1alpha3.DeploymentTemplate) (admission.Warnings, error) {
+	var errList field.ErrorList
+	specPath := field.NewPath("spec")
+
+	errList = append(errList, validateTemplateAndParameters(specPath, deploymentTemplate.Spec.Template, deploymentTemplate.Spec.Parameters)...)
+	errList = append(errList, validateProviderConfig(specPath.Child("providerConfig"), deploymentTemplate.Spec.ProviderConfig)...)
+
+	if interval := deploymentTemplate.Spec.ReconcileInterval; interval != nil && interval.Duration <= 0 {
+		errList = append(errList, field.Invalid(specPath.Child("reconcileInterval"), interval.Duration.String(), "must be a positive duration"))
+	}
+	if deploymentTemplate.Spec.SelfHeal && deploymentTemplate.Spec.ReconcileInterval == nil {
+		errList = append(errList, field.Invalid(specPath.Child("selfHeal"), true, "requires spec.reconcileInterval to be set"))
+	}
+
+	if len(errList) > 0 {
+		return nil, apierrors.NewInvalid(
+			schema.GroupKind{Group: radappiov1alpha3.GroupVersion.Group, Kind: deploymentTemplateKind},
+			deploymentTemplate.Name,
+			errList)
+	}
+
+	return nil, nil
+}
+
+// validateTemplateAndParameters validates that the template is an ARM JSON template, and that the parameters are
+// declared by the template and set all of its parameters without a default value.
+func validateTemplateAndParameters(specPath *field.Path, template string, parameters map[string]string) field.ErrorList {
+	templatePath := specPath.Child("template")
+	if template == "" {
+		return field.ErrorList{field.Required(templatePath, "must be an ARM JSON template")}
+	}
+
+	parsed := struct {
+		Parameters map[string]map[string]any `json:"parameters"`
+	}{}
+	if err := json.Unmarshal([]byte(template), &parsed); err != nil {
+		return field.ErrorList{field.Invalid(templatePath, field.OmitValueType{}, fmt.Sprintf("must be an ARM JSON template: %s", err.Error()))}
+	}
+
+	// ARM parameter names are case-insensitive.
+	declared := map[string]string{}
+	for name := range parsed.Parameters {
+		declared[strings.ToLower(name)] = name
+	}
+
+	var errList field.ErrorList
+	parametersPath := specPath.Child("parameters")
+	for _, name := range sortedKeys(parameters) {
+		if _, ok := declared[strings.ToLower(name)]; !ok {
+			errList = append(errList, field.NotFound(parametersPath.Key(name), "is not a parameter of the template"))
+		}
+	}
+
+	provided := map[string]bool{}
+	for name := range parameters {
+		provided[strings.ToLower(name)] = true
+	}
+	for _, name := range sortedKeys(parsed.Parameters) {
+		if _, ok := parsed.Parameters[name]["defaultValue"]; !ok && !provided[strings.ToLower(name)] {
+			errList = append(errList, field.Required(parametersPath.Key(name), "is a parameter of the template without a default value"))
+		}
+	}
+
+	return errList
+}
+
+// validateProviderConfig validates that the provider config sets a valid deployment scope.
+func validateProviderConfig(providerConfigPath *field.Path, providerConfig string) field.ErrorList {
+	scope, err := ParseDeploymentScopeFromProviderConfig(providerConfig)
+	if err != nil {
+		return field.ErrorList{field.Invalid(providerConfigPath, providerConfig, err.Error())}
+	}
+	if scope == "" {
+		return field.ErrorList{field.Invalid(providerConfigPath, providerConfig, "must set deployments.value.scope")}
+	}
+	if _, err := resources.ParseScope(scope); err != nil {
+		return field.ErrorList{field.Invalid(providerConfigPath, providerConfig, fmt.Sprintf("deployments.value.scope is not a valid scope: %s", err.Error()))}
+	}
+
+	return nil
+}
+
+// sortedKeys returns the keys of a map in order, so that validation errors are reported in a stable order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+	"time"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	webhookTestTemplate       = `{"parameters":{"image":{"type":"string"},"port":{"type":"int","defaultValue":80}},"resources":{}}`
+	webhookTestProviderConfig = `{"deployments":{"type":"Microsoft.Resources","value":{"scope":"/planes/radius/local/resourceGroups/default"}}}`
+)
+
+func Test_DeploymentTemplateWebhook_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(spec *radappiov1alpha3.DeploymentTemplateSpec)
+		errors []string
+	}{
+		{
+			name:   "valid",
+			modify: func(spec *radappiov1alpha3.DeploymentTemplateSpec) {},
+		},
+		{
+			name: "parameter names are case-insensitive",
+			modify: func(spec *radappiov1alpha3.DeploymentTemplateSpec) {
+				spec.Parameters = map[string]string{"Image": "nginx", "PORT": "8080"}
+			},
+		},
+		{
+			name:   "missing template",
+			modify: func(spec *radappiov1alpha3.DeploymentTemplateSpec) { spec.Template = "" },
+			errors: []string{"spec.template: Required value: must be an ARM JSON template"},
+		},
+		{
+			name:   "invalid template",
+			modify: func(spec *radappiov1alpha3.DeploymentTemplateSpec) { spec.Template = "resource foo" },
+			errors: []string{"spec.template: Invalid value: must be an ARM JSON template: invalid character 'r' looking for beginning of value"},
+		},
+		{
+			name: "undeclared and missing parameters",
+			modify: func(spec *radappiov1alpha3.DeploymentTemplateSpec) {
+				spec.Parameters = map[string]string{"tag": "latest"}
+			},
+			errors: []string{
+				`spec.parameters[tag]: Not found: "is not a parameter of the template"`,
+				"spec.parameters[image]: Required value: is a parameter of the template without a default value",
+			},
+		},
+		{
+			name:   "provider config without deployments",
+			modify: func(spec *radappiov1alpha3.DeploymentTemplateSpec) { spec.ProviderConfig = "{}" },
+			errors: []string{`spec.providerConfig: Invalid value: "{}": providerConfig.Deployments is nil`},
+		},
+		{
+			name: "provider config with invalid scope",
+			modify: func(spec *radappiov1alpha3.DeploymentTemplateSpec) {
+				spec.ProviderConfig = `{"deployments":{"value":{"scope":"default"}}}`
+			},
+			errors: []string{`spec.providerConfig: Invalid value: "{\"deployments\":{\"value\":{\"scope\":\"default\"}}}": deployments.value.scope is not a valid scope: 'default' is not a valid resource id`},
+		},
+		{
+			name:   "self-heal without reconcile interval",
+			modify: func(spec *radappiov1alpha3.DeploymentTemplateSpec) { spec.SelfHeal = true },
+			errors: []string{"spec.selfHeal: Invalid value: true: requires spec.reconcileInterval to be set"},
+		},
+		{
+			name: "negative reconcile interval",
+			modify: func(spec *radappiov1alpha3.DeploymentTemplateSpec) {
+				spec.ReconcileInterval = &metav1.Duration{Duration: -time.Minute}
+			},
+			errors: []string{`spec.reconcileInterval: Invalid value: "-1m0s": must be a positive duration`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deploymentTemplate := &radappiov1alpha3.DeploymentTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				Spec: radappiov1alpha3.DeploymentTemplateSpec{
+					Template:       webhookTestTemplate,
+					Parameters:     map[string]string{"image": "nginx"},
+					ProviderConfig: webhookTestProviderConfig,
+				},
+			}
+			tt.modify(&deploymentTemplate.Spec)
+
+			webhook := &DeploymentTemplateWebhook{}
+			_, err := webhook.ValidateCreate(t.Context(), deploymentTemplate)
+			_, updateErr := webhook.ValidateUpdate(t.Context(), deploymentTemplate, deploymentTemplate)
+			if len(tt.errors) == 0 {
+				require.NoError(t, err)
+				require.NoError(t, updateErr)
+				return
+			}
+
+			require.True(t, apierrors.IsInvalid(err))
+			require.Equal(t, err, updateErr)
+
+			for _, message := range tt.errors {
+				require.ErrorContains(t, err, message)
+			}
+		})
+	}
+}
+
+func Test_DeploymentTemplateWebhook_ValidateUpdate_Deleting(t *testing.T) {
+	deploymentTemplate := &radappiov1alpha3.DeploymentTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", DeletionTimestamp: &metav1.Time{Time: time.Now()}},
+	}
+
+	_, err := (&DeploymentTemplateWebhook{}).ValidateUpdate(t.Context(), deploymentTemplate, deploymentTemplate)
+	require.NoError(t, err)
+}
@@ -9,8 +9,10 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"sync"
 	"sync/atomic"
 
+	"github.com/fluxcd/pkg/apis/meta"
 	"github.com/go-logr/logr"
 	"github.com/radius-project/radius/pkg/cli/bicep"
 	"github.com/radius-project/radius/pkg/cli/filesystem"
@@ -20,12 +22,18 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	sourcev1 "github.com/fluxcd/source-controller/api/v1"
 
@@ -33,22 +41,36 @@ import (
 )
 
 const (
-	deploymentTemplateRepositoryField = "spec.repository"
-	radiusConfigFileName              = "radius-gitops-config.yaml"
-	armJSONParametersKeyName          = "parameters"
+	deploymentTemplateRepositoryFieldPrefix = "spec.repository"
+	radiusConfigFileName                    = "radius-gitops-config.yaml"
+	armJSONParametersKeyName                = "parameters"
 )
 
-// FluxController watches GitRepository objects for revision changes
+// FluxController watches Flux source objects (GitRepository, OCIRepository and Bucket) for revision changes
 // and processes the artifacts fetched from the Source Controller.
-// It reads the git repository configuration, builds the bicep files.
+// It reads the radius-gitops-config.yaml configuration of the source, builds the bicep files
 // specified in the configuration, and creates DeploymentTemplate objects
-// on the cluster.
+// on the cluster in the order of their sync waves and dependencies. The health of the
+// DeploymentTemplates is reported by the RadiusReady condition of the source.
 type FluxController struct {
 	client.Client
 	Bicep          bicep.Interface
 	FileSystem     filesystem.FileSystem
 	ArchiveFetcher ArchiveFetcher
-	initialized    *atomic.Bool // Track if we've initialized the controller
+
+	// Helm renders the Helm charts of the configuration entries. Entries with a Helm chart fail to build if nil.
+	Helm HelmRenderer
+
+	// EventRecorder is the Kubernetes event recorder.
+	EventRecorder record.EventRecorder
+
+	// Router selects the resource group of the DeploymentTemplates generated for a namespace when the configuration
+	// entry does not set one.
+	Router *UCPRouter
+
+	initialized    map[string]*atomic.Bool // Track which source kinds have a controller, by kind
+	revisionsMutex sync.Mutex
+	revisions      map[fluxSourceKey]*fluxSourceRevision // Track the last revision built for each source
 }
 
 // RadiusGitOpsConfig is the configuration for Radius in a Git repository.
@@ -66,22 +88,125 @@ type ConfigEntry struct {
 	Namespace string `yaml:"namespace,omitempty"`
 	// ResourceGroup is the Radius resource group that the Bicep file should be deployed to.
 	ResourceGroup string `yaml:"resourceGroup,omitempty"`
+	// DependsOn is the list of the names of the Bicep files of the configuration whose DeploymentTemplates must be
+	// ready before the DeploymentTemplate of this file is created or updated.
+	DependsOn []string `yaml:"dependsOn,omitempty"`
+	// SyncWave is the sync wave of the Bicep file. The DeploymentTemplates of a wave are created or updated once the
+	// DeploymentTemplates of the lower waves are ready. Defaults to 0, and can be negative.
+	SyncWave int `yaml:"syncWave,omitempty"`
+	// Helm is the Helm chart deployed together with the Bicep file. The objects of the chart are applied to the
+	// namespace once the DeploymentTemplate is ready, and the entry is ready once its Radius-enabled Deployments are.
+	Helm *HelmChartEntry `yaml:"helm,omitempty"`
 }
 
-// deploymentTemplateRepositoryIndexer indexes DeploymentTemplate objects by their repository field.
-func deploymentTemplateRepositoryIndexer(o client.Object) []string {
-	deploymentTemplate, ok := o.(*radappiov1alpha3.DeploymentTemplate)
-	if !ok {
-		return nil
+// fluxSource is a Flux source object that produces an artifact.
+type fluxSource interface {
+	client.Object
+	sourcev1.Source
+	GetConditions() []metav1.Condition
+	SetConditions(conditions []metav1.Condition)
+}
+
+// fluxSourceKey identifies a Flux source object.
+type fluxSourceKey struct {
+	Kind string
+	types.NamespacedName
+}
+
+// fluxSourceRevision is a revision of a Flux source whose Bicep files were built.
+type fluxSourceRevision struct {
+	// revision is the revision of the artifact of the source.
+	revision string
+	// config is the radius-gitops-config.yaml configuration of the revision, or nil if it has none.
+	config *RadiusGitOpsConfig
+	// builds are the results of building the Bicep files of the configuration, by file name.
+	builds map[string]fluxBuild
+}
+
+// fluxBuild is the result of building a Bicep file of a Flux source.
+type fluxBuild struct {
+	namespace      string
+	template       string
+	parameters     map[string]string
+	providerConfig string
+	objects        []*unstructured.Unstructured
+	err            error
+}
+
+// fluxSourceKind describes a kind of Flux source watched by the FluxController.
+type fluxSourceKind struct {
+	// Kind is the kind of the source, e.g. GitRepository.
+	Kind string
+	// New creates an empty object of the kind.
+	New func() fluxSource
+}
+
+// fluxSourceKinds are the kinds of Flux sources watched by the FluxController.
+var fluxSourceKinds = []fluxSourceKind{
+	{Kind: sourcev1.GitRepositoryKind, New: func() fluxSource { return &sourcev1.GitRepository{} }},
+	{Kind: sourcev1.OCIRepositoryKind, New: func() fluxSource { return &sourcev1.OCIRepository{} }},
+	{Kind: sourcev1.BucketKind, New: func() fluxSource { return &sourcev1.Bucket{} }},
+}
+
+// repositoryReference returns the value of DeploymentTemplate.Spec.Repository for a source. GitRepository sources
+// are referenced by name for compatibility with the DeploymentTemplates created before other kinds were supported,
+// and other kinds are referenced as "<kind>/<name>".
+func repositoryReference(kind string, name string) string {
+	if kind == sourcev1.GitRepositoryKind {
+		return name
+	}
+	return kind + "/" + name
+}
+
+// parseRepositoryReference returns the kind and name of the source referenced by DeploymentTemplate.Spec.Repository.
+func parseRepositoryReference(reference string) (kind string, name string) {
+	// Kubernetes object names cannot contain a '/', so a reference without one is a GitRepository.
+	kind, name, found := strings.Cut(reference, "/")
+	if !found {
+		return sourcev1.GitRepositoryKind, reference
+	}
+	return kind, name
+}
+
+// deploymentTemplateRepositoryField returns the name of the index of DeploymentTemplate objects by the name of
+// their source of the given kind.
+func deploymentTemplateRepositoryField(kind string) string {
+	return deploymentTemplateRepositoryFieldPrefix + "." + strings.ToLower(kind)
+}
+
+// deploymentTemplateRepositoryIndexer returns an indexer of DeploymentTemplate objects by the name of their source
+// of the given kind.
+func deploymentTemplateRepositoryIndexer(kind string) client.IndexerFunc {
+	return func(o client.Object) []string {
+		deploymentTemplate, ok := o.(*radappiov1alpha3.DeploymentTemplate)
+		if !ok || deploymentTemplate.Spec.Repository == "" {
+			return nil
+		}
+
+		sourceKind, name := parseRepositoryReference(deploymentTemplate.Spec.Repository)
+		if sourceKind != kind {
+			return nil
+		}
+		return []string{name}
 	}
-	return []string{deploymentTemplate.Spec.Repository}
 }
 
 func (r *FluxController) SetupWithManager(mgr ctrl.Manager) error {
-	r.initialized = &atomic.Bool{}
+	r.initialized = map[string]*atomic.Bool{}
+	r.revisions = map[fluxSourceKey]*fluxSourceRevision{}
+	for _, kind := range fluxSourceKinds {
+		r.initialized[kind.Kind] = &atomic.Bool{}
+
+		// The indexes are registered up front because they cannot be added once the DeploymentTemplate informer
+		// has started, which can happen before the Flux CRDs are installed.
+		err := mgr.GetFieldIndexer().IndexField(context.Background(), &radappiov1alpha3.DeploymentTemplate{}, deploymentTemplateRepositoryField(kind.Kind), deploymentTemplateRepositoryIndexer(kind.Kind))
+		if err != nil {
+			return err
+		}
+	}
 
 	// Register a controller for CustomResourceDefinition events.
-	// We want to watch for the GitRepository CRD to be created or updated.
+	// We want to watch for the Flux source CRDs to be created or updated.
 	err := ctrl.NewControllerManagedBy(mgr).
 		For(&apiextensionsv1.CustomResourceDefinition{}).
 		WithEventFilter(predicate.Funcs{
@@ -103,14 +228,15 @@ func (r *FluxController) SetupWithManager(mgr ctrl.Manager) error {
 	return err
 }
 
-// registerFluxController registers the FluxController with the manager
-// when the GitRepository CRD is created or updated.
+// registerFluxController registers a controller for a kind of Flux source with the manager
+// when the CRD of the kind is created or updated.
 func (r *FluxController) registerFluxController(obj client.Object, mgr ctrl.Manager) bool {
 	// Only process if:
-	// 1. It's the GitRepository CRD
-	// 2. The controller isn't already initialized
+	// 1. It's the CRD of a Flux source kind we watch
+	// 2. The controller of the kind isn't already initialized
 	// 3. The CRD is established (fully registered with the API server)
-	if !isGitRepositoryCRD(obj) || r.initialized.Load() {
+	kind, ok := fluxSourceKindForCRD(obj)
+	if !ok || r.initialized[kind.Kind].Load() {
 		return false
 	}
 
@@ -119,11 +245,11 @@ func (r *FluxController) registerFluxController(obj client.Object, mgr ctrl.Mana
 	for _, condition := range crd.Status.Conditions {
 		if condition.Type == apiextensionsv1.Established &&
 			condition.Status == apiextensionsv1.ConditionTrue {
-			// CRD is established, set up the GitRepository controller
-			err := r.setupFluxController(mgr)
+			// CRD is established, set up the controller for the kind
+			err := r.setupFluxController(mgr, kind)
 			if err != nil {
 				ucplog.FromContextOrDiscard(context.Background()).Error(
-					err, "failed to setup GitRepository controller")
+					err, "failed to setup Flux source controller", "kind", kind.Kind)
 			}
 			return false // We don't need to reconcile CRDs
 		}
@@ -132,51 +258,90 @@ func (r *FluxController) registerFluxController(obj client.Object, mgr ctrl.Mana
 	return false // Not established yet
 }
 
-// setupFluxController creates a new controller for GitRepository objects
+// setupFluxController creates a new controller for the objects of a kind of Flux source
 // and sets it up with the manager.
-func (r *FluxController) setupFluxController(mgr ctrl.Manager) error {
-	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &radappiov1alpha3.DeploymentTemplate{}, deploymentTemplateRepositoryField, deploymentTemplateRepositoryIndexer); err != nil {
-		return err
-	}
-
+func (r *FluxController) setupFluxController(mgr ctrl.Manager, kind fluxSourceKind) error {
+	reconciler := &fluxSourceReconciler{FluxController: r, kind: kind}
 	err := ctrl.NewControllerManagedBy(mgr).
-		For(&sourcev1.GitRepository{}, builder.WithPredicates(&GitRepositoryRevisionChangePredicate{})).
-		Complete(r)
+		For(kind.New(), builder.WithPredicates(&GitRepositoryRevisionChangePredicate{})).
+		Watches(&radappiov1alpha3.DeploymentTemplate{}, handler.EnqueueRequestsFromMapFunc(reconciler.requestsForDeploymentTemplate)).
+		Complete(reconciler)
 
 	if err != nil {
 		return err
 	}
 
-	r.initialized.Store(true)
+	r.initialized[kind.Kind].Store(true)
 	return nil
 }
 
-// +kubebuilder:rbac:groups=source.toolkit.fluxcd.io,resources=gitrepositories,verbs=get;list;watch
-// +kubebuilder:rbac:groups=source.toolkit.fluxcd.io,resources=gitrepositories/status,verbs=get
-
+// Reconcile is called for CustomResourceDefinition events. The events are filtered by registerFluxController, so
+// there is nothing to reconcile.
 func (r *FluxController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	logger := ucplog.FromContextOrDiscard(ctx).WithValues("kind", "FluxController", "name", req.Name, "namespace", req.Namespace)
+	return ctrl.Result{}, nil
+}
+
+// fluxSourceReconciler reconciles the objects of a kind of Flux source.
+type fluxSourceReconciler struct {
+	*FluxController
+	kind fluxSourceKind
+}
+
+// +kubebuilder:rbac:groups=source.toolkit.fluxcd.io,resources=gitrepositories;ocirepositories;buckets,verbs=get;list;watch
+// +kubebuilder:rbac:groups=source.toolkit.fluxcd.io,resources=gitrepositories/status;ocirepositories/status;buckets/status,verbs=get;update;patch
+
+func (r *fluxSourceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx).WithValues("kind", "FluxController", "sourceKind", r.kind.Kind, "name", req.Name, "namespace", req.Namespace)
 	ctx = logr.NewContext(ctx, logger)
+	key := fluxSourceKey{Kind: r.kind.Kind, NamespacedName: req.NamespacedName}
 
-	// Get the GitRepository object from the cluster
-	var repository sourcev1.GitRepository
-	if err := r.Get(ctx, req.NamespacedName, &repository); err != nil {
+	// Get the source object from the cluster
+	repository := r.kind.New()
+	if err := r.Get(ctx, req.NamespacedName, repository); err != nil {
+		if k8serrors.IsNotFound(err) {
+			r.forgetRevision(key)
+		}
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
 	// Check if the Artifact field is set
-	artifact := repository.Status.Artifact
+	artifact := repository.GetArtifact()
 	if artifact == nil {
-		logger.Info("No artifact found for GitRepository", "name", repository.Name)
+		logger.Info("No artifact found for source", "name", repository.GetName())
 		return ctrl.Result{}, nil
 	}
 
-	logger.Info("New revision detected", "revision", artifact.Revision)
+	// The Bicep files of a revision are built once. The source is reconciled again when its DeploymentTemplates
+	// change, to deploy the entries that were waiting for them.
+	revision := r.revision(key)
+	if revision == nil || revision.revision != artifact.Revision {
+		logger.Info("New revision detected", "revision", artifact.Revision)
+
+		var err error
+		revision, err = r.buildRevision(ctx, repository, artifact)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		r.storeRevision(key, revision)
+	}
+
+	if revision.config == nil {
+		return ctrl.Result{}, nil
+	}
+
+	return r.syncRevision(ctx, repository, revision)
+}
+
+// buildRevision fetches the artifact of a source, parses its radius-gitops-config.yaml file and builds the
+// Bicep files specified in the configuration. The build failures of the Bicep files are recorded in the result
+// and reported as events on the source.
+func (r *fluxSourceReconciler) buildRevision(ctx context.Context, repository fluxSource, artifact *meta.Artifact) (*fluxSourceRevision, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// Create temp dir to store the fetched artifact
-	tmpDir, err := r.FileSystem.MkdirTemp("", repository.Name)
+	tmpDir, err := r.FileSystem.MkdirTemp("", repository.GetName())
 	if err != nil {
-		return ctrl.Result{}, fmt.Errorf("failed to create temp dir, error: %w", err)
+		return nil, fmt.Errorf("failed to create temp dir, error: %w", err)
 	}
 
 	defer func(path string) {
@@ -191,80 +356,142 @@ func (r *FluxController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 	err = r.ArchiveFetcher.Fetch(artifact.URL, artifact.Digest, tmpDir)
 	if err != nil {
 		logger.Error(err, "Failed to fetch artifact", "url", artifact.URL)
-		return ctrl.Result{}, fmt.Errorf("failed to fetch artifact, error: %w", err)
+		return nil, fmt.Errorf("failed to fetch artifact, error: %w", err)
 	}
 
 	logger.Info("Successfully fetched artifact", "url", artifact.URL)
 
+	revision := &fluxSourceRevision{revision: artifact.Revision}
+
 	// Check if the radius-gitops-config.yaml file exists
 	_, err = r.FileSystem.Stat(filepath.Join(tmpDir, radiusConfigFileName))
 	if err != nil {
 		if errors.Is(err, fs.ErrNotExist) {
 			// No radius-gitops-config.yaml found in the repository, safe to ignore
-			logger.Info(fmt.Sprintf("No radius-gitops-config.yaml found in the repository: %s", repository.Name))
-			return ctrl.Result{}, nil
+			logger.Info(fmt.Sprintf("No radius-gitops-config.yaml found in the %s: %s", r.kind.Kind, repository.GetName()))
+			return revision, nil
 		} else {
 			logger.Error(err, "failed to check if radius-gitops-config.yaml exists")
-			return ctrl.Result{}, fmt.Errorf("failed to check if radius-gitops-config.yaml exists, error: %w", err)
+			return nil, fmt.Errorf("failed to check if radius-gitops-config.yaml exists, error: %w", err)
 		}
 	}
 
 	// Parse the radius-gitops-config.yaml file
-	radiusConfig, err := r.parseAndValidateRadiusGitOpsConfigFromFile(tmpDir, radiusConfigFileName)
+	revision.config, err = r.parseAndValidateRadiusGitOpsConfigFromFile(tmpDir, radiusConfigFileName)
 	if err != nil {
 		logger.Error(err, "failed to parse radius-gitops-config.yaml")
-		return ctrl.Result{}, err
+		return nil, err
 	}
 
 	// Run bicep build on all bicep files specified in radius-gitops-config.yaml
-	for _, bicepFile := range radiusConfig.Config {
-		fileName := bicepFile.Name
-		paramFileName := bicepFile.Params
-		namespace := bicepFile.Namespace
-		nameBase := strings.TrimSuffix(fileName, path.Ext(fileName))
-
-		if namespace == "" {
-			// If the namespace is not set, use the name of the bicep file
-			// (without extension) as the namespace. e.g. "example.bicep" -> "example"
-			namespace = nameBase
+	revision.builds = map[string]fluxBuild{}
+	for _, bicepFile := range revision.config.Config {
+		build, err := r.buildConfigEntry(ctx, tmpDir, bicepFile)
+		if err != nil {
+			logger.Error(err, "failed to build bicep file", "name", bicepFile.Name)
+			r.EventRecorder.Eventf(repository, corev1.EventTypeWarning, EventBuildFailed, "Failed to build %s: %s", bicepFile.Name, err.Error())
+			build.err = err
 		}
-		resourceGroup := bicepFile.ResourceGroup
-		if resourceGroup == "" {
-			// If the resource group is not set, use the name of the bicep file
-			// (without extension) as the resource group. e.g. "example.bicep" -> "example"
-			resourceGroup = nameBase
+		revision.builds[bicepFile.Name] = build
+	}
+
+	return revision, nil
+}
+
+// buildConfigEntry builds the Bicep file of a configuration entry and its parameters file.
+func (r *fluxSourceReconciler) buildConfigEntry(ctx context.Context, dir string, bicepFile ConfigEntry) (fluxBuild, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	fileName := bicepFile.Name
+	paramFileName := bicepFile.Params
+	namespace := bicepFile.Namespace
+	nameBase := strings.TrimSuffix(fileName, path.Ext(fileName))
+
+	if namespace == "" {
+		// If the namespace is not set, use the name of the bicep file
+		// (without extension) as the namespace. e.g. "example.bicep" -> "example"
+		namespace = nameBase
+	}
+	resourceGroup := bicepFile.ResourceGroup
+	if resourceGroup == "" {
+		resourceGroup = r.Router.resourceGroup(namespace)
+	}
+	if resourceGroup == "" {
+		// If the resource group is not set, use the name of the bicep file
+		// (without extension) as the resource group. e.g. "example.bicep" -> "example"
+		resourceGroup = nameBase
+	}
+
+	build := fluxBuild{namespace: namespace}
+
+	// Run bicep build on the bicep file
+	logger.Info("Running bicep build", "name", fileName)
+	template, err := r.runBicepBuild(ctx, dir, fileName)
+	if err != nil {
+		return build, err
+	}
+	build.template = template
+
+	// If the bicepparams file is specified, run bicep build-params on it
+	var armJSONParameters map[string]any
+	if paramFileName != "" {
+		logger.Info("Running bicep build-params", "name", paramFileName)
+		armJSONParameters, err = r.runBicepBuildParams(ctx, dir, paramFileName)
+		if err != nil {
+			return build, err
 		}
+	}
+	build.parameters = convertFromARMJSONParameters(armJSONParameters)
 
-		// Run bicep build on the bicep file
-		logger.Info("Running bicep build", "name", fileName)
-		template, err := r.runBicepBuild(ctx, tmpDir, fileName)
+	// Generate the provider config from the radius-gitops-config.yaml file
+	providerConfig := sdkclients.GenerateProviderConfig(resourceGroup, "", "")
+	marshalledProviderConfig, err := json.MarshalIndent(providerConfig, "", "  ")
+	if err != nil {
+		return build, err
+	}
+	build.providerConfig = string(marshalledProviderConfig)
+
+	if bicepFile.Helm != nil {
+		build.objects, err = r.renderHelmChart(ctx, dir, bicepFile, namespace)
 		if err != nil {
-			logger.Error(err, "failed to run bicep build")
-			return ctrl.Result{}, err
+			return build, err
 		}
+	}
 
-		// If the bicepparams file is specified, run bicep build-params on it
-		var armJSONParameters map[string]any
-		if paramFileName != "" {
-			logger.Info("Running bicep build-params", "name", paramFileName)
-			armJSONParameters, err = r.runBicepBuildParams(ctx, tmpDir, paramFileName)
-			if err != nil {
-				logger.Error(err, "failed to run bicep build-params")
-				return ctrl.Result{}, err
-			}
+	return build, nil
+}
+
+// syncRevision creates or updates the DeploymentTemplates of a built revision in sync wave and dependency order,
+// deletes the DeploymentTemplates of the files that were removed from the configuration, and reports the health of
+// the DeploymentTemplates on the source.
+func (r *fluxSourceReconciler) syncRevision(ctx context.Context, repository fluxSource, revision *fluxSourceRevision) (ctrl.Result, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	reference := repositoryReference(r.kind.Kind, repository.GetName())
+
+	entries := orderConfigEntries(revision.config.Config)
+	states := map[string]configEntryState{}
+	buildFailed := false
+	helmProgressing := false
+	for _, bicepFile := range entries {
+		build := revision.builds[bicepFile.Name]
+		if build.err != nil {
+			states[bicepFile.Name] = configEntryBuildFailed
+			buildFailed = true
+			continue
 		}
 
-		// Generate the provider config from the radius-gitops-config.yaml file
-		providerConfig := sdkclients.GenerateProviderConfig(resourceGroup, "", "")
-		marshalledProviderConfig, err := json.MarshalIndent(providerConfig, "", "  ")
-		if err != nil {
-			return ctrl.Result{}, err
+		// Hold back the entry until the entries of the earlier waves and its dependencies are ready. The
+		// DeploymentTemplate keeps the spec of the previous revision meanwhile.
+		if upstream := upstreamEntriesNotReady(bicepFile, entries, states); len(upstream) > 0 {
+			logger.Info("Waiting for upstream DeploymentTemplates to be ready", "name", bicepFile.Name, "upstream", upstream)
+			states[bicepFile.Name] = configEntryWaiting
+			continue
 		}
 
 		// Create the namespace if it doesn't exist
 		namespaceObj := &corev1.Namespace{
 			ObjectMeta: metav1.ObjectMeta{
-				Name: namespace,
+				Name: build.namespace,
 			},
 		}
 
@@ -276,21 +503,33 @@ func (r *FluxController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		}
 
 		// Now we should create (or update) each DeploymentTemplate for the bicep files
-		// specified in the git repository.
+		// specified in the source.
 		logger.Info("Creating or updating DeploymentTemplate", "name", bicepFile.Name)
-		parameters := convertFromARMJSONParameters(armJSONParameters)
-		err = r.createOrUpdateDeploymentTemplate(ctx, bicepFile.Name, namespace, template, string(marshalledProviderConfig), parameters, repository.Name)
+		deploymentTemplate, err := r.createOrUpdateDeploymentTemplate(ctx, bicepFile.Name, build.namespace, build.template, build.providerConfig, build.parameters, reference)
 		if err != nil {
 			logger.Error(err, "failed to create or update deployment template")
 			return ctrl.Result{}, err
 		}
 
+		states[bicepFile.Name] = deploymentTemplateState(deploymentTemplate)
 		logger.Info("Successfully created or updated DeploymentTemplate", "name", bicepFile.Name)
+
+		// The Helm chart of the entry is applied once the resources of the Bicep file are deployed, since its
+		// Deployments can connect to them.
+		if states[bicepFile.Name] == configEntryReady {
+			helmState, err := r.syncHelmChart(ctx, deploymentTemplate, build.objects)
+			if err != nil {
+				logger.Error(err, "failed to sync helm chart", "name", bicepFile.Name)
+				return ctrl.Result{}, err
+			}
+			states[bicepFile.Name] = combineConfigEntryStates(states[bicepFile.Name], helmState)
+			helmProgressing = helmProgressing || helmState == configEntryProgressing
+		}
 	}
 
-	// List all DeploymentTemplates on the cluster that are from the same git repository
+	// List all DeploymentTemplates on the cluster that are from the same source
 	deploymentTemplates := &radappiov1alpha3.DeploymentTemplateList{}
-	err = r.Client.List(ctx, deploymentTemplates, client.MatchingFields{deploymentTemplateRepositoryField: repository.Name}, client.InNamespace(""))
+	err := r.Client.List(ctx, deploymentTemplates, client.MatchingFields{deploymentTemplateRepositoryField(r.kind.Kind): repository.GetName()}, client.InNamespace(""))
 	if err != nil {
 		logger.Error(err, "unable to list deployment templates")
 		return ctrl.Result{}, err
@@ -301,9 +540,13 @@ func (r *FluxController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 	// For all of the DeploymentTemplates on the cluster, check if the bicep file
 	// that it was created from is still present in config. If not, delete the DeploymentTemplate.
 	for _, deploymentTemplate := range deploymentTemplates.Items {
-		if !isSpecifiedInConfig(deploymentTemplate.Name, radiusConfig.Config) {
+		if !isSpecifiedInConfig(deploymentTemplate.Name, revision.config.Config) {
 			// The DeploymentTemplate is not specified in the config, so we should delete it
 			logger.Info("Deleting DeploymentTemplate", "name", deploymentTemplate.Name)
+			if err := r.deleteHelmChart(ctx, &deploymentTemplate); err != nil {
+				logger.Error(err, "unable to delete helm chart objects")
+				return ctrl.Result{}, err
+			}
 			if err := r.Client.Delete(ctx, &deploymentTemplate); err != nil {
 				logger.Error(err, "unable to delete deployment template")
 				return ctrl.Result{}, err
@@ -313,9 +556,87 @@ func (r *FluxController) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		}
 	}
 
+	err = r.setRadiusReadyCondition(ctx, repository, radiusReadyCondition(entries, states, repository.GetGeneration()))
+	if err != nil {
+		logger.Error(err, "unable to update the status of the source")
+		return ctrl.Result{}, err
+	}
+
+	if buildFailed {
+		// Build the revision again on the next attempt, in case the failure is transient.
+		r.forgetRevision(fluxSourceKey{Kind: r.kind.Kind, NamespacedName: client.ObjectKeyFromObject(repository)})
+		return ctrl.Result{}, fmt.Errorf("failed to build the bicep files of %s %s", r.kind.Kind, repository.GetName())
+	}
+
+	if helmProgressing {
+		// Changes to the Deployments of the Helm charts do not trigger a reconcile of the source, so poll them.
+		return ctrl.Result{RequeueAfter: PollingDelay}, nil
+	}
+
 	return ctrl.Result{}, nil
 }
 
+// setRadiusReadyCondition sets the RadiusReady condition on the status of a source, if it changed.
+func (r *fluxSourceReconciler) setRadiusReadyCondition(ctx context.Context, repository fluxSource, condition metav1.Condition) error {
+	patch := client.MergeFromWithOptions(repository.DeepCopyObject().(client.Object), client.MergeFromWithOptimisticLock{})
+
+	conditions := repository.GetConditions()
+	if !apimeta.SetStatusCondition(&conditions, condition) {
+		return nil
+	}
+	repository.SetConditions(conditions)
+
+	return r.Status().Patch(ctx, repository, patch)
+}
+
+// requestsForDeploymentTemplate maps a DeploymentTemplate to the sources of the kind of the reconciler that it was
+// created from.
+func (r *fluxSourceReconciler) requestsForDeploymentTemplate(ctx context.Context, obj client.Object) []reconcile.Request {
+	deploymentTemplate, ok := obj.(*radappiov1alpha3.DeploymentTemplate)
+	if !ok || deploymentTemplate.Spec.Repository == "" {
+		return nil
+	}
+
+	kind, name := parseRepositoryReference(deploymentTemplate.Spec.Repository)
+	if kind != r.kind.Kind {
+		return nil
+	}
+
+	// DeploymentTemplates do not record the namespace of their source, so all the sources with the name that were
+	// built are reconciled.
+	r.revisionsMutex.Lock()
+	defer r.revisionsMutex.Unlock()
+
+	requests := []reconcile.Request{}
+	for key := range r.revisions {
+		if key.Kind == kind && key.Name == name {
+			requests = append(requests, reconcile.Request{NamespacedName: key.NamespacedName})
+		}
+	}
+	return requests
+}
+
+// revision returns the last revision built for a source, or nil.
+func (r *FluxController) revision(key fluxSourceKey) *fluxSourceRevision {
+	r.revisionsMutex.Lock()
+	defer r.revisionsMutex.Unlock()
+	return r.revisions[key]
+}
+
+// storeRevision stores the last revision built for a source.
+func (r *FluxController) storeRevision(key fluxSourceKey, revision *fluxSourceRevision) {
+	r.revisionsMutex.Lock()
+	defer r.revisionsMutex.Unlock()
+	r.revisions[key] = revision
+}
+
+// forgetRevision removes the last revision built for a source.
+func (r *FluxController) forgetRevision(key fluxSourceKey) {
+	r.revisionsMutex.Lock()
+	defer r.revisionsMutex.Unlock()
+	delete(r.revisions, key)
+}
+
 func (r *FluxController) runBicepBuild(ctx context.Context, filepath, filename string) (armJSON string, err error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -384,7 +705,7 @@ func (r *FluxController) runBicepBuildParams(ctx context.Context, filepath, file
 
 // createOrUpdateDeploymentTemplate creates or updates a DeploymentTemplate object in the cluster
 // with the given spec.
-func (r *FluxController) createOrUpdateDeploymentTemplate(ctx context.Context, fileName, namespace, template, providerConfig string, parameters map[string]string, repository string) error {
+func (r *FluxController) createOrUpdateDeploymentTemplate(ctx context.Context, fileName, namespace, template, providerConfig string, parameters map[string]string, repository string) (*radappiov1alpha3.DeploymentTemplate, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// Try to get the DeploymentTemplate object from the cluster
@@ -394,7 +715,7 @@ func (r *FluxController) createOrUpdateDeploymentTemplate(ctx context.Context, f
 		if client.IgnoreNotFound(err) != nil {
 			// Error getting the DeploymentTemplate object that is not a NotFound error
 			logger.Error(err, "unable to get deployment template")
-			return err
+			return nil, err
 		}
 
 		// If the DeploymentTemplate doesn't exist, create it
@@ -412,27 +733,26 @@ func (r *FluxController) createOrUpdateDeploymentTemplate(ctx context.Context, f
 		}
 		if err := r.Client.Create(ctx, deploymentTemplate); err != nil {
 			logger.Error(err, "unable to create deployment template")
-			return err
+			return nil, err
 		}
 
 		logger.Info("Created Deployment Template", "name", deploymentTemplate.Name)
-		return nil
+		return deploymentTemplate, nil
 	}
 
-	// If the DeploymentTemplate already exists, update it
-	deploymentTemplate.Spec = radappiov1alpha3.DeploymentTemplateSpec{
-		Template:       template,
-		Parameters:     parameters,
-		ProviderConfig: providerConfig,
-		Repository:     repository,
-	}
+	// If the DeploymentTemplate already exists, update it. The sync options of the spec are not set from the
+	// source, so they are preserved.
+	deploymentTemplate.Spec.Template = template
+	deploymentTemplate.Spec.Parameters = parameters
+	deploymentTemplate.Spec.ProviderConfig = providerConfig
+	deploymentTemplate.Spec.Repository = repository
 	if err := r.Client.Update(ctx, &deploymentTemplate); err != nil {
 		logger.Error(err, "unable to update deployment template")
-		return err
+		return nil, err
 	}
 
 	logger.Info("Updated Deployment Template", "name", deploymentTemplate.Name)
-	return nil
+	return &deploymentTemplate, nil
 }
 
 // parseAndValidateRadiusGitOpsConfigFromFile reads the radius-gitops-config.yaml file from the given directory
@@ -475,6 +795,13 @@ func (r *FluxController) parseAndValidateRadiusGitOpsConfigFromFile(dir, configF
 			}
 		}
 
+		// If the bicepFile.Helm field is set, validate that the chart and values files exist
+		if bicepFile.Helm != nil {
+			if err := r.validateHelmChartEntry(dir, bicepFile); err != nil {
+				return nil, err
+			}
+		}
+
 		// If the bicepFile.Params field is set, validate that the file exists
 		if bicepFile.Params != "" {
 			_, err := r.FileSystem.Stat(path.Join(dir, bicepFile.Params))
@@ -487,6 +814,12 @@ func (r *FluxController) parseAndValidateRadiusGitOpsConfigFromFile(dir, configF
 			}
 		}
 	}
+
+	// Validate the dependencies and sync waves of the bicep files
+	if err := validateConfigDependencies(radiusConfig.Config); err != nil {
+		return nil, err
+	}
+
 	return &radiusConfig, nil
 }
 
@@ -500,17 +833,21 @@ func isSpecifiedInConfig(fileName string, config []ConfigEntry) bool {
 	return false
 }
 
-// isGitRepositoryCRD checks if obj is a source.toolkit.fluxcd.io/v1/GitRepository CustomResourceDefinition
-func isGitRepositoryCRD(obj client.Object) bool {
+// fluxSourceKindForCRD returns the Flux source kind watched by the FluxController whose
+// source.toolkit.fluxcd.io/v1 CustomResourceDefinition is obj.
+func fluxSourceKindForCRD(obj client.Object) (fluxSourceKind, bool) {
 	crd, ok := obj.(*apiextensionsv1.CustomResourceDefinition)
-	if !ok {
-		return false
+	if !ok || crd.Spec.Group != sourcev1.GroupVersion.Group || !containsVersion(crd.Spec.Versions, sourcev1.GroupVersion.Version) {
+		return fluxSourceKind{}, false
+	}
+
+	for _, kind := range fluxSourceKinds {
+		if crd.Spec.Names.Kind == kind.Kind {
+			return kind, true
+		}
 	}
 
-	// Check if this is the GitRepository CRD
-	return crd.Spec.Group == "source.toolkit.fluxcd.io" &&
-		containsVersion(crd.Spec.Versions, "v1") &&
-		crd.Spec.Names.Kind == "GitRepository"
+	return fluxSourceKind{}, false
 }
 
 // containsVersion checks if the version list contains the target version
@@ -32,9 +32,11 @@ import (
 	"github.com/stretchr/testify/require"
 	gomock "go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	crconfig "sigs.k8s.io/controller-runtime/pkg/config"
@@ -53,6 +55,7 @@ func Test_FluxController_Basic(t *testing.T) {
 		testGitRepoName,
 		testGitRepoURL,
 		testGitRepoSHA,
+		sourcev1.GitRepositoryKind,
 		mctrl,
 	}
 
@@ -79,6 +82,7 @@ func Test_FluxController_Update(t *testing.T) {
 		testGitRepoName,
 		testGitRepoURL,
 		testGitRepoSHA,
+		sourcev1.GitRepositoryKind,
 		mctrl,
 	}
 
@@ -96,6 +100,60 @@ func Test_FluxController_Update(t *testing.T) {
 	runFluxControllerTest(t, runOpts, steps)
 }
 
+func Test_FluxController_OCIRepository(t *testing.T) {
+	testRepoName := "flux-oci-repo"
+	testRepoURL := fmt.Sprintf("oci://ghcr.io/radius-project/%s", testRepoName)
+	testRepoSHA := "sha256:5678"
+
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	setupOpts := setupFluxControllerTestOptions{
+		testRepoName,
+		testRepoURL,
+		testRepoSHA,
+		sourcev1.OCIRepositoryKind,
+		mctrl,
+	}
+
+	steps := []Step{
+		{
+			Path: "testdata/flux-oci",
+		},
+	}
+
+	runOpts := setupFluxControllerTest(t, setupOpts, steps)
+
+	runFluxControllerTest(t, runOpts, steps)
+}
+
+func Test_FluxController_Bucket(t *testing.T) {
+	testBucketName := "flux-bucket"
+	testBucketURL := "s3.amazonaws.com"
+	testBucketSHA := "sha256:9abc"
+
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	setupOpts := setupFluxControllerTestOptions{
+		testBucketName,
+		testBucketURL,
+		testBucketSHA,
+		sourcev1.BucketKind,
+		mctrl,
+	}
+
+	steps := []Step{
+		{
+			Path: "testdata/flux-bucket",
+		},
+	}
+
+	runOpts := setupFluxControllerTest(t, setupOpts, steps)
+
+	runFluxControllerTest(t, runOpts, steps)
+}
+
 type Step struct {
 	Path            string
 	BicepFiles      []string
@@ -106,6 +164,7 @@ type setupFluxControllerTestOptions struct {
 	testGitRepoName string
 	testGitRepoURL  string
 	testGitRepoSHA  string
+	sourceKind      string
 
 	mctrl *gomock.Controller
 }
@@ -114,6 +173,7 @@ type runFluxControllerTestOptions struct {
 	testGitRepoName string
 	testGitRepoURL  string
 	testGitRepoSHA  string
+	sourceKind      string
 
 	archiveFetcher *MockArchiveFetcher
 	filesystem     *filesystem.MemMapFileSystem
@@ -144,8 +204,10 @@ func setupFluxControllerTest(t *testing.T, opts setupFluxControllerTestOptions,
 	})
 	require.NoError(t, err)
 
+	//nolint:staticcheck // SA1019: GetEventRecorderFor is deprecated but migration to new events API requires significant refactoring
 	fluxController := &FluxController{
 		Client:         mgr.GetClient(),
+		EventRecorder:  mgr.GetEventRecorderFor("flux-controller"),
 		FileSystem:     fs,
 		Bicep:          bicep,
 		ArchiveFetcher: archiveFetcher,
@@ -284,6 +346,7 @@ func setupFluxControllerTest(t *testing.T, opts setupFluxControllerTestOptions,
 		testGitRepoName: opts.testGitRepoName,
 		testGitRepoURL:  opts.testGitRepoURL,
 		testGitRepoSHA:  opts.testGitRepoSHA,
+		sourceKind:      opts.sourceKind,
 
 		archiveFetcher: archiveFetcher,
 		filesystem:     fs,
@@ -347,16 +410,15 @@ func runFluxControllerTest(t *testing.T, opts runFluxControllerTestOptions, step
 				require.NoError(t, err)
 			}
 
-			gitRepo := sourcev1.GitRepository{}
-			gitRepoNamespacedName := types.NamespacedName{Name: opts.testGitRepoName, Namespace: namespaceName}
+			sourceNamespacedName := types.NamespacedName{Name: opts.testGitRepoName, Namespace: namespaceName}
+			source := makeFluxSource(opts.sourceKind, sourceNamespacedName, opts.testGitRepoURL)
 			if stepNumber == 1 {
-				// Create a GitRepository resource on the cluster
-				gitRepo = makeGitRepository(gitRepoNamespacedName, opts.testGitRepoURL)
-				err = opts.client.Create(ctx, &gitRepo)
+				// Create the Flux source resource on the cluster
+				err = opts.client.Create(ctx, source)
 				require.NoError(t, err)
 				defer func() {
-					// Clean up the GitRepository resource after the test
-					err := opts.client.Delete(ctx, &gitRepo)
+					// Clean up the Flux source resource after the test
+					err := opts.client.Delete(ctx, source)
 					if err != nil {
 						if k8sclient.IgnoreNotFound(err) != nil {
 							require.NoError(t, err)
@@ -364,43 +426,32 @@ func runFluxControllerTest(t *testing.T, opts runFluxControllerTestOptions, step
 					}
 				}()
 
-				// Wait for the GitRepository to be created
-				err = waitForGitRepositoryToExistWithGeneration(ctx, opts.client, gitRepoNamespacedName, &gitRepo, int64(stepNumber))
-				require.NoError(t, err, "GitRepository was not created successfully")
+				// Wait for the Flux source to be created
+				err = waitForFluxSourceToExistWithGeneration(ctx, opts.client, sourceNamespacedName, source, int64(stepNumber))
+				require.NoError(t, err, "%s was not created successfully", opts.sourceKind)
 			}
 
-			// Fetch the latest GitRepository object
-			err = opts.client.Get(ctx, gitRepoNamespacedName, &gitRepo)
-			require.NoError(t, err)
+			// Update the Status subresource. The controller sets its own condition on the status, so the update is
+			// retried on conflicts.
+			err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				// Fetch the latest Flux source object
+				if err := opts.client.Get(ctx, sourceNamespacedName, source); err != nil {
+					return err
+				}
 
-			// Update the Status subresource
-			updatedStatus := sourcev1.GitRepositoryStatus{
-				ObservedGeneration: int64(stepNumber),
-				Artifact: &meta.Artifact{
+				setFluxSourceStatus(source, int64(stepNumber), &meta.Artifact{
 					URL:      opts.testGitRepoURL,
 					Digest:   opts.testGitRepoSHA,
 					Revision: fmt.Sprintf("v%d", stepNumber),
 					LastUpdateTime: metav1.Time{
 						Time: time.Now(),
 					},
-				},
-				Conditions: []metav1.Condition{
-					{
-						Type:    "Ready",
-						Status:  metav1.ConditionTrue,
-						Reason:  "Succeeded",
-						Message: "Repository is ready",
-						LastTransitionTime: metav1.Time{
-							Time: time.Now(),
-						},
-					},
-				},
-			}
-			gitRepo.Status = updatedStatus
-			err = opts.client.Status().Update(ctx, &gitRepo)
+				})
+				return opts.client.Status().Update(ctx, source)
+			})
 			require.NoError(t, err)
 
-			// Now, the FluxController should reconcile the GitRepository and create the DeploymentTemplate resource.
+			// Now, the FluxController should reconcile the Flux source and create the DeploymentTemplate resource.
 			deploymentTemplateName := name
 			deploymentTemplateNamespacedName := types.NamespacedName{Name: deploymentTemplateName, Namespace: namespaceName}
 			deploymentTemplate := radappiov1alpha3.DeploymentTemplate{}
@@ -410,27 +461,79 @@ func runFluxControllerTest(t *testing.T, opts runFluxControllerTestOptions, step
 			// Fetch the latest DeploymentTemplate object
 			err = opts.client.Get(ctx, deploymentTemplateNamespacedName, &deploymentTemplate)
 			require.NoError(t, err)
+			require.Equal(t, repositoryReference(opts.sourceKind, opts.testGitRepoName), deploymentTemplate.Spec.Repository)
 		}
 	}
 }
 
-func makeGitRepository(namespacedName types.NamespacedName, url string) sourcev1.GitRepository {
-	return sourcev1.GitRepository{
-		TypeMeta: metav1.TypeMeta{
-			Kind:       "GitRepository",
-			APIVersion: "source.toolkit.fluxcd.io/v1",
-		},
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      namespacedName.Name,
-			Namespace: namespacedName.Namespace,
-		},
-		Spec: sourcev1.GitRepositorySpec{
-			URL: url,
+func makeFluxSource(kind string, namespacedName types.NamespacedName, url string) fluxSource {
+	objectMeta := metav1.ObjectMeta{
+		Name:      namespacedName.Name,
+		Namespace: namespacedName.Namespace,
+	}
+
+	switch kind {
+	case sourcev1.OCIRepositoryKind:
+		return &sourcev1.OCIRepository{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       sourcev1.OCIRepositoryKind,
+				APIVersion: sourcev1.GroupVersion.String(),
+			},
+			ObjectMeta: objectMeta,
+			Spec: sourcev1.OCIRepositorySpec{
+				URL: url,
+			},
+		}
+	case sourcev1.BucketKind:
+		return &sourcev1.Bucket{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       sourcev1.BucketKind,
+				APIVersion: sourcev1.GroupVersion.String(),
+			},
+			ObjectMeta: objectMeta,
+			Spec: sourcev1.BucketSpec{
+				BucketName: namespacedName.Name,
+				Endpoint:   url,
+			},
+		}
+	default:
+		return &sourcev1.GitRepository{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       sourcev1.GitRepositoryKind,
+				APIVersion: sourcev1.GroupVersion.String(),
+			},
+			ObjectMeta: objectMeta,
+			Spec: sourcev1.GitRepositorySpec{
+				URL: url,
+			},
+		}
+	}
+}
+
+func setFluxSourceStatus(source fluxSource, observedGeneration int64, artifact *meta.Artifact) {
+	conditions := []metav1.Condition{
+		{
+			Type:    "Ready",
+			Status:  metav1.ConditionTrue,
+			Reason:  "Succeeded",
+			Message: "Source is ready",
+			LastTransitionTime: metav1.Time{
+				Time: time.Now(),
+			},
 		},
 	}
+
+	switch source := source.(type) {
+	case *sourcev1.GitRepository:
+		source.Status = sourcev1.GitRepositoryStatus{ObservedGeneration: observedGeneration, Artifact: artifact, Conditions: conditions}
+	case *sourcev1.OCIRepository:
+		source.Status = sourcev1.OCIRepositoryStatus{ObservedGeneration: observedGeneration, Artifact: artifact, Conditions: conditions}
+	case *sourcev1.Bucket:
+		source.Status = sourcev1.BucketStatus{ObservedGeneration: observedGeneration, Artifact: artifact, Conditions: conditions}
+	}
 }
 
-func waitForGitRepositoryToExistWithGeneration(ctx context.Context, k8sClient k8sclient.Client, key k8sclient.ObjectKey, obj k8sclient.Object, generation int64) error {
+func waitForFluxSourceToExistWithGeneration(ctx context.Context, k8sClient k8sclient.Client, key k8sclient.ObjectKey, obj k8sclient.Object, generation int64) error {
 	timeout := 10 * time.Second
 	interval := 200 * time.Millisecond
 	deadlineCtx, deadlineCancel := context.WithTimeout(ctx, timeout)
@@ -442,12 +545,7 @@ func waitForGitRepositoryToExistWithGeneration(ctx context.Context, k8sClient k8
 			return false, nil // Continue polling
 		}
 
-		gitRepo, ok := obj.(*sourcev1.GitRepository)
-		if !ok {
-			return false, nil // Continue polling
-		}
-
-		if gitRepo.Generation != generation {
+		if obj.GetGeneration() != generation {
 			return false, nil // Continue polling
 		}
 
@@ -455,7 +553,7 @@ func waitForGitRepositoryToExistWithGeneration(ctx context.Context, k8sClient k8
 	})
 
 	if err != nil {
-		return fmt.Errorf("GitRepository %s/%s was not created successfully", key.Namespace, key.Name)
+		return fmt.Errorf("Flux source %s/%s was not created successfully", key.Namespace, key.Name)
 	}
 
 	return nil
@@ -538,3 +636,94 @@ func Test_isSpecifiedInConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_deploymentTemplateRepositoryIndexer(t *testing.T) {
+	tests := []struct {
+		name       string
+		repository string
+		expected   map[string][]string
+	}{
+		{
+			name:       "GitRepository",
+			repository: "my-repo",
+			expected: map[string][]string{
+				sourcev1.GitRepositoryKind: {"my-repo"},
+			},
+		},
+		{
+			name:       "OCIRepository",
+			repository: "OCIRepository/my-repo",
+			expected: map[string][]string{
+				sourcev1.OCIRepositoryKind: {"my-repo"},
+			},
+		},
+		{
+			name:       "Bucket",
+			repository: "Bucket/my-bucket",
+			expected: map[string][]string{
+				sourcev1.BucketKind: {"my-bucket"},
+			},
+		},
+		{
+			name:       "No repository",
+			repository: "",
+			expected:   map[string][]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deploymentTemplate := &radappiov1alpha3.DeploymentTemplate{
+				Spec: radappiov1alpha3.DeploymentTemplateSpec{
+					Repository: tt.repository,
+				},
+			}
+
+			for _, kind := range fluxSourceKinds {
+				require.Equal(t, tt.expected[kind.Kind], deploymentTemplateRepositoryIndexer(kind.Kind)(deploymentTemplate), kind.Kind)
+			}
+		})
+	}
+}
+
+func Test_repositoryReference(t *testing.T) {
+	for _, kind := range fluxSourceKinds {
+		reference := repositoryReference(kind.Kind, "my-source")
+		parsedKind, parsedName := parseRepositoryReference(reference)
+		require.Equal(t, kind.Kind, parsedKind)
+		require.Equal(t, "my-source", parsedName)
+	}
+
+	require.Equal(t, "my-source", repositoryReference(sourcev1.GitRepositoryKind, "my-source"))
+	require.Equal(t, "OCIRepository/my-source", repositoryReference(sourcev1.OCIRepositoryKind, "my-source"))
+}
+
+func Test_fluxSourceKindForCRD(t *testing.T) {
+	makeCRD := func(group string, version string, kind string) *apiextensionsv1.CustomResourceDefinition {
+		return &apiextensionsv1.CustomResourceDefinition{
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group:    group,
+				Names:    apiextensionsv1.CustomResourceDefinitionNames{Kind: kind},
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{Name: version}},
+			},
+		}
+	}
+
+	for _, kind := range fluxSourceKinds {
+		result, ok := fluxSourceKindForCRD(makeCRD("source.toolkit.fluxcd.io", "v1", kind.Kind))
+		require.True(t, ok)
+		require.Equal(t, kind.Kind, result.Kind)
+	}
+
+	_, ok := fluxSourceKindForCRD(makeCRD("source.toolkit.fluxcd.io", "v1", sourcev1.HelmChartKind))
+	require.False(t, ok)
+
+	_, ok = fluxSourceKindForCRD(makeCRD("source.toolkit.fluxcd.io", "v1beta2", sourcev1.OCIRepositoryKind))
+	require.False(t, ok)
+
+	_, ok = fluxSourceKindForCRD(makeCRD("example.com", "v1", sourcev1.BucketKind))
+	require.False(t, ok)
+
+	_, ok = fluxSourceKindForCRD(&corev1.Namespace{})
+	require.False(t, ok)
+}
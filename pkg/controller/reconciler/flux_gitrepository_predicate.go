@@ -23,7 +23,8 @@ import (
 // Based on: https://github.com/fluxcd/source-watcher/blob/main/controllers/gitrepository_predicate.go
 
 // GitRepositoryRevisionChangePredicate triggers an update event
-// when a GitRepository revision changes.
+// when a GitRepository revision changes. It applies to any Flux source,
+// and is also used for OCIRepository and Bucket objects.
 type GitRepositoryRevisionChangePredicate struct {
 	predicate.Funcs
 }
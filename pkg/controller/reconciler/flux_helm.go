@@ -0,0 +1,412 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"go.yaml.in/yaml/v3"
+	"helm.sh/helm/v4/pkg/chart/common"
+	chartutil "helm.sh/helm/v4/pkg/chart/common/util"
+	"helm.sh/helm/v4/pkg/chart/v2/loader"
+	"helm.sh/helm/v4/pkg/engine"
+	releaseutil "helm.sh/helm/v4/pkg/release/v1/util"
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	k8syaml "sigs.k8s.io/yaml"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+)
+
+const (
+	// helmFieldOwner is the field manager used to apply the objects of the Helm charts of Flux sources.
+	helmFieldOwner = "radius-gitops"
+)
+
+// helmSupportedKinds are the kinds of objects that the controller applies from Helm charts. The controller is granted
+// permissions to manage these kinds in deploy/Chart/templates/controller/rbac.yaml. Cluster-scoped objects and RBAC
+// objects are not supported, so that a chart can't grant permissions that the controller doesn't have.
+var helmSupportedKinds = []schema.GroupKind{
+	{Group: "", Kind: "ConfigMap"},
+	{Group: "", Kind: "Secret"},
+	{Group: "", Kind: "Service"},
+	{Group: "", Kind: "ServiceAccount"},
+	{Group: "apps", Kind: "DaemonSet"},
+	{Group: "apps", Kind: "Deployment"},
+	{Group: "apps", Kind: "StatefulSet"},
+	{Group: "autoscaling", Kind: "HorizontalPodAutoscaler"},
+	{Group: "batch", Kind: "CronJob"},
+	{Group: "batch", Kind: "Job"},
+	{Group: "networking.k8s.io", Kind: "Ingress"},
+	{Group: "networking.k8s.io", Kind: "NetworkPolicy"},
+	{Group: "policy", Kind: "PodDisruptionBudget"},
+}
+
+// HelmChartEntry is the configuration of a Helm chart that is deployed together with the Bicep file of a
+// configuration entry.
+type HelmChartEntry struct {
+	// Chart is the path of the chart directory or archive in the source.
+	Chart string `yaml:"chart"`
+	// ReleaseName is the name of the release that the chart is rendered as. Defaults to the name of the Bicep file
+	// without extension.
+	ReleaseName string `yaml:"releaseName,omitempty"`
+	// ValuesFiles is the list of the paths of values files in the source. Values in later files take precedence.
+	ValuesFiles []string `yaml:"valuesFiles,omitempty"`
+	// Values are values that take precedence over the values files.
+	Values map[string]any `yaml:"values,omitempty"`
+}
+
+// HelmRenderer renders Helm charts.
+type HelmRenderer interface {
+	// Render renders the chart at chartPath as the given release, and returns its manifests in install order.
+	Render(chartPath string, releaseName string, namespace string, values map[string]any) ([]string, error)
+}
+
+// NewHelmRenderer creates a new HelmRenderer that renders charts on the client, without access to the cluster.
+func NewHelmRenderer() HelmRenderer {
+	return &HelmRendererImpl{}
+}
+
+type HelmRendererImpl struct{}
+
+// Render renders the chart at chartPath as the given release. Hooks, tests and the notes of the chart are not
+// included in the manifests.
+func (h *HelmRendererImpl) Render(chartPath string, releaseName string, namespace string, values map[string]any) ([]string, error) {
+	chart, err := loader.Load(chartPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chart: %w", err)
+	}
+
+	options := common.ReleaseOptions{
+		Name:      releaseName,
+		Namespace: namespace,
+		Revision:  1,
+		IsInstall: true,
+	}
+	renderValues, err := chartutil.ToRenderValues(chart, values, options, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute chart values: %w", err)
+	}
+
+	files, err := engine.Render(chart, renderValues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render chart: %w", err)
+	}
+
+	for name := range files {
+		if strings.HasSuffix(name, "NOTES.txt") {
+			delete(files, name)
+		}
+	}
+
+	_, manifests, err := releaseutil.SortManifests(files, nil, releaseutil.InstallOrder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse chart manifests: %w", err)
+	}
+
+	result := make([]string, 0, len(manifests))
+	for _, manifest := range manifests {
+		result = append(result, manifest.Content)
+	}
+
+	return result, nil
+}
+
+// helmInventoryEntry identifies an object applied from the Helm chart of a configuration entry.
+type helmInventoryEntry struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+// object returns an object with the identity of the entry.
+func (e helmInventoryEntry) object() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(e.APIVersion)
+	obj.SetKind(e.Kind)
+	obj.SetNamespace(e.Namespace)
+	obj.SetName(e.Name)
+	return obj
+}
+
+// readHelmInventory reads the inventory of the objects applied from the Helm chart of a DeploymentTemplate.
+func readHelmInventory(deploymentTemplate *radappiov1alpha3.DeploymentTemplate) ([]helmInventoryEntry, error) {
+	value := deploymentTemplate.Annotations[AnnotationHelmInventory]
+	if value == "" {
+		return nil, nil
+	}
+
+	inventory := []helmInventoryEntry{}
+	if err := json.Unmarshal([]byte(value), &inventory); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s annotation: %w", AnnotationHelmInventory, err)
+	}
+
+	return inventory, nil
+}
+
+// validateHelmChartEntry validates the Helm chart of a configuration entry against the files of a source.
+func (r *FluxController) validateHelmChartEntry(dir string, bicepFile ConfigEntry) error {
+	if bicepFile.Helm.Chart == "" {
+		return fmt.Errorf("helm.chart field is required for bicep file %s", bicepFile.Name)
+	}
+
+	for _, name := range append([]string{bicepFile.Helm.Chart}, bicepFile.Helm.ValuesFiles...) {
+		if _, err := r.FileSystem.Stat(path.Join(dir, name)); err != nil {
+			return fmt.Errorf("failed to find helm file %s of bicep file %s, error: %w", name, bicepFile.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// renderHelmChart renders the Helm chart of a configuration entry into the objects to apply in the namespace. Objects
+// that the chart renders in another namespace are rejected.
+func (r *FluxController) renderHelmChart(ctx context.Context, dir string, bicepFile ConfigEntry, namespace string) ([]*unstructured.Unstructured, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if r.Helm == nil {
+		return nil, fmt.Errorf("helm charts are not supported by this controller")
+	}
+
+	values := map[string]any{}
+	for _, name := range bicepFile.Helm.ValuesFiles {
+		b, err := r.FileSystem.ReadFile(path.Join(dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read values file %s, error: %w", name, err)
+		}
+
+		file := map[string]any{}
+		if err := yaml.Unmarshal(b, &file); err != nil {
+			return nil, fmt.Errorf("failed to parse values file %s, error: %w", name, err)
+		}
+		values = loader.MergeMaps(values, file)
+	}
+	values = loader.MergeMaps(values, bicepFile.Helm.Values)
+
+	releaseName := bicepFile.Helm.ReleaseName
+	if releaseName == "" {
+		releaseName = strings.TrimSuffix(bicepFile.Name, path.Ext(bicepFile.Name))
+	}
+
+	logger.Info("Rendering helm chart", "chart", bicepFile.Helm.Chart, "release", releaseName)
+	manifests, err := r.Helm.Render(path.Join(dir, bicepFile.Helm.Chart), releaseName, namespace, values)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []*unstructured.Unstructured{}
+	for _, manifest := range manifests {
+		b, err := k8syaml.YAMLToJSON([]byte(manifest))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse manifest of helm chart %s, error: %w", bicepFile.Helm.Chart, err)
+		}
+		if string(b) == "null" {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(b); err != nil {
+			return nil, fmt.Errorf("failed to parse manifest of helm chart %s, error: %w", bicepFile.Helm.Chart, err)
+		}
+
+		if kind := obj.GroupVersionKind().GroupKind(); !slices.Contains(helmSupportedKinds, kind) {
+			return nil, fmt.Errorf("helm chart %s renders %s %s, which is not supported. Supported kinds are: %s", bicepFile.Helm.Chart, obj.GetKind(), obj.GetName(), formatGroupKinds(helmSupportedKinds))
+		}
+
+		// The objects are applied with the permissions of the controller, so a chart can't place them outside the
+		// namespace of its configuration entry.
+		if obj.GetNamespace() != "" && obj.GetNamespace() != namespace {
+			return nil, fmt.Errorf("helm chart %s renders %s %s in namespace %s. Objects must be in namespace %s", bicepFile.Helm.Chart, obj.GetKind(), obj.GetName(), obj.GetNamespace(), namespace)
+		}
+		obj.SetNamespace(namespace)
+
+		objects = append(objects, obj)
+	}
+
+	return objects, nil
+}
+
+// formatGroupKinds formats kinds as a comma-separated list such as "Deployment.apps, Service".
+func formatGroupKinds(kinds []schema.GroupKind) string {
+	formatted := make([]string, len(kinds))
+	for i, kind := range kinds {
+		formatted[i] = kind.String()
+	}
+	return strings.Join(formatted, ", ")
+}
+
+// syncHelmChart applies the objects rendered from the Helm chart of a DeploymentTemplate, deletes the objects that
+// were applied from a previous revision of the chart and are no longer rendered, and returns the state of the
+// Radius-enabled Deployments of the chart.
+func (r *FluxController) syncHelmChart(ctx context.Context, deploymentTemplate *radappiov1alpha3.DeploymentTemplate, objects []*unstructured.Unstructured) (configEntryState, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	previous, err := readHelmInventory(deploymentTemplate)
+	if err != nil {
+		return "", err
+	}
+	if len(objects) == 0 && len(previous) == 0 {
+		return configEntryReady, nil
+	}
+
+	inventory := []helmInventoryEntry{}
+	deployments := []client.ObjectKey{}
+	for _, object := range objects {
+		obj := object.DeepCopy()
+		if obj.GetNamespace() != deploymentTemplate.Namespace {
+			return "", fmt.Errorf("%s %s must be in namespace %s", obj.GetKind(), obj.GetName(), deploymentTemplate.Namespace)
+		}
+
+		logger.Info("Applying helm chart object", "kind", obj.GetKind(), "name", obj.GetName(), "namespace", obj.GetNamespace())
+		err = r.Client.Apply(ctx, client.ApplyConfigurationFromUnstructured(obj), client.FieldOwner(helmFieldOwner), client.ForceOwnership)
+		if err != nil {
+			return "", fmt.Errorf("failed to apply %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+
+		inventory = append(inventory, helmInventoryEntry{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+		})
+
+		if obj.GroupVersionKind() == appsv1.SchemeGroupVersion.WithKind("Deployment") {
+			deployments = append(deployments, client.ObjectKeyFromObject(obj))
+		}
+	}
+
+	for _, entry := range previous {
+		if slices.Contains(inventory, entry) {
+			continue
+		}
+
+		logger.Info("Deleting helm chart object", "kind", entry.Kind, "name", entry.Name, "namespace", entry.Namespace)
+		if err := r.Client.Delete(ctx, entry.object()); client.IgnoreNotFound(err) != nil {
+			return "", fmt.Errorf("failed to delete %s %s: %w", entry.Kind, entry.Name, err)
+		}
+	}
+
+	if err := r.storeHelmInventory(ctx, deploymentTemplate, inventory); err != nil {
+		return "", err
+	}
+
+	state := configEntryReady
+	for _, key := range deployments {
+		deployment := appsv1.Deployment{}
+		if err := r.Client.Get(ctx, key, &deployment); k8serrors.IsNotFound(err) {
+			// The cache has not observed the applied Deployment yet.
+			state = combineConfigEntryStates(state, configEntryProgressing)
+			continue
+		} else if err != nil {
+			return "", err
+		}
+
+		state = combineConfigEntryStates(state, radiusDeploymentState(&deployment))
+	}
+
+	return state, nil
+}
+
+// deleteHelmChart deletes the objects applied from the Helm chart of a DeploymentTemplate.
+func (r *FluxController) deleteHelmChart(ctx context.Context, deploymentTemplate *radappiov1alpha3.DeploymentTemplate) error {
+	inventory, err := readHelmInventory(deploymentTemplate)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range inventory {
+		if err := r.Client.Delete(ctx, entry.object()); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed to delete %s %s: %w", entry.Kind, entry.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// storeHelmInventory records the inventory of the objects applied from the Helm chart of a DeploymentTemplate.
+func (r *FluxController) storeHelmInventory(ctx context.Context, deploymentTemplate *radappiov1alpha3.DeploymentTemplate, inventory []helmInventoryEntry) error {
+	value := ""
+	if len(inventory) > 0 {
+		b, err := json.Marshal(inventory)
+		if err != nil {
+			return err
+		}
+		value = string(b)
+	}
+
+	if deploymentTemplate.Annotations[AnnotationHelmInventory] == value {
+		return nil
+	}
+
+	patch := client.MergeFrom(deploymentTemplate.DeepCopy())
+	if value == "" {
+		delete(deploymentTemplate.Annotations, AnnotationHelmInventory)
+	} else {
+		if deploymentTemplate.Annotations == nil {
+			deploymentTemplate.Annotations = map[string]string{}
+		}
+		deploymentTemplate.Annotations[AnnotationHelmInventory] = value
+	}
+
+	return r.Client.Patch(ctx, deploymentTemplate, patch)
+}
+
+// radiusDeploymentState returns the state of a Deployment rendered from a Helm chart. Deployments that do not have
+// Radius enabled are ready, and Radius-enabled Deployments are ready once the DeploymentReconciler has deployed their
+// current configuration.
+func radiusDeploymentState(deployment *appsv1.Deployment) configEntryState {
+	annotations, err := readAnnotations(deployment)
+	if err != nil {
+		return configEntryDeploymentFailed
+	}
+
+	if !annotations.isRadiusEnabled() {
+		return configEntryReady
+	}
+
+	if annotations.Status != nil && annotations.Status.Phrase == deploymentPhraseFailed {
+		return configEntryDeploymentFailed
+	}
+
+	if annotations.IsUpToDate() && annotations.Status.Phrase == deploymentPhraseReady {
+		return configEntryReady
+	}
+
+	return configEntryProgressing
+}
+
+// combineConfigEntryStates returns the combined state of the parts of a configuration entry: failed if any part
+// failed, progressing if any part is progressing, and ready otherwise.
+func combineConfigEntryStates(a configEntryState, b configEntryState) configEntryState {
+	for _, state := range []configEntryState{configEntryBuildFailed, configEntryDeploymentFailed, configEntryWaiting, configEntryProgressing} {
+		if a == state || b == state {
+			return state
+		}
+	}
+
+	return configEntryReady
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/filesystem"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func Test_FluxController_renderHelmChart(t *testing.T) {
+	dir := "testdata/flux-helm"
+	r := &FluxController{FileSystem: filesystem.NewOSFS(), Helm: NewHelmRenderer()}
+
+	config, err := r.parseAndValidateRadiusGitOpsConfigFromFile(dir, radiusConfigFileName)
+	require.NoError(t, err)
+	require.Len(t, config.Config, 1)
+
+	objects, err := r.renderHelmChart(t.Context(), dir, config.Config[0], "flux-helm")
+	require.NoError(t, err)
+
+	// The objects are in install order, and the notes and helpers of the chart are not rendered as objects.
+	kinds := []string{}
+	for _, object := range objects {
+		kinds = append(kinds, object.GetKind())
+	}
+	require.Equal(t, []string{"PodDisruptionBudget", "ConfigMap", "Service", "Deployment", "HorizontalPodAutoscaler", "CronJob", "Ingress"}, kinds)
+
+	// Objects without a namespace are placed in the namespace of the configuration entry.
+	for _, object := range objects {
+		require.Equal(t, "flux-helm", object.GetNamespace())
+	}
+
+	deployment := objects[3]
+	require.Equal(t, "flux-helm-demo", deployment.GetName())
+	require.Equal(t, "true", deployment.GetAnnotations()[AnnotationRadiusEnabled])
+
+	// The inline values take precedence over the values files, which take precedence over the chart values.
+	replicas, _, err := unstructured.NestedInt64(deployment.Object, "spec", "replicas")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), replicas)
+
+	containers, _, err := unstructured.NestedSlice(deployment.Object, "spec", "template", "spec", "containers")
+	require.NoError(t, err)
+	require.Equal(t, "ghcr.io/radius-project/samples/demo:prod", containers[0].(map[string]any)["image"])
+}
+
+func Test_FluxController_renderHelmChart_UnsupportedKind(t *testing.T) {
+	r := &FluxController{FileSystem: filesystem.NewOSFS(), Helm: NewHelmRenderer()}
+
+	_, err := r.renderHelmChart(t.Context(), "testdata/flux-helm", ConfigEntry{Name: "flux-helm.bicep", Helm: &HelmChartEntry{Chart: "unsupported-chart"}}, "flux-helm")
+	require.ErrorContains(t, err, "helm chart unsupported-chart renders Role flux-helm, which is not supported. Supported kinds are: ConfigMap, Secret, Service")
+}
+
+// helmRendererFunc is a HelmRenderer that renders the manifests returned by a function.
+type helmRendererFunc func(chartPath string, releaseName string, namespace string, values map[string]any) ([]string, error)
+
+func (f helmRendererFunc) Render(chartPath string, releaseName string, namespace string, values map[string]any) ([]string, error) {
+	return f(chartPath, releaseName, namespace, values)
+}
+
+func Test_FluxController_renderHelmChart_Namespace(t *testing.T) {
+	render := func(manifest string) ([]*unstructured.Unstructured, error) {
+		r := &FluxController{FileSystem: filesystem.NewOSFS(), Helm: helmRendererFunc(func(string, string, string, map[string]any) ([]string, error) {
+			return []string{manifest}, nil
+		})}
+		return r.renderHelmChart(t.Context(), "testdata/flux-helm", ConfigEntry{Name: "flux-helm.bicep", Helm: &HelmChartEntry{Chart: "chart"}}, "flux-helm")
+	}
+
+	objects, err := render("apiVersion: v1\nkind: Secret\nmetadata:\n  name: credentials\n  namespace: flux-helm\n")
+	require.NoError(t, err)
+	require.Equal(t, "flux-helm", objects[0].GetNamespace())
+
+	_, err = render("apiVersion: v1\nkind: Secret\nmetadata:\n  name: credentials\n  namespace: kube-system\n")
+	require.ErrorContains(t, err, "helm chart chart renders Secret credentials in namespace kube-system. Objects must be in namespace flux-helm")
+}
+
+func Test_FluxController_renderHelmChart_NoRenderer(t *testing.T) {
+	r := &FluxController{FileSystem: filesystem.NewOSFS()}
+
+	_, err := r.renderHelmChart(t.Context(), "testdata/flux-helm", ConfigEntry{Name: "flux-helm.bicep", Helm: &HelmChartEntry{Chart: "chart"}}, "flux-helm")
+	require.ErrorContains(t, err, "helm charts are not supported")
+}
+
+func Test_FluxController_validateHelmChartEntry(t *testing.T) {
+	r := &FluxController{FileSystem: filesystem.NewOSFS()}
+
+	err := r.validateHelmChartEntry("testdata/flux-helm", ConfigEntry{Name: "flux-helm.bicep", Helm: &HelmChartEntry{}})
+	require.ErrorContains(t, err, "helm.chart field is required")
+
+	err = r.validateHelmChartEntry("testdata/flux-helm", ConfigEntry{Name: "flux-helm.bicep", Helm: &HelmChartEntry{Chart: "chart", ValuesFiles: []string{"missing.yaml"}}})
+	require.ErrorContains(t, err, "failed to find helm file missing.yaml")
+}
+
+func Test_radiusDeploymentState(t *testing.T) {
+	makeDeployment := func(annotations map[string]string) *appsv1.Deployment {
+		return &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}}
+	}
+
+	configuration := deploymentConfiguration{Connections: map[string]string{}}
+	hash, err := configuration.computeHash()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        configEntryState
+	}{
+		{
+			name: "not radius enabled",
+			want: configEntryReady,
+		},
+		{
+			name:        "not deployed yet",
+			annotations: map[string]string{AnnotationRadiusEnabled: "true"},
+			want:        configEntryProgressing,
+		},
+		{
+			name: "ready",
+			annotations: map[string]string{
+				AnnotationRadiusEnabled:           "true",
+				AnnotationRadiusConfigurationHash: hash,
+				AnnotationRadiusStatus:            `{"phrase":"Ready"}`,
+			},
+			want: configEntryReady,
+		},
+		{
+			name: "configuration changed",
+			annotations: map[string]string{
+				AnnotationRadiusEnabled:           "true",
+				AnnotationRadiusConfigurationHash: "stale",
+				AnnotationRadiusStatus:            `{"phrase":"Ready"}`,
+			},
+			want: configEntryProgressing,
+		},
+		{
+			name: "failed",
+			annotations: map[string]string{
+				AnnotationRadiusEnabled: "true",
+				AnnotationRadiusStatus:  `{"phrase":"Failed"}`,
+			},
+			want: configEntryDeploymentFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, radiusDeploymentState(makeDeployment(tt.annotations)))
+		})
+	}
+}
+
+func Test_combineConfigEntryStates(t *testing.T) {
+	require.Equal(t, configEntryReady, combineConfigEntryStates(configEntryReady, configEntryReady))
+	require.Equal(t, configEntryProgressing, combineConfigEntryStates(configEntryReady, configEntryProgressing))
+	require.Equal(t, configEntryDeploymentFailed, combineConfigEntryStates(configEntryProgressing, configEntryDeploymentFailed))
+}
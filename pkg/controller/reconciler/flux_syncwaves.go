@@ -0,0 +1,218 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+)
+
+// configEntryState is the state of the DeploymentTemplate of a configuration entry of a Flux source.
+type configEntryState string
+
+const (
+	// configEntryReady indicates that the DeploymentTemplate is deployed at its latest generation.
+	configEntryReady configEntryState = "Ready"
+
+	// configEntryProgressing indicates that the DeploymentTemplate is being deployed.
+	configEntryProgressing configEntryState = "Progressing"
+
+	// configEntryWaiting indicates that the DeploymentTemplate is held back until its upstream entries are ready.
+	configEntryWaiting configEntryState = "Waiting"
+
+	// configEntryBuildFailed indicates that the Bicep file of the entry failed to build.
+	configEntryBuildFailed configEntryState = "BuildFailed"
+
+	// configEntryDeploymentFailed indicates that the deployment of the DeploymentTemplate failed.
+	configEntryDeploymentFailed configEntryState = "DeploymentFailed"
+)
+
+const (
+	// ReasonRadiusSucceeded is the reason of the RadiusReady condition when all DeploymentTemplates are ready.
+	ReasonRadiusSucceeded = "Succeeded"
+
+	// ReasonRadiusProgressing is the reason of the RadiusReady condition when DeploymentTemplates are being deployed
+	// or are waiting for their upstream entries.
+	ReasonRadiusProgressing = "Progressing"
+
+	// ReasonRadiusBuildFailed is the reason of the RadiusReady condition when a Bicep file failed to build.
+	ReasonRadiusBuildFailed = "BuildFailed"
+
+	// ReasonRadiusDeploymentFailed is the reason of the RadiusReady condition when a DeploymentTemplate failed to
+	// deploy.
+	ReasonRadiusDeploymentFailed = "DeploymentFailed"
+)
+
+// validateConfigDependencies validates the dependsOn and syncWave fields of the entries of a configuration.
+func validateConfigDependencies(entries []ConfigEntry) error {
+	byName := map[string]ConfigEntry{}
+	for _, entry := range entries {
+		if _, ok := byName[entry.Name]; ok {
+			return fmt.Errorf("bicep file %s is specified more than once", entry.Name)
+		}
+		byName[entry.Name] = entry
+	}
+
+	for _, entry := range entries {
+		for _, dependency := range entry.DependsOn {
+			upstream, ok := byName[dependency]
+			if !ok {
+				return fmt.Errorf("bicep file %s depends on %s, which is not specified in the configuration", entry.Name, dependency)
+			}
+			if dependency == entry.Name {
+				return fmt.Errorf("bicep file %s cannot depend on itself", entry.Name)
+			}
+			if upstream.SyncWave > entry.SyncWave {
+				return fmt.Errorf("bicep file %s depends on %s, which is in a later sync wave", entry.Name, dependency)
+			}
+		}
+	}
+
+	// Detect cycles with a depth-first search. An entry is visiting while its dependencies are being visited.
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	marks := map[string]int{}
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch marks[name] {
+		case visiting:
+			return fmt.Errorf("bicep files have a dependency cycle: %s", strings.Join(append(path, name), " -> "))
+		case visited:
+			return nil
+		}
+
+		marks[name] = visiting
+		for _, dependency := range byName[name].DependsOn {
+			if err := visit(dependency, append(path, name)); err != nil {
+				return err
+			}
+		}
+		marks[name] = visited
+		return nil
+	}
+
+	for _, entry := range entries {
+		if err := visit(entry.Name, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// orderConfigEntries returns the entries of a configuration in the order they are deployed: by sync wave, and then
+// after their dependencies. Entries that are not ordered by these rules keep the order of the configuration.
+// The entries must have been validated by validateConfigDependencies.
+func orderConfigEntries(entries []ConfigEntry) []ConfigEntry {
+	remaining := slices.Clone(entries)
+	slices.SortStableFunc(remaining, func(a, b ConfigEntry) int { return cmp.Compare(a.SyncWave, b.SyncWave) })
+
+	ordered := make([]ConfigEntry, 0, len(entries))
+	placed := map[string]bool{}
+	for len(remaining) > 0 {
+		// Dependencies are never in a later wave and have no cycles, so the lowest wave always has an entry whose
+		// dependencies are placed, and it comes before the entries of the later waves.
+		i := slices.IndexFunc(remaining, func(entry ConfigEntry) bool {
+			return !slices.ContainsFunc(entry.DependsOn, func(dependency string) bool { return !placed[dependency] })
+		})
+		if i < 0 {
+			return append(ordered, remaining...)
+		}
+
+		ordered = append(ordered, remaining[i])
+		placed[remaining[i].Name] = true
+		remaining = slices.Delete(remaining, i, i+1)
+	}
+
+	return ordered
+}
+
+// upstreamEntriesNotReady returns the names of the entries that must be ready before entry is deployed and are not:
+// the entries of the earlier sync waves and the dependencies of the entry.
+func upstreamEntriesNotReady(entry ConfigEntry, entries []ConfigEntry, states map[string]configEntryState) []string {
+	names := []string{}
+	for _, upstream := range entries {
+		if upstream.SyncWave >= entry.SyncWave && !slices.Contains(entry.DependsOn, upstream.Name) {
+			continue
+		}
+		if states[upstream.Name] != configEntryReady {
+			names = append(names, upstream.Name)
+		}
+	}
+
+	return names
+}
+
+// deploymentTemplateState returns the state of the configuration entry of a DeploymentTemplate.
+func deploymentTemplateState(deploymentTemplate *radappiov1alpha3.DeploymentTemplate) configEntryState {
+	// The status only describes the latest spec once the generation has been observed.
+	if deploymentTemplate.Status.ObservedGeneration != deploymentTemplate.Generation {
+		return configEntryProgressing
+	}
+
+	switch deploymentTemplate.Status.Phrase {
+	case radappiov1alpha3.DeploymentTemplatePhraseReady, radappiov1alpha3.DeploymentTemplatePhraseReadyPendingCleanup:
+		return configEntryReady
+	case radappiov1alpha3.DeploymentTemplatePhraseFailed:
+		return configEntryDeploymentFailed
+	default:
+		return configEntryProgressing
+	}
+}
+
+// radiusReadyCondition returns the RadiusReady condition of a Flux source, which aggregates the states of the
+// entries of its configuration.
+func radiusReadyCondition(entries []ConfigEntry, states map[string]configEntryState, generation int64) metav1.Condition {
+	byState := map[configEntryState][]string{}
+	for _, entry := range entries {
+		byState[states[entry.Name]] = append(byState[states[entry.Name]], entry.Name)
+	}
+
+	condition := metav1.Condition{
+		Type:               ConditionRadiusReady,
+		ObservedGeneration: generation,
+	}
+
+	switch {
+	case len(byState[configEntryBuildFailed]) > 0:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonRadiusBuildFailed
+		condition.Message = fmt.Sprintf("Failed to build %s.", strings.Join(byState[configEntryBuildFailed], ", "))
+	case len(byState[configEntryDeploymentFailed]) > 0:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = ReasonRadiusDeploymentFailed
+		condition.Message = fmt.Sprintf("Failed to deploy %s.", strings.Join(byState[configEntryDeploymentFailed], ", "))
+	case len(byState[configEntryProgressing])+len(byState[configEntryWaiting]) > 0:
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = ReasonRadiusProgressing
+		condition.Message = fmt.Sprintf("Deploying %s.", strings.Join(append(byState[configEntryProgressing], byState[configEntryWaiting]...), ", "))
+	default:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = ReasonRadiusSucceeded
+		condition.Message = fmt.Sprintf("All %d DeploymentTemplates are ready.", len(entries))
+	}
+
+	return condition
+}
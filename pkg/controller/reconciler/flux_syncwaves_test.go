@@ -0,0 +1,207 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+)
+
+func Test_validateConfigDependencies(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []ConfigEntry
+		err     string
+	}{
+		{
+			name: "valid",
+			entries: []ConfigEntry{
+				{Name: "infra.bicep", SyncWave: -1},
+				{Name: "db.bicep"},
+				{Name: "app.bicep", DependsOn: []string{"db.bicep", "infra.bicep"}},
+			},
+		},
+		{
+			name:    "unknown dependency",
+			entries: []ConfigEntry{{Name: "app.bicep", DependsOn: []string{"db.bicep"}}},
+			err:     "bicep file app.bicep depends on db.bicep, which is not specified in the configuration",
+		},
+		{
+			name:    "self dependency",
+			entries: []ConfigEntry{{Name: "app.bicep", DependsOn: []string{"app.bicep"}}},
+			err:     "bicep file app.bicep cannot depend on itself",
+		},
+		{
+			name: "dependency in a later wave",
+			entries: []ConfigEntry{
+				{Name: "db.bicep", SyncWave: 1},
+				{Name: "app.bicep", DependsOn: []string{"db.bicep"}},
+			},
+			err: "bicep file app.bicep depends on db.bicep, which is in a later sync wave",
+		},
+		{
+			name: "cycle",
+			entries: []ConfigEntry{
+				{Name: "a.bicep", DependsOn: []string{"b.bicep"}},
+				{Name: "b.bicep", DependsOn: []string{"c.bicep"}},
+				{Name: "c.bicep", DependsOn: []string{"a.bicep"}},
+			},
+			err: "bicep files have a dependency cycle: a.bicep -> b.bicep -> c.bicep -> a.bicep",
+		},
+		{
+			name:    "duplicate",
+			entries: []ConfigEntry{{Name: "app.bicep"}, {Name: "app.bicep"}},
+			err:     "bicep file app.bicep is specified more than once",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateConfigDependencies(tt.entries)
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func Test_orderConfigEntries(t *testing.T) {
+	entries := []ConfigEntry{
+		{Name: "app.bicep", SyncWave: 1},
+		{Name: "frontend.bicep", DependsOn: []string{"backend.bicep"}},
+		{Name: "backend.bicep", DependsOn: []string{"db.bicep"}},
+		{Name: "db.bicep"},
+		{Name: "infra.bicep", SyncWave: -1},
+	}
+
+	names := []string{}
+	for _, entry := range orderConfigEntries(entries) {
+		names = append(names, entry.Name)
+	}
+
+	require.Equal(t, []string{"infra.bicep", "db.bicep", "backend.bicep", "frontend.bicep", "app.bicep"}, names)
+}
+
+func Test_upstreamEntriesNotReady(t *testing.T) {
+	entries := []ConfigEntry{
+		{Name: "infra.bicep", SyncWave: -1},
+		{Name: "db.bicep"},
+		{Name: "cache.bicep"},
+		{Name: "app.bicep", DependsOn: []string{"db.bicep"}},
+	}
+	states := map[string]configEntryState{
+		"infra.bicep": configEntryReady,
+		"db.bicep":    configEntryProgressing,
+		"cache.bicep": configEntryProgressing,
+	}
+
+	require.Empty(t, upstreamEntriesNotReady(entries[1], entries, states))
+	require.Equal(t, []string{"db.bicep"}, upstreamEntriesNotReady(entries[3], entries, states))
+
+	states["infra.bicep"] = configEntryDeploymentFailed
+	require.Equal(t, []string{"infra.bicep"}, upstreamEntriesNotReady(entries[2], entries, states))
+}
+
+func Test_deploymentTemplateState(t *testing.T) {
+	tests := []struct {
+		name               string
+		generation         int64
+		observedGeneration int64
+		phrase             radappiov1alpha3.DeploymentTemplatePhrase
+		expected           configEntryState
+	}{
+		{name: "ready", generation: 2, observedGeneration: 2, phrase: radappiov1alpha3.DeploymentTemplatePhraseReady, expected: configEntryReady},
+		{name: "ready pending cleanup", generation: 2, observedGeneration: 2, phrase: radappiov1alpha3.DeploymentTemplatePhraseReadyPendingCleanup, expected: configEntryReady},
+		{name: "ready previous generation", generation: 3, observedGeneration: 2, phrase: radappiov1alpha3.DeploymentTemplatePhraseReady, expected: configEntryProgressing},
+		{name: "updating", generation: 2, observedGeneration: 2, phrase: radappiov1alpha3.DeploymentTemplatePhraseUpdating, expected: configEntryProgressing},
+		{name: "failed", generation: 2, observedGeneration: 2, phrase: radappiov1alpha3.DeploymentTemplatePhraseFailed, expected: configEntryDeploymentFailed},
+		{name: "new", generation: 1, expected: configEntryProgressing},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deploymentTemplate := &radappiov1alpha3.DeploymentTemplate{
+				ObjectMeta: metav1.ObjectMeta{Generation: tt.generation},
+				Status: radappiov1alpha3.DeploymentTemplateStatus{
+					ObservedGeneration: tt.observedGeneration,
+					Phrase:             tt.phrase,
+				},
+			}
+			require.Equal(t, tt.expected, deploymentTemplateState(deploymentTemplate))
+		})
+	}
+}
+
+func Test_radiusReadyCondition(t *testing.T) {
+	entries := []ConfigEntry{{Name: "db.bicep"}, {Name: "app.bicep"}}
+
+	tests := []struct {
+		name    string
+		states  map[string]configEntryState
+		status  metav1.ConditionStatus
+		reason  string
+		message string
+	}{
+		{
+			name:    "ready",
+			states:  map[string]configEntryState{"db.bicep": configEntryReady, "app.bicep": configEntryReady},
+			status:  metav1.ConditionTrue,
+			reason:  ReasonRadiusSucceeded,
+			message: "All 2 DeploymentTemplates are ready.",
+		},
+		{
+			name:    "progressing",
+			states:  map[string]configEntryState{"db.bicep": configEntryProgressing, "app.bicep": configEntryWaiting},
+			status:  metav1.ConditionUnknown,
+			reason:  ReasonRadiusProgressing,
+			message: "Deploying db.bicep, app.bicep.",
+		},
+		{
+			name:    "deployment failed",
+			states:  map[string]configEntryState{"db.bicep": configEntryDeploymentFailed, "app.bicep": configEntryWaiting},
+			status:  metav1.ConditionFalse,
+			reason:  ReasonRadiusDeploymentFailed,
+			message: "Failed to deploy db.bicep.",
+		},
+		{
+			name:    "build failed",
+			states:  map[string]configEntryState{"db.bicep": configEntryDeploymentFailed, "app.bicep": configEntryBuildFailed},
+			status:  metav1.ConditionFalse,
+			reason:  ReasonRadiusBuildFailed,
+			message: "Failed to build app.bicep.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			condition := radiusReadyCondition(entries, tt.states, 3)
+			require.Equal(t, metav1.Condition{
+				Type:               ConditionRadiusReady,
+				Status:             tt.status,
+				ObservedGeneration: 3,
+				Reason:             tt.reason,
+				Message:            tt.message,
+			}, condition)
+		})
+	}
+}
@@ -39,6 +39,7 @@ func NewMockRadiusClient() *mockRadiusClient {
 		applications: map[string]corerpv20231001preview.ApplicationResource{},
 		containers:   map[string]corerpv20231001preview.ContainerResource{},
 		environments: map[string]corerpv20231001preview.EnvironmentResource{},
+		recipes:      map[string]corerpv20231001preview.RecipeGetMetadataResponse{},
 		groups:       map[string]ucpv20231001preview.ResourceGroupResource{},
 		resources:    map[string]generated.GenericResource{},
 		operations:   map[string]*sdkclients.OperationState{},
@@ -53,6 +54,7 @@ type mockRadiusClient struct {
 	applications map[string]corerpv20231001preview.ApplicationResource
 	containers   map[string]corerpv20231001preview.ContainerResource
 	environments map[string]corerpv20231001preview.EnvironmentResource
+	recipes      map[string]corerpv20231001preview.RecipeGetMetadataResponse
 	groups       map[string]ucpv20231001preview.ResourceGroupResource
 	resources    map[string]generated.GenericResource
 	operations   map[string]*sdkclients.OperationState
@@ -268,6 +270,24 @@ func (ec *mockEnvironmentClient) List(ctx context.Context, options *corerpv20231
 	return corerpv20231001preview.EnvironmentsClientListByScopeResponse{EnvironmentResourceListResult: corerpv20231001preview.EnvironmentResourceListResult{Value: environments}}, nil
 }
 
+func (ec *mockEnvironmentClient) GetMetadata(ctx context.Context, environmentName string, body corerpv20231001preview.RecipeGetMetadata, options *corerpv20231001preview.EnvironmentsClientGetMetadataOptions) (corerpv20231001preview.EnvironmentsClientGetMetadataResponse, error) {
+	ec.mock.lock.Lock()
+	defer ec.mock.lock.Unlock()
+
+	recipe, ok := ec.mock.recipes[mockRecipeKey(ec.scope+"/providers/Applications.Core/environments/"+environmentName, *body.ResourceType, *body.Name)]
+	if !ok {
+		err := &azcore.ResponseError{ErrorCode: v1.CodeNotFound, StatusCode: http.StatusNotFound}
+		return corerpv20231001preview.EnvironmentsClientGetMetadataResponse{}, err
+	}
+
+	return corerpv20231001preview.EnvironmentsClientGetMetadataResponse{RecipeGetMetadataResponse: recipe}, nil
+}
+
+// mockRecipeKey returns the key of the metadata of a recipe registered in an environment.
+func mockRecipeKey(environmentID string, resourceType string, recipeName string) string {
+	return strings.ToLower(environmentID + "|" + resourceType + "|" + recipeName)
+}
+
 var _ ResourceGroupClient = (*mockResourceGroupClient)(nil)
 
 type mockResourceGroupClient struct {
@@ -52,6 +52,7 @@ type ContainerClient interface {
 
 type EnvironmentClient interface {
 	List(ctx context.Context, options *corerpv20231001preview.EnvironmentsClientListByScopeOptions) (corerpv20231001preview.EnvironmentsClientListByScopeResponse, error)
+	GetMetadata(ctx context.Context, environmentName string, body corerpv20231001preview.RecipeGetMetadata, options *corerpv20231001preview.EnvironmentsClientGetMetadataOptions) (corerpv20231001preview.EnvironmentsClientGetMetadataResponse, error)
 }
 
 type ResourceGroupClient interface {
@@ -191,6 +192,10 @@ func (ec *EnvironmentClientImpl) List(ctx context.Context, options *corerpv20231
 	return result, nil
 }
 
+func (ec *EnvironmentClientImpl) GetMetadata(ctx context.Context, environmentName string, body corerpv20231001preview.RecipeGetMetadata, options *corerpv20231001preview.EnvironmentsClientGetMetadataOptions) (corerpv20231001preview.EnvironmentsClientGetMetadataResponse, error) {
+	return ec.inner.GetMetadata(ctx, ec.scope, environmentName, body, options)
+}
+
 type ResourceGroupClientImpl struct {
 	inner *ucpv20231001preview.ResourceGroupsClient
 	scope string
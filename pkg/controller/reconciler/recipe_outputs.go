@@ -0,0 +1,112 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// recipeOutputKeyFuncs are the functions available to the OutputKeyTemplate of a Recipe.
+var recipeOutputKeyFuncs = template.FuncMap{
+	"upper":   strings.ToUpper,
+	"lower":   strings.ToLower,
+	"replace": func(old, new, s string) string { return strings.ReplaceAll(s, old, new) },
+}
+
+// recipeOutputKeyData is the data the OutputKeyTemplate of a Recipe is executed with.
+type recipeOutputKeyData struct {
+	Name   string
+	Recipe string
+	Type   string
+}
+
+// parseRecipeOutputKeyTemplate parses the OutputKeyTemplate of a Recipe. It returns nil if the template is unset.
+func parseRecipeOutputKeyTemplate(recipe *radappiov1alpha3.Recipe) (*template.Template, error) {
+	if recipe.Spec.OutputKeyTemplate == "" {
+		return nil, nil
+	}
+
+	return template.New("outputKeyTemplate").Funcs(recipeOutputKeyFuncs).Option("missingkey=error").Parse(recipe.Spec.OutputKeyTemplate)
+}
+
+// recipeOutputKeys computes the keys under which the outputs of a Recipe are written to its secret or config map,
+// by output name. It returns an error if a key is not a valid secret or config map key, or if two outputs have the
+// same key.
+func recipeOutputKeys(recipe *radappiov1alpha3.Recipe, names []string) (map[string]string, error) {
+	tmpl, err := parseRecipeOutputKeyTemplate(recipe)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse output key template: %w", err)
+	}
+
+	keys := map[string]string{}
+	outputs := map[string]string{}
+	for _, name := range names {
+		key := name
+		if tmpl != nil {
+			buf := bytes.Buffer{}
+			err := tmpl.Execute(&buf, recipeOutputKeyData{Name: name, Recipe: recipe.Name, Type: recipe.Spec.Type})
+			if err != nil {
+				return nil, fmt.Errorf("failed to execute output key template for output %s: %w", name, err)
+			}
+			key = buf.String()
+		}
+		key = recipe.Spec.OutputKeyPrefix + key
+
+		if errs := validation.IsConfigMapKey(key); len(errs) > 0 {
+			return nil, fmt.Errorf("key %q of output %s is invalid: %s", key, name, strings.Join(errs, ", "))
+		}
+		if other, ok := outputs[key]; ok {
+			return nil, fmt.Errorf("outputs %s and %s have the same key %q", other, name, key)
+		}
+
+		outputs[key] = name
+		keys[name] = key
+	}
+
+	return keys, nil
+}
+
+// recipeOutputResources returns the IDs of the resources deployed by the recipe of a resource.
+func recipeOutputResources(resource generated.GenericResource) []string {
+	status, ok := resource.Properties["status"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	outputResources, ok := status["outputResources"].([]any)
+	if !ok {
+		return nil
+	}
+
+	ids := []string{}
+	for _, outputResource := range outputResources {
+		if outputResource, ok := outputResource.(map[string]any); ok {
+			if id, ok := outputResource["id"].(string); ok && id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	return ids
+}
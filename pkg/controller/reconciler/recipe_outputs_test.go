@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_recipeOutputKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefix   string
+		template string
+		expected map[string]string
+		err      string
+	}{
+		{
+			name:     "default",
+			expected: map[string]string{"host": "host", "port": "port"},
+		},
+		{
+			name:     "prefix",
+			prefix:   "REDIS_",
+			expected: map[string]string{"host": "REDIS_host", "port": "REDIS_port"},
+		},
+		{
+			name:     "template and prefix",
+			prefix:   "APP_",
+			template: `{{ .Recipe | upper | replace "-" "_" }}_{{ .Name | upper }}`,
+			expected: map[string]string{"host": "APP_MY_CACHE_HOST", "port": "APP_MY_CACHE_PORT"},
+		},
+		{
+			name:     "invalid key",
+			template: "{{ .Name }}/{{ .Type }}",
+			err:      `key "host/Applications.Datastores/redisCaches" of output host is invalid`,
+		},
+		{
+			name:     "duplicate key",
+			template: "{{ .Recipe }}",
+			err:      `outputs host and port have the same key "my-cache"`,
+		},
+		{
+			name:     "unknown field",
+			template: "{{ .Namespace }}",
+			err:      "failed to execute output key template for output host",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipe := &radappiov1alpha3.Recipe{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-cache", Namespace: "default"},
+				Spec: radappiov1alpha3.RecipeSpec{
+					Type:              "Applications.Datastores/redisCaches",
+					OutputKeyPrefix:   tt.prefix,
+					OutputKeyTemplate: tt.template,
+				},
+			}
+
+			keys, err := recipeOutputKeys(recipe, []string{"host", "port"})
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, keys)
+		})
+	}
+}
+
+func Test_recipeOutputResources(t *testing.T) {
+	resource := generated.GenericResource{
+		Properties: map[string]any{
+			"status": map[string]any{
+				"outputResources": []any{
+					map[string]any{"id": "/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis"},
+					map[string]any{"id": "/planes/kubernetes/local/namespaces/default/providers/core/Service/redis"},
+					map[string]any{"localId": "no-id"},
+				},
+			},
+		},
+	}
+
+	require.Equal(t, []string{
+		"/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis",
+		"/planes/kubernetes/local/namespaces/default/providers/core/Service/redis",
+	}, recipeOutputResources(resource))
+
+	require.Nil(t, recipeOutputResources(generated.GenericResource{Properties: map[string]any{}}))
+}
@@ -18,7 +18,9 @@ package reconciler
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"maps"
 	"strings"
 	"time"
 
@@ -33,6 +35,7 @@ import (
 	"github.com/radius-project/radius/pkg/cli/clients"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/radius-project/radius/pkg/hashutil"
 	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	corev1 "k8s.io/api/core/v1"
@@ -53,6 +56,13 @@ type RecipeReconciler struct {
 	// Radius is the Radius client.
 	Radius RadiusClient
 
+	// Router selects the UCP endpoint of a namespace. Namespaces that match no route use the default clients.
+	Router *UCPRouter
+
+	// ClusterName identifies the cluster that the controller runs in. When set, it is added as a tag to the
+	// deployed Radius resources.
+	ClusterName string
+
 	// DelayInterval is the amount of time to wait between operations.
 	DelayInterval time.Duration
 }
@@ -121,7 +131,7 @@ func (r *RecipeReconciler) reconcileOperation(ctx context.Context, recipe *radap
 	//
 	// The only difference between these two codepaths is how they handle success.
 	if recipe.Status.Operation.OperationKind == radappiov1alpha3.OperationKindPut {
-		poller, err := r.Radius.Resources(recipe.Status.Scope, recipe.Spec.Type).ContinueCreateOperation(ctx, recipe.Status.Operation.ResumeToken)
+		poller, err := r.radius(recipe.Namespace).Resources(recipe.Status.Scope, recipe.Spec.Type).ContinueCreateOperation(ctx, recipe.Status.Operation.ResumeToken)
 		if err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to continue PUT operation: %w", err)
 		}
@@ -144,6 +154,7 @@ func (r *RecipeReconciler) reconcileOperation(ctx context.Context, recipe *radap
 
 			recipe.Status.Operation = nil
 			recipe.Status.Phrase = radappiov1alpha3.PhraseFailed
+			recipe.Status.StatusHash = ""
 
 			err = r.Client.Status().Update(ctx, recipe)
 			if err != nil {
@@ -161,7 +172,7 @@ func (r *RecipeReconciler) reconcileOperation(ctx context.Context, recipe *radap
 		return ctrl.Result{}, nil
 
 	} else if recipe.Status.Operation.OperationKind == radappiov1alpha3.OperationKindDelete {
-		poller, err := r.Radius.Resources(recipe.Status.Scope, recipe.Spec.Type).ContinueDeleteOperation(ctx, recipe.Status.Operation.ResumeToken)
+		poller, err := r.radius(recipe.Namespace).Resources(recipe.Status.Scope, recipe.Spec.Type).ContinueDeleteOperation(ctx, recipe.Status.Operation.ResumeToken)
 		if err != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to continue DELETE operation: %w", err)
 		}
@@ -233,7 +244,7 @@ func (r *RecipeReconciler) reconcileUpdate(ctx context.Context, recipe *radappio
 	// fully processed any status changes until the async operation completes.
 	recipe.Status.ObservedGeneration = recipe.Generation
 
-	environmentName := "default"
+	environmentName := defaultEnvironmentName
 	if recipe.Spec.Environment != "" {
 		environmentName = recipe.Spec.Environment
 	}
@@ -243,7 +254,7 @@ func (r *RecipeReconciler) reconcileUpdate(ctx context.Context, recipe *radappio
 		applicationName = recipe.Spec.Application
 	}
 
-	resourceGroupID, environmentID, applicationID, err := resolveDependencies(ctx, r.Radius, "/planes/radius/local", environmentName, applicationName)
+	resourceGroupID, environmentID, applicationID, err := resolveDependencies(ctx, r.radius(recipe.Namespace), "/planes/radius/local", environmentName, applicationName, r.Router.resourceGroup(recipe.Namespace), clusterTags(r.ClusterName))
 	if err != nil {
 		r.EventRecorder.Event(recipe, corev1.EventTypeWarning, "DependencyError", err.Error())
 		logger.Error(err, "Unable to resolve dependencies.")
@@ -294,9 +305,10 @@ func (r *RecipeReconciler) reconcileUpdate(ctx context.Context, recipe *radappio
 	// If we get here then it means we can process the result of the operation.
 	logger.Info("Resource is in desired state.", "resourceId", recipe.Status.Resource)
 
-	err = r.updateSecret(ctx, recipe)
+	err = r.updateOutputs(ctx, recipe)
 	if err != nil {
-		return ctrl.Result{}, fmt.Errorf("failed to process secret %s: %w", recipe.Spec.SecretName, err)
+		r.EventRecorder.Event(recipe, corev1.EventTypeWarning, "OutputError", err.Error())
+		return ctrl.Result{}, fmt.Errorf("failed to process outputs: %w", err)
 	}
 
 	recipe.Status.Phrase = radappiov1alpha3.PhraseReady
@@ -347,6 +359,13 @@ func (r *RecipeReconciler) reconcileDelete(ctx context.Context, recipe *radappio
 		return ctrl.Result{}, fmt.Errorf("failed to process secret %s: %w", recipe.Spec.SecretName, err)
 	}
 
+	err = r.deleteConfigMap(ctx, recipe)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to process config map %s: %w", recipe.Spec.ConfigMapName, err)
+	}
+
+	recipe.Status.Outputs = nil
+
 	// At this point we've cleaned up everything. We can remove the finalizer which will allow deletion of the
 	// recipe.
 	if controllerutil.RemoveFinalizer(recipe, RecipeFinalizer) {
@@ -378,7 +397,7 @@ func (r *RecipeReconciler) startPutOrDeleteOperationIfNeeded(ctx context.Context
 		logger.Info("Resource is already created but is out-of-date")
 
 		logger.Info("Starting DELETE operation.")
-		poller, err := deleteResource(ctx, r.Radius, recipe.Status.Resource)
+		poller, err := deleteResource(ctx, r.radius(recipe.Namespace), recipe.Status.Resource)
 		if err != nil {
 			return nil, nil, err
 		} else if poller != nil {
@@ -389,29 +408,98 @@ func (r *RecipeReconciler) startPutOrDeleteOperationIfNeeded(ctx context.Context
 		recipe.Status.Resource = ""
 	}
 
+	properties, err := recipeResourceProperties(recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hash, err := computeRecipeHash(properties)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to compute hash: %w", err)
+	}
+
+	// Recipes created before the hash was tracked could not set a recipe name or parameters, so their
+	// resource is up-to-date.
+	if recipe.Status.Resource != "" && recipe.Status.StatusHash == "" && recipe.Spec.RecipeName == "" && recipe.Spec.Parameters == nil {
+		recipe.Status.StatusHash = hash
+	}
+
 	// Note: we separate this check from the previous block, because it could complete synchronously.
-	if recipe.Status.Resource != "" {
+	if recipe.Status.Resource != "" && recipe.Status.StatusHash == hash {
 		logger.Info("Resource is already created and is up-to-date.")
 		return nil, nil, nil
+	} else if recipe.Status.Resource != "" {
+		logger.Info("Resource is already created but the spec changed, updating it in place.")
 	}
 
 	logger.Info("Starting PUT operation.")
+	poller, err := createOrUpdateResource(ctx, r.radius(recipe.Namespace), resourceID, properties, clusterTags(r.ClusterName))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The hash is reset if the operation fails, so that it is retried.
+	recipe.Status.StatusHash = hash
+	if poller != nil {
+		return poller, nil, nil
+	}
+
+	// Update was synchronous
+	recipe.Status.Resource = resourceID
+	return nil, nil, nil
+}
+
+// recipeResourceProperties returns the properties of the resource created by a Recipe.
+func recipeResourceProperties(recipe *radappiov1alpha3.Recipe) (map[string]any, error) {
 	properties := map[string]any{
 		"application":          recipe.Status.Application,
 		"environment":          recipe.Status.Environment,
 		"resourceProvisioning": "recipe",
 	}
 
-	poller, err := createOrUpdateResource(ctx, r.Radius, resourceID, properties)
+	if recipe.Spec.RecipeName == "" && recipe.Spec.Parameters == nil {
+		return properties, nil
+	}
+
+	recipeProperties := map[string]any{}
+	if recipe.Spec.RecipeName != "" {
+		recipeProperties["name"] = recipe.Spec.RecipeName
+	}
+
+	parameters, err := recipeParameters(recipe)
 	if err != nil {
-		return nil, nil, err
-	} else if poller != nil {
-		return poller, nil, nil
+		return nil, err
+	} else if parameters != nil {
+		recipeProperties["parameters"] = parameters
 	}
 
-	// Update was synchronous
-	recipe.Status.Resource = resourceID
-	return nil, nil, nil
+	properties["recipe"] = recipeProperties
+	return properties, nil
+}
+
+// recipeParameters returns the parameters of a Recipe, or nil if it has none.
+func recipeParameters(recipe *radappiov1alpha3.Recipe) (map[string]any, error) {
+	if recipe.Spec.Parameters == nil || len(recipe.Spec.Parameters.Raw) == 0 {
+		return nil, nil
+	}
+
+	parameters := map[string]any{}
+	err := json.Unmarshal(recipe.Spec.Parameters.Raw, &parameters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal parameters: %w", err)
+	}
+
+	return parameters, nil
+}
+
+// computeRecipeHash computes a hash of the properties of the resource created by a Recipe.
+func computeRecipeHash(properties map[string]any) (string, error) {
+	b, err := json.Marshal(properties)
+	if err != nil {
+		return "", err
+	}
+
+	return hashutil.Hex(b), nil
 }
 
 func (r *RecipeReconciler) startDeleteOperationIfNeeded(ctx context.Context, recipe *radappiov1alpha3.Recipe) (sdkclients.Poller[generated.GenericResourcesClientDeleteResponse], error) {
@@ -422,7 +510,7 @@ func (r *RecipeReconciler) startDeleteOperationIfNeeded(ctx context.Context, rec
 	}
 
 	logger.Info("Starting DELETE operation.")
-	poller, err := deleteResource(ctx, r.Radius, recipe.Status.Resource)
+	poller, err := deleteResource(ctx, r.radius(recipe.Namespace), recipe.Status.Resource)
 	if err != nil {
 		return nil, err
 	} else if poller != nil {
@@ -435,91 +523,135 @@ func (r *RecipeReconciler) startDeleteOperationIfNeeded(ctx context.Context, rec
 	return nil, nil
 }
 
-func (r *RecipeReconciler) updateSecret(ctx context.Context, recipe *radappiov1alpha3.Recipe) error {
+// updateOutputs writes the values and secrets of the resource to the secret and config map of the Recipe, and
+// records the outputs of the resource in the status of the Recipe.
+func (r *RecipeReconciler) updateOutputs(ctx context.Context, recipe *radappiov1alpha3.Recipe) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	// If the secret name changed, delete the old secret.
 	if recipe.Spec.SecretName != recipe.Status.Secret.Name && recipe.Status.Secret.Name != "" {
 		logger.Info("Deleting stale secret", "secret", recipe.Status.Secret.Name)
-		err := r.Client.Delete(ctx, &corev1.Secret{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      recipe.Status.Secret.Name,
-				Namespace: recipe.Namespace,
-			},
-		})
-		if err != nil && !apierrors.IsNotFound(err) {
-			return fmt.Errorf("failed to delete stale secret %s: %w", recipe.Status.Secret.Name, err)
+		if err := r.deleteSecret(ctx, recipe); err != nil {
+			return err
 		}
 	}
 
-	if recipe.Spec.SecretName == "" {
-		logger.Info("No secret name specified, skipping secret creation")
-		recipe.Status.Secret = corev1.ObjectReference{}
-		return nil
+	// If the config map name changed, delete the old config map.
+	if recipe.Spec.ConfigMapName != recipe.Status.ConfigMap.Name && recipe.Status.ConfigMap.Name != "" {
+		logger.Info("Deleting stale config map", "configMap", recipe.Status.ConfigMap.Name)
+		if err := r.deleteConfigMap(ctx, recipe); err != nil {
+			return err
+		}
 	}
 
-	logger.Info("Creating or updating secret.", "secret", recipe.Spec.SecretName)
-	result, err := fetchResource(ctx, r.Radius, recipe.Status.Resource)
+	result, err := fetchResource(ctx, r.radius(recipe.Namespace), recipe.Status.Resource)
 	if err != nil {
 		return fmt.Errorf("failed to read resource: %w", err)
 	}
 
-	secret := &corev1.Secret{}
-	err = r.Client.Get(ctx, client.ObjectKey{Namespace: recipe.Namespace, Name: recipe.Spec.SecretName}, secret)
-	if apierrors.IsNotFound(err) {
-		// This is OK, we'll create it next.
-		secret = nil
-	} else if err != nil {
-		return fmt.Errorf("failed to fetch secret %s: %w", recipe.Spec.SecretName, err)
+	values, err := resourceToConnectionValues(result.GenericResource)
+	if err != nil {
+		return fmt.Errorf("failed to read connection values: %w", err)
 	}
 
-	// Initialize the secret if it doesn't exist.
-	if secret == nil {
-		secret = &corev1.Secret{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      recipe.Spec.SecretName,
-				Namespace: recipe.Namespace,
-				OwnerReferences: []metav1.OwnerReference{
-					*metav1.NewControllerRef(recipe, radappiov1alpha3.GroupVersion.WithKind("Recipe")),
-				},
-			},
+	secrets := map[string]string{}
+	response, err := r.radius(recipe.Namespace).Resources(recipe.Status.Scope, recipe.Spec.Type).ListSecrets(ctx, recipe.Name)
+	if clients.Is404Error(err) {
+		// Safe to ignore. Not everything implements this.
+	} else if err != nil {
+		return fmt.Errorf("failed to list secrets: %w", err)
+	} else {
+		for k, v := range response.Value {
+			secrets[k] = *v
 		}
+	}
 
-		err = r.Client.Create(ctx, secret)
-		if err != nil {
-			return fmt.Errorf("failed to create secret %s: %w", secret.Name, err)
+	names := []string{}
+	for name := range values {
+		names = append(names, name)
+	}
+	for name := range secrets {
+		if _, ok := values[name]; !ok {
+			names = append(names, name)
 		}
 	}
 
-	// envtest has some quirky behavior around StringData which makes it hard to test. So we're
-	// using Data directly.
-	if secret.Data == nil {
-		secret.Data = map[string][]byte{}
+	keys, err := recipeOutputKeys(recipe, names)
+	if err != nil {
+		return fmt.Errorf("failed to compute output keys: %w", err)
 	}
 
-	values, err := resourceToConnectionValues(result.GenericResource)
+	valueData := map[string]string{}
+	for name, v := range values {
+		valueData[keys[name]] = v
+	}
+	secretData := map[string]string{}
+	for name, v := range secrets {
+		secretData[keys[name]] = v
+	}
+
+	// Without a config map, values are written to the secret as well. Secrets take precedence.
+	secretContent := secretData
+	if recipe.Spec.ConfigMapName == "" {
+		secretContent = map[string]string{}
+		maps.Copy(secretContent, valueData)
+		maps.Copy(secretContent, secretData)
+	}
+
+	err = r.updateSecret(ctx, recipe, secretContent)
 	if err != nil {
-		return fmt.Errorf("failed to read connection values: %w", err)
+		return err
 	}
 
-	for k, v := range values {
-		secret.Data[k] = []byte(v)
+	err = r.updateConfigMap(ctx, recipe, valueData)
+	if err != nil {
+		return err
 	}
 
-	secrets, err := r.Radius.Resources(recipe.Status.Scope, recipe.Spec.Type).ListSecrets(ctx, recipe.Name)
-	if clients.Is404Error(err) {
-		// Safe to ignore. Not everything implements this.
-	} else if err != nil {
-		return fmt.Errorf("failed to list secrets: %w", err)
-	} else {
-		for k, v := range secrets.Value {
-			secret.Data[k] = []byte(*v)
-		}
+	recipe.Status.Outputs = &radappiov1alpha3.RecipeOutputs{
+		Values:    sortedKeys(valueData),
+		Secrets:   sortedKeys(secretData),
+		Resources: recipeOutputResources(result.GenericResource),
 	}
 
-	err = r.Client.Update(ctx, secret)
+	return nil
+}
+
+func (r *RecipeReconciler) updateSecret(ctx context.Context, recipe *radappiov1alpha3.Recipe, data map[string]string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if recipe.Spec.SecretName == "" {
+		logger.Info("No secret name specified, skipping secret creation")
+		recipe.Status.Secret = corev1.ObjectReference{}
+		return nil
+	}
+
+	logger.Info("Creating or updating secret.", "secret", recipe.Spec.SecretName)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      recipe.Spec.SecretName,
+			Namespace: recipe.Namespace,
+		},
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if secret.CreationTimestamp.IsZero() {
+			secret.OwnerReferences = []metav1.OwnerReference{
+				*metav1.NewControllerRef(recipe, radappiov1alpha3.GroupVersion.WithKind("Recipe")),
+			}
+		}
+
+		// envtest has some quirky behavior around StringData which makes it hard to test. So we're
+		// using Data directly.
+		secret.Data = map[string][]byte{}
+		for k, v := range data {
+			secret.Data[k] = []byte(v)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to update secret %s: %w", secret.Name, err)
+		return fmt.Errorf("failed to create or update secret %s: %w", secret.Name, err)
 	}
 
 	recipe.Status.Secret = corev1.ObjectReference{
@@ -533,6 +665,47 @@ func (r *RecipeReconciler) updateSecret(ctx context.Context, recipe *radappiov1a
 	return nil
 }
 
+func (r *RecipeReconciler) updateConfigMap(ctx context.Context, recipe *radappiov1alpha3.Recipe, data map[string]string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if recipe.Spec.ConfigMapName == "" {
+		recipe.Status.ConfigMap = corev1.ObjectReference{}
+		return nil
+	}
+
+	logger.Info("Creating or updating config map.", "configMap", recipe.Spec.ConfigMapName)
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      recipe.Spec.ConfigMapName,
+			Namespace: recipe.Namespace,
+		},
+	}
+
+	_, err := controllerutil.CreateOrUpdate(ctx, r.Client, configMap, func() error {
+		if configMap.CreationTimestamp.IsZero() {
+			configMap.OwnerReferences = []metav1.OwnerReference{
+				*metav1.NewControllerRef(recipe, radappiov1alpha3.GroupVersion.WithKind("Recipe")),
+			}
+		}
+
+		configMap.Data = data
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create or update config map %s: %w", configMap.Name, err)
+	}
+
+	recipe.Status.ConfigMap = corev1.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Namespace:  configMap.Namespace,
+		Name:       configMap.Name,
+		UID:        configMap.UID,
+	}
+
+	return nil
+}
+
 func (r *RecipeReconciler) deleteSecret(ctx context.Context, recipe *radappiov1alpha3.Recipe) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -553,6 +726,31 @@ func (r *RecipeReconciler) deleteSecret(ctx context.Context, recipe *radappiov1a
 	return nil
 }
 
+func (r *RecipeReconciler) deleteConfigMap(ctx context.Context, recipe *radappiov1alpha3.Recipe) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	if recipe.Status.ConfigMap.Name != "" {
+		logger.Info("Deleting config map.", "configMap", recipe.Status.ConfigMap.Name)
+		err := r.Client.Delete(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      recipe.Status.ConfigMap.Name,
+				Namespace: recipe.Namespace,
+			},
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete config map %s: %w", recipe.Status.ConfigMap.Name, err)
+		}
+	}
+
+	recipe.Status.ConfigMap = corev1.ObjectReference{}
+	return nil
+}
+
+// radius returns the Radius client for the UCP endpoint of a namespace.
+func (r *RecipeReconciler) radius(namespace string) RadiusClient {
+	return r.Router.radius(namespace, r.Radius)
+}
+
 func (r *RecipeReconciler) requeueDelay() time.Duration {
 	delay := r.DelayInterval
 	if delay == 0 {
@@ -567,5 +765,6 @@ func (r *RecipeReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&radappiov1alpha3.Recipe{}).
 		Owns(&corev1.Secret{}).
+		Owns(&corev1.ConfigMap{}).
 		Complete(r)
 }
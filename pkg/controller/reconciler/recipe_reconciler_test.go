@@ -22,10 +22,13 @@ import (
 	"testing"
 
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
+	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
 	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -344,3 +347,177 @@ func Test_RecipeReconciler_WithSecret(t *testing.T) {
 		return apierrors.IsNotFound(err)
 	}, recipeTestWaitDuration, recipeTestWaitInterval, "secret should be deleted")
 }
+
+func Test_RecipeReconciler_WithConfigMap(t *testing.T) {
+	ctx := t.Context()
+	radius, client := SetupRecipeTest(t)
+
+	name := types.NamespacedName{Namespace: "recipe-withconfigmap", Name: "test-recipe-withconfigmap"}
+	err := client.Create(ctx, &corev1.Namespace{ObjectMeta: ctrl.ObjectMeta{Name: name.Namespace}})
+	require.NoError(t, err)
+
+	recipe := makeRecipe(name, "Applications.Core/extenders")
+	recipe.Spec.SecretName = name.Name
+	recipe.Spec.ConfigMapName = name.Name
+	recipe.Spec.OutputKeyPrefix = "EXTENDER_"
+	recipe.Spec.OutputKeyTemplate = "{{ .Name | upper | replace \"-\" \"_\" }}"
+
+	err = client.Create(ctx, recipe)
+	require.NoError(t, err)
+
+	// Recipe will be waiting for environment to be created.
+	createEnvironment(radius, "default", "default")
+
+	// Recipe will be waiting for extender to complete provisioning.
+	status := waitForRecipeStateUpdating(t, client, name, nil)
+
+	// Update the resource with computed values as part of completing the operation.
+	radius.CompleteOperation(status.Operation.ResumeToken, func(state *sdkclients.OperationState) {
+		resource, ok := radius.resources[state.ResourceID]
+		require.True(t, ok, "failed to find resource")
+
+		resource.Properties["a-value"] = "a"
+		resource.Properties["secrets"] = map[string]string{
+			"b-secret": "b",
+		}
+		resource.Properties["status"] = map[string]any{
+			"outputResources": []any{
+				map[string]any{"id": "/planes/kubernetes/local/namespaces/recipe-withconfigmap/providers/core/Service/extender"},
+			},
+		}
+		state.Value = generated.GenericResourcesClientCreateOrUpdateResponse{GenericResource: resource}
+	})
+
+	// Recipe will update after operation completes
+	status = waitForRecipeStateReady(t, client, name)
+
+	// Values are written to the config map, and only secrets are written to the secret.
+	configMap := corev1.ConfigMap{}
+	err = client.Get(ctx, name, &configMap)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"EXTENDER_A_VALUE": "a"}, configMap.Data)
+
+	secret := corev1.Secret{}
+	err = client.Get(ctx, name, &secret)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]byte{"EXTENDER_B_SECRET": []byte("b")}, secret.Data)
+
+	require.Equal(t, name.Name, status.ConfigMap.Name)
+	require.Equal(t, &radappiov1alpha3.RecipeOutputs{
+		Values:    []string{"EXTENDER_A_VALUE"},
+		Secrets:   []string{"EXTENDER_B_SECRET"},
+		Resources: []string{"/planes/kubernetes/local/namespaces/recipe-withconfigmap/providers/core/Service/extender"},
+	}, status.Outputs)
+
+	// Now we'll delete the recipe.
+	err = client.Delete(ctx, recipe)
+	require.NoError(t, err)
+
+	// Deletion of the recipe is in progress.
+	status = waitForRecipeStateDeleting(t, client, name, nil)
+	radius.CompleteOperation(status.Operation.ResumeToken, nil)
+
+	// Now deleting of the deployment object can complete.
+	waitForRecipeDeleted(t, client, name)
+
+	// The config map should be (eventually) deleted - the client reads from
+	// the informer cache so the deletion may not be visible immediately.
+	require.Eventuallyf(t, func() bool {
+		c := corev1.ConfigMap{}
+		err := client.Get(ctx, name, &c)
+		return apierrors.IsNotFound(err)
+	}, recipeTestWaitDuration, recipeTestWaitInterval, "config map should be deleted")
+}
+
+func Test_recipeResourceProperties(t *testing.T) {
+	recipe := makeRecipe(types.NamespacedName{Namespace: "default", Name: "test"}, "Applications.Datastores/redisCaches")
+	recipe.Status.Application = "/planes/radius/local/resourceGroups/default-default/providers/Applications.Core/applications/default"
+	recipe.Status.Environment = "/planes/radius/local/resourceGroups/default/providers/Applications.Core/environments/default"
+
+	properties, err := recipeResourceProperties(recipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"application":          recipe.Status.Application,
+		"environment":          recipe.Status.Environment,
+		"resourceProvisioning": "recipe",
+	}, properties)
+
+	hash, err := computeRecipeHash(properties)
+	require.NoError(t, err)
+
+	recipe.Spec.RecipeName = "large"
+	recipe.Spec.Parameters = &runtime.RawExtension{Raw: []byte(`{"size":3,"tags":{"team":"a"}}`)}
+
+	properties, err = recipeResourceProperties(recipe)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{
+		"name":       "large",
+		"parameters": map[string]any{"size": float64(3), "tags": map[string]any{"team": "a"}},
+	}, properties["recipe"])
+
+	updated, err := computeRecipeHash(properties)
+	require.NoError(t, err)
+	require.NotEqual(t, hash, updated)
+
+	recipe.Spec.Parameters = &runtime.RawExtension{Raw: []byte(`[]`)}
+	_, err = recipeResourceProperties(recipe)
+	require.ErrorContains(t, err, "failed to unmarshal parameters")
+}
+
+func Test_RecipeReconciler_UpdateInPlace(t *testing.T) {
+	ctx := t.Context()
+	radius, client := SetupRecipeTest(t)
+
+	name := types.NamespacedName{Namespace: "recipe-update", Name: "test-recipe-update"}
+	err := client.Create(ctx, &corev1.Namespace{ObjectMeta: ctrl.ObjectMeta{Name: name.Namespace}})
+	require.NoError(t, err)
+
+	recipe := makeRecipe(name, "Applications.Core/extenders")
+	err = client.Create(ctx, recipe)
+	require.NoError(t, err)
+
+	// Recipe will be waiting for environment to be created.
+	createEnvironment(radius, "default", "default")
+
+	// Recipe will be waiting for extender to complete provisioning.
+	status := waitForRecipeStateUpdating(t, client, name, nil)
+	radius.CompleteOperation(status.Operation.ResumeToken, nil)
+	status = waitForRecipeStateReady(t, client, name)
+	resourceID := status.Resource
+
+	// Changing the recipe name and parameters updates the resource in place.
+	err = client.Get(ctx, name, recipe)
+	require.NoError(t, err)
+
+	recipe.Spec.RecipeName = "large"
+	recipe.Spec.Parameters = &runtime.RawExtension{Raw: []byte(`{"size":3}`)}
+	err = client.Update(ctx, recipe)
+	require.NoError(t, err)
+
+	status = waitForRecipeStateUpdating(t, client, name, nil)
+	require.Equal(t, resourceID, status.Resource)
+
+	extender, err := radius.Resources(status.Scope, "Applications.Core/extenders").Get(ctx, name.Name)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"name": "large", "parameters": map[string]any{"size": float64(3)}}, extender.Properties["recipe"])
+
+	radius.CompleteOperation(status.Operation.ResumeToken, nil)
+	status = waitForRecipeStateReady(t, client, name)
+	require.Equal(t, resourceID, status.Resource)
+
+	// Changing the secret name does not update the resource.
+	err = client.Get(ctx, name, recipe)
+	require.NoError(t, err)
+
+	recipe.Spec.SecretName = "new-secret-name"
+	err = client.Update(ctx, recipe)
+	require.NoError(t, err)
+
+	require.EventuallyWithT(t, func(t *assert.CollectT) {
+		current := &radappiov1alpha3.Recipe{}
+		err := client.Get(ctx, name, current)
+		assert.NoError(t, err)
+		assert.Equal(t, "new-secret-name", current.Status.Secret.Name)
+		assert.Nil(t, current.Status.Operation)
+	}, recipeTestWaitDuration, recipeTestWaitInterval, "secret should be created without updating the resource")
+}
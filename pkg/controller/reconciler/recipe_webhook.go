@@ -18,12 +18,18 @@ package reconciler
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
+	"github.com/radius-project/radius/pkg/cli/clients"
 	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/recipes/contract"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -39,14 +45,21 @@ func (r *RecipeWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
 }
 
 // RecipeWebhook implements the validating webhook functions for the Recipe type.
-type RecipeWebhook struct{}
+type RecipeWebhook struct {
+	// Radius is the Radius client used to validate the recipe name and parameters of a Recipe against the
+	// recipes registered in its environment. If nil the recipe name and parameters are not validated.
+	Radius RadiusClient
+
+	// Router selects the UCP endpoint of a namespace. Namespaces that match no route use Radius.
+	Router *UCPRouter
+}
 
 // ValidateCreate validates the creation of a Recipe object.
 func (r *RecipeWebhook) ValidateCreate(ctx context.Context, recipe *radappiov1alpha3.Recipe) (admission.Warnings, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	logger.Info("Validating Create Recipe %s", recipe.Name)
-	return r.validateRecipeType(ctx, recipe)
+	return r.validateRecipe(ctx, recipe)
 }
 
 // ValidateUpdate validates the update of a Recipe object.
@@ -54,7 +67,7 @@ func (r *RecipeWebhook) ValidateUpdate(ctx context.Context, oldRecipe, newRecipe
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	logger.Info("Validating Update Recipe %s", newRecipe.Name)
-	return r.validateRecipeType(ctx, newRecipe)
+	return r.validateRecipe(ctx, newRecipe)
 }
 
 // ValidateDelete validates the deletion of a Recipe object.
@@ -66,7 +79,22 @@ func (r *RecipeWebhook) ValidateDelete(ctx context.Context, recipe *radappiov1al
 	return nil, nil
 }
 
-// validateRecipeType validates Recipe object.
+// validateRecipe validates Recipe object.
+func (r *RecipeWebhook) validateRecipe(ctx context.Context, recipe *radappiov1alpha3.Recipe) (admission.Warnings, error) {
+	warnings, err := r.validateRecipeType(ctx, recipe)
+	if err != nil {
+		return warnings, err
+	}
+
+	warnings, err = r.validateRecipeOutputs(recipe)
+	if err != nil {
+		return warnings, err
+	}
+
+	return r.validateRecipeParameters(ctx, recipe)
+}
+
+// validateRecipeType validates the type of a Recipe object.
 func (r *RecipeWebhook) validateRecipeType(ctx context.Context, recipe *radappiov1alpha3.Recipe) (admission.Warnings, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	var errList field.ErrorList
@@ -84,3 +112,115 @@ func (r *RecipeWebhook) validateRecipeType(ctx context.Context, recipe *radappio
 
 	return nil, nil
 }
+
+// validateRecipeOutputs validates the config map name and the output key options of a Recipe object.
+func (r *RecipeWebhook) validateRecipeOutputs(recipe *radappiov1alpha3.Recipe) (admission.Warnings, error) {
+	var errList field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if recipe.Spec.ConfigMapName != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(recipe.Spec.ConfigMapName) {
+			errList = append(errList, field.Invalid(specPath.Child("configMapName"), recipe.Spec.ConfigMapName, msg))
+		}
+	}
+
+	if _, err := parseRecipeOutputKeyTemplate(recipe); err != nil {
+		errList = append(errList, field.Invalid(specPath.Child("outputKeyTemplate"), recipe.Spec.OutputKeyTemplate, err.Error()))
+	} else if _, err := recipeOutputKeys(recipe, []string{"value"}); err != nil && recipe.Spec.OutputKeyTemplate == "" {
+		errList = append(errList, field.Invalid(specPath.Child("outputKeyPrefix"), recipe.Spec.OutputKeyPrefix, err.Error()))
+	} else if err != nil {
+		// The outputs of the resource are unknown until it is deployed, so the template is executed with a
+		// sample output to catch templates that fail to execute or produce invalid keys.
+		errList = append(errList, field.Invalid(specPath.Child("outputKeyTemplate"), recipe.Spec.OutputKeyTemplate, err.Error()))
+	}
+
+	if len(errList) > 0 {
+		return nil, apierrors.NewInvalid(
+			schema.GroupKind{Group: "radapp.io", Kind: "Recipe"},
+			recipe.Name,
+			errList)
+	}
+
+	return nil, nil
+}
+
+// validateRecipeParameters validates the recipe name and parameters of a Recipe object against the metadata of the
+// recipe registered in its environment. The environment may not exist yet and Radius may be unavailable, in which
+// case the Recipe is admitted with a warning and the parameters are validated when the resource is deployed.
+func (r *RecipeWebhook) validateRecipeParameters(ctx context.Context, recipe *radappiov1alpha3.Recipe) (admission.Warnings, error) {
+	specPath := field.NewPath("spec")
+	invalid := func(errList field.ErrorList) error {
+		return apierrors.NewInvalid(schema.GroupKind{Group: "radapp.io", Kind: "Recipe"}, recipe.Name, errList)
+	}
+
+	parameters, err := recipeParameters(recipe)
+	if err != nil {
+		return nil, invalid(field.ErrorList{field.Invalid(specPath.Child("parameters"), field.OmitValueType{}, err.Error())})
+	}
+
+	radius := r.Router.radius(recipe.Namespace, r.Radius)
+	if radius == nil || (recipe.Spec.RecipeName == "" && parameters == nil) {
+		return nil, nil
+	}
+
+	environmentName := defaultEnvironmentName
+	if recipe.Spec.Environment != "" {
+		environmentName = recipe.Spec.Environment
+	}
+
+	recipeName := defaultRecipeName
+	if recipe.Spec.RecipeName != "" {
+		recipeName = recipe.Spec.RecipeName
+	}
+
+	environmentID, err := findEnvironment(ctx, radius, "/planes/radius/local", environmentName)
+	if err != nil {
+		return admission.Warnings{fmt.Sprintf("The recipe parameters were not validated: failed to list environments: %s", err.Error())}, nil
+	} else if environmentID == nil {
+		return admission.Warnings{fmt.Sprintf("The recipe parameters were not validated: could not find an environment named %q", environmentName)}, nil
+	}
+
+	id, err := resources.ParseResource(*environmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := radius.Environments(id.RootScope()).GetMetadata(ctx, id.Name(), corerpv20231001preview.RecipeGetMetadata{
+		Name:         &recipeName,
+		ResourceType: &recipe.Spec.Type,
+	}, nil)
+	if clients.Is404Error(err) {
+		message := fmt.Sprintf("recipe %q is not registered for resource type %q in environment %q", recipeName, recipe.Spec.Type, environmentName)
+		return nil, invalid(field.ErrorList{field.Invalid(specPath.Child("recipeName"), recipe.Spec.RecipeName, message)})
+	} else if err != nil {
+		return admission.Warnings{fmt.Sprintf("The recipe parameters were not validated: failed to fetch the metadata of recipe %q: %s", recipeName, err.Error())}, nil
+	}
+
+	errList := validateRecipeParameterValues(specPath.Child("parameters"), parameters, response.Parameters)
+	if len(errList) > 0 {
+		return nil, invalid(errList)
+	}
+
+	return nil, nil
+}
+
+// validateRecipeParameterValues validates parameters against the parameter definitions of a recipe, as returned by
+// the getMetadata API of environments, using the same validation as recipe packs.
+func validateRecipeParameterValues(parametersPath *field.Path, parameters map[string]any, definitions map[string]any) field.ErrorList {
+	err := contract.ValidateParameterValues(parameters, map[string]any{"parameters": definitions})
+	if err == nil {
+		return nil
+	}
+
+	errs := []error{err}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs = joined.Unwrap()
+	}
+
+	var errList field.ErrorList
+	for _, err := range errs {
+		errList = append(errList, field.Invalid(parametersPath, field.OmitValueType{}, err.Error()))
+	}
+
+	return errList
+}
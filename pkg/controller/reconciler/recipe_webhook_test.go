@@ -26,17 +26,20 @@ import (
 	"time"
 
 	radappiov1alpha3 "github.com/radius-project/radius/pkg/controller/api/radapp.io/v1alpha3"
+	"github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 
 	"github.com/stretchr/testify/require"
 	admissionv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 const (
@@ -339,3 +342,124 @@ func updateWebhookFailurePolicy(t *testing.T, webhookConfigName string, webhookf
 	err = k8sClient.Update(ctx, webhook)
 	require.NoError(t, err)
 }
+
+func Test_Webhook_ValidateRecipeOutputs(t *testing.T) {
+	tests := []struct {
+		name          string
+		configMapName string
+		prefix        string
+		template      string
+		err           string
+	}{
+		{name: "valid", configMapName: "redis-values", prefix: "REDIS_", template: "{{ .Name | upper }}"},
+		{name: "invalid config map name", configMapName: "Redis", err: "spec.configMapName"},
+		{name: "invalid prefix", prefix: "REDIS:", err: "spec.outputKeyPrefix"},
+		{name: "unparseable template", template: "{{ .Name", err: "spec.outputKeyTemplate"},
+		{name: "template with unknown function", template: "{{ .Name | title }}", err: `function "title" not defined`},
+		{name: "template producing invalid keys", template: "{{ .Type }}", err: "spec.outputKeyTemplate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipe := makeRecipe(types.NamespacedName{Namespace: defaultNamespace, Name: "test-recipe-outputs"}, validResourceType)
+			recipe.Spec.ConfigMapName = tt.configMapName
+			recipe.Spec.OutputKeyPrefix = tt.prefix
+			recipe.Spec.OutputKeyTemplate = tt.template
+
+			_, err := (&RecipeWebhook{}).ValidateCreate(t.Context(), recipe)
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.True(t, apierrors.IsInvalid(err))
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func Test_Webhook_ValidateRecipeParameters(t *testing.T) {
+	radius := NewMockRadiusClient()
+	createEnvironment(radius, "default", "default")
+	radius.recipes[mockRecipeKey("/planes/radius/local/resourceGroups/default/providers/Applications.Core/environments/default", validResourceType, "large")] = v20231001preview.RecipeGetMetadataResponse{
+		Parameters: map[string]any{
+			"size":     map[string]any{"type": "int", "minValue": float64(1), "maxValue": float64(10)},
+			"sku":      map[string]any{"type": "string"},
+			"tags":     map[string]any{"type": "object"},
+			"replicas": map[string]any{"type": "list(string)"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		recipeName  string
+		environment string
+		parameters  string
+		warning     string
+		err         []string
+	}{
+		{name: "no recipe name or parameters"},
+		{name: "valid", recipeName: "large", parameters: `{"size":3,"sku":"premium","tags":{"team":"a"},"replicas":["a"]}`},
+		{
+			name:       "recipe not registered",
+			recipeName: "small",
+			err:        []string{`spec.recipeName: Invalid value: "small": recipe "small" is not registered for resource type "Applications.Core/extenders" in environment "default"`},
+		},
+		{
+			name:       "default recipe not registered",
+			parameters: `{"size":3}`,
+			err:        []string{`recipe "default" is not registered`},
+		},
+		{
+			name:       "invalid parameters",
+			recipeName: "large",
+			parameters: `{"size":true,"sku":{"name":"premium"},"tags":[],"replicas":"a","tier":"gold"}`,
+			err: []string{
+				`spec.parameters: Invalid value: parameter "replicas" has type "list(string)", which does not accept a value of type string`,
+				`spec.parameters: Invalid value: parameter "size" has type "int", which does not accept a value of type bool`,
+				`spec.parameters: Invalid value: parameter "sku" has type "string", which does not accept a value of type map[string]interface {}`,
+				`spec.parameters: Invalid value: parameter "tags" has type "object", which does not accept a value of type []interface {}`,
+				`spec.parameters: Invalid value: parameter "tier" is not declared by the recipe`,
+			},
+		},
+		{
+			name:       "values converted by the IaC engine",
+			recipeName: "large",
+			parameters: `{"size":"3","sku":1}`,
+		},
+		{
+			name:        "environment not found",
+			recipeName:  "large",
+			environment: "prod",
+			warning:     `The recipe parameters were not validated: could not find an environment named "prod"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recipe := makeRecipe(types.NamespacedName{Namespace: defaultNamespace, Name: "test-recipe-parameters"}, validResourceType)
+			recipe.Spec.RecipeName = tt.recipeName
+			recipe.Spec.Environment = tt.environment
+			if tt.parameters != "" {
+				recipe.Spec.Parameters = &runtime.RawExtension{Raw: []byte(tt.parameters)}
+			}
+
+			warnings, err := (&RecipeWebhook{Radius: radius}).ValidateCreate(t.Context(), recipe)
+			if tt.warning != "" {
+				require.Equal(t, admission.Warnings{tt.warning}, warnings)
+			} else {
+				require.Empty(t, warnings)
+			}
+
+			if len(tt.err) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.True(t, apierrors.IsInvalid(err))
+			for _, message := range tt.err {
+				require.ErrorContains(t, err, message)
+			}
+		})
+	}
+}
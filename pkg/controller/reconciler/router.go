@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"path"
+
+	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
+)
+
+// UCPRoute describes the UCP endpoint that the Radius resources of a set of namespaces are deployed to.
+type UCPRoute struct {
+	// Namespaces is the list of namespace patterns that the route applies to. Patterns use the syntax of path.Match,
+	// for example "team-*".
+	Namespaces []string
+
+	// Radius is the Radius client for the UCP endpoint of the route.
+	Radius RadiusClient
+
+	// ResourceDeploymentsClient is the deployments client for the UCP endpoint of the route.
+	ResourceDeploymentsClient sdkclients.ResourceDeploymentsClient
+
+	// ResourceGroup is the name of the Radius resource group that Recipes, Deployments and the DeploymentTemplates
+	// generated from Flux sources of the namespaces are deployed to. If empty, the usual defaults are used.
+	ResourceGroup string
+}
+
+// UCPRouter selects the UCP endpoint that the Radius resources of a namespace are deployed to. Namespaces that
+// match no route use the default clients of the reconcilers.
+type UCPRouter struct {
+	// Routes is the list of routes. The first route with a matching namespace pattern is used.
+	Routes []UCPRoute
+}
+
+// Route returns the route of a namespace, or nil if the namespace matches no route. It is safe to call on a nil router.
+func (r *UCPRouter) Route(namespace string) *UCPRoute {
+	if r == nil {
+		return nil
+	}
+
+	for i := range r.Routes {
+		for _, pattern := range r.Routes[i].Namespaces {
+			if matched, err := path.Match(pattern, namespace); err == nil && matched {
+				return &r.Routes[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// radius returns the Radius client for a namespace, or the given default client if the namespace matches no route.
+func (r *UCPRouter) radius(namespace string, defaultClient RadiusClient) RadiusClient {
+	if route := r.Route(namespace); route != nil && route.Radius != nil {
+		return route.Radius
+	}
+
+	return defaultClient
+}
+
+// resourceDeployments returns the deployments client for a namespace, or the given default client if the namespace
+// matches no route.
+func (r *UCPRouter) resourceDeployments(namespace string, defaultClient sdkclients.ResourceDeploymentsClient) sdkclients.ResourceDeploymentsClient {
+	if route := r.Route(namespace); route != nil && route.ResourceDeploymentsClient != nil {
+		return route.ResourceDeploymentsClient
+	}
+
+	return defaultClient
+}
+
+// resourceGroup returns the name of the resource group configured for a namespace, or an empty string if the
+// namespace matches no route or its route does not set a resource group.
+func (r *UCPRouter) resourceGroup(namespace string) string {
+	if route := r.Route(namespace); route != nil {
+		return route.ResourceGroup
+	}
+
+	return ""
+}
+
+// clusterTags returns the tags that identify the cluster whose controller deployed a Radius resource, or nil if the
+// cluster name is not configured.
+func clusterTags(clusterName string) map[string]*string {
+	if clusterName == "" {
+		return nil
+	}
+
+	return map[string]*string{TagRadiusCluster: new(clusterName)}
+}
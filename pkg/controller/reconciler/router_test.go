@@ -0,0 +1,83 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UCPRouter_Route(t *testing.T) {
+	teamRadius := NewMockRadiusClient()
+	router := &UCPRouter{
+		Routes: []UCPRoute{
+			{Namespaces: []string{"team-*"}, Radius: teamRadius, ResourceGroup: "team"},
+			{Namespaces: []string{"shared", "team-a"}, ResourceGroup: "shared"},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		namespace     string
+		resourceGroup string
+		routed        bool
+	}{
+		{name: "pattern", namespace: "team-a", resourceGroup: "team", routed: true},
+		{name: "exact", namespace: "shared", resourceGroup: "shared"},
+		{name: "no match", namespace: "other"},
+	}
+
+	defaultRadius := NewMockRadiusClient()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.resourceGroup, router.resourceGroup(tt.namespace))
+
+			radius := router.radius(tt.namespace, defaultRadius)
+			if tt.routed {
+				require.Same(t, teamRadius, radius)
+			} else {
+				require.Same(t, defaultRadius, radius)
+			}
+		})
+	}
+}
+
+func Test_UCPRouter_Nil(t *testing.T) {
+	var router *UCPRouter
+	defaultRadius := NewMockRadiusClient()
+
+	require.Nil(t, router.Route("default"))
+	require.Same(t, defaultRadius, router.radius("default", defaultRadius))
+	require.Empty(t, router.resourceGroup("default"))
+}
+
+func Test_resolveDependencies_RouteResourceGroupAndClusterTags(t *testing.T) {
+	radius := NewMockRadiusClient()
+	createEnvironment(radius, "default", "default")
+
+	resourceGroupID, _, applicationID, err := resolveDependencies(t.Context(), radius, "/planes/radius/local", "default", "app", "team", clusterTags("spoke-1"))
+	require.NoError(t, err)
+	require.Equal(t, "/planes/radius/local/resourcegroups/team", resourceGroupID)
+	require.Equal(t, "/planes/radius/local/resourcegroups/team/providers/Applications.Core/applications/app", applicationID)
+
+	require.Len(t, radius.groups, 1)
+	for _, group := range radius.groups {
+		require.Equal(t, "team", *group.Name)
+		require.Equal(t, "spoke-1", *group.Tags[TagRadiusCluster])
+	}
+}
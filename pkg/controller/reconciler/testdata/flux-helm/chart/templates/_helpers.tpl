@@ -0,0 +1,3 @@
+{{- define "demo.name" -}}
+{{ .Release.Name }}-demo
+{{- end -}}
@@ -34,7 +34,10 @@ import (
 	"go.yaml.in/yaml/v3"
 )
 
-func resolveDependencies(ctx context.Context, radius RadiusClient, scope string, environmentName string, applicationName string) (resourceGroupID string, environmentID string, applicationID string, err error) {
+// resolveDependencies finds the environment and creates the resource group and application for a resource. The
+// resource group is named after the environment and application unless resourceGroupName is set. The given tags are
+// applied to the resource group and application when they are created.
+func resolveDependencies(ctx context.Context, radius RadiusClient, scope string, environmentName string, applicationName string, resourceGroupName string, tags map[string]*string) (resourceGroupID string, environmentID string, applicationID string, err error) {
 	found, err := findEnvironment(ctx, radius, scope, environmentName)
 	if found == nil {
 		return "", "", "", fmt.Errorf("could not find an environment named %q", environmentName)
@@ -46,14 +49,17 @@ func resolveDependencies(ctx context.Context, radius RadiusClient, scope string,
 
 	// NOTE: using resource groups with lowercase here is a workaround for a casing bug in `rad app graph`.
 	// When https://github.com/radius-project/radius/issues/6422 is fixed we can use the more correct casing.
-	resourceGroupID = fmt.Sprintf("/planes/radius/local/resourcegroups/%s-%s", environmentName, applicationName)
-	err = createResourceGroupIfNotExists(ctx, radius, resourceGroupID)
+	if resourceGroupName == "" {
+		resourceGroupName = fmt.Sprintf("%s-%s", environmentName, applicationName)
+	}
+	resourceGroupID = "/planes/radius/local/resourcegroups/" + resourceGroupName
+	err = createResourceGroupIfNotExists(ctx, radius, resourceGroupID, tags)
 	if err != nil {
 		return "", "", "", fmt.Errorf("failed to create resource group: %w", err)
 	}
 
 	applicationID = resourceGroupID + "/providers/Applications.Core/applications/" + applicationName
-	err = createApplicationIfNotExists(ctx, radius, environmentID, applicationID)
+	err = createApplicationIfNotExists(ctx, radius, environmentID, applicationID, tags)
 	if err != nil {
 		return "", "", "", fmt.Errorf("failed to get or create application: %w", err)
 	}
@@ -79,7 +85,7 @@ func findEnvironment(ctx context.Context, radius RadiusClient, scope string, env
 	return nil, nil
 }
 
-func createResourceGroupIfNotExists(ctx context.Context, radius RadiusClient, resourceGroupID string) error {
+func createResourceGroupIfNotExists(ctx context.Context, radius RadiusClient, resourceGroupID string, tags map[string]*string) error {
 	id, err := resources.Parse(resourceGroupID)
 	if err != nil {
 		return err
@@ -102,6 +108,7 @@ func createResourceGroupIfNotExists(ctx context.Context, radius RadiusClient, re
 	resourceGroup := ucpv20231001preview.ResourceGroupResource{
 		Location:   to.Ptr(v1.LocationGlobal),
 		Name:       new(id.Name()),
+		Tags:       tags,
 		Properties: &ucpv20231001preview.ResourceGroupProperties{},
 	}
 
@@ -113,7 +120,7 @@ func createResourceGroupIfNotExists(ctx context.Context, radius RadiusClient, re
 	return nil
 }
 
-func createApplicationIfNotExists(ctx context.Context, radius RadiusClient, environmentID string, applicationID string) error {
+func createApplicationIfNotExists(ctx context.Context, radius RadiusClient, environmentID string, applicationID string, tags map[string]*string) error {
 	id, err := resources.Parse(applicationID)
 	if err != nil {
 		return err
@@ -136,6 +143,7 @@ func createApplicationIfNotExists(ctx context.Context, radius RadiusClient, envi
 	app := corerpv20231001preview.ApplicationResource{
 		Location: to.Ptr(v1.LocationGlobal),
 		Name:     new(id.Name()),
+		Tags:     tags,
 		Properties: &corerpv20231001preview.ApplicationProperties{
 			Environment: new(environmentID),
 			Extensions: []corerpv20231001preview.ExtensionClassification{
@@ -181,7 +189,7 @@ func deleteResource(ctx context.Context, radius RadiusClient, resourceID string)
 	return nil, nil
 }
 
-func createOrUpdateResource(ctx context.Context, radius RadiusClient, resourceID string, properties map[string]any) (sdkclients.Poller[generated.GenericResourcesClientCreateOrUpdateResponse], error) {
+func createOrUpdateResource(ctx context.Context, radius RadiusClient, resourceID string, properties map[string]any, tags map[string]*string) (sdkclients.Poller[generated.GenericResourcesClientCreateOrUpdateResponse], error) {
 	id, err := resources.Parse(resourceID)
 	if err != nil {
 		return nil, err
@@ -193,6 +201,7 @@ func createOrUpdateResource(ctx context.Context, radius RadiusClient, resourceID
 	body := generated.GenericResource{
 		Location:   to.Ptr(v1.LocationGlobal),
 		Name:       new(id.Name()),
+		Tags:       tags,
 		Properties: properties,
 	}
 	poller, err := radius.Resources(id.RootScope(), id.Type()).BeginCreateOrUpdate(ctx, id.Name(), body, nil)
@@ -288,7 +297,7 @@ func fetchContainerResource(ctx context.Context, radius RadiusClient, containerI
 	return response.ContainerResource, nil
 }
 
-func createOrUpdateContainer(ctx context.Context, radius RadiusClient, containerID string, properties *corerpv20231001preview.ContainerProperties) (sdkclients.Poller[corerpv20231001preview.ContainersClientCreateOrUpdateResponse], error) {
+func createOrUpdateContainer(ctx context.Context, radius RadiusClient, containerID string, properties *corerpv20231001preview.ContainerProperties, tags map[string]*string) (sdkclients.Poller[corerpv20231001preview.ContainersClientCreateOrUpdateResponse], error) {
 	id, err := parseContainerResourceID(containerID)
 	if err != nil {
 		return nil, err
@@ -300,6 +309,7 @@ func createOrUpdateContainer(ctx context.Context, radius RadiusClient, container
 	body := corerpv20231001preview.ContainerResource{
 		Location:   to.Ptr(v1.LocationGlobal),
 		Name:       new(id.Name()),
+		Tags:       tags,
 		Properties: properties,
 	}
 	poller, err := radius.Containers(id.RootScope()).BeginCreateOrUpdate(ctx, id.Name(), body, nil)
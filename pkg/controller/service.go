@@ -30,6 +30,7 @@ import (
 	"github.com/radius-project/radius/pkg/controller/reconciler"
 	"github.com/radius-project/radius/pkg/sdk"
 	sdkclients "github.com/radius-project/radius/pkg/sdk/clients"
+	"github.com/radius-project/radius/pkg/ucp/config"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -96,6 +97,12 @@ func (s *Service) Run(ctx context.Context) error {
 		return fmt.Errorf("failed to create controller manager: %w", err)
 	}
 
+	router, err := s.newUCPRouter()
+	if err != nil {
+		return err
+	}
+	clusterName := s.Options.Config.Controller.ClusterName
+
 	logger.Info("Registering controllers.")
 	//nolint:staticcheck // SA1019: GetEventRecorderFor is deprecated but migration to new events API requires significant refactoring
 	err = (&reconciler.RecipeReconciler{
@@ -103,6 +110,8 @@ func (s *Service) Run(ctx context.Context) error {
 		Scheme:        mgr.GetScheme(),
 		EventRecorder: mgr.GetEventRecorderFor("recipe-controller"),
 		Radius:        reconciler.NewRadiusClient(s.Options.UCPConnection),
+		Router:        router,
+		ClusterName:   clusterName,
 	}).SetupWithManager(mgr)
 	if err != nil {
 		return fmt.Errorf("failed to setup %s controller: %w", "Recipe", err)
@@ -113,18 +122,16 @@ func (s *Service) Run(ctx context.Context) error {
 		Scheme:        mgr.GetScheme(),
 		EventRecorder: mgr.GetEventRecorderFor("radius-deployment-controller"),
 		Radius:        reconciler.NewRadiusClient(s.Options.UCPConnection),
+		Router:        router,
+		ClusterName:   clusterName,
 	}).SetupWithManager(mgr)
 	if err != nil {
 		return fmt.Errorf("failed to setup %s controller: %w", "Deployment", err)
 	}
 
-	resourceDeploymentsClient, err := sdkclients.NewResourceDeploymentsClient(&sdkclients.Options{
-		Cred:             &aztoken.AnonymousCredential{},
-		BaseURI:          s.Options.UCPConnection.Endpoint(),
-		ARMClientOptions: sdk.NewClientOptions(s.Options.UCPConnection),
-	})
+	resourceDeploymentsClient, err := newResourceDeploymentsClient(s.Options.UCPConnection)
 	if err != nil {
-		return fmt.Errorf("failed to create resource deployments client: %w", err)
+		return err
 	}
 	//nolint:staticcheck // SA1019: GetEventRecorderFor is deprecated but migration to new events API requires significant refactoring
 	err = (&reconciler.DeploymentTemplateReconciler{
@@ -133,6 +140,8 @@ func (s *Service) Run(ctx context.Context) error {
 		EventRecorder:             mgr.GetEventRecorderFor("deploymenttemplate-controller"),
 		Radius:                    reconciler.NewRadiusClient(s.Options.UCPConnection),
 		ResourceDeploymentsClient: resourceDeploymentsClient,
+		Router:                    router,
+		ClusterName:               clusterName,
 	}).SetupWithManager(mgr)
 	if err != nil {
 		return fmt.Errorf("failed to setup %s controller: %w", "DeploymentTemplate", err)
@@ -144,15 +153,20 @@ func (s *Service) Run(ctx context.Context) error {
 		EventRecorder:             mgr.GetEventRecorderFor("deploymentresource-controller"),
 		Radius:                    reconciler.NewRadiusClient(s.Options.UCPConnection),
 		ResourceDeploymentsClient: resourceDeploymentsClient,
+		Router:                    router,
 	}).SetupWithManager(mgr)
 	if err != nil {
 		return fmt.Errorf("failed to setup %s controller: %w", "DeploymentResource", err)
 	}
 
+	//nolint:staticcheck // SA1019: GetEventRecorderFor is deprecated but migration to new events API requires significant refactoring
 	err = (&reconciler.FluxController{
 		Client:         mgr.GetClient(),
+		EventRecorder:  mgr.GetEventRecorderFor("flux-controller"),
 		ArchiveFetcher: reconciler.NewArchiveFetcher(),
 		FileSystem:     filesystem.NewOSFS(),
+		Helm:           reconciler.NewHelmRenderer(),
+		Router:         router,
 		Bicep: &bicep.Impl{
 			FileSystem: filesystem.NewOSFS(),
 		},
@@ -164,10 +178,16 @@ func (s *Service) Run(ctx context.Context) error {
 	if s.TLSCertDir == "" {
 		logger.Info("Webhooks will be skipped. TLS certificates not present.")
 	} else {
-		logger.Info("Registering validating webhook.")
-		if err = (&reconciler.RecipeWebhook{}).SetupWebhookWithManager(mgr); err != nil {
+		logger.Info("Registering admission webhooks.")
+		if err = (&reconciler.RecipeWebhook{Radius: reconciler.NewRadiusClient(s.Options.UCPConnection), Router: router}).SetupWebhookWithManager(mgr); err != nil {
 			return fmt.Errorf("failed to create recipe-webhook: %w", err)
 		}
+		if err = (&reconciler.DeploymentTemplateWebhook{}).SetupWebhookWithManager(mgr); err != nil {
+			return fmt.Errorf("failed to create deploymenttemplate-webhook: %w", err)
+		}
+		if err = (&reconciler.DeploymentWebhook{Client: mgr.GetClient()}).SetupWebhookWithManager(mgr); err != nil {
+			return fmt.Errorf("failed to create deployment-webhook: %w", err)
+		}
 	}
 
 	logger.Info("Registering health checks.")
@@ -190,3 +210,46 @@ func (s *Service) Run(ctx context.Context) error {
 	logger.Info("Running.")
 	return nil
 }
+
+// newUCPRouter creates the router for the per-namespace UCP endpoints of the controller configuration.
+func (s *Service) newUCPRouter() (*reconciler.UCPRouter, error) {
+	router := &reconciler.UCPRouter{}
+	for i, route := range s.Options.Config.Controller.Routes {
+		if len(route.Namespaces) == 0 {
+			return nil, fmt.Errorf("the property .controller.routes[%d].namespaces is required", i)
+		}
+
+		connection, err := config.NewConnectionFromUCPConfig(&route.UCP, s.Options.K8sConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create connection for .controller.routes[%d]: %w", i, err)
+		}
+
+		resourceDeploymentsClient, err := newResourceDeploymentsClient(connection)
+		if err != nil {
+			return nil, err
+		}
+
+		router.Routes = append(router.Routes, reconciler.UCPRoute{
+			Namespaces:                route.Namespaces,
+			Radius:                    reconciler.NewRadiusClient(connection),
+			ResourceDeploymentsClient: resourceDeploymentsClient,
+			ResourceGroup:             route.ResourceGroup,
+		})
+	}
+
+	return router, nil
+}
+
+// newResourceDeploymentsClient creates the deployments client for a UCP connection.
+func newResourceDeploymentsClient(connection sdk.Connection) (sdkclients.ResourceDeploymentsClient, error) {
+	client, err := sdkclients.NewResourceDeploymentsClient(&sdkclients.Options{
+		Cred:             &aztoken.AnonymousCredential{},
+		BaseURI:          connection.Endpoint(),
+		ARMClientOptions: sdk.NewClientOptions(connection),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create resource deployments client: %w", err)
+	}
+
+	return client, nil
+}
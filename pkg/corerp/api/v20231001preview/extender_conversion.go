@@ -132,6 +132,31 @@ func fromRecipeStatus(recipeStatus *rpv1.RecipeStatus) *RecipeStatus {
 		status.TemplateVersion = new(recipeStatus.TemplateVersion)
 	}
 
+	if recipeStatus.Drift != nil {
+		status.Drift = fromRecipeDriftStatus(recipeStatus.Drift)
+	}
+
+	return status
+}
+
+func fromRecipeDriftStatus(drift *rpv1.RecipeDriftStatus) *RecipeDriftStatus {
+	if drift == nil {
+		return nil
+	}
+
+	status := &RecipeDriftStatus{
+		LastCheckedTime:  drift.LastCheckedTime,
+		DriftedResources: to.ArrayofStringPtrs(drift.DriftedResources),
+	}
+
+	if drift.State != "" {
+		status.State = new(RecipeDriftState(drift.State))
+	}
+
+	if drift.Message != "" {
+		status.Message = new(drift.Message)
+	}
+
 	return status
 }
 
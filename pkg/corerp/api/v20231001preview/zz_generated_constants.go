@@ -300,6 +300,30 @@ func PossibleProvisioningStateValues() []ProvisioningState {
 	}
 }
 
+// RecipeDriftState - The drift state of the resources deployed by a recipe.
+type RecipeDriftState string
+
+const (
+	// RecipeDriftStateDrifted - One or more deployed resources were changed or removed outside of Radius.
+	RecipeDriftStateDrifted RecipeDriftState = "Drifted"
+	// RecipeDriftStateInSync - The deployed resources match the recipe.
+	RecipeDriftStateInSync RecipeDriftState = "InSync"
+	// RecipeDriftStateRemediating - The recipe is being re-applied to correct drift.
+	RecipeDriftStateRemediating RecipeDriftState = "Remediating"
+	// RecipeDriftStateUnknown - The drift state could not be determined.
+	RecipeDriftStateUnknown RecipeDriftState = "Unknown"
+)
+
+// PossibleRecipeDriftStateValues returns the possible values for the RecipeDriftState const type.
+func PossibleRecipeDriftStateValues() []RecipeDriftState {
+	return []RecipeDriftState{
+		RecipeDriftStateDrifted,
+		RecipeDriftStateInSync,
+		RecipeDriftStateRemediating,
+		RecipeDriftStateUnknown,
+	}
+}
+
 // ResourceProvisioning - Specifies how the underlying service/resource is provisioned and managed. Available values are 'recipe',
 // where Radius manages the lifecycle of the resource through a Recipe, and 'manual', where a user manages the resource and
 // provides the values.
@@ -21,6 +21,18 @@ type ApplicationGraphConnection struct {
 	ID *string
 }
 
+// ApplicationGraphEndpoint - Describes an endpoint of an application graph resource.
+type ApplicationGraphEndpoint struct {
+	// REQUIRED; The name of the endpoint, which is the path of the property of the resource that holds it.
+	Name *string
+
+	// REQUIRED; The URL or address of the endpoint.
+	URL *string
+
+	// REQUIRED; The visibility of the endpoint, either 'public' or 'internal'.
+	Visibility *string
+}
+
 // ApplicationGraphOutputResource - Describes an output resource that comprises an application graph resource.
 type ApplicationGraphOutputResource struct {
 	// REQUIRED; The resource ID.
@@ -61,6 +73,10 @@ type ApplicationGraphResource struct {
 	// resources as added, removed, modified, or unchanged across graphs. Format: 'sha256:{hex}'.
 	DiffHash *string
 
+	// The endpoints of the resource, from the properties marked with the `x-radius-endpoint` annotation in the schema of the resource
+	// type.
+	Endpoints []*ApplicationGraphEndpoint
+
 	// Resource-type-specific properties of the resource as returned by its resource provider. The shape of this map varies by
 	// `type` (e.g. an `Applications.Core/containers` resource exposes different keys than `Applications.Datastores/redisCaches`
 	// or any user-defined resource type) and matches the `properties` returned by that resource's GET response. Top-level keys
@@ -1111,6 +1127,21 @@ type RecipeConfigProperties struct {
 	Terraform *TerraformConfigProperties
 }
 
+// RecipeDriftStatus - The result of a drift check for the resources deployed by a recipe.
+type RecipeDriftStatus struct {
+	// The identifiers of the resources that have drifted.
+	DriftedResources []*string
+
+	// The timestamp of the most recent drift check.
+	LastCheckedTime *time.Time
+
+	// A human readable description of the drift check result.
+	Message *string
+
+	// The drift state of the resources deployed by the recipe.
+	State *RecipeDriftState
+}
+
 // RecipeGetMetadata - Represents the request body of the getmetadata action.
 type RecipeGetMetadata struct {
 	// REQUIRED; The name of the recipe registered to the environment.
@@ -1163,6 +1194,9 @@ type RecipeStatus struct {
 	// REQUIRED; TemplatePath is the path of the recipe consumed by the portable resource upon deployment.
 	TemplatePath *string
 
+	// The result of the most recent drift check for the resources deployed by the recipe.
+	Drift *RecipeDriftStatus
+
 	// TemplateVersion is the version number of the template.
 	TemplateVersion *string
 }
@@ -79,6 +79,14 @@ func (src *EnvironmentResource) ConvertTo() (v1.DataModelInterface, error) {
 		converted.Properties.BicepSettings = to.String(src.Properties.BicepSettings)
 	}
 
+	// Convert RecipeDrift
+	if src.Properties.RecipeDrift != nil {
+		converted.Properties.RecipeDrift = &datamodel.RecipeDriftPolicy_v20250801preview{
+			Enabled:       src.Properties.RecipeDrift.Enabled,
+			AutoRemediate: to.Bool(src.Properties.RecipeDrift.AutoRemediate),
+		}
+	}
+
 	return converted, nil
 }
 
@@ -132,6 +140,16 @@ func (dst *EnvironmentResource) ConvertFrom(src v1.DataModelInterface) error {
 		dst.Properties.BicepSettings = &env.Properties.BicepSettings
 	}
 
+	// Convert RecipeDrift
+	if env.Properties.RecipeDrift != nil {
+		dst.Properties.RecipeDrift = &RecipeDriftPolicy{
+			Enabled: env.Properties.RecipeDrift.Enabled,
+		}
+		if env.Properties.RecipeDrift.AutoRemediate {
+			dst.Properties.RecipeDrift.AutoRemediate = new(env.Properties.RecipeDrift.AutoRemediate)
+		}
+	}
+
 	return nil
 }
 
@@ -175,6 +193,14 @@ func toProvidersDataModel(providers *Providers) *datamodel.Providers_v20250801pr
 		}
 	}
 
+	// Convert GCP provider
+	if providers.Gcp != nil {
+		result.GCP = &datamodel.ProvidersGCP_v20250801preview{
+			ProjectID: to.String(providers.Gcp.ProjectID),
+			Region:    to.String(providers.Gcp.Region),
+		}
+	}
+
 	return result
 }
 
@@ -218,6 +244,14 @@ func fromProvidersDataModel(providers *datamodel.Providers_v20250801preview) *Pr
 		}
 	}
 
+	// Convert GCP provider
+	if providers.GCP != nil {
+		result.Gcp = &ProvidersGcp{
+			ProjectID: new(providers.GCP.ProjectID),
+			Region:    new(providers.GCP.Region),
+		}
+	}
+
 	return result
 }
 
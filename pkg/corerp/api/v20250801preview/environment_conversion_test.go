@@ -56,6 +56,13 @@ func TestEnvironmentConvertVersionedToDataModel(t *testing.T) {
 						},
 					},
 				},
+				Gcp: &ProvidersGcp{
+					ProjectID: new("my-project"),
+					Region:    new("us-central1"),
+				},
+			},
+			RecipeDrift: &RecipeDriftPolicy{
+				AutoRemediate: new(true),
 			},
 		},
 	}
@@ -76,11 +83,13 @@ func TestEnvironmentConvertVersionedToDataModel(t *testing.T) {
 	require.NotNil(t, env.Properties.Providers.Azure)
 	require.Equal(t, "00000000-0000-0000-0000-000000000000", env.Properties.Providers.Azure.SubscriptionId)
 	require.Equal(t, "my-resource-group", env.Properties.Providers.Azure.ResourceGroupName)
+	require.Equal(t, &datamodel.ProvidersGCP_v20250801preview{ProjectID: "my-project", Region: "us-central1"}, env.Properties.Providers.GCP)
 	require.NotNil(t, env.Properties.RecipeParameters)
 	require.Len(t, env.Properties.RecipeParameters, 1)
 	containerParams, ok := env.Properties.RecipeParameters["Radius.Compute/containers"]
 	require.True(t, ok)
 	require.Equal(t, false, containerParams["allowPlatformOptions"])
+	require.Equal(t, &datamodel.RecipeDriftPolicy_v20250801preview{AutoRemediate: true}, env.Properties.RecipeDrift)
 }
 
 func TestEnvironmentConvertDataModelToVersioned(t *testing.T) {
@@ -112,8 +121,15 @@ func TestEnvironmentConvertDataModelToVersioned(t *testing.T) {
 				Kubernetes: &datamodel.ProvidersKubernetes_v20250801preview{
 					Namespace: "default",
 				},
+				GCP: &datamodel.ProvidersGCP_v20250801preview{
+					ProjectID: "my-project",
+					Region:    "us-central1",
+				},
 			},
 			Simulated: false,
+			RecipeDrift: &datamodel.RecipeDriftPolicy_v20250801preview{
+				Enabled: new(false),
+			},
 		},
 	}
 
@@ -129,9 +145,11 @@ func TestEnvironmentConvertDataModelToVersioned(t *testing.T) {
 	require.NotNil(t, versionedResource.Properties.Providers)
 	require.NotNil(t, versionedResource.Properties.Providers.Kubernetes)
 	require.Equal(t, new("default"), versionedResource.Properties.Providers.Kubernetes.Namespace)
+	require.Equal(t, &ProvidersGcp{ProjectID: new("my-project"), Region: new("us-central1")}, versionedResource.Properties.Providers.Gcp)
 	require.NotNil(t, versionedResource.Properties.RecipeParameters)
 	require.Len(t, versionedResource.Properties.RecipeParameters, 1)
 	containerParams, ok := versionedResource.Properties.RecipeParameters["Radius.Compute/containers"]
 	require.True(t, ok)
 	require.Equal(t, true, containerParams.AdditionalProperties["allowPlatformOptions"])
+	require.Equal(t, &RecipeDriftPolicy{Enabled: new(false)}, versionedResource.Properties.RecipeDrift)
 }
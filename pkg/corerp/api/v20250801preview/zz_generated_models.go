@@ -20,6 +20,18 @@ type ApplicationGraphConnection struct {
 	Kind *ConnectionKind
 }
 
+// ApplicationGraphEndpoint - Describes an endpoint of an application graph resource.
+type ApplicationGraphEndpoint struct {
+	// REQUIRED; The name of the endpoint, which is the path of the property of the resource that holds it.
+	Name *string
+
+	// REQUIRED; The URL or address of the endpoint.
+	URL *string
+
+	// REQUIRED; The visibility of the endpoint, either 'public' or 'internal'.
+	Visibility *string
+}
+
 // ApplicationGraphOutputResource - Describes an output resource that comprises an application graph resource.
 type ApplicationGraphOutputResource struct {
 	// REQUIRED; The resource ID.
@@ -60,6 +72,10 @@ type ApplicationGraphResource struct {
 	// resources as added, removed, modified, or unchanged across graphs. Format: 'sha256:{hex}'.
 	DiffHash *string
 
+	// The endpoints of the resource, from the properties marked with the `x-radius-endpoint` annotation in the schema of the resource
+	// type.
+	Endpoints []*ApplicationGraphEndpoint
+
 	// SHA-256 hex of the resource type's icon SVG, matching `iconHash` on the resource-type registry. Null when the type has
 	// no icon registered. The bytes themselves are delivered inline via `ApplicationGraphResponse.icons` when the request specifies
 	// `includeIcons: true`; otherwise clients fetch bytes by hash from the resource-type icon endpoint.
@@ -344,6 +360,10 @@ type EnvironmentProperties struct {
 	// Radius CLI, defaults to Kubernetes in the `default` namespace.
 	Providers *Providers
 
+	// (Optional) Controls how Radius checks the resources deployed by Recipes in this Environment for drift. Drift detection
+	// is enabled by default and does not remediate drift unless `autoRemediate` is set.
+	RecipeDrift *RecipeDriftPolicy
+
 	// (Optional) Resource IDs of the Recipe Packs this Environment uses to provision infrastructure for application resources.
 	// When created with the Radius CLI, defaults to the `default` Recipe Pack in the `default` resource group.
 	RecipePacks []*string
@@ -609,6 +629,9 @@ type Providers struct {
 	// (Optional) Configuration for deploying resources to Azure.
 	Azure *ProvidersAzure
 
+	// (Optional) Configuration for deploying resources to GCP.
+	Gcp *ProvidersGcp
+
 	// (Optional) Configuration for deploying resources to Kubernetes.
 	Kubernetes *ProvidersKubernetes
 }
@@ -635,6 +658,15 @@ type ProvidersAzure struct {
 	ResourceGroupName *string
 }
 
+// ProvidersGcp - The GCP cloud provider definition.
+type ProvidersGcp struct {
+	// REQUIRED; (Required) ID of the GCP project that resources are deployed into.
+	ProjectID *string
+
+	// REQUIRED; (Required) GCP region that resources are deployed into.
+	Region *string
+}
+
 type ProvidersKubernetes struct {
 	// REQUIRED; (Required) Kubernetes namespace that workloads are deployed into.
 	Namespace *string
@@ -663,6 +695,31 @@ type RecipeDefinition struct {
 	PlainHTTP *bool
 }
 
+// RecipeDriftPolicy - Drift detection policy for the resources deployed by Recipes.
+type RecipeDriftPolicy struct {
+	// (Optional) When true, Radius re-applies the Recipe when drift is detected. Defaults to `false` if not specified.
+	AutoRemediate *bool
+
+	// (Optional) When false, Radius does not check the resources deployed by Recipes in this Environment for drift. Defaults
+	// to `true` if not specified.
+	Enabled *bool
+}
+
+// RecipeDriftStatus - The result of a drift check for the resources deployed by a recipe.
+type RecipeDriftStatus struct {
+	// The identifiers of the resources that have drifted.
+	DriftedResources []*string
+
+	// The timestamp of the most recent drift check.
+	LastCheckedTime *time.Time
+
+	// A human readable description of the drift check result.
+	Message *string
+
+	// The drift state of the resources deployed by the recipe.
+	State *RecipeDriftState
+}
+
 // RecipePackProperties - Recipe Pack properties
 type RecipePackProperties struct {
 	// REQUIRED; (Required) The Recipes in this pack, keyed by the resource type each Recipe provisions. Each key is a resource
@@ -799,6 +856,9 @@ type RecipeStatus struct {
 	// REQUIRED; TemplatePath is the path of the recipe consumed by the portable resource upon deployment.
 	TemplatePath *string
 
+	// The result of the most recent drift check for the resources deployed by the recipe.
+	Drift *RecipeDriftStatus
+
 	// TemplateVersion is the version number of the template.
 	TemplateVersion *string
 }
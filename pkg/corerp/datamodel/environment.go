@@ -74,12 +74,14 @@ func (e *EnvironmentRecipeProperties) ResourceTypeName() string {
 	return "Applications.Core/environments"
 }
 
-// Providers represents configs for providers for the environment, eg azure,aws
+// Providers represents configs for providers for the environment, eg azure,aws,gcp
 type Providers struct {
 	// Azure provider information
 	Azure ProvidersAzure `json:"azure"`
 	// AWS provider information
 	AWS ProvidersAWS `json:"aws"`
+	// GCP provider information
+	GCP ProvidersGCP `json:"gcp,omitzero"`
 }
 
 // ProvidersAzure represents the azure provider configs
@@ -93,3 +95,9 @@ type ProvidersAWS struct {
 	// Scope is the target level for deploying the aws resources
 	Scope string `json:"scope,omitempty"`
 }
+
+// ProvidersGCP represents the gcp provider configs
+type ProvidersGCP struct {
+	// Scope is the target level for deploying the gcp resources
+	Scope string `json:"scope,omitempty"`
+}
@@ -56,6 +56,18 @@ type EnvironmentProperties_v20250801preview struct {
 
 	// Simulated indicates if this is a simulated environment.
 	Simulated bool `json:"simulated,omitempty"`
+
+	// RecipeDrift is the drift detection policy for resources deployed by recipes in this environment.
+	RecipeDrift *RecipeDriftPolicy_v20250801preview `json:"recipeDrift,omitempty"`
+}
+
+// RecipeDriftPolicy_v20250801preview represents the drift detection policy for recipe-deployed resources.
+type RecipeDriftPolicy_v20250801preview struct {
+	// Enabled indicates whether drift detection runs. Drift detection is enabled when nil.
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// AutoRemediate indicates whether the recipe is re-applied when drift is detected.
+	AutoRemediate bool `json:"autoRemediate,omitempty"`
 }
 
 // Providers_v20250801preview represents cloud provider configurations for the environment.
@@ -66,6 +78,9 @@ type Providers_v20250801preview struct {
 	// AWS provider configuration
 	AWS *ProvidersAWS_v20250801preview `json:"aws,omitempty"`
 
+	// GCP provider configuration
+	GCP *ProvidersGCP_v20250801preview `json:"gcp,omitempty"`
+
 	// Kubernetes provider configuration
 	Kubernetes *ProvidersKubernetes_v20250801preview `json:"kubernetes,omitempty"`
 }
@@ -96,3 +111,12 @@ type ProvidersAWS_v20250801preview struct {
 	// Region is the AWS region hosting deployed resources.
 	Region string `json:"region"`
 }
+
+// ProvidersGCP_v20250801preview represents the GCP provider configuration.
+type ProvidersGCP_v20250801preview struct {
+	// ProjectID is the GCP project ID hosting deployed resources.
+	ProjectID string `json:"projectId"`
+
+	// Region is the GCP region hosting deployed resources.
+	Region string `json:"region"`
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/cli/clients"
@@ -91,7 +92,10 @@ func ComputeGraphPayload(ctx context.Context, applicationID resources.ID, enviro
 		tenantID = azureTenantID(ctx, clientOptions)
 	}
 
-	return computeGraph(applicationResources, environmentResources, tenantID), nil
+	// The endpoints of resources are found using the schemas of their resource types.
+	endpointFields := getEndpointFields(ctx, slices.Concat(applicationResources, environmentResources), clientOptions)
+
+	return computeGraph(applicationResources, environmentResources, tenantID, endpointFields), nil
 }
 
 var _ ctrl.Controller = (*GetGraph)(nil)
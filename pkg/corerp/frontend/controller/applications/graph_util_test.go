@@ -27,6 +27,7 @@ import (
 	azpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/schema"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/test/testutil"
@@ -163,7 +164,7 @@ func Test_computeGraph(t *testing.T) {
 			expected := []*corerpv20231001preview.ApplicationGraphResource{}
 			testutil.MustUnmarshalFromFile(tt.expectedDataFile, &expected)
 
-			got := computeGraph(appResource, envResource, "")
+			got := computeGraph(appResource, envResource, "", nil)
 			require.ElementsMatch(t, expected, got.Resources)
 		})
 	}
@@ -775,7 +776,7 @@ func Test_computeGraph_azurePortalLinksInGraphJSON(t *testing.T) {
 		},
 	}
 
-	graph := computeGraph([]generated.GenericResource{container}, nil, tenantID)
+	graph := computeGraph([]generated.GenericResource{container}, nil, tenantID, nil)
 	require.Len(t, graph.Resources, 1, "expected exactly one graph resource")
 
 	// Marshal to JSON and re-parse so the assertion actually exercises the wire format
@@ -815,3 +816,117 @@ func Test_computeGraph_azurePortalLinksInGraphJSON(t *testing.T) {
 	require.True(t, hasK8s, "graph JSON must include the Kubernetes output resource")
 	require.Nil(t, k8sPortalURL, "non-Azure output resource must not carry a portalUrl in the graph JSON")
 }
+
+func Test_getEndpointFields(t *testing.T) {
+	summaryBody := `{
+	  "name": "Radius.Compute",
+	  "locations": {"global": {}},
+	  "resourceTypes": {
+	    "routes": {
+	      "defaultApiVersion": "2025-08-01-preview",
+	      "apiVersions": {
+	        "2025-08-01-preview": {
+	          "schema": {
+	            "type": "object",
+	            "properties": {
+	              "url": {"type": "string", "readOnly": true, "x-radius-endpoint": "public"}
+	            }
+	          }
+	        }
+	      }
+	    },
+	    "containers": {
+	      "apiVersions": {
+	        "2025-08-01-preview": {"schema": {"type": "object"}}
+	      }
+	    }
+	  }
+	}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/planes/radius/local/providers/Radius.Compute" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(summaryBody))
+	}))
+	t.Cleanup(server.Close)
+
+	opts := &policy.ClientOptions{
+		ClientOptions: azpolicy.ClientOptions{
+			Transport: server.Client(),
+			Cloud: cloud.Configuration{
+				Services: map[cloud.ServiceName]cloud.ServiceConfiguration{
+					cloud.ResourceManager: {
+						Endpoint: server.URL,
+						Audience: "https://management.core.windows.net",
+					},
+				},
+			},
+			InsecureAllowCredentialWithHTTP: true,
+			Retry: azpolicy.RetryOptions{
+				MaxRetries: -1,
+			},
+		},
+	}
+
+	resourceList := []generated.GenericResource{
+		{ID: to.Ptr("/planes/radius/local/resourceGroups/default/providers/Radius.Compute/routes/frontend")},
+		{ID: to.Ptr("/planes/radius/local/resourceGroups/default/providers/Radius.Compute/containers/frontend")},
+		{ID: to.Ptr("/planes/radius/local/resourceGroups/default/providers/Applications.Core/containers/backend")},
+	}
+
+	got := getEndpointFields(t.Context(), resourceList, opts)
+	require.Equal(t, map[string][]schema.EndpointField{
+		"radius.compute/routes": {{Path: "url", Visibility: schema.EndpointVisibilityPublic}},
+	}, got)
+}
+
+func Test_computeGraph_endpoints(t *testing.T) {
+	const routeID = "/planes/radius/local/resourceGroups/default/providers/Radius.Compute/routes/frontend"
+	const containerID = "/planes/radius/local/resourceGroups/default/providers/Applications.Core/containers/backend"
+
+	route := generated.GenericResource{
+		ID:   to.Ptr(routeID),
+		Name: to.Ptr("frontend"),
+		Type: to.Ptr("Radius.Compute/routes"),
+		Properties: map[string]any{
+			"application": "/planes/radius/local/resourcegroups/default/providers/Applications.Core/applications/myapp",
+			"url":         "https://frontend.example.com",
+			"internalUrls": []any{
+				"http://frontend.default.svc:80",
+			},
+		},
+	}
+	container := generated.GenericResource{
+		ID:   to.Ptr(containerID),
+		Name: to.Ptr("backend"),
+		Type: to.Ptr("Applications.Core/containers"),
+		Properties: map[string]any{
+			"application": "/planes/radius/local/resourcegroups/default/providers/Applications.Core/applications/myapp",
+		},
+	}
+
+	endpointFields := map[string][]schema.EndpointField{
+		"radius.compute/routes": {
+			{Path: "internalUrls", Visibility: schema.EndpointVisibilityInternal},
+			{Path: "url", Visibility: schema.EndpointVisibilityPublic},
+		},
+	}
+
+	graph := computeGraph([]generated.GenericResource{route, container}, nil, "", endpointFields)
+
+	endpointsByID := map[string][]*corerpv20231001preview.ApplicationGraphEndpoint{}
+	for _, resource := range graph.Resources {
+		endpointsByID[to.String(resource.ID)] = resource.Endpoints
+	}
+
+	require.Equal(t, []*corerpv20231001preview.ApplicationGraphEndpoint{
+		{Name: to.Ptr("internalUrls[0]"), Visibility: to.Ptr("internal"), URL: to.Ptr("http://frontend.default.svc:80")},
+		{Name: to.Ptr("url"), Visibility: to.Ptr("public"), URL: to.Ptr("https://frontend.example.com")},
+	}, endpointsByID[routeID])
+	require.Nil(t, endpointsByID[containerID])
+}
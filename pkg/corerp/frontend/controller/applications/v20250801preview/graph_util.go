@@ -37,6 +37,7 @@ import (
 	corerpv20231001preview "github.com/radius-project/radius/pkg/corerp/api/v20231001preview"
 	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
 	"github.com/radius-project/radius/pkg/graph/edges"
+	"github.com/radius-project/radius/pkg/schema"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/to"
 	ucpv20231001preview "github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
@@ -107,7 +108,10 @@ func computeGraphPayload(ctx context.Context, applicationID resources.ID, enviro
 		tenantID = azureTenantID(ctx, clientOptions)
 	}
 
-	return computeGraph(applicationResources, environmentResources, tenantID, dependsOnEdges), nil
+	// The endpoints of resources are found using the schemas of their resource types.
+	endpointFields := getEndpointFields(ctx, slices.Concat(applicationResources, environmentResources), clientOptions)
+
+	return computeGraph(applicationResources, environmentResources, tenantID, endpointFields, dependsOnEdges), nil
 }
 
 // resolver is a function type to resolve appgraph connection.
@@ -309,7 +313,7 @@ func isResourceInEnvironment(resource generated.GenericResource, environmentName
 // will display the results to a human user, so rather than failing to computeGraph the graph, we will return partial
 // results. Each ApplicationGraphResource will have a provisioning state that indicates whether the resource
 // was successfully processed or not.
-func computeGraph(applicationResources []generated.GenericResource, environmentResources []generated.GenericResource, tenantID string, dependsOnEdges map[string][]*corerpv20250801preview.ApplicationGraphConnection) *corerpv20250801preview.ApplicationGraphResponse {
+func computeGraph(applicationResources []generated.GenericResource, environmentResources []generated.GenericResource, tenantID string, endpointFields map[string][]schema.EndpointField, dependsOnEdges map[string][]*corerpv20250801preview.ApplicationGraphConnection) *corerpv20250801preview.ApplicationGraphResponse {
 	if applicationResources == nil && environmentResources == nil {
 		return &corerpv20250801preview.ApplicationGraphResponse{Resources: []*corerpv20250801preview.ApplicationGraphResource{}}
 	}
@@ -378,6 +382,7 @@ func computeGraph(applicationResources []generated.GenericResource, environmentR
 
 		applicationGraphResource.Connections = connections
 		applicationGraphResource.OutputResources = outputResourcesFromAPIData(resource, tenantID)
+		applicationGraphResource.Endpoints = endpointsFromAPIData(resource, endpointFields[strings.ToLower(to.String(applicationGraphResource.Type))])
 		applicationGraphResource.Properties = getResourceTypeSpecificProperties(resource.Properties)
 
 		applicationGraphResourcesByID[*resource.ID] = *applicationGraphResource
@@ -690,6 +695,63 @@ func azureTenantID(ctx context.Context, clientOptions *policy.ClientOptions) str
 	}
 }
 
+// getEndpointFields returns the endpoint fields of the schemas of the resource types of the given resources, keyed by the
+// lowercase resource type. It is best-effort: the resource types of a resource provider whose summary cannot be fetched
+// have no endpoint fields, so the application graph can still be rendered. Errors are logged at debug level.
+func getEndpointFields(ctx context.Context, resourceList []generated.GenericResource, clientOptions *policy.ClientOptions) map[string][]schema.EndpointField {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	providers := map[string]bool{}
+	for _, resource := range resourceList {
+		id, err := resources.ParseResource(to.String(resource.ID))
+		if err != nil {
+			continue
+		}
+		providers[id.ProviderNamespace()] = true
+	}
+	if len(providers) == 0 {
+		return nil
+	}
+
+	client, err := ucpv20231001preview.NewResourceProvidersClient(&aztoken.AnonymousCredential{}, clientOptions)
+	if err != nil {
+		logger.V(ucplog.LevelDebug).Info("Skipping endpoints: failed to construct resource provider client", "error", err.Error())
+		return nil
+	}
+
+	result := map[string][]schema.EndpointField{}
+	for _, provider := range maps.Keys(providers) {
+		summary, err := client.GetProviderSummary(ctx, planeName, provider, nil)
+		if err != nil {
+			logger.V(ucplog.LevelDebug).Info("Skipping endpoints: failed to get resource provider summary", "provider", provider, "error", err.Error())
+			continue
+		}
+
+		for typeName, resourceType := range summary.ResourceTypes {
+			fields := schema.GetEndpointFieldsFromSummary(resourceType)
+			if len(fields) > 0 {
+				result[strings.ToLower(provider+"/"+typeName)] = fields
+			}
+		}
+	}
+
+	return result
+}
+
+// endpointsFromAPIData returns the endpoints of a resource from the values of its endpoint fields.
+func endpointsFromAPIData(resource generated.GenericResource, fields []schema.EndpointField) []*corerpv20250801preview.ApplicationGraphEndpoint {
+	var endpoints []*corerpv20250801preview.ApplicationGraphEndpoint
+	for _, endpoint := range schema.GetEndpoints(resource.Properties, fields) {
+		endpoints = append(endpoints, &corerpv20250801preview.ApplicationGraphEndpoint{
+			Name:       to.Ptr(endpoint.Name),
+			Visibility: to.Ptr(endpoint.Visibility),
+			URL:        to.Ptr(endpoint.URL),
+		})
+	}
+
+	return endpoints
+}
+
 // outputResourcesFromAPIData processes the generic resource representation returned by the Radius API
 // and produces a list of output resources.
 func outputResourcesFromAPIData(resource generated.GenericResource, tenantID string) []*corerpv20250801preview.ApplicationGraphOutputResource {
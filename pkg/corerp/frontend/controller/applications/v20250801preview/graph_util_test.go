@@ -21,6 +21,7 @@ import (
 
 	"github.com/radius-project/radius/pkg/cli/clients_new/generated"
 	corerpv20250801preview "github.com/radius-project/radius/pkg/corerp/api/v20250801preview"
+	"github.com/radius-project/radius/pkg/schema"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -86,6 +87,7 @@ func Test_computeGraph_MergesDependsOnEdges(t *testing.T) {
 			[]generated.GenericResource{container, queue},
 			nil,
 			"",
+			nil,
 			map[string][]*corerpv20250801preview.ApplicationGraphConnection{
 				containerID: {dependsOnEdge},
 			},
@@ -128,6 +130,7 @@ func Test_computeGraph_MergesDependsOnEdges(t *testing.T) {
 			nil,
 			"",
 			nil,
+			nil,
 		)
 		containerNode := findResource(t, graph, containerID)
 		for _, c := range containerNode.Connections {
@@ -145,6 +148,7 @@ func Test_computeGraph_MergesDependsOnEdges(t *testing.T) {
 			[]generated.GenericResource{container, queue, appResource},
 			nil,
 			"",
+			nil,
 			map[string][]*corerpv20250801preview.ApplicationGraphConnection{
 				appID: {{
 					ID:        to.Ptr(queueID),
@@ -163,3 +167,27 @@ func Test_computeGraph_MergesDependsOnEdges(t *testing.T) {
 		}
 	})
 }
+
+func Test_computeGraph_endpoints(t *testing.T) {
+	const routeID = "/planes/radius/local/resourceGroups/default/providers/Radius.Compute/routes/frontend"
+
+	route := generated.GenericResource{
+		ID:   to.Ptr(routeID),
+		Name: to.Ptr("frontend"),
+		Type: to.Ptr("Radius.Compute/routes"),
+		Properties: map[string]any{
+			"application": "/planes/radius/local/resourceGroups/default/providers/Radius.Core/applications/myapp",
+			"url":         "https://frontend.example.com",
+		},
+	}
+
+	endpointFields := map[string][]schema.EndpointField{
+		"radius.compute/routes": {{Path: "url", Visibility: schema.EndpointVisibilityPublic}},
+	}
+
+	graph := computeGraph([]generated.GenericResource{route}, nil, "", endpointFields, nil)
+	require.Len(t, graph.Resources, 1)
+	require.Equal(t, []*corerpv20250801preview.ApplicationGraphEndpoint{
+		{Name: to.Ptr("url"), Visibility: to.Ptr("public"), URL: to.Ptr("https://frontend.example.com")},
+	}, graph.Resources[0].Endpoints)
+}
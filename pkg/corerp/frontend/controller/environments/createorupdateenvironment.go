@@ -63,11 +63,7 @@ func NewCreateOrUpdateEnvironment(opts ctrl.Options) (ctrl.Controller, error) {
 func (e *CreateOrUpdateEnvironment) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
-	newResource, err := e.GetResourceFromRequest(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-	old, etag, err := e.GetResource(ctx, serviceCtx.ResourceID)
+	newResource, old, etag, err := e.GetResourceForUpdate(ctx, req)
 	if err != nil {
 		return nil, err
 	}
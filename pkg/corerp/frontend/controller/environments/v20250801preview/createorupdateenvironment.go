@@ -59,11 +59,7 @@ func NewCreateOrUpdateEnvironmentv20250801preview(opts ctrl.Options) (ctrl.Contr
 // Run creates or updates a Radius.Core/environments resource.
 func (e *CreateOrUpdateEnvironmentv20250801preview) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
-	newResource, err := e.GetResourceFromRequest(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-	old, etag, err := e.GetResource(ctx, serviceCtx.ResourceID)
+	newResource, old, etag, err := e.GetResourceForUpdate(ctx, req)
 	if err != nil {
 		return nil, err
 	}
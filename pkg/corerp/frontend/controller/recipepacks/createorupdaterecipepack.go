@@ -51,11 +51,7 @@ func NewCreateOrUpdateRecipePack(opts ctrl.Options) (ctrl.Controller, error) {
 func (r *CreateOrUpdateRecipePack) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
-	newResource, err := r.GetResourceFromRequest(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-	old, etag, err := r.GetResource(ctx, serviceCtx.ResourceID)
+	newResource, old, etag, err := r.GetResourceForUpdate(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -466,6 +466,21 @@ type Recipe struct {
 	Parameters map[string]any
 }
 
+// RecipeDriftStatus - The result of a drift check for the resources deployed by a recipe.
+type RecipeDriftStatus struct {
+	// The identifiers of the resources that have drifted.
+	DriftedResources []*string
+
+	// The timestamp of the most recent drift check.
+	LastCheckedTime *time.Time
+
+	// A human readable description of the drift check result.
+	Message *string
+
+	// The drift state of the resources deployed by the recipe.
+	State *RecipeDriftState
+}
+
 // RecipeStatus - Recipe status at deployment time for a resource.
 type RecipeStatus struct {
 	// REQUIRED; TemplateKind is the kind of the recipe template used by the portable resource upon deployment.
@@ -474,6 +489,9 @@ type RecipeStatus struct {
 	// REQUIRED; TemplatePath is the path of the recipe consumed by the portable resource upon deployment.
 	TemplatePath *string
 
+	// The result of the most recent drift check for the resources deployed by the recipe.
+	Drift *RecipeDriftStatus
+
 	// TemplateVersion is the version number of the template.
 	TemplateVersion *string
 }
@@ -46,3 +46,9 @@ type DynamicResource struct {
 func (d *DynamicResource) SetAPIVersion(version string) {
 	d.apiVersion = version
 }
+
+// APIVersion returns the API version of the properties of this resource. For a resource converted from the
+// datamodel, this is the API version the resource was stored with.
+func (d *DynamicResource) APIVersion() string {
+	return d.apiVersion
+}
@@ -102,6 +102,7 @@ func (d *DynamicResource) ConvertFrom(src v1.DataModelInterface) error {
 	d.SystemData = fromSystemDataDataModel(dm.SystemData)
 	d.Properties = properties
 	d.Properties["provisioningState"] = fromProvisioningStateDataModel(dm.AsyncProvisioningState)
+	d.apiVersion = dm.InternalMetadata.UpdatedAPIVersion
 
 	return nil
 }
@@ -0,0 +1,307 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/portableresources/processors"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/resources/radius"
+)
+
+// maxWebhookResponseSize is the maximum size in bytes of the response of an action webhook.
+const maxWebhookResponseSize = 1024 * 1024
+
+// ActionController is the async operation controller to run the custom actions of dynamic resources.
+//
+// An action is implemented either by a webhook, which is called with the resource and the input of the action and
+// responds with its output, or by a recipe, which is run with the input of the action as its parameters.
+type ActionController struct {
+	ctrl.BaseController
+	engine              engine.Engine
+	configurationLoader configloader.ConfigurationLoader
+	httpClient          *http.Client
+}
+
+// NewActionController creates a new ActionController.
+func NewActionController(opts ctrl.Options, engine engine.Engine, configurationLoader configloader.ConfigurationLoader, httpClient *http.Client) (ctrl.Controller, error) {
+	return &ActionController{
+		BaseController:      ctrl.NewBaseAsyncController(opts),
+		engine:              engine,
+		configurationLoader: configurationLoader,
+		httpClient:          httpClient,
+	}, nil
+}
+
+// actionWebhookRequest is the body of the request sent to an action webhook.
+type actionWebhookRequest struct {
+	// Action is the name of the action.
+	Action string `json:"action"`
+
+	// Resource is the resource the action is invoked on, in the shape of the API version of the request.
+	Resource actionWebhookResource `json:"resource"`
+
+	// Input is the input of the action.
+	Input map[string]any `json:"input"`
+}
+
+// actionWebhookResource describes the resource an action is invoked on.
+type actionWebhookResource struct {
+	ID         string         `json:"id"`
+	Name       string         `json:"name"`
+	Type       string         `json:"type"`
+	APIVersion string         `json:"apiVersion"`
+	Properties map[string]any `json:"properties"`
+}
+
+// Run runs the action declared by the schema of the request API version and returns its output. A failed action
+// fails the operation, while the resource is restored to its state before the action by the worker.
+func (c *ActionController) Run(ctx context.Context, request *ctrl.Request) (ctrl.Result, error) {
+	operationType, ok := v1.ParseOperationType(request.OperationType)
+	if !ok {
+		return ctrl.Result{}, fmt.Errorf("invalid operation type: %q", request.OperationType)
+	}
+	name, ok := datamodel.ActionName(operationType.Method)
+	if !ok {
+		return ctrl.Result{}, fmt.Errorf("operation type %q is not a custom action", request.OperationType)
+	}
+
+	id, err := resources.ParseResource(request.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	obj, err := c.DatabaseClient().Get(ctx, request.ResourceID)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	resource := &datamodel.DynamicResource{}
+	if err := obj.As(resource); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	schemaData, err := schema.GetSchema(ctx, c.UcpClient(), request.ResourceID, id.Type(), request.APIVersion)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to fetch schema: %w", err)
+	}
+
+	action, err := schema.GetAction(schemaData, name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if action == nil {
+		return ctrl.NewFailedResult(v1.ErrorDetails{
+			Code:    v1.CodeInvalid,
+			Message: fmt.Sprintf("The action %q is not declared by API version %q of resource type %q.", name, request.APIVersion, id.Type()),
+			Target:  request.ResourceID,
+		}), nil
+	}
+
+	// The action sees the resource in the shape of the API version it was invoked with.
+	properties := resource.Properties
+	if properties == nil {
+		properties = map[string]any{}
+	}
+	if resource.InternalMetadata.UpdatedAPIVersion != request.APIVersion {
+		converter, err := schema.NewVersionConverter(ctx, c.UcpClient(), request.ResourceID, id.Type())
+		if err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to fetch API versions: %w", err)
+		}
+		converter.Convert(properties, resource.InternalMetadata.UpdatedAPIVersion, request.APIVersion)
+	}
+
+	input := request.Input
+	if input == nil {
+		input = map[string]any{}
+	}
+
+	var output map[string]any
+	if action.Webhook != "" {
+		output, err = c.invokeWebhook(ctx, action, actionWebhookResource{
+			ID:         request.ResourceID,
+			Name:       id.Name(),
+			Type:       id.Type(),
+			APIVersion: request.APIVersion,
+			Properties: properties,
+		}, input)
+	} else {
+		output, err = c.runRecipe(ctx, action, resource, properties, input)
+	}
+	if err != nil {
+		return ctrl.NewFailedResult(v1.ErrorDetails{
+			Code:    v1.CodeInternal,
+			Message: fmt.Sprintf("The action %q failed: %s", action.Name, err.Error()),
+			Target:  request.ResourceID,
+		}), nil
+	}
+
+	if output != nil {
+		if err := schema.ValidateActionValue(action.Output, output); err != nil {
+			return ctrl.NewFailedResult(v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: fmt.Sprintf("The output of action %q does not match its schema: %s", action.Name, err.Error()),
+				Target:  request.ResourceID,
+			}), nil
+		}
+
+		// The output is returned as the result of the operation, so it never contains write-only fields.
+		schema.RemoveFields(output, schema.ExtractFieldAnnotations(action.Output).WriteOnly)
+	}
+
+	return ctrl.Result{Output: output}, nil
+}
+
+// invokeWebhook calls the webhook of an action and returns the JSON object it responds with. An empty response
+// is an action without output.
+func (c *ActionController) invokeWebhook(ctx context.Context, action *schema.Action, resource actionWebhookResource, input map[string]any) (map[string]any, error) {
+	body, err := json.Marshal(actionWebhookRequest{
+		Action:   action.Name,
+		Resource: resource,
+		Input:    input,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, action.Webhook, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxWebhookResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("webhook responded with status code %d: %s", resp.StatusCode, string(bytes.TrimSpace(data)))
+	}
+
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, nil
+	}
+
+	output := map[string]any{}
+	if err := json.Unmarshal(data, &output); err != nil {
+		return nil, fmt.Errorf("webhook response is not a JSON object: %w", err)
+	}
+
+	return output, nil
+}
+
+// runRecipe runs the named recipe of an action, registered for the resource type in the environment, with the input
+// of the action as its parameters and returns the values of the recipe result. Secrets of the recipe result are not
+// returned since the output is stored with the operation.
+//
+// The resources deployed by the recipe are deleted once it completes, so an action recipe leaves no infrastructure
+// behind. Terraform recipes are not supported because the Terraform state is stored per resource, so the recipe of the
+// action would replace the state of the recipe of the resource and destroy its infrastructure.
+func (c *ActionController) runRecipe(ctx context.Context, action *schema.Action, resource *datamodel.DynamicResource, properties map[string]any, input map[string]any) (map[string]any, error) {
+	if c.engine == nil || c.configurationLoader == nil {
+		return nil, fmt.Errorf("recipes are not supported")
+	}
+
+	metadata := resource.ResourceMetadata()
+
+	// Recipe packs only define one recipe per resource type, so named recipes can only be found in
+	// Applications.Core environments. Running the recipe of the resource type instead would redeploy the resource.
+	envID, err := resources.Parse(metadata.EnvironmentID())
+	if err != nil {
+		return nil, fmt.Errorf("the resource is not linked to a valid environment: %w", err)
+	}
+	if !strings.EqualFold(envID.ProviderNamespace(), radius.NamespaceApplicationsCore) {
+		return nil, fmt.Errorf("recipe %q cannot be found because actions implemented by recipes require an %s environment", action.Recipe, radius.NamespaceApplicationsCore)
+	}
+
+	recipe := recipes.ResourceMetadata{
+		Name:          action.Recipe,
+		EnvironmentID: metadata.EnvironmentID(),
+		ApplicationID: metadata.ApplicationID(),
+		ResourceID:    resource.ID,
+		Properties:    properties,
+		Parameters:    input,
+	}
+
+	definition, err := c.configurationLoader.LoadRecipe(ctx, &recipe)
+	if err != nil {
+		return nil, err
+	}
+	if definition.Driver == recipes.TemplateKindTerraform {
+		return nil, fmt.Errorf("recipe %q is a Terraform recipe, but actions can only be implemented by Bicep recipes", action.Recipe)
+	}
+
+	recipeOutput, err := c.engine.Execute(ctx, engine.ExecuteOptions{
+		BaseOptions: engine.BaseOptions{Recipe: recipe},
+	})
+	if recipeOutput != nil {
+		if deleteErr := c.deleteRecipeResources(ctx, recipe, recipeOutput); deleteErr != nil {
+			return nil, errors.Join(err, deleteErr)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if recipeOutput == nil {
+		return nil, nil
+	}
+
+	return recipeOutput.Values, nil
+}
+
+// deleteRecipeResources deletes the resources deployed by the recipe of an action.
+func (c *ActionController) deleteRecipeResources(ctx context.Context, recipe recipes.ResourceMetadata, recipeOutput *recipes.RecipeOutput) error {
+	outputResources, err := processors.GetOutputResourcesFromRecipe(recipeOutput)
+	if err != nil {
+		return err
+	}
+	if len(outputResources) == 0 {
+		return nil
+	}
+
+	err = c.engine.Delete(ctx, engine.DeleteOptions{
+		BaseOptions:     engine.BaseOptions{Recipe: recipe},
+		OutputResources: outputResources,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete the resources deployed by recipe %q: %w", recipe.Name, err)
+	}
+
+	return nil
+}
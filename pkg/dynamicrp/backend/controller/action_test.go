@@ -0,0 +1,295 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	dynamicrp_dm "github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	actionResourceID = "/planes/radius/local/resourceGroups/test-group/providers/" + recipeResourceType + "/test-resource"
+	actionAPIVersion = "2025-01-01"
+	actionEnvID      = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Core/environments/env"
+	actionJobID      = "/planes/kubernetes/local/namespaces/default/providers/batch/Job/snapshot"
+)
+
+func setupActionController(t *testing.T, actions map[string]any, recipeEngine engine.Engine, configLoader configloader.ConfigurationLoader) *ActionController {
+	db := inmemory.NewClient()
+	err := db.Save(t.Context(), &database.Object{
+		Metadata: database.Metadata{ID: actionResourceID},
+		Data: map[string]any{
+			"id":                actionResourceID,
+			"name":              "test-resource",
+			"type":              recipeResourceType,
+			"updatedApiVersion": actionAPIVersion,
+			"provisioningState": string(v1.ProvisioningStateUpdating),
+			"properties": map[string]any{
+				"environment": actionEnvID,
+				"size":        "L",
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	ucp, err := testActionUCPClientFactory(map[string]any{
+		"type":             "object",
+		"x-radius-actions": actions,
+	})
+	require.NoError(t, err)
+
+	controller, err := NewActionController(ctrl.Options{DatabaseClient: db, UcpClient: ucp}, recipeEngine, configLoader, http.DefaultClient)
+	require.NoError(t, err)
+	return controller.(*ActionController)
+}
+
+// newActionConfigLoader returns a configuration loader that loads recipes with the given driver.
+func newActionConfigLoader(mctrl *gomock.Controller, driver string) *configloader.MockConfigurationLoader {
+	configLoader := configloader.NewMockConfigurationLoader(mctrl)
+	configLoader.EXPECT().
+		LoadRecipe(gomock.Any(), gomock.Any()).
+		Return(&recipes.EnvironmentDefinition{Driver: driver, TemplatePath: "ghcr.io/test/take-snapshot:latest"}, nil).
+		AnyTimes()
+	return configLoader
+}
+
+func newActionRequest(action string, input map[string]any) *ctrl.Request {
+	return &ctrl.Request{
+		ResourceID:    actionResourceID,
+		APIVersion:    actionAPIVersion,
+		OperationType: v1.OperationType{Type: recipeResourceType, Method: dynamicrp_dm.ActionOperationMethod(action)}.String(),
+		Input:         input,
+	}
+}
+
+func Test_ActionController_Webhook(t *testing.T) {
+	var received actionWebhookRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"rotatedAt": "2025-01-01T00:00:00Z", "password": "rotated"}`))
+	}))
+	defer server.Close()
+
+	controller := setupActionController(t, map[string]any{
+		"rotateCredentials": map[string]any{
+			"webhook": server.URL,
+			"output": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"rotatedAt": map[string]any{"type": "string"},
+					"password":  map[string]any{"type": "string", "x-radius-writeonly": true},
+				},
+			},
+		},
+	}, nil, nil)
+
+	result, err := controller.Run(t.Context(), newActionRequest("rotateCredentials", map[string]any{"expiryDays": 30}))
+	require.NoError(t, err)
+	require.Nil(t, result.Error)
+
+	// Write-only fields of the output are not returned as the result of the operation.
+	require.Equal(t, map[string]any{"rotatedAt": "2025-01-01T00:00:00Z"}, result.Output)
+
+	require.Equal(t, "rotateCredentials", received.Action)
+	require.Equal(t, actionResourceID, received.Resource.ID)
+	require.Equal(t, "test-resource", received.Resource.Name)
+	require.Equal(t, "L", received.Resource.Properties["size"])
+	require.Equal(t, map[string]any{"expiryDays": float64(30)}, received.Input)
+}
+
+func Test_ActionController_WebhookError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "credentials are locked", http.StatusConflict)
+	}))
+	defer server.Close()
+
+	controller := setupActionController(t, map[string]any{
+		"rotateCredentials": map[string]any{"webhook": server.URL},
+	}, nil, nil)
+
+	result, err := controller.Run(t.Context(), newActionRequest("rotateCredentials", nil))
+	require.NoError(t, err)
+	require.Equal(t, v1.ProvisioningStateFailed, result.ProvisioningState())
+	require.Equal(t, `The action "rotateCredentials" failed: webhook responded with status code 409: credentials are locked`, result.Error.Message)
+}
+
+func Test_ActionController_InvalidOutput(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"rotatedAt": 1}`))
+	}))
+	defer server.Close()
+
+	controller := setupActionController(t, map[string]any{
+		"rotateCredentials": map[string]any{
+			"webhook": server.URL,
+			"output": map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"rotatedAt": map[string]any{"type": "string"}},
+			},
+		},
+	}, nil, nil)
+
+	result, err := controller.Run(t.Context(), newActionRequest("rotateCredentials", nil))
+	require.NoError(t, err)
+	require.Equal(t, v1.ProvisioningStateFailed, result.ProvisioningState())
+	require.Contains(t, result.Error.Message, `The output of action "rotateCredentials" does not match its schema`)
+}
+
+func Test_ActionController_Recipe(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	recipeEngine := engine.NewMockEngine(mctrl)
+	recipeEngine.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, opts engine.ExecuteOptions) (*recipes.RecipeOutput, error) {
+			require.Equal(t, "take-snapshot", opts.Recipe.Name)
+			require.Equal(t, actionEnvID, opts.Recipe.EnvironmentID)
+			require.Equal(t, actionResourceID, opts.Recipe.ResourceID)
+			require.Equal(t, map[string]any{"label": "nightly"}, opts.Recipe.Parameters)
+			return &recipes.RecipeOutput{
+				Values:    map[string]any{"snapshotId": "snap-1"},
+				Secrets:   map[string]any{"token": "secret"},
+				Resources: []string{actionJobID},
+			}, nil
+		})
+
+	// The resources deployed by the recipe of the action are deleted once it completes.
+	recipeEngine.EXPECT().
+		Delete(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, opts engine.DeleteOptions) error {
+			require.Equal(t, "take-snapshot", opts.Recipe.Name)
+			require.Len(t, opts.OutputResources, 1)
+			require.Equal(t, actionJobID, opts.OutputResources[0].ID.String())
+			return nil
+		})
+
+	controller := setupActionController(t, map[string]any{
+		"snapshot": map[string]any{"recipe": "take-snapshot"},
+	}, recipeEngine, newActionConfigLoader(mctrl, recipes.TemplateKindBicep))
+
+	result, err := controller.Run(t.Context(), newActionRequest("snapshot", map[string]any{"label": "nightly"}))
+	require.NoError(t, err)
+	require.Nil(t, result.Error)
+	require.Equal(t, map[string]any{"snapshotId": "snap-1"}, result.Output)
+}
+
+func Test_ActionController_RecipeDeleteFailure(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	recipeEngine := engine.NewMockEngine(mctrl)
+	recipeEngine.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		Return(&recipes.RecipeOutput{Values: map[string]any{"snapshotId": "snap-1"}, Resources: []string{actionJobID}}, nil)
+	recipeEngine.EXPECT().
+		Delete(gomock.Any(), gomock.Any()).
+		Return(errors.New("job is still running"))
+
+	controller := setupActionController(t, map[string]any{
+		"snapshot": map[string]any{"recipe": "take-snapshot"},
+	}, recipeEngine, newActionConfigLoader(mctrl, recipes.TemplateKindBicep))
+
+	result, err := controller.Run(t.Context(), newActionRequest("snapshot", nil))
+	require.NoError(t, err)
+	require.Equal(t, v1.ProvisioningStateFailed, result.ProvisioningState())
+	require.Contains(t, result.Error.Message, `failed to delete the resources deployed by recipe "take-snapshot": job is still running`)
+}
+
+func Test_ActionController_TerraformRecipe(t *testing.T) {
+	mctrl := gomock.NewController(t)
+
+	// The Terraform state is stored per resource, so the recipe is not run.
+	controller := setupActionController(t, map[string]any{
+		"snapshot": map[string]any{"recipe": "take-snapshot"},
+	}, engine.NewMockEngine(mctrl), newActionConfigLoader(mctrl, recipes.TemplateKindTerraform))
+
+	result, err := controller.Run(t.Context(), newActionRequest("snapshot", nil))
+	require.NoError(t, err)
+	require.Equal(t, v1.ProvisioningStateFailed, result.ProvisioningState())
+	require.Contains(t, result.Error.Message, `recipe "take-snapshot" is a Terraform recipe, but actions can only be implemented by Bicep recipes`)
+}
+
+func Test_ActionController_RecipeRequiresApplicationsCoreEnvironment(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	controller := setupActionController(t, map[string]any{
+		"snapshot": map[string]any{"recipe": "take-snapshot"},
+	}, engine.NewMockEngine(mctrl), configloader.NewMockConfigurationLoader(mctrl))
+
+	// Recipe packs have no named recipes, so the action must not run the recipe of the resource type.
+	obj, err := controller.DatabaseClient().Get(t.Context(), actionResourceID)
+	require.NoError(t, err)
+	obj.Data.(map[string]any)["properties"].(map[string]any)["environment"] = "/planes/radius/local/resourceGroups/test-group/providers/Radius.Core/environments/env"
+	require.NoError(t, controller.DatabaseClient().Save(t.Context(), obj))
+
+	result, err := controller.Run(t.Context(), newActionRequest("snapshot", nil))
+	require.NoError(t, err)
+	require.Equal(t, v1.ProvisioningStateFailed, result.ProvisioningState())
+	require.Contains(t, result.Error.Message, "actions implemented by recipes require an Applications.Core environment")
+}
+
+func Test_ActionController_UndeclaredAction(t *testing.T) {
+	controller := setupActionController(t, map[string]any{
+		"snapshot": map[string]any{"recipe": "take-snapshot"},
+	}, nil, nil)
+
+	result, err := controller.Run(t.Context(), newActionRequest("rotateCredentials", nil))
+	require.NoError(t, err)
+	require.Equal(t, v1.ProvisioningStateFailed, result.ProvisioningState())
+	require.Equal(t, v1.CodeInvalid, result.Error.Code)
+}
+
+// testActionUCPClientFactory creates a fake UCP client factory that returns the given schema for every API version.
+func testActionUCPClientFactory(schema map[string]any) (*v20231001preview.ClientFactory, error) {
+	apiVersionsServer := fake.APIVersionsServer{
+		Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+			resp.SetResponse(http.StatusOK, v20231001preview.APIVersionsClientGetResponse{
+				APIVersionResource: v20231001preview.APIVersionResource{
+					Name: new(apiVersionName),
+					Properties: &v20231001preview.APIVersionProperties{
+						Schema: schema,
+					},
+				},
+			}, nil)
+			return
+		},
+	}
+
+	return v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewAPIVersionsServerTransport(&apiVersionsServer),
+		},
+	})
+}
@@ -20,12 +20,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	ctrl "github.com/radius-project/radius/pkg/armrpc/asyncoperation/controller"
 	"github.com/radius-project/radius/pkg/dynamicrp/backend/processor"
 	"github.com/radius-project/radius/pkg/dynamicrp/backend/secret"
+	dynamicrp_dm "github.com/radius-project/radius/pkg/dynamicrp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/engine"
 	"github.com/radius-project/radius/pkg/schema"
@@ -105,13 +107,17 @@ func (c *DynamicResourceController) selectController(ctx context.Context, reques
 		}
 		return NewRecipeDeleteController(options, c.engine, c.configurationLoader, c.secretMaterializer)
 
-	case v1.OperationPut:
+	case v1.OperationPut, v1.OperationPatch:
+		// PATCH requests are merged into the stored resource by the frontend, so they are processed like PUT.
 		if hasCapability(resourceTypeDetails, datamodel.CapabilityManualResourceProvisioning) {
 			return NewInertPutController(options)
 		}
 		return NewRecipePutController(options, c.engine, c.configurationLoader, c.secretMaterializer)
 
 	default:
+		if _, ok := dynamicrp_dm.ActionName(operationType.Method); ok {
+			return NewActionController(options, c.engine, c.configurationLoader, http.DefaultClient)
+		}
 		return nil, fmt.Errorf("unsupported operation type: %q", request.OperationType)
 	}
 }
@@ -123,7 +129,7 @@ func (c *DynamicResourceController) validateRequestSchema(ctx context.Context, r
 		return err
 	}
 
-	if operationContext.Method != v1.OperationPut {
+	if operationContext.Method != v1.OperationPut && operationContext.Method != v1.OperationPatch {
 		return nil
 	}
 
@@ -132,7 +138,14 @@ func (c *DynamicResourceController) validateRequestSchema(ctx context.Context, r
 		return fmt.Errorf("failed to access and validate resource data: %w", err)
 	}
 
-	schemaData, err := processor.GetSchemaForResourceType(ctx, c.ucp, request.ResourceID, request.APIVersion)
+	// The frontend may have converted the resource to the storage version of its resource type, so validate
+	// against the schema of the version it was stored with.
+	apiVersion := request.APIVersion
+	if updatedAPIVersion, ok := resourceData["updatedApiVersion"].(string); ok && updatedAPIVersion != "" {
+		apiVersion = updatedAPIVersion
+	}
+
+	schemaData, err := processor.GetSchemaForResourceType(ctx, c.ucp, request.ResourceID, apiVersion)
 	if err != nil {
 		if errors.Is(err, processor.ErrNoSchemaFound) {
 			logger := ucplog.FromContextOrDiscard(ctx)
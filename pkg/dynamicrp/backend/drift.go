@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/dynamicrp"
+	"github.com/radius-project/radius/pkg/recipes/drift"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// DriftService runs the recipe drift detector for resources managed by the dynamic-rp.
+type DriftService struct {
+	options *dynamicrp.Options
+}
+
+// NewDriftService creates a new service to run the recipe drift detector for the dynamic-rp.
+func NewDriftService(options *dynamicrp.Options) *DriftService {
+	return &DriftService{options: options}
+}
+
+// Name returns the name of the service used for logging.
+func (s *DriftService) Name() string {
+	return "dynamic-rp recipe drift detector"
+}
+
+// Run runs the service.
+func (s *DriftService) Run(ctx context.Context) error {
+	var interval time.Duration
+	if s.options.Config.RecipeDrift.Interval != "" {
+		var err error
+		interval, err = time.ParseDuration(s.options.Config.RecipeDrift.Interval)
+		if err != nil {
+			return fmt.Errorf("failed to parse recipe drift interval: %w", err)
+		}
+	}
+
+	e, err := s.options.RecipeEngine()
+	if err != nil {
+		return err
+	}
+
+	databaseClient, err := s.options.DatabaseProvider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	ucp, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(s.options.UCP))
+	if err != nil {
+		return err
+	}
+
+	detector := drift.NewDetector(drift.Options{
+		DatabaseClient:      databaseClient,
+		StatusManager:       s.options.StatusManager,
+		Engine:              e,
+		ConfigurationLoader: s.options.Recipes.ConfigurationLoader,
+		ResourceTypes:       listDynamicResourceTypes(ucp.NewResourceProvidersClient()),
+		Interval:            interval,
+	})
+
+	return detector.Run(ctx)
+}
+
+// listDynamicResourceTypes lists the user-defined resource types registered with UCP. Applications.* types are served
+// by the applications-rp, which runs its own drift detector.
+func listDynamicResourceTypes(client *v20231001preview.ResourceProvidersClient) drift.ResourceTypeLister {
+	return func(ctx context.Context) ([]string, error) {
+		resourceTypes := []string{}
+		pager := client.NewListProviderSummariesPager("local", &v20231001preview.ResourceProvidersClientListProviderSummariesOptions{})
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, summary := range page.Value {
+				if summary.Name == nil || strings.HasPrefix(strings.ToLower(*summary.Name), "applications.") {
+					continue
+				}
+
+				for typeName := range summary.ResourceTypes {
+					resourceTypes = append(resourceTypes, *summary.Name+"/"+typeName)
+				}
+			}
+		}
+
+		return resourceTypes, nil
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backend
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/dynamicrp"
+	"github.com/radius-project/radius/pkg/dynamicrp/backend/migration"
+	"github.com/radius-project/radius/pkg/sdk"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// MigrationService runs the migration of resources managed by the dynamic-rp to the storage version of their
+// resource type.
+type MigrationService struct {
+	options *dynamicrp.Options
+}
+
+// NewMigrationService creates a new service to run the storage version migration for the dynamic-rp.
+func NewMigrationService(options *dynamicrp.Options) *MigrationService {
+	return &MigrationService{options: options}
+}
+
+// Name returns the name of the service used for logging.
+func (s *MigrationService) Name() string {
+	return "dynamic-rp storage version migrator"
+}
+
+// Run runs the service.
+func (s *MigrationService) Run(ctx context.Context) error {
+	var interval time.Duration
+	if s.options.Config.StorageMigration.Interval != "" {
+		var err error
+		interval, err = time.ParseDuration(s.options.Config.StorageMigration.Interval)
+		if err != nil {
+			return fmt.Errorf("failed to parse storage migration interval: %w", err)
+		}
+	}
+
+	databaseClient, err := s.options.DatabaseProvider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	ucp, err := v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, sdk.NewClientOptions(s.options.UCP))
+	if err != nil {
+		return err
+	}
+
+	migrator := migration.NewMigrator(migration.Options{
+		DatabaseClient: databaseClient,
+		UCPClient:      ucp,
+		ResourceTypes:  migration.ResourceTypeLister(listDynamicResourceTypes(ucp.NewResourceProvidersClient())),
+		Interval:       interval,
+	})
+
+	return migrator.Run(ctx)
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/hosting"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// Migrator upgrades stored user-defined resources to the storage version of their resource type.
+//
+// Resources are converted to the storage version whenever they are written, so the migrator only has work to do
+// for resources that were stored before the storage version was declared or changed. Migrating them means
+// reads no longer need a conversion, and API versions that are no longer used can be removed from the manifest.
+type Migrator struct {
+	options Options
+}
+
+var _ hosting.Service = (*Migrator)(nil)
+
+// NewMigrator creates a new migrator with the given options.
+func NewMigrator(options Options) *Migrator {
+	if options.RootScope == "" {
+		options.RootScope = DefaultRootScope
+	}
+	if options.Interval <= 0 {
+		options.Interval = DefaultInterval
+	}
+
+	return &Migrator{options: options}
+}
+
+// Name returns the name of the service used for logging.
+func (m *Migrator) Name() string {
+	return "storage version migrator"
+}
+
+// Run runs a migration pass on startup and then on every interval until the context is cancelled.
+func (m *Migrator) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	ticker := time.NewTicker(m.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.MigrateOnce(ctx); err != nil {
+			logger.Error(err, "Storage version migration pass failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// MigrateOnce runs a single migration pass over all resources of the configured resource types.
+func (m *Migrator) MigrateOnce(ctx context.Context) error {
+	resourceTypes, err := m.options.ResourceTypes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list resource types: %w", err)
+	}
+
+	var errs error
+	for _, resourceType := range resourceTypes {
+		errs = errors.Join(errs, m.migrateResourceType(ctx, resourceType))
+	}
+
+	return errs
+}
+
+func (m *Migrator) migrateResourceType(ctx context.Context, resourceType string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	converter, err := schema.NewVersionConverter(ctx, m.options.UCPClient, m.options.RootScope, resourceType)
+	if err != nil {
+		return fmt.Errorf("failed to fetch API versions of resource type %q: %w", resourceType, err)
+	}
+
+	// Resource types without a storage version are stored as they were sent.
+	if converter.StorageVersion() == "" {
+		return nil
+	}
+
+	query := database.Query{
+		RootScope:      m.options.RootScope,
+		ScopeRecursive: true,
+		ResourceType:   resourceType,
+	}
+
+	migrated := 0
+	paginationToken := ""
+	for {
+		result, err := m.options.DatabaseClient.Query(ctx, query, database.WithPaginationToken(paginationToken))
+		if err != nil {
+			return fmt.Errorf("failed to query resources of type %q: %w", resourceType, err)
+		}
+
+		for i := range result.Items {
+			// A failure for one resource should not prevent the others from being migrated.
+			ok, err := m.migrateResource(ctx, &result.Items[i], converter)
+			if err != nil {
+				logger.Error(err, "Failed to migrate resource to storage version", "resourceID", result.Items[i].ID)
+			} else if ok {
+				migrated++
+			}
+		}
+
+		if result.PaginationToken == "" {
+			break
+		}
+		paginationToken = result.PaginationToken
+	}
+
+	if migrated > 0 {
+		logger.Info("Migrated resources to storage version",
+			"resourceType", resourceType, "storageVersion", converter.StorageVersion(), "count", migrated)
+	}
+
+	return nil
+}
+
+// migrateResource converts a stored resource to the storage version. It returns true if the resource was updated.
+func (m *Migrator) migrateResource(ctx context.Context, obj *database.Object, converter *schema.VersionConverter) (bool, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Work on the raw data so that fields unknown to the data model are preserved.
+	data := map[string]any{}
+	if err := obj.As(&data); err != nil {
+		return false, err
+	}
+
+	apiVersion, _ := data["updatedApiVersion"].(string)
+	if apiVersion == "" || apiVersion == converter.StorageVersion() {
+		return false, nil
+	}
+
+	// Resources with an operation in progress are migrated on the next pass, or converted by the operation itself.
+	provisioningState, _ := data["provisioningState"].(string)
+	if !v1.ProvisioningState(provisioningState).IsTerminal() {
+		return false, nil
+	}
+
+	if properties, ok := data["properties"].(map[string]any); ok {
+		converter.Convert(properties, apiVersion, converter.StorageVersion())
+	}
+	data["updatedApiVersion"] = converter.StorageVersion()
+
+	obj.Data = data
+	err := m.options.DatabaseClient.Save(ctx, obj, database.WithETag(obj.ETag))
+	if errors.Is(err, &database.ErrConcurrency{}) {
+		// The resource was updated since it was read, which converted it to the storage version.
+		logger.Info("Resource changed during storage version migration, skipping", "resourceID", obj.ID)
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+)
+
+const (
+	testResourceType   = "Foo.Bar/myResources"
+	testResourceID     = "/planes/radius/local/resourceGroups/test-rg/providers/Foo.Bar/myResources/test"
+	testOldAPIVersion  = "2025-01-01"
+	testStorageVersion = "2025-06-01"
+)
+
+func setup(t *testing.T, storageVersion bool) (*inmemory.Client, *Migrator) {
+	storageSchema := map[string]any{"type": "object"}
+	if storageVersion {
+		storageSchema["x-radius-storage-version"] = true
+	}
+
+	ucpClient, err := testUCPClientFactory([]*v20231001preview.APIVersionResource{
+		{
+			Name: new(testOldAPIVersion),
+			Properties: &v20231001preview.APIVersionProperties{
+				Schema: map[string]any{
+					"type": "object",
+					"x-radius-conversion": map[string]any{
+						"renamed": map[string]any{"size": "capacity"},
+						"added":   map[string]any{"tier": "standard"},
+					},
+				},
+			},
+		},
+		{
+			Name: new(testStorageVersion),
+			Properties: &v20231001preview.APIVersionProperties{
+				Schema: storageSchema,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	db := inmemory.NewClient()
+	migrator := NewMigrator(Options{
+		DatabaseClient: db,
+		UCPClient:      ucpClient,
+		ResourceTypes: func(ctx context.Context) ([]string, error) {
+			return []string{testResourceType}, nil
+		},
+	})
+
+	return db, migrator
+}
+
+func saveResource(t *testing.T, db database.Client, apiVersion string, provisioningState v1.ProvisioningState) {
+	err := db.Save(t.Context(), &database.Object{
+		Metadata: database.Metadata{ID: testResourceID},
+		Data: map[string]any{
+			"id":                testResourceID,
+			"type":              testResourceType,
+			"updatedApiVersion": apiVersion,
+			"provisioningState": string(provisioningState),
+			"properties": map[string]any{
+				"environment": "env",
+				"size":        "L",
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func getResource(t *testing.T, db database.Client) map[string]any {
+	obj, err := db.Get(t.Context(), testResourceID)
+	require.NoError(t, err)
+
+	data := map[string]any{}
+	require.NoError(t, obj.As(&data))
+	return data
+}
+
+func TestMigrateOnce_MigratesToStorageVersion(t *testing.T) {
+	db, migrator := setup(t, true)
+	saveResource(t, db, testOldAPIVersion, v1.ProvisioningStateSucceeded)
+
+	require.NoError(t, migrator.MigrateOnce(t.Context()))
+
+	data := getResource(t, db)
+	require.Equal(t, testStorageVersion, data["updatedApiVersion"])
+	require.Equal(t, map[string]any{
+		"environment": "env",
+		"capacity":    "L",
+		"tier":        "standard",
+	}, data["properties"])
+}
+
+func TestMigrateOnce_SkipsInProgressResources(t *testing.T) {
+	db, migrator := setup(t, true)
+	saveResource(t, db, testOldAPIVersion, v1.ProvisioningStateUpdating)
+
+	require.NoError(t, migrator.MigrateOnce(t.Context()))
+
+	data := getResource(t, db)
+	require.Equal(t, testOldAPIVersion, data["updatedApiVersion"])
+	require.Equal(t, "L", data["properties"].(map[string]any)["size"])
+}
+
+func TestMigrateOnce_NoStorageVersion(t *testing.T) {
+	db, migrator := setup(t, false)
+	saveResource(t, db, testOldAPIVersion, v1.ProvisioningStateSucceeded)
+
+	require.NoError(t, migrator.MigrateOnce(t.Context()))
+
+	data := getResource(t, db)
+	require.Equal(t, testOldAPIVersion, data["updatedApiVersion"])
+}
+
+func TestMigrateOnce_ResourceTypeListError(t *testing.T) {
+	_, migrator := setup(t, true)
+	migrator.options.ResourceTypes = func(ctx context.Context) ([]string, error) {
+		return nil, errors.New("boom")
+	}
+
+	err := migrator.MigrateOnce(t.Context())
+	require.ErrorContains(t, err, "failed to list resource types: boom")
+}
+
+// testUCPClientFactory creates a fake UCP client factory that lists the given API versions.
+func testUCPClientFactory(apiVersions []*v20231001preview.APIVersionResource) (*v20231001preview.ClientFactory, error) {
+	apiVersionsServer := fake.APIVersionsServer{
+		NewListPager: func(planeName, resourceProviderName, resourceTypeName string, options *v20231001preview.APIVersionsClientListOptions) (resp azfake.PagerResponder[v20231001preview.APIVersionsClientListResponse]) {
+			resp.AddPage(http.StatusOK, v20231001preview.APIVersionsClientListResponse{
+				APIVersionResourceListResult: v20231001preview.APIVersionResourceListResult{
+					Value: apiVersions,
+				},
+			}, nil)
+			return
+		},
+	}
+
+	return v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewAPIVersionsServerTransport(&apiVersionsServer),
+		},
+	})
+}
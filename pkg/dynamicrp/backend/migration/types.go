@@ -0,0 +1,54 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package migration
+
+import (
+	"context"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+const (
+	// DefaultInterval is the default interval between migration passes.
+	DefaultInterval = 24 * time.Hour
+
+	// DefaultRootScope is the default root scope queried for stored resources.
+	DefaultRootScope = "/planes/radius/local"
+)
+
+// ResourceTypeLister returns the fully-qualified resource types whose stored resources are migrated.
+type ResourceTypeLister func(ctx context.Context) ([]string, error)
+
+// Options holds the dependencies and settings of the migrator.
+type Options struct {
+	// DatabaseClient is the client used to read and update resources.
+	DatabaseClient database.Client
+
+	// UCPClient is used to fetch the API versions of each resource type.
+	UCPClient *v20231001preview.ClientFactory
+
+	// ResourceTypes returns the resource types to migrate.
+	ResourceTypes ResourceTypeLister
+
+	// RootScope is the root scope queried for resources. Defaults to DefaultRootScope.
+	RootScope string
+
+	// Interval is the interval between migration passes. Defaults to DefaultInterval.
+	Interval time.Duration
+}
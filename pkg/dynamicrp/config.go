@@ -60,12 +60,22 @@ type Config struct {
 	// Queue is the configuration for the message queue.
 	Queue queueprovider.QueueProviderOptions `yaml:"queueProvider"`
 
+	// RecipeCache is the configuration for the cache of recipe templates, modules and providers.
+	RecipeCache hostoptions.RecipeCacheOptions `yaml:"recipeCache"`
+
+	// RecipeDrift is the configuration for drift detection of recipe-deployed resources.
+	RecipeDrift hostoptions.RecipeDriftOptions `yaml:"recipeDrift"`
+
 	// Secrets is the configuration for the secret storage system.
 	Secrets secretprovider.SecretProviderOptions `yaml:"secretProvider"`
 
 	// Server is the configuration for the HTTP server.
 	Server hostoptions.ServerOptions `yaml:"server"`
 
+	// StorageMigration is the configuration for the migration of stored resources to the storage version of
+	// their resource type.
+	StorageMigration hostoptions.StorageMigrationOptions `yaml:"storageMigration"`
+
 	// Terraform configures properties for the Terraform recipe driver.
 	Terraform hostoptions.TerraformOptions `yaml:"terraform"`
 
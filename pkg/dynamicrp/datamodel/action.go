@@ -0,0 +1,42 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+// actionMethodPrefix is the prefix of the operation method of a custom action, followed by the action name.
+const actionMethodPrefix = "ACTION"
+
+// ActionOperationMethod returns the operation method used to process the custom action with the given name.
+func ActionOperationMethod(action string) v1.OperationMethod {
+	return v1.OperationMethod(actionMethodPrefix + strings.ToUpper(action))
+}
+
+// ActionName returns the upper-case name of the custom action processed by the given operation method. It returns
+// false if the method is not a custom action.
+func ActionName(method v1.OperationMethod) (string, bool) {
+	name, ok := strings.CutPrefix(string(method), actionMethodPrefix)
+	if !ok || name == "" {
+		return "", false
+	}
+
+	return name, true
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+type conversionUpdateFilter controller.UpdateFilter[datamodel.DynamicResource]
+
+// makeConversionFilter creates an UpdateFilter that converts the resource's Properties from the request API version
+// to the storage version of the resource type before saving to the database.
+//
+// The resource's UpdatedAPIVersion is set to the storage version so that the backend, the encryption filter and
+// the read path use the schema the properties were stored with. Fields of the storage version that the request
+// API version cannot express keep their stored values. Resource types without a storage version are stored as
+// they were sent.
+func makeConversionFilter(ucpClient *v20231001preview.ClientFactory) conversionUpdateFilter {
+	return func(
+		ctx context.Context,
+		newResource *datamodel.DynamicResource,
+		oldResource *datamodel.DynamicResource,
+		options *controller.Options,
+	) (rest.Response, error) {
+		return convertToStorageVersion(ctx, newResource, oldResource, ucpClient)
+	}
+}
+
+// makeConversionPatchFilter creates a PatchFilter that converts the existing resource from the version it was
+// stored with to the request API version, so that the merge patch applies to the shape the client knows.
+func makeConversionPatchFilter(ucpClient *v20231001preview.ClientFactory) controller.PatchFilter[datamodel.DynamicResource] {
+	return func(ctx context.Context, base *datamodel.DynamicResource, options *controller.Options) error {
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+		if base.InternalMetadata.UpdatedAPIVersion == serviceCtx.APIVersion {
+			return nil
+		}
+
+		converter, err := schema.NewVersionConverter(ctx, ucpClient, serviceCtx.ResourceID.String(), serviceCtx.ResourceID.Type())
+		if err != nil {
+			return fmt.Errorf("failed to fetch API versions: %w", err)
+		}
+
+		converter.Convert(base.Properties, base.InternalMetadata.UpdatedAPIVersion, serviceCtx.APIVersion)
+		base.InternalMetadata.UpdatedAPIVersion = serviceCtx.APIVersion
+		return nil
+	}
+}
+
+// convertToStorageVersion converts the resource to the storage version of its resource type.
+func convertToStorageVersion(
+	ctx context.Context,
+	newResource *datamodel.DynamicResource,
+	oldResource *datamodel.DynamicResource,
+	ucpClient *v20231001preview.ClientFactory,
+) (rest.Response, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	resourceID := serviceCtx.ResourceID.String()
+	resourceType := serviceCtx.ResourceID.Type()
+	apiVersion := serviceCtx.APIVersion
+
+	converter, err := schema.NewVersionConverter(ctx, ucpClient, resourceID, resourceType)
+	if err != nil {
+		logger.Error(err, "Failed to fetch API versions for conversion",
+			"resourceType", resourceType, "apiVersion", apiVersion)
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: "Failed to fetch API versions to convert the resource to its storage version",
+			},
+		}), nil
+	}
+
+	storageVersion := converter.StorageVersion()
+	if storageVersion == "" || storageVersion == apiVersion {
+		return nil, nil
+	}
+
+	converter.Convert(newResource.Properties, apiVersion, storageVersion)
+	if oldResource != nil && oldResource.InternalMetadata.UpdatedAPIVersion == storageVersion {
+		converter.Preserve(newResource.Properties, oldResource.Properties, apiVersion)
+	}
+	newResource.InternalMetadata.UpdatedAPIVersion = storageVersion
+
+	logger.V(ucplog.LevelDebug).Info("Converted resource to storage version",
+		"apiVersion", apiVersion, "storageVersion", storageVersion, "resourceID", resourceID)
+
+	return nil, nil
+}
+
+// withRequestAPIVersionResponse wraps the factory of a PUT, PATCH or DELETE controller, so that the resource it
+// responds with is in the shape of the request API version, like GET.
+func withRequestAPIVersionResponse(
+	ucpClient *v20231001preview.ClientFactory,
+	factory func(opts controller.Options) (controller.Controller, error),
+) func(opts controller.Options) (controller.Controller, error) {
+	return func(opts controller.Options) (controller.Controller, error) {
+		wrapped, err := factory(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ConversionResponse{Controller: wrapped, ucpClient: ucpClient}, nil
+	}
+}
+
+// ConversionResponse is a controller that converts the resource returned by the controller it wraps to the request
+// API version.
+type ConversionResponse struct {
+	controller.Controller
+	ucpClient *v20231001preview.ClientFactory
+}
+
+// Run runs the wrapped controller, and converts the resource in its response to the request API version.
+//
+// PUT and PATCH respond with the resource after it was converted to its storage version by the conversion filter,
+// and DELETE responds with the resource as it was stored.
+func (c *ConversionResponse) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	response, err := c.Controller.Run(ctx, w, req)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	resource := asyncResponseResource(response)
+	if resource == nil || resource.APIVersion() == "" || resource.APIVersion() == serviceCtx.APIVersion {
+		return response, nil
+	}
+
+	resourceID := serviceCtx.ResourceID.String()
+	resourceType := serviceCtx.ResourceID.Type()
+
+	converter, err := schema.NewVersionConverter(ctx, c.ucpClient, resourceID, resourceType)
+	if err != nil {
+		ucplog.FromContextOrDiscard(ctx).Error(err, "Failed to fetch API versions for response conversion",
+			"resourceType", resourceType, "apiVersion", serviceCtx.APIVersion)
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: "Failed to fetch API versions to convert the resource",
+			},
+		}), nil
+	}
+
+	converter.Convert(resource.Properties, resource.APIVersion(), serviceCtx.APIVersion)
+	resource.SetAPIVersion(serviceCtx.APIVersion)
+
+	return response, nil
+}
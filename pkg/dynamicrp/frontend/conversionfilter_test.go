@@ -0,0 +1,349 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/dynamicrp/api"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testStorageAPIVersion = "2025-06-01"
+
+// testVersionSchemas declares testAPIVersion with a conversion to the storage version testStorageAPIVersion.
+var testVersionSchemas = map[string]map[string]any{
+	testAPIVersion: {
+		"type": "object",
+		"properties": map[string]any{
+			"size": map[string]any{"type": "string"},
+		},
+		"x-radius-conversion": map[string]any{
+			"renamed": map[string]any{"size": "capacity"},
+			"added":   map[string]any{"tier": "standard"},
+		},
+	},
+	testStorageAPIVersion: {
+		"type":                     "object",
+		"x-radius-storage-version": true,
+		"properties": map[string]any{
+			"capacity": map[string]any{"type": "string"},
+			"tier":     map[string]any{"type": "string"},
+			"password": map[string]any{"type": "string", "x-radius-sensitive": true},
+		},
+	},
+}
+
+func TestMakeConversionFilter(t *testing.T) {
+	t.Run("converts to the storage version", func(t *testing.T) {
+		ucpClient, err := createFakeUCPClientFactoryWithVersions(testVersionSchemas)
+		require.NoError(t, err)
+
+		resource := &datamodel.DynamicResource{
+			BaseResource: v1.BaseResource{
+				InternalMetadata: v1.InternalMetadata{UpdatedAPIVersion: testAPIVersion},
+			},
+			Properties: map[string]any{"size": "L"},
+		}
+
+		response, err := makeConversionFilter(ucpClient)(createTestContext(t), resource, nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+		require.Equal(t, testStorageAPIVersion, resource.InternalMetadata.UpdatedAPIVersion)
+		require.Equal(t, map[string]any{"capacity": "L", "tier": "standard"}, resource.Properties)
+	})
+
+	t.Run("keeps stored fields the request version cannot express", func(t *testing.T) {
+		ucpClient, err := createFakeUCPClientFactoryWithVersions(testVersionSchemas)
+		require.NoError(t, err)
+
+		oldResource := &datamodel.DynamicResource{
+			BaseResource: v1.BaseResource{
+				InternalMetadata: v1.InternalMetadata{UpdatedAPIVersion: testStorageAPIVersion},
+			},
+			Properties: map[string]any{"capacity": "M", "tier": "premium"},
+		}
+		resource := &datamodel.DynamicResource{
+			BaseResource: v1.BaseResource{
+				InternalMetadata: v1.InternalMetadata{UpdatedAPIVersion: testAPIVersion},
+			},
+			Properties: map[string]any{"size": "L"},
+		}
+
+		response, err := makeConversionFilter(ucpClient)(createTestContext(t), resource, oldResource, nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+		require.Equal(t, map[string]any{"capacity": "L", "tier": "premium"}, resource.Properties)
+	})
+
+	t.Run("no storage version", func(t *testing.T) {
+		ucpClient, err := testUCPClientFactoryNoSensitiveFields()
+		require.NoError(t, err)
+
+		resource := &datamodel.DynamicResource{
+			BaseResource: v1.BaseResource{
+				InternalMetadata: v1.InternalMetadata{UpdatedAPIVersion: testAPIVersion},
+			},
+			Properties: map[string]any{"size": "L"},
+		}
+
+		response, err := makeConversionFilter(ucpClient)(createTestContext(t), resource, nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+		require.Equal(t, testAPIVersion, resource.InternalMetadata.UpdatedAPIVersion)
+		require.Equal(t, map[string]any{"size": "L"}, resource.Properties)
+	})
+
+	t.Run("fetch error", func(t *testing.T) {
+		ucpClient, err := testUCPClientFactoryWithError()
+		require.NoError(t, err)
+
+		resource := &datamodel.DynamicResource{
+			Properties: map[string]any{"size": "L"},
+		}
+
+		response, err := makeConversionFilter(ucpClient)(createTestContext(t), resource, nil, nil)
+		require.NoError(t, err)
+
+		errorResponse, ok := response.(*rest.InternalServerErrorResponse)
+		require.True(t, ok)
+		require.Equal(t, v1.CodeInternal, errorResponse.Body.Error.Code)
+		require.Equal(t, map[string]any{"size": "L"}, resource.Properties)
+	})
+}
+
+func TestMakeUpdateFilters_EncryptsStorageVersion(t *testing.T) {
+	ucpClient, err := createFakeUCPClientFactoryWithVersions(testVersionSchemas)
+	require.NoError(t, err)
+
+	// 'password' is only sensitive in the storage version, so it is only encrypted after conversion.
+	resource := &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			InternalMetadata: v1.InternalMetadata{UpdatedAPIVersion: testAPIVersion},
+		},
+		Properties: map[string]any{"size": "L", "password": "secret123"},
+	}
+	filters := makeUpdateFilters(
+		makeDefaultsFilter(ucpClient),
+		makeFieldAnnotationsFilter(ucpClient),
+		makeValidationFilter(ucpClient),
+		makeConversionFilter(ucpClient),
+		makeEncryptionFilter(ucpClient, createTestHandler(t)),
+	)
+	for _, filter := range filters {
+		response, err := filter(createTestContext(t), resource, nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+	}
+
+	require.Equal(t, "L", resource.Properties["capacity"])
+	encryptedPassword, ok := resource.Properties["password"].(map[string]any)
+	require.True(t, ok, "password should be encrypted with the storage version schema")
+	require.Contains(t, encryptedPassword, "encrypted")
+}
+
+func TestMakeConversionPatchFilter(t *testing.T) {
+	ucpClient, err := createFakeUCPClientFactoryWithVersions(testVersionSchemas)
+	require.NoError(t, err)
+
+	base := &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			InternalMetadata: v1.InternalMetadata{UpdatedAPIVersion: testStorageAPIVersion},
+		},
+		Properties: map[string]any{"capacity": "L", "tier": "premium"},
+	}
+
+	err = makeConversionPatchFilter(ucpClient)(createTestContext(t), base, nil)
+	require.NoError(t, err)
+	require.Equal(t, testAPIVersion, base.InternalMetadata.UpdatedAPIVersion)
+	require.Equal(t, map[string]any{"size": "L"}, base.Properties)
+}
+
+func TestGetResourceWithRedaction_ConvertsToRequestVersion(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	resource := newGetTestDynamicResource(v1.ProvisioningStateSucceeded, map[string]any{
+		"capacity": "L",
+		"tier":     "premium",
+	})
+	resource.InternalMetadata.UpdatedAPIVersion = testStorageAPIVersion
+
+	storeObject := rpctest.FakeStoreObject(resource)
+	storeObject.Metadata = database.Metadata{ID: testResourceID, ETag: "etag-1"}
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), testResourceID).
+		Return(storeObject, nil)
+
+	ucpClient, err := createFakeUCPClientFactoryWithVersions(testVersionSchemas)
+	require.NoError(t, err)
+
+	c := newTestGetController(t, databaseClient, ucpClient)
+
+	req, err := http.NewRequest(http.MethodGet, testGetURL, nil)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+	w := httptest.NewRecorder()
+
+	resp, err := c.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	var body map[string]any
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&body))
+	properties, ok := body["properties"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "L", properties["size"])
+	require.NotContains(t, properties, "capacity")
+	require.NotContains(t, properties, "tier")
+}
+
+func createFakeUCPClientFactoryWithVersions(schemas map[string]map[string]any) (*v20231001preview.ClientFactory, error) {
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	apiVersionsServer := fake.APIVersionsServer{
+		Get: func(ctx context.Context, planeName, resourceProviderName, resourceTypeName, apiVersionName string, options *v20231001preview.APIVersionsClientGetOptions) (resp azfake.Responder[v20231001preview.APIVersionsClientGetResponse], errResp azfake.ErrorResponder) {
+			schema, ok := schemas[apiVersionName]
+			if !ok {
+				errResp.SetResponseError(http.StatusNotFound, "NotFound")
+				return
+			}
+
+			resp.SetResponse(http.StatusOK, v20231001preview.APIVersionsClientGetResponse{
+				APIVersionResource: v20231001preview.APIVersionResource{
+					Name: new(apiVersionName),
+					Properties: &v20231001preview.APIVersionProperties{
+						Schema: schema,
+					},
+				},
+			}, nil)
+			return
+		},
+		NewListPager: func(planeName, resourceProviderName, resourceTypeName string, options *v20231001preview.APIVersionsClientListOptions) (resp azfake.PagerResponder[v20231001preview.APIVersionsClientListResponse]) {
+			page := v20231001preview.APIVersionsClientListResponse{}
+			for _, name := range names {
+				page.Value = append(page.Value, &v20231001preview.APIVersionResource{
+					Name: new(name),
+					Properties: &v20231001preview.APIVersionProperties{
+						Schema: schemas[name],
+					},
+				})
+			}
+			resp.AddPage(http.StatusOK, page, nil)
+			return
+		},
+	}
+
+	return v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewAPIVersionsServerTransport(&apiVersionsServer),
+		},
+	})
+}
+
+func TestConversionResponse(t *testing.T) {
+	ucpClient, err := createFakeUCPClientFactoryWithVersions(testFieldAnnotationSchemas)
+	require.NoError(t, err)
+
+	run := func(t *testing.T, response rest.Response) rest.Response {
+		factory := withRequestAPIVersionResponse(ucpClient, func(opts ctrl.Options) (ctrl.Controller, error) {
+			return &fakeController{response: response}, nil
+		})
+		controller, err := factory(ctrl.Options{})
+		require.NoError(t, err)
+
+		result, err := controller.Run(createTestContext(t), nil, nil)
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("converts to the request API version", func(t *testing.T) {
+		response := newTestResourceResponse(t, testStorageAPIVersion, map[string]any{"capacity": "L", "replicas": float64(2)})
+
+		result := run(t, response)
+		require.Same(t, response, result)
+
+		resource := response.Body.(*api.DynamicResource)
+		require.Equal(t, map[string]any{"size": "L", "replicas": float64(2), "provisioningState": "Succeeded"}, resource.Properties)
+		require.Equal(t, testAPIVersion, resource.APIVersion())
+	})
+
+	t.Run("request API version is returned unchanged", func(t *testing.T) {
+		response := newTestResourceResponse(t, testAPIVersion, map[string]any{"size": "L"})
+
+		run(t, response)
+		require.Equal(t, map[string]any{"size": "L", "provisioningState": "Succeeded"}, response.Body.(*api.DynamicResource).Properties)
+	})
+
+	t.Run("other responses are returned unchanged", func(t *testing.T) {
+		response := rest.NewBadRequestResponse("invalid")
+		require.Same(t, response, run(t, response))
+	})
+
+	t.Run("fetch error", func(t *testing.T) {
+		ucpClient, err := testUCPClientFactoryWithError()
+		require.NoError(t, err)
+
+		controller := &ConversionResponse{
+			Controller: &fakeController{response: newTestResourceResponse(t, testStorageAPIVersion, map[string]any{"capacity": "L"})},
+			ucpClient:  ucpClient,
+		}
+		result, err := controller.Run(createTestContext(t), nil, nil)
+		require.NoError(t, err)
+
+		errorResponse, ok := result.(*rest.InternalServerErrorResponse)
+		require.True(t, ok)
+		require.Equal(t, v1.CodeInternal, errorResponse.Body.Error.Code)
+	})
+
+	t.Run("write-only fields are removed after the conversion", func(t *testing.T) {
+		factory := withoutWriteOnlyFields(ucpClient, withRequestAPIVersionResponse(ucpClient, func(opts ctrl.Options) (ctrl.Controller, error) {
+			return &fakeController{response: newTestResourceResponse(t, testStorageAPIVersion, map[string]any{"capacity": "L", "password": "secret123"})}, nil
+		}))
+		controller, err := factory(ctrl.Options{})
+		require.NoError(t, err)
+
+		result, err := controller.Run(createTestContext(t), nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"size": "L", "provisioningState": "Succeeded"}, result.(*rest.AsyncOperationResponse).Body.(*api.DynamicResource).Properties)
+	})
+}
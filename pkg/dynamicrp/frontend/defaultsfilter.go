@@ -32,7 +32,8 @@ type defaultsUpdateFilter controller.UpdateFilter[datamodel.DynamicResource]
 
 // makeDefaultsFilter applies schema defaults before a resource is saved.
 //
-// PUT replaces the resource, so omitted properties use current defaults instead of old values.
+// PUT replaces the resource, so omitted properties use current defaults instead of old values. PATCH merges the
+// request into the existing resource first, so only properties that are still missing after the merge use defaults.
 func makeDefaultsFilter(ucpClient *v20231001preview.ClientFactory) defaultsUpdateFilter {
 	return func(
 		ctx context.Context,
@@ -46,11 +47,18 @@ func makeDefaultsFilter(ucpClient *v20231001preview.ClientFactory) defaultsUpdat
 
 func makeUpdateFilters(
 	defaultsFilter defaultsUpdateFilter,
+	fieldAnnotationsFilter fieldAnnotationsUpdateFilter,
+	validationFilter validationUpdateFilter,
+	conversionFilter conversionUpdateFilter,
 	encryptionFilter encryptionUpdateFilter,
 ) []controller.UpdateFilter[datamodel.DynamicResource] {
-	// Distinct types prevent callers from passing encryption first.
+	// Distinct types prevent callers from passing the filters out of order. Defaults, field annotations and
+	// validation rules use the request schema, and encryption uses the storage schema.
 	return []controller.UpdateFilter[datamodel.DynamicResource]{
 		controller.UpdateFilter[datamodel.DynamicResource](defaultsFilter),
+		controller.UpdateFilter[datamodel.DynamicResource](fieldAnnotationsFilter),
+		controller.UpdateFilter[datamodel.DynamicResource](validationFilter),
+		controller.UpdateFilter[datamodel.DynamicResource](conversionFilter),
 		controller.UpdateFilter[datamodel.DynamicResource](encryptionFilter),
 	}
 }
@@ -90,9 +90,16 @@ func TestMakeUpdateFilters_DefaultsBeforeEncryption(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	resource := &datamodel.DynamicResource{}
+	resource := &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			InternalMetadata: v1.InternalMetadata{UpdatedAPIVersion: testAPIVersion},
+		},
+	}
 	filters := makeUpdateFilters(
 		makeDefaultsFilter(ucpClient),
+		makeFieldAnnotationsFilter(ucpClient),
+		makeValidationFilter(ucpClient),
+		makeConversionFilter(ucpClient),
 		makeEncryptionFilter(ucpClient, createTestHandler(t)),
 	)
 	for _, filter := range filters {
@@ -66,7 +66,8 @@ func encryptSensitiveFields(
 
 	resourceID := serviceCtx.ResourceID.String()
 	resourceType := serviceCtx.ResourceID.Type()
-	apiVersion := serviceCtx.APIVersion
+	// The conversion filter may have changed the version of the properties to the storage version.
+	apiVersion := newResource.InternalMetadata.UpdatedAPIVersion
 
 	// If encryption handler is not configured, return an error.
 	if handler == nil {
@@ -45,6 +45,9 @@ func TestMakeEncryptionFilter_NilHandler(t *testing.T) {
 
 	ctx := createTestContext(t)
 	resource := &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			InternalMetadata: v1.InternalMetadata{UpdatedAPIVersion: testAPIVersion},
+		},
 		Properties: map[string]any{
 			"password": "secret123",
 		},
@@ -68,6 +71,9 @@ func TestMakeEncryptionFilter_NoSensitiveFields(t *testing.T) {
 
 	ctx := createTestContext(t)
 	resource := &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			InternalMetadata: v1.InternalMetadata{UpdatedAPIVersion: testAPIVersion},
+		},
 		Properties: map[string]any{
 			"name":  "test",
 			"value": "not-sensitive",
@@ -93,6 +99,9 @@ func TestMakeEncryptionFilter_WithSensitiveFields(t *testing.T) {
 
 	ctx := createTestContext(t)
 	resource := &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			InternalMetadata: v1.InternalMetadata{UpdatedAPIVersion: testAPIVersion},
+		},
 		Properties: map[string]any{
 			"name":     "test",
 			"password": "secret123",
@@ -124,6 +133,9 @@ func TestMakeEncryptionFilter_NilProperties(t *testing.T) {
 
 	ctx := createTestContext(t)
 	resource := &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			InternalMetadata: v1.InternalMetadata{UpdatedAPIVersion: testAPIVersion},
+		},
 		Properties: nil,
 	}
 
@@ -142,6 +154,9 @@ func TestMakeEncryptionFilter_SchemaFetchError(t *testing.T) {
 
 	ctx := createTestContext(t)
 	resource := &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			InternalMetadata: v1.InternalMetadata{UpdatedAPIVersion: testAPIVersion},
+		},
 		Properties: map[string]any{
 			"password": "secret123",
 		},
@@ -165,6 +180,9 @@ func TestMakeEncryptionFilter_NestedSensitiveFields(t *testing.T) {
 
 	ctx := createTestContext(t)
 	resource := &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			InternalMetadata: v1.InternalMetadata{UpdatedAPIVersion: testAPIVersion},
+		},
 		Properties: map[string]any{
 			"name": "test",
 			"credentials": map[string]any{
@@ -306,6 +324,21 @@ func createFakeUCPClientFactory(schema map[string]any) (*v20231001preview.Client
 			resp.SetResponse(http.StatusOK, response, nil)
 			return
 		},
+		NewListPager: func(planeName, resourceProviderName, resourceTypeName string, options *v20231001preview.APIVersionsClientListOptions) (resp azfake.PagerResponder[v20231001preview.APIVersionsClientListResponse]) {
+			resp.AddPage(http.StatusOK, v20231001preview.APIVersionsClientListResponse{
+				APIVersionResourceListResult: v20231001preview.APIVersionResourceListResult{
+					Value: []*v20231001preview.APIVersionResource{
+						{
+							Name: new(testAPIVersion),
+							Properties: &v20231001preview.APIVersionProperties{
+								Schema: schema,
+							},
+						},
+					},
+				},
+			}, nil)
+			return
+		},
 	}
 
 	return v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
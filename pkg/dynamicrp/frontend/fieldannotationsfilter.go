@@ -0,0 +1,152 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+type fieldAnnotationsUpdateFilter controller.UpdateFilter[datamodel.DynamicResource]
+
+// makeFieldAnnotationsFilter creates an UpdateFilter that enforces the x-radius-readonly and x-radius-immutable
+// annotations of the request schema.
+//
+// Read-only fields are computed by Radius, so the values sent in the request are ignored and the values of the
+// existing resource are kept. Immutable fields can be set when the resource is created, but cannot be changed or
+// removed afterwards.
+func makeFieldAnnotationsFilter(ucpClient *v20231001preview.ClientFactory) fieldAnnotationsUpdateFilter {
+	return func(
+		ctx context.Context,
+		newResource *datamodel.DynamicResource,
+		oldResource *datamodel.DynamicResource,
+		options *controller.Options,
+	) (rest.Response, error) {
+		return enforceFieldAnnotations(ctx, newResource, oldResource, ucpClient)
+	}
+}
+
+// enforceFieldAnnotations applies the read-only and immutable annotations of the resource schema.
+func enforceFieldAnnotations(
+	ctx context.Context,
+	newResource *datamodel.DynamicResource,
+	oldResource *datamodel.DynamicResource,
+	ucpClient *v20231001preview.ClientFactory,
+) (rest.Response, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	if newResource == nil {
+		return nil, nil
+	}
+
+	resourceID := serviceCtx.ResourceID.String()
+	resourceType := serviceCtx.ResourceID.Type()
+	apiVersion := serviceCtx.APIVersion
+
+	annotations, err := schema.GetFieldAnnotations(ctx, ucpClient, resourceID, resourceType, apiVersion)
+	if err != nil {
+		logger.Error(err, "Failed to fetch schema for field annotations",
+			"resourceType", resourceType, "apiVersion", apiVersion)
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: "Failed to fetch schema to apply field annotations",
+			},
+		}), nil
+	}
+
+	if annotations.IsEmpty() || (len(annotations.ReadOnly) == 0 && oldResource == nil) {
+		return nil, nil
+	}
+
+	oldProperties := map[string]any{}
+	if oldResource != nil {
+		oldProperties, err = oldPropertiesAtVersion(ctx, oldResource, ucpClient, apiVersion)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if newResource.Properties == nil {
+		newResource.Properties = map[string]any{}
+	}
+
+	// Keep the computed values of the existing resource.
+	schema.RemoveFields(newResource.Properties, annotations.ReadOnly)
+	for _, path := range annotations.ReadOnly {
+		if value, ok := schema.GetFieldValue(oldProperties, path); ok {
+			schema.SetFieldValue(newResource.Properties, path, value)
+		}
+	}
+
+	changed := []string{}
+	for _, path := range annotations.Immutable {
+		oldValue, ok := schema.GetFieldValue(oldProperties, path)
+		if !ok {
+			continue
+		}
+
+		newValue, ok := schema.GetFieldValue(newResource.Properties, path)
+		if !ok || !jsonEqual(oldValue, newValue) {
+			changed = append(changed, fmt.Sprintf("%q", path))
+		}
+	}
+	if len(changed) > 0 {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Immutable fields cannot be changed after the resource is created: %s.", strings.Join(changed, ", "))), nil
+	}
+
+	return nil, nil
+}
+
+// jsonEqual returns true if both values have the same JSON representation, so that numbers compare equal
+// regardless of their Go type.
+func jsonEqual(a any, b any) bool {
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(aJSON, bJSON)
+}
+
+// removeWriteOnlyFields removes the write-only fields of the request schema from the properties of a resource that
+// is returned.
+func removeWriteOnlyFields(ctx context.Context, resourceID string, properties map[string]any, annotations *schema.FieldAnnotations) {
+	if properties == nil || annotations == nil || len(annotations.WriteOnly) == 0 {
+		return
+	}
+
+	schema.RemoveFields(properties, annotations.WriteOnly)
+	ucplog.FromContextOrDiscard(ctx).V(ucplog.LevelDebug).Info("Removed write-only fields",
+		"count", len(annotations.WriteOnly), "resourceID", resourceID)
+}
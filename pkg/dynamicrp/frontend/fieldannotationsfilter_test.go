@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// testFieldAnnotationSchemas declares testAPIVersion with annotated fields, and a storage version that renames 'size'.
+var testFieldAnnotationSchemas = map[string]map[string]any{
+	testAPIVersion: {
+		"type": "object",
+		"properties": map[string]any{
+			"size":     map[string]any{"type": "string", "x-radius-immutable": true},
+			"host":     map[string]any{"type": "string", "x-radius-readonly": true},
+			"password": map[string]any{"type": "string", "x-radius-writeonly": true},
+			"replicas": map[string]any{"type": "integer"},
+		},
+		"x-radius-conversion": map[string]any{
+			"renamed": map[string]any{"size": "capacity"},
+		},
+	},
+	testStorageAPIVersion: {
+		"type":                     "object",
+		"x-radius-storage-version": true,
+		"properties": map[string]any{
+			"capacity": map[string]any{"type": "string"},
+			"host":     map[string]any{"type": "string"},
+			"password": map[string]any{"type": "string"},
+			"replicas": map[string]any{"type": "integer"},
+		},
+	},
+}
+
+func TestMakeFieldAnnotationsFilter(t *testing.T) {
+	ucpClient, err := createFakeUCPClientFactoryWithVersions(testFieldAnnotationSchemas)
+	require.NoError(t, err)
+
+	storedResource := func() *datamodel.DynamicResource {
+		return &datamodel.DynamicResource{
+			BaseResource: v1.BaseResource{
+				InternalMetadata: v1.InternalMetadata{UpdatedAPIVersion: testStorageAPIVersion},
+			},
+			Properties: map[string]any{"capacity": "L", "host": "db.example.com", "replicas": float64(1)},
+		}
+	}
+
+	t.Run("create ignores read-only fields", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{Properties: map[string]any{"size": "M", "host": "spoofed"}}
+
+		response, err := makeFieldAnnotationsFilter(ucpClient)(createTestContext(t), resource, nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+		require.Equal(t, map[string]any{"size": "M"}, resource.Properties)
+	})
+
+	t.Run("update keeps read-only fields of the existing resource", func(t *testing.T) {
+		oldResource := storedResource()
+		resource := &datamodel.DynamicResource{Properties: map[string]any{"size": "L", "replicas": float64(3)}}
+
+		response, err := makeFieldAnnotationsFilter(ucpClient)(createTestContext(t), resource, oldResource, nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+		require.Equal(t, map[string]any{"size": "L", "host": "db.example.com", "replicas": float64(3)}, resource.Properties)
+
+		// The stored properties are used by the conversion filter, so they must not be converted in place.
+		require.Equal(t, storedResource().Properties, oldResource.Properties)
+	})
+
+	t.Run("immutable field changed", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{Properties: map[string]any{"size": "XL"}}
+
+		response, err := makeFieldAnnotationsFilter(ucpClient)(createTestContext(t), resource, storedResource(), nil)
+		require.NoError(t, err)
+
+		badRequest, ok := response.(*rest.BadRequestResponse)
+		require.True(t, ok)
+		require.Equal(t, `Immutable fields cannot be changed after the resource is created: "size".`, badRequest.Body.Error.Message)
+	})
+
+	t.Run("immutable field removed", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{Properties: map[string]any{"replicas": float64(1)}}
+
+		response, err := makeFieldAnnotationsFilter(ucpClient)(createTestContext(t), resource, storedResource(), nil)
+		require.NoError(t, err)
+
+		_, ok := response.(*rest.BadRequestResponse)
+		require.True(t, ok)
+	})
+
+	t.Run("fetch error", func(t *testing.T) {
+		ucpClient, err := testUCPClientFactoryWithError()
+		require.NoError(t, err)
+
+		resource := &datamodel.DynamicResource{Properties: map[string]any{"size": "L"}}
+
+		response, err := makeFieldAnnotationsFilter(ucpClient)(createTestContext(t), resource, nil, nil)
+		require.NoError(t, err)
+
+		errorResponse, ok := response.(*rest.InternalServerErrorResponse)
+		require.True(t, ok)
+		require.Equal(t, v1.CodeInternal, errorResponse.Body.Error.Code)
+	})
+}
+
+func TestGetResourceWithRedaction_RemovesWriteOnlyFields(t *testing.T) {
+	mctrl := gomock.NewController(t)
+	defer mctrl.Finish()
+
+	resource := newGetTestDynamicResource(v1.ProvisioningStateSucceeded, map[string]any{
+		"capacity": "L",
+		"password": "secret123",
+	})
+	resource.InternalMetadata.UpdatedAPIVersion = testStorageAPIVersion
+
+	storeObject := rpctest.FakeStoreObject(resource)
+	storeObject.Metadata = database.Metadata{ID: testResourceID, ETag: "etag-1"}
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), testResourceID).
+		Return(storeObject, nil)
+
+	ucpClient, err := createFakeUCPClientFactoryWithVersions(testFieldAnnotationSchemas)
+	require.NoError(t, err)
+
+	c := newTestGetController(t, databaseClient, ucpClient)
+
+	req, err := http.NewRequest(http.MethodGet, testGetURL, nil)
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+	w := httptest.NewRecorder()
+
+	resp, err := c.Run(ctx, w, req)
+	require.NoError(t, err)
+	_ = resp.Apply(ctx, w, req)
+	require.Equal(t, http.StatusOK, w.Result().StatusCode)
+
+	var body map[string]any
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&body))
+	properties, ok := body["properties"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "L", properties["size"])
+	require.NotContains(t, properties, "password")
+}
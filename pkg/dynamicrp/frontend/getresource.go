@@ -29,7 +29,8 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
-// GetResourceWithRedaction is a custom GET controller that redacts sensitive fields.
+// GetResourceWithRedaction is a custom GET controller that redacts sensitive fields, converts the resource to the
+// request API version and removes its write-only fields.
 type GetResourceWithRedaction struct {
 	ctrl.Operation[*datamodel.DynamicResource, datamodel.DynamicResource]
 	ucpClient *v20231001preview.ClientFactory
@@ -47,7 +48,8 @@ func NewGetResourceWithRedaction(
 	}, nil
 }
 
-// Run returns the requested resource with sensitive fields redacted.
+// Run returns the requested resource with sensitive fields redacted and write-only fields removed, in the shape of
+// the request API version.
 //
 // Design consideration (GET Operation Update): When provisioningState is "Succeeded",
 // the backend has already redacted sensitive data from the database, so we skip the
@@ -105,5 +107,37 @@ func (c *GetResourceWithRedaction) Run(ctx context.Context, w http.ResponseWrite
 		}
 	}
 
+	// Return the resource in the shape of the request API version rather than the version it was stored with.
+	if resource.InternalMetadata.UpdatedAPIVersion != serviceCtx.APIVersion {
+		converter, err := schema.NewVersionConverter(ctx, c.ucpClient, serviceCtx.ResourceID.String(), serviceCtx.ResourceID.Type())
+		if err != nil {
+			logger.Error(err, "Failed to fetch API versions for GET conversion",
+				"resourceType", serviceCtx.ResourceID.Type(), "apiVersion", serviceCtx.APIVersion)
+			return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+				Error: &v1.ErrorDetails{
+					Code:    v1.CodeInternal,
+					Message: "Failed to fetch API versions to convert the resource",
+				},
+			}), nil
+		}
+
+		converter.Convert(resource.Properties, resource.InternalMetadata.UpdatedAPIVersion, serviceCtx.APIVersion)
+	}
+
+	// Write-only fields are never returned. They are looked up in the request schema since the resource has
+	// been converted to it.
+	annotations, err := schema.GetFieldAnnotations(ctx, c.ucpClient, serviceCtx.ResourceID.String(), serviceCtx.ResourceID.Type(), serviceCtx.APIVersion)
+	if err != nil {
+		logger.Error(err, "Failed to fetch field annotations for GET",
+			"resourceType", serviceCtx.ResourceID.Type(), "apiVersion", serviceCtx.APIVersion)
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: "Failed to fetch schema to remove write-only fields",
+			},
+		}), nil
+	}
+	removeWriteOnlyFields(ctx, resource.ID, resource.Properties, annotations)
+
 	return c.ConstructSyncResponse(ctx, req.Method, etag, resource)
 }
@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	sm "github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// InvokeAction is the controller that invokes a custom action declared by the schema of a user-defined resource type.
+//
+// The action runs as an async operation. The resource is marked as updating until the action completes so that
+// it cannot be changed concurrently, and the output of the action is returned as the result of the operation.
+type InvokeAction struct {
+	ctrl.Operation[*datamodel.DynamicResource, datamodel.DynamicResource]
+	ucpClient  *v20231001preview.ClientFactory
+	action     string
+	retryAfter time.Duration
+}
+
+// NewInvokeAction creates a new InvokeAction controller for the given action name.
+func NewInvokeAction(
+	opts ctrl.Options,
+	resourceOpts ctrl.ResourceOptions[datamodel.DynamicResource],
+	ucpClient *v20231001preview.ClientFactory,
+	action string,
+) (ctrl.Controller, error) {
+	retryAfter := resourceOpts.AsyncOperationRetryAfter
+	if retryAfter == 0 {
+		retryAfter = v1.DefaultRetryAfterDuration
+	}
+
+	return &InvokeAction{
+		Operation:  ctrl.NewOperation[*datamodel.DynamicResource](opts, resourceOpts),
+		ucpClient:  ucpClient,
+		action:     action,
+		retryAfter: retryAfter,
+	}, nil
+}
+
+// Run validates the input of the action against the schema of the request API version and queues the action.
+func (c *InvokeAction) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	input, err := readActionInput(req)
+	if err != nil {
+		return rest.NewBadRequestResponse(err.Error()), nil
+	}
+
+	resourceType := serviceCtx.ResourceID.Type()
+	schemaData, err := schema.GetSchema(ctx, c.ucpClient, serviceCtx.ResourceID.String(), resourceType, serviceCtx.APIVersion)
+	if err != nil {
+		logger.Error(err, "Failed to fetch schema for action", "resourceType", resourceType, "apiVersion", serviceCtx.APIVersion)
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: "Failed to fetch schema to invoke the action",
+			},
+		}), nil
+	}
+
+	action, err := schema.GetAction(schemaData, c.action)
+	if err != nil {
+		return nil, err
+	}
+	if action == nil {
+		return rest.NewNotFoundMessageResponse(fmt.Sprintf("The action %q is not declared by API version %q of resource type %q.", c.action, serviceCtx.APIVersion, resourceType)), nil
+	}
+
+	if err := schema.ValidateActionValue(action.Input, input); err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Invalid input for action %q: %s.", action.Name, err.Error())), nil
+	}
+
+	resource, etag, err := c.GetResource(ctx, serviceCtx.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil {
+		return rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	if err := ctrl.ValidateETag(*serviceCtx, etag); err != nil {
+		return rest.NewPreconditionFailedResponse(serviceCtx.ResourceID.String(), err.Error()), nil
+	}
+
+	// The action does not change the resource, so the resource returns to its current state once the action completes,
+	// even if the action fails.
+	state := resource.ProvisioningState()
+	if !state.IsTerminal() {
+		return rest.NewConflictResponse(fmt.Sprintf(ctrl.InProgressStateMessageFormat, state)), nil
+	}
+
+	resource.SetProvisioningState(v1.ProvisioningStateUpdating)
+	etag, err = c.SaveResource(ctx, serviceCtx.ResourceID.String(), resource, etag)
+	if err != nil {
+		return nil, err
+	}
+
+	options := sm.QueueOperationOptions{
+		OperationTimeout:          c.AsyncOperationTimeout(),
+		RetryAfter:                c.retryAfter,
+		Input:                     input,
+		ResourceProvisioningState: state,
+	}
+	if err := c.StatusManager().QueueAsyncOperation(ctx, serviceCtx, options); err != nil {
+		resource.SetProvisioningState(state)
+		if _, rbErr := c.SaveResource(ctx, serviceCtx.ResourceID.String(), resource, etag); rbErr != nil {
+			return nil, rbErr
+		}
+		return nil, err
+	}
+
+	response := rest.NewAsyncOperationResponse(nil, serviceCtx.Location, http.StatusAccepted, serviceCtx.ResourceID, serviceCtx.OperationID, serviceCtx.APIVersion, "", "")
+	response.RetryAfter = c.retryAfter
+	return response, nil
+}
+
+// readActionInput reads the input of an action from the request body. An empty body is an empty input.
+func readActionInput(req *http.Request) (map[string]any, error) {
+	input := map[string]any{}
+	if req.Body == nil {
+		return input, nil
+	}
+	defer req.Body.Close()
+
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+
+	if len(bytes.TrimSpace(data)) == 0 {
+		return input, nil
+	}
+
+	if err := json.Unmarshal(data, &input); err != nil || input == nil {
+		return nil, errors.New("the action input must be a JSON object")
+	}
+
+	return input, nil
+}
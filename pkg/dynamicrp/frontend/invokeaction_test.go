@@ -0,0 +1,157 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testActionURL = "/planes/radius/local/resourceGroups/test-group/providers/Applications.Test/testResources/myResource/rotateCredentials?api-version=2023-10-01-preview"
+
+// testActionSchema declares a 'rotateCredentials' action that requires an 'expiryDays' input.
+var testActionSchema = map[string]any{
+	"type": "object",
+	"x-radius-actions": map[string]any{
+		"rotateCredentials": map[string]any{
+			"input": map[string]any{
+				"type":       "object",
+				"properties": map[string]any{"expiryDays": map[string]any{"type": "integer"}},
+				"required":   []any{"expiryDays"},
+			},
+			"webhook": "https://example.com/rotate",
+		},
+	},
+}
+
+func runInvokeAction(t *testing.T, databaseClient database.Client, statusManager statusmanager.StatusManager, action string, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	ucpClient, err := createFakeUCPClientFactory(testActionSchema)
+	require.NoError(t, err)
+
+	c, err := NewInvokeAction(controller.Options{
+		DatabaseClient: databaseClient,
+		StatusManager:  statusManager,
+	}, controller.ResourceOptions[datamodel.DynamicResource]{}, ucpClient, action)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, testActionURL, bytes.NewBufferString(body))
+	require.NoError(t, err)
+	ctx := rpctest.NewARMRequestContext(req)
+	w := httptest.NewRecorder()
+
+	resp, err := c.Run(ctx, w, req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Apply(ctx, w, req))
+	return w
+}
+
+func TestInvokeAction_QueuesAction(t *testing.T) {
+	mctrl := gomock.NewController(t)
+
+	resource := newGetTestDynamicResource(v1.ProvisioningStateSucceeded, map[string]any{"size": "L"})
+	storeObject := rpctest.FakeStoreObject(resource)
+	storeObject.Metadata = database.Metadata{ID: testResourceID, ETag: "etag-1"}
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), testResourceID).
+		Return(storeObject, nil)
+	databaseClient.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, obj *database.Object, _ ...database.SaveOptions) error {
+			saved := obj.Data.(*datamodel.DynamicResource)
+			require.Equal(t, v1.ProvisioningStateUpdating, saved.ProvisioningState())
+			obj.ETag = "etag-2"
+			return nil
+		})
+
+	statusManager := statusmanager.NewMockStatusManager(mctrl)
+	statusManager.EXPECT().
+		QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+			require.Equal(t, testResourceID, sCtx.ResourceID.String())
+			require.Equal(t, map[string]any{"expiryDays": float64(30)}, options.Input)
+			require.Equal(t, v1.ProvisioningStateSucceeded, options.ResourceProvisioningState)
+			return nil
+		})
+
+	w := runInvokeAction(t, databaseClient, statusManager, "rotateCredentials", `{"expiryDays": 30}`)
+	require.Equal(t, http.StatusAccepted, w.Result().StatusCode)
+	require.NotEmpty(t, w.Header().Get("Azure-AsyncOperation"))
+}
+
+func TestInvokeAction_UndeclaredAction(t *testing.T) {
+	mctrl := gomock.NewController(t)
+
+	w := runInvokeAction(t, database.NewMockClient(mctrl), statusmanager.NewMockStatusManager(mctrl), "snapshot", "")
+	require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	require.Contains(t, w.Body.String(), `The action \"snapshot\" is not declared`)
+}
+
+func TestInvokeAction_InvalidInput(t *testing.T) {
+	mctrl := gomock.NewController(t)
+
+	w := runInvokeAction(t, database.NewMockClient(mctrl), statusmanager.NewMockStatusManager(mctrl), "rotateCredentials", `{"expiryDays": "thirty"}`)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	require.Contains(t, w.Body.String(), `Invalid input for action \"rotateCredentials\"`)
+
+	w = runInvokeAction(t, database.NewMockClient(mctrl), statusmanager.NewMockStatusManager(mctrl), "rotateCredentials", `[1, 2]`)
+	require.Equal(t, http.StatusBadRequest, w.Result().StatusCode)
+	require.Contains(t, w.Body.String(), "the action input must be a JSON object")
+}
+
+func TestInvokeAction_ResourceNotFound(t *testing.T) {
+	mctrl := gomock.NewController(t)
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), testResourceID).
+		Return(nil, &database.ErrNotFound{ID: testResourceID})
+
+	w := runInvokeAction(t, databaseClient, statusmanager.NewMockStatusManager(mctrl), "rotateCredentials", `{"expiryDays": 30}`)
+	require.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+}
+
+func TestInvokeAction_OperationInProgress(t *testing.T) {
+	mctrl := gomock.NewController(t)
+
+	resource := newGetTestDynamicResource(v1.ProvisioningStateUpdating, map[string]any{"size": "L"})
+	storeObject := rpctest.FakeStoreObject(resource)
+	storeObject.Metadata = database.Metadata{ID: testResourceID, ETag: "etag-1"}
+
+	databaseClient := database.NewMockClient(mctrl)
+	databaseClient.EXPECT().
+		Get(gomock.Any(), testResourceID).
+		Return(storeObject, nil)
+
+	w := runInvokeAction(t, databaseClient, statusmanager.NewMockStatusManager(mctrl), "rotateCredentials", `{"expiryDays": 30}`)
+	require.Equal(t, http.StatusConflict, w.Result().StatusCode)
+}
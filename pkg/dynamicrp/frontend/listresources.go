@@ -30,7 +30,8 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
-// ListResourcesWithRedaction is a custom LIST controller that redacts sensitive fields.
+// ListResourcesWithRedaction is a custom LIST controller that redacts sensitive fields, converts the resources to
+// the request API version and removes their write-only fields.
 type ListResourcesWithRedaction struct {
 	ctrl.Operation[*datamodel.DynamicResource, datamodel.DynamicResource]
 	ucpClient          *v20231001preview.ClientFactory
@@ -50,7 +51,8 @@ func NewListResourcesWithRedaction(
 	}, nil
 }
 
-// Run returns the list of resources with sensitive fields redacted.
+// Run returns the list of resources with sensitive fields redacted and write-only fields removed, in the shape of
+// the request API version.
 func (c *ListResourcesWithRedaction) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
 	serviceCtx := v1.ARMRequestContextFromContext(ctx)
 	logger := ucplog.FromContextOrDiscard(ctx)
@@ -70,6 +72,12 @@ func (c *ListResourcesWithRedaction) Run(ctx context.Context, w http.ResponseWri
 	// Different resources in the list may have been created with different API versions
 	sensitiveFieldPathsCache := make(map[string][]string)
 
+	// All resources in the list have the same resource type, so they share a converter.
+	var converter *schema.VersionConverter
+
+	// All resources are returned in the request API version, so they share its write-only fields.
+	var annotations *schema.FieldAnnotations
+
 	items := []any{}
 	for _, item := range result.Items {
 		resource := &datamodel.DynamicResource{}
@@ -116,6 +124,43 @@ func (c *ListResourcesWithRedaction) Run(ctx context.Context, w http.ResponseWri
 			}
 		}
 
+		// Return each resource in the shape of the request API version rather than the version it was stored with.
+		if resource.InternalMetadata.UpdatedAPIVersion != serviceCtx.APIVersion {
+			if converter == nil {
+				converter, err = schema.NewVersionConverter(ctx, c.ucpClient, resource.ID, serviceCtx.ResourceID.Type())
+				if err != nil {
+					logger.Error(err, "Failed to fetch API versions for LIST conversion",
+						"resourceType", serviceCtx.ResourceID.Type(), "apiVersion", serviceCtx.APIVersion)
+					return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+						Error: &v1.ErrorDetails{
+							Code:    v1.CodeInternal,
+							Message: "Failed to fetch API versions to convert the resources",
+						},
+					}), nil
+				}
+			}
+
+			converter.Convert(resource.Properties, resource.InternalMetadata.UpdatedAPIVersion, serviceCtx.APIVersion)
+		}
+
+		if annotations == nil {
+			annotations, err = schema.GetFieldAnnotations(ctx, c.ucpClient, resource.ID, serviceCtx.ResourceID.Type(), serviceCtx.APIVersion)
+			if err != nil {
+				logger.Error(err, "Failed to fetch field annotations for LIST",
+					"resourceType", serviceCtx.ResourceID.Type(), "apiVersion", serviceCtx.APIVersion)
+				return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+					Error: &v1.ErrorDetails{
+						Code:    v1.CodeInternal,
+						Message: "Failed to fetch schema to remove write-only fields",
+					},
+				}), nil
+			}
+			if annotations == nil {
+				annotations = &schema.FieldAnnotations{}
+			}
+		}
+		removeWriteOnlyFields(ctx, resource.ID, resource.Properties, annotations)
+
 		versioned, err := c.ResponseConverter()(resource, serviceCtx.APIVersion)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+// makeRedactionPatchFilter creates a PatchFilter that redacts sensitive fields in the existing resource before
+// a merge patch is applied to it.
+//
+// Sensitive fields are write-only: they are encrypted while the resource is being deployed and redacted once
+// it succeeds. Carrying the stored values over would encrypt them a second time, so a PATCH must resend any
+// sensitive values that should be kept.
+func makeRedactionPatchFilter(ucpClient *v20231001preview.ClientFactory) controller.PatchFilter[datamodel.DynamicResource] {
+	return func(ctx context.Context, base *datamodel.DynamicResource, options *controller.Options) error {
+		if base.Properties == nil {
+			return nil
+		}
+
+		serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+		// Use the API version the resource was last updated with, since that schema was used for encryption.
+		sensitiveFieldPaths, err := schema.GetSensitiveFieldPaths(
+			ctx,
+			ucpClient,
+			serviceCtx.ResourceID.String(),
+			serviceCtx.ResourceID.Type(),
+			base.InternalMetadata.UpdatedAPIVersion,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to fetch sensitive field paths: %w", err)
+		}
+
+		schema.RedactFields(base.Properties, sensitiveFieldPaths)
+		return nil
+	}
+}
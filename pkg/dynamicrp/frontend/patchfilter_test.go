@@ -0,0 +1,71 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeRedactionPatchFilter_RedactsSensitiveFields(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithSensitiveFields()
+	require.NoError(t, err)
+
+	filter := makeRedactionPatchFilter(ucpClient)
+
+	base := &datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			InternalMetadata: v1.InternalMetadata{UpdatedAPIVersion: testAPIVersion},
+		},
+		Properties: map[string]any{
+			"name":     "test",
+			"password": map[string]any{"encrypted": "abc", "nonce": "def"},
+		},
+	}
+
+	err = filter(createTestContext(t), base, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, "test", base.Properties["name"])
+	require.Nil(t, base.Properties["password"])
+}
+
+func TestMakeRedactionPatchFilter_NilProperties(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithError()
+	require.NoError(t, err)
+
+	// The schema is not fetched when there is nothing to redact.
+	err = makeRedactionPatchFilter(ucpClient)(createTestContext(t), &datamodel.DynamicResource{}, nil)
+	require.NoError(t, err)
+}
+
+func TestMakeRedactionPatchFilter_SchemaFetchError(t *testing.T) {
+	ucpClient, err := testUCPClientFactoryWithError()
+	require.NoError(t, err)
+
+	base := &datamodel.DynamicResource{
+		Properties: map[string]any{
+			"password": "secret123",
+		},
+	}
+
+	err = makeRedactionPatchFilter(ucpClient)(createTestContext(t), base, nil)
+	require.ErrorContains(t, err, "failed to fetch sensitive field paths")
+}
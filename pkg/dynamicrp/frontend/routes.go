@@ -17,6 +17,7 @@ limitations under the License.
 package frontend
 
 import (
+	"net/http"
 	"strings"
 	"time"
 
@@ -56,12 +57,28 @@ func (s *Service) registerRoutes(
 	// Apply defaults before encrypting sensitive fields.
 	defaultsFilter := makeDefaultsFilter(ucpClient)
 
-	// Resource options with encryption filter applied to PUT operations
+	// Enforce read-only and immutable fields after applying defaults, so that defaulted values are compared.
+	fieldAnnotationsFilter := makeFieldAnnotationsFilter(ucpClient)
+
+	// Evaluate the validation rules of the request version after applying its defaults.
+	validationFilter := makeValidationFilter(ucpClient)
+
+	// Convert to the storage version after applying the defaults of the request version.
+	conversionFilter := makeConversionFilter(ucpClient)
+
+	// Resource options with encryption filter applied to PUT and PATCH operations
 	resourceOptions := controller.ResourceOptions[datamodel.DynamicResource]{
 		RequestConverter:  converter.DynamicResourceDataModelFromVersioned,
 		ResponseConverter: converter.DynamicResourceDataModelToVersioned,
+		PatchFilters: []controller.PatchFilter[datamodel.DynamicResource]{
+			makeRedactionPatchFilter(ucpClient),
+			makeConversionPatchFilter(ucpClient),
+		},
 		UpdateFilters: makeUpdateFilters(
 			defaultsFilter,
+			fieldAnnotationsFilter,
+			validationFilter,
+			conversionFilter,
 			encryptionFilter,
 		),
 		AsyncOperationRetryAfter: time.Second * 5,
@@ -99,13 +116,26 @@ func (s *Service) registerRoutes(
 					return NewGetResourceWithRedaction(opts, resourceOptions, ucpClient)
 				}))
 			r.Put("/{resourceName}", dynamicOperationHandler(v1.OperationPut, controllerOptions,
-				func(opts controller.Options) (controller.Controller, error) {
+				withoutWriteOnlyFields(ucpClient, withRequestAPIVersionResponse(ucpClient, func(opts controller.Options) (controller.Controller, error) {
 					return defaultoperation.NewDefaultAsyncPut(opts, resourceOptions)
-				}))
+				}))))
+			r.Patch("/{resourceName}", dynamicOperationHandler(v1.OperationPatch, controllerOptions,
+				withoutWriteOnlyFields(ucpClient, withRequestAPIVersionResponse(ucpClient, func(opts controller.Options) (controller.Controller, error) {
+					return defaultoperation.NewDefaultAsyncPatch(opts, resourceOptions)
+				}))))
 			r.Delete("/{resourceName}", dynamicOperationHandler(v1.OperationDelete, controllerOptions,
-				func(opts controller.Options) (controller.Controller, error) {
+				withoutWriteOnlyFields(ucpClient, withRequestAPIVersionResponse(ucpClient, func(opts controller.Options) (controller.Controller, error) {
 					return defaultoperation.NewDefaultAsyncDelete(opts, resourceOptions)
-				}))
+				}))))
+
+			// Custom actions declared by the schema of the resource type.
+			r.Post("/{resourceName}/{action}", func(w http.ResponseWriter, req *http.Request) {
+				action := chi.URLParam(req, "action")
+				dynamicOperationHandler(datamodel.ActionOperationMethod(action), controllerOptions,
+					func(opts controller.Options) (controller.Controller, error) {
+						return NewInvokeAction(opts, resourceOptions, ucpClient, action)
+					}).ServeHTTP(w, req)
+			})
 		})
 	})
 
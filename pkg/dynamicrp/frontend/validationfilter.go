@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+type validationUpdateFilter controller.UpdateFilter[datamodel.DynamicResource]
+
+// makeValidationFilter creates an UpdateFilter that evaluates the x-radius-validations rules of the request schema
+// against the resource before it is saved.
+//
+// Transition rules compare the resource with the existing resource, which is converted from the version it was
+// stored with to the request API version first. Sensitive fields of the existing resource are stored encrypted,
+// so transition rules cannot compare them.
+func makeValidationFilter(ucpClient *v20231001preview.ClientFactory) validationUpdateFilter {
+	return func(
+		ctx context.Context,
+		newResource *datamodel.DynamicResource,
+		oldResource *datamodel.DynamicResource,
+		options *controller.Options,
+	) (rest.Response, error) {
+		return validateSchemaRules(ctx, newResource, oldResource, ucpClient)
+	}
+}
+
+// validateSchemaRules evaluates the validation rules of the resource schema.
+func validateSchemaRules(
+	ctx context.Context,
+	newResource *datamodel.DynamicResource,
+	oldResource *datamodel.DynamicResource,
+	ucpClient *v20231001preview.ClientFactory,
+) (rest.Response, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	if newResource == nil {
+		return nil, nil
+	}
+
+	resourceID := serviceCtx.ResourceID.String()
+	resourceType := serviceCtx.ResourceID.Type()
+	apiVersion := serviceCtx.APIVersion
+
+	schemaData, err := schema.GetSchema(ctx, ucpClient, resourceID, resourceType, apiVersion)
+	if err != nil {
+		logger.Error(err, "Failed to fetch schema for validation rules",
+			"resourceType", resourceType, "apiVersion", apiVersion)
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: "Failed to fetch schema to evaluate validation rules",
+			},
+		}), nil
+	}
+
+	if schemaData == nil {
+		return nil, nil
+	}
+
+	var oldProperties map[string]any
+	if oldResource != nil {
+		oldProperties, err = oldPropertiesAtVersion(ctx, oldResource, ucpClient, apiVersion)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := schema.ValidateRules(schemaData, newResource.Properties, oldProperties); err != nil {
+		return rest.NewBadRequestResponse(fmt.Sprintf("Invalid properties: %s.", err.Error())), nil
+	}
+
+	return nil, nil
+}
+
+// oldPropertiesAtVersion returns a copy of the properties of the existing resource in the given API version.
+func oldPropertiesAtVersion(
+	ctx context.Context,
+	oldResource *datamodel.DynamicResource,
+	ucpClient *v20231001preview.ClientFactory,
+	apiVersion string,
+) (map[string]any, error) {
+	// Copy the properties since the conversion filter uses the stored properties of the existing resource.
+	properties := map[string]any{}
+	if oldResource.Properties != nil {
+		b, err := json.Marshal(oldResource.Properties)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, &properties); err != nil {
+			return nil, err
+		}
+	}
+
+	storedVersion := oldResource.InternalMetadata.UpdatedAPIVersion
+	if storedVersion == "" || storedVersion == apiVersion {
+		return properties, nil
+	}
+
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	converter, err := schema.NewVersionConverter(ctx, ucpClient, serviceCtx.ResourceID.String(), serviceCtx.ResourceID.Type())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch API versions: %w", err)
+	}
+
+	converter.Convert(properties, storedVersion, apiVersion)
+	return properties, nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+// testValidationSchemas declares testAPIVersion with validation rules, and a storage version that renames 'size'.
+var testValidationSchemas = map[string]map[string]any{
+	testAPIVersion: {
+		"type": "object",
+		"properties": map[string]any{
+			"replicas":  map[string]any{"type": "integer"},
+			"autoscale": map[string]any{"type": "boolean"},
+			"size": map[string]any{
+				"type":                 "string",
+				"x-radius-validations": []any{map[string]any{"rule": "self == oldSelf", "message": "size is immutable"}},
+			},
+		},
+		"x-radius-validations": []any{
+			map[string]any{"rule": "self.autoscale || self.replicas > 0", "message": "replicas must be greater than 0 when autoscale is false"},
+		},
+		"x-radius-conversion": map[string]any{
+			"renamed": map[string]any{"size": "capacity"},
+		},
+	},
+	testStorageAPIVersion: {
+		"type":                     "object",
+		"x-radius-storage-version": true,
+		"properties": map[string]any{
+			"replicas":  map[string]any{"type": "integer"},
+			"autoscale": map[string]any{"type": "boolean"},
+			"capacity":  map[string]any{"type": "string"},
+		},
+	},
+}
+
+func TestMakeValidationFilter(t *testing.T) {
+	ucpClient, err := createFakeUCPClientFactoryWithVersions(testValidationSchemas)
+	require.NoError(t, err)
+
+	storedResource := func() *datamodel.DynamicResource {
+		return &datamodel.DynamicResource{
+			BaseResource: v1.BaseResource{
+				InternalMetadata: v1.InternalMetadata{UpdatedAPIVersion: testStorageAPIVersion},
+			},
+			Properties: map[string]any{"autoscale": true, "capacity": "L"},
+		}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{Properties: map[string]any{"autoscale": false, "replicas": float64(2), "size": "M"}}
+
+		response, err := makeValidationFilter(ucpClient)(createTestContext(t), resource, nil, nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+
+	t.Run("rule fails", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{Properties: map[string]any{"autoscale": false, "replicas": float64(0)}}
+
+		response, err := makeValidationFilter(ucpClient)(createTestContext(t), resource, nil, nil)
+		require.NoError(t, err)
+
+		badRequest, ok := response.(*rest.BadRequestResponse)
+		require.True(t, ok)
+		require.Equal(t, "Invalid properties: replicas must be greater than 0 when autoscale is false.", badRequest.Body.Error.Message)
+	})
+
+	t.Run("transition rule compares the existing resource in the request version", func(t *testing.T) {
+		oldResource := storedResource()
+		resource := &datamodel.DynamicResource{Properties: map[string]any{"autoscale": true, "size": "L"}}
+
+		response, err := makeValidationFilter(ucpClient)(createTestContext(t), resource, oldResource, nil)
+		require.NoError(t, err)
+		require.Nil(t, response)
+
+		// The stored properties are used by the conversion filter, so they must not be converted in place.
+		require.Equal(t, storedResource().Properties, oldResource.Properties)
+	})
+
+	t.Run("transition rule fails", func(t *testing.T) {
+		resource := &datamodel.DynamicResource{Properties: map[string]any{"autoscale": true, "size": "XL"}}
+
+		response, err := makeValidationFilter(ucpClient)(createTestContext(t), resource, storedResource(), nil)
+		require.NoError(t, err)
+
+		badRequest, ok := response.(*rest.BadRequestResponse)
+		require.True(t, ok)
+		require.Equal(t, `Invalid properties: error at "size": size is immutable.`, badRequest.Body.Error.Message)
+	})
+
+	t.Run("fetch error", func(t *testing.T) {
+		ucpClient, err := testUCPClientFactoryWithError()
+		require.NoError(t, err)
+
+		resource := &datamodel.DynamicResource{Properties: map[string]any{"size": "L"}}
+
+		response, err := makeValidationFilter(ucpClient)(createTestContext(t), resource, nil, nil)
+		require.NoError(t, err)
+
+		errorResponse, ok := response.(*rest.InternalServerErrorResponse)
+		require.True(t, ok)
+		require.Equal(t, v1.CodeInternal, errorResponse.Body.Error.Code)
+	})
+}
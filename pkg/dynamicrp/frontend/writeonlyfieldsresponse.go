@@ -0,0 +1,103 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/api"
+	"github.com/radius-project/radius/pkg/schema"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// withoutWriteOnlyFields wraps the factory of a PUT, PATCH or DELETE controller, so that the resource it responds
+// with does not contain write-only fields.
+func withoutWriteOnlyFields(
+	ucpClient *v20231001preview.ClientFactory,
+	factory func(opts ctrl.Options) (ctrl.Controller, error),
+) func(opts ctrl.Options) (ctrl.Controller, error) {
+	return func(opts ctrl.Options) (ctrl.Controller, error) {
+		controller, err := factory(opts)
+		if err != nil {
+			return nil, err
+		}
+
+		return &WriteOnlyFieldsResponse{Controller: controller, ucpClient: ucpClient}, nil
+	}
+}
+
+// WriteOnlyFieldsResponse is a controller that removes the write-only fields from the resource returned by the
+// controller it wraps. The resource is expected in the shape of the request API version, see
+// withRequestAPIVersionResponse.
+type WriteOnlyFieldsResponse struct {
+	ctrl.Controller
+	ucpClient *v20231001preview.ClientFactory
+}
+
+// Run runs the wrapped controller, and removes the write-only fields from the resource in its response.
+func (c *WriteOnlyFieldsResponse) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	response, err := c.Controller.Run(ctx, w, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := asyncResponseResource(response)
+	if resource == nil {
+		return response, nil
+	}
+
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	resourceID := serviceCtx.ResourceID.String()
+	resourceType := serviceCtx.ResourceID.Type()
+
+	annotations, err := schema.GetFieldAnnotations(ctx, c.ucpClient, resourceID, resourceType, serviceCtx.APIVersion)
+	if err != nil {
+		logger.Error(err, "Failed to fetch field annotations for response",
+			"resourceType", resourceType, "apiVersion", serviceCtx.APIVersion)
+		return rest.NewInternalServerErrorARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInternal,
+				Message: "Failed to fetch schema to remove write-only fields",
+			},
+		}), nil
+	}
+	removeWriteOnlyFields(ctx, resourceID, resource.Properties, annotations)
+
+	return response, nil
+}
+
+// asyncResponseResource returns the resource of an async operation response, or nil if the response does not contain
+// a resource with properties.
+func asyncResponseResource(response rest.Response) *api.DynamicResource {
+	asyncResponse, ok := response.(*rest.AsyncOperationResponse)
+	if !ok {
+		return nil
+	}
+	resource, ok := asyncResponse.Body.(*api.DynamicResource)
+	if !ok || resource.Properties == nil {
+		return nil
+	}
+
+	return resource
+}
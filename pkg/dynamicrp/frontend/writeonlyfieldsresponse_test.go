@@ -0,0 +1,97 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package frontend
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	ctrl "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/dynamicrp/api"
+	"github.com/radius-project/radius/pkg/dynamicrp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeController is a controller that returns a fixed response.
+type fakeController struct {
+	response rest.Response
+}
+
+func (c *fakeController) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (rest.Response, error) {
+	return c.response, nil
+}
+
+// newTestResourceResponse returns the response of a PUT for a resource stored at the given API version.
+func newTestResourceResponse(t *testing.T, apiVersion string, properties map[string]any) *rest.AsyncOperationResponse {
+	resource := &api.DynamicResource{}
+	require.NoError(t, resource.ConvertFrom(&datamodel.DynamicResource{
+		BaseResource: v1.BaseResource{
+			InternalMetadata: v1.InternalMetadata{UpdatedAPIVersion: apiVersion},
+		},
+		Properties: properties,
+	}))
+	return rest.NewAsyncOperationResponse(resource, "global", http.StatusCreated, mustParseResourceID(testResourceID), uuid.New(), testAPIVersion, "", "")
+}
+
+func TestWriteOnlyFieldsResponse(t *testing.T) {
+	ucpClient, err := createFakeUCPClientFactoryWithVersions(testFieldAnnotationSchemas)
+	require.NoError(t, err)
+
+	run := func(t *testing.T, response rest.Response) rest.Response {
+		factory := withoutWriteOnlyFields(ucpClient, func(opts ctrl.Options) (ctrl.Controller, error) {
+			return &fakeController{response: response}, nil
+		})
+		controller, err := factory(ctrl.Options{})
+		require.NoError(t, err)
+
+		result, err := controller.Run(createTestContext(t), nil, nil)
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("removes write-only fields of the request API version", func(t *testing.T) {
+		response := newTestResourceResponse(t, testAPIVersion, map[string]any{"size": "L", "password": "secret123"})
+
+		run(t, response)
+		require.Equal(t, map[string]any{"size": "L", "provisioningState": "Succeeded"}, response.Body.(*api.DynamicResource).Properties)
+	})
+
+	t.Run("other responses are returned unchanged", func(t *testing.T) {
+		response := rest.NewBadRequestResponse("invalid")
+		require.Same(t, response, run(t, response))
+	})
+
+	t.Run("fetch error", func(t *testing.T) {
+		ucpClient, err := testUCPClientFactoryWithError()
+		require.NoError(t, err)
+
+		controller := &WriteOnlyFieldsResponse{
+			Controller: &fakeController{response: newTestResourceResponse(t, testAPIVersion, map[string]any{"password": "secret123"})},
+			ucpClient:  ucpClient,
+		}
+		result, err := controller.Run(createTestContext(t), nil, nil)
+		require.NoError(t, err)
+
+		errorResponse, ok := result.(*rest.InternalServerErrorResponse)
+		require.True(t, ok)
+		require.Equal(t, v1.CodeInternal, errorResponse.Body.Error.Code)
+	})
+}
@@ -31,11 +31,13 @@ import (
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/cache"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/driver/bicep"
 	"github.com/radius-project/radius/pkg/recipes/driver/terraform"
 	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/recipes/lease"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/sdk/clients"
 	ucpconfig "github.com/radius-project/radius/pkg/ucp/config"
@@ -74,6 +76,9 @@ type Options struct {
 
 // RecipeOptions holds the configuration options for the recipe engine subsystem.
 type RecipeOptions struct {
+	// Cache is the cache of recipe templates, modules and providers. It is nil when the cache is disabled.
+	Cache *cache.Cache
+
 	// ConfigurationLoader is the loader for recipe configurations.
 	ConfigurationLoader configloader.ConfigurationLoader
 
@@ -118,6 +123,16 @@ func NewOptions(ctx context.Context, config *Config) (*Options, error) {
 		return nil, err
 	}
 
+	if config.RecipeCache.Path != "" {
+		options.Recipes.Cache, err = cache.New(cache.Options{
+			Dir:          config.RecipeCache.Path,
+			MaxSizeBytes: config.RecipeCache.MaxSizeMB * 1024 * 1024,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	options.Recipes.ConfigurationLoader = configloader.NewEnvironmentLoader(sdk.NewClientOptions(options.UCP))
 	options.Recipes.SecretsLoader = configloader.NewSecretStoreLoader(sdk.NewClientOptions(options.UCP), options.KubernetesProvider)
 
@@ -159,7 +174,9 @@ func (o *Options) RecipeEngine() (engine.Engine, error) {
 	return engine.NewEngine(engine.Options{
 		ConfigurationLoader: o.Recipes.ConfigurationLoader,
 		SecretsLoader:       o.Recipes.SecretsLoader,
-		Drivers:             drivers}), nil
+		Drivers:             drivers,
+		Leases:              lease.NewManager(o.DatabaseProvider, lease.Options{}),
+	}), nil
 }
 
 func bicepDriver(options *Options) (driver.Driver, error) {
@@ -201,6 +218,7 @@ func bicepDriver(options *Options) (driver.Driver, error) {
 		bicep.BicepOptions{
 			DeleteRetryCount:        bicepDeleteRetryCount,
 			DeleteRetryDelaySeconds: bicepDeleteRetryDeleteSeconds,
+			Cache:                   options.Recipes.Cache,
 		}), nil
 }
 
@@ -211,5 +229,6 @@ func terraformDriver(options *Options) (driver.Driver, error) {
 		terraform.TerraformOptions{
 			Path:     options.Config.Terraform.Path,
 			LogLevel: options.Config.Terraform.LogLevel,
+			Cache:    options.Recipes.Cache,
 		}, *options.KubernetesProvider), nil
 }
@@ -48,6 +48,16 @@ func NewServer(options *dynamicrp.Options) (*hosting.Host, error) {
 	services = append(services, frontend.NewService(options))
 	services = append(services, backend.NewService(options))
 
+	// Recipe drift detection is opt-in because it periodically re-plans every recipe deployment.
+	if options.Config.RecipeDrift.Enabled {
+		services = append(services, backend.NewDriftService(options))
+	}
+
+	// Storage version migration is opt-in because each pass reads every user-defined resource.
+	if options.Config.StorageMigration.Enabled {
+		services = append(services, backend.NewMigrationService(options))
+	}
+
 	return &hosting.Host{
 		Services: services,
 	}, nil
@@ -90,6 +90,23 @@ func Test_fromRecipeStatus(t *testing.T) {
 			TemplatePath:    new("/path/to/template.bicep"),
 			TemplateVersion: nil,
 		}},
+		{&rpv1.RecipeStatus{
+			TemplateKind: recipes.TemplateKindTerraform,
+			TemplatePath: "/path/to/template.tf",
+			Drift: &rpv1.RecipeDriftStatus{
+				State:            rpv1.RecipeDriftStateDrifted,
+				DriftedResources: []string{"azurerm_redis_cache.cache"},
+				Message:          "1 Terraform resource(s) changed outside of Terraform",
+			},
+		}, &RecipeStatus{
+			TemplateKind: to.Ptr(recipes.TemplateKindTerraform),
+			TemplatePath: new("/path/to/template.tf"),
+			Drift: &RecipeDriftStatus{
+				State:            to.Ptr(RecipeDriftStateDrifted),
+				DriftedResources: []*string{new("azurerm_redis_cache.cache")},
+				Message:          new("1 Terraform resource(s) changed outside of Terraform"),
+			},
+		}},
 	}
 
 	for _, tt := range testCases {
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/go-logr/logr"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -82,13 +83,15 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 
 	currentETag := storedResource.ETag
 	var recipeProperties map[string]any
+	var schema map[string]any
 	redactionCompleted := false
 
 	// Attempt to decrypt and redact sensitive fields before recipe execution.
 	apiVersion := getResourceAPIVersion(resource)
 	if apiVersion != "" {
 		resourceType := resource.GetBaseResource().Type
-		schema, schemaErr := schemautil.GetSchema(ctx, c.UcpClient(), req.ResourceID, resourceType, apiVersion)
+		var schemaErr error
+		schema, schemaErr = schemautil.GetSchema(ctx, c.UcpClient(), req.ResourceID, resourceType, apiVersion)
 		if schemaErr != nil {
 			return ctrl.Result{}, fmt.Errorf("failed to fetch schema for sensitive field detection: %w", schemaErr)
 		} else if schema != nil {
@@ -171,9 +174,9 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 	recipeDataModel, supportsRecipes := any(resource).(datamodel.RecipeDataModel)
 	var recipeOutput *recipes.RecipeOutput
 	if supportsRecipes && recipeDataModel.GetRecipe() != nil {
-		recipeOutput, err = c.executeRecipeIfNeeded(ctx, resource, recipeDataModel, previousOutputResources, config.Simulated, recipeProperties)
+		recipeOutput, err = c.executeRecipeIfNeeded(ctx, resource, recipeDataModel, previousOutputResources, config.Simulated, recipeProperties, schema)
 		if err != nil {
-			return c.handleRecipeError(ctx, err, recipeDataModel, req.ResourceID, currentETag, logger, redactionCompleted)
+			return c.handleRecipeError(ctx, err, recipeOutput, recipeDataModel, req.ResourceID, currentETag, logger, redactionCompleted)
 		}
 	}
 
@@ -223,13 +226,19 @@ func (c *CreateOrUpdateResource[P, T]) Run(ctx context.Context, req *ctrl.Reques
 // best-effort status persistence fails, so the real recipe failure is never masked. For non-RecipeError failures,
 // when redactionCompleted is true the failure is made terminal (NewFailedResult) to prevent a retry that would fail
 // because sensitive data has already been nullified from the database.
-func (c *CreateOrUpdateResource[P, T]) handleRecipeError(ctx context.Context, err error, recipeDataModel datamodel.RecipeDataModel, resourceID string, etag string, logger logr.Logger, redactionCompleted bool) (ctrl.Result, error) {
+func (c *CreateOrUpdateResource[P, T]) handleRecipeError(ctx context.Context, err error, recipeOutput *recipes.RecipeOutput, recipeDataModel datamodel.RecipeDataModel, resourceID string, etag string, logger logr.Logger, redactionCompleted bool) (ctrl.Result, error) {
 	var recipeErr *recipes.RecipeError
 	if errors.As(err, &recipeErr) {
 		logger.Error(recipeErr, fmt.Sprintf("failed to execute recipe. Encountered error while processing %s ", recipeErr.ErrorDetails.Target))
 
 		// Set the deployment status to the recipe error code
 		recipeDataModel.GetRecipe().DeploymentStatus = util.RecipeDeploymentStatus(recipeErr.DeploymentStatus)
+
+		// The recipe can fail after it has deployed its resources, for example when its outputs don't match the
+		// resource type schema. Record the deployed resources so that they are deleted with the resource.
+		if err := recordRecipeOutputResources(recipeDataModel.(rpv1.RadiusResourceModel), recipeOutput); err != nil {
+			logger.Error(err, "failed to record the output resources of the failed recipe")
+		}
 		update := &database.Object{
 			Metadata: database.Metadata{ID: resourceID},
 			Data:     recipeDataModel.(rpv1.RadiusResourceModel),
@@ -256,6 +265,33 @@ func (c *CreateOrUpdateResource[P, T]) handleRecipeError(ctx context.Context, er
 	return ctrl.Result{}, err
 }
 
+// recordRecipeOutputResources adds the resources deployed by the recipe to the output resources of the resource.
+func recordRecipeOutputResources(resource rpv1.RadiusResourceModel, recipeOutput *recipes.RecipeOutput) error {
+	if recipeOutput == nil || len(recipeOutput.Resources) == 0 {
+		return nil
+	}
+
+	deployed, err := processors.GetOutputResourcesFromRecipe(recipeOutput)
+	if err != nil {
+		return err
+	}
+
+	status := resource.ResourceMetadata().GetResourceStatus()
+	existing := map[string]bool{}
+	for _, outputResource := range status.OutputResources {
+		existing[strings.ToLower(outputResource.ID.String())] = true
+	}
+
+	for _, outputResource := range deployed {
+		if !existing[strings.ToLower(outputResource.ID.String())] {
+			status.OutputResources = append(status.OutputResources, outputResource)
+		}
+	}
+
+	resource.ResourceMetadata().SetResourceStatus(status)
+	return nil
+}
+
 func (c *CreateOrUpdateResource[P, T]) copyOutputResources(resource P) []string {
 	previousOutputResources := []string{}
 	for _, outputResource := range resource.OutputResources() {
@@ -264,7 +300,7 @@ func (c *CreateOrUpdateResource[P, T]) copyOutputResources(resource P) []string
 	return previousOutputResources
 }
 
-func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context, resource P, recipeDataModel datamodel.RecipeDataModel, prevState []string, simulated bool, recipeProperties map[string]any) (*recipes.RecipeOutput, error) {
+func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context, resource P, recipeDataModel datamodel.RecipeDataModel, prevState []string, simulated bool, recipeProperties map[string]any, schema map[string]any) (*recipes.RecipeOutput, error) {
 	// Caller ensures recipeDataModel supports recipes and has a non-nil recipe
 	recipe := recipeDataModel.GetRecipe()
 
@@ -321,6 +357,7 @@ func (c *CreateOrUpdateResource[P, T]) executeRecipeIfNeeded(ctx context.Context
 		},
 		PreviousState: prevState,
 		Simulated:     simulated,
+		Schema:        schema,
 	})
 }
 
@@ -580,6 +580,81 @@ func TestCreateOrUpdateResource_Run_RecipeErrorSurfacedWhenStatusSaveFails(t *te
 	require.Equal(t, recipeErr.ErrorDetails.Message, res.Error.Message)
 }
 
+func TestCreateOrUpdateResource_Run_RecipeErrorRecordsDeployedResources(t *testing.T) {
+	// When the recipe fails after deploying its resources (for example because its outputs don't match the resource
+	// type schema), the deployed resources must be recorded so that they are deleted with the resource.
+	mctrl := gomock.NewController(t)
+	msc := database.NewMockClient(mctrl)
+	eng := engine.NewMockEngine(mctrl)
+	cfg := configloader.NewMockConfigurationLoader(mctrl)
+
+	data := map[string]any{
+		"name":     "tr",
+		"type":     TestResourceType,
+		"id":       TestResourceID,
+		"location": v1.LocationGlobal,
+		"properties": map[string]any{
+			"application":       TestApplicationID,
+			"environment":       TestEnvironmentID,
+			"provisioningState": "Accepted",
+			"status": map[string]any{
+				"outputResources": []map[string]any{
+					{"id": oldOutputResourceResourceID},
+				},
+			},
+			"recipe": map[string]any{
+				"name": "test-recipe",
+			},
+		},
+	}
+
+	msc.EXPECT().
+		Get(gomock.Any(), TestResourceID).
+		Return(&database.Object{Metadata: database.Metadata{ID: TestResourceID, ETag: "etag-1"}, Data: data}, nil).
+		Times(1)
+	cfg.EXPECT().LoadConfiguration(gomock.Any(), gomock.Any()).Return(&recipes.Configuration{}, nil).Times(1)
+
+	recipeErr := &recipes.RecipeError{
+		ErrorDetails: v1.ErrorDetails{
+			Code:    recipes.RecipeContractValidationFailed,
+			Message: "recipe output values do not match the resource type schema",
+		},
+	}
+	eng.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		Return(&recipes.RecipeOutput{Resources: []string{oldOutputResourceResourceID, newOutputResourceResourceID}}, recipeErr).
+		Times(1)
+
+	var saved *TestResource
+	msc.EXPECT().
+		Save(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, obj *database.Object, opts ...database.SaveOptions) error {
+			saved = obj.Data.(*TestResource)
+			return nil
+		}).
+		Times(1)
+
+	ctrlr, err := NewCreateOrUpdateResource(ctrl.Options{DatabaseClient: msc}, successProcessorReference, eng, cfg)
+	require.NoError(t, err)
+
+	res, err := ctrlr.Run(t.Context(), &ctrl.Request{
+		OperationID:      uuid.New(),
+		OperationType:    "APPLICATIONS.TEST/TESTRESOURCES|PUT",
+		ResourceID:       TestResourceID,
+		CorrelationID:    uuid.NewString(),
+		OperationTimeout: &ctrl.DefaultAsyncOperationTimeout,
+	})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProvisioningStateFailed, res.ProvisioningState())
+
+	require.NotNil(t, saved)
+	outputResourceIDs := []string{}
+	for _, outputResource := range saved.OutputResources() {
+		outputResourceIDs = append(outputResourceIDs, outputResource.ID.String())
+	}
+	require.Equal(t, []string{oldOutputResourceResourceID, newOutputResourceResourceID}, outputResourceIDs)
+}
+
 func TestCreateOrUpdateResource_Run_SensitiveRedaction(t *testing.T) {
 	mctrl := gomock.NewController(t)
 	msc := database.NewMockClient(mctrl)
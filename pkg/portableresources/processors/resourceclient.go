@@ -36,6 +36,7 @@ import (
 	resources_kubernetes "github.com/radius-project/radius/pkg/ucp/resources/kubernetes"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"go.opentelemetry.io/otel/attribute"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -86,6 +87,32 @@ func (c *resourceClient) Delete(ctx context.Context, id string) error {
 	}
 }
 
+// Exists checks whether a resource exists, either through UCP, Azure, or Kubernetes, depending on the resource type.
+func (c *resourceClient) Exists(ctx context.Context, id string) (bool, error) {
+	parsed, err := resources.ParseResource(id)
+	if err != nil {
+		return false, err
+	}
+
+	attributes := []attribute.KeyValue{{Key: attribute.Key(ucplog.LogFieldTargetResourceID), Value: attribute.StringValue(id)}}
+	ctx, span := trace.StartCustomSpan(ctx, "resourceclient.Exists", trace.BackendTracerName, attributes)
+	defer span.End()
+
+	// Azure and Kubernetes resources are handled as special cases here, the same as Delete.
+	ns := strings.ToLower(parsed.PlaneNamespace())
+
+	var exists bool
+	if !parsed.IsUCPQualified() || strings.HasPrefix(ns, "azure/") {
+		exists, err = c.azureResourceExists(ctx, parsed)
+	} else if strings.HasPrefix(ns, "kubernetes/") {
+		exists, err = c.kubernetesResourceExists(ctx, parsed)
+	} else {
+		exists, err = c.ucpResourceExists(ctx, parsed)
+	}
+
+	return exists, c.wrapError(parsed, err)
+}
+
 func (c *resourceClient) wrapError(id resources.ID, err error) error {
 	if err != nil {
 		return &ResourceError{Inner: err, ID: id.String()}
@@ -136,6 +163,35 @@ func (c *resourceClient) deleteAzureResource(ctx context.Context, id resources.I
 	return nil
 }
 
+func (c *resourceClient) azureResourceExists(ctx context.Context, id resources.ID) (bool, error) {
+	var err error
+	if id.IsUCPQualified() {
+		id, err = resources.ParseResource(resources.MakeRelativeID(id.ScopeSegments()[1:], id.TypeSegments(), id.ExtensionSegments()))
+		if err != nil {
+			return false, err
+		}
+	}
+
+	apiVersion, err := c.lookupARMAPIVersion(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	client, err := clientv2.NewGenericResourceClient(id.FindScope(resources_azure.ScopeSubscriptions), &c.arm.ClientOptions, c.armClientOptions)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = client.GetByID(ctx, id.String(), apiVersion, &armresources.ClientGetByIDOptions{})
+	if clients.Is404Error(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (c *resourceClient) lookupARMAPIVersion(ctx context.Context, id resources.ID) (string, error) {
 	client, err := clientv2.NewProvidersClient(id.FindScope(resources_azure.ScopeSubscriptions), &c.arm.ClientOptions, c.armClientOptions)
 	if err != nil {
@@ -204,6 +260,53 @@ func (c *resourceClient) deleteUCPResource(ctx context.Context, id resources.ID)
 	return nil
 }
 
+func (c *resourceClient) ucpResourceExists(ctx context.Context, id resources.ID) (bool, error) {
+	// NOTE: see deleteUCPResource for details on how the API version is handled.
+	client, err := generated.NewGenericResourcesClient(id.Type(), id.RootScope(), &aztoken.AnonymousCredential{}, sdk.NewClientOptions(c.connection))
+	if err != nil {
+		return false, err
+	}
+
+	_, err = client.Get(ctx, id.Name(), nil)
+	if clients.Is404Error(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (c *resourceClient) kubernetesResourceExists(ctx context.Context, id resources.ID) (bool, error) {
+	apiVersion, err := c.lookupKubernetesAPIVersion(id)
+	if err != nil {
+		return false, err
+	}
+
+	group, kind, namespace, name := resources_kubernetes.ToParts(id)
+	if group != "" {
+		apiVersion = fmt.Sprintf("%s/%s", group, apiVersion)
+	}
+
+	obj := unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+
+	runtimeClient, err := c.kubernetesClient.RuntimeClient()
+	if err != nil {
+		return false, err
+	}
+
+	err = runtimeClient.Get(ctx, runtime_client.ObjectKey{Namespace: namespace, Name: name}, &obj)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (c *resourceClient) deleteKubernetesResource(ctx context.Context, id resources.ID) error {
 	apiVersion, err := c.lookupKubernetesAPIVersion(id)
 	if err != nil {
@@ -0,0 +1,346 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache is a content-addressed cache of the artifacts downloaded by recipe drivers, such as Bicep templates and
+// Terraform modules. Artifacts are keyed by their source and version, and their content is stored once per digest
+// and verified against it when read.
+//
+// Files are written to a temporary location and renamed into place, so the cache directory can be shared by several
+// processes, for example on a persistent volume.
+type Cache struct {
+	dir     string
+	maxSize int64
+
+	mu sync.Mutex
+}
+
+// New creates a recipe cache stored in options.Dir.
+func New(options Options) (*Cache, error) {
+	if options.Dir == "" {
+		return nil, errors.New("the recipe cache directory is required")
+	}
+
+	c := &Cache{dir: options.Dir, maxSize: options.MaxSizeBytes}
+	for _, dir := range []string{blobsDir, indexDir, tmpDir, terraformProvidersDir} {
+		if err := os.MkdirAll(filepath.Join(c.dir, dir), dirMode); err != nil {
+			return nil, fmt.Errorf("failed to create the recipe cache directory: %w", err)
+		}
+	}
+
+	return c, nil
+}
+
+// Key returns the cache key of an artifact from its source and version. The version can be empty when it is part of
+// the source, for example in the tag of an OCI reference.
+func Key(source string, version string) string {
+	if version == "" {
+		return source
+	}
+	return source + "@" + version
+}
+
+// Dir returns the directory where the cache is stored.
+func (c *Cache) Dir() string {
+	return c.dir
+}
+
+// TerraformProvidersDir returns the directory of the cache used as a Terraform provider filesystem mirror.
+func (c *Cache) TerraformProvidersDir() string {
+	return filepath.Join(c.dir, terraformProvidersDir)
+}
+
+// Get returns the content of the artifact with the given key. It returns ErrNotFound when the artifact is not cached
+// and ErrIntegrity when its content does not match its digest.
+func (c *Cache) Get(key string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, err := c.readEntry(key)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(c.blobPath(entry.Digest))
+	if errors.Is(err, fs.ErrNotExist) {
+		_ = os.Remove(c.entryPath(key))
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if digest(data) != entry.Digest {
+		_ = os.Remove(c.entryPath(key))
+		_ = os.Remove(c.blobPath(entry.Digest))
+		return nil, fmt.Errorf("%w: %s", ErrIntegrity, key)
+	}
+
+	// The access time is only used for eviction, so failing to update it does not fail the read.
+	entry.LastAccessedAt = time.Now().UTC()
+	_ = c.writeEntry(entry)
+
+	return data, nil
+}
+
+// Put stores the content of the artifact with the given key, replacing the previous content, and evicts the least
+// recently used artifacts if the cache exceeds its size limit.
+func (c *Cache) Put(key string, data []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.maxSize > 0 && int64(len(data)) > c.maxSize {
+		return fmt.Errorf("the artifact %q is larger than the recipe cache size limit of %d bytes", key, c.maxSize)
+	}
+
+	d := digest(data)
+	if _, err := os.Stat(c.blobPath(d)); errors.Is(err, fs.ErrNotExist) {
+		if err := c.writeFile(c.blobPath(d), data); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	err := c.writeEntry(&Entry{
+		Key:            key,
+		Digest:         d,
+		Size:           int64(len(data)),
+		CreatedAt:      now,
+		LastAccessedAt: now,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.prune()
+}
+
+// Delete removes the artifact with the given key from the cache.
+func (c *Cache) Delete(key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	err := os.Remove(c.entryPath(key))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return c.removeUnreferencedBlobs()
+}
+
+// Entries returns the entries of the cache sorted by key.
+func (c *Cache) Entries() ([]Entry, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entries, err := c.readEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+	return entries, nil
+}
+
+// prune evicts the least recently used artifacts until the total size of the cached content is within the size limit.
+func (c *Cache) prune() error {
+	if c.maxSize <= 0 {
+		return nil
+	}
+
+	entries, err := c.readEntries()
+	if err != nil {
+		return err
+	}
+
+	// Content shared by several entries is only stored and counted once.
+	refs := map[string]int{}
+	sizes := map[string]int64{}
+	var total int64
+	for _, entry := range entries {
+		if refs[entry.Digest] == 0 {
+			total += entry.Size
+			sizes[entry.Digest] = entry.Size
+		}
+		refs[entry.Digest]++
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].LastAccessedAt.Before(entries[j].LastAccessedAt) })
+	for _, entry := range entries {
+		if total <= c.maxSize {
+			break
+		}
+
+		if err := os.Remove(c.entryPath(entry.Key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		refs[entry.Digest]--
+		if refs[entry.Digest] == 0 {
+			if err := os.Remove(c.blobPath(entry.Digest)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+			total -= sizes[entry.Digest]
+		}
+	}
+
+	return nil
+}
+
+// removeUnreferencedBlobs removes the content that is no longer referenced by any entry.
+func (c *Cache) removeUnreferencedBlobs() error {
+	entries, err := c.readEntries()
+	if err != nil {
+		return err
+	}
+
+	referenced := map[string]bool{}
+	for _, entry := range entries {
+		referenced[strings.TrimPrefix(entry.Digest, "sha256:")] = true
+	}
+
+	files, err := os.ReadDir(filepath.Join(c.dir, blobsDir))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if !referenced[file.Name()] {
+			if err := os.Remove(filepath.Join(c.dir, blobsDir, file.Name())); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *Cache) readEntry(key string) (*Entry, error) {
+	b, err := os.ReadFile(c.entryPath(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	entry := &Entry{}
+	if err := json.Unmarshal(b, entry); err != nil || entry.Key != key {
+		// A corrupted entry is treated as a cache miss and replaced by the next Put.
+		_ = os.Remove(c.entryPath(key))
+		return nil, ErrNotFound
+	}
+
+	return entry, nil
+}
+
+func (c *Cache) readEntries() ([]Entry, error) {
+	files, err := os.ReadDir(filepath.Join(c.dir, indexDir))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := []Entry{}
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != ".json" {
+			continue
+		}
+
+		b, err := os.ReadFile(filepath.Join(c.dir, indexDir, file.Name()))
+		if errors.Is(err, fs.ErrNotExist) {
+			// The entry was removed concurrently.
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		entry := Entry{}
+		if err := json.Unmarshal(b, &entry); err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (c *Cache) writeEntry(entry *Entry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	return c.writeFile(c.entryPath(entry.Key), b)
+}
+
+// writeFile writes a file of the cache atomically by writing it to a temporary file and renaming it into place.
+func (c *Cache) writeFile(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Join(c.dir, tmpDir), "write-*")
+	if err != nil {
+		return fmt.Errorf("failed to write to the recipe cache: %w", err)
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write to the recipe cache: %w", err)
+	}
+
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write to the recipe cache: %w", err)
+	}
+
+	if err := os.Chmod(f.Name(), fileMode); err != nil {
+		return fmt.Errorf("failed to write to the recipe cache: %w", err)
+	}
+
+	if err := os.Rename(f.Name(), path); err != nil {
+		return fmt.Errorf("failed to write to the recipe cache: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Cache) entryPath(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, indexDir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (c *Cache) blobPath(d string) string {
+	return filepath.Join(c.dir, blobsDir, strings.TrimPrefix(d, "sha256:"))
+}
+
+// digest returns the sha256 digest of the content in the form "sha256:<hex>".
+func digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
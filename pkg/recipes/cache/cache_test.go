@@ -0,0 +1,147 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_New_RequiresDir(t *testing.T) {
+	_, err := New(Options{})
+	require.Error(t, err)
+}
+
+func Test_Key(t *testing.T) {
+	require.Equal(t, "ghcr.io/radius-project/recipes/redis:1.0", Key("ghcr.io/radius-project/recipes/redis:1.0", ""))
+	require.Equal(t, "Azure/cosmosdb/azurerm@1.2.0", Key("Azure/cosmosdb/azurerm", "1.2.0"))
+}
+
+func Test_PutGet(t *testing.T) {
+	c, err := New(Options{Dir: t.TempDir()})
+	require.NoError(t, err)
+
+	_, err = c.Get("a")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	require.NoError(t, c.Put("a", []byte("content")))
+	require.NoError(t, c.Put("b", []byte("content")))
+
+	data, err := c.Get("a")
+	require.NoError(t, err)
+	require.Equal(t, "content", string(data))
+
+	// Identical content is stored once.
+	blobs, err := os.ReadDir(filepath.Join(c.Dir(), blobsDir))
+	require.NoError(t, err)
+	require.Len(t, blobs, 1)
+
+	require.NoError(t, c.Delete("a"))
+	_, err = c.Get("a")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	data, err = c.Get("b")
+	require.NoError(t, err)
+	require.Equal(t, "content", string(data))
+
+	entries, err := c.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "b", entries[0].Key)
+	require.Equal(t, digest([]byte("content")), entries[0].Digest)
+}
+
+func Test_Get_IntegrityFailure(t *testing.T) {
+	c, err := New(Options{Dir: t.TempDir()})
+	require.NoError(t, err)
+
+	require.NoError(t, c.Put("a", []byte("content")))
+	require.NoError(t, os.WriteFile(c.blobPath(digest([]byte("content"))), []byte("tampered"), fileMode))
+
+	_, err = c.Get("a")
+	require.ErrorIs(t, err, ErrIntegrity)
+
+	// The corrupted entry is removed.
+	_, err = c.Get("a")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func Test_Put_EvictsLeastRecentlyUsed(t *testing.T) {
+	c, err := New(Options{Dir: t.TempDir(), MaxSizeBytes: 10})
+	require.NoError(t, err)
+
+	require.NoError(t, c.Put("a", []byte("aaaa")))
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, c.Put("b", []byte("bbbb")))
+	time.Sleep(10 * time.Millisecond)
+
+	// Reading "a" makes "b" the least recently used artifact.
+	_, err = c.Get("a")
+	require.NoError(t, err)
+	time.Sleep(10 * time.Millisecond)
+
+	require.NoError(t, c.Put("c", []byte("cccc")))
+
+	_, err = c.Get("b")
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, err = c.Get("a")
+	require.NoError(t, err)
+	_, err = c.Get("c")
+	require.NoError(t, err)
+
+	err = c.Put("d", []byte("larger than the limit"))
+	require.Error(t, err)
+}
+
+func Test_PutDirGetDir(t *testing.T) {
+	c, err := New(Options{Dir: t.TempDir()})
+	require.NoError(t, err)
+
+	src := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "default", "modules"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "modules.json"), []byte(`{"Modules":[]}`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "default", "main.tf"), []byte(`variable "context" {}`), 0644))
+	require.NoError(t, os.Symlink("main.tf", filepath.Join(src, "default", "link.tf")))
+
+	require.NoError(t, c.PutDir("module", src))
+
+	// Archiving the same content again produces the same digest.
+	require.NoError(t, c.PutDir("module-copy", src))
+	entries, err := c.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, entries[0].Digest, entries[1].Digest)
+
+	dst := filepath.Join(t.TempDir(), "modules")
+	require.NoError(t, c.GetDir("module", dst))
+
+	b, err := os.ReadFile(filepath.Join(dst, "default", "main.tf"))
+	require.NoError(t, err)
+	require.Equal(t, `variable "context" {}`, string(b))
+
+	link, err := os.Readlink(filepath.Join(dst, "default", "link.tf"))
+	require.NoError(t, err)
+	require.Equal(t, "main.tf", link)
+
+	require.DirExists(t, filepath.Join(dst, "default", "modules"))
+	require.FileExists(t, filepath.Join(dst, "modules.json"))
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// blobsDir is the directory of the cache that stores the content of the cached artifacts, named by their digest.
+	blobsDir = "blobs/sha256"
+
+	// indexDir is the directory of the cache that stores the entries mapping a key to the digest of its content.
+	indexDir = "index"
+
+	// tmpDir is the directory of the cache where files are written before they are moved into place.
+	tmpDir = "tmp"
+
+	// terraformProvidersDir is the directory of the cache used as a Terraform provider filesystem mirror.
+	terraformProvidersDir = "terraform/providers"
+
+	dirMode  = 0755
+	fileMode = 0644
+)
+
+var (
+	// ErrNotFound is returned when the cache has no entry for a key.
+	ErrNotFound = errors.New("the artifact is not in the recipe cache")
+
+	// ErrIntegrity is returned when the content of a cached artifact does not match its digest. The entry is removed
+	// from the cache.
+	ErrIntegrity = errors.New("the cached artifact failed integrity verification")
+)
+
+// Options represents the options of the recipe cache.
+type Options struct {
+	// Dir is the directory where the cache is stored, typically on a persistent volume shared across restarts.
+	Dir string
+
+	// MaxSizeBytes is the maximum total size of the cached artifacts. The least recently used artifacts are evicted
+	// when the limit is exceeded. Zero means no limit.
+	MaxSizeBytes int64
+}
+
+// Entry is an entry of the cache index that maps a key to the content of a cached artifact.
+type Entry struct {
+	// Key is the key of the cached artifact, identifying its source and version.
+	Key string `json:"key"`
+
+	// Digest is the sha256 digest of the content of the artifact, in the form "sha256:<hex>".
+	Digest string `json:"digest"`
+
+	// Size is the size of the content of the artifact in bytes.
+	Size int64 `json:"size"`
+
+	// CreatedAt is the time the artifact was added to the cache.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// LastAccessedAt is the time the artifact was last read from the cache.
+	LastAccessedAt time.Time `json:"lastAccessedAt"`
+}
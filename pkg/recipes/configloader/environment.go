@@ -177,6 +177,11 @@ func getConfigurationV20250801(ctx context.Context, environment *v20250801previe
 				Scope: "/planes/aws/aws/accounts/" + envDatamodel.Properties.Providers.AWS.AccountID + "/regions/" + envDatamodel.Properties.Providers.AWS.Region,
 			}
 		}
+		if envDatamodel.Properties.Providers.GCP != nil {
+			config.Providers.GCP = datamodel.ProvidersGCP{
+				Scope: "/planes/gcp/gcp/projects/" + envDatamodel.Properties.Providers.GCP.ProjectID + "/locations/" + envDatamodel.Properties.Providers.GCP.Region,
+			}
+		}
 	}
 	// Radius enables keying in of a preexisting namespace for kubernetes resources using
 	// properties.providers.kubernetes.namespace. However, it does not mandate configuring a
@@ -190,6 +195,13 @@ func getConfigurationV20250801(ctx context.Context, environment *v20250801previe
 		config.Simulated = true
 	}
 
+	if drift := envDatamodel.Properties.RecipeDrift; drift != nil {
+		config.DriftPolicy = recipes.DriftPolicy{
+			Disabled:      drift.Enabled != nil && !*drift.Enabled,
+			AutoRemediate: drift.AutoRemediate,
+		}
+	}
+
 	// Resolve TerraformSettings resource if referenced.
 	if envDatamodel.Properties.TerraformSettings != "" {
 		tfConfig, err := util.FetchTerraformSettings(ctx, envDatamodel.Properties.TerraformSettings, armOptions)
@@ -380,7 +392,7 @@ func getRecipeDefinitionFromEnvironmentV20250801(ctx context.Context, environmen
 		templatePath := recipeDefinition.Source
 		templateVersion := ""
 		if strings.EqualFold(recipeDefinition.Kind, recipes.TemplateKindTerraform) {
-			templatePath, templateVersion = parseTerraformModuleSource(recipeDefinition.Source)
+			templatePath, templateVersion = ParseTerraformModuleSource(recipeDefinition.Source)
 		}
 
 		// TODO: For now, we can set "Name" to default as recipe packs don't have named recipes.
@@ -402,7 +414,7 @@ func getRecipeDefinitionFromEnvironmentV20250801(ctx context.Context, environmen
 	return nil, fmt.Errorf("could not find any recipe pack for %q in environment %q", resource.Type(), recipe.EnvironmentID)
 }
 
-// parseTerraformModuleSource splits a Terraform module reference of the form "<source>:<version>"
+// ParseTerraformModuleSource splits a Terraform module reference of the form "<source>:<version>"
 // into its source and version components, implementing the Radius convention for pinning Terraform
 // registry modules (https://github.com/radius-project/radius/issues/12086). This mirrors the way
 // Bicep/OCI recipes embed the version in the image tag (e.g. "ghcr.io/org/recipe:1.0.0"). Terraform
@@ -416,7 +428,7 @@ func getRecipeDefinitionFromEnvironmentV20250801(ctx context.Context, environmen
 // the ":" must appear in the final path segment (after the last "/"), so a registry "host:port" such as
 // "my.registry.com:8443/ns/name/aws" is not mistaken for a version. References without a recognized
 // suffix are returned unchanged with an empty version.
-func parseTerraformModuleSource(location string) (source string, version string) {
+func ParseTerraformModuleSource(location string) (source string, version string) {
 	if strings.Contains(location, "://") {
 		return location, ""
 	}
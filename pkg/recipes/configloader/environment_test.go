@@ -492,6 +492,38 @@ func TestGetConfigurationV20250801(t *testing.T) {
 				Simulated: false,
 			},
 		},
+		{
+			name: "gcp provider with env resource v20250801",
+			envResource: &modelv20250801.EnvironmentResource{
+				Properties: &modelv20250801.EnvironmentProperties{
+					Providers: &modelv20250801.Providers{
+						Gcp: &modelv20250801.ProvidersGcp{
+							ProjectID: new("my-project"),
+							Region:    new("us-central1"),
+						},
+						Kubernetes: &modelv20250801.ProvidersKubernetes{
+							Namespace: new(envNamespace),
+						},
+					},
+					Simulated: new(false),
+				},
+			},
+			appResource: nil,
+			expectedConfig: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            envNamespace,
+						EnvironmentNamespace: envNamespace,
+					},
+				},
+				Providers: datamodel.Providers{
+					GCP: datamodel.ProvidersGCP{
+						Scope: "/planes/gcp/gcp/projects/my-project/locations/us-central1",
+					},
+				},
+				Simulated: false,
+			},
+		},
 		{
 			name: "simulated env v20250801",
 			envResource: &modelv20250801.EnvironmentResource{
@@ -515,6 +547,35 @@ func TestGetConfigurationV20250801(t *testing.T) {
 				Simulated: true,
 			},
 		},
+		{
+			name: "recipe drift policy v20250801",
+			envResource: &modelv20250801.EnvironmentResource{
+				Properties: &modelv20250801.EnvironmentProperties{
+					Providers: &modelv20250801.Providers{
+						Kubernetes: &modelv20250801.ProvidersKubernetes{
+							Namespace: new(envNamespace),
+						},
+					},
+					RecipeDrift: &modelv20250801.RecipeDriftPolicy{
+						Enabled:       new(false),
+						AutoRemediate: new(true),
+					},
+				},
+			},
+			appResource: nil,
+			expectedConfig: &recipes.Configuration{
+				Runtime: recipes.RuntimeConfiguration{
+					Kubernetes: &recipes.KubernetesRuntime{
+						Namespace:            envNamespace,
+						EnvironmentNamespace: envNamespace,
+					},
+				},
+				DriftPolicy: recipes.DriftPolicy{
+					Disabled:      true,
+					AutoRemediate: true,
+				},
+			},
+		},
 		{
 			name: "environment with recipe packs v20250801",
 			envResource: &modelv20250801.EnvironmentResource{
@@ -708,7 +769,7 @@ func Test_reconcileRecipeParameters(t *testing.T) {
 	}
 }
 
-func Test_parseTerraformModuleSource(t *testing.T) {
+func Test_ParseTerraformModuleSource(t *testing.T) {
 	tests := []struct {
 		name            string
 		location        string
@@ -798,7 +859,7 @@ func Test_parseTerraformModuleSource(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
-			source, version := parseTerraformModuleSource(tt.location)
+			source, version := ParseTerraformModuleSource(tt.location)
 			require.Equal(t, tt.expectedSource, source)
 			require.Equal(t, tt.expectedVersion, version)
 		})
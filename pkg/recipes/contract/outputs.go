@@ -0,0 +1,186 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contract
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/radius-project/radius/pkg/resourceutil"
+	"github.com/radius-project/radius/pkg/schema"
+)
+
+// ValidateOutputs validates the values and secrets produced by a recipe against the resource type schema for the
+// resource's API version.
+//
+// Only values whose name matches a property declared by the schema are validated, because those are the values
+// that are copied onto the resource. Secrets are validated against the keys declared in the schema's `secrets` block,
+// including the keys listed as required by the block. A nil schema disables validation.
+func ValidateOutputs(ctx context.Context, resourceSchema map[string]any, values map[string]any, secrets map[string]any) error {
+	if resourceSchema == nil {
+		return nil
+	}
+
+	properties, _ := resourceSchema["properties"].(map[string]any)
+
+	var errs []error
+
+	valueProperties := map[string]any{}
+	declaredValues := map[string]any{}
+	for key, value := range values {
+		if slices.Contains(resourceutil.BasicProperties, key) {
+			continue
+		}
+
+		if propertySchema, ok := properties[key]; ok {
+			valueProperties[key] = propertySchema
+			declaredValues[key] = value
+		}
+	}
+
+	if len(declaredValues) > 0 {
+		valueSchema := map[string]any{
+			"type":       "object",
+			"properties": valueProperties,
+		}
+		if err := schema.ValidateResourceAgainstSchema(ctx, map[string]any{"properties": declaredValues}, valueSchema); err != nil {
+			errs = append(errs, fmt.Errorf("recipe output values do not match the resource type schema: %w", err))
+		}
+	}
+
+	if secretsSchema, ok := secretsBlock(properties); ok {
+		secretProperties, required := secretDeclarations(secretsSchema)
+
+		declaredSecrets := map[string]any{}
+		for key, value := range secrets {
+			if value == nil {
+				continue
+			}
+
+			if _, ok := secretProperties[key]; !ok {
+				continue
+			}
+
+			// Secret values are stored as strings, mirroring how they are materialized.
+			if s, ok := value.(string); ok {
+				declaredSecrets[key] = s
+			} else {
+				declaredSecrets[key] = fmt.Sprintf("%v", value)
+			}
+		}
+
+		if len(declaredSecrets) > 0 || len(required) > 0 {
+			secretSchema := map[string]any{
+				"type":       "object",
+				"properties": secretProperties,
+			}
+			if len(required) > 0 {
+				secretSchema["required"] = required
+			}
+
+			if err := schema.ValidateResourceAgainstSchema(ctx, map[string]any{"properties": declaredSecrets}, secretSchema); err != nil {
+				errs = append(errs, fmt.Errorf("recipe output secrets do not match the resource type schema: %w", err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateOutputMappings validates the output mappings of a recipe that points directly at a module against the
+// resource type schema. outputs maps resource property names to module outputs and secretOutputs maps secret names
+// to module outputs.
+//
+// Unlike ValidateOutputs, this check does not need the recipe to run, so it can be used to validate a recipe pack
+// before it is used. A nil schema disables validation.
+func ValidateOutputMappings(resourceSchema map[string]any, outputs map[string]string, secretOutputs map[string]string) error {
+	if resourceSchema == nil {
+		return nil
+	}
+
+	properties, _ := resourceSchema["properties"].(map[string]any)
+
+	secretProperties := map[string]any{}
+	secretsSchema, hasSecretsBlock := secretsBlock(properties)
+	if hasSecretsBlock {
+		secretProperties, _ = secretDeclarations(secretsSchema)
+	}
+
+	var errs []error
+	for _, name := range sortedKeys(outputs) {
+		// Outputs keep the module's own secret classification, so a secret output may map onto a secret key.
+		if _, ok := secretProperties[name]; ok {
+			continue
+		}
+
+		if _, ok := properties[name]; !ok || slices.Contains(resourceutil.BasicProperties, name) {
+			errs = append(errs, fmt.Errorf("output %q is not a property declared by the resource type", name))
+		}
+	}
+
+	for _, name := range sortedKeys(secretOutputs) {
+		if !hasSecretsBlock {
+			errs = append(errs, fmt.Errorf("secret output %q cannot be mapped because the resource type does not declare a %q block", name, schema.SecretsBlockPropertyName))
+			continue
+		}
+
+		if _, ok := secretProperties[name]; !ok {
+			errs = append(errs, fmt.Errorf("secret output %q is not a secret declared by the resource type", name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// secretsBlock returns the schema of the `secrets` block declared in the given schema properties.
+func secretsBlock(properties map[string]any) (map[string]any, bool) {
+	secretsSchema, ok := properties[schema.SecretsBlockPropertyName].(map[string]any)
+	return secretsSchema, ok
+}
+
+// secretDeclarations returns the secret keys declared by a `secrets` block and the keys the block requires,
+// excluding the reserved secret reference that is populated by Radius.
+func secretDeclarations(secretsSchema map[string]any) (map[string]any, []any) {
+	secretProperties := map[string]any{}
+	if declared, ok := secretsSchema["properties"].(map[string]any); ok {
+		for key, value := range declared {
+			if key != schema.SecretNameReferenceKey {
+				secretProperties[key] = value
+			}
+		}
+	}
+
+	required := []any{}
+	switch declared := secretsSchema["required"].(type) {
+	case []any:
+		for _, key := range declared {
+			if key != schema.SecretNameReferenceKey {
+				required = append(required, key)
+			}
+		}
+	case []string:
+		for _, key := range declared {
+			if key != schema.SecretNameReferenceKey {
+				required = append(required, key)
+			}
+		}
+	}
+
+	return secretProperties, required
+}
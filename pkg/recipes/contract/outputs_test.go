@@ -0,0 +1,169 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contract
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testSchema() map[string]any {
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"environment": map[string]any{"type": "string"},
+			"application": map[string]any{"type": "string"},
+			"size": map[string]any{
+				"type": "string",
+				"enum": []any{"S", "M", "L"},
+			},
+			"host": map[string]any{
+				"type":     "string",
+				"readOnly": true,
+			},
+			"port": map[string]any{
+				"type":     "integer",
+				"readOnly": true,
+			},
+			"secrets": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"name": map[string]any{"type": "string", "readOnly": true},
+					"url":  map[string]any{"type": "string", "readOnly": true},
+				},
+				"required": []any{"url"},
+			},
+		},
+		"required": []any{"environment"},
+	}
+}
+
+func Test_ValidateOutputs(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  map[string]any
+		values  map[string]any
+		secrets map[string]any
+		errs    []string
+	}{
+		{
+			name:    "valid",
+			schema:  testSchema(),
+			values:  map[string]any{"host": "redis.default.svc", "port": float64(6379), "undeclared": true},
+			secrets: map[string]any{"url": "rediss://redis:6379", "undeclared": 1},
+		},
+		{
+			name:    "wrong value type",
+			schema:  testSchema(),
+			values:  map[string]any{"host": "redis.default.svc", "port": "6379"},
+			secrets: map[string]any{"url": "rediss://redis:6379"},
+			errs:    []string{`recipe output values do not match the resource type schema`, `"port"`},
+		},
+		{
+			name:    "value not in enum",
+			schema:  testSchema(),
+			values:  map[string]any{"size": "XL"},
+			secrets: map[string]any{"url": "rediss://redis:6379"},
+			errs:    []string{`recipe output values do not match the resource type schema`, `"size"`},
+		},
+		{
+			name:   "missing required secret",
+			schema: testSchema(),
+			values: map[string]any{"host": "redis.default.svc"},
+			errs:   []string{`recipe output secrets do not match the resource type schema`, `"url"`},
+		},
+		{
+			name:   "nil schema",
+			schema: nil,
+			values: map[string]any{"port": "not-a-number"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateOutputs(t.Context(), tc.schema, tc.values, tc.secrets)
+			if len(tc.errs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			for _, expected := range tc.errs {
+				require.ErrorContains(t, err, expected)
+			}
+		})
+	}
+}
+
+func Test_ValidateOutputMappings(t *testing.T) {
+	tests := []struct {
+		name          string
+		schema        map[string]any
+		outputs       map[string]string
+		secretOutputs map[string]string
+		errs          []string
+	}{
+		{
+			name:          "valid",
+			schema:        testSchema(),
+			outputs:       map[string]string{"host": "hostName", "port": "sslPort", "url": "connectionString"},
+			secretOutputs: map[string]string{"url": "primaryConnectionString"},
+		},
+		{
+			name:    "undeclared output",
+			schema:  testSchema(),
+			outputs: map[string]string{"hostname": "hostName", "environment": "env"},
+			errs: []string{
+				`output "environment" is not a property declared by the resource type`,
+				`output "hostname" is not a property declared by the resource type`,
+			},
+		},
+		{
+			name:          "undeclared secret output",
+			schema:        testSchema(),
+			secretOutputs: map[string]string{"password": "primaryKey", "name": "secretName"},
+			errs: []string{
+				`secret output "password" is not a secret declared by the resource type`,
+				`secret output "name" is not a secret declared by the resource type`,
+			},
+		},
+		{
+			name: "no secrets block",
+			schema: map[string]any{
+				"properties": map[string]any{"host": map[string]any{"type": "string"}},
+			},
+			secretOutputs: map[string]string{"url": "primaryConnectionString"},
+			errs:          []string{`secret output "url" cannot be mapped because the resource type does not declare a "secrets" block`},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateOutputMappings(tc.schema, tc.outputs, tc.secretOutputs)
+			if len(tc.errs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			for _, expected := range tc.errs {
+				require.ErrorContains(t, err, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contract
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+const (
+	// contextParameterName is the parameter Radius populates with the recipe context. It is never provided by users.
+	contextParameterName = "context"
+
+	// parametersKey is the key of the parameter declarations in the metadata returned by GetRecipeMetadata.
+	parametersKey = "parameters"
+)
+
+// parameterKind is the kind of value accepted by a recipe parameter.
+type parameterKind int
+
+const (
+	kindAny parameterKind = iota
+	kindString
+	kindNumber
+	kindBool
+	kindObject
+	kindArray
+)
+
+// ValidateParameters validates the parameters passed to a recipe against the parameters declared by the recipe
+// template. metadata is the result of the recipe driver's GetRecipeMetadata, which has the same shape for Bicep and
+// Terraform recipes:
+//
+//	{
+//		"parameters": {
+//			<parameter-name>: {
+//				"type": <type>,
+//				"defaultValue": <value>,
+//				...
+//			}
+//		}
+//	}
+//
+// The validation reports parameters that are not declared by the recipe, required parameters that are not provided
+// and values whose kind can never be accepted by the declared type. Scalar conversions performed by the IaC engines
+// (for example a string passed to a Terraform number variable) are allowed, and values containing {{context.*}}
+// expressions are not checked because they are resolved at execution time.
+func ValidateParameters(parameters map[string]any, metadata map[string]any) error {
+	return validateParameters(parameters, metadata, true)
+}
+
+// ValidateParameterValues validates a subset of the parameters passed to a recipe, such as the default parameters
+// set by a recipe pack, against the parameters declared by the recipe template. It performs the same checks as
+// ValidateParameters, except for required parameters, which may still be provided by the environment or the resource.
+func ValidateParameterValues(parameters map[string]any, metadata map[string]any) error {
+	return validateParameters(parameters, metadata, false)
+}
+
+func validateParameters(parameters map[string]any, metadata map[string]any, requireAll bool) error {
+	declared, ok := metadata[parametersKey].(map[string]any)
+	if !ok {
+		// The recipe does not declare its parameters, so there is nothing to validate against.
+		return nil
+	}
+
+	var errs []error
+	for _, name := range sortedKeys(parameters) {
+		if name == contextParameterName {
+			continue
+		}
+
+		definition, ok := declared[name].(map[string]any)
+		if !ok {
+			errs = append(errs, fmt.Errorf("parameter %q is not declared by the recipe", name))
+			continue
+		}
+
+		if err := validateParameterValue(name, parameters[name], definition); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	for _, name := range sortedKeys(declared) {
+		if !requireAll || name == contextParameterName {
+			continue
+		}
+
+		if _, ok := parameters[name]; ok {
+			continue
+		}
+
+		definition, ok := declared[name].(map[string]any)
+		if ok && isRequiredParameter(definition) {
+			errs = append(errs, fmt.Errorf("parameter %q is required by the recipe but was not provided", name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// isRequiredParameter reports whether a declared parameter must be provided. Terraform variables report this
+// directly, Bicep parameters are required unless they have a default value or are nullable.
+func isRequiredParameter(definition map[string]any) bool {
+	if required, ok := definition["required"].(bool); ok {
+		return required
+	}
+
+	if nullable, ok := definition["nullable"].(bool); ok && nullable {
+		return false
+	}
+
+	_, hasDefault := definition["defaultValue"]
+	return !hasDefault
+}
+
+func validateParameterValue(name string, value any, definition map[string]any) error {
+	if value == nil {
+		return nil
+	}
+
+	if s, ok := value.(string); ok && strings.Contains(s, "{{") {
+		return nil
+	}
+
+	if allowed, ok := definition["allowedValues"].([]any); ok && len(allowed) > 0 {
+		if !slices.ContainsFunc(allowed, func(a any) bool { return fmt.Sprint(a) == fmt.Sprint(value) }) {
+			return fmt.Errorf("parameter %q must be one of %v", name, allowed)
+		}
+	}
+
+	declaredType, _ := definition["type"].(string)
+	expected := declaredParameterKind(declaredType)
+	actual := valueKind(value)
+
+	compatible := true
+	switch expected {
+	case kindObject, kindArray:
+		compatible = actual == expected
+	case kindString:
+		compatible = actual != kindObject && actual != kindArray
+	case kindNumber:
+		compatible = actual == kindNumber || actual == kindString
+	case kindBool:
+		compatible = actual == kindBool || actual == kindString
+	}
+
+	if !compatible {
+		return fmt.Errorf("parameter %q has type %q, which does not accept a value of type %T", name, declaredType, value)
+	}
+
+	return nil
+}
+
+// declaredParameterKind maps a Bicep parameter type or Terraform type constraint to the kind of value it accepts.
+func declaredParameterKind(declaredType string) parameterKind {
+	t := strings.ToLower(strings.TrimSpace(declaredType))
+	switch {
+	case t == "string" || t == "securestring":
+		return kindString
+	case t == "int" || t == "number":
+		return kindNumber
+	case t == "bool":
+		return kindBool
+	case t == "object" || t == "secureobject" || strings.HasPrefix(t, "map(") || strings.HasPrefix(t, "object("):
+		return kindObject
+	case t == "array" || strings.HasPrefix(t, "list(") || strings.HasPrefix(t, "set(") || strings.HasPrefix(t, "tuple("):
+		return kindArray
+	default:
+		return kindAny
+	}
+}
+
+func valueKind(value any) parameterKind {
+	switch value.(type) {
+	case string:
+		return kindString
+	case bool:
+		return kindBool
+	case int, int32, int64, float32, float64:
+		return kindNumber
+	case map[string]any:
+		return kindObject
+	case []any:
+		return kindArray
+	default:
+		return kindAny
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package contract
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ValidateParameters(t *testing.T) {
+	bicepMetadata := map[string]any{
+		"parameters": map[string]any{
+			"context": map[string]any{"type": "object"},
+			"location": map[string]any{
+				"type":         "string",
+				"defaultValue": "[resourceGroup().location]",
+			},
+			"sku": map[string]any{
+				"type":          "string",
+				"allowedValues": []any{"Basic", "Standard"},
+				"defaultValue":  "Basic",
+			},
+			"capacity": map[string]any{"type": "int"},
+			"tags": map[string]any{
+				"type":     "object",
+				"nullable": true,
+			},
+		},
+	}
+
+	terraformMetadata := map[string]any{
+		"parameters": map[string]any{
+			"context": map[string]any{"type": "any", "required": true},
+			"redis_cache_name": map[string]any{
+				"type":     "string",
+				"required": true,
+			},
+			"zones": map[string]any{
+				"type":         "list(string)",
+				"required":     false,
+				"defaultValue": nil,
+			},
+			"port": map[string]any{
+				"type":     "number",
+				"required": false,
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		parameters map[string]any
+		metadata   map[string]any
+		errs       []string
+	}{
+		{
+			name:       "bicep valid",
+			parameters: map[string]any{"capacity": float64(2), "sku": "Standard"},
+			metadata:   bicepMetadata,
+		},
+		{
+			name:       "bicep missing required parameter",
+			parameters: map[string]any{"location": "westus"},
+			metadata:   bicepMetadata,
+			errs:       []string{`parameter "capacity" is required by the recipe but was not provided`},
+		},
+		{
+			name:       "bicep undeclared parameter",
+			parameters: map[string]any{"capacity": 1, "replicas": 3},
+			metadata:   bicepMetadata,
+			errs:       []string{`parameter "replicas" is not declared by the recipe`},
+		},
+		{
+			name:       "bicep value not allowed",
+			parameters: map[string]any{"capacity": 1, "sku": "Premium"},
+			metadata:   bicepMetadata,
+			errs:       []string{`parameter "sku" must be one of [Basic Standard]`},
+		},
+		{
+			name:       "bicep wrong type",
+			parameters: map[string]any{"capacity": true, "tags": []any{"a"}},
+			metadata:   bicepMetadata,
+			errs: []string{
+				`parameter "capacity" has type "int", which does not accept a value of type bool`,
+				`parameter "tags" has type "object", which does not accept a value of type []interface {}`,
+			},
+		},
+		{
+			name:       "expressions are not checked",
+			parameters: map[string]any{"capacity": "{{context.resource.properties.capacity}}", "sku": "{{context.resource.properties.sku}}"},
+			metadata:   bicepMetadata,
+		},
+		{
+			name:       "terraform valid",
+			parameters: map[string]any{"redis_cache_name": "cache", "port": "6379", "zones": []any{"1"}},
+			metadata:   terraformMetadata,
+		},
+		{
+			name:       "terraform missing required variable",
+			parameters: map[string]any{"port": 6379},
+			metadata:   terraformMetadata,
+			errs:       []string{`parameter "redis_cache_name" is required by the recipe but was not provided`},
+		},
+		{
+			name:       "terraform wrong type",
+			parameters: map[string]any{"redis_cache_name": "cache", "zones": "1"},
+			metadata:   terraformMetadata,
+			errs:       []string{`parameter "zones" has type "list(string)", which does not accept a value of type string`},
+		},
+		{
+			name:       "no declared parameters",
+			parameters: map[string]any{"anything": "value"},
+			metadata:   map[string]any{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateParameters(tc.parameters, tc.metadata)
+			if len(tc.errs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			for _, expected := range tc.errs {
+				require.ErrorContains(t, err, expected)
+			}
+		})
+	}
+}
+
+func Test_ValidateParameterValues(t *testing.T) {
+	metadata := map[string]any{
+		"parameters": map[string]any{
+			"capacity": map[string]any{"type": "int"},
+			"location": map[string]any{"type": "string"},
+		},
+	}
+
+	// Required parameters may be provided later by the environment or the resource.
+	err := ValidateParameterValues(map[string]any{"capacity": 1}, metadata)
+	require.NoError(t, err)
+
+	err = ValidateParameterValues(map[string]any{"capacity": "{{context.resource.properties.capacity}}", "zone": "1"}, metadata)
+	require.EqualError(t, err, `parameter "zone" is not declared by the recipe`)
+}
@@ -22,15 +22,18 @@ import (
 	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
 	"github.com/radius-project/radius/pkg/azure/armauth"
 	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
 	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/cache"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/driver/bicep"
 	"github.com/radius-project/radius/pkg/recipes/driver/terraform"
 	"github.com/radius-project/radius/pkg/recipes/engine"
+	"github.com/radius-project/radius/pkg/recipes/lease"
 	"github.com/radius-project/radius/pkg/sdk"
 	"github.com/radius-project/radius/pkg/sdk/clients"
 )
@@ -96,6 +99,17 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 		return nil, err
 	}
 
+	var recipeCache *cache.Cache
+	if options.Config.RecipeCache.Path != "" {
+		recipeCache, err = cache.New(cache.Options{
+			Dir:          options.Config.RecipeCache.Path,
+			MaxSizeBytes: options.Config.RecipeCache.MaxSizeMB * 1024 * 1024,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	cfg.ConfigLoader = configloader.NewEnvironmentLoader(clientOptions)
 	cfg.Engine = engine.NewEngine(engine.Options{
 		ConfigurationLoader: cfg.ConfigLoader,
@@ -108,14 +122,17 @@ func New(options hostoptions.HostOptions) (*RecipeControllerConfig, error) {
 				bicep.BicepOptions{
 					DeleteRetryCount:        bicepDeleteRetryCount,
 					DeleteRetryDelaySeconds: bicepDeleteRetryDeleteSeconds,
+					Cache:                   recipeCache,
 				},
 			),
 			recipes.TemplateKindTerraform: terraform.NewTerraformDriver(options.UCPConnection, secretprovider.NewSecretProvider(options.Config.SecretProvider),
 				terraform.TerraformOptions{
 					Path:     options.Config.Terraform.Path,
 					LogLevel: options.Config.Terraform.LogLevel,
+					Cache:    recipeCache,
 				}, *cfg.Kubernetes),
 		},
+		Leases: lease.NewManager(databaseprovider.FromOptions(options.Config.DatabaseProvider), lease.Options{}),
 	})
 
 	return cfg, nil
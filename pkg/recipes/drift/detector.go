@@ -0,0 +1,313 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/hosting"
+	"github.com/radius-project/radius/pkg/components/metrics"
+	"github.com/radius-project/radius/pkg/portableresources"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// Detector periodically checks the infrastructure deployed by recipes for drift, records the result in the
+// status of each resource, and re-applies the recipe when the environment policy asks for remediation.
+type Detector struct {
+	options Options
+}
+
+var _ hosting.Service = (*Detector)(nil)
+
+// NewDetector creates a new drift detector with the given options.
+func NewDetector(options Options) *Detector {
+	if options.RootScope == "" {
+		options.RootScope = DefaultRootScope
+	}
+	if options.Interval <= 0 {
+		options.Interval = DefaultInterval
+	}
+	if options.RemediationTimeout <= 0 {
+		options.RemediationTimeout = DefaultRemediationTimeout
+	}
+
+	return &Detector{options: options}
+}
+
+// Name returns the name of the service used for logging.
+func (d *Detector) Name() string {
+	return "recipe drift detector"
+}
+
+// Run runs a drift detection pass on every interval until the context is cancelled.
+func (d *Detector) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	ticker := time.NewTicker(d.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := d.DetectOnce(ctx); err != nil {
+				logger.Error(err, "Recipe drift detection pass failed")
+			}
+		}
+	}
+}
+
+// DetectOnce runs a single drift detection pass over all resources of the configured resource types.
+func (d *Detector) DetectOnce(ctx context.Context) error {
+	resourceTypes, err := d.options.ResourceTypes(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list resource types: %w", err)
+	}
+
+	var errs error
+	for _, resourceType := range resourceTypes {
+		errs = errors.Join(errs, d.detectResourceType(ctx, resourceType))
+	}
+
+	return errs
+}
+
+func (d *Detector) detectResourceType(ctx context.Context, resourceType string) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	query := database.Query{
+		RootScope:      d.options.RootScope,
+		ScopeRecursive: true,
+		ResourceType:   resourceType,
+	}
+
+	paginationToken := ""
+	for {
+		result, err := d.options.DatabaseClient.Query(ctx, query, database.WithPaginationToken(paginationToken))
+		if err != nil {
+			return fmt.Errorf("failed to query resources of type %q: %w", resourceType, err)
+		}
+
+		for i := range result.Items {
+			// A failure for one resource should not prevent the others from being checked.
+			if err := d.detectResource(ctx, &result.Items[i]); err != nil {
+				logger.Error(err, "Failed to detect recipe drift", "resourceID", result.Items[i].ID)
+			}
+		}
+
+		if result.PaginationToken == "" {
+			return nil
+		}
+		paginationToken = result.PaginationToken
+	}
+}
+
+// recipeResource is the subset of a stored portable or dynamic resource used for drift detection.
+type recipeResource struct {
+	ProvisioningState v1.ProvisioningState `json:"provisioningState,omitempty"`
+	UpdatedAPIVersion string               `json:"updatedApiVersion,omitempty"`
+	Properties        struct {
+		Application string                            `json:"application,omitempty"`
+		Environment string                            `json:"environment,omitempty"`
+		Recipe      *portableresources.ResourceRecipe `json:"recipe,omitempty"`
+		Status      rpv1.ResourceStatus               `json:"status"`
+	} `json:"properties"`
+}
+
+func (d *Detector) detectResource(ctx context.Context, obj *database.Object) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	resource := &recipeResource{}
+	if err := obj.As(resource); err != nil {
+		return err
+	}
+
+	// Only resources that were successfully deployed by a recipe can drift. Resources with an operation
+	// in progress are checked on the next pass.
+	if resource.Properties.Status.Recipe == nil || resource.ProvisioningState != v1.ProvisioningStateSucceeded {
+		return nil
+	}
+
+	data := map[string]any{}
+	if err := obj.As(&data); err != nil {
+		return err
+	}
+
+	metadata := recipes.ResourceMetadata{
+		Name:          portableresources.DefaultRecipeName,
+		EnvironmentID: resource.Properties.Environment,
+		ApplicationID: resource.Properties.Application,
+		ResourceID:    obj.ID,
+	}
+	if properties, ok := data["properties"].(map[string]any); ok {
+		metadata.Properties = properties
+	}
+	if resource.Properties.Recipe != nil {
+		if resource.Properties.Recipe.Name != "" {
+			metadata.Name = resource.Properties.Recipe.Name
+		}
+		metadata.Parameters = resource.Properties.Recipe.Parameters
+	}
+
+	configuration, err := d.options.ConfigurationLoader.LoadConfiguration(ctx, metadata)
+	if err != nil {
+		return err
+	}
+
+	if configuration.Simulated || configuration.DriftPolicy.Disabled {
+		return nil
+	}
+
+	status := &rpv1.RecipeDriftStatus{
+		LastCheckedTime: new(time.Now().UTC()),
+	}
+
+	remediate := false
+	result, err := d.options.Engine.DetectDrift(ctx, engine.DetectDriftOptions{
+		BaseOptions: engine.BaseOptions{
+			Recipe: metadata,
+		},
+		OutputResources: resource.Properties.Status.OutputResources,
+	})
+	switch {
+	case err != nil:
+		status.State = rpv1.RecipeDriftStateUnknown
+		status.Message = err.Error()
+	case result.Drifted:
+		status.State = rpv1.RecipeDriftStateDrifted
+		status.DriftedResources = result.Resources
+		status.Message = result.Message
+
+		if configuration.DriftPolicy.AutoRemediate {
+			remediate = true
+			status.State = rpv1.RecipeDriftStateRemediating
+		}
+	default:
+		status.State = rpv1.RecipeDriftStateInSync
+	}
+
+	if err := setDriftStatus(data, status); err != nil {
+		return err
+	}
+
+	if remediate {
+		data["provisioningState"] = string(v1.ProvisioningStateAccepted)
+	}
+
+	obj.Data = data
+	err = d.options.DatabaseClient.Save(ctx, obj, database.WithETag(obj.ETag))
+	if errors.Is(err, &database.ErrConcurrency{}) {
+		// The resource was updated since it was read. The next pass will check the new state.
+		logger.Info("Resource changed during drift detection, skipping", "resourceID", obj.ID)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if !remediate {
+		return nil
+	}
+
+	logger.Info("Remediating recipe drift", "resourceID", obj.ID, "driftedResources", status.DriftedResources)
+	if err := d.remediate(ctx, obj.ID, resource.UpdatedAPIVersion); err != nil {
+		// Roll back so the resource is not left in a non-terminal state without an operation.
+		status.State = rpv1.RecipeDriftStateDrifted
+		data["provisioningState"] = string(v1.ProvisioningStateSucceeded)
+		if rbErr := setDriftStatus(data, status); rbErr == nil {
+			obj.Data = data
+			rbErr = d.options.DatabaseClient.Save(ctx, obj, database.WithETag(obj.ETag))
+			err = errors.Join(err, rbErr)
+		}
+		return fmt.Errorf("failed to queue drift remediation: %w", err)
+	}
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeDriftRemediation(ctx,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDetectDrift, metadata.Name, nil, ""))
+
+	return nil
+}
+
+// remediate queues a PUT operation for the resource so that the recipe is applied again.
+func (d *Detector) remediate(ctx context.Context, resourceID string, apiVersion string) error {
+	id, err := resources.ParseResource(resourceID)
+	if err != nil {
+		return err
+	}
+
+	sCtx := &v1.ARMRequestContext{
+		ResourceID:  id,
+		OperationID: uuid.New(),
+		OperationType: v1.OperationType{
+			Type:   id.Type(),
+			Method: v1.OperationPut,
+		},
+		APIVersion: apiVersion,
+	}
+
+	return d.options.StatusManager.QueueAsyncOperation(ctx, sCtx, statusmanager.QueueOperationOptions{
+		OperationTimeout: d.options.RemediationTimeout,
+		RetryAfter:       v1.DefaultRetryAfterDuration,
+	})
+}
+
+// setDriftStatus sets '.properties.status.recipe.drift' on the stored resource, preserving all other fields.
+func setDriftStatus(data map[string]any, status *rpv1.RecipeDriftStatus) error {
+	b, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	drift := map[string]any{}
+	if err := json.Unmarshal(b, &drift); err != nil {
+		return err
+	}
+
+	recipe, ok := nestedMap(data, "properties", "status", "recipe")
+	if !ok {
+		return errors.New("resource does not have a recipe status")
+	}
+
+	recipe["drift"] = drift
+	return nil
+}
+
+func nestedMap(data map[string]any, keys ...string) (map[string]any, bool) {
+	current := data
+	for _, key := range keys {
+		next, ok := current[key].(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current = next
+	}
+
+	return current, true
+}
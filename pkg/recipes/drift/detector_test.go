@@ -0,0 +1,287 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+)
+
+const (
+	testResourceType = "Applications.Datastores/redisCaches"
+	testResourceID   = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis"
+	testEnvID        = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Core/environments/env"
+)
+
+type testSetup struct {
+	db            *inmemory.Client
+	engine        *engine.MockEngine
+	configLoader  *configloader.MockConfigurationLoader
+	statusManager *statusmanager.MockStatusManager
+	detector      *Detector
+}
+
+func setup(t *testing.T) *testSetup {
+	ctrl := gomock.NewController(t)
+	s := &testSetup{
+		db:            inmemory.NewClient(),
+		engine:        engine.NewMockEngine(ctrl),
+		configLoader:  configloader.NewMockConfigurationLoader(ctrl),
+		statusManager: statusmanager.NewMockStatusManager(ctrl),
+	}
+
+	s.detector = NewDetector(Options{
+		DatabaseClient:      s.db,
+		StatusManager:       s.statusManager,
+		Engine:              s.engine,
+		ConfigurationLoader: s.configLoader,
+		ResourceTypes: func(ctx context.Context) ([]string, error) {
+			return []string{testResourceType}, nil
+		},
+	})
+
+	return s
+}
+
+func saveResource(t *testing.T, db database.Client, provisioningState v1.ProvisioningState, withRecipeStatus bool) {
+	status := map[string]any{
+		"outputResources": []any{
+			map[string]any{
+				"id":            "/planes/kubernetes/local/namespaces/default/providers/core/Service/redis",
+				"radiusManaged": true,
+			},
+		},
+	}
+	if withRecipeStatus {
+		status["recipe"] = map[string]any{
+			"templateKind": recipes.TemplateKindTerraform,
+			"templatePath": "ghcr.io/radius-project/recipes/redis:latest",
+		}
+	}
+
+	err := db.Save(t.Context(), &database.Object{
+		Metadata: database.Metadata{ID: testResourceID},
+		Data: map[string]any{
+			"id":                testResourceID,
+			"type":              testResourceType,
+			"provisioningState": string(provisioningState),
+			"updatedApiVersion": "2023-10-01-preview",
+			"properties": map[string]any{
+				"environment": testEnvID,
+				"recipe": map[string]any{
+					"name": "redis",
+				},
+				"status": status,
+			},
+		},
+	})
+	require.NoError(t, err)
+}
+
+func getResource(t *testing.T, db database.Client) map[string]any {
+	obj, err := db.Get(t.Context(), testResourceID)
+	require.NoError(t, err)
+
+	data := map[string]any{}
+	require.NoError(t, obj.As(&data))
+	return data
+}
+
+func getDrift(t *testing.T, data map[string]any) map[string]any {
+	drift, ok := nestedMap(data, "properties", "status", "recipe", "drift")
+	require.True(t, ok, "drift status was not recorded")
+	return drift
+}
+
+func Test_DetectOnce_InSync(t *testing.T) {
+	s := setup(t)
+	saveResource(t, s.db, v1.ProvisioningStateSucceeded, true)
+
+	s.configLoader.EXPECT().
+		LoadConfiguration(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, metadata recipes.ResourceMetadata) (*recipes.Configuration, error) {
+			require.Equal(t, "redis", metadata.Name)
+			require.Equal(t, testEnvID, metadata.EnvironmentID)
+			require.Equal(t, testResourceID, metadata.ResourceID)
+			return &recipes.Configuration{}, nil
+		})
+	s.engine.EXPECT().
+		DetectDrift(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, opts engine.DetectDriftOptions) (*recipes.DriftResult, error) {
+			require.Len(t, opts.OutputResources, 1)
+			return &recipes.DriftResult{}, nil
+		})
+
+	err := s.detector.DetectOnce(t.Context())
+	require.NoError(t, err)
+
+	data := getResource(t, s.db)
+	drift := getDrift(t, data)
+	require.Equal(t, "InSync", drift["state"])
+	require.NotEmpty(t, drift["lastCheckedTime"])
+	require.Equal(t, string(v1.ProvisioningStateSucceeded), data["provisioningState"])
+}
+
+func Test_DetectOnce_Drifted(t *testing.T) {
+	s := setup(t)
+	saveResource(t, s.db, v1.ProvisioningStateSucceeded, true)
+
+	s.configLoader.EXPECT().LoadConfiguration(gomock.Any(), gomock.Any()).Return(&recipes.Configuration{}, nil)
+	s.engine.EXPECT().DetectDrift(gomock.Any(), gomock.Any()).Return(&recipes.DriftResult{
+		Drifted:   true,
+		Resources: []string{"kubernetes_service.redis"},
+		Message:   "1 Terraform resource(s) changed outside of Terraform",
+	}, nil)
+
+	err := s.detector.DetectOnce(t.Context())
+	require.NoError(t, err)
+
+	drift := getDrift(t, getResource(t, s.db))
+	require.Equal(t, "Drifted", drift["state"])
+	require.Equal(t, []any{"kubernetes_service.redis"}, drift["driftedResources"])
+	require.Equal(t, "1 Terraform resource(s) changed outside of Terraform", drift["message"])
+}
+
+func Test_DetectOnce_AutoRemediate(t *testing.T) {
+	s := setup(t)
+	saveResource(t, s.db, v1.ProvisioningStateSucceeded, true)
+
+	s.configLoader.EXPECT().LoadConfiguration(gomock.Any(), gomock.Any()).Return(&recipes.Configuration{
+		DriftPolicy: recipes.DriftPolicy{AutoRemediate: true},
+	}, nil)
+	s.engine.EXPECT().DetectDrift(gomock.Any(), gomock.Any()).Return(&recipes.DriftResult{
+		Drifted:   true,
+		Resources: []string{"kubernetes_service.redis"},
+	}, nil)
+	s.statusManager.EXPECT().
+		QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, sCtx *v1.ARMRequestContext, options statusmanager.QueueOperationOptions) error {
+			require.Equal(t, testResourceID, sCtx.ResourceID.String())
+			require.Equal(t, "APPLICATIONS.DATASTORES/REDISCACHES|PUT", sCtx.OperationType.String())
+			require.Equal(t, "2023-10-01-preview", sCtx.APIVersion)
+			require.Equal(t, DefaultRemediationTimeout, options.OperationTimeout)
+			return nil
+		})
+
+	err := s.detector.DetectOnce(t.Context())
+	require.NoError(t, err)
+
+	data := getResource(t, s.db)
+	require.Equal(t, "Remediating", getDrift(t, data)["state"])
+	require.Equal(t, string(v1.ProvisioningStateAccepted), data["provisioningState"])
+}
+
+func Test_DetectOnce_AutoRemediate_QueueFailure(t *testing.T) {
+	s := setup(t)
+	saveResource(t, s.db, v1.ProvisioningStateSucceeded, true)
+
+	s.configLoader.EXPECT().LoadConfiguration(gomock.Any(), gomock.Any()).Return(&recipes.Configuration{
+		DriftPolicy: recipes.DriftPolicy{AutoRemediate: true},
+	}, nil)
+	s.engine.EXPECT().DetectDrift(gomock.Any(), gomock.Any()).Return(&recipes.DriftResult{Drifted: true}, nil)
+	s.statusManager.EXPECT().QueueAsyncOperation(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("queue unavailable"))
+
+	// Per-resource failures are logged rather than returned.
+	err := s.detector.DetectOnce(t.Context())
+	require.NoError(t, err)
+
+	data := getResource(t, s.db)
+	require.Equal(t, "Drifted", getDrift(t, data)["state"])
+	require.Equal(t, string(v1.ProvisioningStateSucceeded), data["provisioningState"])
+}
+
+func Test_DetectOnce_DetectionError(t *testing.T) {
+	s := setup(t)
+	saveResource(t, s.db, v1.ProvisioningStateSucceeded, true)
+
+	s.configLoader.EXPECT().LoadConfiguration(gomock.Any(), gomock.Any()).Return(&recipes.Configuration{}, nil)
+	s.engine.EXPECT().DetectDrift(gomock.Any(), gomock.Any()).Return(nil, errors.New("driver failure"))
+
+	err := s.detector.DetectOnce(t.Context())
+	require.NoError(t, err)
+
+	drift := getDrift(t, getResource(t, s.db))
+	require.Equal(t, "Unknown", drift["state"])
+	require.Equal(t, "driver failure", drift["message"])
+}
+
+func Test_DetectOnce_Skipped(t *testing.T) {
+	t.Run("policy disabled", func(t *testing.T) {
+		s := setup(t)
+		saveResource(t, s.db, v1.ProvisioningStateSucceeded, true)
+
+		s.configLoader.EXPECT().LoadConfiguration(gomock.Any(), gomock.Any()).Return(&recipes.Configuration{
+			DriftPolicy: recipes.DriftPolicy{Disabled: true},
+		}, nil)
+
+		err := s.detector.DetectOnce(t.Context())
+		require.NoError(t, err)
+
+		_, ok := nestedMap(getResource(t, s.db), "properties", "status", "recipe", "drift")
+		require.False(t, ok)
+	})
+
+	t.Run("simulated environment", func(t *testing.T) {
+		s := setup(t)
+		saveResource(t, s.db, v1.ProvisioningStateSucceeded, true)
+
+		s.configLoader.EXPECT().LoadConfiguration(gomock.Any(), gomock.Any()).Return(&recipes.Configuration{Simulated: true}, nil)
+
+		err := s.detector.DetectOnce(t.Context())
+		require.NoError(t, err)
+	})
+
+	t.Run("operation in progress", func(t *testing.T) {
+		s := setup(t)
+		saveResource(t, s.db, v1.ProvisioningStateUpdating, true)
+
+		err := s.detector.DetectOnce(t.Context())
+		require.NoError(t, err)
+	})
+
+	t.Run("not deployed by a recipe", func(t *testing.T) {
+		s := setup(t)
+		saveResource(t, s.db, v1.ProvisioningStateSucceeded, false)
+
+		err := s.detector.DetectOnce(t.Context())
+		require.NoError(t, err)
+	})
+}
+
+func Test_DetectOnce_ResourceTypesError(t *testing.T) {
+	d := NewDetector(Options{
+		DatabaseClient: inmemory.NewClient(),
+		ResourceTypes: func(ctx context.Context) ([]string, error) {
+			return nil, errors.New("ucp unavailable")
+		},
+	})
+
+	err := d.DetectOnce(t.Context())
+	require.ErrorContains(t, err, "ucp unavailable")
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"context"
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/engine"
+)
+
+const (
+	// DefaultInterval is the default interval between drift detection passes.
+	DefaultInterval = 30 * time.Minute
+
+	// DefaultRootScope is the default root scope queried for recipe-deployed resources.
+	DefaultRootScope = "/planes/radius/local"
+
+	// DefaultRemediationTimeout is the default timeout of the operation queued to remediate drift.
+	DefaultRemediationTimeout = 60 * time.Minute
+)
+
+// ResourceTypeLister returns the fully-qualified resource types that are checked for drift.
+type ResourceTypeLister func(ctx context.Context) ([]string, error)
+
+// Options holds the dependencies and settings of the drift detector.
+type Options struct {
+	// DatabaseClient is the client used to read and update resources.
+	DatabaseClient database.Client
+
+	// StatusManager is used to queue remediation operations.
+	StatusManager statusmanager.StatusManager
+
+	// Engine is the recipe engine used to detect drift.
+	Engine engine.Engine
+
+	// ConfigurationLoader loads the environment configuration, including the drift policy, for a resource.
+	ConfigurationLoader configloader.ConfigurationLoader
+
+	// ResourceTypes returns the resource types to check for drift.
+	ResourceTypes ResourceTypeLister
+
+	// RootScope is the root scope queried for resources. Defaults to DefaultRootScope.
+	RootScope string
+
+	// Interval is the interval between drift detection passes. Defaults to DefaultInterval.
+	Interval time.Duration
+
+	// RemediationTimeout is the timeout of the operation queued to remediate drift. Defaults to DefaultRemediationTimeout.
+	RemediationTimeout time.Duration
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/radius-project/radius/pkg/portableresources/datamodel"
 	"github.com/radius-project/radius/pkg/portableresources/processors"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/cache"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/kubernetes/clusteraccess"
 	"github.com/radius-project/radius/pkg/recipes/paramresolver"
@@ -57,6 +58,7 @@ const (
 )
 
 var _ driver.Driver = (*bicepDriver)(nil)
+var _ driver.DriverWithDriftDetection = (*bicepDriver)(nil)
 
 // NewBicepDriver creates a new bicep driver instance with the given ARM client options, deployment client, resource client, and options.
 func NewBicepDriver(armOptions *arm.ClientOptions, deploymentClient clients.ResourceDeploymentsClient, client processors.ResourceClient, options BicepOptions) driver.Driver {
@@ -72,6 +74,9 @@ func NewBicepDriver(armOptions *arm.ClientOptions, deploymentClient clients.Reso
 type BicepOptions struct {
 	DeleteRetryCount        int
 	DeleteRetryDelaySeconds int
+
+	// Cache is the optional cache of recipe templates. When it is nil, templates are downloaded on every execution.
+	Cache *cache.Cache
 }
 
 type bicepDriver struct {
@@ -110,7 +115,7 @@ func (d *bicepDriver) Execute(ctx context.Context, opts driver.ExecuteOptions) (
 		registryClient = authClient
 	}
 
-	err = util.ReadFromRegistry(ctx, opts.Definition, &recipeData, registryClient)
+	err = util.ReadFromRegistryWithCache(ctx, opts.Definition, &recipeData, registryClient, d.options.Cache)
 	if err != nil {
 		metrics.DefaultRecipeEngineMetrics.RecordRecipeDownloadDuration(ctx, downloadStartTime,
 			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, opts.Recipe.Name, &opts.Definition, recipes.RecipeDownloadFailed))
@@ -284,6 +289,38 @@ func (d *bicepDriver) Delete(ctx context.Context, opts driver.DeleteOptions) err
 	return nil
 }
 
+// DetectDrift checks that the Radius-managed output resources deployed by the recipe still exist.
+//
+// Bicep recipes are deployed through the UCP deployment engine, which does not support what-if deployments. Drift is
+// therefore limited to resources that were deleted outside of Radius; changes to the properties of a resource are not
+// detected.
+func (d *bicepDriver) DetectDrift(ctx context.Context, opts driver.DetectDriftOptions) (*recipes.DriftResult, error) {
+	result := &recipes.DriftResult{}
+	for _, outputResource := range opts.OutputResources {
+		// Resources that are not managed by Radius are not deployed by the recipe.
+		if outputResource.RadiusManaged == nil || !*outputResource.RadiusManaged {
+			continue
+		}
+
+		id := outputResource.ID.String()
+		exists, err := d.ResourceClient.Exists(ctx, id)
+		if err != nil {
+			return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+		}
+
+		if !exists {
+			result.Resources = append(result.Resources, id)
+		}
+	}
+
+	if len(result.Resources) > 0 {
+		result.Drifted = true
+		result.Message = fmt.Sprintf("%d resource(s) deployed by the recipe no longer exist", len(result.Resources))
+	}
+
+	return result, nil
+}
+
 // GetRecipeMetadata gets the Bicep recipe parameters information from the container registry
 func (d *bicepDriver) GetRecipeMetadata(ctx context.Context, opts driver.BaseOptions) (map[string]any, error) {
 	// Recipe parameters can be found in the recipe data pulled from the registry in the following format:
@@ -320,7 +357,7 @@ func (d *bicepDriver) GetRecipeMetadata(ctx context.Context, opts driver.BaseOpt
 		registryClient = authClient
 	}
 
-	err = util.ReadFromRegistry(ctx, opts.Definition, &recipeData, registryClient)
+	err = util.ReadFromRegistryWithCache(ctx, opts.Definition, &recipeData, registryClient, d.options.Cache)
 	if err != nil {
 		return nil, err
 	}
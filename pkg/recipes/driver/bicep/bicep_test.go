@@ -458,6 +458,54 @@ func Test_Bicep_Delete_Error(t *testing.T) {
 	require.Equal(t, err, &recipeError)
 }
 
+func Test_Bicep_DetectDrift(t *testing.T) {
+	deploymentID := "/planes/kubernetes/local/namespaces/recipe-app/providers/apps/Deployment/redis"
+	outputResources := []rpv1.OutputResource{
+		{
+			ID:            resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "apps", "Deployment", "recipe-app", "redis"),
+			RadiusManaged: new(true),
+		},
+		{
+			ID: resources_kubernetes.IDFromParts(resources_kubernetes.PlaneNameTODO, "", "Service", "recipe-app", "redis"),
+			// We don't expect a call to check existence when RadiusManaged is false.
+			RadiusManaged: new(false),
+		},
+	}
+
+	t.Run("in sync", func(t *testing.T) {
+		driverBicep, client := setupDeleteInputs(t)
+		client.EXPECT().Exists(gomock.Any(), deploymentID).Times(1).Return(true, nil)
+
+		result, err := driverBicep.DetectDrift(t.Context(), driver.DetectDriftOptions{OutputResources: outputResources})
+		require.NoError(t, err)
+		require.False(t, result.Drifted)
+		require.Empty(t, result.Resources)
+	})
+
+	t.Run("resource deleted", func(t *testing.T) {
+		driverBicep, client := setupDeleteInputs(t)
+		client.EXPECT().Exists(gomock.Any(), deploymentID).Times(1).Return(false, nil)
+
+		result, err := driverBicep.DetectDrift(t.Context(), driver.DetectDriftOptions{OutputResources: outputResources})
+		require.NoError(t, err)
+		require.True(t, result.Drifted)
+		require.Equal(t, []string{deploymentID}, result.Resources)
+		require.Equal(t, "1 resource(s) deployed by the recipe no longer exist", result.Message)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		driverBicep, client := setupDeleteInputs(t)
+		client.EXPECT().Exists(gomock.Any(), deploymentID).Times(1).Return(false, fmt.Errorf("connection refused"))
+
+		_, err := driverBicep.DetectDrift(t.Context(), driver.DetectDriftOptions{OutputResources: outputResources})
+		require.Error(t, err)
+
+		recipeError := &recipes.RecipeError{}
+		require.ErrorAs(t, err, &recipeError)
+		require.Equal(t, recipes.RecipeDriftDetectionFailed, recipeError.ErrorDetails.Code)
+	})
+}
+
 func Test_Bicep_GetRecipeMetadata_Success(t *testing.T) {
 	ts := registrytest.NewFakeRegistryServer(t)
 	t.Cleanup(ts.CloseServer)
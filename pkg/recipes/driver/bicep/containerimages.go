@@ -36,6 +36,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 )
@@ -306,6 +307,7 @@ func (d *bicepDriver) writeDockerConfig(ctx context.Context, registry, registryS
 		return fmt.Errorf("output %q has an empty registry host", imageBuildOutputName)
 	}
 	auth := base64.StdEncoding.EncodeToString([]byte(string(username) + ":" + string(password)))
+	v1.OperationLoggerFromContext(ctx).AddSecrets(string(password), auth)
 	configBytes, err := json.Marshal(map[string]any{
 		"auths": map[string]any{authKey: map[string]any{"auth": auth}},
 	})
@@ -353,10 +355,15 @@ func runScript(ctx context.Context, script string, args, env []string, workDir s
 		return "", fmt.Errorf("failed to start %s: %w", scriptShell, err)
 	}
 
+	// The output is also recorded in the log of the operation executing the recipe, so that users can see the
+	// complete output of a failed build rather than only the stderr tail returned in the error.
+	operationLog := v1.OperationLoggerFromContext(ctx)
 	tail := &bytes.Buffer{}
 	done := make(chan error, 2)
-	go func() { done <- drainScriptStream(stdout, "imageBuild: ", logger, nil, 0) }()
-	go func() { done <- drainScriptStream(stderr, "imageBuild(stderr): ", logger, tail, stderrTailLimit) }()
+	go func() { done <- drainScriptStream(stdout, "imageBuild: ", logger, operationLog, nil, 0) }()
+	go func() {
+		done <- drainScriptStream(stderr, "imageBuild(stderr): ", logger, operationLog, tail, stderrTailLimit)
+	}()
 	streamErr := errors.Join(<-done, <-done)
 
 	err = cmd.Wait()
@@ -372,10 +379,11 @@ func runScript(ctx context.Context, script string, args, env []string, workDir s
 	return tail.String(), nil
 }
 
-// drainScriptStream logs each line of the stream. If tail is non-nil, it also retains the
+// drainScriptStream logs each line of the stream, and writes it to operationLog if it is non-nil. If tail is non-nil,
+// it also retains the
 // last tailLimit bytes there (dropping older bytes) so callers can surface a bounded stderr
 // tail in errors without buffering the entire stream.
-func drainScriptStream(stream io.Reader, logPrefix string, logger logr.Logger, tail *bytes.Buffer, tailLimit int) error {
+func drainScriptStream(stream io.Reader, logPrefix string, logger logr.Logger, operationLog io.Writer, tail *bytes.Buffer, tailLimit int) error {
 	reader := bufio.NewReaderSize(stream, scriptLogLineLimit)
 	line := make([]byte, 0, scriptLogLineLimit)
 	lineStarted := false
@@ -404,7 +412,7 @@ func drainScriptStream(stream io.Reader, logPrefix string, logger logr.Logger, t
 		}
 
 		if err == nil {
-			logScriptLine(logger, logPrefix, line, lineTruncated)
+			logScriptLine(logger, operationLog, logPrefix, line, lineTruncated)
 			line = line[:0]
 			lineStarted = false
 			lineTruncated = false
@@ -414,7 +422,7 @@ func drainScriptStream(stream io.Reader, logPrefix string, logger logr.Logger, t
 			continue
 		}
 		if lineStarted {
-			logScriptLine(logger, logPrefix, line, lineTruncated)
+			logScriptLine(logger, operationLog, logPrefix, line, lineTruncated)
 		}
 		if errors.Is(err, io.EOF) {
 			return nil
@@ -423,12 +431,17 @@ func drainScriptStream(stream io.Reader, logPrefix string, logger logr.Logger, t
 	}
 }
 
-func logScriptLine(logger logr.Logger, prefix string, line []byte, truncated bool) {
+func logScriptLine(logger logr.Logger, operationLog io.Writer, prefix string, line []byte, truncated bool) {
 	message := prefix + string(line)
 	if truncated {
 		message += scriptLogTruncationMarker
 	}
 	logger.Info(message)
+
+	if operationLog != nil {
+		// Each line is written at once so that the lines of stdout and stderr are not interleaved.
+		_, _ = io.WriteString(operationLog, message+"\n")
+	}
 }
 
 // readScriptResult reads the single imageReference the script wrote.
@@ -591,7 +591,7 @@ func Test_DrainScriptStream_LongLineBoundsLoggingAndTail(t *testing.T) {
 	}, funcr.Options{LogInfoLevel: &logInfoLevel})
 	tail := &bytes.Buffer{}
 
-	err := drainScriptStream(strings.NewReader(strings.Repeat("x", 1_100_000)), "imageBuild(stderr): ", logger, tail, stderrTailLimit)
+	err := drainScriptStream(strings.NewReader(strings.Repeat("x", 1_100_000)), "imageBuild(stderr): ", logger, nil, tail, stderrTailLimit)
 	require.NoError(t, err)
 	require.Len(t, messages, 1)
 	require.Contains(t, messages[0], scriptLogTruncationMarker)
@@ -599,6 +599,14 @@ func Test_DrainScriptStream_LongLineBoundsLoggingAndTail(t *testing.T) {
 	require.Equal(t, strings.Repeat("x", stderrTailLimit), tail.String())
 }
 
+func Test_DrainScriptStream_WritesOperationLog(t *testing.T) {
+	operationLog := &bytes.Buffer{}
+
+	err := drainScriptStream(strings.NewReader("#1 building\r\n#2 pushing"), "imageBuild: ", logr.Discard(), operationLog, nil, 0)
+	require.NoError(t, err)
+	require.Equal(t, "imageBuild: #1 building\nimageBuild: #2 pushing\n", operationLog.String())
+}
+
 func Test_RunScript_CancelKillsSpawnedProcesses(t *testing.T) {
 	requireScriptShell(t)
 
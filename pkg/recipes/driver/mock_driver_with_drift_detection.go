@@ -0,0 +1,197 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/radius-project/radius/pkg/recipes/driver (interfaces: DriverWithDriftDetection)
+//
+// Generated by this command:
+//
+//	mockgen -typed -destination=./mock_driver_with_drift_detection.go -package=driver -self_package github.com/radius-project/radius/pkg/recipes/driver github.com/radius-project/radius/pkg/recipes/driver DriverWithDriftDetection
+//
+
+// Package driver is a generated GoMock package.
+package driver
+
+import (
+	context "context"
+	reflect "reflect"
+
+	recipes "github.com/radius-project/radius/pkg/recipes"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDriverWithDriftDetection is a mock of DriverWithDriftDetection interface.
+type MockDriverWithDriftDetection struct {
+	ctrl     *gomock.Controller
+	recorder *MockDriverWithDriftDetectionMockRecorder
+	isgomock struct{}
+}
+
+// MockDriverWithDriftDetectionMockRecorder is the mock recorder for MockDriverWithDriftDetection.
+type MockDriverWithDriftDetectionMockRecorder struct {
+	mock *MockDriverWithDriftDetection
+}
+
+// NewMockDriverWithDriftDetection creates a new mock instance.
+func NewMockDriverWithDriftDetection(ctrl *gomock.Controller) *MockDriverWithDriftDetection {
+	mock := &MockDriverWithDriftDetection{ctrl: ctrl}
+	mock.recorder = &MockDriverWithDriftDetectionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDriverWithDriftDetection) EXPECT() *MockDriverWithDriftDetectionMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockDriverWithDriftDetection) Delete(ctx context.Context, opts DeleteOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDriverWithDriftDetectionMockRecorder) Delete(ctx, opts any) *MockDriverWithDriftDetectionDeleteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDriverWithDriftDetection)(nil).Delete), ctx, opts)
+	return &MockDriverWithDriftDetectionDeleteCall{Call: call}
+}
+
+// MockDriverWithDriftDetectionDeleteCall wrap *gomock.Call
+type MockDriverWithDriftDetectionDeleteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDriverWithDriftDetectionDeleteCall) Return(arg0 error) *MockDriverWithDriftDetectionDeleteCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDriverWithDriftDetectionDeleteCall) Do(f func(context.Context, DeleteOptions) error) *MockDriverWithDriftDetectionDeleteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDriverWithDriftDetectionDeleteCall) DoAndReturn(f func(context.Context, DeleteOptions) error) *MockDriverWithDriftDetectionDeleteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DetectDrift mocks base method.
+func (m *MockDriverWithDriftDetection) DetectDrift(ctx context.Context, opts DetectDriftOptions) (*recipes.DriftResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectDrift", ctx, opts)
+	ret0, _ := ret[0].(*recipes.DriftResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectDrift indicates an expected call of DetectDrift.
+func (mr *MockDriverWithDriftDetectionMockRecorder) DetectDrift(ctx, opts any) *MockDriverWithDriftDetectionDetectDriftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectDrift", reflect.TypeOf((*MockDriverWithDriftDetection)(nil).DetectDrift), ctx, opts)
+	return &MockDriverWithDriftDetectionDetectDriftCall{Call: call}
+}
+
+// MockDriverWithDriftDetectionDetectDriftCall wrap *gomock.Call
+type MockDriverWithDriftDetectionDetectDriftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDriverWithDriftDetectionDetectDriftCall) Return(arg0 *recipes.DriftResult, arg1 error) *MockDriverWithDriftDetectionDetectDriftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDriverWithDriftDetectionDetectDriftCall) Do(f func(context.Context, DetectDriftOptions) (*recipes.DriftResult, error)) *MockDriverWithDriftDetectionDetectDriftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDriverWithDriftDetectionDetectDriftCall) DoAndReturn(f func(context.Context, DetectDriftOptions) (*recipes.DriftResult, error)) *MockDriverWithDriftDetectionDetectDriftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Execute mocks base method.
+func (m *MockDriverWithDriftDetection) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, opts)
+	ret0, _ := ret[0].(*recipes.RecipeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockDriverWithDriftDetectionMockRecorder) Execute(ctx, opts any) *MockDriverWithDriftDetectionExecuteCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDriverWithDriftDetection)(nil).Execute), ctx, opts)
+	return &MockDriverWithDriftDetectionExecuteCall{Call: call}
+}
+
+// MockDriverWithDriftDetectionExecuteCall wrap *gomock.Call
+type MockDriverWithDriftDetectionExecuteCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDriverWithDriftDetectionExecuteCall) Return(arg0 *recipes.RecipeOutput, arg1 error) *MockDriverWithDriftDetectionExecuteCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDriverWithDriftDetectionExecuteCall) Do(f func(context.Context, ExecuteOptions) (*recipes.RecipeOutput, error)) *MockDriverWithDriftDetectionExecuteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDriverWithDriftDetectionExecuteCall) DoAndReturn(f func(context.Context, ExecuteOptions) (*recipes.RecipeOutput, error)) *MockDriverWithDriftDetectionExecuteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetRecipeMetadata mocks base method.
+func (m *MockDriverWithDriftDetection) GetRecipeMetadata(ctx context.Context, opts BaseOptions) (map[string]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecipeMetadata", ctx, opts)
+	ret0, _ := ret[0].(map[string]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecipeMetadata indicates an expected call of GetRecipeMetadata.
+func (mr *MockDriverWithDriftDetectionMockRecorder) GetRecipeMetadata(ctx, opts any) *MockDriverWithDriftDetectionGetRecipeMetadataCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecipeMetadata", reflect.TypeOf((*MockDriverWithDriftDetection)(nil).GetRecipeMetadata), ctx, opts)
+	return &MockDriverWithDriftDetectionGetRecipeMetadataCall{Call: call}
+}
+
+// MockDriverWithDriftDetectionGetRecipeMetadataCall wrap *gomock.Call
+type MockDriverWithDriftDetectionGetRecipeMetadataCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockDriverWithDriftDetectionGetRecipeMetadataCall) Return(arg0 map[string]any, arg1 error) *MockDriverWithDriftDetectionGetRecipeMetadataCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockDriverWithDriftDetectionGetRecipeMetadataCall) Do(f func(context.Context, BaseOptions) (map[string]any, error)) *MockDriverWithDriftDetectionGetRecipeMetadataCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockDriverWithDriftDetectionGetRecipeMetadataCall) DoAndReturn(f func(context.Context, BaseOptions) (map[string]any, error)) *MockDriverWithDriftDetectionGetRecipeMetadataCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
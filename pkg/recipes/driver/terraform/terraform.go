@@ -34,6 +34,7 @@ import (
 	"golang.org/x/exp/slices"
 
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/cache"
 	"github.com/radius-project/radius/pkg/recipes/driver"
 	"github.com/radius-project/radius/pkg/recipes/terraform"
 	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
@@ -48,6 +49,7 @@ import (
 )
 
 var _ driver.Driver = (*terraformDriver)(nil)
+var _ driver.DriverWithDriftDetection = (*terraformDriver)(nil)
 
 // NewTerraformDriver creates a new instance of driver to execute a Terraform recipe.
 func NewTerraformDriver(ucpConn sdk.Connection, secretProvider *secretprovider.SecretProvider, options TerraformOptions, kubernetesClients kubernetesclientprovider.KubernetesClientProvider) driver.Driver {
@@ -64,6 +66,10 @@ type TerraformOptions struct {
 
 	// LogLevel is the log level for Terraform execution. Valid values: TRACE, DEBUG, INFO, WARN, ERROR, OFF. Default: ERROR.
 	LogLevel string
+
+	// Cache is the optional cache of recipe modules and providers. When it is nil, modules and providers are
+	// downloaded on every execution.
+	Cache *cache.Cache
 }
 
 // terraformDriver represents a driver to interact with Terraform Recipe - deploy recipe, delete resources, etc.
@@ -110,6 +116,7 @@ func (d *terraformDriver) Execute(ctx context.Context, opts driver.ExecuteOption
 		Secrets:          opts.Secrets,
 		StateLockTimeout: terraform.DefaultStateLockTimeout,
 		LogLevel:         d.options.LogLevel,
+		Cache:            d.options.Cache,
 	})
 
 	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
@@ -164,6 +171,7 @@ func (d *terraformDriver) Delete(ctx context.Context, opts driver.DeleteOptions)
 		Secrets:          opts.Secrets,
 		StateLockTimeout: terraform.DefaultStateLockTimeout,
 		LogLevel:         d.options.LogLevel,
+		Cache:            d.options.Cache,
 	})
 
 	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
@@ -178,6 +186,79 @@ func (d *terraformDriver) Delete(ctx context.Context, opts driver.DeleteOptions)
 	return nil
 }
 
+// DetectDrift creates a unique directory for each execution of terraform and runs a refresh-only Terraform plan against the
+// Terraform state of the recipe. It returns the addresses of the Terraform resources that were changed outside of Terraform.
+func (d *terraformDriver) DetectDrift(ctx context.Context, opts driver.DetectDriftOptions) (*recipes.DriftResult, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	requestDirPath, err := d.createExecutionDirectory(ctx, opts.Recipe, opts.Definition)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), "", recipes.GetErrorDetails(err))
+	}
+	defer func() {
+		if err := os.RemoveAll(requestDirPath); err != nil {
+			logger.Info(fmt.Sprintf("Failed to cleanup Terraform execution directory %q. Err: %s", requestDirPath, err.Error()))
+		}
+	}()
+
+	// Get the secret store ID associated with the git private terraform repository source.
+	secretStoreID, err := GetPrivateGitRepoSecretStoreID(opts.Configuration, opts.Definition.TemplatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add credential information to .gitconfig for module source of type git if applicable.
+	err = addSecretsToGitConfigIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := d.terraformExecutor.DetectDrift(ctx, terraform.Options{
+		RootDir:          requestDirPath,
+		EnvConfig:        &opts.Configuration,
+		ResourceRecipe:   &opts.Recipe,
+		EnvRecipe:        &opts.Definition,
+		Secrets:          opts.Secrets,
+		StateLockTimeout: terraform.DefaultStateLockTimeout,
+		LogLevel:         d.options.LogLevel,
+		Cache:            d.options.Cache,
+	})
+
+	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
+	if unsetError != nil {
+		return nil, unsetError
+	}
+
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeDriftDetectionFailed, err.Error(), recipes_util.ExecutionError, recipes.GetErrorDetails(err))
+	}
+
+	return getDriftResult(plan), nil
+}
+
+// getDriftResult converts the resource drift reported by a refresh-only Terraform plan into a drift result.
+func getDriftResult(plan *tfjson.Plan) *recipes.DriftResult {
+	result := &recipes.DriftResult{}
+	if plan == nil {
+		return result
+	}
+
+	for _, change := range plan.ResourceDrift {
+		if change == nil || change.Change == nil || change.Change.Actions.NoOp() {
+			continue
+		}
+
+		result.Resources = append(result.Resources, change.Address)
+	}
+
+	if len(result.Resources) > 0 {
+		result.Drifted = true
+		result.Message = fmt.Sprintf("%d Terraform resource(s) changed outside of Terraform", len(result.Resources))
+	}
+
+	return result
+}
+
 // prepareRecipeResponse populates the recipe response from the module output named "result" and the
 // resources deployed by the Terraform module. The outputs and resources are retrieved from the input Terraform JSON state.
 //
@@ -311,6 +392,7 @@ func (d *terraformDriver) GetRecipeMetadata(ctx context.Context, opts driver.Bas
 		ResourceRecipe: &opts.Recipe,
 		EnvRecipe:      &opts.Definition,
 		LogLevel:       d.options.LogLevel,
+		Cache:          d.options.Cache,
 	})
 
 	unsetError := unsetGitConfigForDirIfApplicable(secretStoreID, opts.Secrets, requestDirPath, opts.Definition.TemplatePath)
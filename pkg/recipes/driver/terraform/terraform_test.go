@@ -1309,3 +1309,55 @@ func createTerraformConfigWithEnvSecrets() recipes.Configuration {
 		},
 	}
 }
+
+func Test_getDriftResult(t *testing.T) {
+	tests := []struct {
+		name     string
+		plan     *tfjson.Plan
+		expected *recipes.DriftResult
+	}{
+		{
+			name:     "nil plan",
+			plan:     nil,
+			expected: &recipes.DriftResult{},
+		},
+		{
+			name: "no drift",
+			plan: &tfjson.Plan{
+				ResourceDrift: []*tfjson.ResourceChange{
+					{
+						Address: "kubernetes_service.redis",
+						Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionNoop}},
+					},
+				},
+			},
+			expected: &recipes.DriftResult{},
+		},
+		{
+			name: "drifted",
+			plan: &tfjson.Plan{
+				ResourceDrift: []*tfjson.ResourceChange{
+					{
+						Address: "kubernetes_deployment.redis",
+						Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionUpdate}},
+					},
+					{
+						Address: "kubernetes_service.redis",
+						Change:  &tfjson.Change{Actions: tfjson.Actions{tfjson.ActionDelete}},
+					},
+				},
+			},
+			expected: &recipes.DriftResult{
+				Drifted:   true,
+				Resources: []string{"kubernetes_deployment.redis", "kubernetes_service.redis"},
+				Message:   "2 Terraform resource(s) changed outside of Terraform",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, getDriftResult(tc.plan))
+		})
+	}
+}
@@ -48,6 +48,18 @@ type DriverWithSecrets interface {
 	FindSecretIDs(ctx context.Context, config recipes.Configuration, definition recipes.EnvironmentDefinition) (secretIDs map[string][]string, err error)
 }
 
+// DriverWithDriftDetection is an optional interface and used when the driver can detect drift between the deployed
+// infrastructure and the recipe.
+//
+//go:generate go tool mockgen -typed -destination=./mock_driver_with_drift_detection.go -package=driver -self_package github.com/radius-project/radius/pkg/recipes/driver github.com/radius-project/radius/pkg/recipes/driver DriverWithDriftDetection
+type DriverWithDriftDetection interface {
+	// Driver is an interface to implement recipe deployment and recipe resources deletion.
+	Driver
+
+	// DetectDrift compares the infrastructure deployed by the recipe with its current state and returns the drift result.
+	DetectDrift(ctx context.Context, opts DetectDriftOptions) (*recipes.DriftResult, error)
+}
+
 // BaseOptions is the base options for the driver operations.
 type BaseOptions struct {
 	// Configuration is the configuration for the recipe.
@@ -90,3 +102,11 @@ type DeleteOptions struct {
 	// OutputResources is the list of output resources for the recipe.
 	OutputResources []rpv1.OutputResource
 }
+
+// DetectDriftOptions is the options for the DetectDrift method.
+type DetectDriftOptions struct {
+	BaseOptions
+
+	// OutputResources is the list of output resources deployed by the recipe.
+	OutputResources []rpv1.OutputResource
+}
@@ -18,18 +18,33 @@ package engine
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/components/metrics"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
+	"github.com/radius-project/radius/pkg/recipes/contract"
 	recipedriver "github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/lease"
 	"github.com/radius-project/radius/pkg/recipes/util"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
+const (
+	// recipeOperationExecute is the name of the recipe execute operation used in recipe leases.
+	recipeOperationExecute = "execute"
+
+	// recipeOperationDelete is the name of the recipe delete operation used in recipe leases.
+	recipeOperationDelete = "delete"
+)
+
 // NewEngine creates a new Engine to deploy recipe.
 func NewEngine(options Options) *engine {
 	return &engine{options: options}
@@ -42,6 +57,10 @@ type Options struct {
 	ConfigurationLoader configloader.ConfigurationLoader
 	SecretsLoader       configloader.SecretsLoader
 	Drivers             map[string]recipedriver.Driver
+
+	// Leases serializes the recipe operations performed on the same resource. Recipe operations are not serialized
+	// when it is nil.
+	Leases lease.Manager
 }
 
 type engine struct {
@@ -50,12 +69,19 @@ type engine struct {
 
 // Execute loads the recipe definition from the environment, finds the driver associated with the recipe, loads the
 // configuration associated with the recipe, and then executes the recipe using the driver. It returns a RecipeOutput and
-// an error if one occurs.
+// an error if one occurs. When the recipe deploys its resources but its outputs don't match the resource type schema,
+// both the RecipeOutput and the error are returned.
 func (e *engine) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
 	executionStart := time.Now()
 	result := metrics.SuccessfulOperationState
 
-	recipeOutput, definition, err := e.executeCore(ctx, opts.Recipe, opts.PreviousState)
+	var recipeOutput *recipes.RecipeOutput
+	var definition *recipes.EnvironmentDefinition
+	err := e.withLease(ctx, opts.Recipe, recipeOperationExecute, func(ctx context.Context) error {
+		var err error
+		recipeOutput, definition, err = e.executeCore(ctx, opts.Recipe, opts.PreviousState, opts.Schema)
+		return err
+	})
 	if err != nil {
 		result = metrics.FailedOperationState
 		if errorDetails := recipes.GetErrorDetails(err); errorDetails != nil {
@@ -72,7 +98,7 @@ func (e *engine) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.Rec
 
 // executeCore function is the core logic of the Execute function.
 // Any changes to the core logic of the Execute function should be made here.
-func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string) (*recipes.RecipeOutput, *recipes.EnvironmentDefinition, error) {
+func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadata, prevState []string, schema map[string]any) (*recipes.RecipeOutput, *recipes.EnvironmentDefinition, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
 	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
@@ -96,28 +122,152 @@ func (e *engine) executeCore(ctx context.Context, recipe recipes.ResourceMetadat
 		return nil, nil, err
 	}
 
+	baseOptions := recipedriver.BaseOptions{
+		Configuration: *configuration,
+		Recipe:        recipe,
+		Definition:    *definition,
+		Secrets:       secrets,
+	}
+
+	if err := e.validateParameters(ctx, driver, baseOptions); err != nil {
+		return nil, definition, err
+	}
+
+	addOperationLogSecrets(ctx, secrets)
+	operationLogf(ctx, "Executing recipe %q (%s) from %q.", recipe.Name, definition.Driver, definition.TemplatePath)
 	res, err := driver.Execute(ctx, recipedriver.ExecuteOptions{
-		BaseOptions: recipedriver.BaseOptions{
-			Configuration: *configuration,
-			Recipe:        recipe,
-			Definition:    *definition,
-			Secrets:       secrets,
-		},
-		PrevState: prevState,
+		BaseOptions: baseOptions,
+		PrevState:   prevState,
 	})
 	if err != nil {
+		operationLogf(ctx, "Recipe %q failed: %s", recipe.Name, err.Error())
 		return nil, definition, err
 	}
+	operationLogf(ctx, "Recipe %q completed.", recipe.Name)
+
+	if res != nil {
+		// The recipe has already deployed its resources, so the output is returned with the error. This lets the
+		// caller record the deployed resources so that they are deleted with the resource.
+		if err := contract.ValidateOutputs(ctx, schema, res.Values, res.Secrets); err != nil {
+			return res, definition, recipes.NewRecipeError(recipes.RecipeContractValidationFailed, err.Error(), util.ExecutionError, nil)
+		}
+	}
 
 	return res, definition, nil
 }
 
+// validateParameters validates the parameters passed to the recipe against the parameters declared by the recipe
+// template before the recipe is executed. Retrieving the recipe metadata requires downloading the recipe, so the
+// validation only runs when parameters are provided.
+func (e *engine) validateParameters(ctx context.Context, driver recipedriver.Driver, opts recipedriver.BaseOptions) error {
+	parameters := util.ShallowMergeParameters(opts.Definition.Parameters, opts.Recipe.Parameters)
+	if len(parameters) == 0 {
+		return nil
+	}
+
+	metadata, err := driver.GetRecipeMetadata(ctx, opts)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeGetMetadataFailed, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	if err := contract.ValidateParameters(parameters, metadata); err != nil {
+		return recipes.NewRecipeError(recipes.RecipeContractValidationFailed, fmt.Sprintf("recipe parameters do not match the parameters declared by %q: %s", opts.Definition.TemplatePath, err.Error()), util.RecipeSetupError, nil)
+	}
+
+	return nil
+}
+
+// withLease runs the recipe operation while holding the lease of the resource, so that recipe operations on the same
+// resource never run concurrently. While the operation waits for the lease, the reason it is waiting is reported in
+// the status of the async operation.
+func (e *engine) withLease(ctx context.Context, recipe recipes.ResourceMetadata, operation string, fn func(ctx context.Context) error) error {
+	if e.options.Leases == nil || recipe.ResourceID == "" {
+		return fn(ctx)
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	desiredState, err := recipeDesiredState(recipe, operation)
+	if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeLeaseFailed, err.Error(), util.RecipeSetupError, nil)
+	}
+
+	waited := false
+	handle, err := e.options.Leases.Acquire(ctx, lease.AcquireOptions{
+		ResourceID:   recipe.ResourceID,
+		Operation:    operation,
+		DesiredState: desiredState,
+		OnWait: func(ctx context.Context, reason string) {
+			waited = true
+			logger.Info(reason)
+			if err := v1.ReportOperationStatus(ctx, reason); err != nil {
+				logger.Error(err, "failed to report the reason the recipe operation is waiting")
+			}
+		},
+	})
+	if errors.Is(err, lease.ErrSuperseded) {
+		return recipes.NewRecipeError(recipes.RecipeOperationSuperseded, err.Error(), util.RecipeSetupError, nil)
+	} else if err != nil {
+		return recipes.NewRecipeError(recipes.RecipeLeaseFailed, fmt.Sprintf("failed to acquire the recipe lease of resource %q: %s", recipe.ResourceID, err.Error()), util.RecipeSetupError, nil)
+	}
+
+	// Clear the wait reason now that the operation is running.
+	if waited {
+		if err := v1.ReportOperationStatus(ctx, ""); err != nil {
+			logger.Error(err, "failed to clear the reason the recipe operation was waiting")
+		}
+	}
+
+	defer func() {
+		if err := handle.Release(ctx); err != nil {
+			logger.Error(err, "failed to release the recipe lease", "resourceID", recipe.ResourceID)
+		}
+	}()
+
+	err = fn(handle.Context())
+	if err != nil {
+		if cause := context.Cause(handle.Context()); errors.Is(cause, lease.ErrSuperseded) || errors.Is(cause, lease.ErrLost) {
+			return recipes.NewRecipeError(recipes.RecipeOperationSuperseded, fmt.Sprintf("%s: %s", cause.Error(), err.Error()), util.ExecutionError, nil)
+		}
+	}
+
+	return err
+}
+
+// recipeDesiredState returns a fingerprint of the desired state a recipe operation applies to the resource. Two
+// executions of the same recipe with the same inputs have the same desired state.
+func recipeDesiredState(recipe recipes.ResourceMetadata, operation string) (string, error) {
+	if operation == recipeOperationDelete {
+		return recipeOperationDelete, nil
+	}
+
+	b, err := json.Marshal(map[string]any{
+		"name":                         recipe.Name,
+		"environmentID":                recipe.EnvironmentID,
+		"applicationID":                recipe.ApplicationID,
+		"properties":                   recipe.Properties,
+		"parameters":                   recipe.Parameters,
+		"connectedResourcesProperties": recipe.ConnectedResourcesProperties,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to compute the desired state of resource %q: %w", recipe.ResourceID, err)
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
 // Delete calls the Delete method of the driver specified in the recipe definition to delete the output resources.
 func (e *engine) Delete(ctx context.Context, opts DeleteOptions) error {
 	deletionStart := time.Now()
 	result := metrics.SuccessfulOperationState
 
-	definition, err := e.deleteCore(ctx, opts.Recipe, opts.OutputResources)
+	var definition *recipes.EnvironmentDefinition
+	err := e.withLease(ctx, opts.Recipe, recipeOperationDelete, func(ctx context.Context) error {
+		var err error
+		definition, err = e.deleteCore(ctx, opts.Recipe, opts.OutputResources)
+		return err
+	})
 	if err != nil {
 		result = metrics.FailedOperationState
 		if errorDetails := recipes.GetErrorDetails(err); errorDetails != nil {
@@ -155,6 +305,8 @@ func (e *engine) deleteCore(ctx context.Context, recipe recipes.ResourceMetadata
 	if err != nil {
 		return nil, err
 	}
+	addOperationLogSecrets(ctx, secrets)
+	operationLogf(ctx, "Deleting the resources deployed by recipe %q (%s).", recipe.Name, definition.Driver)
 	err = driver.Delete(ctx, recipedriver.DeleteOptions{
 		BaseOptions: recipedriver.BaseOptions{
 			Configuration: *configuration,
@@ -165,12 +317,90 @@ func (e *engine) deleteCore(ctx context.Context, recipe recipes.ResourceMetadata
 		OutputResources: outputResources,
 	})
 	if err != nil {
+		operationLogf(ctx, "Deleting the resources deployed by recipe %q failed: %s", recipe.Name, err.Error())
 		return definition, err
 	}
+	operationLogf(ctx, "Deleted the resources deployed by recipe %q.", recipe.Name)
 
 	return definition, nil
 }
 
+// DetectDrift loads the recipe definition from the environment, finds the driver associated with the recipe and uses the
+// driver to compare the output resources deployed by the recipe with their current state. It returns a DriftResult and
+// an error if one occurs.
+func (e *engine) DetectDrift(ctx context.Context, opts DetectDriftOptions) (*recipes.DriftResult, error) {
+	detectionStart := time.Now()
+	result := metrics.SuccessfulOperationState
+
+	driftResult, definition, err := e.detectDriftCore(ctx, opts.Recipe, opts.OutputResources)
+	if err != nil {
+		result = metrics.FailedOperationState
+		if errorDetails := recipes.GetErrorDetails(err); errorDetails != nil {
+			result = errorDetails.Code
+		}
+	}
+
+	metrics.DefaultRecipeEngineMetrics.RecordRecipeOperationDuration(ctx, detectionStart,
+		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDetectDrift, opts.Recipe.Name,
+			definition, result))
+
+	if driftResult != nil && driftResult.Drifted {
+		metrics.DefaultRecipeEngineMetrics.RecordRecipeDriftDetected(ctx,
+			metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDetectDrift, opts.Recipe.Name,
+				definition, ""))
+	}
+
+	return driftResult, err
+}
+
+// detectDriftCore function is the core logic of the DetectDrift function.
+// Any changes to the core logic of the DetectDrift function should be made here.
+func (e *engine) detectDriftCore(ctx context.Context, recipe recipes.ResourceMetadata, outputResources []rpv1.OutputResource) (*recipes.DriftResult, *recipes.EnvironmentDefinition, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	configuration, err := e.options.ConfigurationLoader.LoadConfiguration(ctx, recipe)
+	if err != nil {
+		return nil, nil, recipes.NewRecipeError(recipes.RecipeConfigurationFailure, err.Error(), util.RecipeSetupError, recipes.GetErrorDetails(err))
+	}
+
+	// Nothing is deployed in a simulated environment, so there is nothing to drift.
+	if configuration.Simulated {
+		logger.Info("simulated environment enabled, skipping drift detection")
+		return &recipes.DriftResult{}, nil, nil
+	}
+
+	definition, driver, err := e.getDriver(ctx, recipe)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	driftDriver, ok := driver.(recipedriver.DriverWithDriftDetection)
+	if !ok {
+		err := fmt.Errorf("driver `%s` does not support drift detection", definition.Driver)
+		return nil, definition, recipes.NewRecipeError(recipes.RecipeDriftDetectionNotSupported, err.Error(), util.RecipeSetupError, nil)
+	}
+
+	secrets, err := e.getRecipeConfigSecrets(ctx, driver, configuration, definition)
+	if err != nil {
+		return nil, definition, err
+	}
+
+	res, err := driftDriver.DetectDrift(ctx, recipedriver.DetectDriftOptions{
+		BaseOptions: recipedriver.BaseOptions{
+			Configuration: *configuration,
+			Recipe:        recipe,
+			Definition:    *definition,
+			Secrets:       secrets,
+		},
+		OutputResources: outputResources,
+	})
+	if err != nil {
+		return nil, definition, err
+	}
+
+	return res, definition, nil
+}
+
 // Gets the Recipe metadata and parameters from Recipe's template path.
 func (e *engine) GetRecipeMetadata(ctx context.Context, opts GetRecipeMetadataOptions) (map[string]any, error) {
 	recipeData, err := e.getRecipeMetadataCore(ctx, opts)
@@ -247,3 +477,19 @@ func (e *engine) getRecipeConfigSecrets(ctx context.Context, driver recipedriver
 
 	return secretData, nil
 }
+
+// operationLogf records a line in the log of the operation executing the recipe.
+func operationLogf(ctx context.Context, format string, args ...any) {
+	_, _ = fmt.Fprintf(v1.OperationLoggerFromContext(ctx), format+"\n", args...)
+}
+
+// addOperationLogSecrets registers the values of the secrets used by the recipe so that they are redacted from the log
+// of the operation executing the recipe.
+func addOperationLogSecrets(ctx context.Context, secrets map[string]recipes.SecretData) {
+	operationLog := v1.OperationLoggerFromContext(ctx)
+	for _, secret := range secrets {
+		for _, value := range secret.Data {
+			operationLog.AddSecrets(value)
+		}
+	}
+}
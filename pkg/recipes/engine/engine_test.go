@@ -17,14 +17,21 @@ limitations under the License.
 package engine
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
 	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
 	"github.com/radius-project/radius/pkg/recipes/configloader"
 	recipedriver "github.com/radius-project/radius/pkg/recipes/driver"
+	"github.com/radius-project/radius/pkg/recipes/lease"
 	rpv1 "github.com/radius-project/radius/pkg/rp/v1"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/stretchr/testify/require"
@@ -51,6 +58,15 @@ func setup(t *testing.T) (engine, configloader.MockConfigurationLoader, recipedr
 	return engine, *cfgLoader, *mDriver, *mDriverWithSecrets, *secretLoader
 }
 
+// testRecipeMetadata is the recipe metadata declaring the parameters used by the tests.
+var testRecipeMetadata = map[string]any{
+	"parameters": map[string]any{
+		"resourceName": map[string]any{
+			"type": "string",
+		},
+	},
+}
+
 func Test_Engine_Execute_Success(t *testing.T) {
 	recipeMetadata := recipes.ResourceMetadata{
 		Name:          "mongo-azure",
@@ -112,6 +128,10 @@ func Test_Engine_Execute_Success(t *testing.T) {
 		LoadRecipe(ctx, &recipeMetadata).
 		Times(1).
 		Return(recipeDefinition, nil)
+	driver.EXPECT().
+		GetRecipeMetadata(ctx, gomock.Any()).
+		Times(1).
+		Return(testRecipeMetadata, nil)
 	driver.EXPECT().
 		Execute(ctx, recipedriver.ExecuteOptions{
 			BaseOptions: recipedriver.BaseOptions{
@@ -224,6 +244,10 @@ func Test_Engine_Execute_Failure(t *testing.T) {
 		LoadRecipe(ctx, &recipeMetadata).
 		Times(1).
 		Return(recipeDefinition, nil)
+	driver.EXPECT().
+		GetRecipeMetadata(ctx, gomock.Any()).
+		Times(1).
+		Return(testRecipeMetadata, nil)
 	driver.EXPECT().
 		Execute(ctx, recipedriver.ExecuteOptions{
 			BaseOptions: recipedriver.BaseOptions{
@@ -303,6 +327,10 @@ func Test_Engine_Terraform_Success(t *testing.T) {
 		FindSecretIDs(ctx, *envConfig, *recipeDefinition).
 		Times(1).
 		Return(nil, nil)
+	driverWithSecrets.EXPECT().
+		GetRecipeMetadata(ctx, gomock.Any()).
+		Times(1).
+		Return(testRecipeMetadata, nil)
 	driverWithSecrets.EXPECT().
 		Execute(ctx, recipedriver.ExecuteOptions{
 			BaseOptions: recipedriver.BaseOptions{
@@ -446,6 +474,10 @@ func Test_Engine_Terraform_Failure(t *testing.T) {
 						Times(1).
 						Return(nil, nil)
 					if tc.errExecute != nil {
+						driverWithSecrets.EXPECT().
+							GetRecipeMetadata(ctx, gomock.Any()).
+							Times(1).
+							Return(testRecipeMetadata, nil)
 						driverWithSecrets.EXPECT().
 							Execute(ctx, recipedriver.ExecuteOptions{
 								BaseOptions: recipedriver.BaseOptions{
@@ -458,6 +490,10 @@ func Test_Engine_Terraform_Failure(t *testing.T) {
 							Times(1).
 							Return(nil, tc.errExecute)
 					} else {
+						driverWithSecrets.EXPECT().
+							GetRecipeMetadata(ctx, gomock.Any()).
+							Times(1).
+							Return(testRecipeMetadata, nil)
 						driverWithSecrets.EXPECT().
 							Execute(ctx, recipedriver.ExecuteOptions{
 								BaseOptions: recipedriver.BaseOptions{
@@ -1110,6 +1146,10 @@ func Test_Engine_Execute_With_Secrets_Success(t *testing.T) {
 		LoadSecrets(ctx, gomock.Any()).
 		Times(1).
 		Return(nil, nil)
+	driverWithSecrets.EXPECT().
+		GetRecipeMetadata(ctx, gomock.Any()).
+		Times(1).
+		Return(testRecipeMetadata, nil)
 	driverWithSecrets.EXPECT().
 		Execute(ctx, recipedriver.ExecuteOptions{
 			BaseOptions: recipedriver.BaseOptions{
@@ -1132,6 +1172,75 @@ func Test_Engine_Execute_With_Secrets_Success(t *testing.T) {
 	require.Equal(t, result, recipeResult)
 }
 
+type testOperationLogger struct {
+	bytes.Buffer
+	secrets []string
+}
+
+func (l *testOperationLogger) AddSecrets(values ...string) {
+	l.secrets = append(l.secrets, values...)
+}
+
+func Test_Engine_Execute_WritesOperationLog(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "mongo-azure",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Microsoft.Resources/deployments/recipe",
+	}
+	envConfig := &recipes.Configuration{
+		Runtime: recipes.RuntimeConfiguration{
+			Kubernetes: &recipes.KubernetesRuntime{
+				Namespace: "default",
+			},
+		},
+	}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindTerraform,
+		TemplatePath: "git://https://dev.azure.com/mongo-recipe/recipe",
+		ResourceType: "Applications.Datastores/mongoDatabases",
+	}
+	secretStoreID := "/planes/radius/local/resourcegroups/default/providers/Applications.Core/secretStores/azdevopsgit"
+
+	operationLog := &testOperationLogger{}
+	ctx := v1.WithOperationLogger(t.Context(), operationLog)
+	engine, configLoader, _, driverWithSecrets, secretsLoader := setup(t)
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(recipeDefinition, nil)
+	driverWithSecrets.EXPECT().
+		FindSecretIDs(ctx, *envConfig, *recipeDefinition).
+		Times(1).
+		Return(map[string][]string{secretStoreID: {"pat"}}, nil)
+	secretsLoader.EXPECT().
+		LoadSecrets(ctx, gomock.Any()).
+		Times(1).
+		Return(map[string]recipes.SecretData{secretStoreID: {Data: map[string]string{"pat": "s3cr3t-pat"}}}, nil)
+	driverWithSecrets.EXPECT().
+		Execute(ctx, gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, opts recipedriver.ExecuteOptions) (*recipes.RecipeOutput, error) {
+			_, _ = fmt.Fprintln(v1.OperationLoggerFromContext(ctx), "Apply complete!")
+			return &recipes.RecipeOutput{}, nil
+		})
+
+	_, err := engine.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, `Executing recipe "mongo-azure" (terraform) from "git://https://dev.azure.com/mongo-recipe/recipe".
+Apply complete!
+Recipe "mongo-azure" completed.
+`, operationLog.String())
+	require.Equal(t, []string{"s3cr3t-pat"}, operationLog.secrets)
+}
+
 func Test_Engine_Delete_With_Secrets_Success(t *testing.T) {
 	_, _, outputResources := getRecipeInputs()
 	recipeMetadata := recipes.ResourceMetadata{
@@ -1213,3 +1322,324 @@ func Test_Engine_Delete_With_Secrets_Success(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func Test_Engine_DetectDrift_Success(t *testing.T) {
+	recipeMetadata, recipeDefinition, outputResources := getRecipeInputs()
+	envConfig := &recipes.Configuration{}
+
+	ctx := t.Context()
+	engine, configLoader, _, _, _ := setup(t)
+	driftDriver := recipedriver.NewMockDriverWithDriftDetection(gomock.NewController(t))
+	engine.options.Drivers[recipes.TemplateKindBicep] = driftDriver
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+
+	expected := &recipes.DriftResult{
+		Drifted:   true,
+		Resources: []string{outputResources[0].ID.String()},
+		Message:   "1 resource(s) deployed by the recipe no longer exist",
+	}
+	driftDriver.EXPECT().
+		DetectDrift(ctx, recipedriver.DetectDriftOptions{
+			BaseOptions: recipedriver.BaseOptions{
+				Configuration: *envConfig,
+				Recipe:        recipeMetadata,
+				Definition:    recipeDefinition,
+			},
+			OutputResources: outputResources,
+		}).
+		Times(1).
+		Return(expected, nil)
+
+	result, err := engine.DetectDrift(ctx, DetectDriftOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		OutputResources: outputResources,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected, result)
+}
+
+func Test_Engine_DetectDrift_SimulatedEnv(t *testing.T) {
+	recipeMetadata, _, outputResources := getRecipeInputs()
+
+	ctx := t.Context()
+	engine, configLoader, _, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(&recipes.Configuration{Simulated: true}, nil)
+
+	result, err := engine.DetectDrift(ctx, DetectDriftOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		OutputResources: outputResources,
+	})
+	require.NoError(t, err)
+	require.False(t, result.Drifted)
+}
+
+func Test_Engine_DetectDrift_NotSupported(t *testing.T) {
+	recipeMetadata, recipeDefinition, outputResources := getRecipeInputs()
+
+	ctx := t.Context()
+	engine, configLoader, _, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(&recipes.Configuration{}, nil)
+
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+
+	_, err := engine.DetectDrift(ctx, DetectDriftOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		OutputResources: outputResources,
+	})
+	require.Error(t, err)
+
+	var recipeError *recipes.RecipeError
+	require.True(t, errors.As(err, &recipeError))
+	require.Equal(t, recipes.RecipeDriftDetectionNotSupported, recipeError.ErrorDetails.Code)
+}
+
+func Test_Engine_DetectDrift_Load_Error(t *testing.T) {
+	recipeMetadata, _, outputResources := getRecipeInputs()
+
+	ctx := t.Context()
+	engine, configLoader, _, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(nil, errors.New("failed to load environment"))
+
+	_, err := engine.DetectDrift(ctx, DetectDriftOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		OutputResources: outputResources,
+	})
+	require.ErrorContains(t, err, "failed to load environment")
+}
+
+func Test_Engine_Execute_InvalidParameters(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "redis",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Radius.Data/redisCaches/redis",
+		Parameters: map[string]any{
+			"resourceName": "resource1",
+			"sku":          "Premium",
+		},
+	}
+	envConfig := &recipes.Configuration{}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/recipes/redis:latest",
+		ResourceType: "Radius.Data/redisCaches",
+	}
+	ctx := t.Context()
+	engine, configLoader, driver, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(recipeDefinition, nil)
+	driver.EXPECT().
+		GetRecipeMetadata(ctx, gomock.Any()).
+		Times(1).
+		Return(testRecipeMetadata, nil)
+
+	_, err := engine.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+	})
+	require.Error(t, err)
+
+	recipeError := &recipes.RecipeError{}
+	require.ErrorAs(t, err, &recipeError)
+	require.Equal(t, recipes.RecipeContractValidationFailed, recipeError.ErrorDetails.Code)
+	require.Contains(t, recipeError.ErrorDetails.Message, `parameter "sku" is not declared by the recipe`)
+}
+
+func Test_Engine_Execute_InvalidOutputs(t *testing.T) {
+	recipeMetadata := recipes.ResourceMetadata{
+		Name:          "redis",
+		EnvironmentID: "/planes/radius/local/resourcegroups/test-rg/providers/applications.core/environments/env1",
+		ResourceID:    "/planes/radius/local/resourceGroups/test-rg/providers/Radius.Data/redisCaches/redis",
+	}
+	envConfig := &recipes.Configuration{}
+	recipeDefinition := &recipes.EnvironmentDefinition{
+		Driver:       recipes.TemplateKindBicep,
+		TemplatePath: "ghcr.io/radius-project/recipes/redis:latest",
+		ResourceType: "Radius.Data/redisCaches",
+	}
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"port": map[string]any{
+				"type":     "integer",
+				"readOnly": true,
+			},
+		},
+	}
+	ctx := t.Context()
+	engine, configLoader, driver, _, _ := setup(t)
+
+	configLoader.EXPECT().
+		LoadConfiguration(ctx, recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(ctx, &recipeMetadata).
+		Times(1).
+		Return(recipeDefinition, nil)
+	driver.EXPECT().
+		Execute(ctx, gomock.Any()).
+		Times(1).
+		Return(&recipes.RecipeOutput{
+			Values:    map[string]any{"port": "not-a-port"},
+			Resources: []string{"/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis"},
+		}, nil)
+
+	output, err := engine.Execute(ctx, ExecuteOptions{
+		BaseOptions: BaseOptions{
+			Recipe: recipeMetadata,
+		},
+		Schema: schema,
+	})
+	require.Error(t, err)
+
+	// The deployed resources are returned with the error so that they can be recorded.
+	require.NotNil(t, output)
+	require.Equal(t, []string{"/planes/kubernetes/local/namespaces/default/providers/apps/Deployment/redis"}, output.Resources)
+
+	recipeError := &recipes.RecipeError{}
+	require.ErrorAs(t, err, &recipeError)
+	require.Equal(t, recipes.RecipeContractValidationFailed, recipeError.ErrorDetails.Code)
+	require.Contains(t, recipeError.ErrorDetails.Message, "recipe output values do not match the resource type schema")
+}
+
+func newTestLeaseManager() lease.Manager {
+	return lease.NewManager(databaseprovider.FromClient(inmemory.NewClient()), lease.Options{
+		Duration:      time.Second,
+		RenewInterval: 10 * time.Millisecond,
+		PollInterval:  10 * time.Millisecond,
+	})
+}
+
+func Test_Engine_Execute_WaitsForLease(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	recipeMetadata.Parameters = nil
+	envConfig := &recipes.Configuration{}
+
+	engine, configLoader, driver, _, _ := setup(t)
+	leases := newTestLeaseManager()
+	engine.options.Leases = leases
+
+	// Another recipe operation holds the lease of the resource.
+	holder, err := leases.Acquire(t.Context(), lease.AcquireOptions{ResourceID: recipeMetadata.ResourceID, Operation: "execute"})
+	require.NoError(t, err)
+
+	configLoader.EXPECT().
+		LoadConfiguration(gomock.Any(), recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(gomock.Any(), &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+	driver.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		Times(1).
+		Return(&recipes.RecipeOutput{}, nil)
+
+	reported := make(chan string, 10)
+	ctx := v1.WithOperationStatusReporter(t.Context(), func(ctx context.Context, message string) error {
+		reported <- message
+		return nil
+	})
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := engine.Execute(ctx, ExecuteOptions{BaseOptions: BaseOptions{Recipe: recipeMetadata}})
+		done <- err
+	}()
+
+	require.Contains(t, <-reported, "Waiting for the recipe execute operation in progress")
+	require.NoError(t, holder.Release(t.Context()))
+
+	require.NoError(t, <-done)
+
+	// The wait reason is cleared once the operation runs.
+	require.Equal(t, "", <-reported)
+}
+
+func Test_Engine_Execute_Superseded(t *testing.T) {
+	recipeMetadata, recipeDefinition, _ := getRecipeInputs()
+	recipeMetadata.Parameters = nil
+	envConfig := &recipes.Configuration{}
+
+	engine, configLoader, driver, _, _ := setup(t)
+	leases := newTestLeaseManager()
+	engine.options.Leases = leases
+
+	configLoader.EXPECT().
+		LoadConfiguration(gomock.Any(), recipeMetadata).
+		Times(1).
+		Return(envConfig, nil)
+	configLoader.EXPECT().
+		LoadRecipe(gomock.Any(), &recipeMetadata).
+		Times(1).
+		Return(&recipeDefinition, nil)
+
+	running := make(chan struct{})
+	driver.EXPECT().
+		Execute(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(ctx context.Context, opts recipedriver.ExecuteOptions) (*recipes.RecipeOutput, error) {
+			close(running)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := engine.Execute(t.Context(), ExecuteOptions{BaseOptions: BaseOptions{Recipe: recipeMetadata}})
+		done <- err
+	}()
+
+	<-running
+
+	// A delete of the resource supersedes the running execution.
+	deletion, err := leases.Acquire(t.Context(), lease.AcquireOptions{ResourceID: recipeMetadata.ResourceID, Operation: "delete", DesiredState: "delete"})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, deletion.Release(t.Context())) }()
+
+	err = <-done
+	recipeError := &recipes.RecipeError{}
+	require.ErrorAs(t, err, &recipeError)
+	require.Equal(t, recipes.RecipeOperationSuperseded, recipeError.ErrorDetails.Code)
+}
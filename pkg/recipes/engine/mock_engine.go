@@ -79,6 +79,45 @@ func (c *MockEngineDeleteCall) DoAndReturn(f func(context.Context, DeleteOptions
 	return c
 }
 
+// DetectDrift mocks base method.
+func (m *MockEngine) DetectDrift(ctx context.Context, opts DetectDriftOptions) (*recipes.DriftResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectDrift", ctx, opts)
+	ret0, _ := ret[0].(*recipes.DriftResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectDrift indicates an expected call of DetectDrift.
+func (mr *MockEngineMockRecorder) DetectDrift(ctx, opts any) *MockEngineDetectDriftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectDrift", reflect.TypeOf((*MockEngine)(nil).DetectDrift), ctx, opts)
+	return &MockEngineDetectDriftCall{Call: call}
+}
+
+// MockEngineDetectDriftCall wrap *gomock.Call
+type MockEngineDetectDriftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockEngineDetectDriftCall) Return(arg0 *recipes.DriftResult, arg1 error) *MockEngineDetectDriftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockEngineDetectDriftCall) Do(f func(context.Context, DetectDriftOptions) (*recipes.DriftResult, error)) *MockEngineDetectDriftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockEngineDetectDriftCall) DoAndReturn(f func(context.Context, DetectDriftOptions) (*recipes.DriftResult, error)) *MockEngineDetectDriftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // Execute mocks base method.
 func (m *MockEngine) Execute(ctx context.Context, opts ExecuteOptions) (*recipes.RecipeOutput, error) {
 	m.ctrl.T.Helper()
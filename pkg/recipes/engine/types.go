@@ -35,6 +35,9 @@ type Engine interface {
 
 	// Gets the Recipe metadata and parameters from Recipe's template path
 	GetRecipeMetadata(ctx context.Context, opts GetRecipeMetadataOptions) (map[string]any, error)
+
+	// DetectDrift compares the output resources deployed by the recipe with their current state.
+	DetectDrift(ctx context.Context, opts DetectDriftOptions) (*recipes.DriftResult, error)
 }
 
 // BaseOptions is the base options for the engine operations.
@@ -50,6 +53,9 @@ type ExecuteOptions struct {
 	PreviousState []string
 	// Simulated is the flag to indicate if the execution is a simulation.
 	Simulated bool
+	// Schema is the resource type schema for the API version of the resource. When set, the recipe outputs
+	// are validated against it.
+	Schema map[string]any
 }
 
 // DeleteOptions is the options for the Delete method.
@@ -60,6 +66,14 @@ type DeleteOptions struct {
 	OutputResources []rpv1.OutputResource
 }
 
+// DetectDriftOptions is the options for the DetectDrift method.
+type DetectDriftOptions struct {
+	BaseOptions
+
+	// OutputResources is the list of output resources deployed by the recipe.
+	OutputResources []rpv1.OutputResource
+}
+
 type GetRecipeMetadataOptions struct {
 	BaseOptions
 	RecipeDefinition recipes.EnvironmentDefinition
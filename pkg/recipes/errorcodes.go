@@ -49,4 +49,19 @@ const (
 
 	// Used for errors encountered while loading recipe secrets.
 	LoadSecretsFailed = "LoadSecretsFailed"
+
+	// Used for errors encountered while detecting drift of the resources deployed by a recipe.
+	RecipeDriftDetectionFailed = "RecipeDriftDetectionFailed"
+
+	// Used for recipe drivers that do not support drift detection.
+	RecipeDriftDetectionNotSupported = "RecipeDriftDetectionNotSupported"
+
+	// Used for recipe parameters or outputs that do not match the recipe template or the resource type schema.
+	RecipeContractValidationFailed = "RecipeContractValidationFailed"
+
+	// Used for errors encountered while acquiring the lease that serializes recipe operations on a resource.
+	RecipeLeaseFailed = "RecipeLeaseFailed"
+
+	// Used for recipe operations superseded by a later recipe operation on the same resource.
+	RecipeOperationSuperseded = "RecipeOperationSuperseded"
 )
@@ -0,0 +1,349 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lease
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+// maxConcurrencyRetries is the number of times an update of the lease is retried when it is modified concurrently.
+const maxConcurrencyRetries = 5
+
+//go:generate go tool mockgen -typed -destination=./mock_manager.go -package=lease -self_package github.com/radius-project/radius/pkg/recipes/lease github.com/radius-project/radius/pkg/recipes/lease Manager
+
+// Manager serializes the recipe operations performed on the same resource using a lease stored in the database.
+//
+// Only one recipe operation holds the lease of a resource at a time. Operations that find the lease held wait for
+// it, and only the most recent waiting operation is kept: earlier waiting operations are coalesced into it and fail
+// with ErrSuperseded. A waiting operation whose desired state differs from the state applied by the holder also
+// supersedes the holder, whose context is canceled with ErrSuperseded.
+type Manager interface {
+	// Acquire waits until the lease of the resource is acquired or ctx is done. The returned Handle must be released
+	// when the recipe operation completes.
+	Acquire(ctx context.Context, opts AcquireOptions) (*Handle, error)
+}
+
+// NewManager creates a new lease manager that stores leases in the database.
+func NewManager(databaseProvider *databaseprovider.DatabaseProvider, options Options) Manager {
+	if options.Duration <= 0 {
+		options.Duration = DefaultDuration
+	}
+	if options.RenewInterval <= 0 {
+		options.RenewInterval = DefaultRenewInterval
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = DefaultPollInterval
+	}
+
+	return &manager{databaseProvider: databaseProvider, options: options}
+}
+
+var _ Manager = (*manager)(nil)
+
+type manager struct {
+	databaseProvider *databaseprovider.DatabaseProvider
+	options          Options
+}
+
+// Handle is a lease held by a recipe operation.
+type Handle struct {
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	done   chan struct{}
+	once   sync.Once
+
+	manager *manager
+	client  database.Client
+	id      string
+	holder  string
+}
+
+// Context returns the context of the recipe operation holding the lease. It is canceled with ErrSuperseded or
+// ErrLost as the cause when the operation is superseded or the lease is lost.
+func (h *Handle) Context() context.Context {
+	return h.ctx
+}
+
+// Release stops renewing the lease and frees it so that the next waiting operation can acquire it.
+func (h *Handle) Release(ctx context.Context) error {
+	var err error
+	h.once.Do(func() {
+		close(h.done)
+		h.cancel(context.Canceled)
+		err = h.manager.release(ctx, h.client, h.id, h.holder)
+	})
+	return err
+}
+
+// Acquire waits until the lease of the resource is acquired or ctx is done.
+func (m *manager) Acquire(ctx context.Context, opts AcquireOptions) (*Handle, error) {
+	client, err := m.databaseProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id := leaseID(opts.ResourceID)
+	holder := uuid.NewString()
+	queuedAt := time.Now().UTC()
+
+	lastReason := ""
+	for {
+		acquired, reason, err := m.tryAcquire(ctx, client, id, holder, queuedAt, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		if acquired {
+			break
+		}
+
+		if reason != lastReason && opts.OnWait != nil {
+			opts.OnWait(ctx, reason)
+		}
+		lastReason = reason
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(m.options.PollInterval):
+		}
+	}
+
+	leaseCtx, cancel := context.WithCancelCause(ctx)
+	h := &Handle{
+		ctx:     leaseCtx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+		manager: m,
+		client:  client,
+		id:      id,
+		holder:  holder,
+	}
+
+	go m.renew(h)
+
+	return h, nil
+}
+
+// tryAcquire makes a single attempt to acquire the lease. When the lease is held by another operation, the operation
+// is recorded as the pending operation and the reason it is waiting is returned.
+func (m *manager) tryAcquire(ctx context.Context, client database.Client, id string, holder string, queuedAt time.Time, opts AcquireOptions) (bool, string, error) {
+	for range maxConcurrencyRetries {
+		now := time.Now().UTC()
+
+		obj, err := client.Get(ctx, id)
+		if errors.Is(err, &database.ErrNotFound{}) {
+			lease := &Lease{ResourceID: opts.ResourceID}
+			m.take(lease, holder, opts, now)
+
+			// Only one operation can create the lease. An operation that loses the race reads the lease created by
+			// the winner and tries again.
+			err = client.Save(ctx, &database.Object{Metadata: database.Metadata{ID: id}, Data: lease}, database.WithCreateOnly())
+			if errors.Is(err, &database.ErrConcurrency{}) {
+				continue
+			} else if err != nil {
+				return false, "", err
+			}
+			return true, "", nil
+		} else if err != nil {
+			return false, "", err
+		}
+
+		lease := &Lease{}
+		if err := obj.As(lease); err != nil {
+			return false, "", err
+		}
+
+		if pending := lease.pending(now); pending != nil && pending.Holder != holder && pending.QueuedAt.After(queuedAt) {
+			return false, "", ErrSuperseded
+		}
+
+		reason := ""
+		if !lease.held(now) || lease.Holder == holder {
+			m.take(lease, holder, opts, now)
+		} else {
+			lease.Pending = &Waiter{
+				Holder:    holder,
+				Operation: opts.Operation,
+				QueuedAt:  queuedAt,
+				ExpiresAt: now.Add(m.options.Duration),
+			}
+
+			if lease.SupersededBy == "" && opts.DesiredState != lease.DesiredState {
+				lease.SupersededBy = holder
+			}
+
+			reason = fmt.Sprintf("Waiting for the recipe %s operation in progress on resource %q to complete.", lease.Operation, opts.ResourceID)
+			if lease.SupersededBy == holder {
+				reason = fmt.Sprintf("Waiting for the recipe %s operation in progress on resource %q to stop because the desired state of the resource has changed.", lease.Operation, opts.ResourceID)
+			}
+		}
+
+		obj.Data = lease
+		err = client.Save(ctx, obj, database.WithETag(obj.ETag))
+		if errors.Is(err, &database.ErrConcurrency{}) {
+			continue
+		} else if err != nil {
+			return false, "", err
+		}
+
+		return reason == "", reason, nil
+	}
+
+	return false, "", fmt.Errorf("failed to update the recipe lease of resource %q: the lease was modified concurrently", opts.ResourceID)
+}
+
+// take assigns the lease to the operation.
+func (m *manager) take(lease *Lease, holder string, opts AcquireOptions, now time.Time) {
+	lease.Holder = holder
+	lease.Operation = opts.Operation
+	lease.DesiredState = opts.DesiredState
+	lease.AcquiredAt = now
+	lease.ExpiresAt = now.Add(m.options.Duration)
+	lease.SupersededBy = ""
+	if lease.Pending != nil && lease.Pending.Holder == holder {
+		lease.Pending = nil
+	}
+}
+
+// renew extends the lease until it is released, and cancels the context of the operation holding the lease when the
+// operation is superseded or the lease is lost.
+func (m *manager) renew(h *Handle) {
+	logger := ucplog.FromContextOrDiscard(h.ctx)
+
+	ticker := time.NewTicker(m.options.RenewInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.done:
+			return
+		case <-h.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		cause, err := m.renewOnce(h.ctx, h.client, h.id, h.holder)
+		if err != nil {
+			// The lease is renewed again on the next tick, before it expires.
+			logger.Error(err, "failed to renew the recipe lease", "leaseID", h.id)
+			continue
+		}
+
+		if cause != nil {
+			h.cancel(cause)
+			return
+		}
+	}
+}
+
+// renewOnce extends the lease. It returns the cause to cancel the holder with when the holder was superseded or the
+// lease was lost.
+func (m *manager) renewOnce(ctx context.Context, client database.Client, id string, holder string) (cause error, err error) {
+	for range maxConcurrencyRetries {
+		var obj *database.Object
+		obj, err = client.Get(ctx, id)
+		if errors.Is(err, &database.ErrNotFound{}) {
+			return ErrLost, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		lease := &Lease{}
+		if err := obj.As(lease); err != nil {
+			return nil, err
+		}
+
+		if lease.Holder != holder {
+			return ErrLost, nil
+		}
+
+		if lease.SupersededBy != "" {
+			return ErrSuperseded, nil
+		}
+
+		lease.ExpiresAt = time.Now().UTC().Add(m.options.Duration)
+		obj.Data = lease
+		err = client.Save(ctx, obj, database.WithETag(obj.ETag))
+		if errors.Is(err, &database.ErrConcurrency{}) {
+			continue
+		}
+		return nil, err
+	}
+
+	return nil, errors.New("the lease was modified concurrently")
+}
+
+// release frees the lease if it is still held by the operation. The lease is deleted when no operation is waiting.
+func (m *manager) release(ctx context.Context, client database.Client, id string, holder string) error {
+	// The lease must be released even if the operation context was canceled.
+	ctx = context.WithoutCancel(ctx)
+
+	for range maxConcurrencyRetries {
+		obj, err := client.Get(ctx, id)
+		if errors.Is(err, &database.ErrNotFound{}) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		lease := &Lease{}
+		if err := obj.As(lease); err != nil {
+			return err
+		}
+
+		if lease.Holder != holder {
+			return nil
+		}
+
+		if lease.pending(time.Now().UTC()) == nil {
+			err = client.Delete(ctx, id, database.WithETag(obj.ETag))
+		} else {
+			lease.Holder = ""
+			lease.Operation = ""
+			lease.DesiredState = ""
+			lease.SupersededBy = ""
+			lease.AcquiredAt = time.Time{}
+			lease.ExpiresAt = time.Time{}
+			obj.Data = lease
+			err = client.Save(ctx, obj, database.WithETag(obj.ETag))
+		}
+
+		if errors.Is(err, &database.ErrConcurrency{}) {
+			continue
+		}
+		return err
+	}
+
+	return fmt.Errorf("failed to release the recipe lease %q: the lease was modified concurrently", id)
+}
+
+// leaseID returns the database ID of the lease of the resource.
+func leaseID(resourceID string) string {
+	return strings.TrimSuffix(resourceID, "/") + "/" + leaseResourceType + "/" + leaseName
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lease
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+)
+
+const testResourceID = "/planes/radius/local/resourceGroups/test-rg/providers/Applications.Datastores/redisCaches/redis"
+
+func newTestManager(t *testing.T) (*manager, database.Client) {
+	client := inmemory.NewClient()
+	m := NewManager(databaseprovider.FromClient(client), Options{
+		Duration:      time.Second,
+		RenewInterval: 10 * time.Millisecond,
+		PollInterval:  10 * time.Millisecond,
+	})
+	return m.(*manager), client
+}
+
+func Test_Acquire_Release(t *testing.T) {
+	m, client := newTestManager(t)
+
+	h, err := m.Acquire(t.Context(), AcquireOptions{ResourceID: testResourceID, Operation: "execute", DesiredState: "a"})
+	require.NoError(t, err)
+
+	obj, err := client.Get(t.Context(), leaseID(testResourceID))
+	require.NoError(t, err)
+	lease := &Lease{}
+	require.NoError(t, obj.As(lease))
+	require.Equal(t, testResourceID, lease.ResourceID)
+	require.Equal(t, h.holder, lease.Holder)
+	require.Equal(t, "execute", lease.Operation)
+
+	require.NoError(t, h.Release(t.Context()))
+	require.ErrorIs(t, h.Context().Err(), context.Canceled)
+
+	// The lease is deleted when no operation is waiting for it.
+	_, err = client.Get(t.Context(), leaseID(testResourceID))
+	require.ErrorIs(t, err, &database.ErrNotFound{})
+}
+
+func Test_Acquire_WaitsForHolder(t *testing.T) {
+	m, _ := newTestManager(t)
+
+	first, err := m.Acquire(t.Context(), AcquireOptions{ResourceID: testResourceID, Operation: "execute", DesiredState: "a"})
+	require.NoError(t, err)
+
+	reasons := make(chan string, 10)
+	acquired := make(chan *Handle, 1)
+	go func() {
+		h, err := m.Acquire(t.Context(), AcquireOptions{
+			ResourceID:   testResourceID,
+			Operation:    "execute",
+			DesiredState: "a",
+			OnWait: func(ctx context.Context, reason string) {
+				reasons <- reason
+			},
+		})
+		require.NoError(t, err)
+		acquired <- h
+	}()
+
+	reason := <-reasons
+	require.Equal(t, `Waiting for the recipe execute operation in progress on resource "`+testResourceID+`" to complete.`, reason)
+
+	select {
+	case <-acquired:
+		require.Fail(t, "the lease was acquired while it was held")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// The holder is not superseded because the desired state did not change.
+	require.NoError(t, first.Context().Err())
+	require.NoError(t, first.Release(t.Context()))
+
+	second := <-acquired
+	require.NoError(t, second.Release(t.Context()))
+}
+
+func Test_Acquire_SupersedesHolder(t *testing.T) {
+	m, _ := newTestManager(t)
+
+	first, err := m.Acquire(t.Context(), AcquireOptions{ResourceID: testResourceID, Operation: "execute", DesiredState: "a"})
+	require.NoError(t, err)
+
+	reasons := make(chan string, 10)
+	acquired := make(chan *Handle, 1)
+	go func() {
+		h, err := m.Acquire(t.Context(), AcquireOptions{
+			ResourceID:   testResourceID,
+			Operation:    "delete",
+			DesiredState: "deleted",
+			OnWait: func(ctx context.Context, reason string) {
+				reasons <- reason
+			},
+		})
+		require.NoError(t, err)
+		acquired <- h
+	}()
+
+	reason := <-reasons
+	require.Contains(t, reason, "to stop because the desired state of the resource has changed")
+
+	// The holder is canceled when it renews the lease.
+	<-first.Context().Done()
+	require.ErrorIs(t, context.Cause(first.Context()), ErrSuperseded)
+	require.NoError(t, first.Release(t.Context()))
+
+	second := <-acquired
+	require.NoError(t, second.Release(t.Context()))
+}
+
+func Test_Acquire_CoalescesWaiters(t *testing.T) {
+	m, _ := newTestManager(t)
+
+	first, err := m.Acquire(t.Context(), AcquireOptions{ResourceID: testResourceID, Operation: "execute", DesiredState: "a"})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	waiting := make(chan struct{}, 2)
+	start := func(result chan<- error) {
+		wg.Go(func() {
+			h, err := m.Acquire(t.Context(), AcquireOptions{
+				ResourceID:   testResourceID,
+				Operation:    "execute",
+				DesiredState: "a",
+				OnWait: func(ctx context.Context, reason string) {
+					waiting <- struct{}{}
+				},
+			})
+			if err == nil {
+				err = h.Release(t.Context())
+			}
+			result <- err
+		})
+	}
+
+	earlier := make(chan error, 1)
+	start(earlier)
+	<-waiting
+
+	later := make(chan error, 1)
+	start(later)
+	<-waiting
+
+	// The earlier waiting operation is coalesced into the later one.
+	require.ErrorIs(t, <-earlier, ErrSuperseded)
+
+	require.NoError(t, first.Release(t.Context()))
+	require.NoError(t, <-later)
+	wg.Wait()
+}
+
+func Test_Acquire_ExpiredLease(t *testing.T) {
+	m, client := newTestManager(t)
+
+	// A lease left behind by an operation that stopped without releasing it.
+	err := client.Save(t.Context(), &database.Object{
+		Metadata: database.Metadata{ID: leaseID(testResourceID)},
+		Data: &Lease{
+			ResourceID: testResourceID,
+			Holder:     "crashed",
+			Operation:  "execute",
+			ExpiresAt:  time.Now().UTC().Add(-time.Minute),
+		},
+	})
+	require.NoError(t, err)
+
+	h, err := m.Acquire(t.Context(), AcquireOptions{ResourceID: testResourceID, Operation: "execute"})
+	require.NoError(t, err)
+	require.NoError(t, h.Release(t.Context()))
+}
+
+func Test_Acquire_ContextCanceled(t *testing.T) {
+	m, _ := newTestManager(t)
+
+	first, err := m.Acquire(t.Context(), AcquireOptions{ResourceID: testResourceID, Operation: "execute", DesiredState: "a"})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, first.Release(t.Context())) }()
+
+	ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = m.Acquire(ctx, AcquireOptions{ResourceID: testResourceID, Operation: "execute", DesiredState: "a"})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func Test_Renew_LostLease(t *testing.T) {
+	m, client := newTestManager(t)
+
+	h, err := m.Acquire(t.Context(), AcquireOptions{ResourceID: testResourceID, Operation: "execute"})
+	require.NoError(t, err)
+
+	require.NoError(t, client.Delete(t.Context(), leaseID(testResourceID)))
+
+	<-h.Context().Done()
+	require.ErrorIs(t, context.Cause(h.Context()), ErrLost)
+	require.NoError(t, h.Release(t.Context()))
+}
+
+// staleGetClient returns ErrNotFound from the first Get, simulating another operation creating the lease between the
+// Get and the Save of the operation acquiring it.
+type staleGetClient struct {
+	database.Client
+	once sync.Once
+}
+
+func (c *staleGetClient) Get(ctx context.Context, id string, options ...database.GetOptions) (*database.Object, error) {
+	stale := false
+	c.once.Do(func() { stale = true })
+	if stale {
+		return nil, &database.ErrNotFound{ID: id}
+	}
+	return c.Client.Get(ctx, id, options...)
+}
+
+func Test_Acquire_CreateRace(t *testing.T) {
+	m, client := newTestManager(t)
+
+	// The lease is created by another operation after this operation found that it did not exist.
+	err := client.Save(t.Context(), &database.Object{
+		Metadata: database.Metadata{ID: leaseID(testResourceID)},
+		Data: &Lease{
+			ResourceID:   testResourceID,
+			Holder:       "other",
+			Operation:    "execute",
+			DesiredState: "a",
+			ExpiresAt:    time.Now().UTC().Add(time.Minute),
+		},
+	})
+	require.NoError(t, err)
+
+	id := leaseID(testResourceID)
+	opts := AcquireOptions{ResourceID: testResourceID, Operation: "execute", DesiredState: "a"}
+	acquired, reason, err := m.tryAcquire(t.Context(), &staleGetClient{Client: client}, id, "holder", time.Now().UTC(), opts)
+	require.NoError(t, err)
+	require.False(t, acquired)
+	require.Equal(t, `Waiting for the recipe execute operation in progress on resource "`+testResourceID+`" to complete.`, reason)
+
+	obj, err := client.Get(t.Context(), id)
+	require.NoError(t, err)
+	lease := &Lease{}
+	require.NoError(t, obj.As(lease))
+	require.Equal(t, "other", lease.Holder)
+}
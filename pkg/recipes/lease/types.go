@@ -0,0 +1,135 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lease
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	// DefaultDuration is the default duration of a lease. A lease that is not renewed within this duration expires
+	// and can be acquired by another recipe operation.
+	DefaultDuration = 2 * time.Minute
+
+	// DefaultRenewInterval is the default interval at which the holder of a lease renews it.
+	DefaultRenewInterval = 30 * time.Second
+
+	// DefaultPollInterval is the default interval at which a waiting recipe operation checks the lease.
+	DefaultPollInterval = 5 * time.Second
+
+	// leaseResourceType is the child resource type used to store the lease of a resource in the database.
+	leaseResourceType = "recipeLeases"
+
+	// leaseName is the name of the lease of a resource.
+	leaseName = "default"
+)
+
+var (
+	// ErrSuperseded is the cause of the cancellation of a recipe operation that was superseded by a later operation
+	// on the same resource.
+	ErrSuperseded = errors.New("the recipe operation was superseded by a later operation on the same resource")
+
+	// ErrLost is the cause of the cancellation of a recipe operation whose lease was acquired by another operation,
+	// for example because it could not be renewed before it expired.
+	ErrLost = errors.New("the lease of the recipe operation was lost")
+)
+
+// Options represents the options of the lease manager.
+type Options struct {
+	// Duration is the duration of a lease. Defaults to DefaultDuration.
+	Duration time.Duration
+
+	// RenewInterval is the interval at which the holder renews the lease. Defaults to DefaultRenewInterval.
+	RenewInterval time.Duration
+
+	// PollInterval is the interval at which a waiting operation checks the lease. Defaults to DefaultPollInterval.
+	PollInterval time.Duration
+}
+
+// AcquireOptions represents the options of a lease acquisition.
+type AcquireOptions struct {
+	// ResourceID is the ID of the resource the recipe operation is performed on.
+	ResourceID string
+
+	// Operation is the name of the recipe operation, for example "execute" or "delete".
+	Operation string
+
+	// DesiredState identifies the desired state of the resource the operation is applying. A waiting operation
+	// with a different desired state supersedes the operation holding the lease.
+	DesiredState string
+
+	// OnWait is called with the reason the operation is waiting each time the reason changes.
+	OnWait func(ctx context.Context, reason string)
+}
+
+// Lease is the lease of a resource stored in the database.
+type Lease struct {
+	// ResourceID is the ID of the resource the lease is for.
+	ResourceID string `json:"resourceID"`
+
+	// Holder is the ID of the recipe operation holding the lease. The lease is free when it is empty.
+	Holder string `json:"holder,omitempty"`
+
+	// Operation is the name of the recipe operation holding the lease.
+	Operation string `json:"operation,omitempty"`
+
+	// DesiredState identifies the desired state applied by the recipe operation holding the lease.
+	DesiredState string `json:"desiredState,omitempty"`
+
+	// AcquiredAt is the time the lease was acquired.
+	AcquiredAt time.Time `json:"acquiredAt,omitzero"`
+
+	// ExpiresAt is the time the lease expires unless it is renewed.
+	ExpiresAt time.Time `json:"expiresAt,omitzero"`
+
+	// SupersededBy is the ID of the waiting recipe operation that superseded the operation holding the lease.
+	SupersededBy string `json:"supersededBy,omitempty"`
+
+	// Pending is the most recent recipe operation waiting for the lease. Earlier waiting operations are coalesced
+	// into it.
+	Pending *Waiter `json:"pending,omitempty"`
+}
+
+// Waiter is a recipe operation waiting for a lease.
+type Waiter struct {
+	// Holder is the ID of the waiting recipe operation.
+	Holder string `json:"holder"`
+
+	// Operation is the name of the waiting recipe operation.
+	Operation string `json:"operation,omitempty"`
+
+	// QueuedAt is the time the operation started waiting.
+	QueuedAt time.Time `json:"queuedAt"`
+
+	// ExpiresAt is the time the waiter expires unless the waiting operation checks the lease again.
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// held reports whether the lease is held by an operation at the given time.
+func (l *Lease) held(now time.Time) bool {
+	return l.Holder != "" && now.Before(l.ExpiresAt)
+}
+
+// pending returns the waiting operation if it has not expired at the given time.
+func (l *Lease) pending(now time.Time) *Waiter {
+	if l.Pending == nil || !now.Before(l.Pending.ExpiresAt) {
+		return nil
+	}
+	return l.Pending
+}
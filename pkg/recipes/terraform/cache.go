@@ -0,0 +1,230 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-exec/tfexec"
+
+	"github.com/radius-project/radius/pkg/recipes/cache"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// modulesDir is the directory, relative to the working directory, where Terraform installs modules.
+	modulesDir = ".terraform/modules"
+
+	// moduleConfigFileName is the name of the configuration file written to prefetch a module.
+	moduleConfigFileName = "main.tf.json"
+)
+
+// PrefetchOptions represents the options to prefetch a Terraform module and its providers into the recipe cache.
+type PrefetchOptions struct {
+	// ExecPath is the path to the Terraform binary.
+	ExecPath string
+
+	// Name is the name of the module in the generated Terraform configuration. Recipe packs use "default".
+	Name string
+
+	// Source is the source of the module.
+	Source string
+
+	// Version is the version of the module.
+	Version string
+
+	// Platforms are the platforms, such as "linux_amd64", for which the providers required by the module are
+	// mirrored. Providers are not mirrored when it is empty.
+	Platforms []string
+}
+
+// Prefetch downloads a Terraform module into the recipe cache, and mirrors the providers it requires into the
+// Terraform provider mirror of the cache. It is used to warm the cache for environments without network access.
+func Prefetch(ctx context.Context, c *cache.Cache, options PrefetchOptions) error {
+	if !moduleCacheable(options.Source, options.Version) {
+		return fmt.Errorf("the Terraform module %q must be pinned to a version to be cached", options.Source)
+	}
+
+	workingDir, err := os.MkdirTemp("", "recipe-prefetch-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(workingDir) }()
+
+	module := map[string]any{"source": options.Source}
+	if options.Version != "" {
+		module["version"] = options.Version
+	}
+
+	b, err := json.Marshal(map[string]any{"module": map[string]any{options.Name: module}})
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filepath.Join(workingDir, moduleConfigFileName), b, 0600); err != nil {
+		return err
+	}
+
+	tf, err := tfexec.NewTerraform(workingDir, options.ExecPath)
+	if err != nil {
+		return err
+	}
+
+	if err := tf.Get(ctx); err != nil {
+		return fmt.Errorf("failed to download Terraform module from source %q, version %q: %w", options.Source, options.Version, err)
+	}
+
+	if err := c.PutDir(moduleCacheKey(options.Name, options.Source, options.Version), filepath.Join(workingDir, modulesDir)); err != nil {
+		return err
+	}
+
+	if len(options.Platforms) == 0 {
+		return nil
+	}
+
+	// terraform-exec does not support "terraform providers mirror", so the command is run directly.
+	args := []string{"providers", "mirror"}
+	for _, platform := range options.Platforms {
+		args = append(args, "-platform="+platform)
+	}
+	args = append(args, c.TerraformProvidersDir())
+
+	cmd := exec.CommandContext(ctx, options.ExecPath, args...)
+	cmd.Dir = workingDir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to mirror the providers of Terraform module %q: %w: %s", options.Source, err, strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
+
+// restoreModules extracts the cached modules of the recipe into the working directory. It returns true when the
+// modules were restored, in which case terraform get does not download them again.
+func restoreModules(ctx context.Context, workingDir string, options Options) bool {
+	if options.Cache == nil || options.EnvRecipe == nil || !moduleCacheable(options.EnvRecipe.TemplatePath, options.EnvRecipe.TemplateVersion) {
+		return false
+	}
+
+	logger := ucplog.FromContextOrDiscard(ctx)
+	dst := filepath.Join(workingDir, modulesDir)
+	err := options.Cache.GetDir(moduleCacheKey(options.EnvRecipe.Name, options.EnvRecipe.TemplatePath, options.EnvRecipe.TemplateVersion), dst)
+	if errors.Is(err, cache.ErrNotFound) {
+		return false
+	} else if err != nil {
+		logger.Error(err, "failed to restore the Terraform module from the cache", "source", options.EnvRecipe.TemplatePath)
+		_ = os.RemoveAll(dst)
+		return false
+	}
+
+	logger.Info(fmt.Sprintf("Using cached Terraform module: %s", options.EnvRecipe.TemplatePath))
+	return true
+}
+
+// saveModules adds the modules downloaded in the working directory to the cache. Failing to cache the modules does
+// not fail the recipe operation.
+func saveModules(ctx context.Context, workingDir string, options Options) {
+	if options.Cache == nil || options.EnvRecipe == nil || !moduleCacheable(options.EnvRecipe.TemplatePath, options.EnvRecipe.TemplateVersion) {
+		return
+	}
+
+	err := options.Cache.PutDir(moduleCacheKey(options.EnvRecipe.Name, options.EnvRecipe.TemplatePath, options.EnvRecipe.TemplateVersion), filepath.Join(workingDir, modulesDir))
+	if err != nil {
+		ucplog.FromContextOrDiscard(ctx).Error(err, "failed to add the Terraform module to the cache", "source", options.EnvRecipe.TemplatePath)
+	}
+}
+
+// providerMirror returns the filesystem mirror of the providers cached in the recipe cache, or nil when no provider
+// is cached.
+func providerMirror(c *cache.Cache) (*filesystemMirror, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	providers, err := mirroredProviders(c.TerraformProvidersDir())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the Terraform providers in the recipe cache: %w", err)
+	}
+
+	if len(providers) == 0 {
+		return nil, nil
+	}
+
+	return &filesystemMirror{Path: c.TerraformProvidersDir(), Providers: providers}, nil
+}
+
+// mirroredProviders returns the addresses of the providers in a Terraform provider filesystem mirror, which stores
+// each provider in a "<hostname>/<namespace>/<type>" directory.
+func mirroredProviders(dir string) ([]string, error) {
+	providers := []string{}
+	hosts, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return providers, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	for _, host := range hosts {
+		if !host.IsDir() {
+			continue
+		}
+
+		namespaces, err := os.ReadDir(filepath.Join(dir, host.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, namespace := range namespaces {
+			if !namespace.IsDir() {
+				continue
+			}
+
+			types, err := os.ReadDir(filepath.Join(dir, host.Name(), namespace.Name()))
+			if err != nil {
+				return nil, err
+			}
+
+			for _, t := range types {
+				if t.IsDir() {
+					providers = append(providers, host.Name()+"/"+namespace.Name()+"/"+t.Name())
+				}
+			}
+		}
+	}
+
+	sort.Strings(providers)
+	return providers, nil
+}
+
+// moduleCacheable reports whether a module source is pinned to a version, and can be cached.
+func moduleCacheable(source string, version string) bool {
+	return version != "" || strings.Contains(source, "ref=")
+}
+
+// moduleCacheKey returns the cache key of the modules installed for a module. The name is part of the key because
+// Terraform installs modules in a directory named after the module.
+func moduleCacheKey(name string, source string, version string) string {
+	return "terraform-module/" + name + "/" + cache.Key(source, version)
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraform
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/cache"
+)
+
+func Test_RestoreSaveModules(t *testing.T) {
+	c, err := cache.New(cache.Options{Dir: t.TempDir()})
+	require.NoError(t, err)
+
+	options := Options{
+		Cache: c,
+		EnvRecipe: &recipes.EnvironmentDefinition{
+			Name:            "default",
+			TemplatePath:    "Azure/cosmosdb/azurerm",
+			TemplateVersion: "1.0.0",
+		},
+	}
+
+	workingDir := t.TempDir()
+	require.False(t, restoreModules(t.Context(), workingDir, options))
+
+	moduleDir := filepath.Join(workingDir, modulesDir, "default")
+	require.NoError(t, os.MkdirAll(moduleDir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(moduleDir, "main.tf"), []byte(`variable "context" {}`), 0644))
+	saveModules(t.Context(), workingDir, options)
+
+	restoredDir := t.TempDir()
+	require.True(t, restoreModules(t.Context(), restoredDir, options))
+	require.FileExists(t, filepath.Join(restoredDir, modulesDir, "default", "main.tf"))
+
+	// Modules that are not pinned to a version are not cached.
+	options.EnvRecipe.TemplateVersion = ""
+	require.False(t, restoreModules(t.Context(), t.TempDir(), options))
+}
+
+func Test_ProviderMirror(t *testing.T) {
+	c, err := cache.New(cache.Options{Dir: t.TempDir()})
+	require.NoError(t, err)
+
+	mirror, err := providerMirror(c)
+	require.NoError(t, err)
+	require.Nil(t, mirror)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(c.TerraformProvidersDir(), "registry.terraform.io", "hashicorp", "kubernetes"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(c.TerraformProvidersDir(), "registry.terraform.io", "hashicorp", "aws"), 0755))
+
+	mirror, err = providerMirror(c)
+	require.NoError(t, err)
+	require.Equal(t, c.TerraformProvidersDir(), mirror.Path)
+	require.Equal(t, []string{"registry.terraform.io/hashicorp/aws", "registry.terraform.io/hashicorp/kubernetes"}, mirror.Providers)
+
+	mirror, err = providerMirror(nil)
+	require.NoError(t, err)
+	require.Nil(t, mirror)
+}
+
+func Test_ModuleCacheable(t *testing.T) {
+	require.True(t, moduleCacheable("Azure/cosmosdb/azurerm", "1.0.0"))
+	require.True(t, moduleCacheable("git::https://github.com/org/modules.git//redis?ref=v1.0.0", ""))
+	require.False(t, moduleCacheable("https://example.com/modules/redis.zip", ""))
+}
@@ -49,10 +49,22 @@ const (
 	TerraformCredentialsTokenKey = "token"
 )
 
+// filesystemMirror is a Terraform provider filesystem mirror, such as the provider mirror of the recipe cache.
+type filesystemMirror struct {
+	// Path is the directory of the mirror.
+	Path string
+
+	// Providers are the addresses of the providers in the mirror, such as "registry.terraform.io/hashicorp/aws".
+	Providers []string
+}
+
 // writeTerraformCLIConfig renders a .terraformrc file in workingDir from the given
 // provider_installation and credentials configuration and returns the absolute path
 // to it.
 //
+// mirror is the optional filesystem mirror of cached providers. The providers it
+// contains are installed from it and excluded from the other installation methods.
+//
 // secrets is the map of fetched secret data keyed by secret store ID, as supplied
 // in Options.Secrets. Credential entries that reference secret stores not present
 // in this map (or missing the `token` key) cause the call to fail; this prevents
@@ -64,13 +76,15 @@ func writeTerraformCLIConfig(
 	pi *datamodel.TerraformProviderInstallation,
 	credentials map[string]datamodel.TerraformCredentialConfig,
 	secrets map[string]recipes.SecretData,
+	mirror *filesystemMirror,
 ) (string, error) {
 	piHasContent := pi != nil && (pi.NetworkMirror != nil || pi.Direct != nil)
-	if !piHasContent && len(credentials) == 0 {
+	mirrorHasContent := mirror != nil && len(mirror.Providers) > 0
+	if !piHasContent && !mirrorHasContent && len(credentials) == 0 {
 		return "", nil
 	}
 
-	body, err := renderTerraformrcHCL(pi, credentials, secrets)
+	body, err := renderTerraformrcHCL(pi, credentials, secrets, mirror)
 	if err != nil {
 		return "", err
 	}
@@ -92,10 +106,13 @@ func renderTerraformrcHCL(
 	pi *datamodel.TerraformProviderInstallation,
 	credentials map[string]datamodel.TerraformCredentialConfig,
 	secrets map[string]recipes.SecretData,
+	mirror *filesystemMirror,
 ) (string, error) {
 	var b strings.Builder
 
-	if pi != nil {
+	if mirror != nil && len(mirror.Providers) > 0 {
+		b.WriteString(renderProviderInstallationWithMirrorHCL(pi, mirror))
+	} else if pi != nil {
 		piBody := renderProviderInstallationHCL(pi)
 		b.WriteString(piBody)
 	}
@@ -138,31 +155,63 @@ func renderProviderInstallationHCL(pi *datamodel.TerraformProviderInstallation)
 
 	var b strings.Builder
 	b.WriteString("provider_installation {\n")
+	wrote := writeProviderInstallationMethods(&b, pi)
+	b.WriteString("}\n")
+
+	if !wrote {
+		return ""
+	}
+	return b.String()
+}
+
+// renderProviderInstallationWithMirrorHCL formats a Terraform CLI provider_installation block that installs the
+// providers of the filesystem mirror from it, and all providers as configured by pi. When pi is nil, providers are
+// installed directly from their registries, which is the default behavior of Terraform.
+//
+// The mirrored providers are not excluded from the other methods: Terraform selects the newest version available from
+// all the methods matching a provider and installs it from the first method that has it, so the filesystem mirror is
+// used when it has the selected version, and the versions and platforms it doesn't have are still installed from the
+// other methods. In clusters that can't reach the registries of the mirrored providers, exclude them from the direct
+// method of the provider installation settings.
+func renderProviderInstallationWithMirrorHCL(pi *datamodel.TerraformProviderInstallation, mirror *filesystemMirror) string {
+	var b strings.Builder
+	b.WriteString("provider_installation {\n")
+
+	b.WriteString("  filesystem_mirror {\n")
+	b.WriteString(fmt.Sprintf("    path = %s\n", quote(mirror.Path)))
+	writePatternList(&b, "include", mirror.Providers)
+	b.WriteString("  }\n")
+
+	if pi == nil || !writeProviderInstallationMethods(&b, pi) {
+		b.WriteString("  direct {}\n")
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
 
+// writeProviderInstallationMethods writes the network_mirror and direct methods configured by pi. It returns false
+// when pi doesn't configure any method.
+func writeProviderInstallationMethods(b *strings.Builder, pi *datamodel.TerraformProviderInstallation) bool {
 	wrote := false
 	if pi.NetworkMirror != nil && pi.NetworkMirror.URL != "" {
 		b.WriteString("  network_mirror {\n")
 		b.WriteString(fmt.Sprintf("    url = %s\n", quote(pi.NetworkMirror.URL)))
-		writePatternList(&b, "include", pi.NetworkMirror.Include)
-		writePatternList(&b, "exclude", pi.NetworkMirror.Exclude)
+		writePatternList(b, "include", pi.NetworkMirror.Include)
+		writePatternList(b, "exclude", pi.NetworkMirror.Exclude)
 		b.WriteString("  }\n")
 		wrote = true
 	}
 
 	if pi.Direct != nil && (len(pi.Direct.Include) > 0 || len(pi.Direct.Exclude) > 0) {
 		b.WriteString("  direct {\n")
-		writePatternList(&b, "include", pi.Direct.Include)
-		writePatternList(&b, "exclude", pi.Direct.Exclude)
+		writePatternList(b, "include", pi.Direct.Include)
+		writePatternList(b, "exclude", pi.Direct.Exclude)
 		b.WriteString("  }\n")
 		wrote = true
 	}
 
-	b.WriteString("}\n")
-
-	if !wrote {
-		return ""
-	}
-	return b.String()
+	return wrote
 }
 
 func writePatternList(b *strings.Builder, label string, patterns []string) {
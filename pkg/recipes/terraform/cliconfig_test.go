@@ -121,7 +121,7 @@ func TestWriteTerraformCLIConfig_ProviderInstallation(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			workingDir := t.TempDir()
-			path, err := writeTerraformCLIConfig(workingDir, tc.input, nil, nil)
+			path, err := writeTerraformCLIConfig(workingDir, tc.input, nil, nil, nil)
 			require.NoError(t, err)
 
 			if !tc.wantPathSet {
@@ -155,7 +155,7 @@ func TestWriteTerraformCLIConfig_BadDir(t *testing.T) {
 	_, err := writeTerraformCLIConfig("/nonexistent/path/that/does/not/exist",
 		&datamodel.TerraformProviderInstallation{
 			NetworkMirror: &datamodel.TerraformProviderMirror{URL: "https://mirror/"},
-		}, nil, nil)
+		}, nil, nil, nil)
 	require.Error(t, err)
 }
 
@@ -240,7 +240,7 @@ func TestWriteTerraformCLIConfig_Credentials(t *testing.T) {
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			workingDir := t.TempDir()
-			path, err := writeTerraformCLIConfig(workingDir, nil, tc.creds, tc.secrets)
+			path, err := writeTerraformCLIConfig(workingDir, nil, tc.creds, tc.secrets, nil)
 			if tc.wantErr != "" {
 				require.Error(t, err)
 				require.Contains(t, err.Error(), tc.wantErr)
@@ -276,7 +276,7 @@ func TestWriteTerraformCLIConfig_BothBlocks(t *testing.T) {
 	}
 
 	workingDir := t.TempDir()
-	path, err := writeTerraformCLIConfig(workingDir, pi, creds, secrets)
+	path, err := writeTerraformCLIConfig(workingDir, pi, creds, secrets, nil)
 	require.NoError(t, err)
 	require.NotEmpty(t, path)
 
@@ -286,3 +286,92 @@ func TestWriteTerraformCLIConfig_BothBlocks(t *testing.T) {
 	require.Contains(t, s, `provider_installation {`)
 	require.Contains(t, s, `credentials "app.terraform.io" {`)
 }
+
+func TestWriteTerraformCLIConfig_FilesystemMirror(t *testing.T) {
+	mirror := &filesystemMirror{
+		Path:      "/cache/terraform/providers",
+		Providers: []string{"registry.terraform.io/hashicorp/aws"},
+	}
+
+	tests := []struct {
+		name     string
+		input    *datamodel.TerraformProviderInstallation
+		expected string
+	}{
+		{
+			name:  "providers are installed directly when they are not in the mirror",
+			input: nil,
+			expected: `provider_installation {
+  filesystem_mirror {
+    path = "/cache/terraform/providers"
+    include = ["registry.terraform.io/hashicorp/aws"]
+  }
+  direct {}
+}
+`,
+		},
+		{
+			name: "providers are installed directly when no method is configured",
+			input: &datamodel.TerraformProviderInstallation{
+				Direct: &datamodel.TerraformProviderDirect{},
+			},
+			expected: `provider_installation {
+  filesystem_mirror {
+    path = "/cache/terraform/providers"
+    include = ["registry.terraform.io/hashicorp/aws"]
+  }
+  direct {}
+}
+`,
+		},
+		{
+			name: "mirrored providers are installed with the configured methods when they are not in the mirror",
+			input: &datamodel.TerraformProviderInstallation{
+				NetworkMirror: &datamodel.TerraformProviderMirror{
+					URL:     "https://mirror/",
+					Exclude: []string{"hashicorp/azurerm"},
+				},
+			},
+			expected: `provider_installation {
+  filesystem_mirror {
+    path = "/cache/terraform/providers"
+    include = ["registry.terraform.io/hashicorp/aws"]
+  }
+  network_mirror {
+    url = "https://mirror/"
+    exclude = ["hashicorp/azurerm"]
+  }
+}
+`,
+		},
+		{
+			name: "mirrored providers excluded from the direct method are only installed from the mirror",
+			input: &datamodel.TerraformProviderInstallation{
+				Direct: &datamodel.TerraformProviderDirect{
+					Exclude: []string{"registry.terraform.io/hashicorp/aws"},
+				},
+			},
+			expected: `provider_installation {
+  filesystem_mirror {
+    path = "/cache/terraform/providers"
+    include = ["registry.terraform.io/hashicorp/aws"]
+  }
+  direct {
+    exclude = ["registry.terraform.io/hashicorp/aws"]
+  }
+}
+`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := writeTerraformCLIConfig(t.TempDir(), tc.input, nil, nil, mirror)
+			require.NoError(t, err)
+
+			b, err := os.ReadFile(path)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, string(b))
+		})
+	}
+}
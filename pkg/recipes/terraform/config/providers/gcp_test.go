@@ -0,0 +1,278 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package providers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/radius-project/radius/pkg/components/secret"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
+	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/sdk"
+	ucp_credentials "github.com/radius-project/radius/pkg/ucp/credentials"
+	ucp_datamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+	ucp_gcp "github.com/radius-project/radius/pkg/ucp/gcp"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testGCPServiceAccountKeyCredentials = ucp_credentials.GCPCredential{
+		Kind: ucp_datamodel.GCPServiceAccountKeyCredentialKind,
+		ServiceAccountKeyCredential: &ucp_datamodel.GCPServiceAccountKeyCredentialProperties{
+			ServiceAccountKey: `{"type":"service_account","client_email":"radius@test-project.iam.gserviceaccount.com"}`,
+		},
+	}
+	testGCPWorkloadIdentityCredentials = ucp_credentials.GCPCredential{
+		Kind: ucp_datamodel.GCPWorkloadIdentityCredentialKind,
+		WorkloadIdentityCredential: &ucp_datamodel.GCPWorkloadIdentityCredentialProperties{
+			Audience:            "//iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/radius/providers/k8s",
+			ServiceAccountEmail: "radius@test-project.iam.gserviceaccount.com",
+		},
+	}
+)
+
+type mockGCPCredentialsProvider struct {
+	testCredential *ucp_credentials.GCPCredential
+	err            error
+}
+
+// Fetch returns mock GCP credentials for testing.
+func (p *mockGCPCredentialsProvider) Fetch(ctx context.Context, planeName, name string) (*ucp_credentials.GCPCredential, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	if p.testCredential == nil {
+		return nil, &secret.ErrNotFound{}
+	}
+	return p.testCredential, nil
+}
+
+func TestGCPProvider_ParseScope(t *testing.T) {
+	tests := []struct {
+		desc            string
+		envConfig       *recipes.Configuration
+		expectedProject string
+		expectedRegion  string
+		expectedErrMsg  string
+	}{
+		{
+			desc: "valid config",
+			envConfig: &recipes.Configuration{
+				Providers: datamodel.Providers{
+					GCP: datamodel.ProvidersGCP{
+						Scope: "/planes/gcp/gcp/projects/test-project/locations/us-central1",
+					},
+				},
+			},
+			expectedProject: "test-project",
+			expectedRegion:  "us-central1",
+		},
+		{
+			desc: "project only",
+			envConfig: &recipes.Configuration{
+				Providers: datamodel.Providers{
+					GCP: datamodel.ProvidersGCP{
+						Scope: "/planes/gcp/gcp/projects/test-project",
+					},
+				},
+			},
+			expectedProject: "test-project",
+		},
+		{
+			desc:      "nil config",
+			envConfig: nil,
+		},
+		{
+			desc:      "empty config",
+			envConfig: &recipes.Configuration{},
+		},
+		{
+			desc: "invalid scope",
+			envConfig: &recipes.Configuration{
+				Providers: datamodel.Providers{
+					GCP: datamodel.ProvidersGCP{
+						Scope: "invalid",
+					},
+				},
+			},
+			expectedErrMsg: "invalid GCP provider scope \"invalid\" is configured on the Environment, error parsing: 'invalid' is not a valid resource id",
+		},
+		{
+			desc: "missing project",
+			envConfig: &recipes.Configuration{
+				Providers: datamodel.Providers{
+					GCP: datamodel.ProvidersGCP{
+						Scope: "/planes/gcp/gcp/locations/us-central1",
+					},
+				},
+			},
+			expectedErrMsg: "invalid GCP provider scope \"/planes/gcp/gcp/locations/us-central1\" is configured on the Environment, project is required in the scope",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			p := &gcpProvider{}
+			project, region, err := p.parseScope(t.Context(), tt.envConfig)
+			if tt.expectedErrMsg != "" {
+				require.EqualError(t, err, tt.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedProject, project)
+			require.Equal(t, tt.expectedRegion, region)
+		})
+	}
+}
+
+func TestGCPProvider_getCredentialsProvider(t *testing.T) {
+	connection, err := sdk.NewDirectConnection("http://example.com")
+	require.NoError(t, err)
+
+	p := &gcpProvider{
+		ucpConn: connection,
+	}
+	gcpCredentialProvider, err := p.getCredentialsProvider()
+	require.NoError(t, err)
+	require.NotNil(t, gcpCredentialProvider)
+}
+
+func TestGCPProvider_FetchCredentials(t *testing.T) {
+	tests := []struct {
+		desc                string
+		credentialsProvider *mockGCPCredentialsProvider
+		expectedCreds       *ucp_credentials.GCPCredential
+		expectedErr         bool
+	}{
+		{
+			desc:                "valid service account key credentials",
+			credentialsProvider: &mockGCPCredentialsProvider{testCredential: &testGCPServiceAccountKeyCredentials},
+			expectedCreds:       &testGCPServiceAccountKeyCredentials,
+		},
+		{
+			desc:                "valid workload identity credentials",
+			credentialsProvider: &mockGCPCredentialsProvider{testCredential: &testGCPWorkloadIdentityCredentials},
+			expectedCreds:       &testGCPWorkloadIdentityCredentials,
+		},
+		{
+			desc:                "credentials not found - no error",
+			credentialsProvider: &mockGCPCredentialsProvider{},
+		},
+		{
+			desc: "empty service account key - no error",
+			credentialsProvider: &mockGCPCredentialsProvider{
+				testCredential: &ucp_credentials.GCPCredential{
+					Kind:                        ucp_datamodel.GCPServiceAccountKeyCredentialKind,
+					ServiceAccountKeyCredential: &ucp_datamodel.GCPServiceAccountKeyCredentialProperties{},
+				},
+			},
+		},
+		{
+			desc: "empty workload identity - no error",
+			credentialsProvider: &mockGCPCredentialsProvider{
+				testCredential: &ucp_credentials.GCPCredential{
+					Kind:                       ucp_datamodel.GCPWorkloadIdentityCredentialKind,
+					WorkloadIdentityCredential: &ucp_datamodel.GCPWorkloadIdentityCredentialProperties{},
+				},
+			},
+		},
+		{
+			desc:                "fetch credential error",
+			credentialsProvider: &mockGCPCredentialsProvider{err: errors.New("failed to fetch credential")},
+			expectedErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			c, err := fetchGCPCredentials(t.Context(), tt.credentialsProvider)
+			if tt.expectedErr {
+				require.Error(t, err)
+				require.Nil(t, c)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedCreds, c)
+		})
+	}
+}
+
+func TestGCPProvider_generateProviderConfigMap(t *testing.T) {
+	externalAccount, err := json.Marshal(ucp_gcp.ExternalAccountConfig(testGCPWorkloadIdentityCredentials.WorkloadIdentityCredential))
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc           string
+		project        string
+		region         string
+		credentials    *ucp_credentials.GCPCredential
+		expectedConfig map[string]any
+	}{
+		{
+			desc:        "valid service account key credential config",
+			project:     "test-project",
+			region:      testRegion,
+			credentials: &testGCPServiceAccountKeyCredentials,
+			expectedConfig: map[string]any{
+				googleProjectParam:     "test-project",
+				googleRegionParam:      testRegion,
+				googleCredentialsParam: testGCPServiceAccountKeyCredentials.ServiceAccountKeyCredential.ServiceAccountKey,
+			},
+		},
+		{
+			desc:        "valid workload identity credential config",
+			project:     "test-project",
+			credentials: &testGCPWorkloadIdentityCredentials,
+			expectedConfig: map[string]any{
+				googleProjectParam:     "test-project",
+				googleCredentialsParam: string(externalAccount),
+			},
+		},
+		{
+			desc:    "missing credentials",
+			project: "test-project",
+			region:  testRegion,
+			expectedConfig: map[string]any{
+				googleProjectParam: "test-project",
+				googleRegionParam:  testRegion,
+			},
+		},
+		{
+			desc: "invalid workload identity credentials",
+			credentials: &ucp_credentials.GCPCredential{
+				Kind: ucp_datamodel.GCPWorkloadIdentityCredentialKind,
+				WorkloadIdentityCredential: &ucp_datamodel.GCPWorkloadIdentityCredentialProperties{
+					Audience: testGCPWorkloadIdentityCredentials.WorkloadIdentityCredential.Audience,
+				},
+			},
+			expectedConfig: map[string]any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			config, err := generateGCPProviderConfigMap(tt.credentials, tt.project, tt.region)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedConfig, config)
+		})
+	}
+}
@@ -45,6 +45,7 @@ func GetUCPConfiguredTerraformProviders(ucpConn sdk.Connection, secretProvider *
 	return map[string]Provider{
 		AWSProviderName:        NewAWSProvider(ucpConn, secretProvider),
 		AzureProviderName:      NewAzureProvider(ucpConn, secretProvider),
+		GoogleProviderName:     NewGCPProvider(ucpConn, secretProvider),
 		KubernetesProviderName: newKubernetesProvider(clusteraccess.NewResolver()),
 	}
 }
@@ -23,6 +23,7 @@ import (
 	"io"
 	"maps"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -32,6 +33,7 @@ import (
 	"github.com/radius-project/radius/pkg/components/kubernetesclient/kubernetesclientprovider"
 	"github.com/radius-project/radius/pkg/components/metrics"
 	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
+	"github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes/paramresolver"
 	"github.com/radius-project/radius/pkg/recipes/recipecontext"
 	"github.com/radius-project/radius/pkg/recipes/terraform/config"
@@ -44,6 +46,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	// driftPlanFileName is the name of the plan file written by the refresh-only plan used for drift detection.
+	driftPlanFileName = "drift.tfplan"
+)
+
 var (
 	// ErrRecipeNameEmpty is the error when the recipe name is empty.
 	ErrRecipeNameEmpty = errors.New("recipe name cannot be empty")
@@ -182,6 +189,48 @@ func (e *executor) Delete(ctx context.Context, options Options) error {
 	return nil
 }
 
+// DetectDrift ensures Terraform is available, creates a working directory, generates a config, and runs Terraform init
+// and a refresh-only plan in the working directory against the existing Terraform state. The returned plan lists the
+// deployed resources that were changed outside of Terraform in its ResourceDrift field.
+func (e *executor) DetectDrift(ctx context.Context, options Options) (*tfjson.Plan, error) {
+	// Install Terraform
+	i := install.NewInstaller()
+	tf, err := Install(ctx, i, InstallOptions{RootDir: options.RootDir, LogLevel: options.LogLevel})
+	if err != nil {
+		return nil, err
+	}
+
+	// Set environment variables before generateConfig, which downloads the module. See Deploy for details.
+	if options.EnvConfig != nil {
+		if err = e.setEnvironmentVariables(tf, options); err != nil {
+			return nil, err
+		}
+	}
+
+	// Create Terraform config in the working directory
+	kubernetesBackendSuffix, err := e.generateConfig(ctx, tf, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Drift can only be detected against an existing Terraform state file.
+	kubernetesClient, err := e.kubernetesClients.ClientGoClient()
+	if err != nil {
+		return nil, fmt.Errorf("error getting kubernetes client: %w", err)
+	}
+
+	backendExists, err := backends.NewKubernetesBackend(kubernetesClient).ValidateBackendExists(ctx, backends.KubernetesBackendNamePrefix+kubernetesBackendSuffix)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving kubernetes secret for terraform state: %w", err)
+	} else if !backendExists {
+		return nil, errors.New("expected kubernetes secret for terraform state is not found")
+	}
+
+	// Run TF Init and a refresh-only Plan in the working directory
+	stateLockTimeout := getStateLockTimeout(options.StateLockTimeout)
+	return initAndPlanRefreshOnly(ctx, tf, stateLockTimeout)
+}
+
 func (e *executor) GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error) {
 	// Install Terraform
 	i := install.NewInstaller()
@@ -240,13 +289,19 @@ func (e executor) setEnvironmentVariables(tf *tfexec.Terraform, options Options)
 
 	// Render a .terraformrc for provider_installation rules and credentials, and
 	// point Terraform at it. Both inputs are populated only by the Radius.Core
-	// path; Applications.Core leaves them nil/empty so this is a no-op there.
-	if recipeConfig.Terraform.ProviderInstallation != nil || len(recipeConfig.Terraform.Credentials) > 0 {
+	// path; Applications.Core leaves them nil/empty so this is a no-op there
+	// unless providers are cached in the recipe cache.
+	mirror, err := providerMirror(options.Cache)
+	if err != nil {
+		return err
+	}
+	if recipeConfig.Terraform.ProviderInstallation != nil || len(recipeConfig.Terraform.Credentials) > 0 || mirror != nil {
 		rcPath, err := writeTerraformCLIConfig(
 			tf.WorkingDir(),
 			recipeConfig.Terraform.ProviderInstallation,
 			recipeConfig.Terraform.Credentials,
 			options.Secrets,
+			mirror,
 		)
 		if err != nil {
 			return err
@@ -268,24 +323,30 @@ func (e executor) setEnvironmentVariables(tf *tfexec.Terraform, options Options)
 }
 
 // applyTerraformCLIConfig writes a .terraformrc file derived from the
-// provider_installation rules and credentials in options.EnvConfig (if any) and
-// configures the Terraform CLI to use it via TF_CLI_CONFIG_FILE.
+// provider_installation rules and credentials in options.EnvConfig (if any), and
+// the providers cached in options.Cache (if any), and configures the Terraform CLI
+// to use it via TF_CLI_CONFIG_FILE.
 //
 // This path is used by Delete (and indirectly by Deploy via setEnvironmentVariables)
 // to ensure terraform init can resolve providers from a network mirror and
 // authenticate to private registries. When neither input is populated, this is
 // a no-op.
 func (e executor) applyTerraformCLIConfig(tf *tfexec.Terraform, options Options) error {
-	if options.EnvConfig == nil {
-		return nil
+	var pi *datamodel.TerraformProviderInstallation
+	var creds map[string]datamodel.TerraformCredentialConfig
+	if options.EnvConfig != nil {
+		pi = options.EnvConfig.RecipeConfig.Terraform.ProviderInstallation
+		creds = options.EnvConfig.RecipeConfig.Terraform.Credentials
 	}
-	pi := options.EnvConfig.RecipeConfig.Terraform.ProviderInstallation
-	creds := options.EnvConfig.RecipeConfig.Terraform.Credentials
-	if pi == nil && len(creds) == 0 {
+	mirror, err := providerMirror(options.Cache)
+	if err != nil {
+		return err
+	}
+	if pi == nil && len(creds) == 0 && mirror == nil {
 		return nil
 	}
 
-	rcPath, err := writeTerraformCLIConfig(tf.WorkingDir(), pi, creds, options.Secrets)
+	rcPath, err := writeTerraformCLIConfig(tf.WorkingDir(), pi, creds, options.Secrets, mirror)
 	if err != nil {
 		return err
 	}
@@ -513,6 +574,38 @@ func initAndApply(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout st
 	return tf.Show(ctx)
 }
 
+// initAndPlanRefreshOnly runs Terraform init and plan with -refresh-only in the provided working directory, and returns the
+// saved plan. A refresh-only plan never proposes changes to the infrastructure, it only compares the remote objects with the state.
+func initAndPlanRefreshOnly(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout string) (*tfjson.Plan, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	// Initialize Terraform
+	logger.Info("Initializing Terraform")
+	terraformInitStartTime := time.Now()
+	if err := tf.Init(ctx); err != nil {
+		metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+			[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.FailedOperationState)})
+
+		return nil, fmt.Errorf("terraform init failure: %w", err)
+	}
+	metrics.DefaultRecipeEngineMetrics.RecordTerraformInitializationDuration(ctx, terraformInitStartTime,
+		[]attribute.KeyValue{metrics.OperationStateAttrKey.String(metrics.SuccessfulOperationState)})
+
+	// Plan Terraform configuration in refresh-only mode with state lock timeout
+	logger.Info("Running Terraform refresh-only plan with state lock timeout: " + stateLockTimeout)
+	planFile := filepath.Join(tf.WorkingDir(), driftPlanFileName)
+	if _, err := tf.Plan(ctx, tfexec.RefreshOnly(true), tfexec.Out(planFile), tfexec.Lock(true), tfexec.LockTimeout(stateLockTimeout)); err != nil {
+		return nil, fmt.Errorf("terraform plan failure: %w", err)
+	}
+
+	// Suppress stdout during tf.ShowPlanFile to prevent resource attributes (which may
+	// contain sensitive values) from being written to the Radius logs.
+	tf.SetStdout(io.Discard)
+	defer tf.SetStdout(&tfLogWrapper{logger: logger})
+
+	return tf.ShowPlanFile(ctx, planFile)
+}
+
 // initAndDestroy runs Terraform init and destroy in the provided working directory.
 func initAndDestroy(ctx context.Context, tf *tfexec.Terraform, stateLockTimeout string) error {
 	logger := ucplog.FromContextOrDiscard(ctx)
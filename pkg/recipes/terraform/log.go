@@ -18,20 +18,28 @@ package terraform
 
 import (
 	"context"
+	"io"
 
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/terraform-exec/tfexec"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
-// tfLogWrapper is a wrapper around the Terraform logger to stream the logs to the Radius logger.
+// tfLogWrapper is a wrapper around the Terraform logger to stream the logs to the Radius logger and to the log of the
+// operation executing the recipe.
 type tfLogWrapper struct {
-	logger   logr.Logger
-	isStdErr bool
+	logger       logr.Logger
+	operationLog io.Writer
+	isStdErr     bool
 }
 
 // Write implements the io.Writer interface to stream the Terraform logs to the Radius logger.
 func (w *tfLogWrapper) Write(p []byte) (n int, err error) {
+	if w.operationLog != nil {
+		_, _ = w.operationLog.Write(p)
+	}
+
 	if w.isStdErr {
 		w.logger.Error(nil, string(p))
 	} else {
@@ -41,7 +49,8 @@ func (w *tfLogWrapper) Write(p []byte) (n int, err error) {
 	return len(p), nil
 }
 
-// configureTerraformLogs configures the Terraform logs to be streamed to the Radius logs.
+// configureTerraformLogs configures the Terraform logs to be streamed to the Radius logs and to the log of the operation
+// executing the recipe.
 func configureTerraformLogs(ctx context.Context, tf *tfexec.Terraform, logLevel string) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 
@@ -56,6 +65,7 @@ func configureTerraformLogs(ctx context.Context, tf *tfexec.Terraform, logLevel
 		return
 	}
 
-	tf.SetStdout(&tfLogWrapper{logger: logger})
-	tf.SetStderr(&tfLogWrapper{logger: logger, isStdErr: true})
+	operationLog := v1.OperationLoggerFromContext(ctx)
+	tf.SetStdout(&tfLogWrapper{logger: logger, operationLog: operationLog})
+	tf.SetStderr(&tfLogWrapper{logger: logger, operationLog: operationLog, isStdErr: true})
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package terraform
 
 import (
+	"bytes"
 	"path/filepath"
 	"testing"
 
@@ -121,6 +122,15 @@ func TestTfLogWrapper_Write(t *testing.T) {
 	n, err = stderrWrapper.Write(testMessage)
 	require.NoError(t, err)
 	require.Equal(t, len(testMessage), n)
+
+	// Test the wrapper streaming to the operation log
+	operationLog := &bytes.Buffer{}
+	operationLogWrapper := &tfLogWrapper{logger: logger, operationLog: operationLog}
+
+	n, err = operationLogWrapper.Write(testMessage)
+	require.NoError(t, err)
+	require.Equal(t, len(testMessage), n)
+	require.Equal(t, "test stdout message", operationLog.String())
 }
 
 // TestConfigureTerraformLogs_LoggerSetup tests that log wrappers are properly configured
@@ -118,6 +118,45 @@ func (c *MockTerraformExecutorDeployCall) DoAndReturn(f func(context.Context, Op
 	return c
 }
 
+// DetectDrift mocks base method.
+func (m *MockTerraformExecutor) DetectDrift(ctx context.Context, options Options) (*tfjson.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectDrift", ctx, options)
+	ret0, _ := ret[0].(*tfjson.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectDrift indicates an expected call of DetectDrift.
+func (mr *MockTerraformExecutorMockRecorder) DetectDrift(ctx, options any) *MockTerraformExecutorDetectDriftCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectDrift", reflect.TypeOf((*MockTerraformExecutor)(nil).DetectDrift), ctx, options)
+	return &MockTerraformExecutorDetectDriftCall{Call: call}
+}
+
+// MockTerraformExecutorDetectDriftCall wrap *gomock.Call
+type MockTerraformExecutorDetectDriftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockTerraformExecutorDetectDriftCall) Return(arg0 *tfjson.Plan, arg1 error) *MockTerraformExecutorDetectDriftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockTerraformExecutorDetectDriftCall) Do(f func(context.Context, Options) (*tfjson.Plan, error)) *MockTerraformExecutorDetectDriftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockTerraformExecutorDetectDriftCall) DoAndReturn(f func(context.Context, Options) (*tfjson.Plan, error)) *MockTerraformExecutorDetectDriftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // GetRecipeMetadata mocks base method.
 func (m *MockTerraformExecutor) GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error) {
 	m.ctrl.T.Helper()
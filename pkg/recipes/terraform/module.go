@@ -69,6 +69,8 @@ func downloadAndInspect(ctx context.Context, tf *tfexec.Terraform, options Optio
 
 	// Run Terraform Get command to download the module from the source specified in the config.
 	// The downloaded module is stored in the working directory.
+	// Modules restored from the recipe cache are already installed, so terraform get does not download them again.
+	restored := restoreModules(ctx, tf.WorkingDir(), options)
 	logger.Info(fmt.Sprintf("Downloading Terraform module: %s", options.EnvRecipe.TemplatePath))
 	downloadStartTime := time.Now()
 	if err := tf.Get(ctx); err != nil {
@@ -84,6 +86,10 @@ func downloadAndInspect(ctx context.Context, tf *tfexec.Terraform, options Optio
 		metrics.NewRecipeAttributes(metrics.RecipeEngineOperationDownloadRecipe, options.EnvRecipe.Name,
 			options.EnvRecipe, metrics.SuccessfulOperationState))
 
+	if !restored {
+		saveModules(ctx, tf.WorkingDir(), options)
+	}
+
 	// Load the downloaded module to retrieve providers and variables required by the module.
 	// This is needed to add the appropriate providers config and populate the value of recipe context variable.
 	logger.Info(fmt.Sprintf("Inspecting the downloaded Terraform module: %s", options.EnvRecipe.TemplatePath))
@@ -28,6 +28,7 @@ import (
 	tfjson "github.com/hashicorp/terraform-json"
 	dm "github.com/radius-project/radius/pkg/corerp/datamodel"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/cache"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
@@ -50,6 +51,10 @@ type TerraformExecutor interface {
 
 	// GetRecipeMetadata installs terraform and runs terraform get to retrieve information on the terraform module
 	GetRecipeMetadata(ctx context.Context, options Options) (map[string]any, error)
+
+	// DetectDrift installs terraform and runs terraform init and a refresh-only plan on the terraform module referenced by the recipe
+	// using terraform-exec, and returns the plan describing the changes made to the deployed resources outside of Terraform.
+	DetectDrift(ctx context.Context, options Options) (*tfjson.Plan, error)
 }
 
 // Options represents the options required to build inputs to interact with Terraform.
@@ -76,6 +81,10 @@ type Options struct {
 
 	// LogLevel is the log level for Terraform execution (e.g., TRACE, DEBUG, INFO, WARN, ERROR).
 	LogLevel string
+
+	// Cache is the optional cache of recipe modules and providers. When it is nil, modules and providers are
+	// downloaded on every execution.
+	Cache *cache.Cache
 }
 
 // NewTerraform creates a working directory for Terraform execution and new Terraform executor with Terraform logs enabled.
@@ -46,6 +46,18 @@ type Configuration struct {
 	Simulated bool
 
 	RecipeConfig datamodel.RecipeConfigProperties
+
+	// DriftPolicy represents the environment policy for detecting and remediating recipe drift.
+	DriftPolicy DriftPolicy
+}
+
+// DriftPolicy represents the environment policy for detecting and remediating drift of recipe-deployed infrastructure.
+type DriftPolicy struct {
+	// Disabled turns off periodic drift detection for resources in the environment.
+	Disabled bool
+
+	// AutoRemediate redeploys the recipe when drift is detected.
+	AutoRemediate bool
 }
 
 // RuntimeConfiguration represents Runtime configuration for the environment.
@@ -139,6 +151,18 @@ type RecipeOutput struct {
 	Status *rpv1.RecipeStatus
 }
 
+// DriftResult represents the result of comparing the deployed infrastructure with the recipe.
+type DriftResult struct {
+	// Drifted is true when the deployed infrastructure no longer matches the recipe.
+	Drifted bool
+
+	// Resources represents the list of resources that no longer match the recipe.
+	Resources []string
+
+	// Message represents additional details about the detected drift.
+	Message string
+}
+
 // SecretData represents secrets data and includes secret type and a map of secret keys to their values.
 type SecretData struct {
 	Type string            `json:"type"`
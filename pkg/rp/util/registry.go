@@ -19,6 +19,7 @@ package util
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
 	"strings"
@@ -26,39 +27,70 @@ import (
 	"github.com/distribution/reference"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/recipes"
+	"github.com/radius-project/radius/pkg/recipes/cache"
 	recipes_util "github.com/radius-project/radius/pkg/recipes/util"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
 	"oras.land/oras-go/v2/content"
 	"oras.land/oras-go/v2/registry/remote"
 )
 
+// latestTag is the tag of an OCI reference that does not include one.
+const latestTag = "latest"
+
 // ReadFromRegistry reads data from an OCI compliant registry and stores it in a map. It returns an error if the path is invalid,
 // if the client to the registry fails to be created, if the manifest fails to be fetched, if the bytes fail to be fetched, or if
 // the data fails to be unmarshalled.
 func ReadFromRegistry(ctx context.Context, definition recipes.EnvironmentDefinition, data *map[string]any, client remote.Client) error {
-	registryRepo, tag, err := parsePath(definition.TemplatePath)
+	bytes, err := readBytesFromRegistry(ctx, definition, client)
 	if err != nil {
-		return v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid path %s", err.Error()))
+		return err
 	}
 
-	repo, err := remote.NewRepository(registryRepo)
+	err = json.Unmarshal(bytes, data)
 	if err != nil {
-		return fmt.Errorf("failed to create client to registry %s", err.Error())
+		return err
 	}
 
-	repo.Client = client
+	return nil
+}
 
-	if definition.PlainHTTP {
-		repo.PlainHTTP = true
+// ReadFromRegistryWithCache reads data from an OCI compliant registry like ReadFromRegistry, using the recipe cache c
+// to avoid downloading the same template again. Templates referenced by a tag other than "latest" are read from the
+// cache when they are present. Templates referenced by the "latest" tag are always downloaded, and are only read
+// from the cache when the registry cannot be reached. When c is nil, the template is always downloaded.
+func ReadFromRegistryWithCache(ctx context.Context, definition recipes.EnvironmentDefinition, data *map[string]any, client remote.Client, c *cache.Cache) error {
+	if c == nil {
+		return ReadFromRegistry(ctx, definition, data, client)
 	}
 
-	digest, err := getDigestFromManifest(ctx, repo, tag)
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	_, tag, err := parseTemplatePath(definition.TemplatePath)
 	if err != nil {
-		return recipes.NewRecipeError(recipes.RecipeLanguageFailure, fmt.Sprintf("failed to fetch repository from the path %q: %s", definition.TemplatePath, err.Error()), recipes_util.RecipeSetupError, nil)
+		return err
 	}
 
-	bytes, err := getBytes(ctx, repo, digest)
+	key := cache.Key(definition.TemplatePath, "")
+	pinned := tag != latestTag
+	if pinned {
+		bytes, err := c.Get(key)
+		if err == nil {
+			logger.Info(fmt.Sprintf("Using cached recipe template %q", definition.TemplatePath))
+			return json.Unmarshal(bytes, data)
+		} else if !errors.Is(err, cache.ErrNotFound) {
+			logger.Error(err, "failed to read the recipe template from the cache", "templatePath", definition.TemplatePath)
+		}
+	}
+
+	bytes, err := readBytesFromRegistry(ctx, definition, client)
 	if err != nil {
-		return recipes.NewRecipeError(recipes.RecipeLanguageFailure, fmt.Sprintf("failed to fetch repository from the path %q: %s", definition.TemplatePath, err.Error()), recipes_util.RecipeSetupError, nil)
+		if !pinned {
+			if cached, cacheErr := c.Get(key); cacheErr == nil {
+				logger.Info(fmt.Sprintf("Using cached recipe template %q because the registry could not be reached: %s", definition.TemplatePath, err.Error()))
+				return json.Unmarshal(cached, data)
+			}
+		}
+		return err
 	}
 
 	err = json.Unmarshal(bytes, data)
@@ -66,9 +98,45 @@ func ReadFromRegistry(ctx context.Context, definition recipes.EnvironmentDefinit
 		return err
 	}
 
+	// Failing to cache the template does not fail the read.
+	if err := c.Put(key, bytes); err != nil {
+		logger.Error(err, "failed to add the recipe template to the cache", "templatePath", definition.TemplatePath)
+	}
+
 	return nil
 }
 
+// readBytesFromRegistry downloads the content of the first layer of the OCI artifact referenced by the template path.
+func readBytesFromRegistry(ctx context.Context, definition recipes.EnvironmentDefinition, client remote.Client) ([]byte, error) {
+	registryRepo, tag, err := parseTemplatePath(definition.TemplatePath)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := remote.NewRepository(registryRepo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client to registry %s", err.Error())
+	}
+
+	repo.Client = client
+
+	if definition.PlainHTTP {
+		repo.PlainHTTP = true
+	}
+
+	digest, err := getDigestFromManifest(ctx, repo, tag)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeLanguageFailure, fmt.Sprintf("failed to fetch repository from the path %q: %s", definition.TemplatePath, err.Error()), recipes_util.RecipeSetupError, nil)
+	}
+
+	bytes, err := getBytes(ctx, repo, digest)
+	if err != nil {
+		return nil, recipes.NewRecipeError(recipes.RecipeLanguageFailure, fmt.Sprintf("failed to fetch repository from the path %q: %s", definition.TemplatePath, err.Error()), recipes_util.RecipeSetupError, nil)
+	}
+
+	return bytes, nil
+}
+
 // getDigestFromManifest gets the layers digest from the manifest
 func getDigestFromManifest(ctx context.Context, repo *remote.Repository, tag string) (string, error) {
 	// resolves a manifest descriptor with a Tag reference
@@ -125,6 +193,17 @@ func getBytes(ctx context.Context, repo *remote.Repository, layerDigest string)
 	return pulledBlob, nil
 }
 
+// parseTemplatePath parses the template path of a recipe like parsePath, and returns an invalid request error if the
+// path is not valid.
+func parseTemplatePath(path string) (repository string, tag string, err error) {
+	repository, tag, err = parsePath(path)
+	if err != nil {
+		return "", "", v1.NewClientErrInvalidRequest(fmt.Sprintf("invalid path %s", err.Error()))
+	}
+
+	return repository, tag, nil
+}
+
 // parsePath parses a path in the form of registry/repository:tag. Recipe template
 // paths may include an http(s):// scheme even though OCI references do not, so a
 // leading scheme is stripped before normalizing (matching the previous parser).
@@ -16,6 +16,25 @@ limitations under the License.
 
 package v1
 
+import "time"
+
+// RecipeDriftState represents whether the infrastructure deployed by a recipe still matches the recipe.
+type RecipeDriftState string
+
+const (
+	// RecipeDriftStateInSync indicates that the deployed infrastructure matches the recipe.
+	RecipeDriftStateInSync RecipeDriftState = "InSync"
+
+	// RecipeDriftStateDrifted indicates that the deployed infrastructure was changed outside of Radius.
+	RecipeDriftStateDrifted RecipeDriftState = "Drifted"
+
+	// RecipeDriftStateRemediating indicates that drift was detected and the recipe is being redeployed.
+	RecipeDriftStateRemediating RecipeDriftState = "Remediating"
+
+	// RecipeDriftStateUnknown indicates that drift detection failed or is not supported by the recipe driver.
+	RecipeDriftStateUnknown RecipeDriftState = "Unknown"
+)
+
 // RecipeStatus defines the status of the recipe
 type RecipeStatus struct {
 	// TemplateKind specifies the kind of template used for the recipe.
@@ -26,4 +45,22 @@ type RecipeStatus struct {
 
 	// TemplateVersion specifies the version of the template used for the recipe.
 	TemplateVersion string `json:"templateVersion,omitempty"`
+
+	// Drift specifies the result of the last drift detection run for the recipe.
+	Drift *RecipeDriftStatus `json:"drift,omitempty"`
+}
+
+// RecipeDriftStatus defines the drift status of the infrastructure deployed by a recipe.
+type RecipeDriftStatus struct {
+	// State specifies the drift state of the deployed infrastructure.
+	State RecipeDriftState `json:"state,omitempty"`
+
+	// LastCheckedTime specifies when drift detection last ran for the recipe.
+	LastCheckedTime *time.Time `json:"lastCheckedTime,omitempty"`
+
+	// DriftedResources specifies the resources that no longer match the recipe.
+	DriftedResources []string `json:"driftedResources,omitempty"`
+
+	// Message specifies additional details about the drift state, such as a detection failure.
+	Message string `json:"message,omitempty"`
 }
@@ -0,0 +1,209 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// annotationRadiusActions declares the custom actions of an API version of a resource type.
+const annotationRadiusActions = "x-radius-actions"
+
+// actionNamePattern matches the names of custom actions, which are used as the last segment of the action URL.
+var actionNamePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]{0,62}$`)
+
+// Action describes a custom action of a resource type. Actions are declared on the root schema of an API version:
+//
+//	x-radius-actions:
+//	  rotateCredentials:
+//	    description: Rotates the credentials of the database.
+//	    input:
+//	      type: object
+//	      properties:
+//	        expiryDays: { type: integer }
+//	    output:
+//	      type: object
+//	      properties:
+//	        rotatedAt: { type: string }
+//	    recipe: rotate-credentials    # or webhook: https://example.com/rotate
+//
+// An action is invoked with POST on the resource URL followed by the action name, and runs as an async operation.
+type Action struct {
+	// Name is the name of the action.
+	Name string
+
+	// Description describes the action.
+	Description string
+
+	// Input is the schema of the input of the action. A nil schema accepts any input.
+	Input map[string]any
+
+	// Output is the schema of the output of the action. A nil schema accepts any output.
+	Output map[string]any
+
+	// Recipe is the name of the recipe that implements the action.
+	Recipe string
+
+	// Webhook is the URL of the webhook that implements the action.
+	Webhook string
+}
+
+// ParseActions parses the value of the x-radius-actions annotation. It returns nil if the value is nil.
+func ParseActions(value any) (map[string]*Action, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	declared, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an object", annotationRadiusActions)
+	}
+
+	actions := map[string]*Action{}
+	names := map[string]string{}
+	for _, name := range sortedKeys(declared) {
+		if !actionNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("%s has invalid action name %q, names must start with a letter and contain only letters and digits", annotationRadiusActions, name)
+		}
+
+		// Action names are matched case-insensitively, like the rest of the resource URL.
+		if other, ok := names[strings.ToLower(name)]; ok {
+			return nil, fmt.Errorf("%s declares actions %q and %q that differ only by case", annotationRadiusActions, other, name)
+		}
+		names[strings.ToLower(name)] = name
+
+		action, err := parseAction(name, declared[name])
+		if err != nil {
+			return nil, err
+		}
+		actions[name] = action
+	}
+
+	return actions, nil
+}
+
+func parseAction(name string, value any) (*Action, error) {
+	prefix := annotationRadiusActions + "." + name
+
+	fields, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an object", prefix)
+	}
+
+	action := &Action{Name: name}
+	for _, key := range sortedKeys(fields) {
+		switch key {
+		case "description":
+			description, ok := fields[key].(string)
+			if !ok {
+				return nil, fmt.Errorf("%s.description must be a string", prefix)
+			}
+			action.Description = description
+		case "input", "output":
+			schema, ok := fields[key].(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%s.%s must be a schema object", prefix, key)
+			}
+			if _, err := ConvertToOpenAPISchema(schema); err != nil {
+				return nil, fmt.Errorf("%s.%s is not a valid schema: %w", prefix, key, err)
+			}
+			if key == "input" {
+				action.Input = schema
+			} else {
+				action.Output = schema
+			}
+		case "recipe":
+			recipe, ok := fields[key].(string)
+			if !ok || recipe == "" {
+				return nil, fmt.Errorf("%s.recipe must be a non-empty recipe name", prefix)
+			}
+			action.Recipe = recipe
+		case "webhook":
+			webhook, ok := fields[key].(string)
+			if !ok || !isValidWebhookURL(webhook) {
+				return nil, fmt.Errorf("%s.webhook must be an absolute http or https URL", prefix)
+			}
+			action.Webhook = webhook
+		default:
+			return nil, fmt.Errorf("%s has unsupported field %q, expected one of description, input, output, recipe or webhook", prefix, key)
+		}
+	}
+
+	if (action.Recipe == "") == (action.Webhook == "") {
+		return nil, fmt.Errorf("%s must declare exactly one of recipe or webhook", prefix)
+	}
+
+	return action, nil
+}
+
+// GetAction returns the action with the given name declared by the schema of an API version. The name is matched
+// case-insensitively. It returns nil if the action is not declared.
+func GetAction(schema map[string]any, name string) (*Action, error) {
+	if schema == nil {
+		return nil, nil
+	}
+
+	actions, err := ParseActions(schema[annotationRadiusActions])
+	if err != nil {
+		return nil, err
+	}
+
+	for declared, action := range actions {
+		if strings.EqualFold(declared, name) {
+			return action, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ValidateActionValue validates the input or output of an action against its schema. A nil schema accepts any value.
+func ValidateActionValue(schema map[string]any, value map[string]any) error {
+	if schema == nil {
+		return nil
+	}
+
+	openAPISchema, err := ConvertToOpenAPISchema(schema)
+	if err != nil {
+		return fmt.Errorf("failed to convert schema: %w", err)
+	}
+
+	if err := openAPISchema.VisitJSON(value); err != nil {
+		if schemaErr, ok := err.(*openapi3.SchemaError); ok {
+			fieldPath := strings.Trim(fmt.Sprintf("%v", schemaErr.JSONPointer()), "[]")
+			return fmt.Errorf("error at %q: %s", fieldPath, schemaErr.Reason)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// isValidWebhookURL reports whether value is an absolute http or https URL.
+func isValidWebhookURL(value string) bool {
+	u, err := url.Parse(value)
+	if err != nil {
+		return false
+	}
+
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseActions(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		actions, err := ParseActions(nil)
+		require.NoError(t, err)
+		require.Nil(t, actions)
+	})
+
+	t.Run("recipe and webhook actions", func(t *testing.T) {
+		inputSchema := map[string]any{
+			"type":       "object",
+			"properties": map[string]any{"expiryDays": map[string]any{"type": "integer"}},
+		}
+		actions, err := ParseActions(map[string]any{
+			"rotateCredentials": map[string]any{
+				"description": "Rotates the credentials.",
+				"input":       inputSchema,
+				"recipe":      "rotate-credentials",
+			},
+			"snapshot": map[string]any{
+				"webhook": "https://example.com/snapshot",
+			},
+		})
+		require.NoError(t, err)
+		require.Equal(t, map[string]*Action{
+			"rotateCredentials": {
+				Name:        "rotateCredentials",
+				Description: "Rotates the credentials.",
+				Input:       inputSchema,
+				Recipe:      "rotate-credentials",
+			},
+			"snapshot": {
+				Name:    "snapshot",
+				Webhook: "https://example.com/snapshot",
+			},
+		}, actions)
+	})
+
+	tests := []struct {
+		name  string
+		value any
+		err   string
+	}{
+		{
+			name:  "not an object",
+			value: "snapshot",
+			err:   "x-radius-actions must be an object",
+		},
+		{
+			name:  "invalid name",
+			value: map[string]any{"take-snapshot": map[string]any{"recipe": "snapshot"}},
+			err:   `x-radius-actions has invalid action name "take-snapshot", names must start with a letter and contain only letters and digits`,
+		},
+		{
+			name: "names differ only by case",
+			value: map[string]any{
+				"Snapshot": map[string]any{"recipe": "snapshot"},
+				"snapshot": map[string]any{"recipe": "snapshot"},
+			},
+			err: `x-radius-actions declares actions "Snapshot" and "snapshot" that differ only by case`,
+		},
+		{
+			name:  "action not an object",
+			value: map[string]any{"snapshot": "snapshot"},
+			err:   "x-radius-actions.snapshot must be an object",
+		},
+		{
+			name:  "unsupported field",
+			value: map[string]any{"snapshot": map[string]any{"recipe": "snapshot", "timeout": "1h"}},
+			err:   `x-radius-actions.snapshot has unsupported field "timeout", expected one of description, input, output, recipe or webhook`,
+		},
+		{
+			name:  "input not an object",
+			value: map[string]any{"snapshot": map[string]any{"recipe": "snapshot", "input": "string"}},
+			err:   "x-radius-actions.snapshot.input must be a schema object",
+		},
+		{
+			name:  "no implementation",
+			value: map[string]any{"snapshot": map[string]any{"description": "Takes a snapshot."}},
+			err:   "x-radius-actions.snapshot must declare exactly one of recipe or webhook",
+		},
+		{
+			name:  "recipe and webhook",
+			value: map[string]any{"snapshot": map[string]any{"recipe": "snapshot", "webhook": "https://example.com"}},
+			err:   "x-radius-actions.snapshot must declare exactly one of recipe or webhook",
+		},
+		{
+			name:  "relative webhook",
+			value: map[string]any{"snapshot": map[string]any{"webhook": "/snapshot"}},
+			err:   "x-radius-actions.snapshot.webhook must be an absolute http or https URL",
+		},
+		{
+			name:  "empty recipe",
+			value: map[string]any{"snapshot": map[string]any{"recipe": ""}},
+			err:   "x-radius-actions.snapshot.recipe must be a non-empty recipe name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actions, err := ParseActions(tt.value)
+			require.EqualError(t, err, tt.err)
+			require.Nil(t, actions)
+		})
+	}
+}
+
+func TestGetAction(t *testing.T) {
+	schema := map[string]any{
+		"type": "object",
+		"x-radius-actions": map[string]any{
+			"rotateCredentials": map[string]any{"recipe": "rotate-credentials"},
+		},
+	}
+
+	action, err := GetAction(schema, "ROTATECREDENTIALS")
+	require.NoError(t, err)
+	require.Equal(t, "rotateCredentials", action.Name)
+
+	action, err = GetAction(schema, "snapshot")
+	require.NoError(t, err)
+	require.Nil(t, action)
+
+	action, err = GetAction(nil, "snapshot")
+	require.NoError(t, err)
+	require.Nil(t, action)
+}
+
+func TestValidateActionValue(t *testing.T) {
+	schema := map[string]any{
+		"type":       "object",
+		"properties": map[string]any{"expiryDays": map[string]any{"type": "integer"}},
+		"required":   []any{"expiryDays"},
+	}
+
+	require.NoError(t, ValidateActionValue(schema, map[string]any{"expiryDays": 30}))
+	require.NoError(t, ValidateActionValue(nil, map[string]any{"anything": true}))
+
+	err := ValidateActionValue(schema, map[string]any{"expiryDays": "thirty"})
+	require.ErrorContains(t, err, `error at "expiryDays"`)
+
+	err = ValidateActionValue(schema, map[string]any{})
+	require.ErrorContains(t, err, "expiryDays")
+}
@@ -0,0 +1,417 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// annotationRadiusStorageVersion marks the API version of a resource type whose schema is used to store resources.
+	annotationRadiusStorageVersion = "x-radius-storage-version"
+
+	// annotationRadiusConversion declares how the properties of an API version map to the storage version.
+	annotationRadiusConversion = "x-radius-conversion"
+)
+
+// Conversion describes how the properties of a resource at one API version map to the storage version of its
+// resource type. It is declared on the root schema of a non-storage API version:
+//
+//	x-radius-conversion:
+//	  renamed:
+//	    size: capacity        # 'size' in this version is 'capacity' in the storage version
+//	  added:
+//	    tier: standard        # 'tier' does not exist in this version and defaults to 'standard' when stored
+//	  removed:
+//	    legacyMode: false     # 'legacyMode' is not stored, and reads at this version return 'false'
+//
+// Field paths use dot notation relative to the resource properties, for example "config.size".
+type Conversion struct {
+	// Renamed maps a field path in this version to the field path in the storage version.
+	Renamed map[string]string
+
+	// Added maps a field path that only exists in the storage version to the default value used when converting
+	// to the storage version. The field is dropped when converting back to this version.
+	Added map[string]any
+
+	// Removed maps a field path that only exists in this version to the value returned when converting back to
+	// this version. The field is dropped when converting to the storage version. A nil value leaves the field unset.
+	Removed map[string]any
+}
+
+// ParseConversion parses the value of the x-radius-conversion annotation. It returns nil if the value is nil.
+func ParseConversion(value any) (*Conversion, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	rules, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an object", annotationRadiusConversion)
+	}
+
+	conversion := &Conversion{}
+	for key, raw := range rules {
+		fields, ok := raw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s.%s must be an object", annotationRadiusConversion, key)
+		}
+
+		switch key {
+		case "renamed":
+			conversion.Renamed = map[string]string{}
+			for from, to := range fields {
+				target, ok := to.(string)
+				if !ok || target == "" {
+					return nil, fmt.Errorf("%s.renamed.%s must be a non-empty field path", annotationRadiusConversion, from)
+				}
+				conversion.Renamed[from] = target
+			}
+		case "added":
+			conversion.Added = fields
+		case "removed":
+			conversion.Removed = fields
+		default:
+			return nil, fmt.Errorf("%s has unsupported rule %q, expected one of renamed, added or removed", annotationRadiusConversion, key)
+		}
+	}
+
+	if err := conversion.validate(); err != nil {
+		return nil, err
+	}
+
+	return conversion, nil
+}
+
+// validate checks that each field path is well-formed and is only used by one rule.
+func (c *Conversion) validate() error {
+	// Paths in this version and paths in the storage version are tracked separately since a
+	// rename can legitimately reuse a name on the other side.
+	versionPaths := map[string]string{}
+	storagePaths := map[string]string{}
+
+	use := func(paths map[string]string, path string, rule string) error {
+		if !isValidConversionPath(path) {
+			return fmt.Errorf("%s.%s has invalid field path %q", annotationRadiusConversion, rule, path)
+		}
+		if other, ok := paths[path]; ok {
+			return fmt.Errorf("%s field %q is used by both %s and %s", annotationRadiusConversion, path, other, rule)
+		}
+		paths[path] = rule
+		return nil
+	}
+
+	for _, from := range sortedKeys(c.Renamed) {
+		if err := use(versionPaths, from, "renamed"); err != nil {
+			return err
+		}
+		if err := use(storagePaths, c.Renamed[from], "renamed"); err != nil {
+			return err
+		}
+	}
+	for _, path := range sortedKeys(c.Removed) {
+		if err := use(versionPaths, path, "removed"); err != nil {
+			return err
+		}
+	}
+	for _, path := range sortedKeys(c.Added) {
+		if err := use(storagePaths, path, "added"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ToStorage converts properties at this version to the storage version, in place.
+func (c *Conversion) ToStorage(properties map[string]any) {
+	if c == nil || properties == nil {
+		return
+	}
+
+	for _, path := range sortedKeys(c.Removed) {
+		deleteFieldPath(properties, path)
+	}
+
+	moveFields(properties, c.Renamed)
+
+	for _, path := range sortedKeys(c.Added) {
+		if _, ok := getFieldPath(properties, path); !ok && c.Added[path] != nil {
+			setFieldPath(properties, path, copyDefaultValue(c.Added[path]))
+		}
+	}
+}
+
+// FromStorage converts properties at the storage version to this version, in place.
+func (c *Conversion) FromStorage(properties map[string]any) {
+	if c == nil || properties == nil {
+		return
+	}
+
+	for _, path := range sortedKeys(c.Added) {
+		deleteFieldPath(properties, path)
+	}
+
+	inverse := make(map[string]string, len(c.Renamed))
+	for from, to := range c.Renamed {
+		inverse[to] = from
+	}
+	moveFields(properties, inverse)
+
+	for _, path := range sortedKeys(c.Removed) {
+		if _, ok := getFieldPath(properties, path); !ok && c.Removed[path] != nil {
+			setFieldPath(properties, path, copyDefaultValue(c.Removed[path]))
+		}
+	}
+}
+
+// IsStorageVersion reports whether the schema of an API version is marked with x-radius-storage-version.
+func IsStorageVersion(schema map[string]any) bool {
+	if schema == nil {
+		return false
+	}
+	storage, ok := schema[annotationRadiusStorageVersion].(bool)
+	return ok && storage
+}
+
+// ValidateVersionAnnotations validates the x-radius-storage-version and x-radius-conversion annotations on the
+// root schema of an API version. The storage version is the target of conversions, so it cannot declare any.
+func ValidateVersionAnnotations(schema map[string]any) error {
+	if schema == nil {
+		return nil
+	}
+
+	if value, ok := schema[annotationRadiusStorageVersion]; ok {
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s must be a boolean value", annotationRadiusStorageVersion)
+		}
+	}
+
+	conversion, err := ParseConversion(schema[annotationRadiusConversion])
+	if err != nil {
+		return err
+	}
+
+	if conversion != nil && IsStorageVersion(schema) {
+		return fmt.Errorf("%s cannot be declared on the storage version", annotationRadiusConversion)
+	}
+
+	return nil
+}
+
+// VersionConverter converts the properties of a resource type between its API versions. Every conversion goes
+// through the storage version, so each API version only declares how it maps to the storage version.
+//
+// Resource types without a storage version are not converted, and resources are stored as they were sent.
+type VersionConverter struct {
+	storageVersion string
+	conversions    map[string]*Conversion
+}
+
+// NewVersionConverter lists the API versions of a resource type and returns a converter for them.
+//
+// Parameters:
+//   - ctx: The request context
+//   - ucpClient: UCP client factory for listing the API versions
+//   - resourceID: The full resource ID, used to determine the plane
+//   - resourceType: The resource type (e.g., "Foo.Bar/myResources")
+func NewVersionConverter(ctx context.Context, ucpClient *v20231001preview.ClientFactory, resourceID string, resourceType string) (*VersionConverter, error) {
+	converter := &VersionConverter{conversions: map[string]*Conversion{}}
+	if ucpClient == nil {
+		return converter, nil
+	}
+
+	ID, err := resources.Parse(resourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	planeName := strings.Split(ID.PlaneNamespace(), "/")[1]
+	resourceProvider, resourceTypeName, ok := strings.Cut(resourceType, "/")
+	if !ok {
+		return nil, fmt.Errorf("invalid resource type %q", resourceType)
+	}
+
+	pager := ucpClient.NewAPIVersionsClient().NewListPager(planeName, resourceProvider, resourceTypeName, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, apiVersion := range page.Value {
+			if apiVersion == nil || apiVersion.Name == nil || apiVersion.Properties == nil {
+				continue
+			}
+
+			schema := apiVersion.Properties.Schema
+			if IsStorageVersion(schema) {
+				if converter.storageVersion != "" {
+					return nil, fmt.Errorf("resource type %q has more than one storage version: %q and %q", resourceType, converter.storageVersion, *apiVersion.Name)
+				}
+				converter.storageVersion = *apiVersion.Name
+				continue
+			}
+
+			conversion, err := ParseConversion(schema[annotationRadiusConversion])
+			if err != nil {
+				return nil, fmt.Errorf("invalid conversion for API version %q of resource type %q: %w", *apiVersion.Name, resourceType, err)
+			}
+			converter.conversions[*apiVersion.Name] = conversion
+		}
+	}
+
+	return converter, nil
+}
+
+// StorageVersion returns the storage version of the resource type, or an empty string if it does not have one.
+func (c *VersionConverter) StorageVersion() string {
+	return c.storageVersion
+}
+
+// Convert converts properties from one API version to another, in place. API versions that do not declare a
+// conversion have the same shape as the storage version.
+func (c *VersionConverter) Convert(properties map[string]any, fromVersion string, toVersion string) {
+	if c.storageVersion == "" || fromVersion == toVersion || properties == nil {
+		return
+	}
+
+	if fromVersion != c.storageVersion {
+		c.conversions[fromVersion].ToStorage(properties)
+	}
+
+	if toVersion != c.storageVersion {
+		c.conversions[toVersion].FromStorage(properties)
+	}
+}
+
+// Preserve copies the fields that an API version cannot express from a resource stored at the storage version,
+// so that updating a resource at an older API version does not reset them to their defaults.
+func (c *VersionConverter) Preserve(properties map[string]any, stored map[string]any, apiVersion string) {
+	if c.storageVersion == "" || apiVersion == c.storageVersion || properties == nil || stored == nil {
+		return
+	}
+
+	conversion := c.conversions[apiVersion]
+	if conversion == nil {
+		return
+	}
+
+	for _, path := range sortedKeys(conversion.Added) {
+		if value, ok := getFieldPath(stored, path); ok {
+			setFieldPath(properties, path, copyDefaultValue(value))
+		}
+	}
+}
+
+// moveFields moves the value at each source path to its target path. All values are read before any is written
+// so that renames can swap fields.
+func moveFields(properties map[string]any, paths map[string]string) {
+	values := map[string]any{}
+	for _, from := range sortedKeys(paths) {
+		if value, ok := getFieldPath(properties, from); ok {
+			values[paths[from]] = value
+			deleteFieldPath(properties, from)
+		}
+	}
+
+	for _, to := range sortedKeys(values) {
+		setFieldPath(properties, to, values[to])
+	}
+}
+
+// isValidConversionPath reports whether a path only contains named fields separated by dots.
+func isValidConversionPath(path string) bool {
+	if path == "" {
+		return false
+	}
+	for _, segment := range strings.Split(path, ".") {
+		if segment == "" || strings.ContainsAny(segment, "[]") {
+			return false
+		}
+	}
+	return true
+}
+
+func getFieldPath(data map[string]any, path string) (any, bool) {
+	segments := strings.Split(path, ".")
+	current := data
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current = next
+	}
+
+	value, ok := current[segments[len(segments)-1]]
+	return value, ok
+}
+
+// setFieldPath sets the value at a path, creating intermediate objects as needed.
+func setFieldPath(data map[string]any, path string, value any) {
+	segments := strings.Split(path, ".")
+	current := data
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			current[segment] = next
+		}
+		current = next
+	}
+
+	current[segments[len(segments)-1]] = value
+}
+
+// deleteFieldPath deletes the value at a path. Objects that become empty because of the deletion are deleted too,
+// so that moving a field out of an object does not leave the object behind.
+func deleteFieldPath(data map[string]any, path string) {
+	deleteFieldSegments(data, strings.Split(path, "."))
+}
+
+func deleteFieldSegments(data map[string]any, segments []string) {
+	if len(segments) == 1 {
+		delete(data, segments[0])
+		return
+	}
+
+	next, ok := data[segments[0]].(map[string]any)
+	if !ok || len(next) == 0 {
+		return
+	}
+
+	deleteFieldSegments(next, segments[1:])
+	if len(next) == 0 {
+		delete(data, segments[0])
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
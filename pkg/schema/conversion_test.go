@@ -0,0 +1,346 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"net/http"
+	"sort"
+	"testing"
+
+	armpolicy "github.com/Azure/azure-sdk-for-go/sdk/azcore/arm/policy"
+	azfake "github.com/Azure/azure-sdk-for-go/sdk/azcore/fake"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview/fake"
+	"github.com/stretchr/testify/require"
+)
+
+const conversionTestResourceID = "/planes/radius/local/resourceGroups/test/providers/Foo.Bar/myResources/test"
+
+func TestParseConversion(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		conversion, err := ParseConversion(nil)
+		require.NoError(t, err)
+		require.Nil(t, conversion)
+	})
+
+	t.Run("all rules", func(t *testing.T) {
+		conversion, err := ParseConversion(map[string]any{
+			"renamed": map[string]any{"size": "capacity"},
+			"added":   map[string]any{"tier": "standard"},
+			"removed": map[string]any{"legacyMode": false},
+		})
+		require.NoError(t, err)
+		require.Equal(t, &Conversion{
+			Renamed: map[string]string{"size": "capacity"},
+			Added:   map[string]any{"tier": "standard"},
+			Removed: map[string]any{"legacyMode": false},
+		}, conversion)
+	})
+
+	tests := []struct {
+		name  string
+		value any
+		err   string
+	}{
+		{
+			name:  "not an object",
+			value: "size",
+			err:   "x-radius-conversion must be an object",
+		},
+		{
+			name:  "rule not an object",
+			value: map[string]any{"renamed": []any{"size"}},
+			err:   "x-radius-conversion.renamed must be an object",
+		},
+		{
+			name:  "unsupported rule",
+			value: map[string]any{"mapped": map[string]any{}},
+			err:   `x-radius-conversion has unsupported rule "mapped", expected one of renamed, added or removed`,
+		},
+		{
+			name:  "rename target not a string",
+			value: map[string]any{"renamed": map[string]any{"size": 1}},
+			err:   "x-radius-conversion.renamed.size must be a non-empty field path",
+		},
+		{
+			name:  "invalid field path",
+			value: map[string]any{"added": map[string]any{"items[0]": "a"}},
+			err:   `x-radius-conversion.added has invalid field path "items[0]"`,
+		},
+		{
+			name: "field renamed and removed",
+			value: map[string]any{
+				"renamed": map[string]any{"size": "capacity"},
+				"removed": map[string]any{"size": nil},
+			},
+			err: `x-radius-conversion field "size" is used by both renamed and removed`,
+		},
+		{
+			name: "field renamed and added",
+			value: map[string]any{
+				"renamed": map[string]any{"size": "capacity"},
+				"added":   map[string]any{"capacity": "S"},
+			},
+			err: `x-radius-conversion field "capacity" is used by both renamed and added`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conversion, err := ParseConversion(tt.value)
+			require.EqualError(t, err, tt.err)
+			require.Nil(t, conversion)
+		})
+	}
+}
+
+func TestConversion_RoundTrip(t *testing.T) {
+	conversion := &Conversion{
+		Renamed: map[string]string{"size": "sizing.capacity", "host": "endpoint.host"},
+		Added:   map[string]any{"tier": "standard", "options": map[string]any{"tls": true}},
+		Removed: map[string]any{"legacyMode": false, "notes": nil},
+	}
+
+	properties := map[string]any{
+		"environment": "env",
+		"size":        "L",
+		"host":        "example.com",
+		"legacyMode":  true,
+		"notes":       "gone",
+	}
+
+	conversion.ToStorage(properties)
+	require.Equal(t, map[string]any{
+		"environment": "env",
+		"sizing":      map[string]any{"capacity": "L"},
+		"endpoint":    map[string]any{"host": "example.com"},
+		"tier":        "standard",
+		"options":     map[string]any{"tls": true},
+	}, properties)
+
+	conversion.FromStorage(properties)
+	require.Equal(t, map[string]any{
+		"environment": "env",
+		"size":        "L",
+		"host":        "example.com",
+		"legacyMode":  false,
+	}, properties)
+}
+
+func TestConversion_KeepsStoredValues(t *testing.T) {
+	conversion := &Conversion{
+		Added:   map[string]any{"tier": "standard"},
+		Removed: map[string]any{"legacyMode": false},
+	}
+
+	properties := map[string]any{"tier": "premium"}
+	conversion.ToStorage(properties)
+	require.Equal(t, map[string]any{"tier": "premium"}, properties)
+
+	properties = map[string]any{"legacyMode": true}
+	conversion.FromStorage(properties)
+	require.Equal(t, map[string]any{"legacyMode": true}, properties)
+}
+
+func TestConversion_SwapsFields(t *testing.T) {
+	conversion := &Conversion{
+		Renamed: map[string]string{"a": "b", "b": "a"},
+	}
+
+	properties := map[string]any{"a": 1, "b": 2}
+	conversion.ToStorage(properties)
+	require.Equal(t, map[string]any{"a": 2, "b": 1}, properties)
+}
+
+func TestConversion_DefaultsAreCopied(t *testing.T) {
+	conversion := &Conversion{
+		Added: map[string]any{"options": map[string]any{"tls": true}},
+	}
+
+	properties := map[string]any{}
+	conversion.ToStorage(properties)
+	properties["options"].(map[string]any)["tls"] = false
+
+	require.Equal(t, map[string]any{"tls": true}, conversion.Added["options"])
+}
+
+func TestValidateVersionAnnotations(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema map[string]any
+		err    string
+	}{
+		{
+			name:   "no annotations",
+			schema: map[string]any{"type": "object"},
+		},
+		{
+			name:   "storage version",
+			schema: map[string]any{"x-radius-storage-version": true},
+		},
+		{
+			name:   "conversion",
+			schema: map[string]any{"x-radius-conversion": map[string]any{"renamed": map[string]any{"size": "capacity"}}},
+		},
+		{
+			name:   "storage version not a boolean",
+			schema: map[string]any{"x-radius-storage-version": "true"},
+			err:    "x-radius-storage-version must be a boolean value",
+		},
+		{
+			name:   "invalid conversion",
+			schema: map[string]any{"x-radius-conversion": "size"},
+			err:    "x-radius-conversion must be an object",
+		},
+		{
+			name: "conversion on the storage version",
+			schema: map[string]any{
+				"x-radius-storage-version": true,
+				"x-radius-conversion":      map[string]any{"renamed": map[string]any{"size": "capacity"}},
+			},
+			err: "x-radius-conversion cannot be declared on the storage version",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateVersionAnnotations(tt.schema)
+			if tt.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestVersionConverter(t *testing.T) {
+	schemas := map[string]map[string]any{
+		"2025-01-01": {
+			"type": "object",
+			"x-radius-conversion": map[string]any{
+				"renamed": map[string]any{"size": "capacity"},
+				"added":   map[string]any{"tier": "standard"},
+			},
+		},
+		"2025-06-01": {
+			"type":                     "object",
+			"x-radius-storage-version": true,
+		},
+		"2025-09-01": {
+			"type": "object",
+			"x-radius-conversion": map[string]any{
+				"removed": map[string]any{"replicas": 1},
+			},
+		},
+	}
+
+	t.Run("converts to and from the storage version", func(t *testing.T) {
+		clientFactory, err := testUCPClientFactoryWithVersions(schemas)
+		require.NoError(t, err)
+
+		converter, err := NewVersionConverter(t.Context(), clientFactory, conversionTestResourceID, "Foo.Bar/myResources")
+		require.NoError(t, err)
+		require.Equal(t, "2025-06-01", converter.StorageVersion())
+
+		properties := map[string]any{"size": "L"}
+		converter.Convert(properties, "2025-01-01", "2025-06-01")
+		require.Equal(t, map[string]any{"capacity": "L", "tier": "standard"}, properties)
+
+		converter.Convert(properties, "2025-06-01", "2025-01-01")
+		require.Equal(t, map[string]any{"size": "L"}, properties)
+	})
+
+	t.Run("converts between versions through the storage version", func(t *testing.T) {
+		clientFactory, err := testUCPClientFactoryWithVersions(schemas)
+		require.NoError(t, err)
+
+		converter, err := NewVersionConverter(t.Context(), clientFactory, conversionTestResourceID, "Foo.Bar/myResources")
+		require.NoError(t, err)
+
+		properties := map[string]any{"size": "L"}
+		converter.Convert(properties, "2025-01-01", "2025-09-01")
+		require.Equal(t, map[string]any{"capacity": "L", "tier": "standard", "replicas": float64(1)}, properties)
+	})
+
+	t.Run("no storage version", func(t *testing.T) {
+		clientFactory, err := testUCPClientFactoryWithVersions(map[string]map[string]any{
+			"2025-01-01": schemas["2025-01-01"],
+		})
+		require.NoError(t, err)
+
+		converter, err := NewVersionConverter(t.Context(), clientFactory, conversionTestResourceID, "Foo.Bar/myResources")
+		require.NoError(t, err)
+		require.Empty(t, converter.StorageVersion())
+
+		properties := map[string]any{"size": "L"}
+		converter.Convert(properties, "2025-01-01", "2025-06-01")
+		require.Equal(t, map[string]any{"size": "L"}, properties)
+	})
+
+	t.Run("more than one storage version", func(t *testing.T) {
+		clientFactory, err := testUCPClientFactoryWithVersions(map[string]map[string]any{
+			"2025-01-01": {"x-radius-storage-version": true},
+			"2025-06-01": {"x-radius-storage-version": true},
+		})
+		require.NoError(t, err)
+
+		_, err = NewVersionConverter(t.Context(), clientFactory, conversionTestResourceID, "Foo.Bar/myResources")
+		require.ErrorContains(t, err, `resource type "Foo.Bar/myResources" has more than one storage version`)
+	})
+
+	t.Run("nil client", func(t *testing.T) {
+		converter, err := NewVersionConverter(t.Context(), nil, conversionTestResourceID, "Foo.Bar/myResources")
+		require.NoError(t, err)
+		require.Empty(t, converter.StorageVersion())
+	})
+}
+
+// testUCPClientFactoryWithVersions creates a mock UCP client factory that lists the given API versions.
+func testUCPClientFactoryWithVersions(schemas map[string]map[string]any) (*v20231001preview.ClientFactory, error) {
+	names := make([]string, 0, len(schemas))
+	for name := range schemas {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	apiVersionsServer := fake.APIVersionsServer{
+		NewListPager: func(planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.APIVersionsClientListOptions) (resp azfake.PagerResponder[v20231001preview.APIVersionsClientListResponse]) {
+			page := v20231001preview.APIVersionsClientListResponse{}
+			for _, name := range names {
+				page.Value = append(page.Value, &v20231001preview.APIVersionResource{
+					Name: new(name),
+					Properties: &v20231001preview.APIVersionProperties{
+						Schema: schemas[name],
+					},
+				})
+			}
+			resp.AddPage(http.StatusOK, page, nil)
+			return
+		},
+	}
+
+	return v20231001preview.NewClientFactory(&aztoken.AnonymousCredential{}, &armpolicy.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Transport: fake.NewServerFactoryTransport(&fake.ServerFactory{
+				APIVersionsServer: apiVersionsServer,
+			}),
+		},
+	})
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+const (
+	// annotationRadiusEndpoint marks a field whose value is a URL where the resource can be reached. The value of the
+	// annotation is the visibility of the endpoint, either "public" or "internal".
+	annotationRadiusEndpoint = "x-radius-endpoint"
+
+	// EndpointVisibilityPublic is the visibility of an endpoint that can be reached from outside the environment.
+	EndpointVisibilityPublic = "public"
+
+	// EndpointVisibilityInternal is the visibility of an endpoint that can only be reached from within the environment.
+	EndpointVisibilityInternal = "internal"
+)
+
+// EndpointField is a field of a schema with the x-radius-endpoint annotation.
+type EndpointField struct {
+	// Path is the path of the field in dot notation, e.g. "status.url".
+	Path string
+
+	// Visibility is the visibility of the endpoints of the field, either "public" or "internal".
+	Visibility string
+}
+
+// Endpoint is the value of a field with the x-radius-endpoint annotation.
+type Endpoint struct {
+	// Name identifies the endpoint within the resource. It is the path of the field, followed by the index or key
+	// of the value for array and map fields, e.g. "url", "urls[0]" or "urls.admin".
+	Name string
+
+	// Visibility is the visibility of the endpoint, either "public" or "internal".
+	Visibility string
+
+	// URL is the value of the field.
+	URL string
+}
+
+// GetEndpointFieldsFromSummary returns the fields with the x-radius-endpoint annotation of the schema of a resource
+// type in a resource provider summary. The schema of the default API version is used, or of the latest API version
+// when no default is set.
+func GetEndpointFieldsFromSummary(resourceType *v20231001preview.ResourceProviderSummaryResourceType) []EndpointField {
+	if resourceType == nil || len(resourceType.APIVersions) == 0 {
+		return nil
+	}
+
+	apiVersion := ""
+	if resourceType.DefaultAPIVersion != nil {
+		apiVersion = *resourceType.DefaultAPIVersion
+	}
+	if _, ok := resourceType.APIVersions[apiVersion]; !ok {
+		apiVersion = slices.Max(slices.Collect(maps.Keys(resourceType.APIVersions)))
+	}
+
+	summary := resourceType.APIVersions[apiVersion]
+	if summary == nil || summary.Schema == nil {
+		return nil
+	}
+
+	return ExtractEndpointFields(summary.Schema)
+}
+
+// ExtractEndpointFields walks the object properties of a schema and returns the fields with the x-radius-endpoint
+// annotation, ordered by path. The nested fields of an endpoint field are not checked.
+func ExtractEndpointFields(schema map[string]any) []EndpointField {
+	fields := []EndpointField{}
+	extractEndpointFields(schema, "", &fields)
+	return fields
+}
+
+func extractEndpointFields(schema map[string]any, prefix string, fields *[]EndpointField) {
+	properties, ok := schema["properties"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	for _, name := range sortedKeys(properties) {
+		field, ok := properties[name].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		path := joinPath(prefix, name)
+		if visibility, ok := field[annotationRadiusEndpoint].(string); ok {
+			*fields = append(*fields, EndpointField{Path: path, Visibility: visibility})
+			continue
+		}
+
+		extractEndpointFields(field, path, fields)
+	}
+}
+
+// GetEndpoints returns the endpoints of a resource from the values of its endpoint fields. Fields that are not set
+// are skipped. A string field is a single endpoint, and each string value of an array or map field is an endpoint.
+func GetEndpoints(properties map[string]any, fields []EndpointField) []Endpoint {
+	endpoints := []Endpoint{}
+	for _, field := range fields {
+		value, ok := GetFieldValue(properties, field.Path)
+		if !ok {
+			continue
+		}
+
+		switch value := value.(type) {
+		case string:
+			if value != "" {
+				endpoints = append(endpoints, Endpoint{Name: field.Path, Visibility: field.Visibility, URL: value})
+			}
+		case []any:
+			for i, item := range value {
+				if url, ok := item.(string); ok && url != "" {
+					endpoints = append(endpoints, Endpoint{Name: field.Path + "[" + strconv.Itoa(i) + "]", Visibility: field.Visibility, URL: url})
+				}
+			}
+		case map[string]any:
+			for _, key := range sortedKeys(value) {
+				if url, ok := value[key].(string); ok && url != "" {
+					endpoints = append(endpoints, Endpoint{Name: joinPath(field.Path, key), Visibility: field.Visibility, URL: url})
+				}
+			}
+		}
+	}
+
+	return endpoints
+}
+
+// checkEndpointAnnotations validates the x-radius-endpoint annotation of a schema and its fields. The annotation is
+// only supported on object properties that are strings, arrays of strings, or maps of strings.
+func checkEndpointAnnotations(schema *openapi3.Schema, path string, inCollection bool, errs *ValidationErrors) {
+	if schema == nil {
+		return
+	}
+
+	if value, exists := schema.Extensions[annotationRadiusEndpoint]; exists {
+		visibility, _ := value.(string)
+		switch {
+		case visibility != EndpointVisibilityPublic && visibility != EndpointVisibilityInternal:
+			errs.Add(NewConstraintError(path, fmt.Sprintf("%s must be either %q or %q", annotationRadiusEndpoint, EndpointVisibilityPublic, EndpointVisibilityInternal)))
+		case path == "":
+			errs.Add(NewConstraintError(path, fmt.Sprintf("%s is only supported on properties", annotationRadiusEndpoint)))
+		case inCollection:
+			errs.Add(NewConstraintError(path, fmt.Sprintf("%s is not supported within array items or additionalProperties", annotationRadiusEndpoint)))
+		case !isEndpointSchema(schema):
+			errs.Add(NewConstraintError(path, fmt.Sprintf("%s is only supported on strings, arrays of strings and maps of strings", annotationRadiusEndpoint)))
+		}
+	}
+
+	for _, name := range sortedKeys(schema.Properties) {
+		if ref := schema.Properties[name]; ref != nil {
+			checkEndpointAnnotations(ref.Value, joinPath(path, name), inCollection, errs)
+		}
+	}
+	if schema.AdditionalProperties.Schema != nil {
+		checkEndpointAnnotations(schema.AdditionalProperties.Schema.Value, joinPath(path, "additionalProperties"), true, errs)
+	}
+	if schema.Items != nil {
+		checkEndpointAnnotations(schema.Items.Value, path+"[]", true, errs)
+	}
+}
+
+// isEndpointSchema returns true if the schema is a string, an array of strings or a map of strings.
+func isEndpointSchema(schema *openapi3.Schema) bool {
+	switch {
+	case schema.Type.Is(openapi3.TypeString):
+		return true
+	case schema.Type.Is(openapi3.TypeArray):
+		return schema.Items != nil && schema.Items.Value != nil && schema.Items.Value.Type.Is(openapi3.TypeString)
+	case schema.Type.Is(openapi3.TypeObject):
+		additional := schema.AdditionalProperties.Schema
+		return len(schema.Properties) == 0 && additional != nil && additional.Value != nil && additional.Value.Type.Is(openapi3.TypeString)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractEndpointFields(t *testing.T) {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"environment": map[string]any{"type": "string"},
+			"url":         map[string]any{"type": "string", "x-radius-endpoint": "public"},
+			"status": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"internalUrls": map[string]any{
+						"type":              "array",
+						"items":             map[string]any{"type": "string"},
+						"x-radius-endpoint": "internal",
+					},
+				},
+			},
+			"port": map[string]any{"type": "integer"},
+		},
+	}
+
+	require.Equal(t, []EndpointField{
+		{Path: "status.internalUrls", Visibility: EndpointVisibilityInternal},
+		{Path: "url", Visibility: EndpointVisibilityPublic},
+	}, ExtractEndpointFields(schema))
+
+	require.Empty(t, ExtractEndpointFields(map[string]any{"type": "object"}))
+}
+
+func TestGetEndpoints(t *testing.T) {
+	fields := []EndpointField{
+		{Path: "url", Visibility: EndpointVisibilityPublic},
+		{Path: "status.internalUrls", Visibility: EndpointVisibilityInternal},
+		{Path: "status.hosts", Visibility: EndpointVisibilityPublic},
+		{Path: "missing", Visibility: EndpointVisibilityPublic},
+	}
+
+	properties := map[string]any{
+		"url": "https://app.example.com",
+		"status": map[string]any{
+			"internalUrls": []any{"http://app.default.svc:80", "", 8080},
+			"hosts": map[string]any{
+				"web":   "https://web.example.com",
+				"admin": "https://admin.example.com",
+			},
+		},
+	}
+
+	require.Equal(t, []Endpoint{
+		{Name: "url", Visibility: EndpointVisibilityPublic, URL: "https://app.example.com"},
+		{Name: "status.internalUrls[0]", Visibility: EndpointVisibilityInternal, URL: "http://app.default.svc:80"},
+		{Name: "status.hosts.admin", Visibility: EndpointVisibilityPublic, URL: "https://admin.example.com"},
+		{Name: "status.hosts.web", Visibility: EndpointVisibilityPublic, URL: "https://web.example.com"},
+	}, GetEndpoints(properties, fields))
+
+	require.Empty(t, GetEndpoints(map[string]any{"url": ""}, fields))
+}
+
+func TestValidator_ValidateSchema_EndpointAnnotations(t *testing.T) {
+	validator := NewValidator()
+
+	withProperties := func(properties map[string]any) map[string]any {
+		properties["environment"] = map[string]any{"type": "string"}
+		return map[string]any{"type": "object", "properties": properties}
+	}
+
+	tests := []struct {
+		name   string
+		schema map[string]any
+		err    string
+	}{
+		{
+			name: "valid annotations",
+			schema: withProperties(map[string]any{
+				"url":  map[string]any{"type": "string", "x-radius-endpoint": "public", "x-radius-readonly": true},
+				"urls": map[string]any{"type": "array", "items": map[string]any{"type": "string"}, "x-radius-endpoint": "internal"},
+				"hosts": map[string]any{
+					"type":                 "object",
+					"additionalProperties": map[string]any{"type": "string"},
+					"x-radius-endpoint":    "public",
+				},
+			}),
+		},
+		{
+			name: "invalid visibility",
+			schema: withProperties(map[string]any{
+				"url": map[string]any{"type": "string", "x-radius-endpoint": "private"},
+			}),
+			err: `ConstraintError error at "url": x-radius-endpoint must be either "public" or "internal"`,
+		},
+		{
+			name: "not a string",
+			schema: withProperties(map[string]any{
+				"port": map[string]any{"type": "integer", "x-radius-endpoint": "public"},
+			}),
+			err: `ConstraintError error at "port": x-radius-endpoint is only supported on strings, arrays of strings and maps of strings`,
+		},
+		{
+			name: "array of objects",
+			schema: withProperties(map[string]any{
+				"urls": map[string]any{
+					"type":              "array",
+					"items":             map[string]any{"type": "object", "properties": map[string]any{"url": map[string]any{"type": "string"}}},
+					"x-radius-endpoint": "public",
+				},
+			}),
+			err: `ConstraintError error at "urls": x-radius-endpoint is only supported on strings, arrays of strings and maps of strings`,
+		},
+		{
+			name: "within array items",
+			schema: withProperties(map[string]any{
+				"ports": map[string]any{
+					"type": "array",
+					"items": map[string]any{
+						"type":       "object",
+						"properties": map[string]any{"url": map[string]any{"type": "string", "x-radius-endpoint": "public"}},
+					},
+				},
+			}),
+			err: `ConstraintError error at "ports[].url": x-radius-endpoint is not supported within array items or additionalProperties`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, err := ConvertToOpenAPISchema(tt.schema)
+			require.NoError(t, err)
+
+			err = validator.ValidateSchema(t.Context(), schema)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGetEndpointFieldsFromSummary(t *testing.T) {
+	schemaWithEndpoint := func(path string) map[string]any {
+		return map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				path: map[string]any{"type": "string", "x-radius-endpoint": "public"},
+			},
+		}
+	}
+
+	resourceType := &v20231001preview.ResourceProviderSummaryResourceType{
+		APIVersions: map[string]*v20231001preview.ResourceTypeSummaryResultAPIVersion{
+			"2025-01-01-preview": {Schema: schemaWithEndpoint("url")},
+			"2025-06-01-preview": {Schema: schemaWithEndpoint("endpoint")},
+			"2025-08-01-preview": {},
+		},
+	}
+
+	t.Run("latest API version", func(t *testing.T) {
+		require.Empty(t, GetEndpointFieldsFromSummary(resourceType))
+	})
+
+	t.Run("default API version", func(t *testing.T) {
+		resourceType.DefaultAPIVersion = new("2025-06-01-preview")
+		require.Equal(t, []EndpointField{{Path: "endpoint", Visibility: EndpointVisibilityPublic}}, GetEndpointFieldsFromSummary(resourceType))
+	})
+
+	t.Run("no API versions", func(t *testing.T) {
+		require.Nil(t, GetEndpointFieldsFromSummary(nil))
+		require.Nil(t, GetEndpointFieldsFromSummary(&v20231001preview.ResourceProviderSummaryResourceType{}))
+	})
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+)
+
+const (
+	// annotationRadiusImmutable marks a field that can be set when the resource is created, but not changed afterwards.
+	annotationRadiusImmutable = "x-radius-immutable"
+
+	// annotationRadiusReadOnly marks a field that is computed by Radius, for example from the outputs of a recipe.
+	annotationRadiusReadOnly = "x-radius-readonly"
+
+	// annotationRadiusWriteOnly marks a field that is accepted in requests but never returned.
+	annotationRadiusWriteOnly = "x-radius-writeonly"
+)
+
+// FieldAnnotations contains the paths of the fields of a schema with the x-radius-immutable, x-radius-readonly and
+// x-radius-writeonly annotations. Paths are in dot notation, e.g. "database.name".
+type FieldAnnotations struct {
+	// Immutable are the paths of the fields that cannot be changed once the resource is created.
+	Immutable []string
+
+	// ReadOnly are the paths of the fields that are computed by Radius and ignored in requests.
+	ReadOnly []string
+
+	// WriteOnly are the paths of the fields that are never returned.
+	WriteOnly []string
+}
+
+// IsEmpty returns true if the schema has no annotated fields.
+func (a *FieldAnnotations) IsEmpty() bool {
+	return a == nil || (len(a.Immutable) == 0 && len(a.ReadOnly) == 0 && len(a.WriteOnly) == 0)
+}
+
+// GetFieldAnnotations fetches the schema for a resource type and API version and returns its annotated fields.
+// It returns nil if the schema is not found or the client is nil.
+func GetFieldAnnotations(ctx context.Context, ucpClient *v20231001preview.ClientFactory, resourceID string, resourceType string, apiVersion string) (*FieldAnnotations, error) {
+	schema, err := GetSchema(ctx, ucpClient, resourceID, resourceType, apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	if schema == nil {
+		return nil, nil
+	}
+
+	return ExtractFieldAnnotations(schema), nil
+}
+
+// ExtractFieldAnnotations walks the object properties of a schema and returns its annotated fields. The nested
+// fields of read-only and write-only fields are not checked since the annotation applies to the whole field.
+func ExtractFieldAnnotations(schema map[string]any) *FieldAnnotations {
+	annotations := &FieldAnnotations{}
+	extractFieldAnnotations(schema, "", annotations)
+	return annotations
+}
+
+func extractFieldAnnotations(schema map[string]any, prefix string, annotations *FieldAnnotations) {
+	properties, ok := schema["properties"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	for _, name := range sortedKeys(properties) {
+		field, ok := properties[name].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		path := joinPath(prefix, name)
+		if isAnnotated(field, annotationRadiusReadOnly) {
+			annotations.ReadOnly = append(annotations.ReadOnly, path)
+			continue
+		}
+		if isAnnotated(field, annotationRadiusWriteOnly) {
+			annotations.WriteOnly = append(annotations.WriteOnly, path)
+			continue
+		}
+		if isAnnotated(field, annotationRadiusImmutable) {
+			annotations.Immutable = append(annotations.Immutable, path)
+		}
+
+		extractFieldAnnotations(field, path, annotations)
+	}
+}
+
+// isAnnotated returns true if the boolean annotation is set to true on the schema.
+func isAnnotated(schema map[string]any, annotation string) bool {
+	value, ok := schema[annotation].(bool)
+	return ok && value
+}
+
+// checkFieldAnnotations validates the x-radius-immutable, x-radius-readonly and x-radius-writeonly annotations of a
+// schema and its fields. The annotations are only supported on object properties, since the items of arrays and
+// maps cannot be matched with the items of the existing resource.
+func checkFieldAnnotations(schema *openapi3.Schema, path string, required bool, inCollection bool, errs *ValidationErrors) {
+	if schema == nil {
+		return
+	}
+
+	annotated := map[string]bool{}
+	for _, annotation := range []string{annotationRadiusImmutable, annotationRadiusReadOnly, annotationRadiusWriteOnly} {
+		value, exists := schema.Extensions[annotation]
+		if !exists {
+			continue
+		}
+
+		enabled, ok := value.(bool)
+		if !ok {
+			errs.Add(NewConstraintError(path, fmt.Sprintf("%s must be a boolean value", annotation)))
+			continue
+		}
+		annotated[annotation] = enabled
+	}
+
+	if annotated[annotationRadiusImmutable] || annotated[annotationRadiusReadOnly] || annotated[annotationRadiusWriteOnly] {
+		switch {
+		case path == "":
+			errs.Add(NewConstraintError(path, fmt.Sprintf("%s, %s and %s are only supported on properties", annotationRadiusImmutable, annotationRadiusReadOnly, annotationRadiusWriteOnly)))
+		case inCollection:
+			errs.Add(NewConstraintError(path, fmt.Sprintf("%s, %s and %s are not supported within array items or additionalProperties", annotationRadiusImmutable, annotationRadiusReadOnly, annotationRadiusWriteOnly)))
+		case annotated[annotationRadiusReadOnly] && (annotated[annotationRadiusImmutable] || annotated[annotationRadiusWriteOnly]):
+			errs.Add(NewConstraintError(path, fmt.Sprintf("%s cannot be combined with %s or %s", annotationRadiusReadOnly, annotationRadiusImmutable, annotationRadiusWriteOnly)))
+		case annotated[annotationRadiusReadOnly] && required:
+			errs.Add(NewConstraintError(path, fmt.Sprintf("%s fields are computed by Radius and cannot be required", annotationRadiusReadOnly)))
+		}
+	}
+
+	for _, name := range sortedKeys(schema.Properties) {
+		if ref := schema.Properties[name]; ref != nil {
+			checkFieldAnnotations(ref.Value, joinPath(path, name), slices.Contains(schema.Required, name), inCollection, errs)
+		}
+	}
+	if schema.AdditionalProperties.Schema != nil {
+		checkFieldAnnotations(schema.AdditionalProperties.Schema.Value, joinPath(path, "additionalProperties"), false, true, errs)
+	}
+	if schema.Items != nil {
+		checkFieldAnnotations(schema.Items.Value, path+"[]", false, true, errs)
+	}
+}
+
+// GetFieldValue returns the value of the field at a path in dot notation, and whether the field is set.
+func GetFieldValue(data map[string]any, path string) (any, bool) {
+	var current any = data
+	for _, segment := range strings.Split(path, ".") {
+		fields, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current, ok = fields[segment]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+// SetFieldValue sets the value of the field at a path in dot notation, creating the objects that contain it.
+// Fields that contain it but are not objects are replaced.
+func SetFieldValue(data map[string]any, path string, value any) {
+	segments := strings.Split(path, ".")
+	current := data
+	for _, segment := range segments[:len(segments)-1] {
+		next, ok := current[segment].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			current[segment] = next
+		}
+		current = next
+	}
+
+	current[segments[len(segments)-1]] = value
+}
+
+// RemoveFields deletes the fields at the given paths in dot notation. Missing fields are skipped.
+func RemoveFields(data map[string]any, paths []string) {
+	for _, path := range paths {
+		segments := strings.Split(path, ".")
+		current := data
+		for _, segment := range segments[:len(segments)-1] {
+			next, ok := current[segment].(map[string]any)
+			if !ok {
+				current = nil
+				break
+			}
+			current = next
+		}
+
+		if current != nil {
+			delete(current, segments[len(segments)-1])
+		}
+	}
+}
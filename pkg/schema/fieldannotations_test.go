@@ -0,0 +1,157 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractFieldAnnotations(t *testing.T) {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"environment": map[string]any{"type": "string"},
+			"database": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"name":     map[string]any{"type": "string", "x-radius-immutable": true},
+					"password": map[string]any{"type": "string", "x-radius-writeonly": true},
+				},
+			},
+			"host": map[string]any{"type": "string", "x-radius-readonly": true},
+			"status": map[string]any{
+				"type":              "object",
+				"x-radius-readonly": true,
+				"properties": map[string]any{
+					"ready": map[string]any{"type": "boolean", "x-radius-immutable": true},
+				},
+			},
+			"region": map[string]any{"type": "string", "x-radius-immutable": false},
+		},
+	}
+
+	require.Equal(t, &FieldAnnotations{
+		Immutable: []string{"database.name"},
+		ReadOnly:  []string{"host", "status"},
+		WriteOnly: []string{"database.password"},
+	}, ExtractFieldAnnotations(schema))
+
+	require.True(t, ExtractFieldAnnotations(map[string]any{"type": "object"}).IsEmpty())
+}
+
+func TestValidator_ValidateSchema_FieldAnnotations(t *testing.T) {
+	validator := NewValidator()
+
+	withProperties := func(properties map[string]any, required ...any) map[string]any {
+		properties["environment"] = map[string]any{"type": "string"}
+		schema := map[string]any{"type": "object", "properties": properties}
+		if len(required) > 0 {
+			schema["required"] = required
+		}
+		return schema
+	}
+
+	tests := []struct {
+		name   string
+		schema map[string]any
+		err    string
+	}{
+		{
+			name: "valid annotations",
+			schema: withProperties(map[string]any{
+				"name":     map[string]any{"type": "string", "x-radius-immutable": true, "x-radius-writeonly": true},
+				"host":     map[string]any{"type": "string", "x-radius-readonly": true},
+				"password": map[string]any{"type": "string", "x-radius-writeonly": true, "x-radius-sensitive": true},
+			}, "name"),
+		},
+		{
+			name: "not a boolean",
+			schema: withProperties(map[string]any{
+				"name": map[string]any{"type": "string", "x-radius-immutable": "yes"},
+			}),
+			err: `ConstraintError error at "name": x-radius-immutable must be a boolean value`,
+		},
+		{
+			name: "read-only and write-only",
+			schema: withProperties(map[string]any{
+				"host": map[string]any{"type": "string", "x-radius-readonly": true, "x-radius-writeonly": true},
+			}),
+			err: `ConstraintError error at "host": x-radius-readonly cannot be combined with x-radius-immutable or x-radius-writeonly`,
+		},
+		{
+			name: "required read-only",
+			schema: withProperties(map[string]any{
+				"host": map[string]any{"type": "string", "x-radius-readonly": true},
+			}, "host"),
+			err: `ConstraintError error at "host": x-radius-readonly fields are computed by Radius and cannot be required`,
+		},
+		{
+			name: "within array items",
+			schema: withProperties(map[string]any{
+				"ports": map[string]any{
+					"type": "array",
+					"items": map[string]any{
+						"type":       "object",
+						"properties": map[string]any{"port": map[string]any{"type": "integer", "x-radius-immutable": true}},
+					},
+				},
+			}),
+			err: `ConstraintError error at "ports[].port": x-radius-immutable, x-radius-readonly and x-radius-writeonly are not supported within array items or additionalProperties`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, err := ConvertToOpenAPISchema(tt.schema)
+			require.NoError(t, err)
+
+			err = validator.ValidateSchema(t.Context(), schema)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFieldValues(t *testing.T) {
+	data := map[string]any{
+		"database": map[string]any{"name": "orders", "password": "secret"},
+		"host":     "db.example.com",
+	}
+
+	value, ok := GetFieldValue(data, "database.name")
+	require.True(t, ok)
+	require.Equal(t, "orders", value)
+
+	_, ok = GetFieldValue(data, "database.port")
+	require.False(t, ok)
+	_, ok = GetFieldValue(data, "host.name")
+	require.False(t, ok)
+
+	SetFieldValue(data, "status.ready", true)
+	require.Equal(t, map[string]any{"ready": true}, data["status"])
+
+	RemoveFields(data, []string{"database.password", "host", "missing.field"})
+	require.Equal(t, map[string]any{
+		"database": map[string]any{"name": "orders"},
+		"status":   map[string]any{"ready": true},
+	}, data)
+}
@@ -0,0 +1,386 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+
+	"cel.dev/cel-go/cel"
+	"cel.dev/cel-go/ext"
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// annotationRadiusValidations declares CEL validation rules on a schema.
+const annotationRadiusValidations = "x-radius-validations"
+
+// validationRuleCostLimit limits the cost of evaluating a single validation rule, so that a rule cannot make every
+// request to the resource type expensive.
+const validationRuleCostLimit = 1_000_000
+
+// ValidationRule is a CEL rule declared with x-radius-validations on the schema of an object, an array or a field:
+//
+//	x-radius-validations:
+//	  - rule: "self.autoscale || self.replicas > 0"
+//	    message: replicas must be greater than 0 when autoscale is false
+//	  - rule: "self.ports.all(p, self.ports.exists_one(q, q.port == p.port))"
+//	    message: port must be unique across the ports array
+//
+// The rule is evaluated with self bound to the value the schema describes, and must evaluate to true. Rules that
+// reference oldSelf are transition rules: they are only evaluated when an existing resource is updated, with
+// oldSelf bound to the existing value, e.g. "self == oldSelf" makes a field immutable.
+type ValidationRule struct {
+	// Rule is the CEL expression of the rule.
+	Rule string
+
+	// Message is the error message returned when the rule evaluates to false.
+	Message string
+}
+
+// compiledRule is a validation rule compiled into a CEL program.
+type compiledRule struct {
+	ValidationRule
+
+	program cel.Program
+
+	// transition is true if the rule references oldSelf.
+	transition bool
+}
+
+// validationRuleEnv is the CEL environment that validation rules are compiled in.
+var validationRuleEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("self", cel.DynType),
+		cel.Variable("oldSelf", cel.DynType),
+		cel.CrossTypeNumericComparisons(true),
+		ext.Strings(),
+		ext.Sets(),
+	)
+})
+
+// ParseValidationRules parses the value of the x-radius-validations annotation. It returns nil if the value is nil.
+func ParseValidationRules(value any) ([]ValidationRule, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	items, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an array", annotationRadiusValidations)
+	}
+
+	rules := make([]ValidationRule, 0, len(items))
+	for i, item := range items {
+		prefix := fmt.Sprintf("%s[%d]", annotationRadiusValidations, i)
+
+		fields, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s must be an object", prefix)
+		}
+
+		rule := ValidationRule{}
+		for _, key := range sortedKeys(fields) {
+			switch key {
+			case "rule":
+				expression, ok := fields[key].(string)
+				if !ok || strings.TrimSpace(expression) == "" {
+					return nil, fmt.Errorf("%s.rule must be a non-empty CEL expression", prefix)
+				}
+				rule.Rule = expression
+			case "message":
+				message, ok := fields[key].(string)
+				if !ok {
+					return nil, fmt.Errorf("%s.message must be a string", prefix)
+				}
+				rule.Message = message
+			default:
+				return nil, fmt.Errorf("%s has unsupported field %q, expected rule or message", prefix, key)
+			}
+		}
+
+		if rule.Rule == "" {
+			return nil, fmt.Errorf("%s.rule must be a non-empty CEL expression", prefix)
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// compiledProgram is the result of compiling the CEL expression of a validation rule.
+type compiledProgram struct {
+	program cel.Program
+
+	// transition is true if the expression references oldSelf.
+	transition bool
+
+	err error
+}
+
+// compiledPrograms caches the compiled programs of validation rules by CEL expression, since the schema of a
+// resource type is fetched for every request. The program of an expression does not depend on the schema it is
+// declared on, so updating a schema does not make the cache stale. CEL programs are safe for concurrent use.
+var compiledPrograms sync.Map
+
+// compileValidationRules parses and compiles the x-radius-validations rules of a schema. Transition rules are not
+// allowed within array items since the items of the new and the existing array cannot be correlated.
+func compileValidationRules(schema *openapi3.Schema, inArray bool) ([]*compiledRule, error) {
+	rules, err := ParseValidationRules(schema.Extensions[annotationRadiusValidations])
+	if err != nil || len(rules) == 0 {
+		return nil, err
+	}
+
+	compiled := make([]*compiledRule, 0, len(rules))
+	for _, rule := range rules {
+		result := compileProgram(rule.Rule)
+		if result.err != nil {
+			return nil, result.err
+		}
+		if result.transition && inArray {
+			return nil, fmt.Errorf("%s rule %q references oldSelf, which is not supported within array items", annotationRadiusValidations, rule.Rule)
+		}
+
+		compiled = append(compiled, &compiledRule{ValidationRule: rule, program: result.program, transition: result.transition})
+	}
+
+	return compiled, nil
+}
+
+// compileProgram compiles the CEL expression of a validation rule, or returns the cached program of the expression.
+func compileProgram(expression string) *compiledProgram {
+	if cached, ok := compiledPrograms.Load(expression); ok {
+		return cached.(*compiledProgram)
+	}
+
+	result := &compiledProgram{}
+	result.program, result.transition, result.err = newProgram(expression)
+	cached, _ := compiledPrograms.LoadOrStore(expression, result)
+	return cached.(*compiledProgram)
+}
+
+// newProgram compiles the CEL expression of a validation rule into a program, and reports whether the expression
+// references oldSelf.
+func newProgram(expression string) (cel.Program, bool, error) {
+	env, err := validationRuleEnv()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, false, fmt.Errorf("%s rule %q does not compile: %w", annotationRadiusValidations, expression, issues.Err())
+	}
+
+	if outputType := ast.OutputType(); !outputType.IsExactType(cel.BoolType) && !outputType.IsExactType(cel.DynType) {
+		return nil, false, fmt.Errorf("%s rule %q must evaluate to a boolean, got %s", annotationRadiusValidations, expression, outputType)
+	}
+
+	transition := false
+	for _, reference := range ast.NativeRep().ReferenceMap() {
+		if reference.Name == "oldSelf" {
+			transition = true
+			break
+		}
+	}
+
+	program, err := env.Program(ast, cel.CostLimit(validationRuleCostLimit))
+	if err != nil {
+		return nil, false, fmt.Errorf("%s rule %q does not compile: %w", annotationRadiusValidations, expression, err)
+	}
+
+	return program, transition, nil
+}
+
+// checkValidationRules compiles the x-radius-validations rules declared anywhere in the schema.
+func checkValidationRules(schema *openapi3.Schema, path string, inArray bool, errs *ValidationErrors) {
+	if schema == nil {
+		return
+	}
+
+	if _, err := compileValidationRules(schema, inArray); err != nil {
+		errs.Add(NewConstraintError(path, err.Error()))
+	}
+
+	for _, name := range sortedKeys(schema.Properties) {
+		if ref := schema.Properties[name]; ref != nil {
+			checkValidationRules(ref.Value, joinPath(path, name), inArray, errs)
+		}
+	}
+	if schema.AdditionalProperties.Schema != nil {
+		checkValidationRules(schema.AdditionalProperties.Schema.Value, joinPath(path, "additionalProperties"), inArray, errs)
+	}
+	if schema.Items != nil {
+		checkValidationRules(schema.Items.Value, path+"[]", true, errs)
+	}
+}
+
+// ValidateRules evaluates the x-radius-validations rules of a schema against the properties of a resource.
+//
+// oldProperties are the existing properties of the resource in the same API version, or nil if the resource is
+// being created. Rules declared on a field are only evaluated when the field is set, and transition rules are only
+// evaluated when the field is also set in oldProperties. All failed rules are reported in the returned error.
+func ValidateRules(schemaData any, properties map[string]any, oldProperties map[string]any) error {
+	if schemaData == nil {
+		return nil
+	}
+
+	openAPISchema, err := ConvertToOpenAPISchema(schemaData)
+	if err != nil {
+		return fmt.Errorf("failed to convert schema: %w", err)
+	}
+
+	if properties == nil {
+		properties = map[string]any{}
+	}
+
+	var failures []string
+	if err := evaluateRules(openAPISchema, "", properties, oldProperties, oldProperties != nil, false, &failures); err != nil {
+		return err
+	}
+	if len(failures) > 0 {
+		return errors.New(strings.Join(failures, "; "))
+	}
+
+	return nil
+}
+
+// evaluateRules evaluates the rules of the schema against value, and then the rules of the schemas of its fields
+// and items.
+func evaluateRules(schema *openapi3.Schema, path string, value any, oldValue any, hasOld bool, inArray bool, failures *[]string) error {
+	if schema == nil {
+		return nil
+	}
+
+	rules, err := compileValidationRules(schema, inArray)
+	if err != nil {
+		return fmt.Errorf("invalid validation rules at %q: %w", path, err)
+	}
+
+	if len(rules) > 0 {
+		vars := map[string]any{"self": celValue(schema, value)}
+		if hasOld {
+			vars["oldSelf"] = celValue(schema, oldValue)
+		}
+
+		for _, rule := range rules {
+			if rule.transition && !hasOld {
+				continue
+			}
+
+			if message := evaluateRule(rule, vars); message != "" {
+				if path != "" {
+					message = fmt.Sprintf("error at %q: %s", path, message)
+				}
+				*failures = append(*failures, message)
+			}
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		oldFields, _ := oldValue.(map[string]any)
+		for _, name := range sortedKeys(v) {
+			propertySchema := fieldSchema(schema, name)
+			if propertySchema == nil {
+				continue
+			}
+
+			oldField, hasOldField := oldFields[name]
+			if err := evaluateRules(propertySchema, joinPath(path, name), v[name], oldField, hasOld && hasOldField, inArray, failures); err != nil {
+				return err
+			}
+		}
+	case []any:
+		if schema.Items == nil {
+			return nil
+		}
+		for i, item := range v {
+			if err := evaluateRules(schema.Items.Value, fmt.Sprintf("%s[%d]", path, i), item, nil, false, true, failures); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// evaluateRule evaluates a rule and returns the message to report if it fails, or an empty string if it passes.
+func evaluateRule(rule *compiledRule, vars map[string]any) string {
+	message := rule.Message
+	if message == "" {
+		message = fmt.Sprintf("failed rule: %s", rule.Rule)
+	}
+
+	out, _, err := rule.program.Eval(vars)
+	if err != nil {
+		return fmt.Sprintf("%s (rule could not be evaluated: %s)", message, err.Error())
+	}
+
+	if passed, ok := out.Value().(bool); !ok || !passed {
+		return message
+	}
+
+	return ""
+}
+
+// fieldSchema returns the schema of a field of an object, which is either a declared property or an additional
+// property. It returns nil if the field has no schema.
+func fieldSchema(schema *openapi3.Schema, name string) *openapi3.Schema {
+	if ref := schema.Properties[name]; ref != nil {
+		return ref.Value
+	}
+	if schema.AdditionalProperties.Schema != nil {
+		return schema.AdditionalProperties.Schema.Value
+	}
+	return nil
+}
+
+// celValue copies a JSON value for evaluation by CEL. Numbers of integer fields are converted to integers, so that
+// rules can use integer arithmetic and compare them with integer literals.
+func celValue(schema *openapi3.Schema, value any) any {
+	switch v := value.(type) {
+	case float64:
+		if schema != nil && schema.Type.Is(openapi3.TypeInteger) && v == math.Trunc(v) {
+			return int64(v)
+		}
+	case map[string]any:
+		copied := make(map[string]any, len(v))
+		for key, item := range v {
+			var itemSchema *openapi3.Schema
+			if schema != nil {
+				itemSchema = fieldSchema(schema, key)
+			}
+			copied[key] = celValue(itemSchema, item)
+		}
+		return copied
+	case []any:
+		var itemSchema *openapi3.Schema
+		if schema != nil && schema.Items != nil {
+			itemSchema = schema.Items.Value
+		}
+		copied := make([]any, len(v))
+		for i, item := range v {
+			copied[i] = celValue(itemSchema, item)
+		}
+		return copied
+	}
+
+	return value
+}
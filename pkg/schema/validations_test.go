@@ -0,0 +1,271 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseValidationRules(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected []ValidationRule
+		err      string
+	}{
+		{
+			name:  "nil",
+			value: nil,
+		},
+		{
+			name: "rules",
+			value: []any{
+				map[string]any{"rule": "self.replicas > 0", "message": "replicas must be positive"},
+				map[string]any{"rule": "self == oldSelf"},
+			},
+			expected: []ValidationRule{
+				{Rule: "self.replicas > 0", Message: "replicas must be positive"},
+				{Rule: "self == oldSelf"},
+			},
+		},
+		{
+			name:  "not an array",
+			value: map[string]any{"rule": "true"},
+			err:   "x-radius-validations must be an array",
+		},
+		{
+			name:  "missing rule",
+			value: []any{map[string]any{"message": "oops"}},
+			err:   "x-radius-validations[0].rule must be a non-empty CEL expression",
+		},
+		{
+			name:  "unsupported field",
+			value: []any{map[string]any{"rule": "true", "reason": "FieldValueInvalid"}},
+			err:   `x-radius-validations[0] has unsupported field "reason", expected rule or message`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, err := ParseValidationRules(tt.value)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, rules)
+		})
+	}
+}
+
+func TestValidator_ValidateSchema_ValidationRules(t *testing.T) {
+	validator := NewValidator()
+
+	tests := []struct {
+		name   string
+		schema map[string]any
+		err    string
+	}{
+		{
+			name: "valid rules",
+			schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"environment": map[string]any{"type": "string"},
+					"replicas":    map[string]any{"type": "integer", "x-radius-validations": []any{map[string]any{"rule": "self == oldSelf"}}},
+					"autoscale":   map[string]any{"type": "boolean"},
+				},
+				"x-radius-validations": []any{
+					map[string]any{"rule": "self.autoscale || self.replicas > 0", "message": "replicas must be greater than 0 when autoscale is false"},
+				},
+			},
+		},
+		{
+			name: "rule does not compile",
+			schema: map[string]any{
+				"type":                 "object",
+				"properties":           map[string]any{"environment": map[string]any{"type": "string"}},
+				"x-radius-validations": []any{map[string]any{"rule": "self.replicas >"}},
+			},
+			err: `x-radius-validations rule "self.replicas >" does not compile`,
+		},
+		{
+			name: "rule is not a boolean",
+			schema: map[string]any{
+				"type":                 "object",
+				"properties":           map[string]any{"environment": map[string]any{"type": "string"}},
+				"x-radius-validations": []any{map[string]any{"rule": "'replicas'"}},
+			},
+			err: `x-radius-validations rule "'replicas'" must evaluate to a boolean, got string`,
+		},
+		{
+			name: "transition rule in array items",
+			schema: map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"environment": map[string]any{"type": "string"},
+					"ports": map[string]any{
+						"type": "array",
+						"items": map[string]any{
+							"type":                 "object",
+							"properties":           map[string]any{"port": map[string]any{"type": "integer"}},
+							"x-radius-validations": []any{map[string]any{"rule": "self.port == oldSelf.port"}},
+						},
+					},
+				},
+			},
+			err: `ConstraintError error at "ports[]": x-radius-validations rule "self.port == oldSelf.port" references oldSelf, which is not supported within array items`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema, err := ConvertToOpenAPISchema(tt.schema)
+			require.NoError(t, err)
+
+			err = validator.ValidateSchema(t.Context(), schema)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateRules(t *testing.T) {
+	schema := map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"replicas":  map[string]any{"type": "integer"},
+			"autoscale": map[string]any{"type": "boolean"},
+			"region": map[string]any{
+				"type":                 "string",
+				"x-radius-validations": []any{map[string]any{"rule": "self == oldSelf", "message": "region is immutable"}},
+			},
+			"ports": map[string]any{
+				"type": "array",
+				"items": map[string]any{
+					"type":       "object",
+					"properties": map[string]any{"port": map[string]any{"type": "integer"}},
+					"x-radius-validations": []any{
+						map[string]any{"rule": "self.port % 2 == 0", "message": "port must be even"},
+					},
+				},
+				"x-radius-validations": []any{
+					map[string]any{"rule": "self.all(p, self.exists_one(q, q.port == p.port))", "message": "port must be unique across the ports array"},
+				},
+			},
+		},
+		"x-radius-validations": []any{
+			map[string]any{"rule": "self.autoscale || self.replicas > 0", "message": "replicas must be greater than 0 when autoscale is false"},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		properties    map[string]any
+		oldProperties map[string]any
+		err           string
+	}{
+		{
+			name:       "valid",
+			properties: map[string]any{"autoscale": false, "replicas": float64(2), "ports": []any{map[string]any{"port": float64(80)}, map[string]any{"port": float64(8080)}}},
+		},
+		{
+			name:       "cross-field rule",
+			properties: map[string]any{"autoscale": false, "replicas": float64(0)},
+			err:        "replicas must be greater than 0 when autoscale is false",
+		},
+		{
+			name:       "array and item rules",
+			properties: map[string]any{"autoscale": true, "ports": []any{map[string]any{"port": float64(80)}, map[string]any{"port": float64(80)}, map[string]any{"port": float64(81)}}},
+			err:        `error at "ports": port must be unique across the ports array; error at "ports[2]": port must be even`,
+		},
+		{
+			name:       "transition rule is skipped on create",
+			properties: map[string]any{"autoscale": true, "region": "westus"},
+		},
+		{
+			name:          "transition rule passes",
+			properties:    map[string]any{"autoscale": true, "region": "westus"},
+			oldProperties: map[string]any{"autoscale": true, "region": "westus"},
+		},
+		{
+			name:          "transition rule fails",
+			properties:    map[string]any{"autoscale": true, "region": "eastus"},
+			oldProperties: map[string]any{"autoscale": true, "region": "westus"},
+			err:           `error at "region": region is immutable`,
+		},
+		{
+			name:          "transition rule is skipped when the field was not set",
+			properties:    map[string]any{"autoscale": true, "region": "eastus"},
+			oldProperties: map[string]any{"autoscale": true},
+		},
+		{
+			name:       "rule cannot be evaluated",
+			properties: map[string]any{"autoscale": false},
+			err:        "replicas must be greater than 0 when autoscale is false (rule could not be evaluated: no such key: replicas)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRules(schema, tt.properties, tt.oldProperties)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("nil schema", func(t *testing.T) {
+		require.NoError(t, ValidateRules(nil, map[string]any{}, nil))
+	})
+}
+
+func TestCompileValidationRules_Cache(t *testing.T) {
+	schema := &openapi3.Schema{
+		Extensions: map[string]any{
+			annotationRadiusValidations: []any{
+				map[string]any{"rule": "self.cached > 0", "message": "cached must be positive"},
+				map[string]any{"rule": "self.cached == oldSelf.cached"},
+			},
+		},
+	}
+
+	first, err := compileValidationRules(schema, false)
+	require.NoError(t, err)
+	second, err := compileValidationRules(schema, false)
+	require.NoError(t, err)
+
+	// The programs are compiled once and shared by every schema that declares the same rule.
+	require.Len(t, second, 2)
+	for i := range first {
+		require.Same(t, first[i].program, second[i].program)
+	}
+	require.False(t, second[0].transition)
+	require.True(t, second[1].transition)
+
+	// Transition rules are still rejected within array items when the program is cached.
+	_, err = compileValidationRules(schema, true)
+	require.ErrorContains(t, err, "references oldSelf, which is not supported within array items")
+}
@@ -283,6 +283,25 @@ func (v *Validator) ValidateSchema(ctx context.Context, schema *openapi3.Schema)
 		}
 	}
 
+	// Check API version annotations at root level only
+	if err := ValidateVersionAnnotations(schema.Extensions); err != nil {
+		errors.Add(NewConstraintError("", err.Error()))
+	}
+
+	// Check custom actions at root level only
+	if _, err := ParseActions(schema.Extensions[annotationRadiusActions]); err != nil {
+		errors.Add(NewConstraintError("", err.Error()))
+	}
+
+	// Check that the CEL validation rules compile
+	checkValidationRules(schema, "", false, &errors)
+
+	// Check the immutable, read-only and write-only annotations of the fields
+	checkFieldAnnotations(schema, "", false, false, &errors)
+
+	// Check the endpoint annotations of the fields
+	checkEndpointAnnotations(schema, "", false, &errors)
+
 	// Check Radius-specific constraints
 	if err := v.validateRadiusConstraints(schema); err != nil {
 		// If it's already a ValidationErrors collection, merge it
@@ -17,24 +17,52 @@ limitations under the License.
 package sdk
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"os"
 
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"k8s.io/client-go/transport"
 )
 
 var _ Connection = (*directConnection)(nil)
 
-// directConnection represents a connection to a Radius API endpoint with no authentication
-// or intermediate systems. This is mostly used for test scenarios.
+// directConnection represents a connection to a Radius API endpoint with no intermediate systems. Without
+// DirectConnectionOptions it uses no authentication, which is mostly used for test scenarios.
 type directConnection struct {
-	endpoint string
+	endpoint  string
+	transport http.RoundTripper
+}
+
+// DirectConnectionOptions describes how a direct connection authenticates with a remote Radius API endpoint.
+type DirectConnectionOptions struct {
+	// TokenFile is the path of a file containing a bearer token, such as a projected service account token.
+	// The file is re-read periodically so that rotated tokens are picked up.
+	TokenFile string
+
+	// CertFile and KeyFile are the paths of a PEM encoded client certificate and key. They are re-read for
+	// each new TLS connection so that rotated certificates are picked up.
+	CertFile string
+	KeyFile  string
+
+	// CAFile is the path of a PEM encoded CA bundle used to verify the endpoint instead of the system roots.
+	CAFile string
 }
 
 // NewDirectConnection parses the given endpoint string and returns a direct connection if the endpoint uses the http or
 // https scheme, otherwise it returns an error.
 func NewDirectConnection(endpoint string) (Connection, error) {
+	return NewDirectConnectionWithOptions(endpoint, DirectConnectionOptions{})
+}
+
+// NewDirectConnectionWithOptions parses the given endpoint string and returns a direct connection that authenticates
+// using the given options. It returns an error if the endpoint does not use the http or https scheme, if the options
+// are inconsistent, or if the CA bundle cannot be loaded.
+func NewDirectConnectionWithOptions(endpoint string, options DirectConnectionOptions) (Connection, error) {
 	parsed, err := url.Parse(endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse endpoint %q: %w", endpoint, err)
@@ -44,16 +72,70 @@ func NewDirectConnection(endpoint string) (Connection, error) {
 		return nil, fmt.Errorf("the endpoint must use the http or https scheme (got %q)", endpoint)
 	}
 
+	rt, err := newDirectTransport(options)
+	if err != nil {
+		return nil, err
+	}
+
 	return &directConnection{
-		endpoint: endpoint,
+		endpoint:  endpoint,
+		transport: otelhttp.NewTransport(rt),
 	}, nil
 }
 
+// newDirectTransport builds the http.RoundTripper used by a direct connection with the given options.
+func newDirectTransport(options DirectConnectionOptions) (http.RoundTripper, error) {
+	if (options.CertFile == "") != (options.KeyFile == "") {
+		return nil, errors.New("the client certificate and key files must be specified together")
+	}
+
+	if options.CertFile == "" && options.CAFile == "" && options.TokenFile == "" {
+		return http.DefaultTransport, nil
+	}
+
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	base.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if options.CAFile != "" {
+		b, err := os.ReadFile(options.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file %q: %w", options.CAFile, err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, fmt.Errorf("the CA file %q does not contain any PEM encoded certificates", options.CAFile)
+		}
+		base.TLSClientConfig.RootCAs = pool
+	}
+
+	if options.CertFile != "" {
+		// Fail fast on a missing or invalid key pair rather than on the first request.
+		if _, err := tls.LoadX509KeyPair(options.CertFile, options.KeyFile); err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+
+		base.TLSClientConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, err := tls.LoadX509KeyPair(options.CertFile, options.KeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load client certificate: %w", err)
+			}
+			return &cert, nil
+		}
+	}
+
+	if options.TokenFile != "" {
+		return transport.TokenSourceWrapTransport(transport.NewCachedFileTokenSource(options.TokenFile))(base), nil
+	}
+
+	return base, nil
+}
+
 // Client returns an http.Client for communicating with Radius. This satisfies both the
 // autorest.Sender interface (autorest Track1 Go SDK) and policy.Transporter interface
 // (autorest Track2 Go SDK).
 func (c *directConnection) Client() *http.Client {
-	return &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
+	return &http.Client{Transport: c.transport}
 }
 
 // Endpoint returns the endpoint (aka. base URL) of the Radius API. This definitely includes
@@ -18,6 +18,9 @@ package sdk
 
 import (
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -57,3 +60,66 @@ func Test_NewDirectConnection_InvalidWithoutScheme(t *testing.T) {
 	require.Contains(t, err.Error(), "the endpoint must use the http or https scheme")
 	require.Nil(t, connection)
 }
+
+func Test_NewDirectConnectionWithOptions_TokenFile(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+	}))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("my-token\n"), 0600))
+
+	connection, err := NewDirectConnectionWithOptions(server.URL, DirectConnectionOptions{TokenFile: tokenFile})
+	require.NoError(t, err)
+
+	request, err := http.NewRequestWithContext(t.Context(), http.MethodGet, connection.Endpoint(), nil)
+	require.NoError(t, err)
+	response, err := connection.Client().Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	require.Equal(t, "Bearer my-token", authorization)
+}
+
+func Test_NewDirectConnectionWithOptions_Invalid(t *testing.T) {
+	dir := t.TempDir()
+	invalidFile := filepath.Join(dir, "invalid.pem")
+	require.NoError(t, os.WriteFile(invalidFile, []byte("not a certificate"), 0600))
+
+	tests := []struct {
+		name    string
+		options DirectConnectionOptions
+		err     string
+	}{
+		{
+			name:    "cert without key",
+			options: DirectConnectionOptions{CertFile: invalidFile},
+			err:     "the client certificate and key files must be specified together",
+		},
+		{
+			name:    "invalid key pair",
+			options: DirectConnectionOptions{CertFile: invalidFile, KeyFile: invalidFile},
+			err:     "failed to load client certificate",
+		},
+		{
+			name:    "missing CA file",
+			options: DirectConnectionOptions{CAFile: filepath.Join(dir, "missing.pem")},
+			err:     "failed to read CA file",
+		},
+		{
+			name:    "invalid CA file",
+			options: DirectConnectionOptions{CAFile: invalidFile},
+			err:     "does not contain any PEM encoded certificates",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			connection, err := NewDirectConnectionWithOptions("https://example.com", tt.options)
+			require.ErrorContains(t, err, tt.err)
+			require.Nil(t, connection)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
+	"github.com/radius-project/radius/pkg/components/queue/queueprovider"
+	corerp_dm "github.com/radius-project/radius/pkg/corerp/datamodel"
+	dapr_ctrl "github.com/radius-project/radius/pkg/daprrp/frontend/controller"
+	ds_ctrl "github.com/radius-project/radius/pkg/datastoresrp/frontend/controller"
+	msg_ctrl "github.com/radius-project/radius/pkg/messagingrp/frontend/controller"
+	"github.com/radius-project/radius/pkg/recipes/controllerconfig"
+	"github.com/radius-project/radius/pkg/recipes/drift"
+)
+
+// recipeResourceTypes are the Applications.* resource types that can be deployed by recipes.
+var recipeResourceTypes = []string{
+	corerp_dm.ExtenderResourceType,
+	dapr_ctrl.DaprConfigurationStoresResourceType,
+	dapr_ctrl.DaprPubSubBrokersResourceType,
+	dapr_ctrl.DaprSecretStoresResourceType,
+	dapr_ctrl.DaprStateStoresResourceType,
+	ds_ctrl.MongoDatabasesResourceType,
+	ds_ctrl.RedisCachesResourceType,
+	ds_ctrl.SqlDatabasesResourceType,
+	msg_ctrl.RabbitMQQueuesResourceType,
+}
+
+// RecipeDriftService is a service to run the recipe drift detector for Applications.* resources.
+type RecipeDriftService struct {
+	options hostoptions.HostOptions
+}
+
+// NewRecipeDriftService creates a new service to run the recipe drift detector.
+func NewRecipeDriftService(options hostoptions.HostOptions) *RecipeDriftService {
+	return &RecipeDriftService{options: options}
+}
+
+// Name represents the service name.
+func (s *RecipeDriftService) Name() string {
+	return "radiusrecipedriftdetector"
+}
+
+// Run starts the recipe drift detector.
+func (s *RecipeDriftService) Run(ctx context.Context) error {
+	var interval time.Duration
+	if s.options.Config.RecipeDrift.Interval != "" {
+		var err error
+		interval, err = time.ParseDuration(s.options.Config.RecipeDrift.Interval)
+		if err != nil {
+			return fmt.Errorf("failed to parse recipe drift interval: %w", err)
+		}
+	}
+
+	databaseClient, err := databaseprovider.FromOptions(s.options.Config.DatabaseProvider).GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	queueClient, err := queueprovider.New(s.options.Config.QueueProvider).GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	config, err := controllerconfig.New(s.options)
+	if err != nil {
+		return err
+	}
+
+	detector := drift.NewDetector(drift.Options{
+		DatabaseClient:      databaseClient,
+		StatusManager:       statusmanager.New(databaseClient, queueClient, s.options.Config.Env.RoleLocation),
+		Engine:              config.Engine,
+		ConfigurationLoader: config.ConfigLoader,
+		ResourceTypes: func(ctx context.Context) ([]string, error) {
+			return recipeResourceTypes, nil
+		},
+		Interval: interval,
+	})
+
+	return detector.Run(ctx)
+}
@@ -39,8 +39,14 @@ func (src *APIVersionResource) ConvertTo() (v1.DataModelInterface, error) {
 		},
 	}
 
+	lifecycle, err := toLifecycleDataModel(src.Properties.Lifecycle)
+	if err != nil {
+		return nil, err
+	}
+
 	dst.Properties = datamodel.APIVersionProperties{
-		Schema: src.Properties.Schema,
+		Schema:    src.Properties.Schema,
+		Lifecycle: lifecycle,
 	}
 
 	return dst, nil
@@ -62,6 +68,7 @@ func (dst *APIVersionResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Properties = &APIVersionProperties{
 		ProvisioningState: new(ProvisioningState(dm.InternalMetadata.AsyncProvisioningState)),
 		Schema:            dm.Properties.Schema,
+		Lifecycle:         fromLifecycleDataModel(dm.Properties.Lifecycle),
 	}
 
 	return nil
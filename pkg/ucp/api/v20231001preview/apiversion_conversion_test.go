@@ -19,6 +19,7 @@ package v20231001preview
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
@@ -50,6 +51,28 @@ func Test_APIVersion_VersionedToDataModel(t *testing.T) {
 				Properties: datamodel.APIVersionProperties{},
 			},
 		},
+		{
+			filename: "apiversion_resource_lifecycle.json",
+			expected: &datamodel.APIVersion{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources/apiVersions/2025-01-01",
+						Name: "2025-01-01",
+						Type: datamodel.APIVersionResourceType,
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.APIVersionProperties{
+					Lifecycle: &datamodel.ResourceTypeLifecycle{
+						State:      datamodel.LifecycleStateDeprecated,
+						SunsetDate: new(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+						Message:    new("Use API version 2026-01-01 instead."),
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -110,3 +133,19 @@ func Test_APIVersion_DataModelToVersioned(t *testing.T) {
 		})
 	}
 }
+
+func Test_APIVersion_VersionedToDataModel_InvalidLifecycle(t *testing.T) {
+	versioned := &APIVersionResource{
+		Properties: &APIVersionProperties{
+			Lifecycle: &ResourceTypeLifecycle{State: new(LifecycleState("Sunset"))},
+		},
+	}
+
+	_, err := versioned.ConvertTo()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `lifecycle state "Sunset" is not recognized`)
+
+	versioned.Properties.Lifecycle = &ResourceTypeLifecycle{}
+	_, err = versioned.ConvertTo()
+	require.Contains(t, err.Error(), "lifecycle state is required")
+}
@@ -17,7 +17,11 @@ limitations under the License.
 package v20231001preview
 
 import (
+	"fmt"
+	"strings"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 )
 
 func fromProvisioningStateDataModel(state v1.ProvisioningState) *ProvisioningState {
@@ -39,3 +43,42 @@ func fromSystemDataModel(s v1.SystemData) *SystemData {
 		LastModifiedAt:     v1.UnmarshalTimeString(s.LastModifiedAt),
 	}
 }
+
+func toLifecycleDataModel(lifecycle *ResourceTypeLifecycle) (*datamodel.ResourceTypeLifecycle, error) {
+	if lifecycle == nil {
+		return nil, nil
+	}
+
+	if lifecycle.State == nil {
+		return nil, v1.NewClientErrInvalidRequest("lifecycle state is required")
+	}
+
+	var state datamodel.LifecycleState
+	for _, value := range PossibleLifecycleStateValues() {
+		if strings.EqualFold(string(value), string(*lifecycle.State)) {
+			state = datamodel.LifecycleState(value)
+			break
+		}
+	}
+	if state == "" {
+		return nil, v1.NewClientErrInvalidRequest(fmt.Sprintf("lifecycle state %q is not recognized. Supported states: %v", *lifecycle.State, PossibleLifecycleStateValues()))
+	}
+
+	return &datamodel.ResourceTypeLifecycle{
+		State:      state,
+		SunsetDate: lifecycle.SunsetDate,
+		Message:    lifecycle.Message,
+	}, nil
+}
+
+func fromLifecycleDataModel(lifecycle *datamodel.ResourceTypeLifecycle) *ResourceTypeLifecycle {
+	if lifecycle == nil {
+		return nil
+	}
+
+	return &ResourceTypeLifecycle{
+		State:      new(LifecycleState(lifecycle.State)),
+		SunsetDate: lifecycle.SunsetDate,
+		Message:    lifecycle.Message,
+	}
+}
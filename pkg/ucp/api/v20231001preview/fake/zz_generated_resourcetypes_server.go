@@ -36,6 +36,10 @@ type ResourceTypesServer struct {
 	// HTTP status codes to indicate success: http.StatusOK
 	GetIcon func(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, hashParam string, options *v20231001preview.ResourceTypesClientGetIconOptions) (resp azfake.Responder[v20231001preview.ResourceTypesClientGetIconResponse], errResp azfake.ErrorResponder)
 
+	// GetUsage is the fake for method ResourceTypesClient.GetUsage
+	// HTTP status codes to indicate success: http.StatusOK
+	GetUsage func(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, options *v20231001preview.ResourceTypesClientGetUsageOptions) (resp azfake.Responder[v20231001preview.ResourceTypesClientGetUsageResponse], errResp azfake.ErrorResponder)
+
 	// NewListPager is the fake for method ResourceTypesClient.NewListPager
 	// HTTP status codes to indicate success: http.StatusOK
 	NewListPager func(planeName string, resourceProviderName string, options *v20231001preview.ResourceTypesClientListOptions) (resp azfake.PagerResponder[v20231001preview.ResourceTypesClientListResponse])
@@ -91,6 +95,8 @@ func (r *ResourceTypesServerTransport) dispatchToMethodFake(req *http.Request, m
 				res.resp, res.err = r.dispatchGet(req)
 			case "ResourceTypesClient.GetIcon":
 				res.resp, res.err = r.dispatchGetIcon(req)
+			case "ResourceTypesClient.GetUsage":
+				res.resp, res.err = r.dispatchGetUsage(req)
 			case "ResourceTypesClient.NewListPager":
 				res.resp, res.err = r.dispatchNewListPager(req)
 			default:
@@ -296,6 +302,43 @@ func (r *ResourceTypesServerTransport) dispatchGetIcon(req *http.Request) (*http
 	return resp, nil
 }
 
+func (r *ResourceTypesServerTransport) dispatchGetUsage(req *http.Request) (*http.Response, error) {
+	if r.srv.GetUsage == nil {
+		return nil, &nonRetriableError{errors.New("fake for method GetUsage not implemented")}
+	}
+	const regexStr = `/planes/radius/(?P<planeName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/providers/System\.Resources/resourceproviders/(?P<resourceProviderName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/resourcetypes/(?P<resourceTypeName>[!#&$-;=?-\[\]_a-zA-Z0-9~%@]+)/usage`
+	regex := regexp.MustCompile(regexStr)
+	matches := regex.FindStringSubmatch(req.URL.EscapedPath())
+	if len(matches) < 4 {
+		return nil, fmt.Errorf("failed to parse path %s", req.URL.Path)
+	}
+	planeNameParam, err := url.PathUnescape(matches[regex.SubexpIndex("planeName")])
+	if err != nil {
+		return nil, err
+	}
+	resourceProviderNameParam, err := url.PathUnescape(matches[regex.SubexpIndex("resourceProviderName")])
+	if err != nil {
+		return nil, err
+	}
+	resourceTypeNameParam, err := url.PathUnescape(matches[regex.SubexpIndex("resourceTypeName")])
+	if err != nil {
+		return nil, err
+	}
+	respr, errRespr := r.srv.GetUsage(req.Context(), planeNameParam, resourceProviderNameParam, resourceTypeNameParam, nil)
+	if respErr := server.GetError(errRespr, req); respErr != nil {
+		return nil, respErr
+	}
+	respContent := server.GetResponseContent(respr)
+	if !slices.Contains([]int{http.StatusOK}, respContent.HTTPStatus) {
+		return nil, &nonRetriableError{fmt.Errorf("unexpected status code %d. acceptable values are http.StatusOK", respContent.HTTPStatus)}
+	}
+	resp, err := server.MarshalResponseAsJSON(respContent, server.GetResponse(respr).ResourceTypeUsage, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (r *ResourceTypesServerTransport) dispatchNewListPager(req *http.Request) (*http.Response, error) {
 	if r.srv.NewListPager == nil {
 		return nil, &nonRetriableError{errors.New("fake for method NewListPager not implemented")}
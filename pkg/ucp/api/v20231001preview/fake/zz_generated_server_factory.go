@@ -29,6 +29,12 @@ type ServerFactory struct {
 	// AzurePlanesServer contains the fakes for client AzurePlanesClient
 	AzurePlanesServer AzurePlanesServer
 
+	// GcpCredentialsServer contains the fakes for client GcpCredentialsClient
+	GcpCredentialsServer GcpCredentialsServer
+
+	// GcpPlanesServer contains the fakes for client GcpPlanesClient
+	GcpPlanesServer GcpPlanesServer
+
 	// LocationsServer contains the fakes for client LocationsClient
 	LocationsServer LocationsServer
 
@@ -70,6 +76,8 @@ type ServerFactoryTransport struct {
 	trAwsPlanesServer         *AwsPlanesServerTransport
 	trAzureCredentialsServer  *AzureCredentialsServerTransport
 	trAzurePlanesServer       *AzurePlanesServerTransport
+	trGcpCredentialsServer    *GcpCredentialsServerTransport
+	trGcpPlanesServer         *GcpPlanesServerTransport
 	trLocationsServer         *LocationsServerTransport
 	trPlanesServer            *PlanesServerTransport
 	trRadiusPlanesServer      *RadiusPlanesServerTransport
@@ -111,6 +119,14 @@ func (s *ServerFactoryTransport) Do(req *http.Request) (*http.Response, error) {
 	case "AzurePlanesClient":
 		initServer(&s.trMu, &s.trAzurePlanesServer, func() *AzurePlanesServerTransport { return NewAzurePlanesServerTransport(&s.srv.AzurePlanesServer) })
 		resp, err = s.trAzurePlanesServer.Do(req)
+	case "GcpCredentialsClient":
+		initServer(&s.trMu, &s.trGcpCredentialsServer, func() *GcpCredentialsServerTransport {
+			return NewGcpCredentialsServerTransport(&s.srv.GcpCredentialsServer)
+		})
+		resp, err = s.trGcpCredentialsServer.Do(req)
+	case "GcpPlanesClient":
+		initServer(&s.trMu, &s.trGcpPlanesServer, func() *GcpPlanesServerTransport { return NewGcpPlanesServerTransport(&s.srv.GcpPlanesServer) })
+		resp, err = s.trGcpPlanesServer.Do(req)
 	case "LocationsClient":
 		initServer(&s.trMu, &s.trLocationsServer, func() *LocationsServerTransport { return NewLocationsServerTransport(&s.srv.LocationsServer) })
 		resp, err = s.trLocationsServer.Do(req)
@@ -0,0 +1,166 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"fmt"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+const (
+	// GCPCredentialType represents the ucp gcp credential type value.
+	GCPCredentialType = "System.GCP/credentials"
+)
+
+// ConvertTo converts from the versioned Credential resource to version-agnostic datamodel.
+func (cr *GcpCredentialResource) ConvertTo() (v1.DataModelInterface, error) {
+	prop, err := cr.getDataModelCredentialProperties()
+	if err != nil {
+		return nil, err
+	}
+
+	converted := &datamodel.GCPCredential{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:       to.String(cr.ID),
+				Name:     to.String(cr.Name),
+				Type:     to.String(cr.Type),
+				Location: to.String(cr.Location),
+				Tags:     to.StringMap(cr.Tags),
+			},
+			InternalMetadata: v1.InternalMetadata{
+				UpdatedAPIVersion: Version,
+			},
+		},
+		Properties: prop,
+	}
+
+	return converted, nil
+}
+
+func (cr *GcpCredentialResource) getDataModelCredentialProperties() (*datamodel.GCPCredentialResourceProperties, error) {
+	if cr.Properties == nil {
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"}
+	}
+
+	switch p := cr.Properties.(type) {
+	case *GcpServiceAccountKeyCredentialProperties:
+		storage, err := toCredentialStorageDataModel(p.Storage)
+		if err != nil {
+			return nil, err
+		}
+
+		return &datamodel.GCPCredentialResourceProperties{
+			Kind: datamodel.GCPServiceAccountKeyCredentialKind,
+			GCPCredential: &datamodel.GCPCredentialProperties{
+				Kind: datamodel.GCPServiceAccountKeyCredentialKind,
+				ServiceAccountKeyCredential: &datamodel.GCPServiceAccountKeyCredentialProperties{
+					ServiceAccountKey: to.String(p.ServiceAccountKey),
+				},
+			},
+			Storage: storage,
+		}, nil
+	case *GcpWorkloadIdentityCredentialProperties:
+		storage, err := toCredentialStorageDataModel(p.Storage)
+		if err != nil {
+			return nil, err
+		}
+
+		return &datamodel.GCPCredentialResourceProperties{
+			Kind: datamodel.GCPWorkloadIdentityCredentialKind,
+			GCPCredential: &datamodel.GCPCredentialProperties{
+				Kind: datamodel.GCPWorkloadIdentityCredentialKind,
+				WorkloadIdentityCredential: &datamodel.GCPWorkloadIdentityCredentialProperties{
+					Audience:            to.String(p.Audience),
+					ServiceAccountEmail: to.String(p.ServiceAccountEmail),
+				},
+			},
+			Storage: storage,
+		}, nil
+
+	default:
+		return nil, v1.ErrInvalidModelConversion
+	}
+}
+
+// toCredentialStorageDataModel converts the versioned credential storage properties to the datamodel.
+func toCredentialStorageDataModel(s CredentialStoragePropertiesClassification) (*datamodel.CredentialStorageProperties, error) {
+	switch c := s.(type) {
+	case *InternalCredentialStorageProperties:
+		if c.Kind == nil {
+			return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage.kind", ValidValue: fmt.Sprintf("one of %q", PossibleCredentialStorageKindValues())}
+		}
+		return &datamodel.CredentialStorageProperties{
+			Kind: datamodel.InternalStorageKind,
+			InternalCredential: &datamodel.InternalCredentialStorageProperties{
+				SecretName: to.String(c.SecretName),
+			},
+		}, nil
+	case nil:
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage", ValidValue: "not nil"}
+	default:
+		return nil, &v1.ErrModelConversion{PropertyName: "$.properties.storage.kind", ValidValue: fmt.Sprintf("one of %q", PossibleCredentialStorageKindValues())}
+	}
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned Credential resource.
+func (dst *GcpCredentialResource) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.GCPCredential)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ID = &dm.ID
+	dst.Name = &dm.Name
+	dst.Type = &dm.Type
+	dst.Location = &dm.Location
+	dst.Tags = *to.StringMapPtr(dm.Tags)
+
+	var storage CredentialStoragePropertiesClassification
+	switch dm.Properties.Storage.Kind {
+	case datamodel.InternalStorageKind:
+		storage = &InternalCredentialStorageProperties{
+			Kind:       new(CredentialStorageKindInternal),
+			SecretName: new(dm.Properties.Storage.InternalCredential.SecretName),
+		}
+	default:
+		return v1.ErrInvalidModelConversion
+	}
+
+	// DO NOT convert any secret values to versioned model.
+	switch dm.Properties.Kind {
+	case datamodel.GCPServiceAccountKeyCredentialKind:
+		dst.Properties = &GcpServiceAccountKeyCredentialProperties{
+			Kind:    new(GCPCredentialKind(dm.Properties.Kind)),
+			Storage: storage,
+		}
+	case datamodel.GCPWorkloadIdentityCredentialKind:
+		dst.Properties = &GcpWorkloadIdentityCredentialProperties{
+			Kind:                new(GCPCredentialKind(dm.Properties.Kind)),
+			Audience:            new(dm.Properties.GCPCredential.WorkloadIdentityCredential.Audience),
+			ServiceAccountEmail: new(dm.Properties.GCPCredential.WorkloadIdentityCredential.ServiceAccountEmail),
+			Storage:             storage,
+		}
+	default:
+		return v1.ErrInvalidModelConversion
+	}
+
+	return nil
+}
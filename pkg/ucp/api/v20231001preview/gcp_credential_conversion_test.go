@@ -0,0 +1,212 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/test/testutil"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGCPCredentialConvertVersionedToDataModel(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *datamodel.GCPCredential
+		err      error
+	}{
+		{
+			filename: "credentialresource-gcp-serviceaccountkey.json",
+			expected: &datamodel.GCPCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/gcp/gcp/providers/System.GCP/credentials/default",
+						Name:     "default",
+						Type:     "System.GCP/credentials",
+						Location: "global",
+						Tags: map[string]string{
+							"env": "dev",
+						},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: &datamodel.GCPCredentialResourceProperties{
+					Kind: "ServiceAccountKey",
+					GCPCredential: &datamodel.GCPCredentialProperties{
+						Kind: datamodel.GCPServiceAccountKeyCredentialKind,
+						ServiceAccountKeyCredential: &datamodel.GCPServiceAccountKeyCredentialProperties{
+							ServiceAccountKey: `{"type":"service_account","project_id":"my-project"}`,
+						},
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind:               datamodel.InternalStorageKind,
+						InternalCredential: &datamodel.InternalCredentialStorageProperties{},
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresource-gcp-workloadidentity.json",
+			expected: &datamodel.GCPCredential{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:       "/planes/gcp/gcp/providers/System.GCP/credentials/default",
+						Name:     "default",
+						Type:     "System.GCP/credentials",
+						Location: "global",
+						Tags: map[string]string{
+							"env": "dev",
+						},
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: &datamodel.GCPCredentialResourceProperties{
+					Kind: "WorkloadIdentity",
+					GCPCredential: &datamodel.GCPCredentialProperties{
+						Kind: datamodel.GCPWorkloadIdentityCredentialKind,
+						WorkloadIdentityCredential: &datamodel.GCPWorkloadIdentityCredentialProperties{
+							Audience:            "//iam.googleapis.com/projects/123456789/locations/global/workloadIdentityPools/radius/providers/kubernetes",
+							ServiceAccountEmail: "radius@my-project.iam.gserviceaccount.com",
+						},
+					},
+					Storage: &datamodel.CredentialStorageProperties{
+						Kind:               datamodel.InternalStorageKind,
+						InternalCredential: &datamodel.InternalCredentialStorageProperties{},
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresource-other.json",
+			err:      v1.ErrInvalidModelConversion,
+		},
+		{
+			filename: "credentialresource-empty-properties.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties", ValidValue: "not nil"},
+		},
+		{
+			filename: "credentialresource-empty-storage-gcp.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.storage", ValidValue: "not nil"},
+		},
+		{
+			filename: "credentialresource-empty-storage-kind-gcp.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.storage.kind", ValidValue: "not nil"},
+		},
+		{
+			filename: "credentialresource-invalid-storagekind-gcp.json",
+			err:      &v1.ErrModelConversion{PropertyName: "$.properties.storage.kind", ValidValue: fmt.Sprintf("one of %q", PossibleCredentialStorageKindValues())},
+		},
+	}
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			r := &GcpCredentialResource{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+
+			dm, err := r.ConvertTo()
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				ct := dm.(*datamodel.GCPCredential)
+				require.Equal(t, tt.expected, ct)
+			}
+		})
+	}
+}
+
+func TestGCPCredentialConvertDataModelToVersioned(t *testing.T) {
+	conversionTests := []struct {
+		filename string
+		expected *GcpCredentialResource
+		err      error
+	}{
+		{
+			filename: "credentialresourcedatamodel-gcp-serviceaccountkey.json",
+			expected: &GcpCredentialResource{
+				ID:       new("/planes/gcp/gcp/providers/System.GCP/credentials/default"),
+				Name:     new("default"),
+				Type:     new("System.GCP/credentials"),
+				Location: new("global"),
+				Tags: map[string]*string{
+					"env": new("dev"),
+				},
+				Properties: &GcpServiceAccountKeyCredentialProperties{
+					Kind: new(GCPCredentialKindServiceAccountKey),
+					Storage: &InternalCredentialStorageProperties{
+						Kind:       new(CredentialStorageKindInternal),
+						SecretName: new("gcp-gcp-default"),
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresourcedatamodel-gcp-workloadidentity.json",
+			expected: &GcpCredentialResource{
+				ID:       new("/planes/gcp/gcp/providers/System.GCP/credentials/default"),
+				Name:     new("default"),
+				Type:     new("System.GCP/credentials"),
+				Location: new("global"),
+				Tags: map[string]*string{
+					"env": new("dev"),
+				},
+				Properties: &GcpWorkloadIdentityCredentialProperties{
+					Kind:                new(GCPCredentialKindWorkloadIdentity),
+					Audience:            new("//iam.googleapis.com/projects/123456789/locations/global/workloadIdentityPools/radius/providers/kubernetes"),
+					ServiceAccountEmail: new("radius@my-project.iam.gserviceaccount.com"),
+					Storage: &InternalCredentialStorageProperties{
+						Kind:       new(CredentialStorageKindInternal),
+						SecretName: new("gcp-gcp-default"),
+					},
+				},
+			},
+		},
+		{
+			filename: "credentialresourcedatamodel-default.json",
+			err:      v1.ErrInvalidModelConversion,
+		},
+	}
+	for _, tt := range conversionTests {
+		t.Run(tt.filename, func(t *testing.T) {
+			rawPayload := testutil.ReadFixture(tt.filename)
+			r := &datamodel.GCPCredential{}
+			err := json.Unmarshal(rawPayload, r)
+			require.NoError(t, err)
+
+			versioned := &GcpCredentialResource{}
+			err = versioned.ConvertFrom(r)
+
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tt.expected, versioned)
+			}
+		})
+	}
+}
@@ -17,6 +17,9 @@ limitations under the License.
 package v20231001preview
 
 import (
+	"fmt"
+	"strings"
+
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/to"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
@@ -39,11 +42,24 @@ func (src *LocationResource) ConvertTo() (v1.DataModelInterface, error) {
 		},
 	}
 
+	routingPolicy, err := toLocationRoutingPolicyDatamodel(src.Properties.RoutingPolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	dst.Properties = datamodel.LocationProperties{
 		Address:       src.Properties.Address,
+		Addresses:     to.StringArray(src.Properties.Addresses),
+		RoutingPolicy: routingPolicy,
 		ResourceTypes: map[string]datamodel.LocationResourceTypeConfiguration{},
 	}
 
+	if src.Properties.HealthProbe != nil {
+		dst.Properties.HealthProbe = &datamodel.LocationHealthProbe{
+			Path: src.Properties.HealthProbe.Path,
+		}
+	}
+
 	for name, value := range src.Properties.ResourceTypes {
 		dst.Properties.ResourceTypes[name] = toLocationResourceTypeDatamodel(value)
 	}
@@ -67,9 +83,20 @@ func (dst *LocationResource) ConvertFrom(src v1.DataModelInterface) error {
 	dst.Properties = &LocationProperties{
 		ProvisioningState: new(ProvisioningState(dm.InternalMetadata.AsyncProvisioningState)),
 		Address:           dm.Properties.Address,
+		Addresses:         to.ArrayofStringPtrs(dm.Properties.Addresses),
 		ResourceTypes:     map[string]*LocationResourceType{},
 	}
 
+	if dm.Properties.RoutingPolicy != "" {
+		dst.Properties.RoutingPolicy = new(LocationRoutingPolicy(dm.Properties.RoutingPolicy))
+	}
+
+	if dm.Properties.HealthProbe != nil {
+		dst.Properties.HealthProbe = &LocationHealthProbe{
+			Path: dm.Properties.HealthProbe.Path,
+		}
+	}
+
 	for name, value := range dm.Properties.ResourceTypes {
 		dst.Properties.ResourceTypes[name] = fromLocationResourceTypeDatamodel(value)
 	}
@@ -114,3 +141,17 @@ func fromLocationAPIVersionDatamodel(_ datamodel.LocationAPIVersionConfiguration
 	}
 	return dst
 }
+
+func toLocationRoutingPolicyDatamodel(src *LocationRoutingPolicy) (datamodel.LocationRoutingPolicy, error) {
+	if src == nil {
+		return "", nil
+	}
+
+	for _, value := range PossibleLocationRoutingPolicyValues() {
+		if strings.EqualFold(string(value), string(*src)) {
+			return datamodel.LocationRoutingPolicy(value), nil
+		}
+	}
+
+	return "", v1.NewClientErrInvalidRequest(fmt.Sprintf("routing policy %q is not recognized. Supported policies: %v", *src, PossibleLocationRoutingPolicyValues()))
+}
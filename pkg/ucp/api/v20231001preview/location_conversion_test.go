@@ -59,6 +59,38 @@ func Test_Location_VersionedToDataModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "location_resource_failover.json",
+			expected: &datamodel.Location{
+				BaseResource: v1.BaseResource{
+					TrackedResource: v1.TrackedResource{
+						ID:   "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/locations/east",
+						Name: "east",
+						Type: datamodel.LocationResourceType,
+					},
+					InternalMetadata: v1.InternalMetadata{
+						UpdatedAPIVersion: Version,
+					},
+				},
+				Properties: datamodel.LocationProperties{
+					Address:       new("https://east.myrp.com"),
+					Addresses:     []string{"https://east2.myrp.com"},
+					RoutingPolicy: datamodel.LocationRoutingPolicyRoundRobin,
+					HealthProbe:   &datamodel.LocationHealthProbe{Path: new("/healthz")},
+					ResourceTypes: map[string]datamodel.LocationResourceTypeConfiguration{
+						"testResources": {
+							APIVersions: map[string]datamodel.LocationAPIVersionConfiguration{
+								"2025-01-01": {},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			filename: "location_resource_invalidroutingpolicy.json",
+			err:      &v1.ErrClientRP{},
+		},
 	}
 
 	for _, tt := range conversionTests {
@@ -105,6 +137,28 @@ func Test_Location_DataModelToVersioned(t *testing.T) {
 				},
 			},
 		},
+		{
+			filename: "location_datamodel_failover.json",
+			expected: &LocationResource{
+				ID:   new("/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/locations/east"),
+				Type: to.Ptr(datamodel.LocationResourceType),
+				Name: new("east"),
+				Properties: &LocationProperties{
+					ProvisioningState: new(ProvisioningStateSucceeded),
+					Address:           new("https://east.myrp.com"),
+					Addresses:         []*string{new("https://east2.myrp.com")},
+					RoutingPolicy:     new(LocationRoutingPolicyRoundRobin),
+					HealthProbe:       &LocationHealthProbe{Path: new("/healthz")},
+					ResourceTypes: map[string]*LocationResourceType{
+						"testResources": {
+							APIVersions: map[string]*LocationResourceTypeAPIVersion{
+								"2025-01-01": {},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range conversionTests {
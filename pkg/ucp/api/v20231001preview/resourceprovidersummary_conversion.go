@@ -41,8 +41,10 @@ func (dst *ResourceProviderSummary) ConvertFrom(src v1.DataModelInterface) error
 	dst.Name = new(dm.Name)
 
 	dst.Locations = map[string]*ResourceProviderSummaryLocation{}
-	for locationName := range dm.Properties.Locations {
-		dst.Locations[locationName] = &ResourceProviderSummaryLocation{}
+	for locationName, location := range dm.Properties.Locations {
+		dst.Locations[locationName] = &ResourceProviderSummaryLocation{
+			Health: fromLocationHealthDatamodel(location.Health),
+		}
 	}
 
 	dst.ResourceTypes = map[string]*ResourceProviderSummaryResourceType{}
@@ -51,7 +53,8 @@ func (dst *ResourceProviderSummary) ConvertFrom(src v1.DataModelInterface) error
 		apiVersions := map[string]*ResourceTypeSummaryResultAPIVersion{}
 		for k, v := range resourceType.APIVersions {
 			apiVersions[k] = &ResourceTypeSummaryResultAPIVersion{
-				Schema: v.Schema,
+				Schema:    v.Schema,
+				Lifecycle: fromLifecycleDataModel(v.Lifecycle),
 			}
 		}
 
@@ -62,8 +65,30 @@ func (dst *ResourceProviderSummary) ConvertFrom(src v1.DataModelInterface) error
 			Description:       resourceType.Description,
 			Icon:              resourceType.Icon,
 			IconHash:          resourceType.IconHash,
+			Lifecycle:         fromLifecycleDataModel(resourceType.Lifecycle),
 		}
 	}
 
 	return nil
 }
+
+func fromLocationHealthDatamodel(src *datamodel.LocationHealth) *LocationHealth {
+	if src == nil {
+		return nil
+	}
+
+	dst := &LocationHealth{
+		Status: new(LocationHealthStatus(src.Status)),
+	}
+
+	for _, address := range src.Addresses {
+		dst.Addresses = append(dst.Addresses, &LocationAddressHealth{
+			Address:       new(address.Address),
+			Status:        new(LocationHealthStatus(address.Status)),
+			LastProbeTime: address.LastProbeTime,
+			Message:       address.Message,
+		})
+	}
+
+	return dst
+}
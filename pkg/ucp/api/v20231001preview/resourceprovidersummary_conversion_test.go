@@ -19,6 +19,7 @@ package v20231001preview
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/test/testutil"
@@ -110,3 +111,69 @@ func Test_ResourceProviderSummary_Icon_DataModelToVersioned(t *testing.T) {
 	require.NotNil(t, rt.IconHash)
 	require.Equal(t, "deadbeef", *rt.IconHash)
 }
+
+func Test_ResourceProviderSummary_Lifecycle_DataModelToVersioned(t *testing.T) {
+	dm := &datamodel.ResourceProviderSummary{
+		Properties: datamodel.ResourceProviderSummaryProperties{
+			ResourceTypes: map[string]datamodel.ResourceProviderSummaryPropertiesResourceType{
+				"testResources": {
+					Lifecycle: &datamodel.ResourceTypeLifecycle{State: datamodel.LifecycleStateGA},
+					APIVersions: map[string]datamodel.ResourceProviderSummaryPropertiesAPIVersion{
+						"2025-01-01": {
+							Lifecycle: &datamodel.ResourceTypeLifecycle{
+								State:   datamodel.LifecycleStateRetired,
+								Message: new("Use API version 2026-01-01 instead."),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	dm.Name = "Applications.Test"
+
+	versioned := &ResourceProviderSummary{}
+	err := versioned.ConvertFrom(dm)
+	require.NoError(t, err)
+
+	rt := versioned.ResourceTypes["testResources"]
+	require.Equal(t, &ResourceTypeLifecycle{State: new(LifecycleStateGA)}, rt.Lifecycle)
+	require.Equal(t, &ResourceTypeLifecycle{
+		State:   new(LifecycleStateRetired),
+		Message: new("Use API version 2026-01-01 instead."),
+	}, rt.APIVersions["2025-01-01"].Lifecycle)
+}
+
+func Test_ResourceProviderSummary_LocationHealth_DataModelToVersioned(t *testing.T) {
+	probeTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	dm := &datamodel.ResourceProviderSummary{
+		Properties: datamodel.ResourceProviderSummaryProperties{
+			Locations: map[string]datamodel.ResourceProviderSummaryPropertiesLocation{
+				"east": {
+					Health: &datamodel.LocationHealth{
+						Status: datamodel.LocationHealthStatusDegraded,
+						Addresses: []datamodel.LocationAddressHealth{
+							{Address: "https://east.myrp.com", Status: datamodel.LocationHealthStatusUnhealthy, LastProbeTime: &probeTime, Message: new("connection refused")},
+							{Address: "https://east2.myrp.com", Status: datamodel.LocationHealthStatusHealthy, LastProbeTime: &probeTime},
+						},
+					},
+				},
+				"west": {},
+			},
+		},
+	}
+	dm.Name = "Applications.Test"
+
+	versioned := &ResourceProviderSummary{}
+	err := versioned.ConvertFrom(dm)
+	require.NoError(t, err)
+
+	require.Equal(t, &ResourceProviderSummaryLocation{}, versioned.Locations["west"])
+	require.Equal(t, &LocationHealth{
+		Status: new(LocationHealthStatusDegraded),
+		Addresses: []*LocationAddressHealth{
+			{Address: new("https://east.myrp.com"), Status: new(LocationHealthStatusUnhealthy), LastProbeTime: &probeTime, Message: new("connection refused")},
+			{Address: new("https://east2.myrp.com"), Status: new(LocationHealthStatusHealthy), LastProbeTime: &probeTime},
+		},
+	}, versioned.Locations["east"].Health)
+}
@@ -61,6 +61,12 @@ func (src *ResourceTypeResource) ConvertTo() (v1.DataModelInterface, error) {
 
 	dst.Properties.Description = src.Properties.Description
 
+	lifecycle, err := toLifecycleDataModel(src.Properties.Lifecycle)
+	if err != nil {
+		return nil, err
+	}
+	dst.Properties.Lifecycle = lifecycle
+
 	// The icon is written by the client as verbatim SVG bytes. The hash is
 	// server-computed (read-only on the wire) so it content-addresses exactly
 	// the bytes that were stored.
@@ -106,6 +112,7 @@ func (dst *ResourceTypeResource) ConvertFrom(src v1.DataModelInterface) error {
 		Description:       dm.Properties.Description,
 		Icon:              dm.Properties.Icon,
 		IconHash:          dm.Properties.IconHash,
+		Lifecycle:         fromLifecycleDataModel(dm.Properties.Lifecycle),
 	}
 
 	return nil
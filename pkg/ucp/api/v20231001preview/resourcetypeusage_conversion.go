@@ -0,0 +1,53 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"errors"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/to"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+// ConvertTo converts from the versioned ResourceTypeUsage resource to version-agnostic datamodel.
+//
+// NOTE: ResourceTypeUsage is READONLY. There is no conversion from versioned to datamodel.
+func (src *ResourceTypeUsage) ConvertTo() (v1.DataModelInterface, error) {
+	return nil, errors.New("the ResourceTypeUsage is READONLY. There is no conversion from versioned to datamodel")
+}
+
+// ConvertFrom converts from version-agnostic datamodel to the versioned ResourceTypeUsage resource.
+func (dst *ResourceTypeUsage) ConvertFrom(src v1.DataModelInterface) error {
+	dm, ok := src.(*datamodel.ResourceTypeUsage)
+	if !ok {
+		return v1.ErrInvalidModelConversion
+	}
+
+	dst.ResourceType = new(dm.ResourceType)
+	dst.Count = new(int32(dm.Count()))
+
+	dst.APIVersions = map[string]*APIVersionUsage{}
+	for apiVersion, ids := range dm.APIVersions {
+		dst.APIVersions[apiVersion] = &APIVersionUsage{
+			Count:     new(int32(len(ids))),
+			Resources: to.SliceOfPtrs(ids...),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v20231001preview
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ResourceTypeUsage_DataModelToVersioned(t *testing.T) {
+	dm := &datamodel.ResourceTypeUsage{
+		ResourceType: "Applications.Test/testResources",
+		APIVersions: map[string][]string{
+			"2025-01-01": {
+				"/planes/radius/local/resourceGroups/a/providers/Applications.Test/testResources/one",
+				"/planes/radius/local/resourceGroups/b/providers/Applications.Test/testResources/two",
+			},
+			"2026-01-01": {
+				"/planes/radius/local/resourceGroups/a/providers/Applications.Test/testResources/three",
+			},
+		},
+	}
+
+	versioned := &ResourceTypeUsage{}
+	err := versioned.ConvertFrom(dm)
+	require.NoError(t, err)
+
+	require.Equal(t, "Applications.Test/testResources", *versioned.ResourceType)
+	require.Equal(t, int32(3), *versioned.Count)
+	require.Equal(t, int32(2), *versioned.APIVersions["2025-01-01"].Count)
+	require.Equal(t, "/planes/radius/local/resourceGroups/a/providers/Applications.Test/testResources/three", *versioned.APIVersions["2026-01-01"].Resources[0])
+}
+
+func Test_ResourceTypeUsage_ConvertFrom_InvalidModel(t *testing.T) {
+	versioned := &ResourceTypeUsage{}
+	err := versioned.ConvertFrom(&datamodel.ResourceType{})
+	require.ErrorIs(t, err, v1.ErrInvalidModelConversion)
+}
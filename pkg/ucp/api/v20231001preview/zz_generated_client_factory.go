@@ -63,6 +63,20 @@ func (c *ClientFactory) NewAzurePlanesClient() *AzurePlanesClient {
 	}
 }
 
+// NewGcpCredentialsClient creates a new instance of GcpCredentialsClient.
+func (c *ClientFactory) NewGcpCredentialsClient() *GcpCredentialsClient {
+	return &GcpCredentialsClient{
+		internal: c.internal,
+	}
+}
+
+// NewGcpPlanesClient creates a new instance of GcpPlanesClient.
+func (c *ClientFactory) NewGcpPlanesClient() *GcpPlanesClient {
+	return &GcpPlanesClient{
+		internal: c.internal,
+	}
+}
+
 // NewLocationsClient creates a new instance of LocationsClient.
 func (c *ClientFactory) NewLocationsClient() *LocationsClient {
 	return &LocationsClient{
@@ -84,6 +84,91 @@ func PossibleCredentialStorageKindValues() []CredentialStorageKind {
 	}
 }
 
+// GCPCredentialKind - GCP credential kind
+type GCPCredentialKind string
+
+const (
+	// GCPCredentialKindServiceAccountKey - The GCP service account key credential
+	GCPCredentialKindServiceAccountKey GCPCredentialKind = "ServiceAccountKey"
+	// GCPCredentialKindWorkloadIdentity - GCP workload identity federation. For more information, please see: https://cloud.google.com/iam/docs/workload-identity-federation-with-kubernetes
+	GCPCredentialKindWorkloadIdentity GCPCredentialKind = "WorkloadIdentity"
+)
+
+// PossibleGCPCredentialKindValues returns the possible values for the GCPCredentialKind const type.
+func PossibleGCPCredentialKindValues() []GCPCredentialKind {
+	return []GCPCredentialKind{
+		GCPCredentialKindServiceAccountKey,
+		GCPCredentialKindWorkloadIdentity,
+	}
+}
+
+// LifecycleState - The lifecycle state of a resource type or API version.
+type LifecycleState string
+
+const (
+	// LifecycleStateDeprecated - The resource type or API version is deprecated. Existing resources keep working, and new resources
+	// should use a replacement.
+	LifecycleStateDeprecated LifecycleState = "Deprecated"
+	// LifecycleStateGA - The resource type or API version is generally available.
+	LifecycleStateGA LifecycleState = "GA"
+	// LifecycleStatePreview - The resource type or API version is in preview and may change.
+	LifecycleStatePreview LifecycleState = "Preview"
+	// LifecycleStateRetired - The resource type or API version is retired and cannot be used to create new resources.
+	LifecycleStateRetired LifecycleState = "Retired"
+)
+
+// PossibleLifecycleStateValues returns the possible values for the LifecycleState const type.
+func PossibleLifecycleStateValues() []LifecycleState {
+	return []LifecycleState{
+		LifecycleStateDeprecated,
+		LifecycleStateGA,
+		LifecycleStatePreview,
+		LifecycleStateRetired,
+	}
+}
+
+// LocationHealthStatus - The health status of a location or one of its addresses.
+type LocationHealthStatus string
+
+const (
+	// LocationHealthStatusDegraded - Some, but not all, addresses are unhealthy.
+	LocationHealthStatusDegraded LocationHealthStatus = "Degraded"
+	// LocationHealthStatusHealthy - All addresses are healthy.
+	LocationHealthStatusHealthy LocationHealthStatus = "Healthy"
+	// LocationHealthStatusUnhealthy - All addresses are unhealthy.
+	LocationHealthStatusUnhealthy LocationHealthStatus = "Unhealthy"
+	// LocationHealthStatusUnknown - The health has not been probed yet.
+	LocationHealthStatusUnknown LocationHealthStatus = "Unknown"
+)
+
+// PossibleLocationHealthStatusValues returns the possible values for the LocationHealthStatus const type.
+func PossibleLocationHealthStatusValues() []LocationHealthStatus {
+	return []LocationHealthStatus{
+		LocationHealthStatusDegraded,
+		LocationHealthStatusHealthy,
+		LocationHealthStatusUnhealthy,
+		LocationHealthStatusUnknown,
+	}
+}
+
+// LocationRoutingPolicy - The policy for routing requests across the addresses of a location.
+type LocationRoutingPolicy string
+
+const (
+	// LocationRoutingPolicyActiveStandby - Requests are sent to the first healthy address, in priority order.
+	LocationRoutingPolicyActiveStandby LocationRoutingPolicy = "ActiveStandby"
+	// LocationRoutingPolicyRoundRobin - Requests are distributed across the healthy addresses.
+	LocationRoutingPolicyRoundRobin LocationRoutingPolicy = "RoundRobin"
+)
+
+// PossibleLocationRoutingPolicyValues returns the possible values for the LocationRoutingPolicy const type.
+func PossibleLocationRoutingPolicyValues() []LocationRoutingPolicy {
+	return []LocationRoutingPolicy{
+		LocationRoutingPolicyActiveStandby,
+		LocationRoutingPolicyRoundRobin,
+	}
+}
+
 // ProvisioningState - Provisioning state of the resource at the time the operation was called
 type ProvisioningState string
 
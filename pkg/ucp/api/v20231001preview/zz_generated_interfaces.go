@@ -29,3 +29,12 @@ type CredentialStoragePropertiesClassification interface {
 	// GetCredentialStorageProperties returns the CredentialStorageProperties content of the underlying type.
 	GetCredentialStorageProperties() *CredentialStorageProperties
 }
+
+// GcpCredentialPropertiesClassification provides polymorphic access to related types.
+// Call the interface's GetGcpCredentialProperties() method to access the common type.
+// Use a type switch to determine the concrete type.  The possible types are:
+// - *GcpCredentialProperties, *GcpServiceAccountKeyCredentialProperties, *GcpWorkloadIdentityCredentialProperties
+type GcpCredentialPropertiesClassification interface {
+	// GetGcpCredentialProperties returns the GcpCredentialProperties content of the underlying type.
+	GetGcpCredentialProperties() *GcpCredentialProperties
+}
@@ -7,6 +7,9 @@ import "time"
 
 // APIVersionProperties - The properties of an API version.
 type APIVersionProperties struct {
+	// The lifecycle of the API version.
+	Lifecycle *ResourceTypeLifecycle
+
 	// Schema is the schema for the resource type.
 	Schema map[string]any
 
@@ -42,6 +45,15 @@ type APIVersionResourceListResult struct {
 	NextLink *string
 }
 
+// APIVersionUsage - The stored resources of a resource type that were last written with an API version.
+type APIVersionUsage struct {
+	// REQUIRED; The number of stored resources.
+	Count *int32
+
+	// REQUIRED; The IDs of the stored resources.
+	Resources []*string
+}
+
 // AwsAccessKeyCredentialProperties - AWS credential properties for Access Key
 type AwsAccessKeyCredentialProperties struct {
 	// REQUIRED; Access key ID for AWS identity
@@ -359,6 +371,154 @@ func (c *CredentialStorageProperties) GetCredentialStorageProperties() *Credenti
 	return c
 }
 
+// GcpCredentialProperties - GCP Credential properties
+type GcpCredentialProperties struct {
+	// REQUIRED; The GCP credential kind
+	Kind *GCPCredentialKind
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GetGcpCredentialProperties implements the GcpCredentialPropertiesClassification interface for type GcpCredentialProperties.
+func (g *GcpCredentialProperties) GetGcpCredentialProperties() *GcpCredentialProperties { return g }
+
+// GcpCredentialResource - Concrete tracked resource types can be created by aliasing this type using a specific property
+// type.
+type GcpCredentialResource struct {
+	// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+	// REQUIRED; The resource-specific properties for this resource.
+	Properties GcpCredentialPropertiesClassification
+
+	// Resource tags.
+	Tags map[string]*string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// GcpCredentialResourceListResult - The response of a GcpCredentialResource list operation.
+type GcpCredentialResourceListResult struct {
+	// REQUIRED; The GcpCredentialResource items on this page
+	Value []*GcpCredentialResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// GcpCredentialResourceTagsUpdate - The type used for updating tags in GcpCredentialResource resources.
+type GcpCredentialResourceTagsUpdate struct {
+	// Resource tags.
+	Tags map[string]*string
+}
+
+// GcpPlaneResource - The GCP plane resource
+type GcpPlaneResource struct {
+	// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+	// REQUIRED; The resource-specific properties for this resource.
+	Properties *GcpPlaneResourceProperties
+
+	// Resource tags.
+	Tags map[string]*string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// GcpPlaneResourceListResult - The response of a GcpPlaneResource list operation.
+type GcpPlaneResourceListResult struct {
+	// REQUIRED; The GcpPlaneResource items on this page
+	Value []*GcpPlaneResource
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// GcpPlaneResourceProperties - The Plane properties.
+type GcpPlaneResourceProperties struct {
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GcpPlaneResourceTagsUpdate - The type used for updating tags in GcpPlaneResource resources.
+type GcpPlaneResourceTagsUpdate struct {
+	// Resource tags.
+	Tags map[string]*string
+}
+
+// GcpServiceAccountKeyCredentialProperties - GCP credential properties for a service account key
+type GcpServiceAccountKeyCredentialProperties struct {
+	// CONSTANT; Service account key kind
+	// Field has constant value GCPCredentialKindServiceAccountKey, any specified value is ignored.
+	Kind *GCPCredentialKind
+
+	// REQUIRED; The JSON key file of the GCP service account
+	ServiceAccountKey *string
+
+	// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GetGcpCredentialProperties implements the GcpCredentialPropertiesClassification interface for type GcpServiceAccountKeyCredentialProperties.
+func (g *GcpServiceAccountKeyCredentialProperties) GetGcpCredentialProperties() *GcpCredentialProperties {
+	return &GcpCredentialProperties{
+		Kind:              g.Kind,
+		ProvisioningState: g.ProvisioningState,
+	}
+}
+
+// GcpWorkloadIdentityCredentialProperties - GCP credential properties for workload identity federation
+type GcpWorkloadIdentityCredentialProperties struct {
+	// REQUIRED; The audience of the workload identity pool provider, e.g. //iam.googleapis.com/projects/123/locations/global/workloadIdentityPools/pool/providers/provider
+	Audience *string
+
+	// CONSTANT; Workload identity kind
+	// Field has constant value GCPCredentialKindWorkloadIdentity, any specified value is ignored.
+	Kind *GCPCredentialKind
+
+	// REQUIRED; The email of the GCP service account to impersonate
+	ServiceAccountEmail *string
+
+	// REQUIRED; The storage properties
+	Storage CredentialStoragePropertiesClassification
+
+	// READ-ONLY; The status of the asynchronous operation.
+	ProvisioningState *ProvisioningState
+}
+
+// GetGcpCredentialProperties implements the GcpCredentialPropertiesClassification interface for type GcpWorkloadIdentityCredentialProperties.
+func (g *GcpWorkloadIdentityCredentialProperties) GetGcpCredentialProperties() *GcpCredentialProperties {
+	return &GcpCredentialProperties{
+		Kind:              g.Kind,
+		ProvisioningState: g.ProvisioningState,
+	}
+}
+
 // GenericPlaneResource - The generic representation of a plane resource
 type GenericPlaneResource struct {
 	// REQUIRED; The geo-location where the resource lives
@@ -442,14 +602,55 @@ func (i *InternalCredentialStorageProperties) GetCredentialStorageProperties() *
 	}
 }
 
+// LocationAddressHealth - The health of an address of a location.
+type LocationAddressHealth struct {
+	// REQUIRED; The address of the resource provider implementation.
+	Address *string
+
+	// REQUIRED; The health status of the address.
+	Status *LocationHealthStatus
+
+	// The time of the last health probe.
+	LastProbeTime *time.Time
+
+	// Describes the result of the last health probe when the address is unhealthy.
+	Message *string
+}
+
+// LocationHealth - The health of the addresses of a location.
+type LocationHealth struct {
+	// REQUIRED; The overall health status of the location.
+	Status *LocationHealthStatus
+
+	// The health of each address of the location, in priority order.
+	Addresses []*LocationAddressHealth
+}
+
+// LocationHealthProbe - The health probe for the addresses of a location.
+type LocationHealthProbe struct {
+	// The path that is probed on each address, for example '/healthz'. A 2xx response is healthy. When not set, an address
+	// is healthy if it responds with a status code below 500.
+	Path *string
+}
+
 // LocationProperties - The properties of a location.
 type LocationProperties struct {
 	// Address of a resource provider implementation.
 	Address *string
 
+	// Additional addresses of the resource provider implementation. Requests fail over to these addresses when the address is
+	// unhealthy.
+	Addresses []*string
+
+	// The health probe for the addresses of the location.
+	HealthProbe *LocationHealthProbe
+
 	// Configuration for resource types supported by the location.
 	ResourceTypes map[string]*LocationResourceType
 
+	// The policy for routing requests across the addresses of the location. Defaults to ActiveStandby.
+	RoutingPolicy *LocationRoutingPolicy
+
 	// READ-ONLY; The status of the asynchronous operation.
 	ProvisioningState *ProvisioningState
 }
@@ -652,6 +853,8 @@ type ResourceProviderSummary struct {
 
 // ResourceProviderSummaryLocation - The configuration of a resource provider in a specific location.
 type ResourceProviderSummaryLocation struct {
+	// The health of the addresses of the location. Only set for locations with an address.
+	Health *LocationHealth
 }
 
 // ResourceProviderSummaryResourceType - A resource type and its versions.
@@ -673,6 +876,21 @@ type ResourceProviderSummaryResourceType struct {
 
 	// The SHA-256 hash of the icon's SVG bytes, computed by the control plane.
 	IconHash *string
+
+	// The lifecycle of the resource type.
+	Lifecycle *ResourceTypeLifecycle
+}
+
+// ResourceTypeLifecycle - The lifecycle of a resource type or API version.
+type ResourceTypeLifecycle struct {
+	// REQUIRED; The lifecycle state.
+	State *LifecycleState
+
+	// A message for users, such as the resource type or API version to use instead.
+	Message *string
+
+	// The date from which the resource type or API version can no longer be used to create new resources.
+	SunsetDate *time.Time
 }
 
 // ResourceTypeProperties - The properties of a resource type.
@@ -690,6 +908,9 @@ type ResourceTypeProperties struct {
 	// create --icon <path>'.
 	Icon *string
 
+	// The lifecycle of the resource type. The lifecycle of an API version takes precedence over it.
+	Lifecycle *ResourceTypeLifecycle
+
 	// READ-ONLY; The SHA-256 hash of the icon's SVG bytes. Computed by the control plane and used to content-address the icon.
 	IconHash *string
 
@@ -726,10 +947,25 @@ type ResourceTypeResourceListResult struct {
 
 // ResourceTypeSummaryResultAPIVersion - The configuration of a resource type API version.
 type ResourceTypeSummaryResultAPIVersion struct {
+	// The lifecycle of the API version.
+	Lifecycle *ResourceTypeLifecycle
+
 	// Schema holds the resource type definitions for this API version.
 	Schema map[string]any
 }
 
+// ResourceTypeUsage - The number of stored resources of a resource type, by API version.
+type ResourceTypeUsage struct {
+	// REQUIRED; The stored resources by the API version they were last written with.
+	APIVersions map[string]*APIVersionUsage
+
+	// REQUIRED; The total number of stored resources of the resource type.
+	Count *int32
+
+	// REQUIRED; The fully qualified resource type. Example: 'Applications.Datastores/redisCaches'.
+	ResourceType *string
+}
+
 // SystemData - Metadata pertaining to creation and last modification of the resource.
 type SystemData struct {
 	// The timestamp of resource creation (UTC).
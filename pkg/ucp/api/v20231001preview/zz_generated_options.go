@@ -136,6 +136,61 @@ type AzurePlanesClientListOptions struct {
 	// placeholder for future optional parameters
 }
 
+// GcpCredentialsClientCreateOrUpdateOptions contains the optional parameters for the GcpCredentialsClient.CreateOrUpdate
+// method.
+type GcpCredentialsClientCreateOrUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpCredentialsClientDeleteOptions contains the optional parameters for the GcpCredentialsClient.Delete method.
+type GcpCredentialsClientDeleteOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpCredentialsClientGetOptions contains the optional parameters for the GcpCredentialsClient.Get method.
+type GcpCredentialsClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpCredentialsClientListOptions contains the optional parameters for the GcpCredentialsClient.NewListPager method.
+type GcpCredentialsClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpCredentialsClientUpdateOptions contains the optional parameters for the GcpCredentialsClient.Update method.
+type GcpCredentialsClientUpdateOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpPlanesClientBeginCreateOrUpdateOptions contains the optional parameters for the GcpPlanesClient.BeginCreateOrUpdate
+// method.
+type GcpPlanesClientBeginCreateOrUpdateOptions struct {
+	// Resumes the long-running operation from the provided token.
+	ResumeToken string
+}
+
+// GcpPlanesClientBeginDeleteOptions contains the optional parameters for the GcpPlanesClient.BeginDelete method.
+type GcpPlanesClientBeginDeleteOptions struct {
+	// Resumes the long-running operation from the provided token.
+	ResumeToken string
+}
+
+// GcpPlanesClientBeginUpdateOptions contains the optional parameters for the GcpPlanesClient.BeginUpdate method.
+type GcpPlanesClientBeginUpdateOptions struct {
+	// Resumes the long-running operation from the provided token.
+	ResumeToken string
+}
+
+// GcpPlanesClientGetOptions contains the optional parameters for the GcpPlanesClient.Get method.
+type GcpPlanesClientGetOptions struct {
+	// placeholder for future optional parameters
+}
+
+// GcpPlanesClientListOptions contains the optional parameters for the GcpPlanesClient.NewListPager method.
+type GcpPlanesClientListOptions struct {
+	// placeholder for future optional parameters
+}
+
 // LocationsClientBeginCreateOrUpdateOptions contains the optional parameters for the LocationsClient.BeginCreateOrUpdate
 // method.
 type LocationsClientBeginCreateOrUpdateOptions struct {
@@ -279,6 +334,11 @@ type ResourceTypesClientGetOptions struct {
 	// placeholder for future optional parameters
 }
 
+// ResourceTypesClientGetUsageOptions contains the optional parameters for the ResourceTypesClient.GetUsage method.
+type ResourceTypesClientGetUsageOptions struct {
+	// placeholder for future optional parameters
+}
+
 // ResourceTypesClientListOptions contains the optional parameters for the ResourceTypesClient.NewListPager method.
 type ResourceTypesClientListOptions struct {
 	// placeholder for future optional parameters
@@ -308,6 +308,67 @@ func (client *ResourceTypesClient) getIconHandleResponse(resp *http.Response) (R
 	return result, nil
 }
 
+// GetUsage - Get the number of stored resources of the specified resource type, by the API version they were last written
+// with. Resource types and API versions cannot be deleted while they are in use unless the delete is forced.
+// If the operation fails it returns an *azcore.ResponseError type.
+//   - planeName - The plane name.
+//   - resourceProviderName - The resource provider name. This is also the resource provider namespace. Example: 'Applications.Datastores'.
+//   - resourceTypeName - The resource type name.
+//   - options - ResourceTypesClientGetUsageOptions contains the optional parameters for the ResourceTypesClient.GetUsage method.
+func (client *ResourceTypesClient) GetUsage(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, options *ResourceTypesClientGetUsageOptions) (ResourceTypesClientGetUsageResponse, error) {
+	var err error
+	ctx = context.WithValue(ctx, runtime.CtxAPINameKey{}, "ResourceTypesClient.GetUsage")
+	req, err := client.getUsageCreateRequest(ctx, planeName, resourceProviderName, resourceTypeName, options)
+	if err != nil {
+		return ResourceTypesClientGetUsageResponse{}, err
+	}
+	httpResp, err := client.internal.Pipeline().Do(req)
+	if err != nil {
+		return ResourceTypesClientGetUsageResponse{}, err
+	}
+	if !runtime.HasStatusCode(httpResp, http.StatusOK) {
+		err = runtime.NewResponseError(httpResp)
+		return ResourceTypesClientGetUsageResponse{}, err
+	}
+	resp, err := client.getUsageHandleResponse(httpResp)
+	return resp, err
+}
+
+// getUsageCreateRequest creates the GetUsage request.
+func (client *ResourceTypesClient) getUsageCreateRequest(ctx context.Context, planeName string, resourceProviderName string, resourceTypeName string, _ *ResourceTypesClientGetUsageOptions) (*policy.Request, error) {
+	urlPath := "/planes/radius/{planeName}/providers/System.Resources/resourceproviders/{resourceProviderName}/resourcetypes/{resourceTypeName}/usage"
+	if planeName == "" {
+		return nil, errors.New("parameter planeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{planeName}", url.PathEscape(planeName))
+	if resourceProviderName == "" {
+		return nil, errors.New("parameter resourceProviderName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{resourceProviderName}", url.PathEscape(resourceProviderName))
+	if resourceTypeName == "" {
+		return nil, errors.New("parameter resourceTypeName cannot be empty")
+	}
+	urlPath = strings.ReplaceAll(urlPath, "{resourceTypeName}", url.PathEscape(resourceTypeName))
+	req, err := runtime.NewRequest(ctx, http.MethodGet, runtime.JoinPaths(client.internal.Endpoint(), urlPath))
+	if err != nil {
+		return nil, err
+	}
+	reqQP := req.Raw().URL.Query()
+	reqQP.Set("api-version", version20231001Preview)
+	req.Raw().URL.RawQuery = strings.ReplaceAll(reqQP.Encode(), "+", "%20")
+	req.Raw().Header["Accept"] = []string{"application/json"}
+	return req, nil
+}
+
+// getUsageHandleResponse handles the GetUsage response.
+func (client *ResourceTypesClient) getUsageHandleResponse(resp *http.Response) (ResourceTypesClientGetUsageResponse, error) {
+	result := ResourceTypesClientGetUsageResponse{}
+	if err := runtime.UnmarshalAsJSON(resp, &result.ResourceTypeUsage); err != nil {
+		return ResourceTypesClientGetUsageResponse{}, err
+	}
+	return result, nil
+}
+
 // NewListPager - List resource types.
 //   - planeName - The plane name.
 //   - resourceProviderName - The resource provider name. This is also the resource provider namespace. Example: 'Applications.Datastores'.
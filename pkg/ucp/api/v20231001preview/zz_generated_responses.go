@@ -142,6 +142,63 @@ type AzurePlanesClientUpdateResponse struct {
 	// placeholder for future response values
 }
 
+// GcpCredentialsClientCreateOrUpdateResponse contains the response from method GcpCredentialsClient.CreateOrUpdate.
+type GcpCredentialsClientCreateOrUpdateResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	GcpCredentialResource
+}
+
+// GcpCredentialsClientDeleteResponse contains the response from method GcpCredentialsClient.Delete.
+type GcpCredentialsClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// GcpCredentialsClientGetResponse contains the response from method GcpCredentialsClient.Get.
+type GcpCredentialsClientGetResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	GcpCredentialResource
+}
+
+// GcpCredentialsClientListResponse contains the response from method GcpCredentialsClient.NewListPager.
+type GcpCredentialsClientListResponse struct {
+	// The response of a GcpCredentialResource list operation.
+	GcpCredentialResourceListResult
+}
+
+// GcpCredentialsClientUpdateResponse contains the response from method GcpCredentialsClient.Update.
+type GcpCredentialsClientUpdateResponse struct {
+	// Concrete tracked resource types can be created by aliasing this type using a specific property type.
+	GcpCredentialResource
+}
+
+// GcpPlanesClientCreateOrUpdateResponse contains the response from method GcpPlanesClient.BeginCreateOrUpdate.
+type GcpPlanesClientCreateOrUpdateResponse struct {
+	// The GCP plane resource
+	GcpPlaneResource
+}
+
+// GcpPlanesClientDeleteResponse contains the response from method GcpPlanesClient.BeginDelete.
+type GcpPlanesClientDeleteResponse struct {
+	// placeholder for future response values
+}
+
+// GcpPlanesClientGetResponse contains the response from method GcpPlanesClient.Get.
+type GcpPlanesClientGetResponse struct {
+	// The GCP plane resource
+	GcpPlaneResource
+}
+
+// GcpPlanesClientListResponse contains the response from method GcpPlanesClient.NewListPager.
+type GcpPlanesClientListResponse struct {
+	// The response of a GcpPlaneResource list operation.
+	GcpPlaneResourceListResult
+}
+
+// GcpPlanesClientUpdateResponse contains the response from method GcpPlanesClient.BeginUpdate.
+type GcpPlanesClientUpdateResponse struct {
+	// placeholder for future response values
+}
+
 // LocationsClientCreateOrUpdateResponse contains the response from method LocationsClient.BeginCreateOrUpdate.
 type LocationsClientCreateOrUpdateResponse struct {
 	// The resource type for defining a location of the containing resource provider. The location resource represents a logical
@@ -295,6 +352,12 @@ type ResourceTypesClientGetResponse struct {
 	ResourceTypeResource
 }
 
+// ResourceTypesClientGetUsageResponse contains the response from method ResourceTypesClient.GetUsage.
+type ResourceTypesClientGetUsageResponse struct {
+	// The number of stored resources of a resource type, by API version.
+	ResourceTypeUsage
+}
+
 // ResourceTypesClientListResponse contains the response from method ResourceTypesClient.NewListPager.
 type ResourceTypesClientListResponse struct {
 	// The response of a ResourceTypeResource list operation.
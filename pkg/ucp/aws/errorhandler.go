@@ -18,6 +18,7 @@ package aws
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
 	"github.com/aws/smithy-go"
@@ -76,6 +77,40 @@ func IsAWSResourceNotFoundError(err error) bool {
 	return errors.As(err, &target)
 }
 
+// IsAWSUnsupportedActionError checks if the given error is an AWS Cloud Control UnsupportedActionException error, which
+// is returned for resource types that Cloud Control cannot provision.
+func IsAWSUnsupportedActionError(err error) bool {
+	target := &types.UnsupportedActionException{}
+	return errors.As(err, &target)
+}
+
+// IsAWSRequestTokenNotFoundError checks if the given error is an AWS Cloud Control RequestTokenNotFoundException error.
+func IsAWSRequestTokenNotFoundError(err error) bool {
+	target := &types.RequestTokenNotFoundException{}
+	return errors.As(err, &target)
+}
+
+// IsCloudFormationStackNotFoundError checks if the given error is returned by AWS CloudFormation for a stack that does
+// not exist. CloudFormation reports this as a generic ValidationError.
+func IsCloudFormationStackNotFoundError(err error) bool {
+	return isCloudFormationValidationError(err, "does not exist")
+}
+
+// IsCloudFormationNoUpdatesError checks if the given error is returned by AWS CloudFormation for a stack update that
+// has no changes.
+func IsCloudFormationNoUpdatesError(err error) bool {
+	return isCloudFormationValidationError(err, "No updates are to be performed")
+}
+
+func isCloudFormationValidationError(err error, message string) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	return apiErr.ErrorCode() == "ValidationError" && strings.Contains(apiErr.ErrorMessage(), message)
+}
+
 // AWSMissingPropertyError is an error type to be returned when the call to UCP CreateWithPost
 // is missing values for one of the expected primary identifier properties
 type AWSMissingPropertyError struct {
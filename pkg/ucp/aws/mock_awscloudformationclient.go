@@ -41,6 +41,138 @@ func (m *MockAWSCloudFormationClient) EXPECT() *MockAWSCloudFormationClientMockR
 	return m.recorder
 }
 
+// CreateStack mocks base method.
+func (m *MockAWSCloudFormationClient) CreateStack(ctx context.Context, params *cloudformation.CreateStackInput, optFns ...func(*cloudformation.Options)) (*cloudformation.CreateStackOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateStack", varargs...)
+	ret0, _ := ret[0].(*cloudformation.CreateStackOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStack indicates an expected call of CreateStack.
+func (mr *MockAWSCloudFormationClientMockRecorder) CreateStack(ctx, params any, optFns ...any) *MockAWSCloudFormationClientCreateStackCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, optFns...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStack", reflect.TypeOf((*MockAWSCloudFormationClient)(nil).CreateStack), varargs...)
+	return &MockAWSCloudFormationClientCreateStackCall{Call: call}
+}
+
+// MockAWSCloudFormationClientCreateStackCall wrap *gomock.Call
+type MockAWSCloudFormationClientCreateStackCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAWSCloudFormationClientCreateStackCall) Return(arg0 *cloudformation.CreateStackOutput, arg1 error) *MockAWSCloudFormationClientCreateStackCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAWSCloudFormationClientCreateStackCall) Do(f func(context.Context, *cloudformation.CreateStackInput, ...func(*cloudformation.Options)) (*cloudformation.CreateStackOutput, error)) *MockAWSCloudFormationClientCreateStackCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAWSCloudFormationClientCreateStackCall) DoAndReturn(f func(context.Context, *cloudformation.CreateStackInput, ...func(*cloudformation.Options)) (*cloudformation.CreateStackOutput, error)) *MockAWSCloudFormationClientCreateStackCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteStack mocks base method.
+func (m *MockAWSCloudFormationClient) DeleteStack(ctx context.Context, params *cloudformation.DeleteStackInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DeleteStackOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteStack", varargs...)
+	ret0, _ := ret[0].(*cloudformation.DeleteStackOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStack indicates an expected call of DeleteStack.
+func (mr *MockAWSCloudFormationClientMockRecorder) DeleteStack(ctx, params any, optFns ...any) *MockAWSCloudFormationClientDeleteStackCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, optFns...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStack", reflect.TypeOf((*MockAWSCloudFormationClient)(nil).DeleteStack), varargs...)
+	return &MockAWSCloudFormationClientDeleteStackCall{Call: call}
+}
+
+// MockAWSCloudFormationClientDeleteStackCall wrap *gomock.Call
+type MockAWSCloudFormationClientDeleteStackCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAWSCloudFormationClientDeleteStackCall) Return(arg0 *cloudformation.DeleteStackOutput, arg1 error) *MockAWSCloudFormationClientDeleteStackCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAWSCloudFormationClientDeleteStackCall) Do(f func(context.Context, *cloudformation.DeleteStackInput, ...func(*cloudformation.Options)) (*cloudformation.DeleteStackOutput, error)) *MockAWSCloudFormationClientDeleteStackCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAWSCloudFormationClientDeleteStackCall) DoAndReturn(f func(context.Context, *cloudformation.DeleteStackInput, ...func(*cloudformation.Options)) (*cloudformation.DeleteStackOutput, error)) *MockAWSCloudFormationClientDeleteStackCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DescribeStacks mocks base method.
+func (m *MockAWSCloudFormationClient) DescribeStacks(ctx context.Context, params *cloudformation.DescribeStacksInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeStacks", varargs...)
+	ret0, _ := ret[0].(*cloudformation.DescribeStacksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeStacks indicates an expected call of DescribeStacks.
+func (mr *MockAWSCloudFormationClientMockRecorder) DescribeStacks(ctx, params any, optFns ...any) *MockAWSCloudFormationClientDescribeStacksCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, optFns...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeStacks", reflect.TypeOf((*MockAWSCloudFormationClient)(nil).DescribeStacks), varargs...)
+	return &MockAWSCloudFormationClientDescribeStacksCall{Call: call}
+}
+
+// MockAWSCloudFormationClientDescribeStacksCall wrap *gomock.Call
+type MockAWSCloudFormationClientDescribeStacksCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAWSCloudFormationClientDescribeStacksCall) Return(arg0 *cloudformation.DescribeStacksOutput, arg1 error) *MockAWSCloudFormationClientDescribeStacksCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAWSCloudFormationClientDescribeStacksCall) Do(f func(context.Context, *cloudformation.DescribeStacksInput, ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error)) *MockAWSCloudFormationClientDescribeStacksCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAWSCloudFormationClientDescribeStacksCall) DoAndReturn(f func(context.Context, *cloudformation.DescribeStacksInput, ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error)) *MockAWSCloudFormationClientDescribeStacksCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // DescribeType mocks base method.
 func (m *MockAWSCloudFormationClient) DescribeType(ctx context.Context, params *cloudformation.DescribeTypeInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeTypeOutput, error) {
 	m.ctrl.T.Helper()
@@ -84,3 +216,135 @@ func (c *MockAWSCloudFormationClientDescribeTypeCall) DoAndReturn(f func(context
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
+
+// DetectStackResourceDrift mocks base method.
+func (m *MockAWSCloudFormationClient) DetectStackResourceDrift(ctx context.Context, params *cloudformation.DetectStackResourceDriftInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DetectStackResourceDriftOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DetectStackResourceDrift", varargs...)
+	ret0, _ := ret[0].(*cloudformation.DetectStackResourceDriftOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectStackResourceDrift indicates an expected call of DetectStackResourceDrift.
+func (mr *MockAWSCloudFormationClientMockRecorder) DetectStackResourceDrift(ctx, params any, optFns ...any) *MockAWSCloudFormationClientDetectStackResourceDriftCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, optFns...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectStackResourceDrift", reflect.TypeOf((*MockAWSCloudFormationClient)(nil).DetectStackResourceDrift), varargs...)
+	return &MockAWSCloudFormationClientDetectStackResourceDriftCall{Call: call}
+}
+
+// MockAWSCloudFormationClientDetectStackResourceDriftCall wrap *gomock.Call
+type MockAWSCloudFormationClientDetectStackResourceDriftCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAWSCloudFormationClientDetectStackResourceDriftCall) Return(arg0 *cloudformation.DetectStackResourceDriftOutput, arg1 error) *MockAWSCloudFormationClientDetectStackResourceDriftCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAWSCloudFormationClientDetectStackResourceDriftCall) Do(f func(context.Context, *cloudformation.DetectStackResourceDriftInput, ...func(*cloudformation.Options)) (*cloudformation.DetectStackResourceDriftOutput, error)) *MockAWSCloudFormationClientDetectStackResourceDriftCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAWSCloudFormationClientDetectStackResourceDriftCall) DoAndReturn(f func(context.Context, *cloudformation.DetectStackResourceDriftInput, ...func(*cloudformation.Options)) (*cloudformation.DetectStackResourceDriftOutput, error)) *MockAWSCloudFormationClientDetectStackResourceDriftCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetTemplate mocks base method.
+func (m *MockAWSCloudFormationClient) GetTemplate(ctx context.Context, params *cloudformation.GetTemplateInput, optFns ...func(*cloudformation.Options)) (*cloudformation.GetTemplateOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTemplate", varargs...)
+	ret0, _ := ret[0].(*cloudformation.GetTemplateOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplate indicates an expected call of GetTemplate.
+func (mr *MockAWSCloudFormationClientMockRecorder) GetTemplate(ctx, params any, optFns ...any) *MockAWSCloudFormationClientGetTemplateCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, optFns...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplate", reflect.TypeOf((*MockAWSCloudFormationClient)(nil).GetTemplate), varargs...)
+	return &MockAWSCloudFormationClientGetTemplateCall{Call: call}
+}
+
+// MockAWSCloudFormationClientGetTemplateCall wrap *gomock.Call
+type MockAWSCloudFormationClientGetTemplateCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAWSCloudFormationClientGetTemplateCall) Return(arg0 *cloudformation.GetTemplateOutput, arg1 error) *MockAWSCloudFormationClientGetTemplateCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAWSCloudFormationClientGetTemplateCall) Do(f func(context.Context, *cloudformation.GetTemplateInput, ...func(*cloudformation.Options)) (*cloudformation.GetTemplateOutput, error)) *MockAWSCloudFormationClientGetTemplateCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAWSCloudFormationClientGetTemplateCall) DoAndReturn(f func(context.Context, *cloudformation.GetTemplateInput, ...func(*cloudformation.Options)) (*cloudformation.GetTemplateOutput, error)) *MockAWSCloudFormationClientGetTemplateCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateStack mocks base method.
+func (m *MockAWSCloudFormationClient) UpdateStack(ctx context.Context, params *cloudformation.UpdateStackInput, optFns ...func(*cloudformation.Options)) (*cloudformation.UpdateStackOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateStack", varargs...)
+	ret0, _ := ret[0].(*cloudformation.UpdateStackOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStack indicates an expected call of UpdateStack.
+func (mr *MockAWSCloudFormationClientMockRecorder) UpdateStack(ctx, params any, optFns ...any) *MockAWSCloudFormationClientUpdateStackCall {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, optFns...)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStack", reflect.TypeOf((*MockAWSCloudFormationClient)(nil).UpdateStack), varargs...)
+	return &MockAWSCloudFormationClientUpdateStackCall{Call: call}
+}
+
+// MockAWSCloudFormationClientUpdateStackCall wrap *gomock.Call
+type MockAWSCloudFormationClientUpdateStackCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockAWSCloudFormationClientUpdateStackCall) Return(arg0 *cloudformation.UpdateStackOutput, arg1 error) *MockAWSCloudFormationClientUpdateStackCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockAWSCloudFormationClientUpdateStackCall) Do(f func(context.Context, *cloudformation.UpdateStackInput, ...func(*cloudformation.Options)) (*cloudformation.UpdateStackOutput, error)) *MockAWSCloudFormationClientUpdateStackCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockAWSCloudFormationClientUpdateStackCall) DoAndReturn(f func(context.Context, *cloudformation.UpdateStackInput, ...func(*cloudformation.Options)) (*cloudformation.UpdateStackOutput, error)) *MockAWSCloudFormationClientUpdateStackCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -48,14 +48,19 @@ type TrackedResourceProcessController struct {
 
 	// defaultDownstream is the address of the dynamic resource provider to proxy requests to.
 	defaultDownstream *url.URL
+
+	// selector selects the address of the resource provider location to send requests to.
+	selector resourcegroups.AddressSelector
 }
 
 // NewTrackedResourceProcessController creates a new TrackedResourceProcessController controller which is used to process resources asynchronously.
-func NewTrackedResourceProcessController(opts ctrl.Options, transport http.RoundTripper, defaultDownstream *url.URL) (ctrl.Controller, error) {
+// The selector chooses between the addresses of a resource provider location, and may be nil.
+func NewTrackedResourceProcessController(opts ctrl.Options, transport http.RoundTripper, defaultDownstream *url.URL, selector resourcegroups.AddressSelector) (ctrl.Controller, error) {
 	return &TrackedResourceProcessController{
 		BaseController:    ctrl.NewBaseAsyncController(opts),
 		updater:           trackedresource.NewUpdater(opts.DatabaseClient, &http.Client{Transport: transport}),
 		defaultDownstream: defaultDownstream,
+		selector:          selector,
 	}, nil
 }
 
@@ -73,7 +78,7 @@ func (c *TrackedResourceProcessController) Run(ctx context.Context, request *ctr
 		return ctrl.Result{}, err
 	}
 
-	downstreamURL, err := resourcegroups.ValidateDownstream(ctx, c.DatabaseClient(), originalID, v1.LocationGlobal, resource.Properties.APIVersion)
+	downstreamURL, err := resourcegroups.ValidateDownstream(ctx, c.DatabaseClient(), originalID, v1.LocationGlobal, resource.Properties.APIVersion, c.selector)
 	if errors.Is(err, &resourcegroups.NotFoundError{}) {
 		return ctrl.NewFailedResult(v1.ErrorDetails{Code: v1.CodeNotFound, Message: err.Error(), Target: request.ResourceID}), nil
 	} else if errors.Is(err, &resourcegroups.InvalidError{}) {
@@ -36,7 +36,7 @@ func Test_Run(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		databaseClient := database.NewMockClient(ctrl)
 
-		pc, err := NewTrackedResourceProcessController(controller.Options{DatabaseClient: databaseClient}, nil, nil)
+		pc, err := NewTrackedResourceProcessController(controller.Options{DatabaseClient: databaseClient}, nil, nil, nil)
 		require.NoError(t, err)
 
 		updater := mockUpdater{}
@@ -83,6 +83,7 @@ func (c *ResourceTypePutController) updateSummary(id resources.ID, resourceType
 		resourceTypeEntry.Description = resourceType.Properties.Description
 		resourceTypeEntry.Icon = resourceType.Properties.Icon
 		resourceTypeEntry.IconHash = resourceType.Properties.IconHash
+		resourceTypeEntry.Lifecycle = resourceType.Properties.Lifecycle
 		summary.Properties.ResourceTypes[resourceTypeName] = resourceTypeEntry
 		return nil
 	}
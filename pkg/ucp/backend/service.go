@@ -31,6 +31,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/backend/controller/resourcegroups"
 	"github.com/radius-project/radius/pkg/ucp/backend/controller/resourceproviders"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/locationhealth"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
@@ -89,7 +90,7 @@ func (w *Service) Run(ctx context.Context) error {
 	}
 
 	transport := otelhttp.NewTransport(http.DefaultTransport)
-	err = RegisterControllers(w.Controllers(), w.options.UCP, transport, opts, defaultDownstream)
+	err = RegisterControllers(w.Controllers(), w.options.UCP, transport, opts, defaultDownstream, w.options.LocationHealth)
 	if err != nil {
 		return err
 	}
@@ -98,10 +99,10 @@ func (w *Service) Run(ctx context.Context) error {
 }
 
 // RegisterControllers registers the controllers for the UCP backend.
-func RegisterControllers(registry *worker.ControllerRegistry, connection sdk.Connection, transport http.RoundTripper, opts ctrl.Options, defaultDownstream *url.URL) error {
+func RegisterControllers(registry *worker.ControllerRegistry, connection sdk.Connection, transport http.RoundTripper, opts ctrl.Options, defaultDownstream *url.URL, health *locationhealth.Monitor) error {
 	// Tracked resources
 	err := errors.Join(nil, registry.Register(v20231001preview.ResourceType, v1.OperationMethod(datamodel.OperationProcess), func(opts ctrl.Options) (ctrl.Controller, error) {
-		return resourcegroups.NewTrackedResourceProcessController(opts, transport, defaultDownstream)
+		return resourcegroups.NewTrackedResourceProcessController(opts, transport, defaultDownstream, health)
 	}, opts))
 
 	// Resource providers and related types
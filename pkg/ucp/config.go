@@ -97,6 +97,9 @@ type RoutingConfig struct {
 	// DefaultDownstreamEndpoint is the default destination when a resource provider does not provide a downstream endpoint.
 	// In practice, this points to the URL of dynamic-rp.
 	DefaultDownstreamEndpoint string `yaml:"defaultDownstreamEndpoint"`
+
+	// HealthProbe is the configuration for probing the health of the addresses of resource provider locations.
+	HealthProbe ucpconfig.HealthProbeOptions `yaml:"healthProbe,omitempty"`
 }
 
 // InitializeConfig defines the configuration for initializing the UCP server.
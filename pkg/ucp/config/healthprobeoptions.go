@@ -0,0 +1,30 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+// HealthProbeOptions describes how UCP probes the health of the addresses registered for resource provider locations.
+type HealthProbeOptions struct {
+	// Interval is the time between health probes, for example "30s". Defaults to "30s".
+	Interval string `yaml:"interval,omitempty"`
+
+	// Timeout is the timeout of each health probe, for example "5s". Defaults to "5s".
+	Timeout string `yaml:"timeout,omitempty"`
+
+	// FailureThreshold is the number of consecutive failed probes before an address is considered unhealthy.
+	// Defaults to 1.
+	FailureThreshold int `yaml:"failureThreshold,omitempty"`
+}
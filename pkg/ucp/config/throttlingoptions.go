@@ -0,0 +1,73 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+// ThrottlingOptions describes the rate limits, retries and circuit breaking applied by the UCP proxies to requests
+// sent to resource providers and cloud APIs. Throttling is disabled when no options are set.
+type ThrottlingOptions struct {
+	// Planes is a map of plane types, eg: aws, to the rate limit applied to all requests sent to the plane.
+	Planes map[string]RateLimitOptions `yaml:"planes,omitempty"`
+
+	// ResourceProviders is a map of resource provider namespaces, eg: Applications.Core, to the rate limit applied
+	// to all requests sent to the resource provider.
+	ResourceProviders map[string]RateLimitOptions `yaml:"resourceProviders,omitempty"`
+
+	// Credentials is a map of credentials, eg: aws/default, to the rate limit applied to all requests authenticated
+	// with the credential. Credentials are named by the plane type and credential name.
+	Credentials map[string]RateLimitOptions `yaml:"credentials,omitempty"`
+
+	// Retry describes how requests that are throttled or rejected by an unavailable downstream are retried.
+	Retry RetryOptions `yaml:"retry,omitempty"`
+
+	// CircuitBreaker describes how requests to an unhealthy downstream are short-circuited.
+	CircuitBreaker CircuitBreakerOptions `yaml:"circuitBreaker,omitempty"`
+}
+
+// RateLimitOptions describes a token bucket rate limit.
+type RateLimitOptions struct {
+	// RequestsPerSecond is the rate at which tokens are added to the bucket.
+	RequestsPerSecond float64 `yaml:"requestsPerSecond"`
+
+	// Burst is the size of the bucket. Defaults to RequestsPerSecond rounded up.
+	Burst int `yaml:"burst,omitempty"`
+}
+
+// RetryOptions describes how requests that receive a 429 or 503 response are retried.
+type RetryOptions struct {
+	// MaxAttempts is the maximum number of attempts for a request, including the first attempt. Requests are not
+	// retried when MaxAttempts is less than 2.
+	MaxAttempts int `yaml:"maxAttempts,omitempty"`
+
+	// InitialBackoff is the delay before the first retry, for example "500ms". The delay doubles on each retry.
+	// Defaults to "1s".
+	InitialBackoff string `yaml:"initialBackoff,omitempty"`
+
+	// MaxBackoff is the maximum delay before a retry, for example "30s". Responses with a Retry-After header
+	// that exceeds MaxBackoff are returned to the caller instead of being retried. Defaults to "30s".
+	MaxBackoff string `yaml:"maxBackoff,omitempty"`
+}
+
+// CircuitBreakerOptions describes a circuit breaker for each downstream.
+type CircuitBreakerOptions struct {
+	// FailureThreshold is the number of consecutive failed requests that opens the circuit. The circuit breaker is
+	// disabled when FailureThreshold is 0.
+	FailureThreshold int `yaml:"failureThreshold,omitempty"`
+
+	// OpenDuration is how long the circuit stays open before a trial request is allowed, for example "30s".
+	// Defaults to "30s".
+	OpenDuration string `yaml:"openDuration,omitempty"`
+}
@@ -42,12 +42,29 @@ type UCPOptions struct {
 
 	// Direct describes the connection options for a direct connection.
 	Direct *UCPDirectConnectionOptions `yaml:"direct,omitempty"`
+
+	// Throttling describes how UCP protects downstream resource providers and cloud APIs from bursts of requests.
+	// This is only used by the UCP server.
+	Throttling ThrottlingOptions `yaml:"throttling,omitempty"`
 }
 
 // UCPDirectConnectionOptions describes the connection options for a direct connection.
 type UCPDirectConnectionOptions struct {
 	// Endpoint is the URL endpoint for the connection.
 	Endpoint string `yaml:"endpoint"`
+
+	// TokenFile is the path of a file containing a bearer token used to authenticate with the endpoint, such as
+	// a projected service account token.
+	TokenFile string `yaml:"tokenFile,omitempty"`
+
+	// CertFile is the path of a PEM encoded client certificate used to authenticate with the endpoint.
+	CertFile string `yaml:"certFile,omitempty"`
+
+	// KeyFile is the path of the PEM encoded key of the client certificate.
+	KeyFile string `yaml:"keyFile,omitempty"`
+
+	// CAFile is the path of a PEM encoded CA bundle used to verify the endpoint.
+	CAFile string `yaml:"caFile,omitempty"`
 }
 
 // NewConnectionFromUCPConfig creates a Connection for UCP endpoint. It checks if the connection kind is direct and if so,
@@ -58,7 +75,12 @@ func NewConnectionFromUCPConfig(option *UCPOptions, k8sConfig *rest.Config) (sdk
 		if option.Direct == nil || option.Direct.Endpoint == "" {
 			return nil, errors.New("the property .ucp.direct.endpoint is required when using a direct connection")
 		}
-		return sdk.NewDirectConnection(option.Direct.Endpoint)
+		return sdk.NewDirectConnectionWithOptions(option.Direct.Endpoint, sdk.DirectConnectionOptions{
+			TokenFile: option.Direct.TokenFile,
+			CertFile:  option.Direct.CertFile,
+			KeyFile:   option.Direct.KeyFile,
+			CAFile:    option.Direct.CAFile,
+		})
 	} else if option.Kind == UCPConnectionKindKubernetes {
 		return sdk.NewKubernetesConnectionFromConfig(k8sConfig)
 	}
@@ -31,6 +31,9 @@ const (
 	// AWSPublic represents the aws public cloud plane name for UCP.
 	AWSPublic = "aws"
 
+	// GCPPublic represents the gcp public cloud plane name for UCP.
+	GCPPublic = "gcp"
+
 	// AzureServicePrincipalCredentialKind represents the kind of Azure service principal credential.
 	AzureServicePrincipalCredentialKind = ucp_dm.AzureServicePrincipalCredentialKind
 
@@ -42,6 +45,12 @@ const (
 
 	// AWSIRSACredentialKind represents the kind of AWS IRSA credential.
 	AWSIRSACredentialKind = ucp_dm.AWSIRSACredentialKind
+
+	// GCPServiceAccountKeyCredentialKind represents the kind of GCP service account key credential.
+	GCPServiceAccountKeyCredentialKind = ucp_dm.GCPServiceAccountKeyCredentialKind
+
+	// GCPWorkloadIdentityCredentialKind represents the kind of GCP workload identity federation credential.
+	GCPWorkloadIdentityCredentialKind = ucp_dm.GCPWorkloadIdentityCredentialKind
 )
 
 type (
@@ -57,6 +66,12 @@ type (
 	AWSAccessKeyCredential = ucp_dm.AWSAccessKeyCredentialProperties
 	// AWSIRSACredential represents a RoleARN for AWS IRSA.
 	AWSIRSACredential = ucp_dm.AWSIRSACredentialProperties
+	// GCPCredential represents a credential for GCP IAM.
+	GCPCredential = ucp_dm.GCPCredentialProperties
+	// GCPServiceAccountKeyCredential represents a credential for GCP service account key.
+	GCPServiceAccountKeyCredential = ucp_dm.GCPServiceAccountKeyCredentialProperties
+	// GCPWorkloadIdentityCredential represents a credential for GCP workload identity federation.
+	GCPWorkloadIdentityCredential = ucp_dm.GCPWorkloadIdentityCredentialProperties
 )
 
 // CredentialProvider is an UCP credential provider interface.
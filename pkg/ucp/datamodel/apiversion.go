@@ -21,6 +21,9 @@ import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 const (
 	// APIVersionResourceType is the resource type for an API version.
 	APIVersionResourceType = "System.Resources/resourceProviders/resourceTypes/apiVersions"
+
+	// APIVersionResourceUnqualifiedResourceType is the unqualified resource type for an API version.
+	APIVersionResourceUnqualifiedResourceType = "apiVersions"
 )
 
 // APIVersion represents an API version of a resource type.
@@ -40,4 +43,7 @@ func (r *APIVersion) ResourceTypeName() string {
 type APIVersionProperties struct {
 	// Schema is the schema for the resource type.
 	Schema map[string]any
+
+	// Lifecycle is the lifecycle of the API version.
+	Lifecycle *ResourceTypeLifecycle `json:"lifecycle,omitempty"`
 }
@@ -16,7 +16,11 @@ limitations under the License.
 
 package datamodel
 
-import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+import (
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
 
 // AWSResource represents any AWS Resource.
 // AWSResource is not a tracked resource, so it does not implement ResourceDataModel.
@@ -53,3 +57,19 @@ func (a *AWSResource) UpdateMetadata(ctx *v1.ARMRequestContext, oldResource *v1.
 func (a *AWSResource) ResourceTypeName() string {
 	return "UCP/AWSResource"
 }
+
+// AWSCloudFormationOperation records a stack operation started by the AWS proxy for a resource type that is not
+// supported by AWS Cloud Control, so that the operationStatuses and operationResults APIs can poll the stack.
+type AWSCloudFormationOperation struct {
+	// StackID is the ID of the AWS CloudFormation stack that manages the resource.
+	StackID string `json:"stackId"`
+
+	// Operation is the stack operation that was started, eg: CREATE, UPDATE or DELETE.
+	Operation string `json:"operation"`
+
+	// ResourceID is the UCP ID of the resource the operation applies to.
+	ResourceID string `json:"resourceId"`
+
+	// StartTime is the time the operation was started.
+	StartTime time.Time `json:"startTime"`
+}
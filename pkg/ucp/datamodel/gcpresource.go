@@ -0,0 +1,79 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
+
+// GCPResource represents any GCP Resource.
+// GCPResource is not a tracked resource, so it does not implement ResourceDataModel.
+// However need to implement the methods to satisfy the interface.
+type GCPResource struct {
+}
+
+// GetSystemData is not implemented for GCP proxy resource.
+func (g *GCPResource) GetSystemData() *v1.SystemData {
+	return nil
+}
+
+// GetBaseResource is not implemented for GCP proxy resource.
+func (g *GCPResource) GetBaseResource() *v1.BaseResource {
+	return nil
+}
+
+// ProvisioningState is not implemented for GCP proxy resource.
+func (g *GCPResource) ProvisioningState() v1.ProvisioningState {
+	return v1.ProvisioningState("")
+}
+
+// SetProvisioningState is not implemented for GCP proxy resource.
+func (g *GCPResource) SetProvisioningState(state v1.ProvisioningState) {
+
+}
+
+// UpdateMetadata is not implemented for GCP proxy resource.
+func (g *GCPResource) UpdateMetadata(ctx *v1.ARMRequestContext, oldResource *v1.BaseResource) {
+
+}
+
+// ResourceTypeName returns the resource type name.
+func (g *GCPResource) ResourceTypeName() string {
+	return "UCP/GCPResource"
+}
+
+// GCPOperation records a GCP long-running operation started by the GCP proxy, so that the operationStatuses
+// and operationResults APIs can poll it. GCP operation names are not UUIDs, so they cannot be used as the
+// operation ID in the async operation URLs directly.
+type GCPOperation struct {
+	// Name is the relative name of the GCP operation.
+	Name string `json:"name"`
+
+	// Service is the GCP service that owns the operation, eg: pubsub.
+	Service string `json:"service"`
+
+	// APIVersion is the version of the GCP REST API that started the operation, eg: v1.
+	APIVersion string `json:"apiVersion"`
+
+	// ResourceID is the UCP ID of the resource the operation applies to.
+	ResourceID string `json:"resourceId"`
+
+	// StartTime is the time the operation was started.
+	StartTime time.Time `json:"startTime"`
+}
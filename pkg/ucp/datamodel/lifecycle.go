@@ -0,0 +1,109 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import "time"
+
+// LifecycleState is the lifecycle state of a resource type or API version.
+type LifecycleState string
+
+const (
+	// LifecycleStatePreview indicates that the resource type or API version is in preview and may change.
+	LifecycleStatePreview LifecycleState = "Preview"
+
+	// LifecycleStateGA indicates that the resource type or API version is generally available.
+	LifecycleStateGA LifecycleState = "GA"
+
+	// LifecycleStateDeprecated indicates that the resource type or API version is deprecated. Existing resources keep
+	// working, and users are warned when they use it.
+	LifecycleStateDeprecated LifecycleState = "Deprecated"
+
+	// LifecycleStateRetired indicates that the resource type or API version is retired. New resources can no longer be
+	// created with it.
+	LifecycleStateRetired LifecycleState = "Retired"
+)
+
+// ResourceTypeLifecycle stores the lifecycle of a resource type or API version.
+type ResourceTypeLifecycle struct {
+	// State is the lifecycle state.
+	State LifecycleState `json:"state"`
+
+	// SunsetDate is the date from which new resources can no longer be created.
+	SunsetDate *time.Time `json:"sunsetDate,omitempty"`
+
+	// Message is a message for users, such as the resource type or API version to use instead.
+	Message *string `json:"message,omitempty"`
+}
+
+// IsDeprecated returns true if users should be warned when they use the resource type or API version.
+func (l *ResourceTypeLifecycle) IsDeprecated() bool {
+	if l == nil {
+		return false
+	}
+
+	return l.State == LifecycleStateDeprecated || l.State == LifecycleStateRetired || l.SunsetDate != nil
+}
+
+// IsSunset returns true if new resources can no longer be created with the resource type or API version at the given time.
+func (l *ResourceTypeLifecycle) IsSunset(now time.Time) bool {
+	if l == nil {
+		return false
+	}
+
+	return l.State == LifecycleStateRetired || (l.SunsetDate != nil && !now.Before(*l.SunsetDate))
+}
+
+// severity orders the lifecycle states by how much they restrict the use of a resource type or API version.
+func (s LifecycleState) severity() int {
+	switch s {
+	case LifecycleStateRetired:
+		return 2
+	case LifecycleStateDeprecated:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// EffectiveLifecycle returns the lifecycle that applies to a resource type when used with an API version.
+// The lifecycle of the API version takes precedence over the lifecycle of the resource type, except that the API
+// version can't lift the deprecation, retirement or sunset date of the resource type: every API version of a
+// retired resource type is retired.
+func EffectiveLifecycle(resourceType *ResourceTypeLifecycle, apiVersion *ResourceTypeLifecycle) *ResourceTypeLifecycle {
+	if apiVersion == nil {
+		return resourceType
+	} else if resourceType == nil {
+		return apiVersion
+	}
+
+	effective := *apiVersion
+	if resourceType.State.severity() > effective.State.severity() {
+		effective.State = resourceType.State
+		if resourceType.Message != nil {
+			effective.Message = resourceType.Message
+		}
+	}
+
+	if resourceType.SunsetDate != nil && (effective.SunsetDate == nil || resourceType.SunsetDate.Before(*effective.SunsetDate)) {
+		effective.SunsetDate = resourceType.SunsetDate
+		if resourceType.Message != nil {
+			effective.Message = resourceType.Message
+		}
+	}
+
+	return &effective
+}
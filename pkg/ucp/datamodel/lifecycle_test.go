@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EffectiveLifecycle(t *testing.T) {
+	early := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		resourceType *ResourceTypeLifecycle
+		apiVersion   *ResourceTypeLifecycle
+		expected     *ResourceTypeLifecycle
+	}{
+		{
+			name: "no lifecycle",
+		},
+		{
+			name:         "resource type only",
+			resourceType: &ResourceTypeLifecycle{State: LifecycleStateDeprecated},
+			expected:     &ResourceTypeLifecycle{State: LifecycleStateDeprecated},
+		},
+		{
+			name:       "api version only",
+			apiVersion: &ResourceTypeLifecycle{State: LifecycleStatePreview},
+			expected:   &ResourceTypeLifecycle{State: LifecycleStatePreview},
+		},
+		{
+			name:         "api version is more restrictive",
+			resourceType: &ResourceTypeLifecycle{State: LifecycleStateDeprecated, Message: new("type")},
+			apiVersion:   &ResourceTypeLifecycle{State: LifecycleStateRetired, Message: new("version")},
+			expected:     &ResourceTypeLifecycle{State: LifecycleStateRetired, Message: new("version")},
+		},
+		{
+			name:         "GA api version of a retired resource type",
+			resourceType: &ResourceTypeLifecycle{State: LifecycleStateRetired, Message: new("type")},
+			apiVersion:   &ResourceTypeLifecycle{State: LifecycleStateGA},
+			expected:     &ResourceTypeLifecycle{State: LifecycleStateRetired, Message: new("type")},
+		},
+		{
+			name:         "earliest sunset date applies",
+			resourceType: &ResourceTypeLifecycle{State: LifecycleStateDeprecated, SunsetDate: &early},
+			apiVersion:   &ResourceTypeLifecycle{State: LifecycleStateDeprecated, SunsetDate: &late, Message: new("version")},
+			expected:     &ResourceTypeLifecycle{State: LifecycleStateDeprecated, SunsetDate: &early, Message: new("version")},
+		},
+		{
+			name:         "GA api version of a resource type with a sunset date",
+			resourceType: &ResourceTypeLifecycle{State: LifecycleStateGA, SunsetDate: &early},
+			apiVersion:   &ResourceTypeLifecycle{State: LifecycleStateGA},
+			expected:     &ResourceTypeLifecycle{State: LifecycleStateGA, SunsetDate: &early},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, EffectiveLifecycle(tt.resourceType, tt.apiVersion))
+		})
+	}
+}
@@ -16,7 +16,11 @@ limitations under the License.
 
 package datamodel
 
-import v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+import (
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+)
 
 const (
 	// LocationResourceType is the resource type for a resource provider location
@@ -44,10 +48,102 @@ type LocationProperties struct {
 	// Address is the address (url) of the resource provider.
 	Address *string `json:"address,omitempty"`
 
+	// Addresses is the list of additional addresses (urls) of the resource provider. Requests fail over to these
+	// addresses when Address is unhealthy.
+	Addresses []string `json:"addresses,omitempty"`
+
+	// RoutingPolicy is the policy for routing requests across the addresses of the location.
+	RoutingPolicy LocationRoutingPolicy `json:"routingPolicy,omitempty"`
+
+	// HealthProbe is the health probe for the addresses of the location.
+	HealthProbe *LocationHealthProbe `json:"healthProbe,omitempty"`
+
 	// ResourceTypes defines the configuration for resource types supported in this location.
 	ResourceTypes map[string]LocationResourceTypeConfiguration `json:"resourceTypes,omitempty"`
 }
 
+// AllAddresses returns the addresses of the location in priority order. Address is first, followed by Addresses.
+// Duplicate and empty addresses are omitted.
+func (p *LocationProperties) AllAddresses() []string {
+	candidates := []string{}
+	if p.Address != nil {
+		candidates = append(candidates, *p.Address)
+	}
+	candidates = append(candidates, p.Addresses...)
+
+	result := []string{}
+	seen := map[string]bool{}
+	for _, address := range candidates {
+		if address == "" || seen[address] {
+			continue
+		}
+		seen[address] = true
+		result = append(result, address)
+	}
+
+	return result
+}
+
+// LocationRoutingPolicy is the policy for routing requests across the addresses of a location.
+type LocationRoutingPolicy string
+
+const (
+	// LocationRoutingPolicyActiveStandby sends requests to the first healthy address, in priority order.
+	// This is the default.
+	LocationRoutingPolicyActiveStandby LocationRoutingPolicy = "ActiveStandby"
+
+	// LocationRoutingPolicyRoundRobin distributes requests across the healthy addresses.
+	LocationRoutingPolicyRoundRobin LocationRoutingPolicy = "RoundRobin"
+)
+
+// LocationHealthProbe represents the health probe for the addresses of a location.
+type LocationHealthProbe struct {
+	// Path is the path that is probed on each address, for example "/healthz". A 2xx response is healthy.
+	// When Path is not set, an address is healthy if it responds with a status code below 500.
+	Path *string `json:"path,omitempty"`
+}
+
+// LocationHealthStatus is the health status of a location or one of its addresses.
+type LocationHealthStatus string
+
+const (
+	// LocationHealthStatusUnknown means the health has not been probed yet.
+	LocationHealthStatusUnknown LocationHealthStatus = "Unknown"
+
+	// LocationHealthStatusHealthy means all addresses are healthy.
+	LocationHealthStatusHealthy LocationHealthStatus = "Healthy"
+
+	// LocationHealthStatusDegraded means some, but not all, addresses are unhealthy.
+	LocationHealthStatusDegraded LocationHealthStatus = "Degraded"
+
+	// LocationHealthStatusUnhealthy means all addresses are unhealthy.
+	LocationHealthStatusUnhealthy LocationHealthStatus = "Unhealthy"
+)
+
+// LocationHealth represents the health of the addresses of a location.
+type LocationHealth struct {
+	// Status is the overall health status of the location.
+	Status LocationHealthStatus `json:"status"`
+
+	// Addresses is the health of each address of the location, in priority order.
+	Addresses []LocationAddressHealth `json:"addresses,omitempty"`
+}
+
+// LocationAddressHealth represents the health of an address of a location.
+type LocationAddressHealth struct {
+	// Address is the address (url) of the resource provider.
+	Address string `json:"address"`
+
+	// Status is the health status of the address.
+	Status LocationHealthStatus `json:"status"`
+
+	// LastProbeTime is the time of the last health probe.
+	LastProbeTime *time.Time `json:"lastProbeTime,omitempty"`
+
+	// Message describes the result of the last health probe when the address is unhealthy.
+	Message *string `json:"message,omitempty"`
+}
+
 // LocationResourceTypeConfiguration represents the configuration for resource type in a location.
 type LocationResourceTypeConfiguration struct {
 	// APIVersions defines the configuration for API versions supported for this resource type in this location.
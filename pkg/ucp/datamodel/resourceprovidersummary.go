@@ -64,7 +64,9 @@ type ResourceProviderSummaryProperties struct {
 
 // ResourceProviderSummaryLocation represents a location where a resource provider is available.
 type ResourceProviderSummaryPropertiesLocation struct {
-	// Empty for now.
+	// Health is the health of the addresses of the location. This is not stored, it is populated from the
+	// health probes when the summary is read.
+	Health *LocationHealth `json:"health,omitempty"`
 }
 
 // ResourceProviderSummaryResourceType represents a resource type available in a resource provider.
@@ -84,6 +86,9 @@ type ResourceProviderSummaryPropertiesResourceType struct {
 	// IconHash is the SHA-256 hash of the icon's SVG bytes, computed by the control plane.
 	IconHash *string `json:"iconHash,omitempty"`
 
+	// Lifecycle is the lifecycle of the resource type.
+	Lifecycle *ResourceTypeLifecycle `json:"lifecycle,omitempty"`
+
 	// APIVersions is the list of API versions available for the resource type.
 	APIVersions map[string]ResourceProviderSummaryPropertiesAPIVersion `json:"apiVersions,omitempty"`
 }
@@ -92,4 +97,7 @@ type ResourceProviderSummaryPropertiesResourceType struct {
 type ResourceProviderSummaryPropertiesAPIVersion struct {
 	// Schema holds the resource type definitions for this API version.
 	Schema map[string]any `json:"schema,omitempty"`
+
+	// Lifecycle is the lifecycle of the API version.
+	Lifecycle *ResourceTypeLifecycle `json:"lifecycle,omitempty"`
 }
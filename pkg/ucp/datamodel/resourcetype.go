@@ -55,4 +55,7 @@ type ResourceTypeProperties struct {
 
 	// IconHash is the SHA-256 hash of the icon's SVG bytes, computed by the control plane.
 	IconHash *string `json:"iconHash,omitempty"`
+
+	// Lifecycle is the lifecycle of the resource type. The lifecycle of an API version takes precedence over it.
+	Lifecycle *ResourceTypeLifecycle `json:"lifecycle,omitempty"`
 }
@@ -0,0 +1,50 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datamodel
+
+const (
+	// ResourceTypeUsageResourceType is the resource type for the usage of a resource type.
+	//
+	// These are **READONLY** virtual resources served from URLs like:
+	//
+	// /planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources/usage
+	ResourceTypeUsageResourceType = "System.Resources/resourceProviders/resourceTypes/usage"
+)
+
+// ResourceTypeUsage represents the stored resources of a resource type.
+type ResourceTypeUsage struct {
+	// ResourceType is the fully-qualified resource type. Example: 'Applications.Test/testResources'.
+	ResourceType string `json:"resourceType"`
+
+	// APIVersions maps an API version to the IDs of the stored resources that were last written with it.
+	APIVersions map[string][]string `json:"apiVersions"`
+}
+
+// ResourceTypeName gives the type of the resource.
+func (r *ResourceTypeUsage) ResourceTypeName() string {
+	return ResourceTypeUsageResourceType
+}
+
+// Count returns the total number of stored resources of the resource type.
+func (r *ResourceTypeUsage) Count() int {
+	count := 0
+	for _, ids := range r.APIVersions {
+		count += len(ids)
+	}
+
+	return count
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
 	aws_frontend "github.com/radius-project/radius/pkg/ucp/frontend/aws"
 	azure_frontend "github.com/radius-project/radius/pkg/ucp/frontend/azure"
+	gcp_frontend "github.com/radius-project/radius/pkg/ucp/frontend/gcp"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
 	radius_frontend "github.com/radius-project/radius/pkg/ucp/frontend/radius"
 	"github.com/radius-project/radius/pkg/ucp/frontend/versions"
@@ -57,6 +58,7 @@ func DefaultModules(options *ucp.Options) []modules.Initializer {
 	return []modules.Initializer{
 		aws_frontend.NewModule(options),
 		azure_frontend.NewModule(options),
+		gcp_frontend.NewModule(options),
 		radius_frontend.NewModule(options),
 	}
 }
@@ -178,6 +180,13 @@ func (s *Service) createPlane(ctx context.Context, plane ucp.Plane) error {
 				ResponseConverter: converter.AzurePlaneDataModelToVersioned,
 			})
 
+	case "gcp":
+		ctrl, err = defaultoperation.NewDefaultSyncPut(opts,
+			armrpc_controller.ResourceOptions[datamodel.GCPPlane]{
+				RequestConverter:  converter.GCPPlaneDataModelFromVersioned,
+				ResponseConverter: converter.GCPPlaneDataModelToVersioned,
+			})
+
 	case "radius":
 		ctrl, err = defaultoperation.NewDefaultSyncPut(opts,
 			armrpc_controller.ResourceOptions[datamodel.RadiusPlane]{
@@ -56,6 +56,9 @@ const (
 
 	// OperationTypeAWSResource is the operation type for CRUDL operations on AWS resources.
 	OperationTypeAWSResource = "AWSRESOURCE"
+
+	// OperationMethodDrift is the operation method for drift detection of AWS resources.
+	OperationMethodDrift v1.OperationMethod = "ACTIONDRIFT"
 )
 
 // Initialize initializes the AWS module.
@@ -201,6 +204,17 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 				return awsproxy_ctrl.NewGetAWSResource(opt, m.AWSClients)
 			},
 		},
+		{
+			// Drift detection is only supported for resources deployed with AWS CloudFormation.
+			ParentRouter:  resourceCollectionRouter,
+			Path:          "/{resourceName}/drift",
+			Method:        OperationMethodDrift,
+			OperationType: &v1.OperationType{Type: OperationTypeAWSResource, Method: OperationMethodDrift},
+			ResourceType:  OperationTypeAWSResource,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return awsproxy_ctrl.NewDetectAWSResourceDrift(opt, m.AWSClients)
+			},
+		},
 	}...)
 
 	// URLs for "non-idempotent" resource lifecycle operations. These are extensions to the UCP spec that are needed when
@@ -320,6 +334,7 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 func (m *Module) newAWSConfig(ctx context.Context) (aws.Config, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	credProviders := []func(*config.LoadOptions) error{}
+	credential := ""
 
 	switch m.options.Config.Identity.AuthMethod {
 	case ucp.AuthUCPCredential:
@@ -329,6 +344,7 @@ func (m *Module) newAWSConfig(ctx context.Context) (aws.Config, error) {
 		}
 		p := ucp_aws.NewUCPCredentialProvider(provider, ucp_aws.DefaultExpireDuration)
 		credProviders = append(credProviders, config.WithCredentialsProvider(p))
+		credential = m.PlaneType() + "/default"
 		logger.Info("Configuring 'UCPCredential' authentication mode using UCP Credential API")
 
 	default:
@@ -340,5 +356,11 @@ func (m *Module) newAWSConfig(ctx context.Context) (aws.Config, error) {
 		return aws.Config{}, err
 	}
 
+	if m.options.Throttler != nil {
+		// The AWS SDK retries throttled requests, eg: 400 ThrottlingException, so the transport only applies the
+		// rate limits and the circuit breaker.
+		awscfg.HTTPClient = &http.Client{Transport: m.options.Throttler.TransportWithoutRetries(nil, m.PlaneType(), credential)}
+	}
+
 	return awscfg, nil
 }
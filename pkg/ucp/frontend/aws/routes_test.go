@@ -88,6 +88,10 @@ func Test_Routes(t *testing.T) {
 			OperationType: v1.OperationType{Type: OperationTypeAWSResource, Method: v1.OperationDelete},
 			Method:        http.MethodDelete,
 			Path:          "/planes/aws/aws/accounts/0000000/regions/some-region/providers/AWS.Kinesis/Stream/some-stream",
+		}, {
+			OperationType: v1.OperationType{Type: OperationTypeAWSResource, Method: OperationMethodDrift},
+			Method:        http.MethodPost,
+			Path:          "/planes/aws/aws/accounts/0000000/regions/some-region/providers/AWS.Kinesis/Stream/some-stream/drift",
 		}, {
 			OperationType: v1.OperationType{Type: OperationTypeAWSResource, Method: v1.OperationGetImperative},
 			Method:        http.MethodPost,
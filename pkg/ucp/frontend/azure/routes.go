@@ -30,6 +30,7 @@ import (
 	azure_credential_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials/azure"
 	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
 	"github.com/radius-project/radius/pkg/validator"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 const (
@@ -68,6 +69,8 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 	credentialCollectionRouter := server.NewSubrouter(baseRouter, credentialCollectionPath, apiValidator)
 	credentialResourceRouter := server.NewSubrouter(baseRouter, credentialResourcePath, apiValidator)
 
+	transport := m.options.Throttler.Transport(otelhttp.NewTransport(http.DefaultTransport), m.PlaneType(), "")
+
 	handlerOptions := []server.HandlerOptions{
 		{
 			// This is a scope query so we can't use the default operation.
@@ -157,11 +160,13 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		// Note that the API validation is not applied for CatchAllPath(/*).
 		{
 			// Method deliberately omitted. This is a catch-all route for proxying.
-			ParentRouter:      planeResourceRouter,
-			Path:              server.CatchAllPath,
-			OperationType:     &v1.OperationType{Type: OperationTypeUCPAzureProxy, Method: v1.OperationProxy},
-			ResourceType:      OperationTypeUCPAzureProxy,
-			ControllerFactory: planes_ctrl.NewProxyController,
+			ParentRouter:  planeResourceRouter,
+			Path:          server.CatchAllPath,
+			OperationType: &v1.OperationType{Type: OperationTypeUCPAzureProxy, Method: v1.OperationProxy},
+			ResourceType:  OperationTypeUCPAzureProxy,
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return planes_ctrl.NewProxyController(opts, transport)
+			},
 		},
 	}
 
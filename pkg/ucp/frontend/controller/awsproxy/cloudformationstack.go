@@ -0,0 +1,408 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsproxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/google/uuid"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	ucp_aws "github.com/radius-project/radius/pkg/ucp/aws"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// Resource types that AWS Cloud Control cannot provision are deployed as a CloudFormation stack containing a single
+// resource. The stack is named after the UCP resource ID, so that it can be found again without any other state.
+const (
+	// stackLogicalResourceID is the logical ID of the resource in the stack template.
+	stackLogicalResourceID = "Resource"
+
+	// stackNamePrefix is the prefix of the names of stacks created by UCP.
+	stackNamePrefix = "radius-"
+
+	// stackNameMaxTypeLength is the maximum length of the resource type segment of a stack name. Stack names
+	// are limited to 128 characters.
+	stackNameMaxTypeLength = 80
+
+	// stackTagResourceID is the stack tag that records the UCP resource ID.
+	stackTagResourceID = "radius:resource-id"
+
+	// stackTagResourceType is the stack tag that records the UCP resource type.
+	stackTagResourceType = "radius:resource-type"
+
+	stackOperationCreate = "CREATE"
+	stackOperationUpdate = "UPDATE"
+	stackOperationDelete = "DELETE"
+
+	// stackOperationRetention is how long a stack operation record is kept. Clients may poll the operation, retry a
+	// poll or follow the Location header after the operation is terminal, so the record is kept for the same 7 days
+	// that AWS Cloud Control keeps the status of its resource requests.
+	stackOperationRetention = 7 * 24 * time.Hour
+)
+
+var stackNameInvalidCharacters = regexp.MustCompile(`[^a-zA-Z0-9-]+`)
+
+// stackName returns the name of the CloudFormation stack for the given UCP resource ID, eg:
+// radius-aws-kinesis-stream-0123456789abcdef.
+func stackName(id resources.ID) string {
+	resourceType := strings.ToLower(stackNameInvalidCharacters.ReplaceAllString(id.Type(), "-"))
+	if len(resourceType) > stackNameMaxTypeLength {
+		resourceType = resourceType[:stackNameMaxTypeLength]
+	}
+
+	hash := sha256.Sum256([]byte(strings.ToLower(id.String())))
+	return stackNamePrefix + strings.Trim(resourceType, "-") + "-" + hex.EncodeToString(hash[:8])
+}
+
+// stackTemplate returns a CloudFormation template that declares a single resource with the given properties.
+func stackTemplate(awsResourceType string, properties map[string]any) (string, error) {
+	template := map[string]any{
+		"AWSTemplateFormatVersion": "2010-09-09",
+		"Resources": map[string]any{
+			stackLogicalResourceID: map[string]any{
+				"Type":       awsResourceType,
+				"Properties": properties,
+			},
+		},
+	}
+
+	b, err := json.Marshal(template)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// stackTags returns the tags that identify a stack as managing the given UCP resource.
+func stackTags(id resources.ID) []types.Tag {
+	return []types.Tag{
+		{Key: aws.String(stackTagResourceID), Value: aws.String(id.String())},
+		{Key: aws.String(stackTagResourceType), Value: aws.String(id.Type())},
+	}
+}
+
+// stackTag returns the value of the given stack tag, or an empty string if the stack does not have the tag.
+func stackTag(stack *types.Stack, key string) string {
+	for _, tag := range stack.Tags {
+		if aws.ToString(tag.Key) == key {
+			return aws.ToString(tag.Value)
+		}
+	}
+
+	return ""
+}
+
+// stackCapabilities are the capabilities acknowledged when creating or updating a stack. Resource types in the IAM
+// namespace cannot be deployed without them.
+var stackCapabilities = []types.Capability{types.CapabilityCapabilityIam, types.CapabilityCapabilityNamedIam}
+
+// describeStack returns the CloudFormation stack with the given name or ID. It returns nil if the stack does not exist
+// or has been deleted.
+func describeStack(ctx context.Context, client ucp_aws.AWSCloudFormationClient, name string, opts ...func(*cloudformation.Options)) (*types.Stack, error) {
+	response, err := client.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
+		StackName: aws.String(name),
+	}, opts...)
+	if ucp_aws.IsCloudFormationStackNotFoundError(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if len(response.Stacks) == 0 || response.Stacks[0].StackStatus == types.StackStatusDeleteComplete {
+		return nil, nil
+	}
+
+	return &response.Stacks[0], nil
+}
+
+// stackResourceExists returns true if the stack has created its resource. A stack that failed to create is rolled
+// back and no longer has a resource.
+func stackResourceExists(stack *types.Stack) bool {
+	switch stack.StackStatus {
+	case types.StackStatusCreateInProgress, types.StackStatusCreateFailed,
+		types.StackStatusRollbackInProgress, types.StackStatusRollbackFailed, types.StackStatusRollbackComplete,
+		types.StackStatusReviewInProgress:
+		return false
+	}
+
+	return true
+}
+
+// stackProperties returns the properties of the resource declared in the stack template.
+func stackProperties(ctx context.Context, client ucp_aws.AWSCloudFormationClient, stackID string, opts ...func(*cloudformation.Options)) (map[string]any, error) {
+	response, err := client.GetTemplate(ctx, &cloudformation.GetTemplateInput{
+		StackName:     aws.String(stackID),
+		TemplateStage: types.TemplateStageOriginal,
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	template := struct {
+		Resources map[string]struct {
+			Properties map[string]any `json:"Properties"`
+		} `json:"Resources"`
+	}{}
+	if err := json.Unmarshal([]byte(aws.ToString(response.TemplateBody)), &template); err != nil {
+		return nil, err
+	}
+
+	properties := template.Resources[stackLogicalResourceID].Properties
+	if properties == nil {
+		properties = map[string]any{}
+	}
+
+	return properties, nil
+}
+
+// stackProvisioningState maps the status of a CloudFormation stack to a provisioning state.
+func stackProvisioningState(status types.StackStatus) v1.ProvisioningState {
+	switch status {
+	case types.StackStatusCreateComplete, types.StackStatusUpdateComplete, types.StackStatusDeleteComplete,
+		types.StackStatusImportComplete:
+		return v1.ProvisioningStateSucceeded
+	case types.StackStatusCreateFailed, types.StackStatusUpdateFailed, types.StackStatusDeleteFailed,
+		types.StackStatusRollbackFailed, types.StackStatusRollbackComplete,
+		types.StackStatusUpdateRollbackFailed, types.StackStatusUpdateRollbackComplete,
+		types.StackStatusImportRollbackFailed, types.StackStatusImportRollbackComplete:
+		return v1.ProvisioningStateFailed
+	default:
+		return v1.ProvisioningStateProvisioning
+	}
+}
+
+// createOrUpdateStack deploys the resource as a single-resource AWS CloudFormation stack. This is used for resource
+// types that AWS Cloud Control does not support.
+func createOrUpdateStack(ctx context.Context, databaseClient database.Client, client ucp_aws.AWSCloudFormationClient, id resources.ID, awsResourceType string, properties map[string]any, pathBase string, opts ...func(*cloudformation.Options)) (armrpc_rest.Response, error) {
+	name := stackName(id)
+	template, err := stackTemplate(awsResourceType, properties)
+	if err != nil {
+		return nil, err
+	}
+
+	stack, err := describeStack(ctx, client, name, opts...)
+	if err != nil {
+		return ucp_aws.HandleAWSError(err)
+	}
+
+	if stack != nil && stackProvisioningState(stack.StackStatus) == v1.ProvisioningStateProvisioning {
+		return armrpc_rest.NewConflictResponse(fmt.Sprintf("the AWS CloudFormation stack %q for resource %q has an operation in progress", name, id.String())), nil
+	}
+
+	// A stack that failed to create can only be deleted.
+	if stack != nil && stack.StackStatus == types.StackStatusRollbackComplete {
+		return armrpc_rest.NewConflictResponse(fmt.Sprintf("the AWS CloudFormation stack %q for resource %q failed to create, delete the resource and try again", name, id.String())), nil
+	}
+
+	responseProperties := maps.Clone(properties)
+	responseBody := map[string]any{
+		"id":         id.String(),
+		"name":       id.Name(),
+		"type":       id.Type(),
+		"properties": responseProperties,
+	}
+
+	operation := uuid.New()
+	var stackID, stackOperation string
+	if stack == nil {
+		response, err := client.CreateStack(ctx, &cloudformation.CreateStackInput{
+			StackName:          aws.String(name),
+			TemplateBody:       aws.String(template),
+			Tags:               stackTags(id),
+			Capabilities:       stackCapabilities,
+			ClientRequestToken: aws.String(operation.String()),
+		}, opts...)
+		if err != nil {
+			return ucp_aws.HandleAWSError(err)
+		}
+
+		stackID, stackOperation = aws.ToString(response.StackId), stackOperationCreate
+	} else {
+		response, err := client.UpdateStack(ctx, &cloudformation.UpdateStackInput{
+			StackName:          stack.StackId,
+			TemplateBody:       aws.String(template),
+			Tags:               stackTags(id),
+			Capabilities:       stackCapabilities,
+			ClientRequestToken: aws.String(operation.String()),
+		}, opts...)
+		if ucp_aws.IsCloudFormationNoUpdatesError(err) {
+			// The resource is already in the desired state.
+			responseProperties["provisioningState"] = v1.ProvisioningStateSucceeded
+			return armrpc_rest.NewOKResponse(responseBody), nil
+		} else if err != nil {
+			return ucp_aws.HandleAWSError(err)
+		}
+
+		stackID, stackOperation = aws.ToString(response.StackId), stackOperationUpdate
+	}
+
+	err = saveStackOperation(ctx, databaseClient, id, operation, stackID, stackOperation)
+	if err != nil {
+		return nil, err
+	}
+
+	responseProperties["provisioningState"] = v1.ProvisioningStateProvisioning
+	return armrpc_rest.NewAsyncOperationResponse(responseBody, v1.LocationGlobal, 201, id, operation, "", id.RootScope(), pathBase), nil
+}
+
+// getStackResource returns the properties of the resource deployed by the AWS CloudFormation stack for the resource
+// ID. It returns nil if the stack does not exist or has not created its resource.
+func getStackResource(ctx context.Context, client ucp_aws.AWSCloudFormationClient, id resources.ID, opts ...func(*cloudformation.Options)) (map[string]any, error) {
+	stack, err := describeStack(ctx, client, stackName(id), opts...)
+	if err != nil {
+		return nil, err
+	} else if stack == nil || !stackResourceExists(stack) {
+		return nil, nil
+	}
+
+	return stackProperties(ctx, client, aws.ToString(stack.StackId), opts...)
+}
+
+// deleteStack deletes the AWS CloudFormation stack for the resource ID, which deletes the resource it manages.
+func deleteStack(ctx context.Context, databaseClient database.Client, client ucp_aws.AWSCloudFormationClient, id resources.ID, pathBase string, opts ...func(*cloudformation.Options)) (armrpc_rest.Response, error) {
+	stack, err := describeStack(ctx, client, stackName(id), opts...)
+	if err != nil {
+		return ucp_aws.HandleAWSError(err)
+	} else if stack == nil {
+		return armrpc_rest.NewNoContentResponse(), nil
+	}
+
+	operation := uuid.New()
+	_, err = client.DeleteStack(ctx, &cloudformation.DeleteStackInput{
+		StackName:          stack.StackId,
+		ClientRequestToken: aws.String(operation.String()),
+	}, opts...)
+	if err != nil {
+		return ucp_aws.HandleAWSError(err)
+	}
+
+	err = saveStackOperation(ctx, databaseClient, id, operation, aws.ToString(stack.StackId), stackOperationDelete)
+	if err != nil {
+		return nil, err
+	}
+
+	return armrpc_rest.NewAsyncOperationResponse(map[string]any{}, v1.LocationGlobal, 202, id, operation, "", id.RootScope(), pathBase), nil
+}
+
+// stackOperationStatus returns the async operation status for a stack operation.
+func stackOperationStatus(record *datamodel.AWSCloudFormationOperation, stack *types.Stack) v1.AsyncOperationStatus {
+	os := v1.AsyncOperationStatus{
+		Status:    stackProvisioningState(stack.StackStatus),
+		StartTime: record.StartTime,
+	}
+
+	if os.Status.IsTerminal() && stack.LastUpdatedTime != nil {
+		os.EndTime = stack.LastUpdatedTime
+	}
+
+	if os.Status == v1.ProvisioningStateFailed {
+		os.Error = &v1.ErrorDetails{
+			Code:    string(stack.StackStatus),
+			Message: aws.ToString(stack.StackStatusReason),
+		}
+	}
+
+	return os
+}
+
+// stackOperationRecordID returns the ID of the stack operation record for the given operationStatuses or
+// operationResults ID.
+func stackOperationRecordID(id resources.ID) string {
+	location := v1.LocationGlobal
+	if segments := id.TypeSegments(); len(segments) > 0 && segments[0].Name != "" {
+		location = segments[0].Name
+	}
+
+	return fmt.Sprintf("%s/providers/%s/locations/%s/operationStatuses/%s", id.RootScope(), id.ProviderNamespace(), location, id.Name())
+}
+
+// saveStackOperation saves a record of a stack operation for the given resource. AWS Cloud Control does not know about
+// stack operations, so the record is used by the operationStatuses and operationResults APIs to find the stack. The
+// record expires after stackOperationRetention.
+func saveStackOperation(ctx context.Context, databaseClient database.Client, id resources.ID, operationID uuid.UUID, stackID string, operation string) error {
+	record := &datamodel.AWSCloudFormationOperation{
+		StackID:    stackID,
+		Operation:  operation,
+		ResourceID: id.String(),
+		StartTime:  time.Now().UTC(),
+	}
+
+	recordID := fmt.Sprintf("%s/providers/%s/locations/%s/operationStatuses/%s", id.RootScope(), id.ProviderNamespace(), v1.LocationGlobal, operationID.String())
+	return databaseClient.Save(ctx, &database.Object{
+		Metadata: database.Metadata{ID: recordID},
+		Data:     record,
+	})
+}
+
+// getStackOperation fetches the stack operation record for the given operationStatuses or operationResults ID and the
+// stack it applies to. It returns nil if the operation record does not exist or has expired, and deletes expired records.
+// The stack is nil if it no longer exists.
+func getStackOperation(ctx context.Context, databaseClient database.Client, client ucp_aws.AWSCloudFormationClient, id resources.ID, opts ...func(*cloudformation.Options)) (*datamodel.AWSCloudFormationOperation, *types.Stack, error) {
+	obj, err := databaseClient.Get(ctx, stackOperationRecordID(id))
+	if err != nil {
+		if errors.Is(err, &database.ErrNotFound{}) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	record := &datamodel.AWSCloudFormationOperation{}
+	if err := obj.As(record); err != nil {
+		return nil, nil, err
+	}
+
+	if time.Since(record.StartTime) > stackOperationRetention {
+		err := databaseClient.Delete(ctx, stackOperationRecordID(id))
+		if err != nil && !errors.Is(err, &database.ErrNotFound{}) {
+			return nil, nil, err
+		}
+		return nil, nil, nil
+	}
+
+	// Stacks are described by their stack ID so that deleted stacks are returned with a DELETE_COMPLETE status.
+	response, err := client.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
+		StackName: aws.String(record.StackID),
+	}, opts...)
+	if ucp_aws.IsCloudFormationStackNotFoundError(err) {
+		return record, nil, nil
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	if len(response.Stacks) == 0 {
+		return record, nil, nil
+	}
+
+	return record, &response.Stacks[0], nil
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsproxy
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cfn_types "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/smithy-go"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	testStackID           = "arn:aws:cloudformation:us-west-2:123456789012:stack/radius-aws-kinesis-stream/00000000-0000-0000-0000-000000000000"
+	testStackTemplateBody = `{"AWSTemplateFormatVersion":"2010-09-09","Resources":{"Resource":{"Type":"AWS::Kinesis::Stream","Properties":{"RetentionPeriodHours":178,"ShardCount":3}}}}`
+)
+
+// testStackNotFoundError is the error returned by AWS CloudFormation for a stack that does not exist.
+var testStackNotFoundError = &smithy.GenericAPIError{
+	Code:    "ValidationError",
+	Message: "Stack with id radius-aws-kinesis-stream does not exist",
+}
+
+// newTestStack returns an AWS CloudFormation stack managing the test resource with the given status.
+func newTestStack(t *testing.T, testResource *AWSTestResource, status cfn_types.StackStatus) cfn_types.Stack {
+	id, err := resources.ParseResource(testResource.SingleResourcePath)
+	require.NoError(t, err)
+
+	return cfn_types.Stack{
+		StackId:     aws.String(testStackID),
+		StackName:   aws.String(stackName(id)),
+		StackStatus: status,
+		Tags:        stackTags(id),
+	}
+}
+
+// newTestStackOperation returns the database object for a stack operation record.
+func newTestStackOperation(testResource *AWSTestResource, operation string, startTime time.Time) *database.Object {
+	return &database.Object{
+		Data: map[string]any{
+			"stackId":    testStackID,
+			"operation":  operation,
+			"resourceId": testResource.SingleResourcePath,
+			"startTime":  startTime.Format(time.RFC3339Nano),
+		},
+	}
+}
+
+func Test_StackName(t *testing.T) {
+	id := resources.MustParse("/planes/aws/aws/accounts/1234567/regions/us-west-2/providers/AWS.Kinesis/Stream/my-stream")
+
+	name := stackName(id)
+	require.Regexp(t, regexp.MustCompile(`^radius-aws-kinesis-stream-[0-9a-f]{16}$`), name)
+	require.LessOrEqual(t, len(name), 128)
+
+	// Stack names are case-insensitive like resource IDs, and unique per resource.
+	require.Equal(t, name, stackName(resources.MustParse("/planes/aws/aws/accounts/1234567/regions/us-west-2/providers/AWS.Kinesis/Stream/MY-STREAM")))
+	require.NotEqual(t, name, stackName(resources.MustParse("/planes/aws/aws/accounts/1234567/regions/us-west-2/providers/AWS.Kinesis/Stream/other-stream")))
+}
+
+func Test_StackTemplate(t *testing.T) {
+	template, err := stackTemplate("AWS::Kinesis::Stream", map[string]any{"RetentionPeriodHours": 178, "ShardCount": 3})
+	require.NoError(t, err)
+	require.JSONEq(t, testStackTemplateBody, template)
+}
+
+func Test_StackProvisioningState(t *testing.T) {
+	tests := []struct {
+		status   cfn_types.StackStatus
+		expected v1.ProvisioningState
+	}{
+		{cfn_types.StackStatusCreateInProgress, v1.ProvisioningStateProvisioning},
+		{cfn_types.StackStatusUpdateCompleteCleanupInProgress, v1.ProvisioningStateProvisioning},
+		{cfn_types.StackStatusDeleteInProgress, v1.ProvisioningStateProvisioning},
+		{cfn_types.StackStatusCreateComplete, v1.ProvisioningStateSucceeded},
+		{cfn_types.StackStatusUpdateComplete, v1.ProvisioningStateSucceeded},
+		{cfn_types.StackStatusDeleteComplete, v1.ProvisioningStateSucceeded},
+		{cfn_types.StackStatusCreateFailed, v1.ProvisioningStateFailed},
+		{cfn_types.StackStatusRollbackComplete, v1.ProvisioningStateFailed},
+		{cfn_types.StackStatusUpdateRollbackComplete, v1.ProvisioningStateFailed},
+		{cfn_types.StackStatusDeleteFailed, v1.ProvisioningStateFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			require.Equal(t, tt.expected, stackProvisioningState(tt.status))
+		})
+	}
+}
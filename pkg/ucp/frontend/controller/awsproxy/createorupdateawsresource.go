@@ -53,6 +53,7 @@ func NewCreateOrUpdateAWSResource(opts armrpc_controller.Options, awsClients ucp
 
 // "Run" reads the request body, determines if the resource exists, and either creates or updates the
 // resource accordingly, returning an async operation response with the resource's properties and a request token.
+// Resource types that AWS Cloud Control does not support are deployed as an AWS CloudFormation stack instead.
 func (p *CreateOrUpdateAWSResource) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
 	serviceCtx := servicecontext.AWSRequestContextFromContext(ctx)
 
@@ -96,7 +97,9 @@ func (p *CreateOrUpdateAWSResource) Run(ctx context.Context, w http.ResponseWrit
 		TypeName:   new(serviceCtx.ResourceTypeInAWSFormat()),
 		Identifier: aws.String(serviceCtx.ResourceID.Name()),
 	}, cloudControlOpts...)
-	if ucp_aws.IsAWSResourceNotFoundError(err) {
+	if ucp_aws.IsAWSUnsupportedActionError(err) {
+		return createOrUpdateStack(ctx, p.DatabaseClient(), p.awsClients.CloudFormation, serviceCtx.ResourceID, serviceCtx.ResourceTypeInAWSFormat(), properties, p.Options().PathBase, cloudFormationOpts...)
+	} else if ucp_aws.IsAWSResourceNotFoundError(err) {
 		existing = false
 	} else if err != nil {
 		return ucp_aws.HandleAWSError(err)
@@ -178,7 +181,9 @@ func (p *CreateOrUpdateAWSResource) Run(ctx context.Context, w http.ResponseWrit
 			TypeName:     new(serviceCtx.ResourceTypeInAWSFormat()),
 			DesiredState: aws.String(string(desiredState)),
 		}, cloudControlOpts...)
-		if err != nil {
+		if ucp_aws.IsAWSUnsupportedActionError(err) {
+			return createOrUpdateStack(ctx, p.DatabaseClient(), p.awsClients.CloudFormation, serviceCtx.ResourceID, serviceCtx.ResourceTypeInAWSFormat(), properties, p.Options().PathBase, cloudFormationOpts...)
+		} else if err != nil {
 			return ucp_aws.HandleAWSError(err)
 		}
 
@@ -17,20 +17,26 @@ package awsproxy
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfn_types "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/smithy-go"
 	"github.com/google/uuid"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
 	ucp_aws "github.com/radius-project/radius/pkg/ucp/aws"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -340,3 +346,212 @@ func Test_UpdateNoChangesDoesNotCallUpdate(t *testing.T) {
 
 	require.Equal(t, expectedResponseObject, actualResponseObject)
 }
+
+func Test_CreateAWSResource_CloudFormationStack(t *testing.T) {
+	testResource := CreateKinesisStreamTestResource(uuid.NewString())
+
+	testOptions := setupTest(t)
+	testOptions.AWSCloudControlClient.EXPECT().GetResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		nil, &types.UnsupportedActionException{
+			Message: aws.String("Resource type AWS::Kinesis::Stream does not support READ action"),
+		})
+
+	testOptions.AWSCloudFormationClient.EXPECT().DescribeStacks(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, testStackNotFoundError)
+
+	var createStackInput *cloudformation.CreateStackInput
+	testOptions.AWSCloudFormationClient.EXPECT().CreateStack(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *cloudformation.CreateStackInput, _ ...func(*cloudformation.Options)) (*cloudformation.CreateStackOutput, error) {
+			createStackInput = input
+			return &cloudformation.CreateStackOutput{StackId: aws.String(testStackID)}, nil
+		})
+
+	var savedObject *database.Object
+	testOptions.DatabaseClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, obj *database.Object, _ ...database.SaveOptions) error {
+			savedObject = obj
+			return nil
+		})
+
+	requestBody := map[string]any{
+		"properties": map[string]any{
+			"RetentionPeriodHours": 178,
+			"ShardCount":           3,
+		},
+	}
+	requestBodyBytes, err := json.Marshal(requestBody)
+	require.NoError(t, err)
+
+	awsClients := ucp_aws.Clients{
+		CloudControl:   testOptions.AWSCloudControlClient,
+		CloudFormation: testOptions.AWSCloudFormationClient,
+	}
+	awsController, err := NewCreateOrUpdateAWSResource(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPut, testResource.SingleResourcePath, bytes.NewBuffer(requestBodyBytes))
+	require.NoError(t, err)
+	request.Host = testHost
+	request.URL.Host = testHost
+	request.URL.Scheme = testScheme
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := awsController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	err = actualResponse.Apply(ctx, w, request)
+	require.NoError(t, err)
+
+	res := w.Result()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	defer res.Body.Close()
+
+	// The stack operation is tracked using the client request token as the operation ID.
+	operationID := aws.ToString(createStackInput.ClientRequestToken)
+	require.NotEmpty(t, operationID)
+	require.Regexp(t, "^radius-aws-kinesis-stream-", aws.ToString(createStackInput.StackName))
+	require.JSONEq(t, testStackTemplateBody, aws.ToString(createStackInput.TemplateBody))
+	require.Contains(t, createStackInput.Tags, cfn_types.Tag{Key: aws.String(stackTagResourceID), Value: aws.String(testResource.SingleResourcePath)})
+	require.Equal(t, strings.Replace(testResource.AzureAsyncOpHeader, "79b9f0da-4882-4dc8-a367-6fd3bc122ded", operationID, 1), res.Header.Get("Azure-AsyncOperation"))
+
+	require.True(t, strings.HasSuffix(savedObject.ID, "/locations/global/operationStatuses/"+operationID))
+	record := savedObject.Data.(*datamodel.AWSCloudFormationOperation)
+	require.Equal(t, testStackID, record.StackID)
+	require.Equal(t, stackOperationCreate, record.Operation)
+	require.Equal(t, testResource.SingleResourcePath, record.ResourceID)
+
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	expectedResponseObject := map[string]any{
+		"id":   testResource.SingleResourcePath,
+		"name": testResource.ResourceName,
+		"type": testResource.ResourceType,
+		"properties": map[string]any{
+			"RetentionPeriodHours": float64(178),
+			"ShardCount":           float64(3),
+			"provisioningState":    "Provisioning",
+		},
+	}
+
+	actualResponseObject := map[string]any{}
+	err = json.Unmarshal(body, &actualResponseObject)
+	require.NoError(t, err)
+
+	require.Equal(t, expectedResponseObject, actualResponseObject)
+}
+
+func Test_UpdateAWSResource_CloudFormationStack_NoChanges(t *testing.T) {
+	testResource := CreateKinesisStreamTestResource(uuid.NewString())
+
+	testOptions := setupTest(t)
+	testOptions.AWSCloudControlClient.EXPECT().GetResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		nil, &types.UnsupportedActionException{
+			Message: aws.String("Resource type AWS::Kinesis::Stream does not support READ action"),
+		})
+
+	testOptions.AWSCloudFormationClient.EXPECT().DescribeStacks(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&cloudformation.DescribeStacksOutput{
+			Stacks: []cfn_types.Stack{newTestStack(t, testResource, cfn_types.StackStatusCreateComplete)},
+		}, nil)
+
+	testOptions.AWSCloudFormationClient.EXPECT().UpdateStack(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		nil, &smithy.GenericAPIError{
+			Code:    "ValidationError",
+			Message: "No updates are to be performed.",
+		})
+
+	requestBody := map[string]any{
+		"properties": map[string]any{
+			"RetentionPeriodHours": 178,
+			"ShardCount":           3,
+		},
+	}
+	requestBodyBytes, err := json.Marshal(requestBody)
+	require.NoError(t, err)
+
+	awsClients := ucp_aws.Clients{
+		CloudControl:   testOptions.AWSCloudControlClient,
+		CloudFormation: testOptions.AWSCloudFormationClient,
+	}
+	awsController, err := NewCreateOrUpdateAWSResource(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPut, testResource.SingleResourcePath, bytes.NewBuffer(requestBodyBytes))
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := awsController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	err = actualResponse.Apply(ctx, w, request)
+	require.NoError(t, err)
+
+	res := w.Result()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	expectedResponseObject := map[string]any{
+		"id":   testResource.SingleResourcePath,
+		"name": testResource.ResourceName,
+		"type": testResource.ResourceType,
+		"properties": map[string]any{
+			"RetentionPeriodHours": float64(178),
+			"ShardCount":           float64(3),
+			"provisioningState":    "Succeeded",
+		},
+	}
+
+	actualResponseObject := map[string]any{}
+	err = json.Unmarshal(body, &actualResponseObject)
+	require.NoError(t, err)
+
+	require.Equal(t, expectedResponseObject, actualResponseObject)
+}
+
+func Test_UpdateAWSResource_CloudFormationStack_InProgress(t *testing.T) {
+	testResource := CreateKinesisStreamTestResource(uuid.NewString())
+
+	testOptions := setupTest(t)
+	testOptions.AWSCloudControlClient.EXPECT().GetResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		nil, &types.UnsupportedActionException{
+			Message: aws.String("Resource type AWS::Kinesis::Stream does not support READ action"),
+		})
+
+	testOptions.AWSCloudFormationClient.EXPECT().DescribeStacks(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&cloudformation.DescribeStacksOutput{
+			Stacks: []cfn_types.Stack{newTestStack(t, testResource, cfn_types.StackStatusUpdateInProgress)},
+		}, nil)
+
+	requestBody := map[string]any{
+		"properties": map[string]any{
+			"ShardCount": 3,
+		},
+	}
+	requestBodyBytes, err := json.Marshal(requestBody)
+	require.NoError(t, err)
+
+	awsClients := ucp_aws.Clients{
+		CloudControl:   testOptions.AWSCloudControlClient,
+		CloudFormation: testOptions.AWSCloudFormationClient,
+	}
+	awsController, err := NewCreateOrUpdateAWSResource(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPut, testResource.SingleResourcePath, bytes.NewBuffer(requestBodyBytes))
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := awsController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	err = actualResponse.Apply(ctx, w, request)
+	require.NoError(t, err)
+
+	res := w.Result()
+	require.Equal(t, http.StatusConflict, res.StatusCode)
+}
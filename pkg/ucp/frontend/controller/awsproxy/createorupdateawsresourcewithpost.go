@@ -35,6 +35,7 @@ import (
 	ucp_aws "github.com/radius-project/radius/pkg/ucp/aws"
 	"github.com/radius-project/radius/pkg/ucp/aws/servicecontext"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 )
 
@@ -55,7 +56,8 @@ func NewCreateOrUpdateAWSResourceWithPost(opts armrpc_controller.Options, awsCli
 }
 
 // "Run" reads the request body to get properties, checks if the resource exists, and creates or updates
-// the resource accordingly, returning an async operation response.
+// the resource accordingly, returning an async operation response. Resource types that AWS Cloud Control does not
+// support are deployed as an AWS CloudFormation stack instead.
 func (p *CreateOrUpdateAWSResourceWithPost) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	serviceCtx := servicecontext.AWSRequestContextFromContext(ctx)
@@ -112,7 +114,9 @@ func (p *CreateOrUpdateAWSResourceWithPost) Run(ctx context.Context, w http.Resp
 			TypeName:   new(serviceCtx.ResourceTypeInAWSFormat()),
 			Identifier: aws.String(awsResourceIdentifier),
 		}, cloudControlOpts...)
-		if ucp_aws.IsAWSResourceNotFoundError(err) {
+		if ucp_aws.IsAWSUnsupportedActionError(err) {
+			return p.createOrUpdateStack(ctx, serviceCtx, computedResourceID, properties, cloudFormationOpts...)
+		} else if ucp_aws.IsAWSResourceNotFoundError(err) {
 			existing = false
 		} else if err != nil {
 			return ucp_aws.HandleAWSError(err)
@@ -178,7 +182,9 @@ func (p *CreateOrUpdateAWSResourceWithPost) Run(ctx context.Context, w http.Resp
 			TypeName:     new(serviceCtx.ResourceTypeInAWSFormat()),
 			DesiredState: aws.String(string(desiredState)),
 		}, cloudControlOpts...)
-		if err != nil {
+		if ucp_aws.IsAWSUnsupportedActionError(err) {
+			return p.createOrUpdateStack(ctx, serviceCtx, computedResourceID, properties, cloudFormationOpts...)
+		} else if err != nil {
 			return ucp_aws.HandleAWSError(err)
 		}
 
@@ -208,3 +214,24 @@ func (p *CreateOrUpdateAWSResourceWithPost) Run(ctx context.Context, w http.Resp
 	resp := armrpc_rest.NewAsyncOperationResponse(responseBody, v1.LocationGlobal, 201, serviceCtx.ResourceID, operation, "", serviceCtx.ResourceID.RootScope(), p.Options().PathBase)
 	return resp, nil
 }
+
+// createOrUpdateStack deploys the resource as an AWS CloudFormation stack. The stack is named after the resource ID
+// computed from the primary identifier, so the resource must declare its primary identifier in its properties.
+func (p *CreateOrUpdateAWSResourceWithPost) createOrUpdateStack(ctx context.Context, serviceCtx *servicecontext.AWSRequestContext, computedResourceID string, properties map[string]any, cloudFormationOpts ...func(*cloudformation.Options)) (armrpc_rest.Response, error) {
+	if computedResourceID == "" {
+		e := v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInvalid,
+				Message: fmt.Sprintf("resource type %q is not supported by AWS Cloud Control and is deployed as an AWS CloudFormation stack, which requires the primary identifier of the resource in its properties", serviceCtx.ResourceTypeInAWSFormat()),
+			},
+		}
+		return armrpc_rest.NewBadRequestARMResponse(e), nil
+	}
+
+	id, err := resources.ParseResource(computedResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return createOrUpdateStack(ctx, p.DatabaseClient(), p.awsClients.CloudFormation, id, serviceCtx.ResourceTypeInAWSFormat(), properties, p.Options().PathBase, cloudFormationOpts...)
+}
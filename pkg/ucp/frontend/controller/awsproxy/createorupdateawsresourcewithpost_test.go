@@ -17,6 +17,7 @@ package awsproxy
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -29,8 +30,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/google/uuid"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
 	ucp_aws "github.com/radius-project/radius/pkg/ucp/aws"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -549,3 +553,138 @@ func Test_UpdateAWSResourceWithPost_MultiIdentifier(t *testing.T) {
 
 	require.Equal(t, expectedResponseObject, actualResponseObject)
 }
+
+func Test_CreateAWSResourceWithPost_CloudFormationStack(t *testing.T) {
+	testResource := CreateKinesisStreamTestResource(uuid.NewString())
+
+	testOptions := setupTest(t)
+	testOptions.AWSCloudFormationClient.EXPECT().DescribeType(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&cloudformation.DescribeTypeOutput{
+			TypeName: aws.String(testResource.AWSResourceType),
+			Schema:   aws.String(testResource.Schema),
+		}, nil)
+
+	testOptions.AWSCloudControlClient.EXPECT().GetResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		nil, &types.UnsupportedActionException{
+			Message: aws.String("Resource type AWS::Kinesis::Stream does not support READ action"),
+		})
+
+	testOptions.AWSCloudFormationClient.EXPECT().DescribeStacks(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, testStackNotFoundError)
+
+	var createStackInput *cloudformation.CreateStackInput
+	testOptions.AWSCloudFormationClient.EXPECT().CreateStack(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *cloudformation.CreateStackInput, _ ...func(*cloudformation.Options)) (*cloudformation.CreateStackOutput, error) {
+			createStackInput = input
+			return &cloudformation.CreateStackOutput{StackId: aws.String(testStackID)}, nil
+		})
+
+	var savedObject *database.Object
+	testOptions.DatabaseClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, obj *database.Object, _ ...database.SaveOptions) error {
+			savedObject = obj
+			return nil
+		})
+
+	requestBody := map[string]any{
+		"properties": map[string]any{
+			"Name":       testResource.ResourceName,
+			"ShardCount": 3,
+		},
+	}
+	requestBodyBytes, err := json.Marshal(requestBody)
+	require.NoError(t, err)
+
+	awsClients := ucp_aws.Clients{
+		CloudControl:   testOptions.AWSCloudControlClient,
+		CloudFormation: testOptions.AWSCloudFormationClient,
+	}
+	awsController, err := NewCreateOrUpdateAWSResourceWithPost(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, testResource.CollectionPath, bytes.NewBuffer(requestBodyBytes))
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := awsController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	err = actualResponse.Apply(ctx, w, request)
+	require.NoError(t, err)
+
+	res := w.Result()
+	require.Equal(t, http.StatusCreated, res.StatusCode)
+	defer res.Body.Close()
+
+	// The stack is named after the resource ID, so that the resource can also be managed by name.
+	id, err := resources.ParseResource(testResource.SingleResourcePath)
+	require.NoError(t, err)
+	require.Equal(t, stackName(id), aws.ToString(createStackInput.StackName))
+
+	record := savedObject.Data.(*datamodel.AWSCloudFormationOperation)
+	require.Equal(t, testStackID, record.StackID)
+	require.Equal(t, stackOperationCreate, record.Operation)
+	require.Equal(t, testResource.SingleResourcePath, record.ResourceID)
+
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	expectedResponseObject := map[string]any{
+		"id":   testResource.SingleResourcePath,
+		"name": testResource.ResourceName,
+		"type": testResource.ResourceType,
+		"properties": map[string]any{
+			"Name":              testResource.ResourceName,
+			"ShardCount":        float64(3),
+			"provisioningState": "Provisioning",
+		},
+	}
+
+	actualResponseObject := map[string]any{}
+	err = json.Unmarshal(body, &actualResponseObject)
+	require.NoError(t, err)
+
+	require.Equal(t, expectedResponseObject, actualResponseObject)
+}
+
+func Test_CreateAWSResourceWithPost_CloudFormationStack_NoPrimaryIdentifier(t *testing.T) {
+	testResource := CreateKinesisStreamTestResource(uuid.NewString())
+
+	testOptions := setupTest(t)
+	testOptions.AWSCloudFormationClient.EXPECT().DescribeType(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&cloudformation.DescribeTypeOutput{
+			TypeName: aws.String(testResource.AWSResourceType),
+			Schema:   aws.String(testResource.Schema),
+		}, nil)
+
+	testOptions.AWSCloudControlClient.EXPECT().CreateResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		nil, &types.UnsupportedActionException{
+			Message: aws.String("Resource type AWS::Kinesis::Stream does not support CREATE action"),
+		})
+
+	requestBody := map[string]any{
+		"properties": map[string]any{
+			"ShardCount": 3,
+		},
+	}
+	requestBodyBytes, err := json.Marshal(requestBody)
+	require.NoError(t, err)
+
+	awsClients := ucp_aws.Clients{
+		CloudControl:   testOptions.AWSCloudControlClient,
+		CloudFormation: testOptions.AWSCloudFormationClient,
+	}
+	awsController, err := NewCreateOrUpdateAWSResourceWithPost(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, testResource.CollectionPath, bytes.NewBuffer(requestBodyBytes))
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := awsController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	badRequest, ok := actualResponse.(*armrpc_rest.BadRequestResponse)
+	require.True(t, ok)
+	require.Contains(t, badRequest.Body.Error.Message, "requires the primary identifier of the resource in its properties")
+}
@@ -48,7 +48,8 @@ func NewDeleteAWSResource(opts armrpc_controller.Options, awsClients ucp_aws.Cli
 }
 
 // Run() parses the request to get the region, then calls the CloudControl API to delete the resource, and
-// returns an AsyncOperationResponse with the operation ID if successful, or an error if not.
+// returns an AsyncOperationResponse with the operation ID if successful, or an error if not. Resource types that AWS
+// Cloud Control does not support are deleted by deleting the AWS CloudFormation stack that manages them.
 func (p *DeleteAWSResource) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
 	serviceCtx := servicecontext.AWSRequestContextFromContext(ctx)
 	region, errResponse := readRegionFromRequest(req.URL.Path, p.Options().PathBase)
@@ -62,7 +63,9 @@ func (p *DeleteAWSResource) Run(ctx context.Context, w http.ResponseWriter, req
 		Identifier: aws.String(serviceCtx.ResourceID.Name()),
 	}, cloudControlOpts...)
 	if err != nil {
-		if ucp_aws.IsAWSResourceNotFoundError(err) {
+		if ucp_aws.IsAWSUnsupportedActionError(err) {
+			return deleteStack(ctx, p.DatabaseClient(), p.awsClients.CloudFormation, serviceCtx.ResourceID, p.Options().PathBase, CloudFormationRegionOption(region))
+		} else if ucp_aws.IsAWSResourceNotFoundError(err) {
 			return armrpc_rest.NewNoContentResponse(), nil
 		}
 		return ucp_aws.HandleAWSError(err)
@@ -25,6 +25,7 @@ import (
 	ucpaws "github.com/radius-project/radius/pkg/ucp/aws"
 	"github.com/radius-project/radius/pkg/ucp/aws/servicecontext"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -57,6 +58,8 @@ func NewDeleteAWSResourceWithPost(opts armrpc_controller.Options, awsClients ucp
 // Run() reads the region from the request, reads properties from the body, gets the primary
 // identifier from the properties, logs the resource to be deleted, deletes the resource, and returns an async operation
 // response. If the resource is not found, it returns a no content response. If an error occurs, it returns an error response.
+// Resource types that AWS Cloud Control does not support are deleted by deleting the AWS CloudFormation stack that
+// manages them.
 func (p *DeleteAWSResourceWithPost) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	serviceCtx := servicecontext.AWSRequestContextFromContext(ctx)
@@ -103,7 +106,13 @@ func (p *DeleteAWSResourceWithPost) Run(ctx context.Context, w http.ResponseWrit
 		Identifier: aws.String(awsResourceIdentifier),
 	}, cloudControlOpts...)
 	if err != nil {
-		if ucpaws.IsAWSResourceNotFoundError(err) {
+		if ucpaws.IsAWSUnsupportedActionError(err) {
+			id, err := resources.ParseResource(computeResourceID(serviceCtx.ResourceID, awsResourceIdentifier))
+			if err != nil {
+				return nil, err
+			}
+			return deleteStack(ctx, p.DatabaseClient(), p.awsClients.CloudFormation, id, p.Options().PathBase, cloudFormationOpts...)
+		} else if ucpaws.IsAWSResourceNotFoundError(err) {
 			return armrpc_rest.NewNoContentResponse(), nil
 		}
 		return ucpaws.HandleAWSError(err)
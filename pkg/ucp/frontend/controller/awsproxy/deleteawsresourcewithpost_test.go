@@ -17,6 +17,7 @@ package awsproxy
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -27,12 +28,15 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfn_types "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/google/uuid"
 	ucp_aws "github.com/radius-project/radius/pkg/ucp/aws"
 	"go.uber.org/mock/gomock"
 
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
 	"github.com/stretchr/testify/require"
 )
 
@@ -201,3 +205,75 @@ func Test_DeleteAWSResourceWithPost_MultiIdentifier(t *testing.T) {
 
 	require.Equal(t, []byte("{}"), body)
 }
+
+func Test_DeleteAWSResourceWithPost_CloudFormationStack(t *testing.T) {
+	testResource := CreateKinesisStreamTestResource(uuid.NewString())
+
+	testOptions := setupTest(t)
+	testOptions.AWSCloudFormationClient.EXPECT().DescribeType(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&cloudformation.DescribeTypeOutput{
+			TypeName: aws.String(testResource.AWSResourceType),
+			Schema:   aws.String(testResource.Schema),
+		}, nil)
+
+	testOptions.AWSCloudControlClient.EXPECT().DeleteResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		nil, &types.UnsupportedActionException{
+			Message: aws.String("Resource type AWS::Kinesis::Stream does not support DELETE action"),
+		})
+
+	testOptions.AWSCloudFormationClient.EXPECT().DescribeStacks(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&cloudformation.DescribeStacksOutput{
+			Stacks: []cfn_types.Stack{newTestStack(t, testResource, cfn_types.StackStatusCreateComplete)},
+		}, nil)
+
+	var deleteStackInput *cloudformation.DeleteStackInput
+	testOptions.AWSCloudFormationClient.EXPECT().DeleteStack(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, input *cloudformation.DeleteStackInput, _ ...func(*cloudformation.Options)) (*cloudformation.DeleteStackOutput, error) {
+			deleteStackInput = input
+			return &cloudformation.DeleteStackOutput{}, nil
+		})
+
+	var savedObject *database.Object
+	testOptions.DatabaseClient.EXPECT().Save(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, obj *database.Object, _ ...database.SaveOptions) error {
+			savedObject = obj
+			return nil
+		})
+
+	awsClients := ucp_aws.Clients{
+		CloudControl:   testOptions.AWSCloudControlClient,
+		CloudFormation: testOptions.AWSCloudFormationClient,
+	}
+	awsController, err := NewDeleteAWSResourceWithPost(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients)
+	require.NoError(t, err)
+
+	requestBody := map[string]any{
+		"properties": map[string]any{
+			"Name": testResource.ResourceName,
+		},
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, testResource.CollectionPath+"/:delete", bytes.NewBuffer(body))
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := awsController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	err = actualResponse.Apply(ctx, w, request)
+	require.NoError(t, err)
+
+	res := w.Result()
+	require.Equal(t, http.StatusAccepted, res.StatusCode)
+	defer res.Body.Close()
+
+	require.Equal(t, testStackID, aws.ToString(deleteStackInput.StackName))
+	require.Contains(t, res.Header.Get("Azure-AsyncOperation"), aws.ToString(deleteStackInput.ClientRequestToken))
+
+	record := savedObject.Data.(*datamodel.AWSCloudFormationOperation)
+	require.Equal(t, stackOperationDelete, record.Operation)
+	require.Equal(t, testResource.SingleResourcePath, record.ResourceID)
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsproxy
+
+import (
+	"context"
+	"fmt"
+	http "net/http"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	ucp_aws "github.com/radius-project/radius/pkg/ucp/aws"
+	"github.com/radius-project/radius/pkg/ucp/aws/servicecontext"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+var _ armrpc_controller.Controller = (*DetectAWSResourceDrift)(nil)
+
+// DetectAWSResourceDrift is the controller implementation to detect drift of an AWS resource.
+type DetectAWSResourceDrift struct {
+	armrpc_controller.Operation[*datamodel.AWSResource, datamodel.AWSResource]
+	awsClients ucp_aws.Clients
+}
+
+// NewDetectAWSResourceDrift creates a new DetectAWSResourceDrift controller with the given options and AWS clients.
+func NewDetectAWSResourceDrift(opts armrpc_controller.Options, awsClients ucp_aws.Clients) (armrpc_controller.Controller, error) {
+	return &DetectAWSResourceDrift{
+		Operation:  armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.AWSResource]{}),
+		awsClients: awsClients,
+	}, nil
+}
+
+// Run reads the region from the request, finds the AWS CloudFormation stack that manages the resource and detects
+// whether the resource has drifted from the properties declared in the stack template. Drift detection is only
+// supported for resources deployed with AWS CloudFormation, so a BadRequest response is returned for other resources.
+func (p *DetectAWSResourceDrift) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := servicecontext.AWSRequestContextFromContext(ctx)
+	region, errResponse := readRegionFromRequest(req.URL.Path, p.Options().PathBase)
+	if errResponse != nil {
+		return errResponse, nil
+	}
+
+	cloudFormationOpts := []func(*cloudformation.Options){CloudFormationRegionOption(region)}
+	stack, err := describeStack(ctx, p.awsClients.CloudFormation, stackName(serviceCtx.ResourceID), cloudFormationOpts...)
+	if err != nil {
+		return ucp_aws.HandleAWSError(err)
+	} else if stack == nil || !stackResourceExists(stack) {
+		e := v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInvalid,
+				Message: fmt.Sprintf("drift detection is only supported for resources deployed with AWS CloudFormation: resource %q is not managed by a stack", serviceCtx.ResourceID.String()),
+			},
+		}
+		return armrpc_rest.NewBadRequestARMResponse(e), nil
+	}
+
+	response, err := p.awsClients.CloudFormation.DetectStackResourceDrift(ctx, &cloudformation.DetectStackResourceDriftInput{
+		StackName:         stack.StackId,
+		LogicalResourceId: aws.String(stackLogicalResourceID),
+	}, cloudFormationOpts...)
+	if err != nil {
+		return ucp_aws.HandleAWSError(err)
+	}
+
+	differences := []any{}
+	for _, difference := range response.StackResourceDrift.PropertyDifferences {
+		differences = append(differences, map[string]any{
+			"propertyPath":   aws.ToString(difference.PropertyPath),
+			"differenceType": string(difference.DifferenceType),
+			"expectedValue":  aws.ToString(difference.ExpectedValue),
+			"actualValue":    aws.ToString(difference.ActualValue),
+		})
+	}
+
+	body := map[string]any{
+		"id":          serviceCtx.ResourceID.String(),
+		"name":        serviceCtx.ResourceID.Name(),
+		"type":        serviceCtx.ResourceID.Type(),
+		"status":      string(response.StackResourceDrift.StackResourceDriftStatus),
+		"timestamp":   response.StackResourceDrift.Timestamp,
+		"differences": differences,
+	}
+	return armrpc_rest.NewOKResponse(body), nil
+}
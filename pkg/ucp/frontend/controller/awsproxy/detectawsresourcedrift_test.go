@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsproxy
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfn_types "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	ucp_aws "github.com/radius-project/radius/pkg/ucp/aws"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DetectAWSResourceDrift(t *testing.T) {
+	testResource := CreateKinesisStreamTestResource(uuid.NewString())
+	timestamp := time.Now()
+
+	testOptions := setupTest(t)
+	testOptions.AWSCloudFormationClient.EXPECT().DescribeStacks(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&cloudformation.DescribeStacksOutput{
+			Stacks: []cfn_types.Stack{newTestStack(t, testResource, cfn_types.StackStatusCreateComplete)},
+		}, nil)
+	testOptions.AWSCloudFormationClient.EXPECT().DetectStackResourceDrift(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&cloudformation.DetectStackResourceDriftOutput{
+			StackResourceDrift: &cfn_types.StackResourceDrift{
+				LogicalResourceId:        aws.String(stackLogicalResourceID),
+				StackId:                  aws.String(testStackID),
+				StackResourceDriftStatus: cfn_types.StackResourceDriftStatusModified,
+				Timestamp:                aws.Time(timestamp),
+				PropertyDifferences: []cfn_types.PropertyDifference{
+					{
+						PropertyPath:   aws.String("/ShardCount"),
+						DifferenceType: cfn_types.DifferenceTypeNotEqual,
+						ExpectedValue:  aws.String("3"),
+						ActualValue:    aws.String("4"),
+					},
+				},
+			},
+		}, nil)
+
+	awsClients := ucp_aws.Clients{
+		CloudControl:   testOptions.AWSCloudControlClient,
+		CloudFormation: testOptions.AWSCloudFormationClient,
+	}
+	awsController, err := NewDetectAWSResourceDrift(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, testResource.SingleResourcePath+"/drift", nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := awsController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	expectedResponse := armrpc_rest.NewOKResponse(map[string]any{
+		"id":        testResource.SingleResourcePath,
+		"name":      testResource.ResourceName,
+		"type":      testResource.ResourceType,
+		"status":    "MODIFIED",
+		"timestamp": aws.Time(timestamp),
+		"differences": []any{
+			map[string]any{
+				"propertyPath":   "/ShardCount",
+				"differenceType": "NOT_EQUAL",
+				"expectedValue":  "3",
+				"actualValue":    "4",
+			},
+		},
+	})
+	require.Equal(t, expectedResponse, actualResponse)
+}
+
+func Test_DetectAWSResourceDrift_NotManagedByStack(t *testing.T) {
+	testResource := CreateKinesisStreamTestResource(uuid.NewString())
+
+	testOptions := setupTest(t)
+	testOptions.AWSCloudFormationClient.EXPECT().DescribeStacks(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, testStackNotFoundError)
+
+	awsClients := ucp_aws.Clients{
+		CloudControl:   testOptions.AWSCloudControlClient,
+		CloudFormation: testOptions.AWSCloudFormationClient,
+	}
+	awsController, err := NewDetectAWSResourceDrift(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPost, testResource.SingleResourcePath+"/drift", nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := awsController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	badRequest, ok := actualResponse.(*armrpc_rest.BadRequestResponse)
+	require.True(t, ok)
+	require.Contains(t, badRequest.Body.Error.Message, "is not managed by a stack")
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	armrpcv1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
@@ -50,6 +51,7 @@ func NewGetAWSOperationResults(opts armrpc_controller.Options, awsClients ucpaws
 // Run reads the region from the request, calls the AWS CloudControl API to get the resource request status, checks
 // if the status is terminal, and returns an AsyncOperationResultResponse if the status is not terminal, or a NoContentResponse
 // if the status is terminal. An error is returned if the AWS resource is not found or if there is an AWS error.
+// Operations on resource types that AWS Cloud Control does not support are AWS CloudFormation stack operations.
 func (p *GetAWSOperationResults) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
 	serviceCtx := servicecontext.AWSRequestContextFromContext(ctx)
 	region, errResponse := readRegionFromRequest(req.URL.Path, p.Options().PathBase)
@@ -61,7 +63,9 @@ func (p *GetAWSOperationResults) Run(ctx context.Context, w http.ResponseWriter,
 		RequestToken: aws.String(serviceCtx.ResourceID.Name()),
 	}, cloudControlOpts...)
 
-	if ucpaws.IsAWSResourceNotFoundError(err) {
+	if ucpaws.IsAWSRequestTokenNotFoundError(err) {
+		return p.getStackOperationResult(ctx, req, serviceCtx, CloudFormationRegionOption(region))
+	} else if ucpaws.IsAWSResourceNotFoundError(err) {
 		return armrpc_rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
 	} else if err != nil {
 		return ucpaws.HandleAWSError(err)
@@ -92,3 +96,23 @@ func isStatusTerminal(response *cloudcontrol.GetResourceRequestStatusOutput) boo
 	}
 	return isTerminal
 }
+
+// getStackOperationResult returns the result of the AWS CloudFormation stack operation for the operation ID.
+func (p *GetAWSOperationResults) getStackOperationResult(ctx context.Context, req *http.Request, serviceCtx *servicecontext.AWSRequestContext, cloudFormationOpts ...func(*cloudformation.Options)) (armrpc_rest.Response, error) {
+	record, stack, err := getStackOperation(ctx, p.DatabaseClient(), p.awsClients.CloudFormation, serviceCtx.ResourceID, cloudFormationOpts...)
+	if err != nil {
+		return ucpaws.HandleAWSError(err)
+	} else if record == nil {
+		return armrpc_rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	if stack != nil && !stackProvisioningState(stack.StackStatus).IsTerminal() {
+		headers := map[string]string{
+			"Location":    req.URL.String(),
+			"Retry-After": armrpcv1.DefaultRetryAfter,
+		}
+		return armrpc_rest.NewAsyncOperationResultResponse(headers), nil
+	}
+
+	return armrpc_rest.NewNoContentResponse(), nil
+}
@@ -19,16 +19,20 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfn_types "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/google/uuid"
 	"go.uber.org/mock/gomock"
 
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
 	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
 	ucp_aws "github.com/radius-project/radius/pkg/ucp/aws"
 	"github.com/stretchr/testify/require"
 )
@@ -113,3 +117,123 @@ func createResourceRequestStatusOutput(status types.OperationStatus) *cloudcontr
 		},
 	}
 }
+
+func Test_GetAWSOperationResults_CloudFormationStack(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   cfn_types.StackStatus
+		expected int
+	}{
+		{name: "in progress", status: cfn_types.StackStatusCreateInProgress, expected: http.StatusAccepted},
+		{name: "succeeded", status: cfn_types.StackStatusCreateComplete, expected: http.StatusNoContent},
+		{name: "failed", status: cfn_types.StackStatusRollbackComplete, expected: http.StatusNoContent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testResource := CreateKinesisStreamTestResource(uuid.NewString())
+
+			testOptions := setupTest(t)
+			testOptions.AWSCloudControlClient.EXPECT().GetResourceRequestStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				nil, &types.RequestTokenNotFoundException{
+					Message: aws.String("Request token not found"),
+				})
+			testOptions.DatabaseClient.EXPECT().Get(gomock.Any(), gomock.Any()).Return(
+				newTestStackOperation(testResource, stackOperationCreate, time.Now()), nil)
+			testOptions.AWSCloudFormationClient.EXPECT().DescribeStacks(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				&cloudformation.DescribeStacksOutput{
+					Stacks: []cfn_types.Stack{newTestStack(t, testResource, tt.status)},
+				}, nil)
+
+			awsClients := ucp_aws.Clients{
+				CloudControl:   testOptions.AWSCloudControlClient,
+				CloudFormation: testOptions.AWSCloudFormationClient,
+			}
+			awsController, err := NewGetAWSOperationResults(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodGet, testResource.OperationResultsPath, nil)
+			require.NoError(t, err)
+
+			ctx := rpctest.NewARMRequestContext(request)
+			actualResponse, err := awsController.Run(ctx, nil, request)
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			err = actualResponse.Apply(ctx, w, request)
+			require.NoError(t, err)
+
+			res := w.Result()
+			defer res.Body.Close()
+			require.Equal(t, tt.expected, res.StatusCode)
+		})
+	}
+}
+
+func Test_GetAWSOperationResults_CloudFormationStack_OperationNotFound(t *testing.T) {
+	testResource := CreateKinesisStreamTestResource(uuid.NewString())
+
+	testOptions := setupTest(t)
+	testOptions.AWSCloudControlClient.EXPECT().GetResourceRequestStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		nil, &types.RequestTokenNotFoundException{
+			Message: aws.String("Request token not found"),
+		})
+	testOptions.DatabaseClient.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, &database.ErrNotFound{})
+
+	awsClients := ucp_aws.Clients{
+		CloudControl:   testOptions.AWSCloudControlClient,
+		CloudFormation: testOptions.AWSCloudFormationClient,
+	}
+	awsController, err := NewGetAWSOperationResults(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, testResource.OperationResultsPath, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := awsController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	err = actualResponse.Apply(ctx, w, request)
+	require.NoError(t, err)
+
+	res := w.Result()
+	defer res.Body.Close()
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+}
+
+func Test_GetAWSOperationResults_CloudFormationStack_OperationExpired(t *testing.T) {
+	testResource := CreateKinesisStreamTestResource(uuid.NewString())
+
+	testOptions := setupTest(t)
+	testOptions.AWSCloudControlClient.EXPECT().GetResourceRequestStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		nil, &types.RequestTokenNotFoundException{
+			Message: aws.String("Request token not found"),
+		})
+	testOptions.DatabaseClient.EXPECT().Get(gomock.Any(), gomock.Any()).Return(
+		newTestStackOperation(testResource, stackOperationCreate, time.Now().Add(-stackOperationRetention-time.Hour)), nil)
+	testOptions.DatabaseClient.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil)
+
+	awsClients := ucp_aws.Clients{
+		CloudControl:   testOptions.AWSCloudControlClient,
+		CloudFormation: testOptions.AWSCloudFormationClient,
+	}
+	awsController, err := NewGetAWSOperationResults(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, testResource.OperationResultsPath, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := awsController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	err = actualResponse.Apply(ctx, w, request)
+	require.NoError(t, err)
+
+	res := w.Result()
+	defer res.Body.Close()
+	require.Equal(t, http.StatusNotFound, res.StatusCode)
+}
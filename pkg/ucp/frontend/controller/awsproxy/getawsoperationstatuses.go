@@ -30,6 +30,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 )
 
 var _ armrpc_controller.Controller = (*GetAWSOperationStatuses)(nil)
@@ -50,7 +51,8 @@ func NewGetAWSOperationStatuses(opts armrpc_controller.Options, awsClients ucpaw
 
 // Run() reads the region from the request, uses the region to get the resource request status
 // from AWS CloudControl, and returns the async operation status. If the resource is not found, it returns a
-// NotFoundResponse, and if there is an error, it returns an error response.
+// NotFoundResponse, and if there is an error, it returns an error response. Operations on resource types that AWS
+// Cloud Control does not support are AWS CloudFormation stack operations, and return the status of the stack.
 func (p *GetAWSOperationStatuses) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
 	serviceCtx := servicecontext.AWSRequestContextFromContext(ctx)
 	region, errResponse := readRegionFromRequest(req.URL.Path, p.Options().PathBase)
@@ -62,10 +64,13 @@ func (p *GetAWSOperationStatuses) Run(ctx context.Context, w http.ResponseWriter
 	response, err := p.awsClients.CloudControl.GetResourceRequestStatus(ctx, &cloudcontrol.GetResourceRequestStatusInput{
 		RequestToken: aws.String(serviceCtx.ResourceID.Name()),
 	}, cloudControlOpts...)
+	if ucpaws.IsAWSRequestTokenNotFoundError(err) {
+		return p.getStackOperationStatus(ctx, serviceCtx, CloudFormationRegionOption(region))
+	}
 
 	// If the resource is not found and the operation is delete,
 	// return a 204 No Content response.
-	if response.ProgressEvent != nil &&
+	if response != nil && response.ProgressEvent != nil &&
 		response.ProgressEvent.Operation == types.OperationDelete &&
 		response.ProgressEvent.ErrorCode == types.HandlerErrorCodeNotFound {
 		return armrpc_rest.NewNoContentResponse(), nil
@@ -102,3 +107,20 @@ func getAsyncOperationStatus(response *cloudcontrol.GetResourceRequestStatusOutp
 	}
 	return os.AsyncOperationStatus
 }
+
+// getStackOperationStatus returns the status of the AWS CloudFormation stack operation for the operation ID.
+func (p *GetAWSOperationStatuses) getStackOperationStatus(ctx context.Context, serviceCtx *servicecontext.AWSRequestContext, cloudFormationOpts ...func(*cloudformation.Options)) (armrpc_rest.Response, error) {
+	record, stack, err := getStackOperation(ctx, p.DatabaseClient(), p.awsClients.CloudFormation, serviceCtx.ResourceID, cloudFormationOpts...)
+	if err != nil {
+		return ucpaws.HandleAWSError(err)
+	} else if record == nil {
+		return armrpc_rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if stack == nil {
+		if record.Operation == stackOperationDelete {
+			return armrpc_rest.NewNoContentResponse(), nil
+		}
+		return armrpc_rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	return armrpc_rest.NewOKResponse(stackOperationStatus(record, stack)), nil
+}
@@ -23,6 +23,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfn_types "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/google/uuid"
 	"go.uber.org/mock/gomock"
 
@@ -149,3 +151,107 @@ func Test_GetAWSOperationStatuses_Delete_NotFound(t *testing.T) {
 
 	require.Equal(t, expectedResponse, actualResponse)
 }
+
+func Test_GetAWSOperationStatuses_CloudFormationStack(t *testing.T) {
+	startTime := time.Now().UTC().Add(-time.Hour)
+	endTime := startTime.Add(time.Minute)
+
+	tests := []struct {
+		name      string
+		operation string
+		stack     func(stack cfn_types.Stack) cfn_types.Stack
+		expected  v1.AsyncOperationStatus
+	}{
+		{
+			name:      "in progress",
+			operation: stackOperationCreate,
+			stack: func(stack cfn_types.Stack) cfn_types.Stack {
+				stack.StackStatus = cfn_types.StackStatusCreateInProgress
+				return stack
+			},
+			expected: v1.AsyncOperationStatus{
+				Status:    v1.ProvisioningStateProvisioning,
+				StartTime: startTime,
+			},
+		},
+		{
+			name:      "succeeded",
+			operation: stackOperationUpdate,
+			stack: func(stack cfn_types.Stack) cfn_types.Stack {
+				stack.StackStatus = cfn_types.StackStatusUpdateComplete
+				stack.LastUpdatedTime = aws.Time(endTime)
+				return stack
+			},
+			expected: v1.AsyncOperationStatus{
+				Status:    v1.ProvisioningStateSucceeded,
+				StartTime: startTime,
+				EndTime:   aws.Time(endTime),
+			},
+		},
+		{
+			name:      "failed",
+			operation: stackOperationCreate,
+			stack: func(stack cfn_types.Stack) cfn_types.Stack {
+				stack.StackStatus = cfn_types.StackStatusRollbackComplete
+				stack.StackStatusReason = aws.String("The following resource(s) failed to create: [Resource].")
+				stack.LastUpdatedTime = aws.Time(endTime)
+				return stack
+			},
+			expected: v1.AsyncOperationStatus{
+				Status:    v1.ProvisioningStateFailed,
+				StartTime: startTime,
+				EndTime:   aws.Time(endTime),
+				Error: &v1.ErrorDetails{
+					Code:    string(cfn_types.StackStatusRollbackComplete),
+					Message: "The following resource(s) failed to create: [Resource].",
+				},
+			},
+		},
+		{
+			name:      "deleted",
+			operation: stackOperationDelete,
+			stack: func(stack cfn_types.Stack) cfn_types.Stack {
+				stack.StackStatus = cfn_types.StackStatusDeleteComplete
+				return stack
+			},
+			expected: v1.AsyncOperationStatus{
+				Status:    v1.ProvisioningStateSucceeded,
+				StartTime: startTime,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testResource := CreateKinesisStreamTestResource(uuid.NewString())
+
+			testOptions := setupTest(t)
+			testOptions.AWSCloudControlClient.EXPECT().GetResourceRequestStatus(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				nil, &types.RequestTokenNotFoundException{
+					Message: aws.String("Request token not found"),
+				})
+			testOptions.DatabaseClient.EXPECT().Get(gomock.Any(), gomock.Any()).Return(
+				newTestStackOperation(testResource, tt.operation, startTime), nil)
+			testOptions.AWSCloudFormationClient.EXPECT().DescribeStacks(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				&cloudformation.DescribeStacksOutput{
+					Stacks: []cfn_types.Stack{tt.stack(newTestStack(t, testResource, ""))},
+				}, nil)
+
+			awsClients := ucp_aws.Clients{
+				CloudControl:   testOptions.AWSCloudControlClient,
+				CloudFormation: testOptions.AWSCloudFormationClient,
+			}
+			awsController, err := NewGetAWSOperationStatuses(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodGet, testResource.OperationStatusesPath, nil)
+			require.NoError(t, err)
+
+			ctx := rpctest.NewARMRequestContext(request)
+			actualResponse, err := awsController.Run(ctx, nil, request)
+			require.NoError(t, err)
+
+			require.Equal(t, armrpc_rest.NewOKResponse(tt.expected), actualResponse)
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 )
 
 var _ armrpc_controller.Controller = (*GetAWSResource)(nil)
@@ -48,7 +49,8 @@ func NewGetAWSResource(opts armrpc_controller.Options, awsClients ucpaws.Clients
 }
 
 // Run() reads the region from the request, gets the resource from AWS using the region and resource type and
-// ID, and returns a response containing the resource's properties.
+// ID, and returns a response containing the resource's properties. Resource types that AWS Cloud Control does not
+// support are read from the AWS CloudFormation stack that manages them.
 func (p *GetAWSResource) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
 	serviceCtx := servicecontext.AWSRequestContextFromContext(ctx)
 	region, errResponse := readRegionFromRequest(req.URL.Path, p.Options().PathBase)
@@ -61,7 +63,9 @@ func (p *GetAWSResource) Run(ctx context.Context, w http.ResponseWriter, req *ht
 		TypeName:   new(serviceCtx.ResourceTypeInAWSFormat()),
 		Identifier: aws.String(serviceCtx.ResourceID.Name()),
 	}, cloudControlOpts...)
-	if ucpaws.IsAWSResourceNotFoundError(err) {
+	if ucpaws.IsAWSUnsupportedActionError(err) {
+		return p.getStack(ctx, serviceCtx, CloudFormationRegionOption(region))
+	} else if ucpaws.IsAWSResourceNotFoundError(err) {
 		return armrpc_rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
 	} else if err != nil {
 		return ucpaws.HandleAWSError(err)
@@ -83,3 +87,21 @@ func (p *GetAWSResource) Run(ctx context.Context, w http.ResponseWriter, req *ht
 	}
 	return armrpc_rest.NewOKResponse(body), nil
 }
+
+// getStack returns the resource deployed by the AWS CloudFormation stack for the resource ID.
+func (p *GetAWSResource) getStack(ctx context.Context, serviceCtx *servicecontext.AWSRequestContext, cloudFormationOpts ...func(*cloudformation.Options)) (armrpc_rest.Response, error) {
+	properties, err := getStackResource(ctx, p.awsClients.CloudFormation, serviceCtx.ResourceID, cloudFormationOpts...)
+	if err != nil {
+		return ucpaws.HandleAWSError(err)
+	} else if properties == nil {
+		return armrpc_rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	body := map[string]any{
+		"id":         serviceCtx.ResourceID.String(),
+		"name":       serviceCtx.ResourceID.Name(),
+		"type":       serviceCtx.ResourceID.Type(),
+		"properties": properties,
+	}
+	return armrpc_rest.NewOKResponse(body), nil
+}
@@ -24,6 +24,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfn_types "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/aws/smithy-go"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/google/uuid"
@@ -174,3 +176,99 @@ func Test_GetAWSResource_SmithyError(t *testing.T) {
 
 	require.Equal(t, expectedResponse, actualResponse)
 }
+
+func Test_GetAWSResource_CloudFormationStack(t *testing.T) {
+	testResource := CreateKinesisStreamTestResource(uuid.NewString())
+
+	testOptions := setupTest(t)
+	testOptions.AWSCloudControlClient.EXPECT().GetResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		nil, &types.UnsupportedActionException{
+			Message: aws.String("Resource type AWS::Kinesis::Stream does not support READ action"),
+		})
+
+	testOptions.AWSCloudFormationClient.EXPECT().DescribeStacks(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&cloudformation.DescribeStacksOutput{
+			Stacks: []cfn_types.Stack{newTestStack(t, testResource, cfn_types.StackStatusUpdateComplete)},
+		}, nil)
+
+	testOptions.AWSCloudFormationClient.EXPECT().GetTemplate(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&cloudformation.GetTemplateOutput{
+			TemplateBody: aws.String(testStackTemplateBody),
+		}, nil)
+
+	awsClients := ucp_aws.Clients{
+		CloudControl:   testOptions.AWSCloudControlClient,
+		CloudFormation: testOptions.AWSCloudFormationClient,
+	}
+	awsController, err := NewGetAWSResource(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, testResource.SingleResourcePath, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := awsController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	expectedResponse := armrpc_rest.NewOKResponse(map[string]any{
+		"id":   testResource.SingleResourcePath,
+		"name": testResource.ResourceName,
+		"type": testResource.ResourceType,
+		"properties": map[string]any{
+			"RetentionPeriodHours": float64(178),
+			"ShardCount":           float64(3),
+		},
+	})
+	require.Equal(t, expectedResponse, actualResponse)
+}
+
+func Test_GetAWSResource_CloudFormationStack_NotFound(t *testing.T) {
+	tests := []struct {
+		name   string
+		output *cloudformation.DescribeStacksOutput
+		err    error
+	}{
+		{
+			name: "stack does not exist",
+			err:  testStackNotFoundError,
+		},
+		{
+			name: "stack failed to create",
+			output: &cloudformation.DescribeStacksOutput{
+				Stacks: []cfn_types.Stack{{StackId: aws.String(testStackID), StackStatus: cfn_types.StackStatusRollbackComplete}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testResource := CreateKinesisStreamTestResource(uuid.NewString())
+
+			testOptions := setupTest(t)
+			testOptions.AWSCloudControlClient.EXPECT().GetResource(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				nil, &types.UnsupportedActionException{
+					Message: aws.String("Resource type AWS::Kinesis::Stream does not support READ action"),
+				})
+			testOptions.AWSCloudFormationClient.EXPECT().DescribeStacks(gomock.Any(), gomock.Any(), gomock.Any()).Return(tt.output, tt.err)
+
+			awsClients := ucp_aws.Clients{
+				CloudControl:   testOptions.AWSCloudControlClient,
+				CloudFormation: testOptions.AWSCloudFormationClient,
+			}
+			awsController, err := NewGetAWSResource(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients)
+			require.NoError(t, err)
+
+			request, err := http.NewRequest(http.MethodGet, testResource.SingleResourcePath, nil)
+			require.NoError(t, err)
+
+			ctx := rpctest.NewARMRequestContext(request)
+			actualResponse, err := awsController.Run(ctx, nil, request)
+			require.NoError(t, err)
+
+			id, err := resources.ParseResource(testResource.SingleResourcePath)
+			require.NoError(t, err)
+
+			require.Equal(t, armrpc_rest.NewNotFoundResponse(id), actualResponse)
+		})
+	}
+}
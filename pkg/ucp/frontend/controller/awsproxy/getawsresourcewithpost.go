@@ -29,6 +29,7 @@ import (
 	ucpaws "github.com/radius-project/radius/pkg/ucp/aws"
 	"github.com/radius-project/radius/pkg/ucp/aws/servicecontext"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -59,7 +60,8 @@ func NewGetAWSResourceWithPost(opts armrpc_controller.Options, awsClients ucpaws
 
 // Run() reads the region from the request, reads properties from the body, fetches the resource
 // from AWS, computes the resource ID and returns an OK response with the resource details. If the resource is not found,
-// it returns a NotFound response. If any other error occurs, it returns an error response.
+// it returns a NotFound response. If any other error occurs, it returns an error response. Resource types that AWS
+// Cloud Control does not support are read from the AWS CloudFormation stack that manages them.
 func (p *GetAWSResourceWithPost) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
 	logger := ucplog.FromContextOrDiscard(ctx)
 	serviceCtx := servicecontext.AWSRequestContextFromContext(ctx)
@@ -119,7 +121,9 @@ func (p *GetAWSResourceWithPost) Run(ctx context.Context, w http.ResponseWriter,
 		// If any other error occurs, return the error.
 		return err
 	}); err != nil {
-		if ucpaws.IsAWSResourceNotFoundError(err) {
+		if ucpaws.IsAWSUnsupportedActionError(err) {
+			return p.getStack(ctx, req, serviceCtx, awsResourceIdentifier, cloudFormationOpts...)
+		} else if ucpaws.IsAWSResourceNotFoundError(err) {
 			return armrpc_rest.NewNotFoundMessageResponse(constructNotFoundResponseMessage(middleware.GetRelativePath(p.Options().PathBase, req.URL.Path), awsResourceIdentifier)), nil
 		} else {
 			return ucpaws.HandleAWSError(err)
@@ -144,6 +148,31 @@ func (p *GetAWSResourceWithPost) Run(ctx context.Context, w http.ResponseWriter,
 	return armrpc_rest.NewOKResponse(body), nil
 }
 
+// getStack returns the resource deployed by the AWS CloudFormation stack for the resource ID computed from the primary
+// identifier.
+func (p *GetAWSResourceWithPost) getStack(ctx context.Context, req *http.Request, serviceCtx *servicecontext.AWSRequestContext, awsResourceIdentifier string, cloudFormationOpts ...func(*cloudformation.Options)) (armrpc_rest.Response, error) {
+	computedResourceID := computeResourceID(serviceCtx.ResourceID, awsResourceIdentifier)
+	id, err := resources.ParseResource(computedResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	properties, err := getStackResource(ctx, p.awsClients.CloudFormation, id, cloudFormationOpts...)
+	if err != nil {
+		return ucpaws.HandleAWSError(err)
+	} else if properties == nil {
+		return armrpc_rest.NewNotFoundMessageResponse(constructNotFoundResponseMessage(middleware.GetRelativePath(p.Options().PathBase, req.URL.Path), awsResourceIdentifier)), nil
+	}
+
+	body := map[string]any{
+		"id":         computedResourceID,
+		"name":       awsResourceIdentifier,
+		"type":       serviceCtx.ResourceID.Type(),
+		"properties": properties,
+	}
+	return armrpc_rest.NewOKResponse(body), nil
+}
+
 func constructNotFoundResponseMessage(path string, resourceIDs string) string {
 	path = strings.Split(path, "/:")[0]
 	resourceIDs = strings.ReplaceAll(resourceIDs, "|", ", ")
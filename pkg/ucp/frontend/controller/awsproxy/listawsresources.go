@@ -20,14 +20,18 @@ import (
 	"encoding/json"
 	http "net/http"
 	"path"
+	"strings"
 
 	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
 	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
 	ucpaws "github.com/radius-project/radius/pkg/ucp/aws"
 	"github.com/radius-project/radius/pkg/ucp/aws/servicecontext"
 	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 )
 
 var _ armrpc_controller.Controller = (*ListAWSResources)(nil)
@@ -47,7 +51,8 @@ func NewListAWSResources(opts armrpc_controller.Options, awsClients ucpaws.Clien
 }
 
 // Run() reads the region from the request, uses the AWS resource type from the context, and lists the
-// resources in the region, returning a response with the list of resources.
+// resources in the region, returning a response with the list of resources. Resource types that AWS Cloud Control does
+// not support are listed from the AWS CloudFormation stacks that manage them.
 func (p *ListAWSResources) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
 	serviceCtx := servicecontext.AWSRequestContextFromContext(ctx)
 	region, errResponse := readRegionFromRequest(req.URL.Path, p.Options().PathBase)
@@ -60,7 +65,9 @@ func (p *ListAWSResources) Run(ctx context.Context, w http.ResponseWriter, req *
 	response, err := p.awsClients.CloudControl.ListResources(ctx, &cloudcontrol.ListResourcesInput{
 		TypeName: new(serviceCtx.ResourceTypeInAWSFormat()),
 	}, cloudControlOpts...)
-	if err != nil {
+	if ucpaws.IsAWSUnsupportedActionError(err) {
+		return p.listStacks(ctx, serviceCtx, CloudFormationRegionOption(region))
+	} else if err != nil {
 		return ucpaws.HandleAWSError(err)
 	}
 
@@ -93,3 +100,44 @@ func (p *ListAWSResources) Run(ctx context.Context, w http.ResponseWriter, req *
 	}
 	return armrpc_rest.NewOKResponse(body), nil
 }
+
+// listStacks lists the resources of the requested type deployed by AWS CloudFormation stacks in the account and
+// region. Stacks are matched using the tags UCP sets when creating them.
+func (p *ListAWSResources) listStacks(ctx context.Context, serviceCtx *servicecontext.AWSRequestContext, cloudFormationOpts ...func(*cloudformation.Options)) (armrpc_rest.Response, error) {
+	items := []any{}
+	paginator := cloudformation.NewDescribeStacksPaginator(p.awsClients.CloudFormation, &cloudformation.DescribeStacksInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx, cloudFormationOpts...)
+		if err != nil {
+			return ucpaws.HandleAWSError(err)
+		}
+
+		for _, stack := range page.Stacks {
+			if !strings.EqualFold(stackTag(&stack, stackTagResourceType), serviceCtx.ResourceID.Type()) || !stackResourceExists(&stack) {
+				continue
+			}
+
+			id, err := resources.ParseResource(stackTag(&stack, stackTagResourceID))
+			if err != nil || !strings.EqualFold(id.RootScope(), serviceCtx.ResourceID.RootScope()) {
+				continue
+			}
+
+			properties, err := stackProperties(ctx, p.awsClients.CloudFormation, aws.ToString(stack.StackId), cloudFormationOpts...)
+			if err != nil {
+				return ucpaws.HandleAWSError(err)
+			}
+
+			items = append(items, map[string]any{
+				"id":         id.String(),
+				"name":       id.Name(),
+				"type":       serviceCtx.ResourceID.Type(),
+				"properties": properties,
+			})
+		}
+	}
+
+	body := map[string]any{
+		"value": items,
+	}
+	return armrpc_rest.NewOKResponse(body), nil
+}
@@ -24,6 +24,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
 	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	cfn_types "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 	"github.com/aws/smithy-go"
 	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/google/uuid"
@@ -198,3 +200,62 @@ func Test_ListAWSResource_SmithyError(t *testing.T) {
 
 	require.Equal(t, expectedResponse, actualResponse)
 }
+
+func Test_ListAWSResources_CloudFormationStacks(t *testing.T) {
+	testResource := CreateKinesisStreamTestResource(uuid.NewString())
+	otherTypeTestResource := CreateMemoryDBClusterTestResource(uuid.NewString())
+
+	testOptions := setupTest(t)
+	testOptions.AWSCloudControlClient.EXPECT().ListResources(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		nil, &types.UnsupportedActionException{
+			Message: aws.String("Resource type AWS::Kinesis::Stream does not support LIST action"),
+		})
+
+	// Stacks are listed across two pages, and only stacks for the requested resource type are returned.
+	testOptions.AWSCloudFormationClient.EXPECT().DescribeStacks(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&cloudformation.DescribeStacksOutput{
+			Stacks: []cfn_types.Stack{
+				newTestStack(t, otherTypeTestResource, cfn_types.StackStatusCreateComplete),
+				{StackId: aws.String("unmanaged-stack"), StackStatus: cfn_types.StackStatusCreateComplete},
+			},
+			NextToken: aws.String("next"),
+		}, nil)
+	testOptions.AWSCloudFormationClient.EXPECT().DescribeStacks(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&cloudformation.DescribeStacksOutput{
+			Stacks: []cfn_types.Stack{newTestStack(t, testResource, cfn_types.StackStatusCreateComplete)},
+		}, nil)
+
+	testOptions.AWSCloudFormationClient.EXPECT().GetTemplate(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&cloudformation.GetTemplateOutput{
+			TemplateBody: aws.String(testStackTemplateBody),
+		}, nil)
+
+	awsClients := ucp_aws.Clients{
+		CloudControl:   testOptions.AWSCloudControlClient,
+		CloudFormation: testOptions.AWSCloudFormationClient,
+	}
+	awsController, err := NewListAWSResources(armrpc_controller.Options{DatabaseClient: testOptions.DatabaseClient}, awsClients)
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, testResource.CollectionPath, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := awsController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	expectedResponse := armrpc_rest.NewOKResponse(map[string]any{
+		"value": []any{
+			map[string]any{
+				"id":   testResource.SingleResourcePath,
+				"name": testResource.ResourceName,
+				"type": testResource.ResourceType,
+				"properties": map[string]any{
+					"RetentionPeriodHours": float64(178),
+					"ShardCount":           float64(3),
+				},
+			},
+		},
+	})
+	require.Equal(t, expectedResponse, actualResponse)
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpproxy
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	http "net/http"
+	"net/url"
+	"path"
+	"reflect"
+	"slices"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	ucp_gcp "github.com/radius-project/radius/pkg/ucp/gcp"
+)
+
+var _ armrpc_controller.Controller = (*CreateOrUpdateGCPResource)(nil)
+
+// CreateOrUpdateGCPResource is the controller implementation to create/update a GCP resource.
+type CreateOrUpdateGCPResource struct {
+	armrpc_controller.Operation[*datamodel.GCPResource, datamodel.GCPResource]
+	client ucp_gcp.Client
+}
+
+// NewCreateOrUpdateGCPResource creates a new CreateOrUpdateGCPResource.
+func NewCreateOrUpdateGCPResource(opts armrpc_controller.Options, client ucp_gcp.Client) (armrpc_controller.Controller, error) {
+	return &CreateOrUpdateGCPResource{
+		Operation: armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.GCPResource]{}),
+		client:    client,
+	}, nil
+}
+
+// Run reads the request body, determines if the resource exists, and either creates the resource following
+// https://google.aip.dev/133 or updates the changed properties following https://google.aip.dev/134. If GCP starts a
+// long-running operation it returns an async operation response, otherwise it returns the resource.
+func (p *CreateOrUpdateGCPResource) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	r, errResponse := readGCPRequest(serviceCtx.ResourceID, req)
+	if errResponse != nil {
+		return errResponse, nil
+	}
+
+	decoder := json.NewDecoder(req.Body)
+	defer req.Body.Close()
+
+	body := map[string]any{}
+	err := decoder.Decode(&body)
+	if err != nil {
+		e := v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInvalid,
+				Message: "failed to read request body",
+			},
+		}
+
+		response := armrpc_rest.NewBadRequestARMResponse(e)
+		return response, nil
+	}
+
+	properties := map[string]any{}
+	obj, ok := body["properties"]
+	if ok {
+		pp, ok := obj.(map[string]any)
+		if ok {
+			properties = pp
+		}
+	}
+
+	// Create and update are different operations in GCP - we need to know if the resource
+	// we're working on exists already.
+	existing, err := p.client.Do(ctx, http.MethodGet, p.client.ResourceURL(r.service, r.version, r.name), nil)
+	if ucp_gcp.IsGCPResourceNotFoundError(err) {
+		existing = nil
+	} else if err != nil {
+		return ucp_gcp.HandleGCPError(err)
+	}
+
+	// Properties specified by users take precedence
+	responseProperties := map[string]any{}
+	maps.Copy(responseProperties, existing)
+	maps.Copy(responseProperties, properties)
+
+	var response map[string]any
+	if existing != nil {
+		updateMask := []string{}
+		for k, v := range properties {
+			if !reflect.DeepEqual(existing[k], v) {
+				updateMask = append(updateMask, k)
+			}
+		}
+
+		if len(updateMask) == 0 {
+			// mark provisioning state as succeeded here
+			// and return 200, telling the deployment engine that the resource is already up to date
+			responseProperties["provisioningState"] = v1.ProvisioningStateSucceeded
+			return armrpc_rest.NewOKResponse(resourceBody(serviceCtx.ResourceID, responseProperties)), nil
+		}
+
+		slices.Sort(updateMask)
+		u := p.client.ResourceURL(r.service, r.version, r.name) + "?" + url.Values{"updateMask": []string{strings.Join(updateMask, ",")}}.Encode()
+		response, err = p.client.Do(ctx, http.MethodPatch, u, properties)
+		if err != nil {
+			return ucp_gcp.HandleGCPError(err)
+		}
+	} else {
+		// The resource is created in its collection, eg: projects/my-project/topics, and the name of the
+		// new resource is passed as a query parameter, eg: ?topicId=my-topic.
+		collection := path.Dir(r.name)
+		u := p.client.ResourceURL(r.service, r.version, collection) + "?" + url.Values{collectionIDParameter(path.Base(collection)): []string{serviceCtx.ResourceID.Name()}}.Encode()
+		response, err = p.client.Do(ctx, http.MethodPost, u, properties)
+		if err != nil {
+			return ucp_gcp.HandleGCPError(err)
+		}
+	}
+
+	op, ok := ucp_gcp.ToOperation(response)
+	if !ok {
+		// The resource was created or updated synchronously.
+		maps.Copy(responseProperties, response)
+		responseProperties["provisioningState"] = v1.ProvisioningStateSucceeded
+		return armrpc_rest.NewOKResponse(resourceBody(serviceCtx.ResourceID, responseProperties)), nil
+	} else if op.Failed() {
+		return operationFailedResponse(op), nil
+	} else if op.Done {
+		maps.Copy(responseProperties, op.Response)
+		responseProperties["provisioningState"] = v1.ProvisioningStateSucceeded
+		return armrpc_rest.NewOKResponse(resourceBody(serviceCtx.ResourceID, responseProperties)), nil
+	}
+
+	operation, err := saveOperation(ctx, p.DatabaseClient(), serviceCtx.ResourceID, r, op)
+	if err != nil {
+		return nil, err
+	}
+
+	responseProperties["provisioningState"] = v1.ProvisioningStateProvisioning
+	resp := armrpc_rest.NewAsyncOperationResponse(resourceBody(serviceCtx.ResourceID, responseProperties), v1.LocationGlobal, 201, serviceCtx.ResourceID, operation, "", serviceCtx.ResourceID.RootScope(), p.Options().PathBase)
+	return resp, nil
+}
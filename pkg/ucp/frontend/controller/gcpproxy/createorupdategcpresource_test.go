@@ -0,0 +1,187 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpproxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	armrpc_v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+)
+
+func newCreateOrUpdateRequest(t *testing.T, properties map[string]any) *http.Request {
+	b, err := json.Marshal(map[string]any{"properties": properties})
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPut, testTopicResourcePath, bytes.NewBuffer(b))
+	require.NoError(t, err)
+	return request
+}
+
+func Test_CreateGCPResource(t *testing.T) {
+	server := newFakeGCPServer(t)
+
+	gcpController, err := NewCreateOrUpdateGCPResource(server.options(), server.client())
+	require.NoError(t, err)
+
+	request := newCreateOrUpdateRequest(t, map[string]any{"messageRetentionDuration": "86400s"})
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := gcpController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	expectedResponse := armrpc_rest.NewOKResponse(map[string]any{
+		"id":   testTopicResourcePath,
+		"name": "my-topic",
+		"type": "GCP.PubSub/topics",
+		"properties": map[string]any{
+			"name":                     testTopicName,
+			"messageRetentionDuration": "86400s",
+			"provisioningState":        armrpc_v1.ProvisioningStateSucceeded,
+		},
+	})
+	require.Equal(t, expectedResponse, actualResponse)
+	require.Equal(t, []string{
+		"GET /v1/projects/my-project/topics/my-topic",
+		"POST /v1/projects/my-project/topics?topicId=my-topic",
+	}, server.requests)
+	require.Equal(t, "86400s", server.resources[testTopicName]["messageRetentionDuration"])
+}
+
+func Test_CreateGCPResource_Async(t *testing.T) {
+	server := newFakeGCPServer(t)
+	server.async = true
+
+	gcpController, err := NewCreateOrUpdateGCPResource(server.options(), server.client())
+	require.NoError(t, err)
+
+	request := newCreateOrUpdateRequest(t, map[string]any{"messageRetentionDuration": "86400s"})
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := gcpController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	response, ok := actualResponse.(*armrpc_rest.AsyncOperationResponse)
+	require.True(t, ok)
+	require.Equal(t, 201, response.Code)
+	require.Equal(t, "/planes/gcp/gcp/projects/my-project", response.RootScope)
+	require.Equal(t, map[string]any{
+		"id":   testTopicResourcePath,
+		"name": "my-topic",
+		"type": "GCP.PubSub/topics",
+		"properties": map[string]any{
+			"messageRetentionDuration": "86400s",
+			"provisioningState":        armrpc_v1.ProvisioningStateProvisioning,
+		},
+	}, response.Body)
+
+	obj, err := server.databaseClient.Get(ctx, "/planes/gcp/gcp/projects/my-project/providers/GCP.PubSub/locations/global/operationStatuses/"+response.OperationID.String())
+	require.NoError(t, err)
+	require.NotNil(t, obj)
+}
+
+func Test_UpdateGCPResource(t *testing.T) {
+	server := newFakeGCPServer(t)
+	server.resources[testTopicName] = map[string]any{
+		"name":                     testTopicName,
+		"messageRetentionDuration": "86400s",
+		"labels":                   map[string]any{"env": "dev"},
+	}
+
+	gcpController, err := NewCreateOrUpdateGCPResource(server.options(), server.client())
+	require.NoError(t, err)
+
+	request := newCreateOrUpdateRequest(t, map[string]any{
+		"messageRetentionDuration": "3600s",
+		"labels":                   map[string]any{"env": "dev"},
+	})
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := gcpController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	expectedResponse := armrpc_rest.NewOKResponse(map[string]any{
+		"id":   testTopicResourcePath,
+		"name": "my-topic",
+		"type": "GCP.PubSub/topics",
+		"properties": map[string]any{
+			"name":                     testTopicName,
+			"messageRetentionDuration": "3600s",
+			"labels":                   map[string]any{"env": "dev"},
+			"provisioningState":        armrpc_v1.ProvisioningStateSucceeded,
+		},
+	})
+	require.Equal(t, expectedResponse, actualResponse)
+	require.Equal(t, []string{
+		"GET /v1/projects/my-project/topics/my-topic",
+		"PATCH /v1/projects/my-project/topics/my-topic?updateMask=messageRetentionDuration",
+	}, server.requests)
+}
+
+func Test_UpdateGCPResource_NoChanges(t *testing.T) {
+	server := newFakeGCPServer(t)
+	server.resources[testTopicName] = map[string]any{
+		"name":                     testTopicName,
+		"messageRetentionDuration": "86400s",
+	}
+
+	gcpController, err := NewCreateOrUpdateGCPResource(server.options(), server.client())
+	require.NoError(t, err)
+
+	request := newCreateOrUpdateRequest(t, map[string]any{"messageRetentionDuration": "86400s"})
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := gcpController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	expectedResponse := armrpc_rest.NewOKResponse(map[string]any{
+		"id":   testTopicResourcePath,
+		"name": "my-topic",
+		"type": "GCP.PubSub/topics",
+		"properties": map[string]any{
+			"name":                     testTopicName,
+			"messageRetentionDuration": "86400s",
+			"provisioningState":        armrpc_v1.ProvisioningStateSucceeded,
+		},
+	})
+	require.Equal(t, expectedResponse, actualResponse)
+	require.Equal(t, []string{"GET /v1/projects/my-project/topics/my-topic"}, server.requests)
+}
+
+func Test_CreateGCPResource_InvalidBody(t *testing.T) {
+	server := newFakeGCPServer(t)
+
+	gcpController, err := NewCreateOrUpdateGCPResource(server.options(), server.client())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPut, testTopicResourcePath, bytes.NewBufferString("invalid"))
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := gcpController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	expectedResponse := armrpc_rest.NewBadRequestARMResponse(armrpc_v1.ErrorResponse{
+		Error: &armrpc_v1.ErrorDetails{
+			Code:    armrpc_v1.CodeInvalid,
+			Message: "failed to read request body",
+		},
+	})
+	require.Equal(t, expectedResponse, actualResponse)
+}
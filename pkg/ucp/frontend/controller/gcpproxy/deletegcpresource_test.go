@@ -0,0 +1,81 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpproxy
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+)
+
+func Test_DeleteGCPResource(t *testing.T) {
+	server := newFakeGCPServer(t)
+	server.resources[testTopicName] = map[string]any{"name": testTopicName}
+
+	gcpController, err := NewDeleteGCPResource(server.options(), server.client())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodDelete, testTopicResourcePath, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := gcpController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	require.Equal(t, armrpc_rest.NewOKResponse(nil), actualResponse)
+	require.Empty(t, server.resources)
+}
+
+func Test_DeleteGCPResource_Async(t *testing.T) {
+	server := newFakeGCPServer(t)
+	server.async = true
+	server.resources[testTopicName] = map[string]any{"name": testTopicName}
+
+	gcpController, err := NewDeleteGCPResource(server.options(), server.client())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodDelete, testTopicResourcePath, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := gcpController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	response, ok := actualResponse.(*armrpc_rest.AsyncOperationResponse)
+	require.True(t, ok)
+	require.Equal(t, 202, response.Code)
+}
+
+func Test_DeleteGCPResource_NotFound(t *testing.T) {
+	server := newFakeGCPServer(t)
+
+	gcpController, err := NewDeleteGCPResource(server.options(), server.client())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodDelete, testTopicResourcePath, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := gcpController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	require.Equal(t, armrpc_rest.NewNoContentResponse(), actualResponse)
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpproxy
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	ucp_gcp "github.com/radius-project/radius/pkg/ucp/gcp"
+)
+
+const (
+	testTopicCollectionPath = "/planes/gcp/gcp/projects/my-project/providers/GCP.PubSub/topics"
+	testTopicResourcePath   = testTopicCollectionPath + "/my-topic"
+	testTopicName           = "projects/my-project/topics/my-topic"
+)
+
+// fakeGCPServer is an httptest fake of the REST APIs of GCP services. It stores resources by their relative resource
+// name and optionally completes mutations through long-running operations.
+type fakeGCPServer struct {
+	*httptest.Server
+
+	// databaseClient is shared by the controllers under test so that operation records can be read back.
+	databaseClient database.Client
+
+	mu         sync.Mutex
+	resources  map[string]map[string]any
+	operations map[string]map[string]any
+	requests   []string
+
+	// async makes mutations return long-running operations that complete on the first poll.
+	async bool
+
+	// fail makes long-running operations complete with an error.
+	fail bool
+
+	// pending keeps long-running operations running.
+	pending bool
+
+	// pageSize is the maximum number of items in a list response.
+	pageSize int
+}
+
+// newFakeGCPServer creates and starts a fakeGCPServer.
+func newFakeGCPServer(t *testing.T) *fakeGCPServer {
+	s := &fakeGCPServer{
+		resources:  map[string]map[string]any{},
+		operations: map[string]map[string]any{},
+		pageSize:   100,
+
+		databaseClient: inmemory.NewClient(),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// client returns a GCP client that sends requests to the fake server.
+func (s *fakeGCPServer) client() ucp_gcp.Client {
+	return ucp_gcp.NewClient(ucp_gcp.ClientOptions{Endpoint: s.URL})
+}
+
+// options returns controller options backed by the in-memory database of the fake server.
+func (s *fakeGCPServer) options() armrpc_controller.Options {
+	return armrpc_controller.Options{DatabaseClient: s.databaseClient}
+}
+
+func (s *fakeGCPServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, r.Method+" "+r.URL.RequestURI())
+
+	_, name, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	body := map[string]any{}
+	if r.Body != nil {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+	}
+
+	if strings.Contains(name, "/operations/") {
+		op, ok := s.operations[name]
+		if !ok {
+			s.writeError(w, http.StatusNotFound, "NOT_FOUND", "operation not found")
+			return
+		}
+
+		// Operations complete on the first poll.
+		op["done"] = !s.pending
+		if s.fail {
+			op["error"] = map[string]any{"code": 3, "message": "invalid resource", "status": "INVALID_ARGUMENT"}
+			delete(op, "response")
+		}
+		s.write(w, op)
+		return
+	}
+
+	isCollection := len(strings.Split(name, "/"))%2 == 1
+	switch {
+	case r.Method == http.MethodGet && isCollection:
+		s.list(w, r, name)
+
+	case r.Method == http.MethodGet:
+		resource, ok := s.resources[name]
+		if !ok {
+			s.writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("Resource not found (resource=%s).", path.Base(name)))
+			return
+		}
+		s.write(w, resource)
+
+	case r.Method == http.MethodPost && isCollection:
+		singular := strings.TrimSuffix(path.Base(name), "s")
+		resourceName := name + "/" + r.URL.Query().Get(singular+"Id")
+		if _, ok := s.resources[resourceName]; ok {
+			s.writeError(w, http.StatusConflict, "ALREADY_EXISTS", "Resource already exists in the project.")
+			return
+		}
+
+		body["name"] = resourceName
+		s.resources[resourceName] = body
+		s.writeResult(w, resourceName, body)
+
+	case r.Method == http.MethodPatch:
+		resource, ok := s.resources[name]
+		if !ok {
+			s.writeError(w, http.StatusNotFound, "NOT_FOUND", "Resource not found.")
+			return
+		}
+
+		for _, field := range strings.Split(r.URL.Query().Get("updateMask"), ",") {
+			resource[field] = body[field]
+		}
+		s.writeResult(w, name, resource)
+
+	case r.Method == http.MethodDelete:
+		if _, ok := s.resources[name]; !ok {
+			s.writeError(w, http.StatusNotFound, "NOT_FOUND", "Resource not found.")
+			return
+		}
+
+		delete(s.resources, name)
+		s.writeResult(w, name, map[string]any{})
+
+	default:
+		s.writeError(w, http.StatusBadRequest, "INVALID_ARGUMENT", "unsupported request")
+	}
+}
+
+func (s *fakeGCPServer) list(w http.ResponseWriter, r *http.Request, collection string) {
+	names := []string{}
+	for name := range s.resources {
+		if path.Dir(name) == collection {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	start, _ := strconv.Atoi(r.URL.Query().Get("pageToken"))
+	end := min(start+s.pageSize, len(names))
+
+	items := []any{}
+	for _, name := range names[start:end] {
+		items = append(items, s.resources[name])
+	}
+
+	response := map[string]any{path.Base(collection): items}
+	if end < len(names) {
+		response["nextPageToken"] = strconv.Itoa(end)
+	}
+	s.write(w, response)
+}
+
+func (s *fakeGCPServer) writeResult(w http.ResponseWriter, name string, resource map[string]any) {
+	if !s.async {
+		s.write(w, resource)
+		return
+	}
+
+	project := strings.Join(strings.Split(name, "/")[:2], "/")
+	op := map[string]any{
+		"name":     fmt.Sprintf("%s/operations/operation-%d", project, len(s.operations)+1),
+		"done":     false,
+		"response": resource,
+	}
+	s.operations[op["name"].(string)] = op
+	s.write(w, map[string]any{"name": op["name"], "done": false})
+}
+
+func (s *fakeGCPServer) write(w http.ResponseWriter, body map[string]any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func (s *fakeGCPServer) writeError(w http.ResponseWriter, statusCode int, status string, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"error": map[string]any{"code": statusCode, "message": message, "status": status},
+	})
+}
+
+// startOperation starts an asynchronous create of the test topic and returns the ID of the operation.
+func startOperation(t *testing.T, s *fakeGCPServer) string {
+	s.async = true
+
+	gcpController, err := NewCreateOrUpdateGCPResource(s.options(), s.client())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodPut, testTopicResourcePath, strings.NewReader(`{"properties":{}}`))
+	require.NoError(t, err)
+
+	actualResponse, err := gcpController.Run(rpctest.NewARMRequestContext(request), nil, request)
+	require.NoError(t, err)
+
+	response, ok := actualResponse.(*armrpc_rest.AsyncOperationResponse)
+	require.True(t, ok)
+	return response.OperationID.String()
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpproxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	ucp_gcp "github.com/radius-project/radius/pkg/ucp/gcp"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	resources_gcp "github.com/radius-project/radius/pkg/ucp/resources/gcp"
+)
+
+// apiVersionPattern matches the versions of GCP REST APIs, eg: v1, v2beta1.
+var apiVersionPattern = regexp.MustCompile(`^v[0-9]+((alpha|beta)[0-9]*)?$`)
+
+// gcpRequest describes the GCP REST API call for a UCP request.
+type gcpRequest struct {
+	// service is the GCP service, eg: pubsub.
+	service string
+
+	// version is the version of the GCP REST API, eg: v1.
+	version string
+
+	// name is the relative resource name of the resource or collection, eg: projects/my-project/topics/my-topic.
+	name string
+}
+
+// readGCPRequest reads the GCP service, API version and relative resource name from the UCP request. The API version
+// is read from the api-version query parameter when it names a GCP REST API version, otherwise the default
+// version is used.
+func readGCPRequest(id resources.ID, req *http.Request) (*gcpRequest, armrpc_rest.Response) {
+	service := resources_gcp.ToGCPServiceName(id)
+	if service == "" || id.FindScope(resources_gcp.ScopeProjects) == "" {
+		errResponse := v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInvalid,
+				Message: fmt.Sprintf("failed to read GCP resource from request path: %q is not a valid GCP resource ID", id.String()),
+			},
+		}
+		return nil, armrpc_rest.NewBadRequestARMResponse(errResponse)
+	}
+
+	version := req.URL.Query().Get("api-version")
+	if !apiVersionPattern.MatchString(version) {
+		version = ucp_gcp.DefaultAPIVersion
+	}
+
+	return &gcpRequest{
+		service: service,
+		version: version,
+		name:    resources_gcp.ToGCPResourceName(id),
+	}, nil
+}
+
+// collectionIDParameter returns the query parameter used to specify the name of a new resource in a collection as
+// described by https://google.aip.dev/133, eg: topics maps to topicId.
+func collectionIDParameter(collection string) string {
+	singular := collection
+	switch {
+	case strings.HasSuffix(collection, "ies"):
+		singular = strings.TrimSuffix(collection, "ies") + "y"
+	case strings.HasSuffix(collection, "sses"), strings.HasSuffix(collection, "xes"):
+		singular = strings.TrimSuffix(collection, "es")
+	case strings.HasSuffix(collection, "s"):
+		singular = strings.TrimSuffix(collection, "s")
+	}
+
+	return singular + "Id"
+}
+
+// operationRecordID returns the ID of the operation record for the given operationStatuses or operationResults ID.
+func operationRecordID(id resources.ID) string {
+	location := v1.LocationGlobal
+	if segments := id.TypeSegments(); len(segments) > 0 && segments[0].Name != "" {
+		location = segments[0].Name
+	}
+
+	return fmt.Sprintf("%s/providers/%s/locations/%s/operationStatuses/%s", id.RootScope(), id.ProviderNamespace(), location, id.Name())
+}
+
+// saveOperation saves a record of the GCP long-running operation for the given resource and returns the operation ID
+// used in the async operation URLs.
+func saveOperation(ctx context.Context, databaseClient database.Client, id resources.ID, r *gcpRequest, op *ucp_gcp.Operation) (uuid.UUID, error) {
+	operationID := uuid.New()
+	record := &datamodel.GCPOperation{
+		Name:       op.Name,
+		Service:    r.service,
+		APIVersion: r.version,
+		ResourceID: id.String(),
+		StartTime:  time.Now().UTC(),
+	}
+
+	recordID := fmt.Sprintf("%s/providers/%s/locations/%s/operationStatuses/%s", id.RootScope(), id.ProviderNamespace(), v1.LocationGlobal, operationID.String())
+	err := databaseClient.Save(ctx, &database.Object{
+		Metadata: database.Metadata{ID: recordID},
+		Data:     record,
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return operationID, nil
+}
+
+// getOperation fetches the operation record for the given operationStatuses or operationResults ID and polls the GCP
+// long-running operation. It returns nil if the operation record does not exist.
+func getOperation(ctx context.Context, databaseClient database.Client, client ucp_gcp.Client, id resources.ID) (*datamodel.GCPOperation, *ucp_gcp.Operation, error) {
+	obj, err := databaseClient.Get(ctx, operationRecordID(id))
+	if err != nil {
+		if errors.Is(err, &database.ErrNotFound{}) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	record := &datamodel.GCPOperation{}
+	if err := obj.As(record); err != nil {
+		return nil, nil, err
+	}
+
+	body, err := client.Do(ctx, http.MethodGet, client.ResourceURL(record.Service, record.APIVersion, record.Name), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	op, ok := ucp_gcp.ToOperation(body)
+	if !ok {
+		return nil, nil, fmt.Errorf("%q is not a GCP long-running operation", record.Name)
+	}
+
+	return record, op, nil
+}
+
+// resourceBody returns the UCP representation of a GCP resource.
+func resourceBody(id resources.ID, properties map[string]any) map[string]any {
+	return map[string]any{
+		"id":         id.String(),
+		"name":       id.Name(),
+		"type":       id.Type(),
+		"properties": properties,
+	}
+}
+
+// operationFailedResponse returns the response for a GCP long-running operation that completed with an error.
+func operationFailedResponse(op *ucp_gcp.Operation) armrpc_rest.Response {
+	return armrpc_rest.NewBadRequestARMResponse(v1.ErrorResponse{
+		Error: &v1.ErrorDetails{
+			Code:    op.ErrorCode,
+			Message: op.ErrorMessage,
+		},
+	})
+}
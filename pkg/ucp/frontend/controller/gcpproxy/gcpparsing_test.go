@@ -0,0 +1,38 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpproxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+func Test_CollectionIDParameter(t *testing.T) {
+	require.Equal(t, "topicId", collectionIDParameter("topics"))
+	require.Equal(t, "repositoryId", collectionIDParameter("repositories"))
+	require.Equal(t, "addressId", collectionIDParameter("addresses"))
+	require.Equal(t, "indexId", collectionIDParameter("indexes"))
+}
+
+func Test_OperationRecordID(t *testing.T) {
+	id, err := resources.ParseResource("/planes/gcp/gcp/projects/my-project/locations/us-central1/providers/GCP.Run/locations/global/operationResults/00000000-0000-0000-0000-000000000000")
+	require.NoError(t, err)
+	require.Equal(t, "/planes/gcp/gcp/projects/my-project/locations/us-central1/providers/GCP.Run/locations/global/operationStatuses/00000000-0000-0000-0000-000000000000", operationRecordID(id))
+}
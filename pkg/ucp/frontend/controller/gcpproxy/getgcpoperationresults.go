@@ -0,0 +1,70 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpproxy
+
+import (
+	"context"
+	http "net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	ucp_gcp "github.com/radius-project/radius/pkg/ucp/gcp"
+)
+
+var _ armrpc_controller.Controller = (*GetGCPOperationResults)(nil)
+
+// GetGCPOperationResults is the controller implementation to get the result of GCP resource operation.
+type GetGCPOperationResults struct {
+	armrpc_controller.Operation[*datamodel.GCPResource, datamodel.GCPResource]
+	client ucp_gcp.Client
+}
+
+// NewGetGCPOperationResults creates a new GetGCPOperationResults controller which is used to get the results of GCP operations.
+func NewGetGCPOperationResults(opts armrpc_controller.Options, client ucp_gcp.Client) (armrpc_controller.Controller, error) {
+	return &GetGCPOperationResults{
+		Operation: armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.GCPResource]{}),
+		client:    client,
+	}, nil
+}
+
+// Run polls the GCP long-running operation recorded for the operation ID, and returns an AsyncOperationResultResponse
+// if the operation has not completed, or a NoContentResponse if it has. If the operation is not found, it returns a
+// NotFoundResponse.
+func (p *GetGCPOperationResults) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	record, op, err := getOperation(ctx, p.DatabaseClient(), p.client, serviceCtx.ResourceID)
+	if ucp_gcp.IsGCPResourceNotFoundError(err) {
+		return armrpc_rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return ucp_gcp.HandleGCPError(err)
+	} else if record == nil {
+		return armrpc_rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	if !op.Done {
+		headers := map[string]string{
+			"Location":    req.URL.String(),
+			"Retry-After": v1.DefaultRetryAfter,
+		}
+		return armrpc_rest.NewAsyncOperationResultResponse(headers), nil
+	}
+
+	return armrpc_rest.NewNoContentResponse(), nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpproxy
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	armrpc_v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+)
+
+const testOperationResultsPath = "/planes/gcp/gcp/projects/my-project/providers/GCP.PubSub/locations/global/operationResults/"
+
+func Test_GetGCPOperationResults(t *testing.T) {
+	server := newFakeGCPServer(t)
+	operationID := startOperation(t, server)
+
+	gcpController, err := NewGetGCPOperationResults(server.options(), server.client())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, testOperationResultsPath+operationID, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := gcpController.Run(ctx, nil, request)
+	require.NoError(t, err)
+	require.Equal(t, armrpc_rest.NewNoContentResponse(), actualResponse)
+}
+
+func Test_GetGCPOperationResults_InProgress(t *testing.T) {
+	server := newFakeGCPServer(t)
+	server.pending = true
+	operationID := startOperation(t, server)
+
+	gcpController, err := NewGetGCPOperationResults(server.options(), server.client())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, testOperationResultsPath+operationID, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := gcpController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	expectedResponse := armrpc_rest.NewAsyncOperationResultResponse(map[string]string{
+		"Location":    testOperationResultsPath + operationID,
+		"Retry-After": armrpc_v1.DefaultRetryAfter,
+	})
+	require.Equal(t, expectedResponse, actualResponse)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpproxy
+
+import (
+	"context"
+	http "net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	ucp_gcp "github.com/radius-project/radius/pkg/ucp/gcp"
+)
+
+var _ armrpc_controller.Controller = (*GetGCPOperationStatuses)(nil)
+
+// GetGCPOperationStatuses is the controller implementation to get GCP resource operation status.
+type GetGCPOperationStatuses struct {
+	armrpc_controller.Operation[*datamodel.GCPResource, datamodel.GCPResource]
+	client ucp_gcp.Client
+}
+
+// NewGetGCPOperationStatuses creates a new GetGCPOperationStatuses controller which is used to get the statuses of GCP operations.
+func NewGetGCPOperationStatuses(opts armrpc_controller.Options, client ucp_gcp.Client) (armrpc_controller.Controller, error) {
+	return &GetGCPOperationStatuses{
+		Operation: armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.GCPResource]{}),
+		client:    client,
+	}, nil
+}
+
+// Run() polls the GCP long-running operation recorded for the operation ID, and returns the async operation status.
+// If the operation is not found, it returns a NotFoundResponse.
+func (p *GetGCPOperationStatuses) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+
+	record, op, err := getOperation(ctx, p.DatabaseClient(), p.client, serviceCtx.ResourceID)
+	if ucp_gcp.IsGCPResourceNotFoundError(err) {
+		return armrpc_rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return ucp_gcp.HandleGCPError(err)
+	} else if record == nil {
+		return armrpc_rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	}
+
+	os := v1.AsyncOperationStatus{
+		ID:        serviceCtx.ResourceID.String(),
+		Name:      serviceCtx.ResourceID.Name(),
+		StartTime: record.StartTime,
+	}
+
+	switch {
+	case op.Failed():
+		os.Status = v1.ProvisioningStateFailed
+		os.Error = &v1.ErrorDetails{
+			Code:    op.ErrorCode,
+			Message: op.ErrorMessage,
+		}
+	case op.Done:
+		os.Status = v1.ProvisioningStateSucceeded
+	default:
+		os.Status = v1.ProvisioningStateProvisioning
+	}
+
+	return armrpc_rest.NewOKResponse(os), nil
+}
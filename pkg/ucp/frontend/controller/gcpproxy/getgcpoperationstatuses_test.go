@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpproxy
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	armrpc_v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const testOperationStatusesPath = "/planes/gcp/gcp/projects/my-project/providers/GCP.PubSub/locations/global/operationStatuses/"
+
+func Test_GetGCPOperationStatuses(t *testing.T) {
+	server := newFakeGCPServer(t)
+	operationID := startOperation(t, server)
+
+	gcpController, err := NewGetGCPOperationStatuses(server.options(), server.client())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, testOperationStatusesPath+operationID, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := gcpController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	response, ok := actualResponse.(*armrpc_rest.OKResponse)
+	require.True(t, ok)
+	status := response.Body.(armrpc_v1.AsyncOperationStatus)
+	require.Equal(t, armrpc_v1.ProvisioningStateSucceeded, status.Status)
+	require.Equal(t, operationID, status.Name)
+	require.Nil(t, status.Error)
+	require.Contains(t, server.requests, "GET /v1/projects/my-project/operations/operation-1")
+}
+
+func Test_GetGCPOperationStatuses_Failed(t *testing.T) {
+	server := newFakeGCPServer(t)
+	server.fail = true
+	operationID := startOperation(t, server)
+
+	gcpController, err := NewGetGCPOperationStatuses(server.options(), server.client())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, testOperationStatusesPath+operationID, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := gcpController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	response, ok := actualResponse.(*armrpc_rest.OKResponse)
+	require.True(t, ok)
+	status := response.Body.(armrpc_v1.AsyncOperationStatus)
+	require.Equal(t, armrpc_v1.ProvisioningStateFailed, status.Status)
+	require.Equal(t, &armrpc_v1.ErrorDetails{Code: "INVALID_ARGUMENT", Message: "invalid resource"}, status.Error)
+}
+
+func Test_GetGCPOperationStatuses_NotFound(t *testing.T) {
+	server := newFakeGCPServer(t)
+
+	gcpController, err := NewGetGCPOperationStatuses(server.options(), server.client())
+	require.NoError(t, err)
+
+	path := testOperationStatusesPath + "00000000-0000-0000-0000-000000000000"
+	request, err := http.NewRequest(http.MethodGet, path, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := gcpController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	id, err := resources.ParseResource(path)
+	require.NoError(t, err)
+	require.Equal(t, armrpc_rest.NewNotFoundResponse(id), actualResponse)
+	require.Empty(t, server.requests)
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpproxy
+
+import (
+	"context"
+	http "net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	ucp_gcp "github.com/radius-project/radius/pkg/ucp/gcp"
+)
+
+var _ armrpc_controller.Controller = (*GetGCPResource)(nil)
+
+// GetGCPResource is the controller implementation to get GCP resource.
+type GetGCPResource struct {
+	armrpc_controller.Operation[*datamodel.GCPResource, datamodel.GCPResource]
+	client ucp_gcp.Client
+}
+
+// NewGetGCPResource creates a new GetGCPResource controller with the given options and GCP client.
+func NewGetGCPResource(opts armrpc_controller.Options, client ucp_gcp.Client) (armrpc_controller.Controller, error) {
+	return &GetGCPResource{
+		Operation: armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.GCPResource]{}),
+		client:    client,
+	}, nil
+}
+
+// Run() gets the resource from the GCP service that owns the resource type, and returns a response containing the
+// resource's properties.
+func (p *GetGCPResource) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	r, errResponse := readGCPRequest(serviceCtx.ResourceID, req)
+	if errResponse != nil {
+		return errResponse, nil
+	}
+
+	properties, err := p.client.Do(ctx, http.MethodGet, p.client.ResourceURL(r.service, r.version, r.name), nil)
+	if ucp_gcp.IsGCPResourceNotFoundError(err) {
+		return armrpc_rest.NewNotFoundResponse(serviceCtx.ResourceID), nil
+	} else if err != nil {
+		return ucp_gcp.HandleGCPError(err)
+	}
+
+	return armrpc_rest.NewOKResponse(resourceBody(serviceCtx.ResourceID, properties)), nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpproxy
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	armrpc_v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+func Test_GetGCPResource(t *testing.T) {
+	server := newFakeGCPServer(t)
+	server.resources[testTopicName] = map[string]any{
+		"name":                     testTopicName,
+		"messageRetentionDuration": "86400s",
+	}
+
+	gcpController, err := NewGetGCPResource(server.options(), server.client())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, testTopicResourcePath, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := gcpController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	expectedResponse := armrpc_rest.NewOKResponse(map[string]any{
+		"id":   testTopicResourcePath,
+		"name": "my-topic",
+		"type": "GCP.PubSub/topics",
+		"properties": map[string]any{
+			"name":                     testTopicName,
+			"messageRetentionDuration": "86400s",
+		},
+	})
+	require.Equal(t, expectedResponse, actualResponse)
+	require.Equal(t, []string{"GET /v1/projects/my-project/topics/my-topic"}, server.requests)
+}
+
+func Test_GetGCPResource_APIVersion(t *testing.T) {
+	server := newFakeGCPServer(t)
+	server.resources[testTopicName] = map[string]any{"name": testTopicName}
+
+	gcpController, err := NewGetGCPResource(server.options(), server.client())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, testTopicResourcePath+"?api-version=v1beta2", nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	_, err = gcpController.Run(ctx, nil, request)
+	require.NoError(t, err)
+	require.Equal(t, []string{"GET /v1beta2/projects/my-project/topics/my-topic"}, server.requests)
+}
+
+func Test_GetGCPResource_NotFound(t *testing.T) {
+	server := newFakeGCPServer(t)
+
+	gcpController, err := NewGetGCPResource(server.options(), server.client())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, testTopicResourcePath, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := gcpController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	id, err := resources.ParseResource(testTopicResourcePath)
+	require.NoError(t, err)
+
+	expectedResponse := armrpc_rest.NewNotFoundResponse(id)
+	require.Equal(t, expectedResponse, actualResponse)
+}
+
+func Test_GetGCPResource_InvalidID(t *testing.T) {
+	server := newFakeGCPServer(t)
+
+	gcpController, err := NewGetGCPResource(server.options(), server.client())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, "/planes/gcp/gcp/providers/Other.Service/topics/my-topic", nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := gcpController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	expectedResponse := armrpc_rest.NewBadRequestARMResponse(armrpc_v1.ErrorResponse{
+		Error: &armrpc_v1.ErrorDetails{
+			Code:    armrpc_v1.CodeInvalid,
+			Message: "failed to read GCP resource from request path: \"/planes/gcp/gcp/providers/Other.Service/topics/my-topic\" is not a valid GCP resource ID",
+		},
+	})
+	require.Equal(t, expectedResponse, actualResponse)
+	require.Empty(t, server.requests)
+}
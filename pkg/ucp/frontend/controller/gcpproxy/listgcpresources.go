@@ -0,0 +1,102 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpproxy
+
+import (
+	"context"
+	http "net/http"
+	"net/url"
+	"path"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	ucp_gcp "github.com/radius-project/radius/pkg/ucp/gcp"
+)
+
+var _ armrpc_controller.Controller = (*ListGCPResources)(nil)
+
+// ListGCPResources is the controller implementation to list GCP resources.
+type ListGCPResources struct {
+	armrpc_controller.Operation[*datamodel.GCPResource, datamodel.GCPResource]
+	client ucp_gcp.Client
+}
+
+// NewListGCPResources creates a new ListGCPResources controller with the given options and GCP client.
+func NewListGCPResources(opts armrpc_controller.Options, client ucp_gcp.Client) (armrpc_controller.Controller, error) {
+	return &ListGCPResources{
+		Operation: armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.GCPResource]{}),
+		client:    client,
+	}, nil
+}
+
+// Run() lists the resources in the collection following https://google.aip.dev/132, reading all pages of the result,
+// and returns a response with the list of resources.
+func (p *ListGCPResources) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	r, errResponse := readGCPRequest(serviceCtx.ResourceID, req)
+	if errResponse != nil {
+		return errResponse, nil
+	}
+
+	// The items of the list response are stored in a field named after the collection, eg: topics.
+	collection := path.Base(r.name)
+
+	items := []any{}
+	pageToken := ""
+	for {
+		u := p.client.ResourceURL(r.service, r.version, r.name)
+		if pageToken != "" {
+			u += "?" + url.Values{"pageToken": []string{pageToken}}.Encode()
+		}
+
+		response, err := p.client.Do(ctx, http.MethodGet, u, nil)
+		if err != nil {
+			return ucp_gcp.HandleGCPError(err)
+		}
+
+		results, _ := response[collection].([]any)
+		for _, result := range results {
+			properties, ok := result.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			// GCP resources are identified by their full relative name, eg: projects/my-project/topics/my-topic.
+			name, _ := properties["name"].(string)
+			resourceName := path.Base(name)
+			item := map[string]any{
+				"id":         path.Join(serviceCtx.ResourceID.String(), resourceName),
+				"name":       resourceName,
+				"type":       serviceCtx.ResourceID.Type(),
+				"properties": properties,
+			}
+			items = append(items, item)
+		}
+
+		pageToken, _ = response["nextPageToken"].(string)
+		if pageToken == "" {
+			break
+		}
+	}
+
+	body := map[string]any{
+		"value": items,
+	}
+	return armrpc_rest.NewOKResponse(body), nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpproxy
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+)
+
+func Test_ListGCPResources(t *testing.T) {
+	server := newFakeGCPServer(t)
+	server.pageSize = 1
+	server.resources["projects/my-project/topics/topic-1"] = map[string]any{"name": "projects/my-project/topics/topic-1"}
+	server.resources["projects/my-project/topics/topic-2"] = map[string]any{"name": "projects/my-project/topics/topic-2"}
+
+	gcpController, err := NewListGCPResources(server.options(), server.client())
+	require.NoError(t, err)
+
+	request, err := http.NewRequest(http.MethodGet, testTopicCollectionPath, nil)
+	require.NoError(t, err)
+
+	ctx := rpctest.NewARMRequestContext(request)
+	actualResponse, err := gcpController.Run(ctx, nil, request)
+	require.NoError(t, err)
+
+	expectedResponse := armrpc_rest.NewOKResponse(map[string]any{
+		"value": []any{
+			map[string]any{
+				"id":         testTopicCollectionPath + "/topic-1",
+				"name":       "topic-1",
+				"type":       "GCP.PubSub/topics",
+				"properties": map[string]any{"name": "projects/my-project/topics/topic-1"},
+			},
+			map[string]any{
+				"id":         testTopicCollectionPath + "/topic-2",
+				"name":       "topic-2",
+				"type":       "GCP.PubSub/topics",
+				"properties": map[string]any{"name": "projects/my-project/topics/topic-2"},
+			},
+		},
+	})
+	require.Equal(t, expectedResponse, actualResponse)
+	require.Equal(t, []string{
+		"GET /v1/projects/my-project/topics",
+		"GET /v1/projects/my-project/topics?pageToken=1",
+	}, server.requests)
+}
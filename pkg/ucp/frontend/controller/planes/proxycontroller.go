@@ -29,7 +29,6 @@ import (
 	"github.com/radius-project/radius/pkg/ucp/proxy"
 	"github.com/radius-project/radius/pkg/ucp/resources"
 	"github.com/radius-project/radius/pkg/ucp/ucplog"
-	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 const (
@@ -41,13 +40,17 @@ var _ armrpc_controller.Controller = (*ProxyController)(nil)
 // ProxyController is the controller implementation to proxy requests to Azure.
 type ProxyController struct {
 	armrpc_controller.Operation[*datamodel.AzurePlane, datamodel.AzurePlane]
+
+	// transport is the http.RoundTripper to use for proxying requests.
+	transport http.RoundTripper
 }
 
-// NewProxyController creates a new ProxyPlane controller with the given options and returns it, or returns an error if the
-// controller cannot be created.
-func NewProxyController(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+// NewProxyController creates a new ProxyPlane controller with the given options and transport and returns it, or returns
+// an error if the controller cannot be created.
+func NewProxyController(opts armrpc_controller.Options, transport http.RoundTripper) (armrpc_controller.Controller, error) {
 	return &ProxyController{
 		Operation: armrpc_controller.NewOperation(opts, armrpc_controller.ResourceOptions[datamodel.AzurePlane]{}),
+		transport: transport,
 	}, nil
 }
 
@@ -107,7 +110,7 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 	}
 
 	options := proxy.ReverseProxyOptions{
-		RoundTripper: otelhttp.NewTransport(http.DefaultTransport),
+		RoundTripper: p.transport,
 	}
 
 	refererURL := url.URL{
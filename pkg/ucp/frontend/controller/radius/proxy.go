@@ -52,6 +52,15 @@ const (
 
 	// EnqueueOperationRetryCount is the number of times to retry enqueueing an async operation before giving up.
 	EnqueueOperationRetryCount = 10
+
+	// DeprecationHeader is the response header that signals a deprecated resource type or API version.
+	DeprecationHeader = "Deprecation"
+
+	// SunsetHeader is the response header that carries the sunset date of a resource type or API version.
+	SunsetHeader = "Sunset"
+
+	// WarningHeader is the response header that carries the deprecation message.
+	WarningHeader = "Warning"
 )
 
 type updater interface {
@@ -72,11 +81,18 @@ type ProxyController struct {
 
 	// updater is used to process tracked resources. Can be overridden for testing.
 	updater updater
+
+	// selector selects the address of the resource provider location to proxy requests to.
+	selector resourcegroups.AddressSelector
+
+	// lifecycles caches the lifecycles of resource types and API versions.
+	lifecycles *resourcegroups.LifecycleCache
 }
 
 // NewProxyController creates a new ProxyPlane controller with the given options and returns it, or returns an error if the
-// controller cannot be created.
-func NewProxyController(opts armrpc_controller.Options, transport http.RoundTripper, defaultDownstream string) (armrpc_controller.Controller, error) {
+// controller cannot be created. The selector chooses between the addresses of a resource provider location, and may be nil.
+// The lifecycle cache is shared between requests, and may be nil.
+func NewProxyController(opts armrpc_controller.Options, transport http.RoundTripper, defaultDownstream string, selector resourcegroups.AddressSelector, lifecycles *resourcegroups.LifecycleCache) (armrpc_controller.Controller, error) {
 	parsedDefaultDownstream, err := url.Parse(defaultDownstream)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse default downstream URL: %w", err)
@@ -88,6 +104,8 @@ func NewProxyController(opts armrpc_controller.Options, transport http.RoundTrip
 		transport:         transport,
 		defaultDownstream: parsedDefaultDownstream,
 		updater:           updater,
+		selector:          selector,
+		lifecycles:        lifecycles,
 	}, nil
 }
 
@@ -118,12 +136,15 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 		return armrpc_rest.NewBadRequestARMResponse(response), nil
 	}
 
-	downstreamURL, err := resourcegroups.ValidateDownstream(ctx, p.DatabaseClient(), id, v1.LocationGlobal, apiVersion)
+	downstreamURL, err := resourcegroups.ValidateDownstream(ctx, p.DatabaseClient(), id, v1.LocationGlobal, apiVersion, p.selector)
 	if errors.Is(err, &resourcegroups.NotFoundError{}) {
 		return armrpc_rest.NewNotFoundResponseWithCause(id, err.Error()), nil
 	} else if errors.Is(err, &resourcegroups.InvalidError{}) {
 		response := v1.ErrorResponse{Error: &v1.ErrorDetails{Code: v1.CodeInvalid, Message: err.Error(), Target: id.String()}}
 		return armrpc_rest.NewBadRequestARMResponse(response), nil
+	} else if errors.Is(err, &resourcegroups.UnavailableError{}) {
+		response := v1.ErrorResponse{Error: &v1.ErrorDetails{Code: v1.CodeServiceUnavailable, Message: err.Error(), Target: id.String()}}
+		return armrpc_rest.NewServiceUnavailableARMResponse(response), nil
 	} else if err != nil {
 		return nil, fmt.Errorf("failed to validate downstream: %w", err)
 	}
@@ -138,6 +159,31 @@ func (p *ProxyController) Run(ctx context.Context, w http.ResponseWriter, req *h
 		return armrpc_rest.NewInternalServerErrorARMResponse(response), nil
 	}
 
+	lifecycle, err := p.lifecycles.Get(ctx, p.DatabaseClient(), id, apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch resource type lifecycle: %w", err)
+	}
+
+	if lifecycle.IsSunset(time.Now()) && strings.EqualFold(req.Method, http.MethodPut) {
+		exists, err := p.IsTrackedResource(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			message := fmt.Sprintf("resource type %q with api version %q can no longer be used to create new resources", id.Type(), apiVersion)
+			if lifecycle.Message != nil {
+				message += ". " + *lifecycle.Message
+			}
+			response := v1.ErrorResponse{Error: &v1.ErrorDetails{Code: v1.CodeInvalid, Message: message, Target: id.String()}}
+			return armrpc_rest.NewBadRequestARMResponse(response), nil
+		}
+	}
+
+	if lifecycle.IsDeprecated() {
+		SetDeprecationHeaders(w.Header(), id, apiVersion, lifecycle)
+	}
+
 	proxyReq, err := p.PrepareProxyRequest(ctx, req, downstreamURL.String(), relativePath)
 	if err != nil {
 		return nil, err
@@ -225,6 +271,47 @@ func (p *ProxyController) PrepareProxyRequest(ctx context.Context, originalReq *
 	return proxyReq, nil
 }
 
+// IsTrackedResource returns true if the resource is already tracked by UCP.
+//
+// Only top-level resources are tracked, so nested resources are always treated as existing.
+func (p *ProxyController) IsTrackedResource(ctx context.Context, id resources.ID) (bool, error) {
+	if len(id.TypeSegments()) != 1 || !id.IsResource() {
+		return true, nil
+	}
+
+	_, obj, err := trackedresource.ResolveTrackingEntry(ctx, p.DatabaseClient(), id)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch tracked resource: %w", err)
+	}
+
+	return obj != nil, nil
+}
+
+// SetDeprecationHeaders adds the headers that warn clients about a deprecated resource type or API version.
+//
+// The Deprecation and Sunset headers follow RFC 9745 and RFC 8594. The Warning header carries a human-readable message.
+func SetDeprecationHeaders(header http.Header, id resources.ID, apiVersion string, lifecycle *datamodel.ResourceTypeLifecycle) {
+	header.Set(DeprecationHeader, "true")
+	if lifecycle.SunsetDate != nil {
+		header.Set(SunsetHeader, lifecycle.SunsetDate.UTC().Format(http.TimeFormat))
+	}
+
+	state := "deprecated"
+	if lifecycle.State == datamodel.LifecycleStateRetired {
+		state = "retired"
+	}
+
+	message := fmt.Sprintf("resource type %q with api version %q is %s", id.Type(), apiVersion, state)
+	if lifecycle.SunsetDate != nil {
+		message += fmt.Sprintf(" and cannot be used to create new resources after %s", lifecycle.SunsetDate.UTC().Format(time.DateOnly))
+	}
+	if lifecycle.Message != nil {
+		message += ". " + *lifecycle.Message
+	}
+
+	header.Set(WarningHeader, fmt.Sprintf("299 - %q", message))
+}
+
 // ShouldTrackRequest returns true if the request should be tracked.
 func (p *ProxyController) ShouldTrackRequest(httpMethod string, id resources.ID, resp *http.Response) bool {
 	// Only track mutating requests.
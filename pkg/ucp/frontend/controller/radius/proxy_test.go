@@ -19,10 +19,12 @@ package radius
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
 	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
@@ -53,7 +55,9 @@ func createController(t *testing.T) (*ProxyController, *database.MockClient, *mo
 	p, err := NewProxyController(
 		controller.Options{DatabaseClient: databaseClient, StatusManager: statusManager},
 		&roundTripper,
-		"http://localhost:1234")
+		"http://localhost:1234",
+		nil,
+		nil)
 	require.NoError(t, err)
 
 	updater := mockUpdater{}
@@ -70,6 +74,8 @@ func Test_Run(t *testing.T) {
 	resourceTypeID, err := datamodel.ResourceTypeIDFromResourceID(id)
 	require.NoError(t, err)
 
+	apiVersionID := resourceTypeID.Append(resources.TypeSegment{Type: datamodel.APIVersionResourceUnqualifiedResourceType, Name: apiVersion})
+
 	locationID, err := datamodel.ResourceProviderLocationIDFromResourceID(id, "global")
 	require.NoError(t, err)
 
@@ -162,7 +168,11 @@ func Test_Run(t *testing.T) {
 
 		databaseClient.EXPECT().
 			Get(gomock.Any(), resourceTypeID.String(), gomock.Any()).
-			Return(&database.Object{Data: resourceTypeResource}, nil).Times(1)
+			Return(&database.Object{Data: resourceTypeResource}, nil).Times(2)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), apiVersionID.String(), gomock.Any()).
+			Return(nil, &database.ErrNotFound{}).Times(1)
 
 		databaseClient.EXPECT().
 			Get(gomock.Any(), id.RootScope(), gomock.Any()).
@@ -202,7 +212,11 @@ func Test_Run(t *testing.T) {
 
 		databaseClient.EXPECT().
 			Get(gomock.Any(), resourceTypeID.String(), gomock.Any()).
-			Return(&database.Object{Data: resourceTypeResource}, nil).Times(1)
+			Return(&database.Object{Data: resourceTypeResource}, nil).Times(2)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), apiVersionID.String(), gomock.Any()).
+			Return(nil, &database.ErrNotFound{}).Times(1)
 
 		databaseClient.EXPECT().
 			Get(gomock.Any(), id.RootScope(), gomock.Any()).
@@ -245,7 +259,11 @@ func Test_Run(t *testing.T) {
 
 		databaseClient.EXPECT().
 			Get(gomock.Any(), resourceTypeID.String(), gomock.Any()).
-			Return(&database.Object{Data: resourceTypeResource}, nil).Times(1)
+			Return(&database.Object{Data: resourceTypeResource}, nil).Times(2)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), apiVersionID.String(), gomock.Any()).
+			Return(nil, &database.ErrNotFound{}).Times(1)
 
 		databaseClient.EXPECT().
 			Get(gomock.Any(), id.RootScope(), gomock.Any()).
@@ -301,7 +319,11 @@ func Test_Run(t *testing.T) {
 
 		databaseClient.EXPECT().
 			Get(gomock.Any(), resourceTypeID.String(), gomock.Any()).
-			Return(&database.Object{Data: resourceTypeResource}, nil).Times(1)
+			Return(&database.Object{Data: resourceTypeResource}, nil).Times(2)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), apiVersionID.String(), gomock.Any()).
+			Return(nil, &database.ErrNotFound{}).Times(1)
 
 		databaseClient.EXPECT().
 			Get(gomock.Any(), id.RootScope(), gomock.Any()).
@@ -342,6 +364,120 @@ func Test_Run(t *testing.T) {
 		require.Nil(t, response)
 	})
 
+	t.Run("success (deprecated api version)", func(t *testing.T) {
+		p, databaseClient, _, roundTripper, _ := createController(t)
+
+		svcContext := &v1.ARMRequestContext{
+			APIVersion: apiVersion,
+			ResourceID: id,
+		}
+		ctx := t.Context()
+		ctx = v1.WithARMRequestContext(ctx, svcContext)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, id.String()+"?api-version="+apiVersion, nil)
+
+		apiVersionResource := &datamodel.APIVersion{
+			Properties: datamodel.APIVersionProperties{
+				Lifecycle: &datamodel.ResourceTypeLifecycle{
+					State:      datamodel.LifecycleStateDeprecated,
+					SunsetDate: new(time.Date(2099, 1, 1, 0, 0, 0, 0, time.UTC)),
+					Message:    new("Use api version 2026-01-01 instead."),
+				},
+			},
+		}
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.PlaneScope(), gomock.Any()).
+			Return(&database.Object{Data: plane}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), resourceTypeID.String(), gomock.Any()).
+			Return(&database.Object{Data: resourceTypeResource}, nil).Times(2)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), apiVersionID.String(), gomock.Any()).
+			Return(&database.Object{Data: apiVersionResource}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.RootScope(), gomock.Any()).
+			Return(&database.Object{Data: resourceGroup}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), locationResource.ID).
+			Return(&database.Object{Data: locationResource}, nil).Times(1)
+
+		downstreamResponse := httptest.NewRecorder()
+		downstreamResponse.WriteHeader(http.StatusOK)
+		roundTripper.Response = downstreamResponse.Result()
+
+		response, err := p.Run(ctx, w, req.WithContext(ctx))
+		require.NoError(t, err)
+		require.Nil(t, response)
+
+		require.Equal(t, "true", w.Header().Get(DeprecationHeader))
+		require.Equal(t, "Thu, 01 Jan 2099 00:00:00 GMT", w.Header().Get(SunsetHeader))
+		require.Equal(t, `299 - "resource type \"Applications.Test/testResources\" with api version \"2025-01-01\" is deprecated and cannot be used to create new resources after 2099-01-01. Use api version 2026-01-01 instead."`, w.Header().Get(WarningHeader))
+	})
+
+	t.Run("failure (retired api version)", func(t *testing.T) {
+		p, databaseClient, _, _, _ := createController(t)
+
+		svcContext := &v1.ARMRequestContext{
+			APIVersion: apiVersion,
+			ResourceID: id,
+		}
+		ctx := t.Context()
+		ctx = v1.WithARMRequestContext(ctx, svcContext)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPut, id.String()+"?api-version="+apiVersion, nil)
+
+		retiredResourceType := &datamodel.ResourceType{
+			BaseResource: resourceTypeResource.BaseResource,
+			Properties: datamodel.ResourceTypeProperties{
+				Lifecycle: &datamodel.ResourceTypeLifecycle{State: datamodel.LifecycleStateRetired},
+			},
+		}
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.PlaneScope(), gomock.Any()).
+			Return(&database.Object{Data: plane}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), resourceTypeID.String(), gomock.Any()).
+			Return(&database.Object{Data: retiredResourceType}, nil).Times(2)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), apiVersionID.String(), gomock.Any()).
+			Return(nil, &database.ErrNotFound{}).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.RootScope(), gomock.Any()).
+			Return(&database.Object{Data: resourceGroup}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), locationResource.ID).
+			Return(&database.Object{Data: locationResource}, nil).Times(1)
+
+		// The resource is not tracked yet, so this request would create it.
+		databaseClient.EXPECT().
+			Get(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil, &database.ErrNotFound{}).Times(2)
+
+		expected := rest.NewBadRequestARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeInvalid,
+				Message: "resource type \"Applications.Test/testResources\" with api version \"2025-01-01\" can no longer be used to create new resources",
+				Target:  id.String(),
+			},
+		})
+
+		response, err := p.Run(ctx, w, req.WithContext(ctx))
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+	})
+
 	t.Run("failure (validate downstream: not found)", func(t *testing.T) {
 		p, databaseClient, _, _, _ := createController(t)
 
@@ -365,6 +501,49 @@ func Test_Run(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, expected, response)
 	})
+
+	t.Run("failure (validate downstream: unavailable)", func(t *testing.T) {
+		p, databaseClient, _, _, _ := createController(t)
+		p.selector = &mockSelector{err: errors.New("all addresses of resource provider location are unhealthy")}
+
+		svcContext := &v1.ARMRequestContext{
+			APIVersion: apiVersion,
+			ResourceID: id,
+		}
+		ctx := t.Context()
+		ctx = v1.WithARMRequestContext(ctx, svcContext)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, id.String()+"?api-version="+apiVersion, nil)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.PlaneScope(), gomock.Any()).
+			Return(&database.Object{Data: plane}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), id.RootScope(), gomock.Any()).
+			Return(&database.Object{Data: resourceGroup}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), resourceTypeID.String(), gomock.Any()).
+			Return(&database.Object{Data: resourceTypeResource}, nil).Times(1)
+
+		databaseClient.EXPECT().
+			Get(gomock.Any(), locationResource.ID).
+			Return(&database.Object{Data: locationResource}, nil).Times(1)
+
+		expected := rest.NewServiceUnavailableARMResponse(v1.ErrorResponse{
+			Error: &v1.ErrorDetails{
+				Code:    v1.CodeServiceUnavailable,
+				Message: "all addresses of resource provider location are unhealthy",
+				Target:  id.String(),
+			},
+		})
+
+		response, err := p.Run(ctx, w, req.WithContext(ctx))
+		require.NoError(t, err)
+		require.Equal(t, expected, response)
+	})
 }
 
 func Test_ProxyController_PrepareProxyRequest(t *testing.T) {
@@ -440,3 +619,12 @@ func (rt *mockRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
 	}
 	return rt.Response, rt.Err
 }
+
+type mockSelector struct {
+	address string
+	err     error
+}
+
+func (s *mockSelector) SelectAddress(location *datamodel.Location) (string, error) {
+	return s.address, s.err
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroups
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// LifecycleCache caches the lifecycles returned by GetResourceTypeLifecycle, so that proxy requests don't read the
+// resource type and API version from the database every time. A change to a lifecycle applies to proxy requests once
+// the cached lifecycle expires.
+type LifecycleCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]lifecycleCacheEntry
+}
+
+type lifecycleCacheEntry struct {
+	lifecycle *datamodel.ResourceTypeLifecycle
+	expiresAt time.Time
+}
+
+// NewLifecycleCache creates a new LifecycleCache that keeps lifecycles for the given duration.
+func NewLifecycleCache(ttl time.Duration) *LifecycleCache {
+	return &LifecycleCache{ttl: ttl, entries: map[string]lifecycleCacheEntry{}}
+}
+
+// Get returns the lifecycle that applies to a proxy request for the resource type and API version, like
+// GetResourceTypeLifecycle. A nil cache reads the lifecycle from the database every time.
+func (c *LifecycleCache) Get(ctx context.Context, client database.Client, id resources.ID, apiVersion string) (*datamodel.ResourceTypeLifecycle, error) {
+	if c == nil || isOperationResourceType(id) {
+		return GetResourceTypeLifecycle(ctx, client, id, apiVersion)
+	}
+
+	resourceTypeID, err := datamodel.ResourceTypeIDFromResourceID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	key := strings.ToLower(resourceTypeID.String() + "@" + apiVersion)
+	now := time.Now()
+
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.lifecycle, nil
+	}
+
+	lifecycle, err := GetResourceTypeLifecycle(ctx, client, id, apiVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Expired entries are removed when a lifecycle is added. ValidateDownstream rejects the resource types and API
+	// versions that are not registered, so the cache holds at most one entry per registered API version.
+	for k, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = lifecycleCacheEntry{lifecycle: lifecycle, expiresAt: now.Add(c.ttl)}
+
+	return lifecycle, nil
+}
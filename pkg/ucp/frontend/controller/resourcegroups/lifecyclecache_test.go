@@ -0,0 +1,78 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcegroups
+
+import (
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_LifecycleCache(t *testing.T) {
+	id := resources.MustParse("/planes/radius/local/resourceGroups/test-group/providers/System.TestRP/testResources/name")
+	otherID := resources.MustParse("/planes/radius/local/resourceGroups/other-group/providers/System.TestRP/testResources/other")
+
+	resourceTypeID, err := datamodel.ResourceTypeIDFromResourceID(id)
+	require.NoError(t, err)
+	apiVersionID := resourceTypeID.Append(resources.TypeSegment{Type: datamodel.APIVersionResourceUnqualifiedResourceType, Name: apiVersion})
+
+	lifecycle := &datamodel.ResourceTypeLifecycle{State: datamodel.LifecycleStateDeprecated}
+	resourceType := &datamodel.ResourceType{Properties: datamodel.ResourceTypeProperties{Lifecycle: lifecycle}}
+
+	t.Run("cached per resource type and API version", func(t *testing.T) {
+		databaseClient := database.NewMockClient(gomock.NewController(t))
+		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeID.String()).Return(&database.Object{Data: resourceType}, nil).Times(1)
+		databaseClient.EXPECT().Get(gomock.Any(), apiVersionID.String()).Return(nil, &database.ErrNotFound{}).Times(1)
+
+		cache := NewLifecycleCache(time.Minute)
+		for _, resourceID := range []resources.ID{id, id, otherID} {
+			result, err := cache.Get(t.Context(), databaseClient, resourceID, apiVersion)
+			require.NoError(t, err)
+			require.Equal(t, lifecycle, result)
+		}
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		databaseClient := database.NewMockClient(gomock.NewController(t))
+		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeID.String()).Return(&database.Object{Data: resourceType}, nil).Times(2)
+		databaseClient.EXPECT().Get(gomock.Any(), apiVersionID.String()).Return(nil, &database.ErrNotFound{}).Times(2)
+
+		cache := NewLifecycleCache(0)
+		for range 2 {
+			result, err := cache.Get(t.Context(), databaseClient, id, apiVersion)
+			require.NoError(t, err)
+			require.Equal(t, lifecycle, result)
+		}
+		require.Len(t, cache.entries, 1)
+	})
+
+	t.Run("nil cache", func(t *testing.T) {
+		databaseClient := database.NewMockClient(gomock.NewController(t))
+		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeID.String()).Return(&database.Object{Data: resourceType}, nil).Times(1)
+		databaseClient.EXPECT().Get(gomock.Any(), apiVersionID.String()).Return(nil, &database.ErrNotFound{}).Times(1)
+
+		var cache *LifecycleCache
+		result, err := cache.Get(t.Context(), databaseClient, id, apiVersion)
+		require.NoError(t, err)
+		require.Equal(t, lifecycle, result)
+	})
+}
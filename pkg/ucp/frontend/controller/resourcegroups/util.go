@@ -61,6 +61,29 @@ func (e *InvalidError) Is(err error) bool {
 	return ok
 }
 
+// UnavailableError is returned when none of the addresses of a resource provider location are available.
+type UnavailableError struct {
+	Message string
+}
+
+// Error returns the error message.
+func (e *UnavailableError) Error() string {
+	return e.Message
+}
+
+// Is returns true if the error is a UnavailableError.
+func (e *UnavailableError) Is(err error) bool {
+	_, ok := err.(*UnavailableError)
+	return ok
+}
+
+// AddressSelector selects the address that requests to a resource provider location are routed to.
+type AddressSelector interface {
+	// SelectAddress returns the address to route a request to, or an empty string if the location has no addresses.
+	// Returns an error if none of the addresses are available.
+	SelectAddress(location *datamodel.Location) (string, error)
+}
+
 // ValidateRadiusPlane validates that the plane specified in the id exists. Returns NotFoundError if the plane does not exist.
 func ValidateRadiusPlane(ctx context.Context, client database.Client, id resources.ID) (*datamodel.RadiusPlane, error) {
 	planeID, err := resources.ParseScope(id.PlaneScope())
@@ -106,8 +129,11 @@ func ValidateResourceGroup(ctx context.Context, client database.Client, id resou
 // ValidateResourceType performs semantic validation of a proxy request against registered
 // resource types.
 //
+// The address of the location is chosen by the selector. When the selector is nil the first address of the location is used.
+//
 // Returns InvalidError if the resource type does not exist or if the request cannot be routed due to an invalid configuration.
-func ValidateResourceType(ctx context.Context, client database.Client, id resources.ID, locationName string, apiVersion string) (*url.URL, error) {
+// Returns UnavailableError if none of the addresses of the location are available.
+func ValidateResourceType(ctx context.Context, client database.Client, id resources.ID, locationName string, apiVersion string, selector AddressSelector) (*url.URL, error) {
 	// The strategy is to:
 	// - Look up the resource type and validate that it exists .. then
 	// - Look up the location resource, and validate that it supports the requested resource type and API version.
@@ -178,14 +204,23 @@ func ValidateResourceType(ctx context.Context, client database.Client, id resour
 	}
 
 	// If we get to here, then we're all good.
-	//
-	// The address might be nil which means that we're using the default address (dynamic RP)
-	if location.Properties.Address == nil {
+	address := ""
+	if selector != nil {
+		address, err = selector.SelectAddress(location)
+		if err != nil {
+			return nil, &UnavailableError{Message: err.Error()}
+		}
+	} else if addresses := location.Properties.AllAddresses(); len(addresses) > 0 {
+		address = addresses[0]
+	}
+
+	// The address might be empty which means that we're using the default address (dynamic RP)
+	if address == "" {
 		return nil, nil
 	}
 
 	// If the address was provided, then use that instead.
-	u, err := url.Parse(*location.Properties.Address)
+	u, err := url.Parse(address)
 	if err != nil {
 		return nil, &InvalidError{Message: fmt.Sprintf("failed to parse location address: %v", err.Error())}
 	}
@@ -193,6 +228,38 @@ func ValidateResourceType(ctx context.Context, client database.Client, id resour
 	return u, nil
 }
 
+// GetResourceTypeLifecycle returns the lifecycle that applies to a proxy request for the resource type and API version.
+// The lifecycles of the resource type and API version are combined by datamodel.EffectiveLifecycle.
+//
+// Returns nil if no lifecycle is declared, or if the resource type or API version is not registered.
+func GetResourceTypeLifecycle(ctx context.Context, client database.Client, id resources.ID, apiVersion string) (*datamodel.ResourceTypeLifecycle, error) {
+	if isOperationResourceType(id) {
+		return nil, nil
+	}
+
+	resourceTypeID, err := datamodel.ResourceTypeIDFromResourceID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceType, err := database.GetResource[datamodel.ResourceType](ctx, client, resourceTypeID.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to fetch resource type %q: %w", id.Type(), err)
+	}
+
+	apiVersionID := resourceTypeID.Append(resources.TypeSegment{Type: datamodel.APIVersionResourceUnqualifiedResourceType, Name: apiVersion})
+	apiVersionResource, err := database.GetResource[datamodel.APIVersion](ctx, client, apiVersionID.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return resourceType.Properties.Lifecycle, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to fetch api version %q of resource type %q: %w", apiVersion, id.Type(), err)
+	}
+
+	return datamodel.EffectiveLifecycle(resourceType.Properties.Lifecycle, apiVersionResource.Properties.Lifecycle), nil
+}
+
 func resourceTypeNotRegisteredError(resourceType string) *InvalidError {
 	return &InvalidError{Message: fmt.Sprintf("resource type %q is not registered. register the resource type before deploying resources of this type", resourceType)}
 }
@@ -203,10 +270,13 @@ func resourceTypeNotRegisteredError(resourceType string) *InvalidError {
 func isOperationResourceType(id resources.ID) bool {
 	// For a resource provider "Applications.Test" the operation resource types are:
 	// - Applications.Test/locations/operationStatuses
+	// - Applications.Test/locations/operationStatuses/logs
 	// - Applications.Test/locations/operationResults
 
-	// Radius resource providers include the location name in the resource id
+	// Radius resource providers include the location name in the resource id. The log of an operation is served by
+	// the resource provider as a child of its operation status.
 	if strings.EqualFold(id.Type(), id.ProviderNamespace()+"/locations/operationstatuses") ||
+		strings.EqualFold(id.Type(), id.ProviderNamespace()+"/locations/operationstatuses/logs") ||
 		strings.EqualFold(id.Type(), id.ProviderNamespace()+"/locations/operationresults") {
 		return true
 	}
@@ -226,7 +296,8 @@ func isOperationResourceType(id resources.ID) bool {
 // ValidateDownstream can be used to find and validate the downstream URL for a resource.
 // Returns NotFoundError for the case where the plane or resource group does not exist.
 // Returns InvalidError for cases where the data is invalid, like when the resource provider is not configured.
-func ValidateDownstream(ctx context.Context, client database.Client, id resources.ID, location string, apiVersion string) (*url.URL, error) {
+// Returns UnavailableError when none of the addresses of the resource provider location are available.
+func ValidateDownstream(ctx context.Context, client database.Client, id resources.ID, location string, apiVersion string, selector AddressSelector) (*url.URL, error) {
 	// There are a few steps to validation:
 	//
 	// - The plane exists
@@ -248,7 +319,7 @@ func ValidateDownstream(ctx context.Context, client database.Client, id resource
 	}
 
 	// If this returns success, it means the resource type is configured using new/UDT routing.
-	downstreamURL, err := ValidateResourceType(ctx, client, id, location, apiVersion)
+	downstreamURL, err := ValidateResourceType(ctx, client, id, location, apiVersion, selector)
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +114,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		expectedURL, err := url.Parse(downstream)
 		require.NoError(t, err)
 
-		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion)
+		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion, nil)
 		require.NoError(t, err)
 		require.Equal(t, expectedURL, downstreamURL)
 	})
@@ -128,7 +128,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		expectedURL, err := url.Parse(downstream)
 		require.NoError(t, err)
 
-		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, idWithoutResourceGroup, location, apiVersion)
+		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, idWithoutResourceGroup, location, apiVersion, nil)
 		require.NoError(t, err)
 		require.Equal(t, expectedURL, downstreamURL)
 	})
@@ -144,7 +144,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		expectedURL, err := url.Parse(downstream)
 		require.NoError(t, err)
 
-		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, operationStatusID, location, apiVersion)
+		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, operationStatusID, location, apiVersion, nil)
 		require.NoError(t, err)
 		require.Equal(t, expectedURL, downstreamURL)
 	})
@@ -160,7 +160,23 @@ func Test_ValidateDownstream(t *testing.T) {
 		expectedURL, err := url.Parse(downstream)
 		require.NoError(t, err)
 
-		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, operationStatusID, location, apiVersion)
+		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, operationStatusID, location, apiVersion, nil)
+		require.NoError(t, err)
+		require.Equal(t, expectedURL, downstreamURL)
+	})
+
+	// The log of an operation is served by the resource provider as a child of its operation status.
+	t.Run("success (operationstatuses logs)", func(t *testing.T) {
+		databaseClient := setup(t)
+		databaseClient.EXPECT().Get(gomock.Any(), idWithoutResourceGroup.PlaneScope()).Return(&database.Object{Data: plane}, nil).Times(1)
+		databaseClient.EXPECT().Get(gomock.Any(), locationResource.ID).Return(&database.Object{Data: locationResource}, nil).Times(1)
+
+		operationLogsID := resources.MustParse("/planes/radius/local/providers/System.TestRP/locations/east/operationStatuses/abcd/logs")
+
+		expectedURL, err := url.Parse(downstream)
+		require.NoError(t, err)
+
+		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, operationLogsID, location, apiVersion, nil)
 		require.NoError(t, err)
 		require.Equal(t, expectedURL, downstreamURL)
 	})
@@ -176,7 +192,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		expectedURL, err := url.Parse(downstream)
 		require.NoError(t, err)
 
-		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, operationResultID, location, apiVersion)
+		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, operationResultID, location, apiVersion, nil)
 		require.NoError(t, err)
 		require.Equal(t, expectedURL, downstreamURL)
 	})
@@ -192,7 +208,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		expectedURL, err := url.Parse(downstream)
 		require.NoError(t, err)
 
-		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, operationResultID, location, apiVersion)
+		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, operationResultID, location, apiVersion, nil)
 		require.NoError(t, err)
 		require.Equal(t, expectedURL, downstreamURL)
 	})
@@ -201,7 +217,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		databaseClient := setup(t)
 		databaseClient.EXPECT().Get(gomock.Any(), id.PlaneScope()).Return(nil, &database.ErrNotFound{}).Times(1)
 
-		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion)
+		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion, nil)
 		require.Error(t, err)
 		require.Equal(t, &NotFoundError{Message: "plane \"/planes/radius/local\" not found"}, err)
 		require.Nil(t, downstreamURL)
@@ -213,7 +229,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		expected := fmt.Errorf("failed to fetch plane \"/planes/radius/local\": %w", errors.New("test error"))
 		databaseClient.EXPECT().Get(gomock.Any(), id.PlaneScope()).Return(nil, errors.New("test error")).Times(1)
 
-		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion)
+		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion, nil)
 		require.Error(t, err)
 		require.Equal(t, expected, err)
 		require.Nil(t, downstreamURL)
@@ -224,7 +240,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		databaseClient.EXPECT().Get(gomock.Any(), id.PlaneScope()).Return(&database.Object{Data: plane}, nil).Times(1)
 		databaseClient.EXPECT().Get(gomock.Any(), id.RootScope()).Return(nil, &database.ErrNotFound{}).Times(1)
 
-		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion)
+		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion, nil)
 		require.Error(t, err)
 		require.Equal(t, &NotFoundError{Message: "resource group \"/planes/radius/local/resourceGroups/test-group\" not found"}, err)
 		require.Nil(t, downstreamURL)
@@ -236,7 +252,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		databaseClient.EXPECT().Get(gomock.Any(), id.PlaneScope()).Return(&database.Object{Data: plane}, nil).Times(1)
 		databaseClient.EXPECT().Get(gomock.Any(), id.RootScope()).Return(nil, errors.New("test error")).Times(1)
 
-		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion)
+		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion, nil)
 		require.Error(t, err)
 		require.Equal(t, "failed to fetch resource group \"/planes/radius/local/resourceGroups/test-group\": test error", err.Error())
 		require.Nil(t, downstreamURL)
@@ -258,7 +274,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		databaseClient.EXPECT().Get(gomock.Any(), id.RootScope()).Return(&database.Object{Data: resourceGroup}, nil).Times(1)
 		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeResource.ID).Return(nil, errors.New("test error")).Times(1)
 
-		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion)
+		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion, nil)
 		require.Error(t, err)
 		require.Equal(t, expected, err)
 		require.Nil(t, downstreamURL)
@@ -278,7 +294,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		databaseClient.EXPECT().Get(gomock.Any(), id.RootScope()).Return(&database.Object{Data: resourceGroup}, nil).Times(1)
 		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeResource.ID).Return(nil, &database.ErrNotFound{}).Times(1)
 
-		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion)
+		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion, nil)
 		require.Error(t, err)
 		require.Equal(t, &InvalidError{Message: "resource type \"System.TestRP/testResources\" is not registered. register the resource type before deploying resources of this type"}, err)
 		require.Nil(t, downstreamURL)
@@ -301,7 +317,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeResource.ID).Return(&database.Object{Data: resourceTypeID}, nil).Times(1)
 		databaseClient.EXPECT().Get(gomock.Any(), locationResource.ID).Return(nil, errors.New("test error")).Times(1)
 
-		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion)
+		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion, nil)
 		require.Error(t, err)
 		require.Equal(t, expected, err)
 		require.Nil(t, downstreamURL)
@@ -341,7 +357,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeResource.ID).Return(&database.Object{Data: resourceTypeID}, nil).Times(1)
 		databaseClient.EXPECT().Get(gomock.Any(), locationResource.ID).Return(&database.Object{Data: locationResource}, nil).Times(1)
 
-		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion)
+		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion, nil)
 		require.Error(t, err)
 		require.Equal(t, &InvalidError{Message: "resource type \"System.TestRP/testResources\" is not registered. register the resource type before deploying resources of this type"}, err)
 		require.Nil(t, downstreamURL)
@@ -381,7 +397,7 @@ func Test_ValidateDownstream(t *testing.T) {
 		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeResource.ID).Return(&database.Object{Data: resourceTypeID}, nil).Times(1)
 		databaseClient.EXPECT().Get(gomock.Any(), locationResource.ID).Return(&database.Object{Data: locationResource}, nil).Times(1)
 
-		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion)
+		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion, nil)
 		require.Error(t, err)
 		require.Equal(t, &InvalidError{Message: "api version \"2025-01-01\" is not supported for resource type \"System.TestRP/testResources\" by location \"east\""}, err)
 		require.Nil(t, downstreamURL)
@@ -421,9 +437,127 @@ func Test_ValidateDownstream(t *testing.T) {
 		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeResource.ID).Return(&database.Object{Data: resourceTypeID}, nil).Times(1)
 		databaseClient.EXPECT().Get(gomock.Any(), locationResource.ID).Return(&database.Object{Data: locationResource}, nil).Times(1)
 
-		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion)
+		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion, nil)
 		require.Error(t, err)
 		require.Equal(t, &InvalidError{Message: "failed to parse location address: parse \"\\ninvalid\": net/url: invalid control character in URL"}, err)
 		require.Nil(t, downstreamURL)
 	})
+
+	t.Run("selector chooses address", func(t *testing.T) {
+		resourceGroup := &datamodel.ResourceGroup{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID: id.RootScope(),
+				},
+			},
+		}
+
+		databaseClient := setup(t)
+		databaseClient.EXPECT().Get(gomock.Any(), id.PlaneScope()).Return(&database.Object{Data: plane}, nil).Times(1)
+		databaseClient.EXPECT().Get(gomock.Any(), id.RootScope()).Return(&database.Object{Data: resourceGroup}, nil).Times(1)
+		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeResource.ID).Return(&database.Object{Data: resourceTypeResource}, nil).Times(1)
+		databaseClient.EXPECT().Get(gomock.Any(), locationResource.ID).Return(&database.Object{Data: locationResource}, nil).Times(1)
+
+		expectedURL, err := url.Parse("http://standby:7443")
+		require.NoError(t, err)
+
+		selector := &testSelector{address: "http://standby:7443"}
+		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion, selector)
+		require.NoError(t, err)
+		require.Equal(t, expectedURL, downstreamURL)
+	})
+
+	t.Run("selector unavailable", func(t *testing.T) {
+		resourceGroup := &datamodel.ResourceGroup{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID: id.RootScope(),
+				},
+			},
+		}
+
+		databaseClient := setup(t)
+		databaseClient.EXPECT().Get(gomock.Any(), id.PlaneScope()).Return(&database.Object{Data: plane}, nil).Times(1)
+		databaseClient.EXPECT().Get(gomock.Any(), id.RootScope()).Return(&database.Object{Data: resourceGroup}, nil).Times(1)
+		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeResource.ID).Return(&database.Object{Data: resourceTypeResource}, nil).Times(1)
+		databaseClient.EXPECT().Get(gomock.Any(), locationResource.ID).Return(&database.Object{Data: locationResource}, nil).Times(1)
+
+		selector := &testSelector{err: errors.New("all addresses are unhealthy")}
+		downstreamURL, err := ValidateDownstream(t.Context(), databaseClient, id, location, apiVersion, selector)
+		require.Error(t, err)
+		require.Equal(t, &UnavailableError{Message: "all addresses are unhealthy"}, err)
+		require.Nil(t, downstreamURL)
+	})
+}
+
+type testSelector struct {
+	address string
+	err     error
+}
+
+func (s *testSelector) SelectAddress(location *datamodel.Location) (string, error) {
+	return s.address, s.err
+}
+
+func Test_GetResourceTypeLifecycle(t *testing.T) {
+	id := resources.MustParse("/planes/radius/local/resourceGroups/test-group/providers/System.TestRP/testResources/name")
+
+	resourceTypeID, err := datamodel.ResourceTypeIDFromResourceID(id)
+	require.NoError(t, err)
+	apiVersionID := resourceTypeID.Append(resources.TypeSegment{Type: datamodel.APIVersionResourceUnqualifiedResourceType, Name: apiVersion})
+
+	resourceTypeLifecycle := &datamodel.ResourceTypeLifecycle{State: datamodel.LifecycleStateDeprecated, Message: new("Use System.TestRP/otherResources instead.")}
+	apiVersionLifecycle := &datamodel.ResourceTypeLifecycle{State: datamodel.LifecycleStateRetired}
+
+	resourceType := &datamodel.ResourceType{Properties: datamodel.ResourceTypeProperties{Lifecycle: resourceTypeLifecycle}}
+
+	setup := func(t *testing.T) *database.MockClient {
+		ctrl := gomock.NewController(t)
+		return database.NewMockClient(ctrl)
+	}
+
+	t.Run("api version lifecycle takes precedence", func(t *testing.T) {
+		databaseClient := setup(t)
+		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeID.String()).Return(&database.Object{Data: resourceType}, nil).Times(1)
+		databaseClient.EXPECT().Get(gomock.Any(), apiVersionID.String()).Return(&database.Object{Data: &datamodel.APIVersion{Properties: datamodel.APIVersionProperties{Lifecycle: apiVersionLifecycle}}}, nil).Times(1)
+
+		lifecycle, err := GetResourceTypeLifecycle(t.Context(), databaseClient, id, apiVersion)
+		require.NoError(t, err)
+		require.Equal(t, apiVersionLifecycle, lifecycle)
+	})
+
+	t.Run("resource type lifecycle", func(t *testing.T) {
+		databaseClient := setup(t)
+		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeID.String()).Return(&database.Object{Data: resourceType}, nil).Times(1)
+		databaseClient.EXPECT().Get(gomock.Any(), apiVersionID.String()).Return(&database.Object{Data: &datamodel.APIVersion{}}, nil).Times(1)
+
+		lifecycle, err := GetResourceTypeLifecycle(t.Context(), databaseClient, id, apiVersion)
+		require.NoError(t, err)
+		require.Equal(t, resourceTypeLifecycle, lifecycle)
+	})
+
+	t.Run("resource type not registered", func(t *testing.T) {
+		databaseClient := setup(t)
+		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeID.String()).Return(nil, &database.ErrNotFound{}).Times(1)
+
+		lifecycle, err := GetResourceTypeLifecycle(t.Context(), databaseClient, id, apiVersion)
+		require.NoError(t, err)
+		require.Nil(t, lifecycle)
+	})
+
+	t.Run("operation resource type", func(t *testing.T) {
+		operationStatusID := resources.MustParse("/planes/radius/local/providers/System.TestRP/locations/east/operationStatuses/abcd")
+
+		lifecycle, err := GetResourceTypeLifecycle(t.Context(), setup(t), operationStatusID, apiVersion)
+		require.NoError(t, err)
+		require.Nil(t, lifecycle)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		databaseClient := setup(t)
+		databaseClient.EXPECT().Get(gomock.Any(), resourceTypeID.String()).Return(nil, errors.New("database is down")).Times(1)
+
+		_, err := GetResourceTypeLifecycle(t.Context(), databaseClient, id, apiVersion)
+		require.Error(t, err)
+	})
 }
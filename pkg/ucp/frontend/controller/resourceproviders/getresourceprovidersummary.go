@@ -37,10 +37,14 @@ var _ armrpc_controller.Controller = (*GetResourceProviderSummary)(nil)
 // GetResourceProviderSummary is the controller implementation to get the list of resources stored in a resource group.
 type GetResourceProviderSummary struct {
 	armrpc_controller.Operation[*datamodel.ResourceProviderSummary, datamodel.ResourceProviderSummary]
+
+	// health provides the health of the resource provider locations. May be nil.
+	health LocationHealthProvider
 }
 
-// NewGetResourceProviderSummary creates a new controller for listing resources stored in a resource group.
-func NewGetResourceProviderSummary(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+// NewGetResourceProviderSummary creates a new controller for listing resources stored in a resource group. The health
+// provider is used to report the health of the resource provider locations, and may be nil.
+func NewGetResourceProviderSummary(opts armrpc_controller.Options, health LocationHealthProvider) (armrpc_controller.Controller, error) {
 	return &GetResourceProviderSummary{
 		Operation: armrpc_controller.NewOperation(opts,
 			armrpc_controller.ResourceOptions[datamodel.ResourceProviderSummary]{
@@ -48,6 +52,7 @@ func NewGetResourceProviderSummary(opts armrpc_controller.Options) (armrpc_contr
 				ResponseConverter: converter.ResourceProviderSummaryDataModelToVersioned,
 			},
 		),
+		health: health,
 	}, nil
 }
 
@@ -133,6 +138,11 @@ func (r *GetResourceProviderSummary) createResponse(ctx context.Context, result
 		return nil, err
 	}
 
+	err = populateLocationHealth(&summary, r.health)
+	if err != nil {
+		return nil, err
+	}
+
 	// Icon bytes are only returned when the caller opts in with includeIcons=true.
 	// The icon hash is always retained so consumers can content-address the icon
 	// via the icon endpoint.
@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+var _ controller.Controller = (*GetUsage)(nil)
+
+// GetUsage is the controller implementation to get the number of stored resources of a resource type.
+type GetUsage struct {
+	controller.Operation[*datamodel.ResourceType, datamodel.ResourceType]
+}
+
+// NewGetUsage creates a new GetUsage controller.
+func NewGetUsage(opts controller.Options) (controller.Controller, error) {
+	return &GetUsage{
+		Operation: controller.NewOperation(opts, controller.ResourceOptions[datamodel.ResourceType]{}),
+	}, nil
+}
+
+// Run executes the GetUsage operation.
+func (r *GetUsage) Run(ctx context.Context, w http.ResponseWriter, req *http.Request) (armrpc_rest.Response, error) {
+	// Extract path parameters from chi. The route is:
+	// /planes/radius/{planeName}/providers/System.Resources/resourceproviders/{resourceProviderName}/resourcetypes/{resourceTypeName}/usage
+	planeName := chi.URLParam(req, "planeName")
+	rpName := chi.URLParam(req, "resourceProviderName")
+	rtName := chi.URLParam(req, "resourceTypeName")
+
+	if planeName == "" || rpName == "" || rtName == "" {
+		return armrpc_rest.NewBadRequestResponse("invalid usage path"), nil
+	}
+
+	rtPath := fmt.Sprintf("/planes/radius/%s/providers/System.Resources/resourceProviders/%s/resourceTypes/%s", planeName, rpName, rtName)
+	id, err := resources.Parse(rtPath)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = r.DatabaseClient().Get(ctx, id.String())
+	if errors.Is(err, &database.ErrNotFound{}) {
+		return armrpc_rest.NewNotFoundResponse(id), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	usage, err := GetResourceTypeUsage(ctx, r.DatabaseClient(), id.PlaneScope(), resourceTypeFromID(id))
+	if err != nil {
+		return nil, err
+	}
+
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	response, err := converter.ResourceTypeUsageDataModelToVersioned(usage, serviceCtx.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return armrpc_rest.NewOKResponse(response), nil
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newUsageRequest(t *testing.T) (context.Context, *http.Request) {
+	t.Helper()
+
+	req, err := http.NewRequest(http.MethodGet, "http://ucp/planes/radius/local/providers/System.Resources/resourceproviders/Applications.Test/resourcetypes/testResources/usage?api-version="+v20231001preview.Version, nil)
+	require.NoError(t, err)
+
+	rctx := chi.NewRouteContext()
+	rctx.URLParams.Add("planeName", "local")
+	rctx.URLParams.Add("resourceProviderName", "Applications.Test")
+	rctx.URLParams.Add("resourceTypeName", "testResources")
+
+	ctx := context.WithValue(rpctest.NewARMRequestContext(req), chi.RouteCtxKey, rctx)
+	return ctx, req.WithContext(ctx)
+}
+
+func TestGetUsage_Success(t *testing.T) {
+	mockDB := database.NewMockClient(gomock.NewController(t))
+	c, err := NewGetUsage(armrpc_controller.Options{DatabaseClient: mockDB})
+	require.NoError(t, err)
+
+	mockDB.EXPECT().
+		Get(gomock.Any(), testUsageResourceTypeID).
+		Return(&database.Object{Data: &datamodel.ResourceType{}}, nil).
+		Times(1)
+	expectUsageQuery(mockDB, trackedResourceObject("/planes/radius/local/resourceGroups/a/providers/Applications.Test/testResources/one", "2025-01-01"))
+
+	ctx, req := newUsageRequest(t)
+	response, err := c.Run(ctx, httptest.NewRecorder(), req)
+	require.NoError(t, err)
+
+	ok, isOK := response.(*armrpc_rest.OKResponse)
+	require.True(t, isOK)
+
+	usage, isUsage := ok.Body.(*v20231001preview.ResourceTypeUsage)
+	require.True(t, isUsage)
+	require.Equal(t, "Applications.Test/testResources", *usage.ResourceType)
+	require.Equal(t, int32(1), *usage.Count)
+	require.Equal(t, int32(1), *usage.APIVersions["2025-01-01"].Count)
+}
+
+func TestGetUsage_ResourceTypeNotFound(t *testing.T) {
+	mockDB := database.NewMockClient(gomock.NewController(t))
+	c, err := NewGetUsage(armrpc_controller.Options{DatabaseClient: mockDB})
+	require.NoError(t, err)
+
+	mockDB.EXPECT().
+		Get(gomock.Any(), testUsageResourceTypeID).
+		Return(nil, &database.ErrNotFound{}).
+		Times(1)
+
+	ctx, req := newUsageRequest(t)
+	response, err := c.Run(ctx, httptest.NewRecorder(), req)
+	require.NoError(t, err)
+
+	_, isNotFound := response.(*armrpc_rest.NotFoundResponse)
+	require.True(t, isNotFound)
+}
@@ -37,10 +37,14 @@ var _ armrpc_controller.Controller = (*ListResourceProviderSummaries)(nil)
 // of all resource providers.
 type ListResourceProviderSummaries struct {
 	armrpc_controller.Operation[*datamodel.ResourceProviderSummary, datamodel.ResourceProviderSummary]
+
+	// health provides the health of the resource provider locations. May be nil.
+	health LocationHealthProvider
 }
 
-// NewListResourceProviderSummaries creates a new controller for listing the summaries of all resource providers.
-func NewListResourceProviderSummaries(opts armrpc_controller.Options) (armrpc_controller.Controller, error) {
+// NewListResourceProviderSummaries creates a new controller for listing the summaries of all resource providers. The
+// health provider is used to report the health of the resource provider locations, and may be nil.
+func NewListResourceProviderSummaries(opts armrpc_controller.Options, health LocationHealthProvider) (armrpc_controller.Controller, error) {
 	return &ListResourceProviderSummaries{
 		Operation: armrpc_controller.NewOperation(opts,
 			armrpc_controller.ResourceOptions[datamodel.ResourceProviderSummary]{
@@ -48,6 +52,7 @@ func NewListResourceProviderSummaries(opts armrpc_controller.Options) (armrpc_co
 				ResponseConverter: converter.ResourceProviderSummaryDataModelToVersioned,
 			},
 		),
+		health: health,
 	}, nil
 }
 
@@ -107,6 +112,11 @@ func (r *ListResourceProviderSummaries) createResponse(ctx context.Context, resu
 			return nil, err
 		}
 
+		err = populateLocationHealth(&data, r.health)
+		if err != nil {
+			return nil, err
+		}
+
 		versioned, err := converter.ResourceProviderSummaryDataModelToVersioned(&data, serviceCtx.APIVersion)
 		if err != nil {
 			return nil, err
@@ -0,0 +1,59 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// LocationHealthProvider provides the health of the addresses of resource provider locations.
+type LocationHealthProvider interface {
+	// Health returns the health of the location with the given resource id, or nil if the health is not known.
+	Health(locationID string) *datamodel.LocationHealth
+}
+
+// populateLocationHealth sets the health of each location of the resource provider summary.
+func populateLocationHealth(summary *datamodel.ResourceProviderSummary, health LocationHealthProvider) error {
+	if health == nil || len(summary.Properties.Locations) == 0 {
+		return nil
+	}
+
+	// Ex: /planes/radius/local/providers/System.Resources/resourceProviderSummaries/Applications.Test
+	// => /planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test
+	id, err := resources.ParseResource(summary.ID)
+	if err != nil {
+		return err
+	}
+
+	resourceProviderID, err := resources.ParseResource(
+		id.RootScope() +
+			resources.SegmentSeparator + resources.ProvidersSegment +
+			resources.SegmentSeparator + datamodel.ResourceProviderResourceType +
+			resources.SegmentSeparator + id.Name())
+	if err != nil {
+		return err
+	}
+
+	for name, location := range summary.Properties.Locations {
+		locationID := resourceProviderID.Append(resources.TypeSegment{Type: datamodel.LocationUnqualifiedResourceType, Name: name})
+		location.Health = health.Health(locationID.String())
+		summary.Properties.Locations[name] = location
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeLocationHealth map[string]*datamodel.LocationHealth
+
+func (f fakeLocationHealth) Health(locationID string) *datamodel.LocationHealth {
+	return f[locationID]
+}
+
+func Test_populateLocationHealth(t *testing.T) {
+	health := &datamodel.LocationHealth{
+		Status: datamodel.LocationHealthStatusHealthy,
+		Addresses: []datamodel.LocationAddressHealth{
+			{Address: "http://localhost:8080", Status: datamodel.LocationHealthStatusHealthy},
+		},
+	}
+
+	provider := fakeLocationHealth{
+		"/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/locations/east": health,
+	}
+
+	t.Run("sets health of each location", func(t *testing.T) {
+		summary := &datamodel.ResourceProviderSummary{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{
+					ID: "/planes/radius/local/providers/System.Resources/resourceProviderSummaries/Applications.Test",
+				},
+			},
+			Properties: datamodel.ResourceProviderSummaryProperties{
+				Locations: map[string]datamodel.ResourceProviderSummaryPropertiesLocation{
+					"east": {},
+					"west": {},
+				},
+			},
+		}
+
+		err := populateLocationHealth(summary, provider)
+		require.NoError(t, err)
+		require.Equal(t, health, summary.Properties.Locations["east"].Health)
+		require.Nil(t, summary.Properties.Locations["west"].Health)
+	})
+
+	t.Run("no health provider", func(t *testing.T) {
+		summary := &datamodel.ResourceProviderSummary{
+			Properties: datamodel.ResourceProviderSummaryProperties{
+				Locations: map[string]datamodel.ResourceProviderSummaryPropertiesLocation{
+					"east": {},
+				},
+			},
+		}
+
+		err := populateLocationHealth(summary, nil)
+		require.NoError(t, err)
+		require.Nil(t, summary.Properties.Locations["east"].Health)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		summary := &datamodel.ResourceProviderSummary{
+			BaseResource: v1.BaseResource{
+				TrackedResource: v1.TrackedResource{ID: "invalid"},
+			},
+			Properties: datamodel.ResourceProviderSummaryProperties{
+				Locations: map[string]datamodel.ResourceProviderSummaryPropertiesLocation{
+					"east": {},
+				},
+			},
+		}
+
+		err := populateLocationHealth(summary, provider)
+		require.Error(t, err)
+	})
+}
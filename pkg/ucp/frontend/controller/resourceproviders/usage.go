@@ -0,0 +1,151 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+// GetResourceTypeUsage returns the stored resources of a resource type, grouped by the API version they were last written with.
+//
+// The usage is computed from the resources tracked by UCP in any resource group of the plane.
+func GetResourceTypeUsage(ctx context.Context, client database.Client, planeScope string, resourceType string) (*datamodel.ResourceTypeUsage, error) {
+	usage := &datamodel.ResourceTypeUsage{
+		ResourceType: resourceType,
+		APIVersions:  map[string][]string{},
+	}
+
+	query := database.Query{
+		RootScope:      planeScope,
+		ScopeRecursive: true,
+		ResourceType:   datamodel.GenericResourceType,
+		Filters: []database.QueryFilter{
+			{Field: "properties.type", Value: resourceType},
+		},
+	}
+
+	options := []database.QueryOptions{}
+	for {
+		result, err := client.Query(ctx, query, options...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query resources of type %q: %w", resourceType, err)
+		}
+
+		for _, item := range result.Items {
+			entry := datamodel.GenericResource{}
+			if err := item.As(&entry); err != nil {
+				return nil, err
+			}
+
+			usage.APIVersions[entry.Properties.APIVersion] = append(usage.APIVersions[entry.Properties.APIVersion], entry.Properties.ID)
+		}
+
+		if result.PaginationToken == "" {
+			break
+		}
+		options = []database.QueryOptions{database.WithPaginationToken(result.PaginationToken)}
+	}
+
+	// Sort so that results are deterministic.
+	for _, ids := range usage.APIVersions {
+		slices.Sort(ids)
+	}
+
+	return usage, nil
+}
+
+// ResourceTypeDeleteFilter refuses to delete a resource type while resources of that type exist, unless the request
+// sets the "force" query parameter to "true".
+func ResourceTypeDeleteFilter(ctx context.Context, oldResource *datamodel.ResourceType, options *controller.Options) (armrpc_rest.Response, error) {
+	if isForceDelete(ctx) {
+		return nil, nil
+	}
+
+	id, err := resources.ParseResource(oldResource.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceType := resourceTypeFromID(id)
+	usage, err := GetResourceTypeUsage(ctx, options.DatabaseClient, id.PlaneScope(), resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	if count := usage.Count(); count > 0 {
+		return armrpc_rest.NewConflictResponse(fmt.Sprintf("resource type %q cannot be deleted because %d resource(s) of this type exist. Delete the resources first, or use force to delete the resource type anyway", resourceType, count)), nil
+	}
+
+	return nil, nil
+}
+
+// APIVersionDeleteFilter refuses to delete an API version while resources were last written with it, unless the request
+// sets the "force" query parameter to "true".
+func APIVersionDeleteFilter(ctx context.Context, oldResource *datamodel.APIVersion, options *controller.Options) (armrpc_rest.Response, error) {
+	if isForceDelete(ctx) {
+		return nil, nil
+	}
+
+	id, err := resources.ParseResource(oldResource.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceType := resourceTypeFromID(id)
+	usage, err := GetResourceTypeUsage(ctx, options.DatabaseClient, id.PlaneScope(), resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	// API versions are case-insensitive.
+	count := 0
+	for apiVersion, ids := range usage.APIVersions {
+		if strings.EqualFold(apiVersion, id.Name()) {
+			count += len(ids)
+		}
+	}
+
+	if count > 0 {
+		return armrpc_rest.NewConflictResponse(fmt.Sprintf("api version %q of resource type %q cannot be deleted because %d resource(s) use it. Update the resources to another api version first, or use force to delete the api version anyway", id.Name(), resourceType, count)), nil
+	}
+
+	return nil, nil
+}
+
+// resourceTypeFromID returns the fully-qualified resource type for the ID of a resource type or one of its child resources.
+//
+// Ex: /planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources
+// => Applications.Test/testResources
+func resourceTypeFromID(id resources.ID) string {
+	typeSegments := id.TypeSegments()
+	return typeSegments[0].Name + resources.SegmentSeparator + typeSegments[1].Name
+}
+
+func isForceDelete(ctx context.Context) bool {
+	serviceCtx := v1.ARMRequestContextFromContext(ctx)
+	return serviceCtx.OriginalURL.Query().Get("force") == "true"
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceproviders
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_controller "github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	testUsageResourceTypeID = "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/resourceTypes/testResources"
+	testUsageAPIVersionID   = testUsageResourceTypeID + "/apiVersions/2025-01-01"
+)
+
+func trackedResourceObject(id string, apiVersion string) database.Object {
+	return database.Object{
+		Data: &datamodel.GenericResource{
+			Properties: datamodel.GenericResourceProperties{
+				ID:         id,
+				Type:       "Applications.Test/testResources",
+				APIVersion: apiVersion,
+			},
+		},
+	}
+}
+
+func expectUsageQuery(mockDB *database.MockClient, items ...database.Object) {
+	mockDB.EXPECT().
+		Query(gomock.Any(), database.Query{
+			RootScope:      "/planes/radius/local",
+			ScopeRecursive: true,
+			ResourceType:   datamodel.GenericResourceType,
+			Filters:        []database.QueryFilter{{Field: "properties.type", Value: "Applications.Test/testResources"}},
+		}).
+		Return(&database.ObjectQueryResult{Items: items}, nil).
+		Times(1)
+}
+
+func newDeleteContext(t *testing.T, id string, force bool) context.Context {
+	t.Helper()
+
+	url := id + "?api-version=2023-10-01-preview"
+	if force {
+		url += "&force=true"
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	require.NoError(t, err)
+	return rpctest.NewARMRequestContext(req)
+}
+
+func TestGetResourceTypeUsage(t *testing.T) {
+	mockDB := database.NewMockClient(gomock.NewController(t))
+
+	mockDB.EXPECT().
+		Query(gomock.Any(), gomock.Any()).
+		Return(&database.ObjectQueryResult{
+			Items: []database.Object{
+				trackedResourceObject("/planes/radius/local/resourceGroups/b/providers/Applications.Test/testResources/two", "2025-01-01"),
+				trackedResourceObject("/planes/radius/local/resourceGroups/a/providers/Applications.Test/testResources/one", "2025-01-01"),
+			},
+			PaginationToken: "next",
+		}, nil).
+		Times(1)
+	mockDB.EXPECT().
+		Query(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&database.ObjectQueryResult{
+			Items: []database.Object{
+				trackedResourceObject("/planes/radius/local/resourceGroups/a/providers/Applications.Test/testResources/three", "2026-01-01"),
+			},
+		}, nil).
+		Times(1)
+
+	usage, err := GetResourceTypeUsage(t.Context(), mockDB, "/planes/radius/local", "Applications.Test/testResources")
+	require.NoError(t, err)
+
+	expected := &datamodel.ResourceTypeUsage{
+		ResourceType: "Applications.Test/testResources",
+		APIVersions: map[string][]string{
+			"2025-01-01": {
+				"/planes/radius/local/resourceGroups/a/providers/Applications.Test/testResources/one",
+				"/planes/radius/local/resourceGroups/b/providers/Applications.Test/testResources/two",
+			},
+			"2026-01-01": {
+				"/planes/radius/local/resourceGroups/a/providers/Applications.Test/testResources/three",
+			},
+		},
+	}
+	require.Equal(t, expected, usage)
+	require.Equal(t, 3, usage.Count())
+}
+
+func TestGetResourceTypeUsage_QueryError(t *testing.T) {
+	mockDB := database.NewMockClient(gomock.NewController(t))
+	mockDB.EXPECT().Query(gomock.Any(), gomock.Any()).Return(nil, errors.New("database is down")).Times(1)
+
+	_, err := GetResourceTypeUsage(t.Context(), mockDB, "/planes/radius/local", "Applications.Test/testResources")
+	require.Error(t, err)
+}
+
+func TestResourceTypeDeleteFilter(t *testing.T) {
+	resourceType := &datamodel.ResourceType{BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: testUsageResourceTypeID}}}
+
+	t.Run("in use", func(t *testing.T) {
+		mockDB := database.NewMockClient(gomock.NewController(t))
+		expectUsageQuery(mockDB, trackedResourceObject("/planes/radius/local/resourceGroups/a/providers/Applications.Test/testResources/one", "2025-01-01"))
+
+		response, err := ResourceTypeDeleteFilter(newDeleteContext(t, testUsageResourceTypeID, false), resourceType, &armrpc_controller.Options{DatabaseClient: mockDB})
+		require.NoError(t, err)
+
+		conflict, ok := response.(*armrpc_rest.ConflictResponse)
+		require.True(t, ok)
+		require.Contains(t, conflict.Body.Error.Message, `resource type "Applications.Test/testResources" cannot be deleted because 1 resource(s) of this type exist`)
+	})
+
+	t.Run("not in use", func(t *testing.T) {
+		mockDB := database.NewMockClient(gomock.NewController(t))
+		expectUsageQuery(mockDB)
+
+		response, err := ResourceTypeDeleteFilter(newDeleteContext(t, testUsageResourceTypeID, false), resourceType, &armrpc_controller.Options{DatabaseClient: mockDB})
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+
+	t.Run("forced", func(t *testing.T) {
+		mockDB := database.NewMockClient(gomock.NewController(t))
+
+		response, err := ResourceTypeDeleteFilter(newDeleteContext(t, testUsageResourceTypeID, true), resourceType, &armrpc_controller.Options{DatabaseClient: mockDB})
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+}
+
+func TestAPIVersionDeleteFilter(t *testing.T) {
+	apiVersion := &datamodel.APIVersion{BaseResource: v1.BaseResource{TrackedResource: v1.TrackedResource{ID: testUsageAPIVersionID}}}
+
+	t.Run("in use", func(t *testing.T) {
+		mockDB := database.NewMockClient(gomock.NewController(t))
+		expectUsageQuery(mockDB, trackedResourceObject("/planes/radius/local/resourceGroups/a/providers/Applications.Test/testResources/one", "2025-01-01"))
+
+		response, err := APIVersionDeleteFilter(newDeleteContext(t, testUsageAPIVersionID, false), apiVersion, &armrpc_controller.Options{DatabaseClient: mockDB})
+		require.NoError(t, err)
+
+		conflict, ok := response.(*armrpc_rest.ConflictResponse)
+		require.True(t, ok)
+		require.Contains(t, conflict.Body.Error.Message, `api version "2025-01-01" of resource type "Applications.Test/testResources" cannot be deleted because 1 resource(s) use it`)
+	})
+
+	t.Run("other api version in use", func(t *testing.T) {
+		mockDB := database.NewMockClient(gomock.NewController(t))
+		expectUsageQuery(mockDB, trackedResourceObject("/planes/radius/local/resourceGroups/a/providers/Applications.Test/testResources/one", "2026-01-01"))
+
+		response, err := APIVersionDeleteFilter(newDeleteContext(t, testUsageAPIVersionID, false), apiVersion, &armrpc_controller.Options{DatabaseClient: mockDB})
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+
+	t.Run("forced", func(t *testing.T) {
+		mockDB := database.NewMockClient(gomock.NewController(t))
+
+		response, err := APIVersionDeleteFilter(newDeleteContext(t, testUsageAPIVersionID, true), apiVersion, &armrpc_controller.Options{DatabaseClient: mockDB})
+		require.NoError(t, err)
+		require.Nil(t, response)
+	})
+}
@@ -0,0 +1,298 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"net/http"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/controller"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/defaultoperation"
+	"github.com/radius-project/radius/pkg/armrpc/frontend/server"
+	aztoken "github.com/radius-project/radius/pkg/azure/tokencredentials"
+	"github.com/radius-project/radius/pkg/ucp"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/datamodel/converter"
+	gcp_credential_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/credentials/gcp"
+	gcpproxy_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/gcpproxy"
+	planes_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/planes"
+	ucp_gcp "github.com/radius-project/radius/pkg/ucp/gcp"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+	"github.com/radius-project/radius/pkg/validator"
+)
+
+const (
+	planeCollectionPath = "/planes/gcp"
+	planeResourcePath   = "/planes/gcp/{planeName}"
+
+	// GCP resources are scoped to a project, and regional or zonal resources are also scoped to a location.
+	projectScopePath  = planeResourcePath + "/projects/{projectId}"
+	locationScopePath = projectScopePath + "/locations/{locationName}"
+
+	resourceCollectionPath = "/providers/{providerNamespace}/{resourceType}"
+	operationResultsPath   = "/providers/{providerNamespace}/locations/{location}/operationResults/{operationId}"
+	operationStatusesPath  = "/providers/{providerNamespace}/locations/{location}/operationStatuses/{operationId}"
+
+	credentialResourcePath   = planeResourcePath + "/providers/System.GCP/credentials/{credentialName}"
+	credentialCollectionPath = planeResourcePath + "/providers/System.GCP/credentials"
+
+	// OperationTypeGCPResource is the operation type for CRUDL operations on GCP resources.
+	OperationTypeGCPResource = "GCPRESOURCE"
+)
+
+// Initialize initializes the GCP module.
+func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
+	secretClient, err := m.options.SecretProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Support override of GCP Client for testing.
+	if m.GCPClient == nil {
+		m.GCPClient, err = m.newGCPClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	baseRouter := server.NewSubrouter(m.router, m.options.Config.Server.PathBase+"/")
+
+	apiValidator := validator.APIValidator(validator.Options{
+		SpecLoader:         m.options.SpecLoader,
+		ResourceTypeGetter: validator.UCPResourceTypeGetter,
+	})
+
+	planeResourceOptions := controller.ResourceOptions[datamodel.GCPPlane]{
+		RequestConverter:  converter.GCPPlaneDataModelFromVersioned,
+		ResponseConverter: converter.GCPPlaneDataModelToVersioned,
+	}
+
+	// URLs for lifecycle of planes
+	planeCollectionRouter := server.NewSubrouter(baseRouter, planeCollectionPath, apiValidator)
+	planeResourceRouter := server.NewSubrouter(baseRouter, planeResourcePath, apiValidator)
+
+	handlerOptions := []server.HandlerOptions{
+		{
+			// This is a scope query so we can't use the default operation.
+			ParentRouter:  planeCollectionRouter,
+			Method:        v1.OperationList,
+			ResourceType:  datamodel.GCPPlaneResourceType,
+			OperationType: &v1.OperationType{Type: datamodel.GCPPlaneResourceType, Method: v1.OperationList},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return &planes_ctrl.ListPlanesByType[*datamodel.GCPPlane, datamodel.GCPPlane]{
+					Operation: controller.NewOperation(opts, planeResourceOptions),
+				}, nil
+			},
+		},
+		{
+			ParentRouter:  planeResourceRouter,
+			Method:        v1.OperationGet,
+			ResourceType:  datamodel.GCPPlaneResourceType,
+			OperationType: &v1.OperationType{Type: datamodel.GCPPlaneResourceType, Method: v1.OperationGet},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewGetResource(opts, planeResourceOptions)
+			},
+		},
+		{
+			ParentRouter:  planeResourceRouter,
+			Method:        v1.OperationPut,
+			ResourceType:  datamodel.GCPPlaneResourceType,
+			OperationType: &v1.OperationType{Type: datamodel.GCPPlaneResourceType, Method: v1.OperationPut},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncPut(opts, planeResourceOptions)
+			},
+		},
+		{
+			ParentRouter:  planeResourceRouter,
+			Method:        v1.OperationDelete,
+			ResourceType:  datamodel.GCPPlaneResourceType,
+			OperationType: &v1.OperationType{Type: datamodel.GCPPlaneResourceType, Method: v1.OperationDelete},
+			ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewDefaultSyncDelete(opts, planeResourceOptions)
+			},
+		},
+	}
+
+	// URLs for GCP resources. General GCP operations DO NOT use the spec validator because we rely on the
+	// validation of the GCP services.
+	for _, scopePath := range []string{projectScopePath, locationScopePath} {
+		resourceCollectionRouter := server.NewSubrouter(baseRouter, scopePath+resourceCollectionPath)
+		handlerOptions = append(handlerOptions, []server.HandlerOptions{
+			{
+				// URLs for standard UCP resource async status result.
+				ParentRouter:  server.NewSubrouter(baseRouter, scopePath+operationResultsPath),
+				Method:        v1.OperationGet,
+				OperationType: &v1.OperationType{Type: OperationResultsResourceType, Method: v1.OperationGet},
+				ResourceType:  OperationResultsResourceType,
+				ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+					return gcpproxy_ctrl.NewGetGCPOperationResults(opts, m.GCPClient)
+				},
+			},
+			{
+				// URLs for standard UCP resource async status.
+				ParentRouter:  server.NewSubrouter(baseRouter, scopePath+operationStatusesPath),
+				Method:        v1.OperationGet,
+				OperationType: &v1.OperationType{Type: OperationStatusResourceType, Method: v1.OperationGet},
+				ResourceType:  OperationStatusResourceType,
+				ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+					return gcpproxy_ctrl.NewGetGCPOperationStatuses(opts, m.GCPClient)
+				},
+			},
+			{
+				// URLs for standard UCP resource lifecycle operations.
+				ParentRouter:  resourceCollectionRouter,
+				Method:        v1.OperationList,
+				OperationType: &v1.OperationType{Type: OperationTypeGCPResource, Method: v1.OperationList},
+				ResourceType:  OperationTypeGCPResource,
+				ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+					return gcpproxy_ctrl.NewListGCPResources(opts, m.GCPClient)
+				},
+			},
+			{
+				ParentRouter:  resourceCollectionRouter,
+				Path:          "/{resourceName}",
+				Method:        v1.OperationPut,
+				OperationType: &v1.OperationType{Type: OperationTypeGCPResource, Method: v1.OperationPut},
+				ResourceType:  OperationTypeGCPResource,
+				ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+					return gcpproxy_ctrl.NewCreateOrUpdateGCPResource(opts, m.GCPClient)
+				},
+			},
+			{
+				ParentRouter:  resourceCollectionRouter,
+				Path:          "/{resourceName}",
+				Method:        v1.OperationDelete,
+				OperationType: &v1.OperationType{Type: OperationTypeGCPResource, Method: v1.OperationDelete},
+				ResourceType:  OperationTypeGCPResource,
+				ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+					return gcpproxy_ctrl.NewDeleteGCPResource(opts, m.GCPClient)
+				},
+			},
+			{
+				ParentRouter:  resourceCollectionRouter,
+				Path:          "/{resourceName}",
+				Method:        v1.OperationGet,
+				OperationType: &v1.OperationType{Type: OperationTypeGCPResource, Method: v1.OperationGet},
+				ResourceType:  OperationTypeGCPResource,
+				ControllerFactory: func(opts controller.Options) (controller.Controller, error) {
+					return gcpproxy_ctrl.NewGetGCPResource(opts, m.GCPClient)
+				},
+			},
+		}...)
+	}
+
+	// URLs for operations on GCP credential resources.
+	//
+	// These use the OpenAPI spec validator.
+	credentialCollectionRouter := server.NewSubrouter(baseRouter, credentialCollectionPath, apiValidator)
+	credentialResourceRouter := server.NewSubrouter(baseRouter, credentialResourcePath, apiValidator)
+
+	handlerOptions = append(handlerOptions, []server.HandlerOptions{
+		{
+			ParentRouter: credentialCollectionRouter,
+			ResourceType: v20231001preview.GCPCredentialType,
+			Method:       v1.OperationList,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewListResources(opt,
+					controller.ResourceOptions[datamodel.GCPCredential]{
+						RequestConverter:  converter.GCPCredentialDataModelFromVersioned,
+						ResponseConverter: converter.GCPCredentialDataModelToVersioned,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: credentialResourceRouter,
+			ResourceType: v20231001preview.GCPCredentialType,
+			Method:       v1.OperationGet,
+			ControllerFactory: func(opt controller.Options) (controller.Controller, error) {
+				return defaultoperation.NewGetResource(opt,
+					controller.ResourceOptions[datamodel.GCPCredential]{
+						RequestConverter:  converter.GCPCredentialDataModelFromVersioned,
+						ResponseConverter: converter.GCPCredentialDataModelToVersioned,
+					},
+				)
+			},
+		},
+		{
+			ParentRouter: credentialResourceRouter,
+			Method:       v1.OperationPut,
+			ResourceType: v20231001preview.GCPCredentialType,
+			ControllerFactory: func(o controller.Options) (controller.Controller, error) {
+				return gcp_credential_ctrl.NewCreateOrUpdateGCPCredential(o, secretClient)
+			},
+		},
+		{
+			ParentRouter: credentialResourceRouter,
+			Method:       v1.OperationDelete,
+			ResourceType: v20231001preview.GCPCredentialType,
+			ControllerFactory: func(o controller.Options) (controller.Controller, error) {
+				return gcp_credential_ctrl.NewDeleteGCPCredential(o, secretClient)
+			},
+		},
+	}...)
+
+	databaseClient, err := m.options.DatabaseProvider.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctrlOpts := controller.Options{
+		Address:        m.options.Config.Server.Address(),
+		DatabaseClient: databaseClient,
+		PathBase:       m.options.Config.Server.PathBase,
+		StatusManager:  m.options.StatusManager,
+
+		KubeClient:   nil, // Unused by GCP module
+		ResourceType: "",  // Set dynamically
+	}
+
+	for _, h := range handlerOptions {
+		if err := server.RegisterHandler(ctx, h, ctrlOpts); err != nil {
+			return nil, err
+		}
+	}
+
+	return m.router, nil
+}
+
+func (m *Module) newGCPClient(ctx context.Context) (ucp_gcp.Client, error) {
+	logger := ucplog.FromContextOrDiscard(ctx)
+	options := ucp_gcp.ClientOptions{}
+	credential := ""
+
+	switch m.options.Config.Identity.AuthMethod {
+	case ucp.AuthUCPCredential:
+		provider, err := sdk_cred.NewGCPCredentialProvider(m.options.SecretProvider, m.options.UCP, &aztoken.AnonymousCredential{})
+		if err != nil {
+			return nil, err
+		}
+		options.TokenSource = ucp_gcp.NewUCPTokenSource(provider, ucp_gcp.DefaultExpireDuration)
+		credential = m.PlaneType() + "/default"
+		logger.Info("Configuring 'UCPCredential' authentication mode using UCP Credential API")
+
+	default:
+		logger.Info("Configuring default authentication mode without GCP credentials.")
+	}
+
+	options.Transport = m.options.Throttler.Transport(nil, m.PlaneType(), credential)
+
+	return ucp_gcp.NewClient(options), nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/mock/gomock"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/armrpc/asyncoperation/statusmanager"
+	"github.com/radius-project/radius/pkg/armrpc/hostoptions"
+	"github.com/radius-project/radius/pkg/armrpc/rpctest"
+	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
+	"github.com/radius-project/radius/pkg/components/secret"
+	"github.com/radius-project/radius/pkg/components/secret/secretprovider"
+	"github.com/radius-project/radius/pkg/ucp"
+	"github.com/radius-project/radius/pkg/ucp/api/v20231001preview"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	ucp_gcp "github.com/radius-project/radius/pkg/ucp/gcp"
+)
+
+const pathBase = "/some-path-base"
+
+func Test_Routes(t *testing.T) {
+	tests := []rpctest.HandlerTestSpec{
+		{
+			OperationType: v1.OperationType{Type: datamodel.GCPPlaneResourceType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/gcp",
+		}, {
+			OperationType: v1.OperationType{Type: datamodel.GCPPlaneResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/gcp/someName",
+		}, {
+			OperationType: v1.OperationType{Type: datamodel.GCPPlaneResourceType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/gcp/someName",
+		}, {
+			OperationType: v1.OperationType{Type: datamodel.GCPPlaneResourceType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/gcp/someName",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.GCPCredentialType, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/gcp/gcp/providers/System.GCP/credentials",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.GCPCredentialType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/gcp/gcp/providers/System.GCP/credentials/default",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.GCPCredentialType, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/gcp/gcp/providers/System.GCP/credentials/default",
+		}, {
+			OperationType: v1.OperationType{Type: v20231001preview.GCPCredentialType, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/gcp/gcp/providers/System.GCP/credentials/default",
+		}, {
+			OperationType: v1.OperationType{Type: OperationTypeGCPResource, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/gcp/gcp/projects/my-project/providers/GCP.PubSub/topics",
+		}, {
+			OperationType: v1.OperationType{Type: OperationTypeGCPResource, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/gcp/gcp/projects/my-project/providers/GCP.PubSub/topics/my-topic",
+		}, {
+			OperationType: v1.OperationType{Type: OperationTypeGCPResource, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/gcp/gcp/projects/my-project/providers/GCP.PubSub/topics/my-topic",
+		}, {
+			OperationType: v1.OperationType{Type: OperationTypeGCPResource, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/gcp/gcp/projects/my-project/providers/GCP.PubSub/topics/my-topic",
+		}, {
+			OperationType: v1.OperationType{Type: OperationResultsResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/gcp/gcp/projects/my-project/providers/GCP.PubSub/locations/global/operationResults/00000000-0000-0000-0000-000000000000",
+		}, {
+			OperationType: v1.OperationType{Type: OperationStatusResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/gcp/gcp/projects/my-project/providers/GCP.PubSub/locations/global/operationStatuses/00000000-0000-0000-0000-000000000000",
+		}, {
+			OperationType: v1.OperationType{Type: OperationTypeGCPResource, Method: v1.OperationList},
+			Method:        http.MethodGet,
+			Path:          "/planes/gcp/gcp/projects/my-project/locations/us-central1/providers/GCP.PubSub/topics",
+		}, {
+			OperationType: v1.OperationType{Type: OperationTypeGCPResource, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/gcp/gcp/projects/my-project/locations/us-central1/providers/GCP.PubSub/topics/my-topic",
+		}, {
+			OperationType: v1.OperationType{Type: OperationTypeGCPResource, Method: v1.OperationPut},
+			Method:        http.MethodPut,
+			Path:          "/planes/gcp/gcp/projects/my-project/locations/us-central1/providers/GCP.PubSub/topics/my-topic",
+		}, {
+			OperationType: v1.OperationType{Type: OperationTypeGCPResource, Method: v1.OperationDelete},
+			Method:        http.MethodDelete,
+			Path:          "/planes/gcp/gcp/projects/my-project/locations/us-central1/providers/GCP.PubSub/topics/my-topic",
+		}, {
+			OperationType: v1.OperationType{Type: OperationResultsResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/gcp/gcp/projects/my-project/locations/us-central1/providers/GCP.PubSub/locations/global/operationResults/00000000-0000-0000-0000-000000000000",
+		}, {
+			OperationType: v1.OperationType{Type: OperationStatusResourceType, Method: v1.OperationGet},
+			Method:        http.MethodGet,
+			Path:          "/planes/gcp/gcp/projects/my-project/locations/us-central1/providers/GCP.PubSub/locations/global/operationStatuses/00000000-0000-0000-0000-000000000000",
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+
+	secretClient := secret.NewMockClient(ctrl)
+	secretProvider := secretprovider.NewSecretProvider(secretprovider.SecretProviderOptions{})
+	secretProvider.SetClient(secretClient)
+
+	options := &ucp.Options{
+		Config: &ucp.Config{
+			Server: hostoptions.ServerOptions{
+				Host:     "localhost",
+				Port:     8080,
+				PathBase: pathBase,
+			},
+		},
+		DatabaseProvider: databaseprovider.FromMemory(),
+		SecretProvider:   secretProvider,
+		StatusManager:    statusmanager.NewMockStatusManager(gomock.NewController(t)),
+	}
+
+	rpctest.AssertRouters(t, tests, pathBase, "", func(ctx context.Context) (chi.Router, error) {
+		module := NewModule(options)
+		module.GCPClient = ucp_gcp.NewClient(ucp_gcp.ClientOptions{})
+		handler, err := module.Initialize(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler.(chi.Router), nil
+	})
+}
@@ -17,19 +17,30 @@ limitations under the License.
 package radius
 
 import (
+	"time"
+
 	"github.com/go-chi/chi/v5"
 	"github.com/radius-project/radius/pkg/ucp"
+	resourcegroups_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
 	"github.com/radius-project/radius/pkg/validator"
 )
 
+// lifecycleCacheTTL is how long the lifecycles of resource types and API versions are cached by proxy requests.
+const lifecycleCacheTTL = 30 * time.Second
+
 // NewModule creates a new Radius module.
 func NewModule(options *ucp.Options) *Module {
 	router := chi.NewRouter()
 	router.NotFound(validator.APINotFoundHandler())
 	router.MethodNotAllowed(validator.APIMethodNotAllowedHandler())
 
-	return &Module{options: options, router: router, defaultDownstream: options.Config.Routing.DefaultDownstreamEndpoint}
+	return &Module{
+		options:           options,
+		router:            router,
+		defaultDownstream: options.Config.Routing.DefaultDownstreamEndpoint,
+		lifecycles:        resourcegroups_ctrl.NewLifecycleCache(lifecycleCacheTTL),
+	}
 }
 
 var _ modules.Initializer = &Module{}
@@ -39,6 +50,7 @@ type Module struct {
 	options           *ucp.Options
 	router            chi.Router
 	defaultDownstream string
+	lifecycles        *resourcegroups_ctrl.LifecycleCache
 }
 
 // PlaneType returns the type of plane this module is for.
@@ -34,6 +34,7 @@ import (
 	radius_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/radius"
 	resourcegroups_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/resourcegroups"
 	resourceproviders_ctrl "github.com/radius-project/radius/pkg/ucp/frontend/controller/resourceproviders"
+	"github.com/radius-project/radius/pkg/ucp/locationhealth"
 	"github.com/radius-project/radius/pkg/validator"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
@@ -52,7 +53,7 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 		ResourceTypeGetter: validator.UCPResourceTypeGetter,
 	})
 
-	transport := otelhttp.NewTransport(http.DefaultTransport)
+	transport := m.options.Throttler.Transport(otelhttp.NewTransport(http.DefaultTransport), m.PlaneType(), "")
 
 	// More convienent way to capture errors
 	var err error
@@ -88,8 +89,8 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 			r.With(apiValidator).Delete("/", capture(radiusPlaneDeleteHandler(ctx, ctrlOptions)))
 
 			r.Route("/providers", func(r chi.Router) {
-				r.Get("/", capture(resourceProviderSummaryListHandler(ctx, ctrlOptions)))
-				r.Get("/{resourceProviderName}", capture(resourceProviderSummaryGetHandler(ctx, ctrlOptions)))
+				r.Get("/", capture(resourceProviderSummaryListHandler(ctx, ctrlOptions, m.options.LocationHealth)))
+				r.Get("/{resourceProviderName}", capture(resourceProviderSummaryGetHandler(ctx, ctrlOptions, m.options.LocationHealth)))
 
 				r.Route("/System.Resources", func(r chi.Router) {
 
@@ -134,6 +135,8 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 									r.Route("/icons", func(r chi.Router) {
 										r.Get("/{hash}", capture(resourceTypeIconGetHandler(ctx, ctrlOptions)))
 									})
+
+									r.Get("/usage", capture(resourceTypeUsageGetHandler(ctx, ctrlOptions)))
 								})
 							})
 						})
@@ -143,7 +146,7 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 				// Proxy to plane-scoped ResourceProvider APIs
 				//
 				// NOTE: DO NOT validate schema for proxy routes.
-				r.Handle("/*", capture(planeScopedProxyHandler(ctx, ctrlOptions, transport, m.defaultDownstream)))
+				r.Handle("/*", capture(planeScopedProxyHandler(ctx, ctrlOptions, transport, m.defaultDownstream, m.options.LocationHealth, m.lifecycles)))
 			})
 
 			r.Route("/resourcegroups", func(r chi.Router) {
@@ -160,7 +163,7 @@ func (m *Module) Initialize(ctx context.Context) (http.Handler, error) {
 						// Proxy to resource-group-scoped ResourceProvider APIs
 						//
 						// NOTE: DO NOT validate schema for proxy routes.
-						r.Handle("/*", capture(resourceGroupScopedProxyHandler(ctx, ctrlOptions, transport, m.defaultDownstream)))
+						r.Handle("/*", capture(resourceGroupScopedProxyHandler(ctx, ctrlOptions, transport, m.defaultDownstream, m.options.LocationHealth, m.lifecycles)))
 					})
 				})
 
@@ -238,15 +241,15 @@ func resourceGroupResourcesHandler(ctx context.Context, ctrlOptions controller.O
 	})
 }
 
-func resourceProviderSummaryListHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+func resourceProviderSummaryListHandler(ctx context.Context, ctrlOptions controller.Options, health *locationhealth.Monitor) (http.HandlerFunc, error) {
 	return server.CreateHandler(ctx, datamodel.ResourceProviderSummaryResourceType, v1.OperationList, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
-		return resourceproviders_ctrl.NewListResourceProviderSummaries(opts)
+		return resourceproviders_ctrl.NewListResourceProviderSummaries(opts, health)
 	})
 }
 
-func resourceProviderSummaryGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+func resourceProviderSummaryGetHandler(ctx context.Context, ctrlOptions controller.Options, health *locationhealth.Monitor) (http.HandlerFunc, error) {
 	return server.CreateHandler(ctx, datamodel.ResourceProviderSummaryResourceType, v1.OperationGet, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
-		return resourceproviders_ctrl.NewGetResourceProviderSummary(opts)
+		return resourceproviders_ctrl.NewGetResourceProviderSummary(opts, health)
 	})
 }
 
@@ -283,6 +286,7 @@ func resourceProviderDeleteHandler(ctx context.Context, ctrlOptions controller.O
 var resourceTypeResourceOptions = controller.ResourceOptions[datamodel.ResourceType]{
 	RequestConverter:         converter.ResourceTypeDataModelFromVersioned,
 	ResponseConverter:        converter.ResourceTypeDataModelToVersioned,
+	DeleteFilters:            []controller.DeleteFilter[datamodel.ResourceType]{resourceproviders_ctrl.ResourceTypeDeleteFilter},
 	AsyncOperationRetryAfter: operationRetryAfter,
 }
 
@@ -313,6 +317,7 @@ func resourceTypeDeleteHandler(ctx context.Context, ctrlOptions controller.Optio
 var apiVersionResourceOptions = controller.ResourceOptions[datamodel.APIVersion]{
 	RequestConverter:         converter.APIVersionDataModelFromVersioned,
 	ResponseConverter:        converter.APIVersionDataModelToVersioned,
+	DeleteFilters:            []controller.DeleteFilter[datamodel.APIVersion]{resourceproviders_ctrl.APIVersionDeleteFilter},
 	AsyncOperationRetryAfter: operationRetryAfter,
 }
 
@@ -346,6 +351,12 @@ func resourceTypeIconGetHandler(ctx context.Context, ctrlOptions controller.Opti
 	})
 }
 
+func resourceTypeUsageGetHandler(ctx context.Context, ctrlOptions controller.Options) (http.HandlerFunc, error) {
+	return server.CreateHandler(ctx, datamodel.ResourceTypeResourceType, v1.OperationGet, ctrlOptions, func(opts controller.Options) (controller.Controller, error) {
+		return resourceproviders_ctrl.NewGetUsage(opts)
+	})
+}
+
 var locationResourceOptions = controller.ResourceOptions[datamodel.Location]{
 	RequestConverter:         converter.LocationDataModelFromVersioned,
 	ResponseConverter:        converter.LocationDataModelToVersioned,
@@ -376,15 +387,15 @@ func locationDeleteHandler(ctx context.Context, ctrlOptions controller.Options)
 	})
 }
 
-func planeScopedProxyHandler(ctx context.Context, ctrlOptions controller.Options, transport http.RoundTripper, defaultDownstream string) (http.HandlerFunc, error) {
+func planeScopedProxyHandler(ctx context.Context, ctrlOptions controller.Options, transport http.RoundTripper, defaultDownstream string, health *locationhealth.Monitor, lifecycles *resourcegroups_ctrl.LifecycleCache) (http.HandlerFunc, error) {
 	return server.CreateHandler(ctx, OperationTypeUCPRadiusProxy, v1.OperationProxy, ctrlOptions, func(o controller.Options) (controller.Controller, error) {
-		return radius_ctrl.NewProxyController(o, transport, defaultDownstream)
+		return radius_ctrl.NewProxyController(o, transport, defaultDownstream, health, lifecycles)
 	})
 }
 
-func resourceGroupScopedProxyHandler(ctx context.Context, ctrlOptions controller.Options, transport http.RoundTripper, defaultDownstream string) (http.HandlerFunc, error) {
+func resourceGroupScopedProxyHandler(ctx context.Context, ctrlOptions controller.Options, transport http.RoundTripper, defaultDownstream string, health *locationhealth.Monitor, lifecycles *resourcegroups_ctrl.LifecycleCache) (http.HandlerFunc, error) {
 	return server.CreateHandler(ctx, OperationTypeUCPRadiusProxy, v1.OperationProxy, ctrlOptions, func(o controller.Options) (controller.Controller, error) {
-		return radius_ctrl.NewProxyController(o, transport, defaultDownstream)
+		return radius_ctrl.NewProxyController(o, transport, defaultDownstream, health, lifecycles)
 	})
 }
 
@@ -0,0 +1,148 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// DefaultAPIVersion is the version of the GCP REST API used when the request does not specify one.
+	DefaultAPIVersion = "v1"
+
+	// endpointFormat is the format of the default endpoint of a GCP service, eg: https://pubsub.googleapis.com.
+	endpointFormat = "https://%s.googleapis.com"
+)
+
+// Client is the interface for calling the REST APIs of GCP services. Resources are addressed by their relative
+// resource name as described by https://google.aip.dev/122.
+type Client interface {
+	// ResourceURL returns the URL of the resource or collection with the given relative name in the given service and
+	// API version.
+	ResourceURL(service string, version string, name string) string
+
+	// Do sends a request with an optional JSON body to the given URL and returns the decoded JSON response body. A
+	// *ResponseError is returned when GCP responds with a non-success status code.
+	Do(ctx context.Context, method string, url string, body any) (map[string]any, error)
+}
+
+// ClientOptions contains the options for creating a Client.
+type ClientOptions struct {
+	// TokenSource provides the OAuth2 access tokens used to authenticate requests. Requests are not authenticated when
+	// TokenSource is nil.
+	TokenSource oauth2.TokenSource
+
+	// Endpoint overrides the endpoint for all GCP services. This is used for testing.
+	Endpoint string
+
+	// Transport is the base HTTP transport. http.DefaultTransport is used when Transport is nil.
+	Transport http.RoundTripper
+}
+
+var _ Client = (*client)(nil)
+
+type client struct {
+	httpClient *http.Client
+	endpoint   string
+}
+
+// NewClient creates a new Client with the given options.
+func NewClient(options ClientOptions) Client {
+	transport := options.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	if options.TokenSource != nil {
+		transport = &oauth2.Transport{Source: options.TokenSource, Base: transport}
+	}
+
+	return &client{
+		httpClient: &http.Client{Transport: transport},
+		endpoint:   options.Endpoint,
+	}
+}
+
+// ResourceURL returns the URL of the resource or collection with the given relative name in the given service and
+// API version.
+func (c *client) ResourceURL(service string, version string, name string) string {
+	endpoint := c.endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf(endpointFormat, service)
+	}
+
+	if version == "" {
+		version = DefaultAPIVersion
+	}
+
+	return fmt.Sprintf("%s/%s/%s", endpoint, version, name)
+}
+
+// Do sends a request with an optional JSON body to the given URL and returns the decoded JSON response body.
+func (c *client) Do(ctx context.Context, method string, url string, body any) (map[string]any, error) {
+	var reader io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, newResponseError(resp.StatusCode, b)
+	}
+
+	result := map[string]any{}
+	if len(bytes.TrimSpace(b)) == 0 {
+		return result, nil
+	}
+
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode response from %s: %w", url, err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	armrpc_v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+)
+
+func TestResourceURL(t *testing.T) {
+	c := NewClient(ClientOptions{})
+	require.Equal(t, "https://pubsub.googleapis.com/v1/projects/my-project/topics/my-topic", c.ResourceURL("pubsub", "", "projects/my-project/topics/my-topic"))
+	require.Equal(t, "https://run.googleapis.com/v2/projects/my-project/locations/us-central1/services", c.ResourceURL("run", "v2", "projects/my-project/locations/us-central1/services"))
+
+	c = NewClient(ClientOptions{Endpoint: "http://localhost:8080"})
+	require.Equal(t, "http://localhost:8080/v1/projects/my-project/topics/my-topic", c.ResourceURL("pubsub", "v1", "projects/my-project/topics/my-topic"))
+}
+
+func TestDo(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer fake-token", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/v1/projects/my-project/topics/my-topic":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"name":"projects/my-project/topics/my-topic"}`))
+		case "/v1/projects/my-project/topics/empty":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":404,"message":"Resource not found","status":"NOT_FOUND"}}`))
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(ClientOptions{
+		Endpoint:    server.URL,
+		TokenSource: oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "fake-token"}),
+	})
+
+	t.Run("success", func(t *testing.T) {
+		body, err := c.Do(t.Context(), http.MethodGet, c.ResourceURL("pubsub", "v1", "projects/my-project/topics/my-topic"), nil)
+		require.NoError(t, err)
+		require.Equal(t, map[string]any{"name": "projects/my-project/topics/my-topic"}, body)
+	})
+
+	t.Run("empty response", func(t *testing.T) {
+		body, err := c.Do(t.Context(), http.MethodDelete, c.ResourceURL("pubsub", "v1", "projects/my-project/topics/empty"), nil)
+		require.NoError(t, err)
+		require.Empty(t, body)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := c.Do(t.Context(), http.MethodGet, c.ResourceURL("pubsub", "v1", "projects/my-project/topics/missing"), nil)
+		require.True(t, IsGCPResourceNotFoundError(err))
+		require.Equal(t, &ResponseError{StatusCode: http.StatusNotFound, Status: "NOT_FOUND", Message: "Resource not found"}, err)
+	})
+}
+
+func TestHandleGCPError(t *testing.T) {
+	resp, err := HandleGCPError(&ResponseError{StatusCode: http.StatusBadRequest, Status: "INVALID_ARGUMENT", Message: "invalid"})
+	require.NoError(t, err)
+	require.Equal(t, armrpc_rest.NewBadRequestARMResponse(armrpc_v1.ErrorResponse{
+		Error: &armrpc_v1.ErrorDetails{Code: "INVALID_ARGUMENT", Message: "invalid"},
+	}), resp)
+
+	resp, err = HandleGCPError(&ResponseError{StatusCode: http.StatusServiceUnavailable, Status: "UNAVAILABLE", Message: "unavailable"})
+	require.NoError(t, err)
+	require.Equal(t, armrpc_rest.NewInternalServerErrorARMResponse(armrpc_v1.ErrorResponse{
+		Error: &armrpc_v1.ErrorDetails{Code: "UNAVAILABLE", Message: "unavailable"},
+	}), resp)
+
+	expected := errors.New("some error")
+	resp, err = HandleGCPError(expected)
+	require.Nil(t, resp)
+	require.Equal(t, expected, err)
+}
+
+func TestToOperation(t *testing.T) {
+	_, ok := ToOperation(map[string]any{"name": "projects/my-project/topics/my-topic"})
+	require.False(t, ok)
+
+	op, ok := ToOperation(map[string]any{"name": "projects/my-project/locations/us-central1/operations/1234"})
+	require.True(t, ok)
+	require.False(t, op.Done)
+
+	op, ok = ToOperation(map[string]any{
+		"name":     "operations/1234",
+		"done":     true,
+		"response": map[string]any{"name": "projects/my-project/topics/my-topic"},
+	})
+	require.True(t, ok)
+	require.True(t, op.Done)
+	require.False(t, op.Failed())
+	require.Equal(t, map[string]any{"name": "projects/my-project/topics/my-topic"}, op.Response)
+
+	op, ok = ToOperation(map[string]any{
+		"name":  "operations/1234",
+		"done":  true,
+		"error": map[string]any{"code": 3, "message": "invalid topic", "status": "INVALID_ARGUMENT"},
+	})
+	require.True(t, ok)
+	require.True(t, op.Failed())
+	require.Equal(t, "INVALID_ARGUMENT", op.ErrorCode)
+	require.Equal(t, "invalid topic", op.ErrorMessage)
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	armrpc_v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	armrpc_rest "github.com/radius-project/radius/pkg/armrpc/rest"
+)
+
+// ResponseError is the error returned when a GCP API responds with a non-success status code. The fields are read
+// from the standard error payload described by https://google.aip.dev/193.
+type ResponseError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+
+	// Status is the canonical error code, eg: NOT_FOUND.
+	Status string
+
+	// Message is the developer-facing error message.
+	Message string
+}
+
+// Error returns the error message of the ResponseError.
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("GCP request failed with status code %d (%s): %s", e.StatusCode, e.Status, e.Message)
+}
+
+func newResponseError(statusCode int, body []byte) *ResponseError {
+	payload := struct {
+		Error struct {
+			Status  string `json:"status"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{}
+
+	e := &ResponseError{StatusCode: statusCode}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		e.Status = payload.Error.Status
+		e.Message = payload.Error.Message
+	}
+
+	if e.Status == "" {
+		e.Status = http.StatusText(statusCode)
+	}
+
+	if e.Message == "" {
+		e.Message = string(body)
+	}
+
+	return e
+}
+
+// IsGCPResourceNotFoundError checks if the given error is a GCP not found error.
+func IsGCPResourceNotFoundError(err error) bool {
+	responseErr := &ResponseError{}
+	return errors.As(err, &responseErr) && responseErr.StatusCode == http.StatusNotFound
+}
+
+// HandleGCPError takes in an error and returns an ARMResponse and an error. Errors caused by the request, such as
+// validation failures, are returned as a BadRequestARMResponse, and errors caused by GCP are returned as an
+// InternalServerErrorARMResponse. Errors that did not come from GCP are returned as-is.
+func HandleGCPError(err error) (armrpc_rest.Response, error) {
+	responseErr := &ResponseError{}
+	if !errors.As(err, &responseErr) {
+		return nil, err
+	}
+
+	e := armrpc_v1.ErrorResponse{
+		Error: &armrpc_v1.ErrorDetails{
+			Code:    responseErr.Status,
+			Message: responseErr.Message,
+		},
+	}
+
+	if responseErr.StatusCode >= 400 && responseErr.StatusCode < 500 {
+		return armrpc_rest.NewBadRequestARMResponse(e), nil
+	}
+
+	return armrpc_rest.NewInternalServerErrorARMResponse(e), nil
+}
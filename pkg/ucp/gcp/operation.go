@@ -0,0 +1,72 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"strings"
+)
+
+// Operation is a GCP long-running operation as described by https://google.aip.dev/151.
+type Operation struct {
+	// Name is the relative name of the operation, eg: projects/my-project/locations/us-central1/operations/1234.
+	Name string
+
+	// Done is true when the operation has completed.
+	Done bool
+
+	// ErrorCode is the canonical error code of a failed operation.
+	ErrorCode string
+
+	// ErrorMessage is the error message of a failed operation.
+	ErrorMessage string
+
+	// Response is the result of a successful operation, typically the resource.
+	Response map[string]any
+}
+
+// Failed returns true if the operation has completed with an error.
+func (o *Operation) Failed() bool {
+	return o.Done && o.ErrorMessage != ""
+}
+
+// ToOperation returns the Operation represented by the given response body, or false if the response body is not a
+// long-running operation.
+func ToOperation(body map[string]any) (*Operation, bool) {
+	name, ok := body["name"].(string)
+	if !ok || !(strings.HasPrefix(name, "operations/") || strings.Contains(name, "/operations/")) {
+		return nil, false
+	}
+
+	op := &Operation{Name: name}
+	op.Done, _ = body["done"].(bool)
+	if response, ok := body["response"].(map[string]any); ok {
+		op.Response = response
+	}
+
+	if e, ok := body["error"].(map[string]any); ok {
+		op.ErrorMessage, _ = e["message"].(string)
+		op.ErrorCode, _ = e["status"].(string)
+		if op.ErrorCode == "" {
+			op.ErrorCode = "OperationFailed"
+		}
+		if op.ErrorMessage == "" {
+			op.ErrorMessage = "operation " + name + " failed"
+		}
+	}
+
+	return op, true
+}
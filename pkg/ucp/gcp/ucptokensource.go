@@ -0,0 +1,193 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google/externalaccount"
+	"golang.org/x/oauth2/jwt"
+
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+var _ oauth2.TokenSource = (*UCPTokenSource)(nil)
+
+const (
+	// DefaultExpireDuration is the default duration after which the credential is fetched again from UCP.
+	DefaultExpireDuration = time.Minute * time.Duration(15)
+
+	// CredentialKindServiceAccountKey is ServiceAccountKey
+	CredentialKindServiceAccountKey = "ServiceAccountKey"
+	// CredentialKindWorkloadIdentity is WorkloadIdentity
+	CredentialKindWorkloadIdentity = "WorkloadIdentity"
+	// Token file path for workload identity federation
+	TokenFilePath = "/var/run/secrets/gcp/serviceaccount/token"
+	// CloudPlatformScope is the OAuth2 scope that grants access to all GCP services.
+	CloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+	// GCP OAuth2 token endpoint used by service account keys without a token_uri.
+	defaultTokenURL = "https://oauth2.googleapis.com/token"
+	// GCP Security Token Service endpoint used to exchange the Kubernetes service account token.
+	stsTokenURL = "https://sts.googleapis.com/v1/token"
+	// GCP IAM Credentials endpoint used to impersonate the service account.
+	impersonationURLFormat = "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/%s:generateAccessToken"
+	// Token type of the Kubernetes service account token.
+	subjectTokenTypeJWT = "urn:ietf:params:oauth:token-type:jwt"
+)
+
+// UCPTokenSource is the implementation of oauth2.TokenSource to retrieve access tokens for GCP APIs via UCP
+// credentials.
+type UCPTokenSource struct {
+	options UCPTokenSourceOptions
+
+	mu      sync.Mutex
+	source  oauth2.TokenSource
+	expires time.Time
+}
+
+// UCPTokenSourceOptions is a configuration for UCPTokenSource.
+type UCPTokenSourceOptions struct {
+	// Provider is an UCP credential provider.
+	Provider sdk_cred.CredentialProvider[sdk_cred.GCPCredential]
+
+	// Duration is the duration after which the credential is fetched again from UCP.
+	Duration time.Duration
+}
+
+// NewUCPTokenSource creates UCPTokenSource to fetch access tokens using UCP credential APIs.
+func NewUCPTokenSource(provider sdk_cred.CredentialProvider[sdk_cred.GCPCredential], expireDuration time.Duration) *UCPTokenSource {
+	if expireDuration == 0 {
+		expireDuration = DefaultExpireDuration
+	}
+
+	o := UCPTokenSourceOptions{
+		Provider: provider,
+		Duration: expireDuration,
+	}
+
+	return &UCPTokenSource{options: o}
+}
+
+// Token returns an access token for GCP APIs. The credential is fetched from UCP when it is first needed and again
+// after the configured duration, so that updates to the registered credential are picked up.
+func (c *UCPTokenSource) Token() (*oauth2.Token, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.source == nil || time.Now().UTC().After(c.expires) {
+		ctx := context.TODO()
+		logger := ucplog.FromContextOrDiscard(ctx)
+
+		s, err := c.options.Provider.Fetch(ctx, sdk_cred.GCPPublic, "default")
+		if err != nil {
+			return nil, err
+		}
+
+		source, err := NewTokenSource(ctx, s)
+		if err != nil {
+			return nil, err
+		}
+
+		logger.Info(fmt.Sprintf("Retrieved GCP Credential - Kind: %s", s.Kind))
+		c.source = oauth2.ReuseTokenSource(nil, source)
+		c.expires = time.Now().UTC().Add(c.options.Duration)
+	}
+
+	return c.source.Token()
+}
+
+// NewTokenSource creates an oauth2.TokenSource for the given GCP credential. Service account keys are exchanged for
+// access tokens directly, and workload identity federation exchanges the projected Kubernetes service account token
+// for an access token of the configured service account.
+func NewTokenSource(ctx context.Context, cred *sdk_cred.GCPCredential) (oauth2.TokenSource, error) {
+	switch cred.Kind {
+	case CredentialKindServiceAccountKey:
+		if cred.ServiceAccountKeyCredential == nil || cred.ServiceAccountKeyCredential.ServiceAccountKey == "" {
+			return nil, errors.New("invalid service account key info")
+		}
+
+		key := struct {
+			ClientEmail  string `json:"client_email"`
+			PrivateKey   string `json:"private_key"`
+			PrivateKeyID string `json:"private_key_id"`
+			TokenURI     string `json:"token_uri"`
+		}{}
+		if err := json.Unmarshal([]byte(cred.ServiceAccountKeyCredential.ServiceAccountKey), &key); err != nil {
+			return nil, fmt.Errorf("invalid service account key: %w", err)
+		}
+
+		if key.ClientEmail == "" || key.PrivateKey == "" {
+			return nil, errors.New("invalid service account key. client_email and private_key are required")
+		}
+
+		if key.TokenURI == "" {
+			key.TokenURI = defaultTokenURL
+		}
+
+		config := &jwt.Config{
+			Email:        key.ClientEmail,
+			PrivateKey:   []byte(key.PrivateKey),
+			PrivateKeyID: key.PrivateKeyID,
+			Scopes:       []string{CloudPlatformScope},
+			TokenURL:     key.TokenURI,
+		}
+		return config.TokenSource(ctx), nil
+
+	case CredentialKindWorkloadIdentity:
+		if cred.WorkloadIdentityCredential == nil || cred.WorkloadIdentityCredential.Audience == "" || cred.WorkloadIdentityCredential.ServiceAccountEmail == "" {
+			return nil, errors.New("invalid workload identity info. Audience and ServiceAccountEmail are required")
+		}
+
+		return externalaccount.NewTokenSource(ctx, externalaccount.Config{
+			Audience:                       cred.WorkloadIdentityCredential.Audience,
+			SubjectTokenType:               subjectTokenTypeJWT,
+			TokenURL:                       stsTokenURL,
+			ServiceAccountImpersonationURL: fmt.Sprintf(impersonationURLFormat, cred.WorkloadIdentityCredential.ServiceAccountEmail),
+			Scopes:                         []string{CloudPlatformScope},
+			CredentialSource: &externalaccount.CredentialSource{
+				File: TokenFilePath,
+			},
+		})
+
+	default:
+		return nil, errors.New("invalid credential kind")
+	}
+}
+
+// ExternalAccountConfig returns the external account credential configuration file for the given workload identity
+// credential. This is the format accepted by GCP client libraries and tools such as Terraform in place of a
+// service account key, see https://google.aip.dev/auth/4117.
+func ExternalAccountConfig(cred *sdk_cred.GCPWorkloadIdentityCredential) map[string]any {
+	return map[string]any{
+		"type":                              "external_account",
+		"audience":                          cred.Audience,
+		"subject_token_type":                subjectTokenTypeJWT,
+		"token_url":                         stsTokenURL,
+		"service_account_impersonation_url": fmt.Sprintf(impersonationURLFormat, cred.ServiceAccountEmail),
+		"credential_source": map[string]any{
+			"file": TokenFilePath,
+		},
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk_cred "github.com/radius-project/radius/pkg/ucp/credentials"
+	ucp_datamodel "github.com/radius-project/radius/pkg/ucp/datamodel"
+)
+
+type mockProvider struct {
+	fakeCredential *sdk_cred.GCPCredential
+	calls          atomic.Int32
+}
+
+// Fetch gets the GCP credentials from secret storage. It takes in a context, planeName and name and returns
+// a GCPCredential or an error if the fakeCredential is nil.
+func (p *mockProvider) Fetch(ctx context.Context, planeName, name string) (*sdk_cred.GCPCredential, error) {
+	p.calls.Add(1)
+	if p.fakeCredential == nil {
+		return nil, errors.New("failed to fetch credential")
+	}
+	return p.fakeCredential, nil
+}
+
+func newServiceAccountKey(t *testing.T, tokenURL string) string {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	key, err := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"project_id":     "my-project",
+		"private_key_id": "fakekeyid",
+		"private_key":    string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})),
+		"client_email":   "radius@my-project.iam.gserviceaccount.com",
+		"token_uri":      tokenURL,
+	})
+	require.NoError(t, err)
+
+	return string(key)
+}
+
+func newMockProviderServiceAccountKey(key string) *mockProvider {
+	return &mockProvider{
+		fakeCredential: &sdk_cred.GCPCredential{
+			Kind: ucp_datamodel.GCPServiceAccountKeyCredentialKind,
+			ServiceAccountKeyCredential: &ucp_datamodel.GCPServiceAccountKeyCredentialProperties{
+				ServiceAccountKey: key,
+			},
+		},
+	}
+}
+
+func newMockProviderWorkloadIdentity() *mockProvider {
+	return &mockProvider{
+		fakeCredential: &sdk_cred.GCPCredential{
+			Kind: ucp_datamodel.GCPWorkloadIdentityCredentialKind,
+			WorkloadIdentityCredential: &ucp_datamodel.GCPWorkloadIdentityCredentialProperties{
+				Audience:            "//iam.googleapis.com/projects/123456789/locations/global/workloadIdentityPools/radius/providers/kubernetes",
+				ServiceAccountEmail: "radius@my-project.iam.gserviceaccount.com",
+			},
+		},
+	}
+}
+
+func TestNewUCPTokenSource(t *testing.T) {
+	p := NewUCPTokenSource(newMockProviderWorkloadIdentity(), 0)
+	require.Equal(t, DefaultExpireDuration, p.options.Duration)
+}
+
+func TestToken(t *testing.T) {
+	t.Run("invalid credential", func(t *testing.T) {
+		p := newMockProviderServiceAccountKey("")
+		_, err := NewUCPTokenSource(p, DefaultExpireDuration).Token()
+		require.EqualError(t, err, "invalid service account key info")
+
+		p = newMockProviderServiceAccountKey(`{"type":"service_account"}`)
+		_, err = NewUCPTokenSource(p, DefaultExpireDuration).Token()
+		require.EqualError(t, err, "invalid service account key. client_email and private_key are required")
+
+		p = newMockProviderWorkloadIdentity()
+		p.fakeCredential.WorkloadIdentityCredential.Audience = ""
+		_, err = NewUCPTokenSource(p, DefaultExpireDuration).Token()
+		require.EqualError(t, err, "invalid workload identity info. Audience and ServiceAccountEmail are required")
+
+		p = &mockProvider{fakeCredential: &sdk_cred.GCPCredential{Kind: "Invalid"}}
+		_, err = NewUCPTokenSource(p, DefaultExpireDuration).Token()
+		require.EqualError(t, err, "invalid credential kind")
+
+		p = &mockProvider{}
+		_, err = NewUCPTokenSource(p, DefaultExpireDuration).Token()
+		require.EqualError(t, err, "failed to fetch credential")
+	})
+
+	t.Run("service account key", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", r.Form.Get("grant_type"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token":"fake-token","token_type":"Bearer","expires_in":3600}`))
+		}))
+		defer server.Close()
+
+		p := newMockProviderServiceAccountKey(newServiceAccountKey(t, server.URL))
+		ts := NewUCPTokenSource(p, DefaultExpireDuration)
+
+		token, err := ts.Token()
+		require.NoError(t, err)
+		require.Equal(t, "fake-token", token.AccessToken)
+
+		// The credential is cached until it expires.
+		_, err = ts.Token()
+		require.NoError(t, err)
+		require.Equal(t, int32(1), p.calls.Load())
+	})
+}
+
+func TestExternalAccountConfig(t *testing.T) {
+	p := newMockProviderWorkloadIdentity()
+	config := ExternalAccountConfig(p.fakeCredential.WorkloadIdentityCredential)
+
+	require.Equal(t, "external_account", config["type"])
+	require.Equal(t, p.fakeCredential.WorkloadIdentityCredential.Audience, config["audience"])
+	require.Equal(t, "https://iamcredentials.googleapis.com/v1/projects/-/serviceAccounts/radius@my-project.iam.gserviceaccount.com:generateAccessToken", config["service_account_impersonation_url"])
+	require.Equal(t, map[string]any{"file": TokenFilePath}, config["credential_source"])
+}
@@ -0,0 +1,426 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locationhealth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/databaseprovider"
+	"github.com/radius-project/radius/pkg/ucp/config"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	// DefaultInterval is the default time between health probes.
+	DefaultInterval = 30 * time.Second
+
+	// DefaultTimeout is the default timeout of each health probe.
+	DefaultTimeout = 5 * time.Second
+
+	// radiusPlanesScope is the scope that contains the locations of all resource providers.
+	radiusPlanesScope = "/planes/radius"
+)
+
+// UnhealthyError is returned when all addresses of a location are unhealthy.
+type UnhealthyError struct {
+	// LocationID is the resource id of the location.
+	LocationID string
+
+	// Addresses is the health of each address of the location.
+	Addresses []datamodel.LocationAddressHealth
+}
+
+// Error returns the error message.
+func (e *UnhealthyError) Error() string {
+	details := []string{}
+	for _, address := range e.Addresses {
+		detail := address.Address
+		if address.Message != nil {
+			detail += ": " + *address.Message
+		}
+		details = append(details, detail)
+	}
+
+	return fmt.Sprintf("all addresses of resource provider location %q are unhealthy (%s)", e.LocationID, strings.Join(details, "; "))
+}
+
+// Is checks if the target error is an UnhealthyError.
+func (e *UnhealthyError) Is(target error) bool {
+	_, ok := target.(*UnhealthyError)
+	return ok
+}
+
+// Monitor periodically probes the health of the addresses of resource provider locations, and selects the address
+// to route requests to based on the results.
+//
+// Health is tracked in memory by each UCP replica. Addresses that have not been probed yet are considered available.
+// A nil *Monitor is valid, and always selects the first address of a location.
+type Monitor struct {
+	databaseProvider *databaseprovider.DatabaseProvider
+	client           *http.Client
+	interval         time.Duration
+	failureThreshold int
+
+	mu        sync.Mutex
+	locations map[string]*locationState
+
+	// now can be overridden for testing.
+	now func() time.Time
+}
+
+type locationState struct {
+	addresses []*addressState
+
+	// next is the index of the next address for round robin routing.
+	next int
+}
+
+type addressState struct {
+	address       string
+	status        datamodel.LocationHealthStatus
+	failures      int
+	lastProbeTime *time.Time
+	message       *string
+}
+
+// NewMonitor creates a new Monitor from the given options.
+func NewMonitor(databaseProvider *databaseprovider.DatabaseProvider, options config.HealthProbeOptions) (*Monitor, error) {
+	interval, err := parseDuration("interval", options.Interval, DefaultInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout, err := parseDuration("timeout", options.Timeout, DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Monitor{
+		databaseProvider: databaseProvider,
+		client:           &http.Client{Timeout: timeout},
+		interval:         interval,
+		failureThreshold: max(options.FailureThreshold, 1),
+		locations:        map[string]*locationState{},
+		now:              time.Now,
+	}, nil
+}
+
+func parseDuration(name string, value string, defaultValue time.Duration) (time.Duration, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid health probe %s: %w", name, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid health probe %s: must be greater than 0", name)
+	}
+
+	return d, nil
+}
+
+// Name returns the service name.
+func (m *Monitor) Name() string {
+	return "location health monitor"
+}
+
+// Run probes the addresses of all resource provider locations until the context is cancelled.
+func (m *Monitor) Run(ctx context.Context) error {
+	logger := ucplog.FromContextOrDiscard(ctx)
+
+	client, err := m.databaseProvider.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.ProbeLocations(ctx, client); err != nil && !errors.Is(err, context.Canceled) {
+			logger.Error(err, "failed to probe resource provider locations")
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProbeLocations probes the addresses of all resource provider locations once.
+func (m *Monitor) ProbeLocations(ctx context.Context, client database.Client) error {
+	query := database.Query{
+		RootScope:      radiusPlanesScope,
+		ScopeRecursive: true,
+		ResourceType:   datamodel.LocationResourceType,
+	}
+
+	locations := []*datamodel.Location{}
+	options := []database.QueryOptions{}
+	for {
+		result, err := client.Query(ctx, query, options...)
+		if err != nil {
+			return fmt.Errorf("failed to query resource provider locations: %w", err)
+		}
+
+		for _, item := range result.Items {
+			location := &datamodel.Location{}
+			if err := item.As(location); err != nil {
+				return err
+			}
+			locations = append(locations, location)
+		}
+
+		if result.PaginationToken == "" {
+			break
+		}
+		options = []database.QueryOptions{database.WithPaginationToken(result.PaginationToken)}
+	}
+
+	wg := sync.WaitGroup{}
+	seen := map[string]bool{}
+	for _, location := range locations {
+		addresses := location.Properties.AllAddresses()
+		if len(addresses) == 0 {
+			continue
+		}
+
+		key := strings.ToLower(location.ID)
+		seen[key] = true
+
+		var path *string
+		if location.Properties.HealthProbe != nil {
+			path = location.Properties.HealthProbe.Path
+		}
+
+		for _, address := range addresses {
+			wg.Go(func() {
+				m.record(key, addresses, address, m.probe(ctx, address, path))
+			})
+		}
+	}
+	wg.Wait()
+
+	// Forget locations that were deleted or no longer have addresses.
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key := range m.locations {
+		if !seen[key] {
+			delete(m.locations, key)
+		}
+	}
+
+	return nil
+}
+
+// probe sends a health probe to the address. When a path is configured a 2xx response is healthy, otherwise any
+// response with a status code below 500 is healthy.
+func (m *Monitor) probe(ctx context.Context, address string, path *string) error {
+	target := address
+	if path != nil && *path != "" {
+		target = strings.TrimSuffix(address, "/") + "/" + strings.TrimPrefix(*path, "/")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	healthy := resp.StatusCode < 500
+	if path != nil && *path != "" {
+		healthy = resp.StatusCode >= 200 && resp.StatusCode <= 299
+	}
+
+	if !healthy {
+		return fmt.Errorf("health probe %q returned status code %d", target, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// record records the result of a health probe of an address of a location.
+func (m *Monitor) record(key string, addresses []string, address string, probeErr error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state := m.location(key, addresses)
+	for _, entry := range state.addresses {
+		if entry.address != address {
+			continue
+		}
+
+		now := m.now()
+		entry.lastProbeTime = &now
+		if probeErr == nil {
+			entry.status = datamodel.LocationHealthStatusHealthy
+			entry.failures = 0
+			entry.message = nil
+			return
+		}
+
+		entry.failures++
+		entry.message = new(probeErr.Error())
+		if entry.failures >= m.failureThreshold {
+			entry.status = datamodel.LocationHealthStatusUnhealthy
+		}
+		return
+	}
+}
+
+// location returns the state of the location, reconciling the tracked addresses with the given addresses.
+//
+// The caller must hold the lock.
+func (m *Monitor) location(key string, addresses []string) *locationState {
+	state, ok := m.locations[key]
+	if !ok {
+		state = &locationState{}
+		m.locations[key] = state
+	}
+
+	existing := map[string]*addressState{}
+	for _, entry := range state.addresses {
+		existing[entry.address] = entry
+	}
+
+	state.addresses = []*addressState{}
+	for _, address := range addresses {
+		entry, ok := existing[address]
+		if !ok {
+			entry = &addressState{address: address, status: datamodel.LocationHealthStatusUnknown}
+		}
+		state.addresses = append(state.addresses, entry)
+	}
+
+	return state
+}
+
+// Health returns the health of the addresses of the location with the given resource id, or nil if the location has
+// not been probed.
+func (m *Monitor) Health(locationID string) *datamodel.LocationHealth {
+	if m == nil {
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state, ok := m.locations[strings.ToLower(locationID)]
+	if !ok {
+		return nil
+	}
+
+	health := &datamodel.LocationHealth{}
+	for _, entry := range state.addresses {
+		health.Addresses = append(health.Addresses, entry.health())
+	}
+	health.Status = overallStatus(health.Addresses)
+
+	return health
+}
+
+// SelectAddress returns the address that a request to the location should be routed to, based on the routing
+// policy of the location and the health of its addresses. Returns an empty string if the location has no addresses,
+// or an UnhealthyError if all addresses are unhealthy.
+func (m *Monitor) SelectAddress(location *datamodel.Location) (string, error) {
+	addresses := location.Properties.AllAddresses()
+	if len(addresses) == 0 {
+		return "", nil
+	}
+
+	if m == nil {
+		return addresses[0], nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state := m.location(strings.ToLower(location.ID), addresses)
+
+	available := []string{}
+	for _, entry := range state.addresses {
+		if entry.status != datamodel.LocationHealthStatusUnhealthy {
+			available = append(available, entry.address)
+		}
+	}
+
+	if len(available) == 0 {
+		err := &UnhealthyError{LocationID: location.ID}
+		for _, entry := range state.addresses {
+			err.Addresses = append(err.Addresses, entry.health())
+		}
+		return "", err
+	}
+
+	if location.Properties.RoutingPolicy == datamodel.LocationRoutingPolicyRoundRobin {
+		address := available[state.next%len(available)]
+		state.next = (state.next + 1) % len(available)
+		return address, nil
+	}
+
+	return available[0], nil
+}
+
+func (a *addressState) health() datamodel.LocationAddressHealth {
+	return datamodel.LocationAddressHealth{
+		Address:       a.address,
+		Status:        a.status,
+		LastProbeTime: a.lastProbeTime,
+		Message:       a.message,
+	}
+}
+
+// overallStatus returns the health status of a location from the health of its addresses.
+func overallStatus(addresses []datamodel.LocationAddressHealth) datamodel.LocationHealthStatus {
+	healthy, unhealthy := 0, 0
+	for _, address := range addresses {
+		switch address.Status {
+		case datamodel.LocationHealthStatusHealthy:
+			healthy++
+		case datamodel.LocationHealthStatusUnhealthy:
+			unhealthy++
+		}
+	}
+
+	switch {
+	case unhealthy == 0 && healthy == len(addresses):
+		return datamodel.LocationHealthStatusHealthy
+	case unhealthy == len(addresses):
+		return datamodel.LocationHealthStatusUnhealthy
+	case unhealthy > 0:
+		return datamodel.LocationHealthStatusDegraded
+	default:
+		return datamodel.LocationHealthStatusUnknown
+	}
+}
@@ -0,0 +1,292 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package locationhealth
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/database"
+	"github.com/radius-project/radius/pkg/components/database/inmemory"
+	"github.com/radius-project/radius/pkg/ucp/config"
+	"github.com/radius-project/radius/pkg/ucp/datamodel"
+	"github.com/stretchr/testify/require"
+)
+
+const testLocationID = "/planes/radius/local/providers/System.Resources/resourceProviders/Applications.Test/locations/global"
+
+// testLocationKey is the key used by the monitor to track testLocationID.
+var testLocationKey = strings.ToLower(testLocationID)
+
+func newTestLocation(id string, properties datamodel.LocationProperties) *datamodel.Location {
+	return &datamodel.Location{
+		BaseResource: v1.BaseResource{
+			TrackedResource: v1.TrackedResource{
+				ID:   id,
+				Name: "global",
+				Type: datamodel.LocationResourceType,
+			},
+		},
+		Properties: properties,
+	}
+}
+
+func saveLocation(t *testing.T, client database.Client, location *datamodel.Location) {
+	err := client.Save(t.Context(), &database.Object{
+		Metadata: database.Metadata{ID: location.ID},
+		Data:     location,
+	})
+	require.NoError(t, err)
+}
+
+func newTestMonitor(t *testing.T, options config.HealthProbeOptions) *Monitor {
+	monitor, err := NewMonitor(nil, options)
+	require.NoError(t, err)
+	return monitor
+}
+
+func Test_NewMonitor(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		monitor := newTestMonitor(t, config.HealthProbeOptions{})
+		require.Equal(t, DefaultInterval, monitor.interval)
+		require.Equal(t, DefaultTimeout, monitor.client.Timeout)
+		require.Equal(t, 1, monitor.failureThreshold)
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		monitor := newTestMonitor(t, config.HealthProbeOptions{Interval: "10s", Timeout: "1s", FailureThreshold: 3})
+		require.Equal(t, 10*time.Second, monitor.interval)
+		require.Equal(t, time.Second, monitor.client.Timeout)
+		require.Equal(t, 3, monitor.failureThreshold)
+	})
+
+	t.Run("invalid interval", func(t *testing.T) {
+		_, err := NewMonitor(nil, config.HealthProbeOptions{Interval: "soon"})
+		require.ErrorContains(t, err, "invalid health probe interval")
+	})
+
+	t.Run("negative timeout", func(t *testing.T) {
+		_, err := NewMonitor(nil, config.HealthProbeOptions{Timeout: "-1s"})
+		require.EqualError(t, err, "invalid health probe timeout: must be greater than 0")
+	})
+}
+
+func Test_ProbeLocations(t *testing.T) {
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer healthy.Close()
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+
+	t.Run("records health of each address", func(t *testing.T) {
+		client := inmemory.NewClient()
+		saveLocation(t, client, newTestLocation(testLocationID, datamodel.LocationProperties{
+			Address:     new(failing.URL),
+			Addresses:   []string{healthy.URL},
+			HealthProbe: &datamodel.LocationHealthProbe{Path: new("/healthz")},
+		}))
+
+		monitor := newTestMonitor(t, config.HealthProbeOptions{})
+		require.NoError(t, monitor.ProbeLocations(t.Context(), client))
+
+		health := monitor.Health(testLocationID)
+		require.NotNil(t, health)
+		require.Equal(t, datamodel.LocationHealthStatusDegraded, health.Status)
+		require.Len(t, health.Addresses, 2)
+
+		require.Equal(t, failing.URL, health.Addresses[0].Address)
+		require.Equal(t, datamodel.LocationHealthStatusUnhealthy, health.Addresses[0].Status)
+		require.NotNil(t, health.Addresses[0].LastProbeTime)
+		require.Contains(t, *health.Addresses[0].Message, "returned status code 503")
+
+		require.Equal(t, healthy.URL, health.Addresses[1].Address)
+		require.Equal(t, datamodel.LocationHealthStatusHealthy, health.Addresses[1].Status)
+		require.Nil(t, health.Addresses[1].Message)
+	})
+
+	t.Run("any status below 500 is healthy without a path", func(t *testing.T) {
+		client := inmemory.NewClient()
+		saveLocation(t, client, newTestLocation(testLocationID, datamodel.LocationProperties{
+			Address: new(healthy.URL),
+		}))
+
+		monitor := newTestMonitor(t, config.HealthProbeOptions{})
+		require.NoError(t, monitor.ProbeLocations(t.Context(), client))
+
+		health := monitor.Health(testLocationID)
+		require.NotNil(t, health)
+		require.Equal(t, datamodel.LocationHealthStatusHealthy, health.Status)
+	})
+
+	t.Run("address is unhealthy after failure threshold", func(t *testing.T) {
+		client := inmemory.NewClient()
+		saveLocation(t, client, newTestLocation(testLocationID, datamodel.LocationProperties{
+			Address: new(failing.URL),
+		}))
+
+		monitor := newTestMonitor(t, config.HealthProbeOptions{FailureThreshold: 2})
+
+		require.NoError(t, monitor.ProbeLocations(t.Context(), client))
+		health := monitor.Health(testLocationID)
+		require.Equal(t, datamodel.LocationHealthStatusUnknown, health.Addresses[0].Status)
+		require.NotNil(t, health.Addresses[0].Message)
+
+		require.NoError(t, monitor.ProbeLocations(t.Context(), client))
+		health = monitor.Health(testLocationID)
+		require.Equal(t, datamodel.LocationHealthStatusUnhealthy, health.Addresses[0].Status)
+	})
+
+	t.Run("forgets deleted locations", func(t *testing.T) {
+		client := inmemory.NewClient()
+		saveLocation(t, client, newTestLocation(testLocationID, datamodel.LocationProperties{
+			Address: new(healthy.URL),
+		}))
+
+		monitor := newTestMonitor(t, config.HealthProbeOptions{})
+		require.NoError(t, monitor.ProbeLocations(t.Context(), client))
+		require.NotNil(t, monitor.Health(testLocationID))
+
+		require.NoError(t, client.Delete(t.Context(), testLocationID))
+		require.NoError(t, monitor.ProbeLocations(t.Context(), client))
+		require.Nil(t, monitor.Health(testLocationID))
+	})
+}
+
+func Test_SelectAddress(t *testing.T) {
+	location := newTestLocation(testLocationID, datamodel.LocationProperties{
+		Address:   new("http://primary"),
+		Addresses: []string{"http://standby"},
+	})
+
+	t.Run("nil monitor selects first address", func(t *testing.T) {
+		var monitor *Monitor
+		address, err := monitor.SelectAddress(location)
+		require.NoError(t, err)
+		require.Equal(t, "http://primary", address)
+		require.Nil(t, monitor.Health(testLocationID))
+	})
+
+	t.Run("no addresses", func(t *testing.T) {
+		monitor := newTestMonitor(t, config.HealthProbeOptions{})
+		address, err := monitor.SelectAddress(newTestLocation(testLocationID, datamodel.LocationProperties{}))
+		require.NoError(t, err)
+		require.Empty(t, address)
+	})
+
+	t.Run("active standby fails over to standby", func(t *testing.T) {
+		monitor := newTestMonitor(t, config.HealthProbeOptions{})
+
+		address, err := monitor.SelectAddress(location)
+		require.NoError(t, err)
+		require.Equal(t, "http://primary", address)
+
+		addresses := location.Properties.AllAddresses()
+		monitor.record(testLocationKey, addresses, "http://primary", errors.New("connection refused"))
+
+		address, err = monitor.SelectAddress(location)
+		require.NoError(t, err)
+		require.Equal(t, "http://standby", address)
+
+		monitor.record(testLocationKey, addresses, "http://primary", nil)
+
+		address, err = monitor.SelectAddress(location)
+		require.NoError(t, err)
+		require.Equal(t, "http://primary", address)
+	})
+
+	t.Run("round robin cycles through available addresses", func(t *testing.T) {
+		monitor := newTestMonitor(t, config.HealthProbeOptions{})
+		roundRobin := newTestLocation(testLocationID, datamodel.LocationProperties{
+			Address:       new("http://a"),
+			Addresses:     []string{"http://b", "http://c"},
+			RoutingPolicy: datamodel.LocationRoutingPolicyRoundRobin,
+		})
+		monitor.record(testLocationKey, roundRobin.Properties.AllAddresses(), "http://b", errors.New("connection refused"))
+
+		selected := []string{}
+		for range 4 {
+			address, err := monitor.SelectAddress(roundRobin)
+			require.NoError(t, err)
+			selected = append(selected, address)
+		}
+		require.Equal(t, []string{"http://a", "http://c", "http://a", "http://c"}, selected)
+	})
+
+	t.Run("all addresses unhealthy", func(t *testing.T) {
+		monitor := newTestMonitor(t, config.HealthProbeOptions{})
+		addresses := location.Properties.AllAddresses()
+		monitor.record(testLocationKey, addresses, "http://primary", errors.New("connection refused"))
+		monitor.record(testLocationKey, addresses, "http://standby", errors.New("timeout"))
+
+		address, err := monitor.SelectAddress(location)
+		require.Empty(t, address)
+		require.ErrorIs(t, err, &UnhealthyError{})
+		require.EqualError(t, err, `all addresses of resource provider location "`+testLocationID+`" are unhealthy (http://primary: connection refused; http://standby: timeout)`)
+	})
+}
+
+func Test_overallStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []datamodel.LocationHealthStatus
+		expected datamodel.LocationHealthStatus
+	}{
+		{
+			name:     "all healthy",
+			statuses: []datamodel.LocationHealthStatus{datamodel.LocationHealthStatusHealthy, datamodel.LocationHealthStatusHealthy},
+			expected: datamodel.LocationHealthStatusHealthy,
+		},
+		{
+			name:     "all unhealthy",
+			statuses: []datamodel.LocationHealthStatus{datamodel.LocationHealthStatusUnhealthy, datamodel.LocationHealthStatusUnhealthy},
+			expected: datamodel.LocationHealthStatusUnhealthy,
+		},
+		{
+			name:     "some unhealthy",
+			statuses: []datamodel.LocationHealthStatus{datamodel.LocationHealthStatusHealthy, datamodel.LocationHealthStatusUnhealthy},
+			expected: datamodel.LocationHealthStatusDegraded,
+		},
+		{
+			name:     "not probed",
+			statuses: []datamodel.LocationHealthStatus{datamodel.LocationHealthStatusHealthy, datamodel.LocationHealthStatusUnknown},
+			expected: datamodel.LocationHealthStatusUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addresses := []datamodel.LocationAddressHealth{}
+			for _, status := range tt.statuses {
+				addresses = append(addresses, datamodel.LocationAddressHealth{Status: status})
+			}
+			require.Equal(t, tt.expected, overallStatus(addresses))
+		})
+	}
+}
@@ -28,6 +28,8 @@ import (
 	"github.com/radius-project/radius/pkg/sdk"
 	ucpconfig "github.com/radius-project/radius/pkg/ucp/config"
 	"github.com/radius-project/radius/pkg/ucp/frontend/modules"
+	"github.com/radius-project/radius/pkg/ucp/locationhealth"
+	"github.com/radius-project/radius/pkg/ucp/proxy"
 	"github.com/radius-project/radius/pkg/validator"
 	"github.com/radius-project/radius/swagger"
 	kube_rest "k8s.io/client-go/rest"
@@ -44,6 +46,10 @@ type Options struct {
 	// DatabaseProvider provides access to the database used for resource data.
 	DatabaseProvider *databaseprovider.DatabaseProvider
 
+	// LocationHealth probes the health of the addresses of resource provider locations and selects the address
+	// requests are routed to.
+	LocationHealth *locationhealth.Monitor
+
 	// Modules is the list of modules to initialize. This will default to nil (implying the default set), and
 	// can be overridden by tests.
 	Modules []modules.Initializer
@@ -60,6 +66,10 @@ type Options struct {
 	// StatusManager implements operations on async operation statuses.
 	StatusManager statusmanager.StatusManager
 
+	// Throttler applies rate limits, retries and circuit breaking to proxied requests. Nil when throttling is
+	// not configured.
+	Throttler *proxy.Throttler
+
 	// UCP is the connection to UCP
 	UCP sdk.Connection
 }
@@ -89,6 +99,16 @@ func NewOptions(ctx context.Context, config *Config) (*Options, error) {
 
 	options.StatusManager = statusmanager.New(databaseClient, queueClient, config.Environment.RoleLocation)
 
+	options.LocationHealth, err = locationhealth.NewMonitor(options.DatabaseProvider, config.Routing.HealthProbe)
+	if err != nil {
+		return nil, fmt.Errorf("invalid health probe configuration: %w", err)
+	}
+
+	options.Throttler, err = proxy.NewThrottler(config.UCP.Throttling)
+	if err != nil {
+		return nil, fmt.Errorf("invalid throttling configuration: %w", err)
+	}
+
 	options.SpecLoader, err = validator.LoadSpec(ctx, "ucp", swagger.SpecFilesUCP, []string{config.Server.PathBase}, "")
 	if err != nil {
 		return nil, err
@@ -0,0 +1,548 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	v1 "github.com/radius-project/radius/pkg/armrpc/api/v1"
+	"github.com/radius-project/radius/pkg/components/metrics"
+	"github.com/radius-project/radius/pkg/ucp/config"
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/radius-project/radius/pkg/ucp/ucplog"
+)
+
+const (
+	defaultInitialBackoff = time.Second
+	defaultMaxBackoff     = 30 * time.Second
+	defaultOpenDuration   = 30 * time.Second
+
+	rateLimitPlane            = "plane"
+	rateLimitResourceProvider = "resourceprovider"
+	rateLimitCredential       = "credential"
+)
+
+type circuitState string
+
+const (
+	circuitClosed   circuitState = "closed"
+	circuitOpen     circuitState = "open"
+	circuitHalfOpen circuitState = "halfopen"
+)
+
+// Throttler applies the rate limits, retries and circuit breaking configured by config.ThrottlingOptions to
+// requests sent by the UCP proxies.
+//
+// A nil *Throttler is valid and applies no throttling.
+type Throttler struct {
+	planes            map[string]config.RateLimitOptions
+	resourceProviders map[string]config.RateLimitOptions
+	credentials       map[string]config.RateLimitOptions
+
+	maxAttempts      int
+	initialBackoff   time.Duration
+	maxBackoff       time.Duration
+	failureThreshold int
+	openDuration     time.Duration
+
+	mu       sync.Mutex
+	limiters map[string]*rate.Limiter
+	breakers map[string]*circuitBreaker
+
+	// now and sleep can be overridden for testing.
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// NewThrottler creates a Throttler from the given options. Returns nil if the options do not configure any
+// throttling, or an error if the options are invalid.
+func NewThrottler(options config.ThrottlingOptions) (*Throttler, error) {
+	if len(options.Planes) == 0 && len(options.ResourceProviders) == 0 && len(options.Credentials) == 0 &&
+		options.Retry.MaxAttempts < 2 && options.CircuitBreaker.FailureThreshold <= 0 {
+		return nil, nil
+	}
+
+	t := &Throttler{
+		maxAttempts:      max(options.Retry.MaxAttempts, 1),
+		failureThreshold: options.CircuitBreaker.FailureThreshold,
+		limiters:         map[string]*rate.Limiter{},
+		breakers:         map[string]*circuitBreaker{},
+		now:              time.Now,
+		sleep:            sleep,
+	}
+
+	var err error
+	if t.planes, err = normalizeRateLimits("planes", options.Planes); err != nil {
+		return nil, err
+	}
+	if t.resourceProviders, err = normalizeRateLimits("resourceProviders", options.ResourceProviders); err != nil {
+		return nil, err
+	}
+	if t.credentials, err = normalizeRateLimits("credentials", options.Credentials); err != nil {
+		return nil, err
+	}
+
+	if t.initialBackoff, err = parseDuration("retry.initialBackoff", options.Retry.InitialBackoff, defaultInitialBackoff); err != nil {
+		return nil, err
+	}
+	if t.maxBackoff, err = parseDuration("retry.maxBackoff", options.Retry.MaxBackoff, defaultMaxBackoff); err != nil {
+		return nil, err
+	}
+	if t.openDuration, err = parseDuration("circuitBreaker.openDuration", options.CircuitBreaker.OpenDuration, defaultOpenDuration); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func normalizeRateLimits(name string, limits map[string]config.RateLimitOptions) (map[string]config.RateLimitOptions, error) {
+	result := map[string]config.RateLimitOptions{}
+	for key, limit := range limits {
+		if limit.RequestsPerSecond <= 0 {
+			return nil, fmt.Errorf("invalid rate limit for %s %q: requestsPerSecond must be greater than 0", name, key)
+		}
+		if limit.Burst < 0 {
+			return nil, fmt.Errorf("invalid rate limit for %s %q: burst must not be negative", name, key)
+		}
+		if limit.Burst == 0 {
+			limit.Burst = int(math.Ceil(limit.RequestsPerSecond))
+		}
+		result[strings.ToLower(key)] = limit
+	}
+
+	return result, nil
+}
+
+func parseDuration(name string, value string, defaultValue time.Duration) (time.Duration, error) {
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration for %s: %w", name, err)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("invalid duration for %s: must not be negative", name)
+	}
+
+	return d, nil
+}
+
+// Transport returns an http.RoundTripper that throttles requests sent to the given plane type using the given
+// credential, eg: aws/default, and sends them using inner. The resource provider of each request is derived from
+// the resource ID in the request path. The credential may be empty for requests that UCP does not authenticate.
+//
+// Transport returns inner when t is nil.
+func (t *Throttler) Transport(inner http.RoundTripper, planeType string, credential string) http.RoundTripper {
+	return t.transport(inner, planeType, credential, true)
+}
+
+// TransportWithoutRetries is like Transport but does not retry throttled requests. It is used for clients whose SDK
+// retries throttled requests itself, eg: the AWS SDK, which would otherwise multiply the retries. Each attempt made by
+// the SDK is still rate limited and counted by the circuit breaker.
+func (t *Throttler) TransportWithoutRetries(inner http.RoundTripper, planeType string, credential string) http.RoundTripper {
+	return t.transport(inner, planeType, credential, false)
+}
+
+func (t *Throttler) transport(inner http.RoundTripper, planeType string, credential string, retry bool) http.RoundTripper {
+	if t == nil {
+		return inner
+	}
+	if inner == nil {
+		inner = http.DefaultTransport
+	}
+
+	maxAttempts := 1
+	if retry {
+		maxAttempts = t.maxAttempts
+	}
+
+	return &throttledTransport{
+		throttler:   t,
+		inner:       inner,
+		planeType:   strings.ToLower(planeType),
+		credential:  strings.ToLower(credential),
+		maxAttempts: maxAttempts,
+	}
+}
+
+type throttledTransport struct {
+	throttler   *Throttler
+	inner       http.RoundTripper
+	planeType   string
+	credential  string
+	maxAttempts int
+}
+
+// RoundTrip implements http.RoundTripper.
+func (tt *throttledTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t := tt.throttler
+	ctx := req.Context()
+	logger := ucplog.FromContextOrDiscard(ctx)
+	resourceProvider := resourceProviderFromPath(req.URL.Path)
+
+	breakerKey := strings.ToLower(req.URL.Host)
+	allowed, trial, retryAfter := t.allow(ctx, breakerKey, tt.planeType, resourceProvider)
+	if !allowed {
+		logger.Info("circuit is open for downstream, rejecting request", "downstream", req.URL.Host)
+		metrics.DefaultProxyMetrics.RecordCircuitRejected(ctx, tt.planeType, resourceProvider)
+		return newCircuitOpenResponse(req, retryAfter), nil
+	}
+
+	// If the trial request of a half-open circuit ends without an outcome from the downstream, eg: it is canceled,
+	// the trial is released so that another request can be sent.
+	recorded := false
+	record := func(success bool) {
+		recorded = true
+		t.record(ctx, breakerKey, tt.planeType, resourceProvider, success)
+	}
+	if trial {
+		defer func() {
+			if !recorded {
+				t.release(breakerKey)
+			}
+		}()
+	}
+
+	if err := t.wait(ctx, tt.planeType, resourceProvider, tt.credential); err != nil {
+		return nil, err
+	}
+
+	// Buffer the request body so that it can be sent again on retry.
+	var body []byte
+	if tt.maxAttempts > 1 && req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+		req.Body, _ = req.GetBody()
+	}
+
+	for attempt := 1; ; attempt++ {
+		resp, err := tt.inner.RoundTrip(req)
+		if err != nil {
+			// A canceled request says nothing about the health of the downstream.
+			if ctx.Err() == nil {
+				record(false)
+			}
+			return nil, err
+		}
+
+		if attempt >= tt.maxAttempts || !isRetryableStatusCode(resp.StatusCode) {
+			record(!isFailureStatusCode(resp.StatusCode))
+			return resp, nil
+		}
+
+		delay := t.backoff(attempt)
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), t.now()); ok {
+			if retryAfter > t.maxBackoff {
+				// The downstream asked us to wait longer than we're willing to, let the caller decide.
+				record(!isFailureStatusCode(resp.StatusCode))
+				return resp, nil
+			}
+			delay = retryAfter
+		}
+
+		logger.Info("retrying throttled request", "statusCode", resp.StatusCode, "attempt", attempt, "delay", delay.String())
+		metrics.DefaultProxyMetrics.RecordRetry(ctx, tt.planeType, resourceProvider, resp.StatusCode)
+
+		// Drain the body so the connection can be reused.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+
+		if err := t.sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+
+		// Each retry is a new request to the downstream, so it waits for the rate limits too.
+		if err := t.wait(ctx, tt.planeType, resourceProvider, tt.credential); err != nil {
+			return nil, err
+		}
+
+		if req.GetBody != nil {
+			req = req.Clone(ctx)
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// wait blocks until the plane, resource provider and credential rate limits allow the request to be sent.
+func (t *Throttler) wait(ctx context.Context, planeType string, resourceProvider string, credential string) error {
+	limits := []struct {
+		kind   string
+		key    string
+		limits map[string]config.RateLimitOptions
+	}{
+		{kind: rateLimitPlane, key: planeType, limits: t.planes},
+		{kind: rateLimitResourceProvider, key: resourceProvider, limits: t.resourceProviders},
+		{kind: rateLimitCredential, key: credential, limits: t.credentials},
+	}
+
+	for _, limit := range limits {
+		limiter := t.limiter(limit.kind, limit.key, limit.limits)
+		if limiter == nil {
+			continue
+		}
+
+		reservation := limiter.ReserveN(t.now(), 1)
+		delay := reservation.DelayFrom(t.now())
+		metrics.DefaultProxyMetrics.RecordRateLimitWait(ctx, limit.kind, planeType, resourceProvider, delay)
+		if delay == 0 {
+			continue
+		}
+
+		ucplog.FromContextOrDiscard(ctx).Info("request is rate limited", "rateLimit", limit.kind, "key", limit.key, "delay", delay.String())
+		if err := t.sleep(ctx, delay); err != nil {
+			reservation.Cancel()
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *Throttler) limiter(kind string, key string, limits map[string]config.RateLimitOptions) *rate.Limiter {
+	if key == "" {
+		return nil
+	}
+
+	options, ok := limits[key]
+	if !ok {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	id := kind + "/" + key
+	limiter, ok := t.limiters[id]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(options.RequestsPerSecond), options.Burst)
+		t.limiters[id] = limiter
+	}
+
+	return limiter
+}
+
+// backoff returns the delay before the given retry attempt.
+func (t *Throttler) backoff(attempt int) time.Duration {
+	delay := t.initialBackoff
+	for i := 1; i < attempt && delay < t.maxBackoff; i++ {
+		delay *= 2
+	}
+
+	return min(delay, t.maxBackoff)
+}
+
+// circuitBreaker tracks the health of a downstream.
+type circuitBreaker struct {
+	state    circuitState
+	failures int
+	openedAt time.Time
+	trial    bool
+}
+
+// allow returns true if a request can be sent to the downstream, and whether it is the trial request of a half-open
+// circuit. When the circuit is open the remaining time until a trial request is allowed is returned.
+func (t *Throttler) allow(ctx context.Context, key string, planeType string, resourceProvider string) (bool, bool, time.Duration) {
+	if t.failureThreshold <= 0 {
+		return true, false, 0
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	breaker, ok := t.breakers[key]
+	if !ok {
+		return true, false, 0
+	}
+
+	switch breaker.state {
+	case circuitOpen:
+		remaining := breaker.openedAt.Add(t.openDuration).Sub(t.now())
+		if remaining > 0 {
+			return false, false, remaining
+		}
+
+		// Allow a single trial request through.
+		t.transition(ctx, breaker, circuitHalfOpen, planeType, resourceProvider)
+		breaker.trial = true
+		return true, true, 0
+	case circuitHalfOpen:
+		if breaker.trial {
+			return false, false, t.openDuration
+		}
+		breaker.trial = true
+		return true, true, 0
+	default:
+		return true, false, 0
+	}
+}
+
+// release releases the trial request of a half-open circuit without recording an outcome, so that the next request
+// becomes the trial request.
+func (t *Throttler) release(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if breaker, ok := t.breakers[key]; ok && breaker.state == circuitHalfOpen {
+		breaker.trial = false
+	}
+}
+
+// record records the outcome of a request sent to the downstream.
+func (t *Throttler) record(ctx context.Context, key string, planeType string, resourceProvider string, success bool) {
+	if t.failureThreshold <= 0 {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	breaker, ok := t.breakers[key]
+	if !ok {
+		if success {
+			return
+		}
+		breaker = &circuitBreaker{state: circuitClosed}
+		t.breakers[key] = breaker
+	}
+
+	breaker.trial = false
+	if success {
+		breaker.failures = 0
+		if breaker.state != circuitClosed {
+			t.transition(ctx, breaker, circuitClosed, planeType, resourceProvider)
+		}
+		return
+	}
+
+	breaker.failures++
+	if breaker.state == circuitHalfOpen || (breaker.state == circuitClosed && breaker.failures >= t.failureThreshold) {
+		breaker.openedAt = t.now()
+		t.transition(ctx, breaker, circuitOpen, planeType, resourceProvider)
+	}
+}
+
+func (t *Throttler) transition(ctx context.Context, breaker *circuitBreaker, state circuitState, planeType string, resourceProvider string) {
+	ucplog.FromContextOrDiscard(ctx).Info("circuit state changed", "from", string(breaker.state), "to", string(state))
+	breaker.state = state
+	metrics.DefaultProxyMetrics.RecordCircuitStateChange(ctx, planeType, resourceProvider, string(state))
+}
+
+// isRetryableStatusCode returns true if the request should be retried after receiving the given status code.
+func isRetryableStatusCode(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable
+}
+
+// isFailureStatusCode returns true if the given status code indicates the downstream is unhealthy.
+func isFailureStatusCode(statusCode int) bool {
+	return statusCode == http.StatusBadGateway || statusCode == http.StatusServiceUnavailable || statusCode == http.StatusGatewayTimeout
+}
+
+// parseRetryAfter parses a Retry-After header, which is either a number of seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+
+	return 0, false
+}
+
+// resourceProviderFromPath returns the lowercased resource provider namespace of the resource ID in the given
+// URL path, or an empty string if the path is not a resource ID.
+func resourceProviderFromPath(path string) string {
+	// Downstream paths for Radius resource providers may include a path base before the resource ID.
+	if i := strings.Index(strings.ToLower(path), resources.SegmentSeparator+resources.PlanesSegment+resources.SegmentSeparator); i > 0 {
+		path = path[i:]
+	}
+
+	id, err := resources.Parse(path)
+	if err != nil {
+		return ""
+	}
+
+	return strings.ToLower(id.ProviderNamespace())
+}
+
+func newCircuitOpenResponse(req *http.Request, retryAfter time.Duration) *http.Response {
+	body, _ := json.Marshal(v1.ErrorResponse{
+		Error: &v1.ErrorDetails{
+			Code:    v1.CodeServiceUnavailable,
+			Message: fmt.Sprintf("The downstream service %q is unavailable. Please try again later.", req.URL.Host),
+		},
+	})
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", http.StatusServiceUnavailable, http.StatusText(http.StatusServiceUnavailable)),
+		StatusCode:    http.StatusServiceUnavailable,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
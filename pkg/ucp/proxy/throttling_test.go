@@ -0,0 +1,411 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proxy
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/radius-project/radius/pkg/ucp/config"
+	"github.com/stretchr/testify/require"
+)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// fakeDownstream responds with the given status codes in order, repeating the last one.
+type fakeDownstream struct {
+	statusCodes []int
+	headers     []http.Header
+	bodies      []string
+}
+
+func (d *fakeDownstream) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := ""
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		body = string(b)
+	}
+	d.bodies = append(d.bodies, body)
+
+	i := min(len(d.bodies)-1, len(d.statusCodes)-1)
+	header := http.Header{}
+	if i < len(d.headers) && d.headers[i] != nil {
+		header = d.headers[i]
+	}
+
+	return &http.Response{StatusCode: d.statusCodes[i], Header: header, Body: io.NopCloser(strings.NewReader("")), Request: req}, nil
+}
+
+// fakeClock is a clock where sleeping advances the time.
+type fakeClock struct {
+	current time.Time
+	sleeps  []time.Duration
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.current
+}
+
+func (c *fakeClock) sleep(ctx context.Context, d time.Duration) error {
+	c.sleeps = append(c.sleeps, d)
+	c.current = c.current.Add(d)
+	return nil
+}
+
+func newTestThrottler(t *testing.T, options config.ThrottlingOptions) (*Throttler, *fakeClock) {
+	throttler, err := NewThrottler(options)
+	require.NoError(t, err)
+	require.NotNil(t, throttler)
+
+	clock := &fakeClock{current: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	throttler.now = clock.now
+	throttler.sleep = clock.sleep
+	return throttler, clock
+}
+
+func newTestRequest(t *testing.T, body string) *http.Request {
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPut, "http://downstream.example.com/planes/radius/local/resourceGroups/test/providers/Applications.Core/containers/test?api-version=2023-10-01-preview", strings.NewReader(body))
+	require.NoError(t, err)
+	return req
+}
+
+func Test_NewThrottler(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		throttler, err := NewThrottler(config.ThrottlingOptions{})
+		require.NoError(t, err)
+		require.Nil(t, throttler)
+
+		// A nil throttler does not wrap the transport.
+		inner := &fakeDownstream{statusCodes: []int{http.StatusOK}}
+		require.Same(t, inner, throttler.Transport(inner, "radius", "").(*fakeDownstream))
+	})
+
+	t.Run("invalid rate limit", func(t *testing.T) {
+		_, err := NewThrottler(config.ThrottlingOptions{Planes: map[string]config.RateLimitOptions{"aws": {RequestsPerSecond: 0}}})
+		require.ErrorContains(t, err, `invalid rate limit for planes "aws"`)
+	})
+
+	t.Run("invalid duration", func(t *testing.T) {
+		_, err := NewThrottler(config.ThrottlingOptions{Retry: config.RetryOptions{MaxAttempts: 3, MaxBackoff: "soon"}})
+		require.ErrorContains(t, err, "invalid duration for retry.maxBackoff")
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		throttler, err := NewThrottler(config.ThrottlingOptions{Planes: map[string]config.RateLimitOptions{"AWS": {RequestsPerSecond: 2.5}}})
+		require.NoError(t, err)
+		require.Equal(t, config.RateLimitOptions{RequestsPerSecond: 2.5, Burst: 3}, throttler.planes["aws"])
+		require.Equal(t, 1, throttler.maxAttempts)
+		require.Equal(t, defaultInitialBackoff, throttler.initialBackoff)
+		require.Equal(t, defaultMaxBackoff, throttler.maxBackoff)
+		require.Equal(t, defaultOpenDuration, throttler.openDuration)
+	})
+}
+
+func Test_Throttler_RateLimit(t *testing.T) {
+	throttler, clock := newTestThrottler(t, config.ThrottlingOptions{
+		Planes:            map[string]config.RateLimitOptions{"radius": {RequestsPerSecond: 10, Burst: 10}},
+		ResourceProviders: map[string]config.RateLimitOptions{"applications.core": {RequestsPerSecond: 1, Burst: 1}},
+	})
+
+	downstream := &fakeDownstream{statusCodes: []int{http.StatusOK}}
+	transport := throttler.Transport(downstream, "radius", "")
+
+	for range 3 {
+		resp, err := transport.RoundTrip(newTestRequest(t, ""))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+	// The first request uses the burst, the next two wait for the resource provider limit.
+	require.Equal(t, []time.Duration{time.Second, time.Second}, clock.sleeps)
+	require.Len(t, downstream.bodies, 3)
+}
+
+func Test_Throttler_CredentialRateLimit(t *testing.T) {
+	throttler, clock := newTestThrottler(t, config.ThrottlingOptions{
+		Credentials: map[string]config.RateLimitOptions{"aws/default": {RequestsPerSecond: 2, Burst: 1}},
+	})
+
+	downstream := &fakeDownstream{statusCodes: []int{http.StatusOK}}
+	transport := throttler.Transport(downstream, "aws", "aws/default")
+	unauthenticated := throttler.Transport(downstream, "aws", "")
+
+	for range 2 {
+		_, err := transport.RoundTrip(newTestRequest(t, ""))
+		require.NoError(t, err)
+		_, err = unauthenticated.RoundTrip(newTestRequest(t, ""))
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, []time.Duration{500 * time.Millisecond}, clock.sleeps)
+}
+
+func Test_Throttler_Retry(t *testing.T) {
+	t.Run("retries with backoff", func(t *testing.T) {
+		throttler, clock := newTestThrottler(t, config.ThrottlingOptions{
+			Retry: config.RetryOptions{MaxAttempts: 4, InitialBackoff: "1s", MaxBackoff: "3s"},
+		})
+
+		downstream := &fakeDownstream{statusCodes: []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK}}
+		resp, err := throttler.Transport(downstream, "radius", "").RoundTrip(newTestRequest(t, "hello"))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		require.Equal(t, []time.Duration{time.Second, 2 * time.Second, 3 * time.Second}, clock.sleeps)
+
+		// The body is replayed on each attempt.
+		require.Equal(t, []string{"hello", "hello", "hello", "hello"}, downstream.bodies)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		throttler, _ := newTestThrottler(t, config.ThrottlingOptions{
+			Retry: config.RetryOptions{MaxAttempts: 2},
+		})
+
+		downstream := &fakeDownstream{statusCodes: []int{http.StatusTooManyRequests}}
+		resp, err := throttler.Transport(downstream, "radius", "").RoundTrip(newTestRequest(t, ""))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		require.Len(t, downstream.bodies, 2)
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		throttler, clock := newTestThrottler(t, config.ThrottlingOptions{
+			Retry: config.RetryOptions{MaxAttempts: 3},
+		})
+
+		downstream := &fakeDownstream{statusCodes: []int{http.StatusInternalServerError}}
+		resp, err := throttler.Transport(downstream, "radius", "").RoundTrip(newTestRequest(t, ""))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		require.Len(t, downstream.bodies, 1)
+		require.Empty(t, clock.sleeps)
+	})
+
+	t.Run("honours Retry-After", func(t *testing.T) {
+		throttler, clock := newTestThrottler(t, config.ThrottlingOptions{
+			Retry: config.RetryOptions{MaxAttempts: 3, MaxBackoff: "10s"},
+		})
+
+		date := clock.current.Add(7 * time.Second).Format(http.TimeFormat)
+		downstream := &fakeDownstream{
+			statusCodes: []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK},
+			headers:     []http.Header{{"Retry-After": []string{"5"}}, {"Retry-After": []string{date}}},
+		}
+		resp, err := throttler.Transport(downstream, "radius", "").RoundTrip(newTestRequest(t, ""))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, []time.Duration{5 * time.Second, 2 * time.Second}, clock.sleeps)
+	})
+
+	t.Run("Retry-After exceeds max backoff", func(t *testing.T) {
+		throttler, clock := newTestThrottler(t, config.ThrottlingOptions{
+			Retry: config.RetryOptions{MaxAttempts: 3, MaxBackoff: "10s"},
+		})
+
+		downstream := &fakeDownstream{
+			statusCodes: []int{http.StatusTooManyRequests},
+			headers:     []http.Header{{"Retry-After": []string{"60"}}},
+		}
+		resp, err := throttler.Transport(downstream, "radius", "").RoundTrip(newTestRequest(t, ""))
+		require.NoError(t, err)
+		require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		require.Equal(t, "60", resp.Header.Get("Retry-After"))
+		require.Empty(t, clock.sleeps)
+	})
+}
+
+func Test_Throttler_CircuitBreaker(t *testing.T) {
+	throttler, clock := newTestThrottler(t, config.ThrottlingOptions{
+		CircuitBreaker: config.CircuitBreakerOptions{FailureThreshold: 2, OpenDuration: "10s"},
+	})
+
+	statusCode := http.StatusBadGateway
+	calls := 0
+	transport := throttler.Transport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		return &http.Response{StatusCode: statusCode, Body: io.NopCloser(strings.NewReader("")), Request: req}, nil
+	}), "radius", "")
+
+	send := func() *http.Response {
+		resp, err := transport.RoundTrip(newTestRequest(t, ""))
+		require.NoError(t, err)
+		return resp
+	}
+
+	// Two failures open the circuit.
+	require.Equal(t, http.StatusBadGateway, send().StatusCode)
+	require.Equal(t, http.StatusBadGateway, send().StatusCode)
+
+	resp := send()
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	require.Equal(t, "10", resp.Header.Get("Retry-After"))
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), `"code":"ServiceUnavailable"`)
+	require.Equal(t, 2, calls)
+
+	// Other downstreams are not affected.
+	other := newTestRequest(t, "")
+	other.URL.Host = "other.example.com"
+	resp, err = transport.RoundTrip(other)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	require.Equal(t, 3, calls)
+
+	// After the open duration a failed trial request re-opens the circuit.
+	clock.current = clock.current.Add(10 * time.Second)
+	require.Equal(t, http.StatusBadGateway, send().StatusCode)
+	require.Equal(t, http.StatusServiceUnavailable, send().StatusCode)
+	require.Equal(t, 4, calls)
+
+	// A successful trial request closes the circuit.
+	clock.current = clock.current.Add(10 * time.Second)
+	statusCode = http.StatusOK
+	require.Equal(t, http.StatusOK, send().StatusCode)
+	require.Equal(t, http.StatusOK, send().StatusCode)
+	require.Equal(t, 6, calls)
+}
+
+func Test_Throttler_CircuitBreaker_CanceledTrial(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		cancel     func(throttler *Throttler, cancel context.CancelFunc) error
+	}{
+		{
+			name:       "canceled downstream request",
+			statusCode: http.StatusOK,
+			cancel: func(_ *Throttler, cancel context.CancelFunc) error {
+				cancel()
+				return context.Canceled
+			},
+		},
+		{
+			name:       "canceled retry",
+			statusCode: http.StatusServiceUnavailable,
+			cancel: func(throttler *Throttler, cancel context.CancelFunc) error {
+				throttler.sleep = func(ctx context.Context, d time.Duration) error {
+					cancel()
+					return context.Canceled
+				}
+				return nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			throttler, clock := newTestThrottler(t, config.ThrottlingOptions{
+				Retry:          config.RetryOptions{MaxAttempts: 2},
+				CircuitBreaker: config.CircuitBreakerOptions{FailureThreshold: 1, OpenDuration: "10s"},
+			})
+
+			var cancel context.CancelFunc
+			statusCode := http.StatusBadGateway
+			transport := throttler.Transport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				if cancel != nil {
+					if err := tt.cancel(throttler, cancel); err != nil {
+						return nil, err
+					}
+					statusCode = tt.statusCode
+				}
+				return &http.Response{StatusCode: statusCode, Body: io.NopCloser(strings.NewReader("")), Request: req}, nil
+			}), "radius", "")
+
+			// A failure opens the circuit.
+			resp, err := transport.RoundTrip(newTestRequest(t, ""))
+			require.NoError(t, err)
+			require.Equal(t, http.StatusBadGateway, resp.StatusCode)
+
+			// The trial request is canceled.
+			clock.current = clock.current.Add(10 * time.Second)
+			ctx, cancelFunc := context.WithCancel(t.Context())
+			cancel = cancelFunc
+			_, err = transport.RoundTrip(newTestRequest(t, "").WithContext(ctx))
+			require.ErrorIs(t, err, context.Canceled)
+
+			// The trial is released, so the next request is sent as the trial request and closes the circuit.
+			cancel = nil
+			throttler.sleep = clock.sleep
+			statusCode = http.StatusOK
+			resp, err = transport.RoundTrip(newTestRequest(t, ""))
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			require.Equal(t, circuitClosed, throttler.breakers["downstream.example.com"].state)
+		})
+	}
+}
+
+func Test_Throttler_RetryRateLimit(t *testing.T) {
+	throttler, clock := newTestThrottler(t, config.ThrottlingOptions{
+		Planes: map[string]config.RateLimitOptions{"radius": {RequestsPerSecond: 1, Burst: 1}},
+		Retry:  config.RetryOptions{MaxAttempts: 2, InitialBackoff: "100ms"},
+	})
+
+	downstream := &fakeDownstream{statusCodes: []int{http.StatusTooManyRequests, http.StatusOK}}
+	resp, err := throttler.Transport(downstream, "radius", "").RoundTrip(newTestRequest(t, ""))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// The retry waits for the backoff, then for the rest of the second until the rate limit has a token.
+	require.Equal(t, []time.Duration{100 * time.Millisecond, 900 * time.Millisecond}, clock.sleeps)
+}
+
+func Test_Throttler_TransportWithoutRetries(t *testing.T) {
+	throttler, clock := newTestThrottler(t, config.ThrottlingOptions{
+		Retry: config.RetryOptions{MaxAttempts: 3},
+	})
+
+	// The SDK of the client retries throttled requests, so the transport does not.
+	downstream := &fakeDownstream{statusCodes: []int{http.StatusServiceUnavailable}}
+	resp, err := throttler.TransportWithoutRetries(downstream, "aws", "").RoundTrip(newTestRequest(t, "hello"))
+	require.NoError(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	require.Equal(t, []string{"hello"}, downstream.bodies)
+	require.Empty(t, clock.sleeps)
+}
+
+func Test_resourceProviderFromPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{path: "/planes/radius/local/resourceGroups/test/providers/Applications.Core/containers/test", expected: "applications.core"},
+		{path: "/apis/api.ucp.dev/v1alpha3/planes/radius/local/providers/Applications.Core/environments", expected: "applications.core"},
+		{path: "/subscriptions/sub/resourceGroups/test/providers/Microsoft.Storage/storageAccounts/test", expected: "microsoft.storage"},
+		{path: "/", expected: ""},
+		{path: "/compute/v1/projects/test/zones/us-central1-a/instances", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			require.Equal(t, tt.expected, resourceProviderFromPath(tt.path))
+		})
+	}
+}
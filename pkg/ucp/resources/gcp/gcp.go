@@ -0,0 +1,77 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"strings"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+)
+
+const (
+	// PlaneTypeGCP defines the type name of the GCP plane.
+	PlaneTypeGCP = "gcp"
+
+	// ScopeProjects defines the project scope for GCP resources.
+	ScopeProjects = "projects"
+
+	// ScopeLocations defines the location scope for GCP resources.
+	ScopeLocations = "locations"
+
+	// ProviderNamespacePrefix is the prefix of the UCP provider namespace for GCP services, eg: GCP.PubSub.
+	ProviderNamespacePrefix = "GCP."
+)
+
+// ToGCPServiceName takes an ID and returns the name of the GCP service that owns the resource, eg: GCP.PubSub/topics
+// maps to "pubsub". The service name is used to build the service endpoint, eg: https://pubsub.googleapis.com.
+func ToGCPServiceName(id resources.ID) string {
+	namespace := id.ProviderNamespace()
+	if len(namespace) < len(ProviderNamespacePrefix) || !strings.EqualFold(namespace[:len(ProviderNamespacePrefix)], ProviderNamespacePrefix) {
+		return ""
+	}
+
+	return strings.ToLower(namespace[len(ProviderNamespacePrefix):])
+}
+
+// ToGCPResourceName takes an ID and returns the GCP relative resource name, eg:
+// /planes/gcp/gcp/projects/my-project/locations/us-central1/providers/GCP.Run/services/my-service maps to
+// projects/my-project/locations/us-central1/services/my-service.
+//
+// When the ID refers to a collection the collection name is returned instead, eg: projects/my-project/topics.
+func ToGCPResourceName(id resources.ID) string {
+	parts := []string{}
+	for _, segment := range id.ScopeSegments() {
+		if strings.EqualFold(segment.Type, ScopeProjects) || strings.EqualFold(segment.Type, ScopeLocations) {
+			parts = append(parts, strings.ToLower(segment.Type), segment.Name)
+		}
+	}
+
+	for i, segment := range id.TypeSegments() {
+		collection := segment.Type
+		if i == 0 {
+			// The first type segment contains the provider namespace, eg: GCP.PubSub/topics.
+			_, collection, _ = strings.Cut(segment.Type, "/")
+		}
+
+		parts = append(parts, collection)
+		if segment.Name != "" {
+			parts = append(parts, segment.Name)
+		}
+	}
+
+	return strings.Join(parts, "/")
+}
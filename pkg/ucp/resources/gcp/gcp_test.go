@@ -0,0 +1,66 @@
+/*
+Copyright 2023 The Radius Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcp
+
+import (
+	"testing"
+
+	"github.com/radius-project/radius/pkg/ucp/resources"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ToGCPServiceName(t *testing.T) {
+	id, err := resources.Parse("/planes/gcp/gcp/projects/my-project/providers/GCP.PubSub/topics/my-topic")
+	require.NoError(t, err)
+	require.Equal(t, "pubsub", ToGCPServiceName(id))
+
+	id, err = resources.Parse("/planes/aws/aws/accounts/123341234/regions/us-west-2/providers/AWS.S3/Bucket/my-bucket")
+	require.NoError(t, err)
+	require.Equal(t, "", ToGCPServiceName(id))
+}
+
+func Test_ToGCPResourceName(t *testing.T) {
+	tests := []struct {
+		id       string
+		expected string
+	}{
+		{
+			id:       "/planes/gcp/gcp/projects/my-project/providers/GCP.PubSub/topics/my-topic",
+			expected: "projects/my-project/topics/my-topic",
+		},
+		{
+			id:       "/planes/gcp/gcp/projects/my-project/providers/GCP.PubSub/topics",
+			expected: "projects/my-project/topics",
+		},
+		{
+			id:       "/planes/gcp/gcp/projects/my-project/locations/us-central1/providers/GCP.Run/services/my-service",
+			expected: "projects/my-project/locations/us-central1/services/my-service",
+		},
+		{
+			id:       "/planes/gcp/gcp/projects/my-project/locations/us-central1/providers/GCP.Container/clusters/my-cluster/nodePools/my-pool",
+			expected: "projects/my-project/locations/us-central1/clusters/my-cluster/nodePools/my-pool",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			id, err := resources.Parse(tt.id)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, ToGCPResourceName(id))
+		})
+	}
+}
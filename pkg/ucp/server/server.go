@@ -46,6 +46,10 @@ func NewServer(options *ucp.Options) (*hosting.Host, error) {
 		services = append(services, &traceservice.Service{Options: &options.Config.Tracing})
 	}
 
+	if options.LocationHealth != nil {
+		services = append(services, options.LocationHealth)
+	}
+
 	services = append(services, initializer.NewService(options))
 
 	return &hosting.Host{